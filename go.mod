@@ -84,3 +84,7 @@ require (
 	gopkg.in/tomb.v1 v1.0.0-20141024135613-dd632973f1e7 // indirect
 	gopkg.in/warnings.v0 v0.1.2 // indirect
 )
+
+// Patches to gocui that haven't been released upstream yet; see
+// third_party/README.md
+replace github.com/jesseduffield/gocui => ./third_party/gocui
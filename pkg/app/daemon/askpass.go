@@ -0,0 +1,102 @@
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/common"
+)
+
+// When git needs credentials and GIT_ASKPASS is set, it runs that program
+// with the prompt as its argument, and reads the answer from its output; ssh
+// does the same with SSH_ASKPASS. We set both to lazygit in daemon mode, which passes the prompt on to the lazygit
+// process that ran the git command through a unix socket, so that the user
+// can answer it in a popup.
+type AskpassInstruction struct {
+	SocketPath string
+}
+
+func NewAskpassInstruction(socketPath string) Instruction {
+	return &AskpassInstruction{
+		SocketPath: socketPath,
+	}
+}
+
+func (self *AskpassInstruction) Kind() DaemonKind {
+	return DaemonKindAskpass
+}
+
+func (self *AskpassInstruction) SerializedInstructions() string {
+	return serializeInstruction(self)
+}
+
+func (self *AskpassInstruction) run(common *common.Common) error {
+	prompt := strings.Join(os.Args[1:], " ")
+	common.Log.Infof("Lazygit invoked as askpass daemon for prompt %q", prompt)
+
+	switch os.Getenv("SSH_ASKPASS_PROMPT") {
+	case "none":
+		// ssh only wants us to show a notification like "Confirm user presence
+		// for key", and kills us when it's done
+		return nil
+	case "confirm":
+		// ssh wants a yes/no answer, which it takes from our exit status
+		answer, err := RequestCredential(self.SocketPath, AskpassRequest{Prompt: prompt, Confirmation: true})
+		if err != nil {
+			return err
+		}
+		if answer != "yes" {
+			return errors.New("Not confirmed")
+		}
+		return nil
+	}
+
+	answer, err := RequestCredential(self.SocketPath, AskpassRequest{Prompt: prompt})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(answer)
+	return nil
+}
+
+// What the askpass daemon sends to the lazygit process that listens on the
+// socket, and what it gets back
+type AskpassRequest struct {
+	Prompt string
+	// True if the prompt is a yes/no question, even if it doesn't look like one
+	Confirmation bool
+}
+
+type AskpassResponse struct {
+	Answer string
+	// True if the user cancelled the prompt, or lazygit isn't allowed to
+	// prompt for credentials for the command; git will fail then
+	Cancelled bool
+}
+
+func RequestCredential(socketPath string, request AskpassRequest) (string, error) {
+	conn, err := net.Dial("unix", socketPath)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	if err := json.NewEncoder(conn).Encode(request); err != nil {
+		return "", err
+	}
+
+	var response AskpassResponse
+	if err := json.NewDecoder(conn).Decode(&response); err != nil {
+		return "", err
+	}
+	if response.Cancelled {
+		return "", errors.New("Credential prompt cancelled")
+	}
+
+	return response.Answer, nil
+}
@@ -39,6 +39,8 @@ const (
 	DaemonKindDropMergeCommit
 	DaemonKindMoveFixupCommitDown
 	DaemonKindWriteRebaseTodo
+	DaemonKindRemoveExecTodosNotAfterCommits
+	DaemonKindAskpass
 )
 
 const (
@@ -61,6 +63,8 @@ func getInstruction() Instruction {
 		DaemonKindMoveTodosDown:                   deserializeInstruction[*MoveTodosDownInstruction],
 		DaemonKindInsertBreak:                     deserializeInstruction[*InsertBreakInstruction],
 		DaemonKindWriteRebaseTodo:                 deserializeInstruction[*WriteRebaseTodoInstruction],
+		DaemonKindRemoveExecTodosNotAfterCommits:  deserializeInstruction[*RemoveExecTodosNotAfterCommitsInstruction],
+		DaemonKindAskpass:                         deserializeInstruction[*AskpassInstruction],
 	}
 
 	return mapping[getDaemonKind()](jsonData)
@@ -364,3 +368,29 @@ func (self *WriteRebaseTodoInstruction) run(common *common.Common) error {
 		return os.WriteFile(path, self.TodosFileContent, 0o644)
 	})
 }
+
+// Used together with `git rebase --exec` to run the exec command only after
+// the given commits rather than after every commit being rebased
+type RemoveExecTodosNotAfterCommitsInstruction struct {
+	Hashes []string
+}
+
+func NewRemoveExecTodosNotAfterCommitsInstruction(hashes []string) Instruction {
+	return &RemoveExecTodosNotAfterCommitsInstruction{
+		Hashes: hashes,
+	}
+}
+
+func (self *RemoveExecTodosNotAfterCommitsInstruction) Kind() DaemonKind {
+	return DaemonKindRemoveExecTodosNotAfterCommits
+}
+
+func (self *RemoveExecTodosNotAfterCommitsInstruction) SerializedInstructions() string {
+	return serializeInstruction(self)
+}
+
+func (self *RemoveExecTodosNotAfterCommitsInstruction) run(common *common.Common) error {
+	return handleInteractiveRebase(common, func(path string) error {
+		return utils.RemoveExecTodosNotAfterCommits(path, self.Hashes, getCommentChar())
+	})
+}
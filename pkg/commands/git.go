@@ -17,6 +17,7 @@ import (
 
 // GitCommand is our main git interface
 type GitCommand struct {
+	Activity    *git_commands.ActivityCommands
 	Blame       *git_commands.BlameCommands
 	Branch      *git_commands.BranchCommands
 	Commit      *git_commands.CommitCommands
@@ -25,8 +26,11 @@ type GitCommand struct {
 	Diff        *git_commands.DiffCommands
 	File        *git_commands.FileCommands
 	Flow        *git_commands.FlowCommands
+	Health      *git_commands.HealthCommands
 	Patch       *git_commands.PatchCommands
 	Rebase      *git_commands.RebaseCommands
+	Rerere      *git_commands.RerereCommands
+	Snapshot    *git_commands.SnapshotCommands
 	Remote      *git_commands.RemoteCommands
 	Stash       *git_commands.StashCommands
 	Status      *git_commands.StatusCommands
@@ -133,6 +137,10 @@ func NewGitCommandAux(
 	bisectCommands := git_commands.NewBisectCommands(gitCommon)
 	worktreeCommands := git_commands.NewWorktreeCommands(gitCommon)
 	blameCommands := git_commands.NewBlameCommands(gitCommon)
+	healthCommands := git_commands.NewHealthCommands(gitCommon)
+	rerereCommands := git_commands.NewRerereCommands(gitCommon)
+	snapshotCommands := git_commands.NewSnapshotCommands(gitCommon)
+	activityCommands := git_commands.NewActivityCommands(gitCommon)
 
 	branchLoader := git_commands.NewBranchLoader(cmn, gitCommon, cmd, branchCommands.CurrentBranchInfo, configCommands)
 	commitFileLoader := git_commands.NewCommitFileLoader(cmn, cmd)
@@ -144,6 +152,7 @@ func NewGitCommandAux(
 	tagLoader := git_commands.NewTagLoader(cmn, cmd)
 
 	return &GitCommand{
+		Activity:    activityCommands,
 		Blame:       blameCommands,
 		Branch:      branchCommands,
 		Commit:      commitCommands,
@@ -152,8 +161,11 @@ func NewGitCommandAux(
 		Diff:        diffCommands,
 		File:        fileCommands,
 		Flow:        flowCommands,
+		Health:      healthCommands,
 		Patch:       patchCommands,
 		Rebase:      rebaseCommands,
+		Rerere:      rerereCommands,
+		Snapshot:    snapshotCommands,
 		Remote:      remoteCommands,
 		Stash:       stashCommands,
 		Status:      statusCommands,
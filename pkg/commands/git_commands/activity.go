@@ -0,0 +1,128 @@
+package git_commands
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+type ActivityCommands struct {
+	*GitCommon
+}
+
+func NewActivityCommands(gitCommon *GitCommon) *ActivityCommands {
+	return &ActivityCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// A commit that the user authored during the period we're looking at
+type ActivityCommit struct {
+	ShortHash string
+	Date      time.Time
+	// The local branch that the commit was found on, e.g. "feature"
+	Branch  string
+	Subject string
+}
+
+// An entry of HEAD's reflog, e.g. a checkout or a rebase
+type ActivityReflogEntry struct {
+	ShortHash string
+	Date      time.Time
+	// The reflog message, e.g. "checkout: moving from master to feature"
+	Action string
+}
+
+// Returns the commits on any local branch that were authored by the current
+// user (going by user.email) since the given time, newest first
+func (self *ActivityCommands) GetOwnCommitsSince(since time.Time) ([]ActivityCommit, error) {
+	email := self.config.GetUserEmail()
+	if email == "" {
+		return nil, errors.New("user.email is not set in the git config")
+	}
+
+	cmdArgs := NewGitCmd("log").
+		Arg("--branches", "--source").
+		Arg("--author=" + email).
+		Arg(fmt.Sprintf("--since=@%d", since.Unix())).
+		Arg("--format=%h%x00%at%x00%S%x00%s").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	commits := []ActivityCommit{}
+	for _, line := range utils.SplitLines(output) {
+		fields := strings.SplitN(line, "\x00", 4)
+		if len(fields) < 4 {
+			continue
+		}
+
+		seconds, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		commits = append(commits, ActivityCommit{
+			ShortHash: fields[0],
+			Date:      time.Unix(seconds, 0),
+			Branch:    strings.TrimPrefix(fields[2], "refs/heads/"),
+			Subject:   fields[3],
+		})
+	}
+
+	return commits, nil
+}
+
+// Returns the entries of HEAD's reflog since the given time, newest first
+func (self *ActivityCommands) GetReflogEntriesSince(since time.Time) ([]ActivityReflogEntry, error) {
+	// With --date=unix, the reflog selector contains the time of the entry,
+	// e.g. HEAD@{1700000000}. We can't use --since because it filters by
+	// commit date rather than by the date of the reflog entry.
+	cmdArgs := NewGitCmd("log").
+		Arg("-g", "--date=unix", "--format=%gd%x00%h%x00%gs").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []ActivityReflogEntry{}
+	for _, line := range utils.SplitLines(output) {
+		fields := strings.SplitN(line, "\x00", 3)
+		if len(fields) < 3 {
+			continue
+		}
+
+		selector := fields[0]
+		start := strings.LastIndex(selector, "{")
+		if start == -1 {
+			continue
+		}
+		seconds, err := strconv.ParseInt(strings.TrimSuffix(selector[start+1:], "}"), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		date := time.Unix(seconds, 0)
+		if date.Before(since) {
+			// The reflog is ordered newest first
+			break
+		}
+
+		entries = append(entries, ActivityReflogEntry{
+			ShortHash: fields[1],
+			Date:      date,
+			Action:    fields[2],
+		})
+	}
+
+	return entries, nil
+}
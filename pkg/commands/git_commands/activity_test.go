@@ -0,0 +1,58 @@
+package git_commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivityGetOwnCommitsSince(t *testing.T) {
+	since := time.Unix(1700000000, 0)
+	output := "abc1234\x001700003600\x00refs/heads/feature\x00Add foo\n" +
+		"def5678\x001700001800\x00refs/heads/master\x00Fix bar\n"
+
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"log", "--branches", "--source", "--author=jane@example.com", "--since=@1700000000", "--format=%h%x00%at%x00%S%x00%s"}, output, nil)
+	instance := NewActivityCommands(buildGitCommon(commonDeps{
+		runner:    runner,
+		gitConfig: git_config.NewFakeGitConfig(map[string]string{"user.email": "jane@example.com"}),
+	}))
+
+	commits, err := instance.GetOwnCommitsSince(since)
+	assert.NoError(t, err)
+	assert.Equal(t, []ActivityCommit{
+		{ShortHash: "abc1234", Date: time.Unix(1700003600, 0), Branch: "feature", Subject: "Add foo"},
+		{ShortHash: "def5678", Date: time.Unix(1700001800, 0), Branch: "master", Subject: "Fix bar"},
+	}, commits)
+	runner.CheckForMissingCalls()
+}
+
+func TestActivityGetOwnCommitsSinceWithoutEmail(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t)
+	instance := NewActivityCommands(buildGitCommon(commonDeps{runner: runner}))
+
+	_, err := instance.GetOwnCommitsSince(time.Unix(1700000000, 0))
+	assert.Error(t, err)
+	runner.CheckForMissingCalls()
+}
+
+func TestActivityGetReflogEntriesSince(t *testing.T) {
+	output := "HEAD@{1700003600}\x00abc1234\x00checkout: moving from master to feature\n" +
+		"HEAD@{1700001800}\x00def5678\x00commit: Fix bar\n" +
+		"HEAD@{1690000000}\x00aaa1111\x00commit: Old work\n"
+
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"log", "-g", "--date=unix", "--format=%gd%x00%h%x00%gs"}, output, nil)
+	instance := NewActivityCommands(buildGitCommon(commonDeps{runner: runner}))
+
+	entries, err := instance.GetReflogEntriesSince(time.Unix(1700000000, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, []ActivityReflogEntry{
+		{ShortHash: "abc1234", Date: time.Unix(1700003600, 0), Action: "checkout: moving from master to feature"},
+		{ShortHash: "def5678", Date: time.Unix(1700001800, 0), Action: "commit: Fix bar"},
+	}, entries)
+	runner.CheckForMissingCalls()
+}
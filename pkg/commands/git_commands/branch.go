@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -116,6 +117,30 @@ func (self *BranchCommands) CurrentBranchName() (string, error) {
 	return strings.TrimSpace(output), nil
 }
 
+// Returns the local and remote branches whose history contains the given commit
+func (self *BranchCommands) BranchesContaining(hash string) ([]string, []string, error) {
+	cmdArgs := NewGitCmd("branch").
+		Arg("--all", "--contains", hash, "--format=%(refname)").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	localBranches := []string{}
+	remoteBranches := []string{}
+	for _, ref := range strings.Split(strings.TrimSpace(output), "\n") {
+		if name, ok := strings.CutPrefix(ref, "refs/heads/"); ok {
+			localBranches = append(localBranches, name)
+		} else if name, ok := strings.CutPrefix(ref, "refs/remotes/"); ok && !strings.HasSuffix(name, "/HEAD") {
+			remoteBranches = append(remoteBranches, name)
+		}
+	}
+
+	return localBranches, remoteBranches, nil
+}
+
 // LocalDelete delete branch locally
 func (self *BranchCommands) LocalDelete(branches []string, force bool) error {
 	cmdArgs := NewGitCmd("branch").
@@ -236,24 +261,83 @@ func (self *BranchCommands) Rename(oldName string, newName string) error {
 
 type MergeOpts struct {
 	FastForwardOnly bool
+	NoFastForward   bool
 	Squash          bool
+	// Passed to git as --strategy-option, e.g. "ours" or "theirs"
+	StrategyOption string
+	// If not empty, used as the message of the merge commit
+	Message string
 }
 
 func (self *BranchCommands) Merge(branchName string, opts MergeOpts) error {
-	if opts.Squash && opts.FastForwardOnly {
-		panic("Squash and FastForwardOnly can't both be true")
+	if opts.Squash && (opts.FastForwardOnly || opts.NoFastForward) {
+		panic("Squash can't be combined with FastForwardOnly or NoFastForward")
+	}
+	if opts.FastForwardOnly && opts.NoFastForward {
+		panic("FastForwardOnly and NoFastForward can't both be true")
 	}
 	cmdArgs := NewGitCmd("merge").
 		Arg("--no-edit").
 		Arg(strings.Fields(self.UserConfig().Git.Merging.Args)...).
 		ArgIf(opts.FastForwardOnly, "--ff-only").
+		ArgIf(opts.NoFastForward, "--no-ff").
 		ArgIf(opts.Squash, "--squash", "--ff").
+		ArgIf(opts.StrategyOption != "", "--strategy-option="+opts.StrategyOption).
+		ArgIf(opts.Message != "", "-m", opts.Message).
 		Arg(branchName).
 		ToArgv()
 
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// OctopusMerge merges several branches into the current branch in a single
+// merge commit
+func (self *BranchCommands) OctopusMerge(branchNames []string) error {
+	cmdArgs := NewGitCmd("merge").
+		Arg("--no-edit").
+		Arg(strings.Fields(self.UserConfig().Git.Merging.Args)...).
+		Arg(branchNames...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+type MergePreview struct {
+	// The tree that merging would produce. Conflicted files contain conflict
+	// markers in this tree.
+	TreeID          string
+	ConflictedFiles []string
+}
+
+// PreviewMerge computes the result of merging the given ref into HEAD, without
+// touching the working tree or the index.
+func (self *BranchCommands) PreviewMerge(refName string) (*MergePreview, error) {
+	if !self.version.IsAtLeast(2, 38, 0) {
+		return nil, errors.New(self.Tr.MergePreviewRequiresNewerGit)
+	}
+
+	cmdArgs := NewGitCmd("merge-tree").
+		Arg("--write-tree", "--name-only", "--no-messages", "-z").
+		Arg("HEAD", refName).
+		ToArgv()
+
+	// git merge-tree exits with status 1 if there are conflicts, so we can
+	// only tell a real error from a conflict by looking at the output
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	fields := lo.WithoutEmpty(strings.Split(output, "\x00"))
+	if err != nil && len(fields) < 2 {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("unexpected git merge-tree output")
+	}
+
+	return &MergePreview{
+		TreeID:          fields[0],
+		ConflictedFiles: fields[1:],
+	}, nil
+}
+
 // Only choose between non-empty, non-identical commands
 func (self *BranchCommands) allBranchesLogCandidates() []string {
 	return lo.Uniq(lo.WithoutEmpty(self.UserConfig().Git.AllBranchesLogCmds))
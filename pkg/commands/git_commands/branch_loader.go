@@ -15,6 +15,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
+	"github.com/sasha-s/go-deadlock"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -46,6 +47,17 @@ type BranchLoader struct {
 	cmd                  oscommands.ICmdObjBuilder
 	getCurrentBranchInfo func() (BranchInfo, error)
 	config               BranchLoaderConfigCommands
+
+	// The divergence of the branches from their base branches, keyed by the
+	// commit hashes of the branch and of the main branches, so that we only ask
+	// git again for the branches that moved, or when a main branch did
+	divergenceCache      map[string]baseBranchDivergence
+	divergenceCacheMutex deadlock.Mutex
+}
+
+type baseBranchDivergence struct {
+	ahead  int32
+	behind int32
 }
 
 func NewBranchLoader(
@@ -86,6 +98,7 @@ func (self *BranchLoader) Load(reflogCommits []*models.Commit,
 				}
 				if strings.EqualFold(reflogBranch.Name, branch.Name) {
 					branch.Recency = reflogBranch.Recency
+					branch.RecencyTimestamp = reflogBranch.RecencyTimestamp
 					branchesWithRecency = append(branchesWithRecency, branch)
 					branches = utils.Remove(branches, j)
 					continue outer
@@ -131,25 +144,26 @@ func (self *BranchLoader) Load(reflogCommits []*models.Commit,
 			branch.UpstreamBranch = match.Merge.Short()
 		}
 
-		// If the branch already existed, take over its BehindBaseBranch value
-		// to reduce flicker
+		// If the branch already existed, take over its divergence from the base
+		// branch to reduce flicker
 		if oldBranch, found := lo.Find(oldBranches, func(b *models.Branch) bool {
 			return b.Name == branch.Name
 		}); found {
 			branch.BehindBaseBranch.Store(oldBranch.BehindBaseBranch.Load())
+			branch.AheadOfBaseBranch.Store(oldBranch.AheadOfBaseBranch.Load())
 		}
 	}
 
 	if loadBehindCounts && self.UserConfig().Gui.ShowDivergenceFromBaseBranch != "none" {
 		onWorker(func() error {
-			return self.GetBehindBaseBranchValuesForAllBranches(branches, mainBranches, renderFunc)
+			return self.GetDivergenceFromBaseBranchForAllBranches(branches, mainBranches, renderFunc)
 		})
 	}
 
 	return branches, nil
 }
 
-func (self *BranchLoader) GetBehindBaseBranchValuesForAllBranches(
+func (self *BranchLoader) GetDivergenceFromBaseBranchForAllBranches(
 	branches []*models.Branch,
 	mainBranches *MainBranches,
 	renderFunc func(),
@@ -162,13 +176,41 @@ func (self *BranchLoader) GetBehindBaseBranchValuesForAllBranches(
 	t := time.Now()
 	errg := errgroup.Group{}
 
+	// If we can't get the hashes of the main branches we can't tell whether
+	// they moved, so we don't use the cache then
+	mainBranchHashes, _ := self.cmd.New(
+		NewGitCmd("rev-parse").Arg(mainBranchRefs...).ToArgv(),
+	).DontLog().RunWithOutput()
+	mainBranchHashes = strings.Join(strings.Fields(mainBranchHashes), " ")
+
+	self.divergenceCacheMutex.Lock()
+	oldCache := self.divergenceCache
+	self.divergenceCacheMutex.Unlock()
+	newCache := map[string]baseBranchDivergence{}
+	var newCacheMutex deadlock.Mutex
+
 	for _, branch := range branches {
 		errg.Go(func() error {
+			cacheKey := ""
+			if branch.CommitHash != "" && mainBranchHashes != "" {
+				cacheKey = branch.CommitHash + " " + mainBranchHashes
+				if divergence, ok := oldCache[cacheKey]; ok {
+					branch.BehindBaseBranch.Store(divergence.behind)
+					branch.AheadOfBaseBranch.Store(divergence.ahead)
+					newCacheMutex.Lock()
+					newCache[cacheKey] = divergence
+					newCacheMutex.Unlock()
+					return nil
+				}
+			}
+
 			baseBranch, err := self.GetBaseBranch(branch, mainBranches)
 			if err != nil {
 				return err
 			}
-			behind := 0 // prime it in case something below fails
+			// prime them in case something below fails
+			ahead := 0
+			behind := 0
 			if baseBranch != "" {
 				output, err := self.cmd.New(
 					NewGitCmd("rev-list").
@@ -183,18 +225,32 @@ func (self *BranchLoader) GetBehindBaseBranchValuesForAllBranches(
 				// The format of the output is "<ahead>\t<behind>"
 				aheadBehindStr := strings.Split(strings.TrimSpace(output), "\t")
 				if len(aheadBehindStr) == 2 {
+					if value, err := strconv.Atoi(aheadBehindStr[0]); err == nil {
+						ahead = value
+					}
 					if value, err := strconv.Atoi(aheadBehindStr[1]); err == nil {
 						behind = value
 					}
 				}
 			}
 			branch.BehindBaseBranch.Store(int32(behind))
+			branch.AheadOfBaseBranch.Store(int32(ahead))
+			if cacheKey != "" {
+				newCacheMutex.Lock()
+				newCache[cacheKey] = baseBranchDivergence{ahead: int32(ahead), behind: int32(behind)}
+				newCacheMutex.Unlock()
+			}
 			return nil
 		})
 	}
 
 	err := errg.Wait()
-	self.Log.Debugf("time to get behind base branch values for all branches: %s", time.Since(t))
+	// Only keep the branches that still exist, so that the cache doesn't grow
+	// forever
+	self.divergenceCacheMutex.Lock()
+	self.divergenceCache = newCache
+	self.divergenceCacheMutex.Unlock()
+	self.Log.Debugf("time to get divergence from base branch for all branches: %s", time.Since(t))
 	renderFunc()
 	return err
 }
@@ -313,23 +369,26 @@ func obtainBranch(split []string, storeCommitDateAsRecency bool) *models.Branch
 	aheadForPush, behindForPush, _ := parseUpstreamInfo(upstreamName, pushTrack)
 
 	recency := ""
+	var recencyTimestamp int64
 	if storeCommitDateAsRecency {
 		if unixTimestamp, err := strconv.ParseInt(commitDate, 10, 64); err == nil {
 			recency = utils.UnixToTimeAgo(unixTimestamp)
+			recencyTimestamp = unixTimestamp
 		}
 	}
 
 	return &models.Branch{
-		Name:          name,
-		Recency:       recency,
-		AheadForPull:  aheadForPull,
-		BehindForPull: behindForPull,
-		AheadForPush:  aheadForPush,
-		BehindForPush: behindForPush,
-		UpstreamGone:  gone,
-		Head:          headMarker == "*",
-		Subject:       subject,
-		CommitHash:    commitHash,
+		Name:             name,
+		Recency:          recency,
+		RecencyTimestamp: recencyTimestamp,
+		AheadForPull:     aheadForPull,
+		BehindForPull:    behindForPull,
+		AheadForPush:     aheadForPush,
+		BehindForPush:    behindForPush,
+		UpstreamGone:     gone,
+		Head:             headMarker == "*",
+		Subject:          subject,
+		CommitHash:       commitHash,
 	}
 }
 
@@ -378,8 +437,9 @@ func (self *BranchLoader) obtainReflogBranches(reflogCommits []*models.Commit) [
 			if !foundBranches.Includes(branchName) {
 				foundBranches.Add(branchName)
 				reflogBranches = append(reflogBranches, &models.Branch{
-					Recency: recency,
-					Name:    branchName,
+					Recency:          recency,
+					RecencyTimestamp: commit.UnixTimestamp,
+					Name:             branchName,
 				})
 			}
 		}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -104,15 +106,16 @@ func TestObtainBranch(t *testing.T) {
 			input:                    []string{"", "a_branch", "", "", "", "subject", "123", timeStamp},
 			storeCommitDateAsRecency: true,
 			expectedBranch: &models.Branch{
-				Name:          "a_branch",
-				Recency:       "2h",
-				AheadForPull:  "?",
-				BehindForPull: "?",
-				AheadForPush:  "?",
-				BehindForPush: "?",
-				Head:          false,
-				Subject:       "subject",
-				CommitHash:    "123",
+				Name:             "a_branch",
+				Recency:          "2h",
+				RecencyTimestamp: now - 2.5*60*60,
+				AheadForPull:     "?",
+				BehindForPull:    "?",
+				AheadForPush:     "?",
+				BehindForPush:    "?",
+				Head:             false,
+				Subject:          "subject",
+				CommitHash:       "123",
 			},
 		},
 	}
@@ -124,3 +127,54 @@ func TestObtainBranch(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDivergenceFromBaseBranchForAllBranches(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t)
+	cmd := oscommands.NewDummyCmdObjBuilder(runner)
+	cmn := common.NewDummyCommon()
+	loader := NewBranchLoader(cmn, nil, cmd, nil, nil)
+	mainBranches := &MainBranches{
+		c:                    cmn,
+		cmd:                  cmd,
+		existingMainBranches: []string{"refs/heads/master"},
+		previousMainBranches: cmn.UserConfig().Git.MainBranches,
+	}
+
+	expectDivergenceCalls := func(output string) {
+		runner.
+			ExpectGitArgs([]string{"merge-base", "refs/heads/feature", "refs/heads/master"}, "base\n", nil).
+			ExpectGitArgs([]string{"for-each-ref", "--contains", "base", "--format=%(refname)", "refs/heads/master"}, "refs/heads/master\n", nil).
+			ExpectGitArgs([]string{"rev-list", "--left-right", "--count", "refs/heads/feature...refs/heads/master"}, output, nil)
+	}
+
+	feature := &models.Branch{Name: "feature", CommitHash: "1111"}
+	runner.ExpectGitArgs([]string{"rev-parse", "refs/heads/master"}, "aaaa\n", nil)
+	expectDivergenceCalls("2\t1\n")
+	assert.NoError(t, loader.GetDivergenceFromBaseBranchForAllBranches([]*models.Branch{feature}, mainBranches, func() {}))
+	assert.EqualValues(t, 2, feature.AheadOfBaseBranch.Load())
+	assert.EqualValues(t, 1, feature.BehindBaseBranch.Load())
+
+	// Nothing moved, so the cached values are used
+	feature = &models.Branch{Name: "feature", CommitHash: "1111"}
+	runner.ExpectGitArgs([]string{"rev-parse", "refs/heads/master"}, "aaaa\n", nil)
+	assert.NoError(t, loader.GetDivergenceFromBaseBranchForAllBranches([]*models.Branch{feature}, mainBranches, func() {}))
+	assert.EqualValues(t, 2, feature.AheadOfBaseBranch.Load())
+	assert.EqualValues(t, 1, feature.BehindBaseBranch.Load())
+
+	// The main branch moved
+	runner.ExpectGitArgs([]string{"rev-parse", "refs/heads/master"}, "bbbb\n", nil)
+	expectDivergenceCalls("2\t3\n")
+	assert.NoError(t, loader.GetDivergenceFromBaseBranchForAllBranches([]*models.Branch{feature}, mainBranches, func() {}))
+	assert.EqualValues(t, 2, feature.AheadOfBaseBranch.Load())
+	assert.EqualValues(t, 3, feature.BehindBaseBranch.Load())
+
+	// The branch moved
+	feature = &models.Branch{Name: "feature", CommitHash: "2222"}
+	runner.ExpectGitArgs([]string{"rev-parse", "refs/heads/master"}, "bbbb\n", nil)
+	expectDivergenceCalls("3\t3\n")
+	assert.NoError(t, loader.GetDivergenceFromBaseBranchForAllBranches([]*models.Branch{feature}, mainBranches, func() {}))
+	assert.EqualValues(t, 3, feature.AheadOfBaseBranch.Load())
+	assert.EqualValues(t, 3, feature.BehindBaseBranch.Load())
+
+	runner.CheckForMissingCalls()
+}
@@ -60,6 +60,19 @@ func TestBranchNewBranch(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestBranchBranchesContaining(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"branch", "--all", "--contains", "abc", "--format=%(refname)"},
+			"refs/heads/master\nrefs/heads/feature\nrefs/remotes/origin/HEAD\nrefs/remotes/origin/master\n", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	localBranches, remoteBranches, err := instance.BranchesContaining("abc")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"master", "feature"}, localBranches)
+	assert.Equal(t, []string{"origin/master"}, remoteBranches)
+	runner.CheckForMissingCalls()
+}
+
 func TestBranchDeleteBranch(t *testing.T) {
 	type scenario struct {
 		testName    string
@@ -118,6 +131,79 @@ func TestBranchDeleteBranch(t *testing.T) {
 	}
 }
 
+func TestBranchOctopusMerge(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"merge", "--no-edit", "--merging-args", "branch1", "branch2"}, "", nil)
+	userConfig := &config.UserConfig{
+		Git: config.GitConfig{
+			Merging: config.MergingConfig{
+				Args: "--merging-args",
+			},
+		},
+	}
+	instance := buildBranchCommands(commonDeps{runner: runner, userConfig: userConfig})
+
+	assert.NoError(t, instance.OctopusMerge([]string{"branch1", "branch2"}))
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchPreviewMerge(t *testing.T) {
+	type scenario struct {
+		testName        string
+		gitVersion      *GitVersion
+		runner          *oscommands.FakeCmdObjRunner
+		expectedPreview *MergePreview
+		expectedError   string
+	}
+
+	mergeTreeArgs := []string{"merge-tree", "--write-tree", "--name-only", "--no-messages", "-z", "HEAD", "mybranch"}
+
+	scenarios := []scenario{
+		{
+			testName:   "merge without conflicts",
+			gitVersion: &GitVersion{2, 38, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(mergeTreeArgs, "1234abcd\x00", nil),
+			expectedPreview: &MergePreview{TreeID: "1234abcd", ConflictedFiles: []string{}},
+		},
+		{
+			testName:   "merge with conflicts",
+			gitVersion: &GitVersion{2, 38, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(mergeTreeArgs, "1234abcd\x00file1\x00dir/file2\x00", errors.New("exit status 1")),
+			expectedPreview: &MergePreview{TreeID: "1234abcd", ConflictedFiles: []string{"file1", "dir/file2"}},
+		},
+		{
+			testName:   "error",
+			gitVersion: &GitVersion{2, 38, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs(mergeTreeArgs, "", errors.New("not something we can merge")),
+			expectedError: "not something we can merge",
+		},
+		{
+			testName:      "git version too old",
+			gitVersion:    &GitVersion{2, 37, 0, ""},
+			runner:        oscommands.NewFakeRunner(t),
+			expectedError: "Previewing a merge requires git 2.38 or later",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildBranchCommands(commonDeps{runner: s.runner, gitVersion: s.gitVersion})
+
+			preview, err := instance.PreviewMerge("mybranch")
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, s.expectedPreview, preview)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestBranchMerge(t *testing.T) {
 	scenarios := []struct {
 		testName   string
@@ -166,6 +252,27 @@ func TestBranchMerge(t *testing.T) {
 			branchName: "mybranch",
 			expected:   []string{"merge", "--no-edit", "--ff-only", "mybranch"},
 		},
+		{
+			testName:   "no fast forward",
+			userConfig: &config.UserConfig{},
+			opts:       MergeOpts{NoFastForward: true},
+			branchName: "mybranch",
+			expected:   []string{"merge", "--no-edit", "--no-ff", "mybranch"},
+		},
+		{
+			testName:   "strategy option and message",
+			userConfig: &config.UserConfig{},
+			opts:       MergeOpts{StrategyOption: "theirs", Message: "my merge"},
+			branchName: "mybranch",
+			expected:   []string{"merge", "--no-edit", "--strategy-option=theirs", "-m", "my merge", "mybranch"},
+		},
+		{
+			testName:   "squash with strategy option",
+			userConfig: &config.UserConfig{},
+			opts:       MergeOpts{Squash: true, StrategyOption: "ours"},
+			branchName: "mybranch",
+			expected:   []string{"merge", "--no-edit", "--squash", "--ff", "--strategy-option=ours", "mybranch"},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -2,10 +2,14 @@ package git_commands
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 var ErrInvalidCommitIndex = errors.New("invalid commit index")
@@ -38,6 +42,15 @@ func (self *CommitCommands) SetAuthor(value string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// Sets the commit's author date to the current time, keeping the author itself
+func (self *CommitCommands) ResetAuthorDate() error {
+	cmdArgs := NewGitCmd("commit").
+		Arg("--allow-empty", "--allow-empty-message", "--only", "--no-edit", "--amend", "--date=now").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 // Add a commit's coauthor using Github/Gitlab Co-authored-by metadata. Value is expected to be of the form 'Name <Email>'
 func (self *CommitCommands) AddCoAuthor(hash string, author string) error {
 	message, err := self.GetCommitMessage(hash)
@@ -173,6 +186,128 @@ func (self *CommitCommands) GetCommitSubject(commitHash string) (string, error)
 	return strings.TrimSpace(subject), err
 }
 
+// Returns the metadata of the given commit, including the local branches and
+// tags that contain it. Finding those requires walking the history, so this is
+// more expensive than it looks.
+func (self *CommitCommands) GetCommitDetails(commitHash string) (*models.CommitDetails, error) {
+	cmdArgs := NewGitCmd("log").
+		Arg("--max-count=1",
+			"--format=%H%x00%an%x00%ae%x00%at%x00%cn%x00%ce%x00%ct%x00%P%x00%B%x00%(trailers:only)%x00%(trailers:only,unfold)",
+			commitHash).
+		Config("log.showsignature=false").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Split(strings.ReplaceAll(output, "\r\n", "\n"), "\x00")
+	if len(fields) != 11 {
+		return nil, errors.Errorf("unexpected output of git log: %q", output)
+	}
+
+	message := strings.TrimSpace(fields[8])
+	rawTrailers := strings.TrimSpace(fields[9])
+	if rawTrailers != "" {
+		message = strings.TrimSpace(strings.TrimSuffix(message, rawTrailers))
+	}
+
+	details := &models.CommitDetails{
+		Hash:               fields[0],
+		AuthorName:         fields[1],
+		AuthorEmail:        fields[2],
+		AuthorTimestamp:    int64(utils.MustConvertToInt(fields[3])),
+		CommitterName:      fields[4],
+		CommitterEmail:     fields[5],
+		CommitterTimestamp: int64(utils.MustConvertToInt(fields[6])),
+		Parents:            strings.Fields(fields[7]),
+		Message:            message,
+		Trailers:           parseTrailers(fields[10]),
+	}
+
+	refsCmdArgs := NewGitCmd("for-each-ref").
+		Arg("--contains", commitHash, "--format=%(refname)", "refs/heads", "refs/tags").
+		ToArgv()
+
+	refsOutput, err := self.cmd.New(refsCmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range strings.Split(strings.TrimSpace(refsOutput), "\n") {
+		if branch, ok := strings.CutPrefix(ref, "refs/heads/"); ok {
+			details.Branches = append(details.Branches, branch)
+		} else if tag, ok := strings.CutPrefix(ref, "refs/tags/"); ok {
+			details.Tags = append(details.Tags, tag)
+		}
+	}
+
+	return details, nil
+}
+
+func parseTrailers(output string) []models.CommitTrailer {
+	trailers := []models.CommitTrailer{}
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		trailers = append(trailers, models.CommitTrailer{Key: strings.TrimSpace(key), Value: strings.TrimSpace(value)})
+	}
+	return trailers
+}
+
+// Returns the full hash of the commit that the given hash, branch, tag or other
+// revision points to.
+func (self *CommitCommands) ResolveCommitHash(ref string) (string, error) {
+	cmdArgs := NewGitCmd("rev-parse").
+		Arg("--verify", "--quiet", ref+"^{commit}").
+		ToArgv()
+
+	hash, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	return strings.TrimSpace(hash), err
+}
+
+var shortStatRegex = regexp.MustCompile(`(\d+) files? changed(?:, (\d+) insertions?\(\+\))?(?:, (\d+) deletions?\(-\))?`)
+
+// Returns the shortstat numbers of the given commits, keyed by hash. Commits
+// without changes (e.g. merge commits) get zero stats.
+func (self *CommitCommands) GetCommitsStats(hashes []string) (map[string]*models.CommitStats, error) {
+	cmdArgs := NewGitCmd("log").
+		Arg("--no-walk=unsorted", "--shortstat", "--format=%H").
+		Arg(hashes...).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*models.CommitStats, len(hashes))
+	var current *models.CommitStats
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if match := shortStatRegex.FindStringSubmatch(line); match != nil {
+			if current != nil {
+				current.FilesChanged, _ = strconv.Atoi(match[1])
+				current.Insertions, _ = strconv.Atoi(match[2])
+				current.Deletions, _ = strconv.Atoi(match[3])
+			}
+			continue
+		}
+
+		current = &models.CommitStats{}
+		result[line] = current
+	}
+
+	return result, nil
+}
+
 func (self *CommitCommands) GetCommitDiff(commitHash string) (string, error) {
 	cmdArgs := NewGitCmd("show").Arg("--no-color", commitHash).ToArgv()
 
@@ -204,6 +339,41 @@ func (self *CommitCommands) GetCommitAuthor(commitHash string) (Author, error) {
 	return author, err
 }
 
+type CommitSummary struct {
+	ShortHash string
+	Author    string
+	Subject   string
+}
+
+// Returns the non-merge commits reachable from `to` but not from `from`,
+// newest first. If `from` is empty, all ancestors of `to` are returned.
+func (self *CommitCommands) GetCommitSummariesInRange(from string, to string) ([]CommitSummary, error) {
+	revisionRange := to
+	if from != "" {
+		revisionRange = from + ".." + to
+	}
+
+	cmdArgs := NewGitCmd("log").
+		Arg("--no-merges", "--format=%h%x00%an%x00%s", revisionRange, "--").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := []CommitSummary{}
+	for _, line := range utils.SplitLines(output) {
+		split := strings.SplitN(line, "\x00", 3)
+		if len(split) < 3 {
+			continue
+		}
+		summaries = append(summaries, CommitSummary{ShortHash: split[0], Author: split[1], Subject: split[2]})
+	}
+
+	return summaries, nil
+}
+
 func (self *CommitCommands) GetCommitMessageFirstLine(hash string) (string, error) {
 	return self.GetCommitMessagesFirstLine([]string{hash})
 }
@@ -253,12 +423,14 @@ func (self *CommitCommands) AmendHeadCmdObj() *oscommands.CmdObj {
 	return self.cmd.New(cmdArgs)
 }
 
-func (self *CommitCommands) ShowCmdObj(hash string, filterPaths []string) *oscommands.CmdObj {
+// If the pickaxe is active, only the files whose diff matches it are shown.
+func (self *CommitCommands) ShowCmdObj(hash string, filterPaths []string, pickaxe Pickaxe) *oscommands.CmdObj {
 	contextSize := self.UserConfig().Git.DiffContextSize
 
 	extDiffCmd := self.UserConfig().Git.Paging.ExternalDiffCommand
 	cmdArgs := NewGitCmd("show").
 		Config("diff.noprefix=false").
+		Config(diffColorConfigs(self.UserConfig())...).
 		ConfigIf(extDiffCmd != "", "diff.external="+extDiffCmd).
 		ArgIfElse(extDiffCmd != "", "--ext-diff", "--no-ext-diff").
 		Arg("--submodule").
@@ -266,9 +438,12 @@ func (self *CommitCommands) ShowCmdObj(hash string, filterPaths []string) *oscom
 		Arg(fmt.Sprintf("--unified=%d", contextSize)).
 		Arg("--stat").
 		Arg("--decorate").
+		// lazygit renders its own header in this case
+		ArgIf(self.UserConfig().Gui.ShowCommitDetailsHeader, "--format=").
 		Arg("-p").
 		Arg(hash).
-		ArgIf(self.UserConfig().Git.IgnoreWhitespaceInDiffView, "--ignore-all-space").
+		ArgIf(pickaxe.Active(), pickaxe.Arg()).
+		Arg(diffOptionArgs(self.UserConfig(), false)...).
 		Arg(fmt.Sprintf("--find-renames=%d%%", self.UserConfig().Git.RenameSimilarityThreshold)).
 		Arg("--").
 		Arg(filterPaths...).
@@ -40,7 +40,42 @@ func (self *CommitFileLoader) GetFilesInDiff(from string, to string, reverse boo
 		return nil, err
 	}
 
-	return getCommitFilesFromFilenames(filenames), nil
+	files := getCommitFilesFromFilenames(filenames)
+
+	if self.UserConfig().Gui.ShowNumstatInCommitFilesView {
+		fileDiffs, err := self.getFileDiffs(from, to, reverse)
+		if err != nil {
+			self.Log.Error(err)
+		}
+		for _, file := range files {
+			if diff, ok := fileDiffs[file.Path]; ok {
+				file.LinesAdded = diff.LinesAdded
+				file.LinesDeleted = diff.LinesDeleted
+			}
+		}
+	}
+
+	return files, nil
+}
+
+func (self *CommitFileLoader) getFileDiffs(from string, to string, reverse bool) (map[string]FileDiff, error) {
+	cmdArgs := NewGitCmd("diff").
+		Arg("--submodule").
+		Arg("--no-ext-diff").
+		Arg("--numstat").
+		Arg("-z").
+		Arg("--no-renames").
+		ArgIf(reverse, "-R").
+		Arg(from).
+		Arg(to).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseNumstat(output), nil
 }
 
 // filenames string is something like "MM\x00file1\x00MU\x00file2\x00AA\x00file3\x00"
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -69,3 +72,48 @@ func TestGetCommitFilesFromFilenames(t *testing.T) {
 		})
 	}
 }
+
+func TestCommitFileLoaderGetFilesInDiff(t *testing.T) {
+	scenarios := []struct {
+		testName    string
+		showNumstat bool
+		runner      *oscommands.FakeCmdObjRunner
+		expected    []*models.CommitFile
+	}{
+		{
+			testName:    "without numstat",
+			showNumstat: false,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-c", "diff.noprefix=false", "diff", "--submodule", "--no-ext-diff", "--name-status", "-z", "--no-renames", "abc^", "abc"}, "M\x00file1\x00A\x00file2\x00", nil),
+			expected: []*models.CommitFile{
+				{Path: "file1", ChangeStatus: "M"},
+				{Path: "file2", ChangeStatus: "A"},
+			},
+		},
+		{
+			testName:    "with numstat",
+			showNumstat: true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-c", "diff.noprefix=false", "diff", "--submodule", "--no-ext-diff", "--name-status", "-z", "--no-renames", "abc^", "abc"}, "M\x00file1\x00A\x00file2\x00A\x00image.png\x00", nil).
+				ExpectGitArgs([]string{"diff", "--submodule", "--no-ext-diff", "--numstat", "-z", "--no-renames", "abc^", "abc"}, "3\t1\tfile1\x005\t0\tfile2\x00-\t-\timage.png\x00", nil),
+			expected: []*models.CommitFile{
+				{Path: "file1", ChangeStatus: "M", LinesAdded: 3, LinesDeleted: 1},
+				{Path: "file2", ChangeStatus: "A", LinesAdded: 5},
+				{Path: "image.png", ChangeStatus: "A"},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.Gui.ShowNumstatInCommitFilesView = s.showNumstat
+			loader := NewCommitFileLoader(common.NewDummyCommonWithUserConfigAndAppState(userConfig, nil), oscommands.NewDummyCmdObjBuilder(s.runner))
+
+			files, err := loader.GetFilesInDiff("abc^", "abc", false)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, files)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -59,6 +59,11 @@ type GetCommitsOptions struct {
 	Limit                bool
 	FilterPath           string
 	FilterAuthor         string
+	FilterSince          string
+	FilterUntil          string
+	FilterGrep           string
+	FilterNoMerges       bool
+	Pickaxe              Pickaxe
 	IncludeRebaseCommits bool
 	RefName              string     // e.g. "HEAD" or "my_branch"
 	RefForPushedStatus   models.Ref // the ref to use for determining pushed/unpushed status
@@ -366,7 +371,7 @@ func (self *CommitLoader) getRebasingCommits(hashPool *utils.StringPool, addConf
 			t.Msg = t.Ref
 		} else if t.Command == todo.Exec {
 			t.Msg = t.ExecCommand
-		} else if t.Commit == "" {
+		} else if t.Commit == "" && t.Command != todo.Break {
 			// Command does not have a commit associated, skip
 			continue
 		}
@@ -587,11 +592,17 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) *oscommands.CmdObj {
 	cmdArgs := NewGitCmd("log").
 		Arg(refSpec).
 		ArgIf(gitLogOrder != "default", "--"+gitLogOrder).
-		ArgIf(opts.All, "--all").
+		// Hide the snapshots that we keep under refs/lazygit
+		ArgIf(opts.All, "--exclude=refs/lazygit/*", "--all").
 		Arg("--oneline").
 		Arg(prettyFormat).
 		Arg("--abbrev=40").
 		ArgIf(opts.FilterAuthor != "", "--author="+opts.FilterAuthor).
+		ArgIf(opts.FilterSince != "", "--since="+opts.FilterSince).
+		ArgIf(opts.FilterUntil != "", "--until="+opts.FilterUntil).
+		ArgIf(opts.FilterGrep != "", "--grep="+opts.FilterGrep).
+		ArgIf(opts.FilterNoMerges, "--no-merges").
+		ArgIf(opts.Pickaxe.Active(), opts.Pickaxe.Arg()).
 		ArgIf(opts.Limit, "-300").
 		ArgIf(opts.FilterPath != "", "--follow", "--name-status").
 		Arg("--no-show-signature").
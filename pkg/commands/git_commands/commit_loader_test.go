@@ -294,6 +294,41 @@ func TestGetCommits(t *testing.T) {
 			expectedCommitOpts: []models.NewCommitOpts{},
 			expectedError:      nil,
 		},
+		{
+			testName: "should combine commit filters with the filter path",
+			logOrder: "default",
+			opts: GetCommitsOptions{
+				RefName:            "HEAD",
+				RefForPushedStatus: &models.Branch{Name: "mybranch"},
+				FilterPath:         "src",
+				FilterAuthor:       "Jesse",
+				FilterSince:        "2 weeks ago",
+				FilterUntil:        "2024-01-01",
+				FilterGrep:         "fix",
+				FilterNoMerges:     true,
+			},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-list", "refs/heads/mybranch", "^mybranch@{u}"}, "", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s", "--abbrev=40", "--author=Jesse", "--since=2 weeks ago", "--until=2024-01-01", "--grep=fix", "--no-merges", "--follow", "--name-status", "--no-show-signature", "--", "src"}, "", nil),
+
+			expectedCommitOpts: []models.NewCommitOpts{},
+			expectedError:      nil,
+		},
+		{
+			testName: "should pass the pickaxe regex",
+			logOrder: "default",
+			opts: GetCommitsOptions{
+				RefName:            "HEAD",
+				RefForPushedStatus: &models.Branch{Name: "mybranch"},
+				Pickaxe:            Pickaxe{Text: "func \\w+Loader", Regex: true},
+			},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-list", "refs/heads/mybranch", "^mybranch@{u}"}, "", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:+%H%x00%at%x00%aN%x00%ae%x00%P%x00%m%x00%D%x00%s", "--abbrev=40", "-Gfunc \\w+Loader", "--no-show-signature", "--"}, "", nil),
+
+			expectedCommitOpts: []models.NewCommitOpts{},
+			expectedError:      nil,
+		},
 	}
 
 	for _, scenario := range scenarios {
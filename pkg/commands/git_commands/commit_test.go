@@ -1,8 +1,10 @@
 package git_commands
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/stretchr/testify/assert"
@@ -252,9 +254,11 @@ func TestCommitShowCmdObj(t *testing.T) {
 	type scenario struct {
 		testName            string
 		filterPaths         []string
+		pickaxe             Pickaxe
 		contextSize         uint64
 		similarityThreshold int
 		ignoreWhitespace    bool
+		colorMoved          string
 		extDiffCmd          string
 		expected            []string
 	}
@@ -305,6 +309,26 @@ func TestCommitShowCmdObj(t *testing.T) {
 			extDiffCmd:          "",
 			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=77", "--stat", "--decorate", "-p", "1234567890", "--ignore-all-space", "--find-renames=50%", "--"},
 		},
+		{
+			testName:            "Show diff, always coloring moved lines",
+			filterPaths:         []string{},
+			contextSize:         3,
+			similarityThreshold: 50,
+			ignoreWhitespace:    false,
+			colorMoved:          "always",
+			extDiffCmd:          "",
+			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890", "--color-moved", "--find-renames=50%", "--"},
+		},
+		{
+			testName:            "Show diff, never coloring moved lines",
+			filterPaths:         []string{},
+			contextSize:         3,
+			similarityThreshold: 50,
+			ignoreWhitespace:    false,
+			colorMoved:          "never",
+			extDiffCmd:          "",
+			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890", "--no-color-moved", "--find-renames=50%", "--"},
+		},
 		{
 			testName:            "Show diff with external diff command",
 			filterPaths:         []string{},
@@ -314,6 +338,26 @@ func TestCommitShowCmdObj(t *testing.T) {
 			extDiffCmd:          "difft --color=always",
 			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.external=difft --color=always", "-c", "diff.noprefix=false", "show", "--ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890", "--find-renames=50%", "--"},
 		},
+		{
+			testName:            "Show diff with pickaxe string",
+			filterPaths:         []string{},
+			pickaxe:             Pickaxe{Text: "myFunc"},
+			contextSize:         3,
+			similarityThreshold: 50,
+			ignoreWhitespace:    false,
+			extDiffCmd:          "",
+			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890", "-SmyFunc", "--find-renames=50%", "--"},
+		},
+		{
+			testName:            "Show diff with pickaxe regex",
+			filterPaths:         []string{},
+			pickaxe:             Pickaxe{Text: "my.*Func", Regex: true},
+			contextSize:         3,
+			similarityThreshold: 50,
+			ignoreWhitespace:    false,
+			extDiffCmd:          "",
+			expected:            []string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "show", "--no-ext-diff", "--submodule", "--color=always", "--unified=3", "--stat", "--decorate", "-p", "1234567890", "-Gmy.*Func", "--find-renames=50%", "--"},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -321,6 +365,7 @@ func TestCommitShowCmdObj(t *testing.T) {
 			userConfig := config.GetDefaultConfig()
 			userConfig.Git.Paging.ExternalDiffCommand = s.extDiffCmd
 			userConfig.Git.IgnoreWhitespaceInDiffView = s.ignoreWhitespace
+			userConfig.Git.ColorMoved = s.colorMoved
 			userConfig.Git.DiffContextSize = s.contextSize
 			userConfig.Git.RenameSimilarityThreshold = s.similarityThreshold
 
@@ -330,7 +375,7 @@ func TestCommitShowCmdObj(t *testing.T) {
 			}
 			instance := buildCommitCommands(commonDeps{userConfig: userConfig, appState: &config.AppState{}, runner: runner, repoPaths: &repoPaths})
 
-			assert.NoError(t, instance.ShowCmdObj("1234567890", s.filterPaths).Run())
+			assert.NoError(t, instance.ShowCmdObj("1234567890", s.filterPaths, s.pickaxe).Run())
 			runner.CheckForMissingCalls()
 		})
 	}
@@ -379,6 +424,146 @@ func TestGetCommitMsg(t *testing.T) {
 	}
 }
 
+func TestCommitResolveCommitHash(t *testing.T) {
+	type scenario struct {
+		testName       string
+		ref            string
+		runner         *oscommands.FakeCmdObjRunner
+		expectedOutput string
+		expectedError  bool
+	}
+	scenarios := []scenario{
+		{
+			testName:       "branch name",
+			ref:            "feature",
+			runner:         oscommands.NewFakeRunner(t).ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "feature^{commit}"}, "1234567890abcdef\n", nil),
+			expectedOutput: "1234567890abcdef",
+		},
+		{
+			testName:      "unknown ref",
+			ref:           "nope",
+			runner:        oscommands.NewFakeRunner(t).ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "nope^{commit}"}, "", errors.New("exit status 1")),
+			expectedError: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildCommitCommands(commonDeps{runner: s.runner})
+
+			output, err := instance.ResolveCommitHash(s.ref)
+
+			if s.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedOutput, output)
+			}
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestCommitGetCommitsStats(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"log", "--no-walk=unsorted", "--shortstat", "--format=%H", "aaa", "bbb", "ccc", "ddd"},
+			"aaa\n\n 3 files changed, 10 insertions(+), 2 deletions(-)\n"+
+				"bbb\n\n 1 file changed, 1 insertion(+)\n"+
+				"ccc\n"+
+				"ddd\n\n 1 file changed, 4 deletions(-)\n",
+			nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	stats, err := instance.GetCommitsStats([]string{"aaa", "bbb", "ccc", "ddd"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*models.CommitStats{
+		"aaa": {FilesChanged: 3, Insertions: 10, Deletions: 2},
+		"bbb": {FilesChanged: 1, Insertions: 1},
+		"ccc": {},
+		"ddd": {FilesChanged: 1, Deletions: 4},
+	}, stats)
+	runner.CheckForMissingCalls()
+}
+
+func TestCommitGetCommitDetails(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"-c", "log.showsignature=false", "log", "--max-count=1", "--format=%H%x00%an%x00%ae%x00%at%x00%cn%x00%ce%x00%ct%x00%P%x00%B%x00%(trailers:only)%x00%(trailers:only,unfold)", "abc"},
+			"abc123\x00Jane Doe\x00jane@example.com\x001700000000\x00John Doe\x00john@example.com\x001700000100\x00def456 fed654\x00"+
+				"subject\n\nbody\n\nSigned-off-by: Jane Doe\n <jane@example.com>\nFixes: #12\n\x00"+
+				"Signed-off-by: Jane Doe\n <jane@example.com>\nFixes: #12\n\x00"+
+				"Signed-off-by: Jane Doe <jane@example.com>\nFixes: #12\n",
+			nil).
+		ExpectGitArgs([]string{"for-each-ref", "--contains", "abc", "--format=%(refname)", "refs/heads", "refs/tags"},
+			"refs/heads/master\nrefs/heads/feature/one\nrefs/tags/v1.0\n",
+			nil)
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	details, err := instance.GetCommitDetails("abc")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &models.CommitDetails{
+		Hash:               "abc123",
+		AuthorName:         "Jane Doe",
+		AuthorEmail:        "jane@example.com",
+		AuthorTimestamp:    1700000000,
+		CommitterName:      "John Doe",
+		CommitterEmail:     "john@example.com",
+		CommitterTimestamp: 1700000100,
+		Parents:            []string{"def456", "fed654"},
+		Message:            "subject\n\nbody",
+		Trailers: []models.CommitTrailer{
+			{Key: "Signed-off-by", Value: "Jane Doe <jane@example.com>"},
+			{Key: "Fixes", Value: "#12"},
+		},
+		Branches: []string{"master", "feature/one"},
+		Tags:     []string{"v1.0"},
+	}, details)
+	runner.CheckForMissingCalls()
+}
+
+func TestCommitGetCommitSummariesInRange(t *testing.T) {
+	type scenario struct {
+		testName     string
+		from         string
+		expectedArgs []string
+		output       string
+		expected     []CommitSummary
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "range between two refs",
+			from:         "v1.0.0",
+			expectedArgs: []string{"log", "--no-merges", "--format=%h%x00%an%x00%s", "v1.0.0..v1.1.0", "--"},
+			output:       "abc\x00Jane Doe\x00feat: add thing\ndef\x00John Doe\x00fix: a bug\n",
+			expected: []CommitSummary{
+				{ShortHash: "abc", Author: "Jane Doe", Subject: "feat: add thing"},
+				{ShortHash: "def", Author: "John Doe", Subject: "fix: a bug"},
+			},
+		},
+		{
+			testName:     "no lower bound",
+			from:         "",
+			expectedArgs: []string{"log", "--no-merges", "--format=%h%x00%an%x00%s", "v1.1.0", "--"},
+			output:       "",
+			expected:     []CommitSummary{},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expectedArgs, s.output, nil)
+			instance := buildCommitCommands(commonDeps{runner: runner})
+
+			summaries, err := instance.GetCommitSummariesInRange(s.from, "v1.1.0")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, summaries)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestGetCommitMessageFromHistory(t *testing.T) {
 	type scenario struct {
 		testName string
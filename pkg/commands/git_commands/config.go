@@ -42,21 +42,43 @@ func (self *ConfigCommands) ConfiguredPager() string {
 	return strings.Split(output, "\n")[0]
 }
 
-func (self *ConfigCommands) GetPager(width int) string {
-	useConfig := self.UserConfig().Git.Paging.UseConfig
-	if useConfig {
-		pager := self.ConfiguredPager()
-		return strings.Split(pager, "| less")[0]
+// Returns the pager to use for showing the diff of the selected item of the
+// given panel
+func (self *ConfigCommands) GetPager(width int, panel string) string {
+	pagerTemplate, ok := self.UserConfig().Git.Paging.PagerOverrides[panel]
+	if !ok {
+		useConfig := self.UserConfig().Git.Paging.UseConfig
+		if useConfig {
+			pager := self.ConfiguredPager()
+			return strings.Split(pager, "| less")[0]
+		}
+
+		pagerTemplate = string(self.UserConfig().Git.Paging.Pager)
 	}
 
 	templateValues := map[string]string{
 		"columnWidth": strconv.Itoa(width/2 - 6),
 	}
 
-	pagerTemplate := string(self.UserConfig().Git.Paging.Pager)
 	return utils.ResolvePlaceholderString(pagerTemplate, templateValues)
 }
 
+// Returns true if the diffs that we get for the given panel are laid out
+// according to the width of the main view, so they need to be rendered again
+// when it is resized
+func (self *ConfigCommands) PagerRendersSideBySide(panel string) bool {
+	pagingConfig := self.UserConfig().Git.Paging
+	if pagingConfig.SideBySide {
+		return true
+	}
+
+	pagerTemplate, ok := pagingConfig.PagerOverrides[panel]
+	if !ok {
+		pagerTemplate = string(pagingConfig.Pager)
+	}
+	return strings.Contains(pagerTemplate, "{{columnWidth}}")
+}
+
 type GpgConfigKey string
 
 const (
@@ -84,6 +106,10 @@ func (self *ConfigCommands) GetGpgTagSign() bool {
 	return self.gitConfig.GetBool(string(TagGpgSign))
 }
 
+func (self *ConfigCommands) GetUserEmail() string {
+	return self.gitConfig.Get("user.email")
+}
+
 func (self *ConfigCommands) GetCoreEditor() string {
 	return self.gitConfig.Get("core.editor")
 }
@@ -97,6 +123,10 @@ func (self *ConfigCommands) GetShowUntrackedFiles() string {
 	return self.gitConfig.Get("status.showUntrackedFiles")
 }
 
+func (self *ConfigCommands) GetSparseCheckout() bool {
+	return self.gitConfig.GetBool("core.sparseCheckout")
+}
+
 // this determines whether the user has configured to push to the remote branch of the same name as the current or not
 func (self *ConfigCommands) GetPushToCurrent() bool {
 	return self.gitConfig.Get("push.default") == "current"
@@ -124,6 +154,25 @@ func (self *ConfigCommands) GetCoreCommentChar() byte {
 	return '#'
 }
 
+// Returns whether moved lines should be shown in different colors from added
+// and deleted lines, according to the git.colorMoved user config, which can
+// defer to git's diff.colorMoved setting
+func (self *ConfigCommands) GetColorMoved() bool {
+	switch self.UserConfig().Git.ColorMoved {
+	case "always":
+		return true
+	case "never":
+		return false
+	}
+
+	switch strings.ToLower(self.gitConfig.Get("diff.colorMoved")) {
+	case "", "no", "false", "off", "0":
+		return false
+	default:
+		return true
+	}
+}
+
 func (self *ConfigCommands) GetRebaseUpdateRefs() bool {
 	return self.gitConfig.GetBool("rebase.updateRefs")
 }
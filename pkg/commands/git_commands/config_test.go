@@ -0,0 +1,129 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigGetPager(t *testing.T) {
+	scenarios := []struct {
+		testName       string
+		pager          string
+		pagerOverrides map[string]string
+		panel          string
+		expected       string
+	}{
+		{
+			testName: "no pager",
+			panel:    "files",
+			expected: "",
+		},
+		{
+			testName: "pager with column width",
+			pager:    "ydiff -p cat -s --width={{columnWidth}}",
+			panel:    "files",
+			expected: "ydiff -p cat -s --width=44",
+		},
+		{
+			testName:       "override for another panel",
+			pager:          "delta --dark --paging=never",
+			pagerOverrides: map[string]string{"commits": "delta --side-by-side --paging=never"},
+			panel:          "files",
+			expected:       "delta --dark --paging=never",
+		},
+		{
+			testName:       "override for this panel",
+			pager:          "delta --dark --paging=never",
+			pagerOverrides: map[string]string{"commits": "ydiff -p cat -s --width={{columnWidth}}"},
+			panel:          "commits",
+			expected:       "ydiff -p cat -s --width=44",
+		},
+		{
+			testName:       "override disabling the pager",
+			pager:          "delta --dark --paging=never",
+			pagerOverrides: map[string]string{"commits": ""},
+			panel:          "commits",
+			expected:       "",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.Paging.Pager = config.PagerType(s.pager)
+			userConfig.Git.Paging.PagerOverrides = s.pagerOverrides
+			instance := NewConfigCommands(
+				common.NewDummyCommonWithUserConfigAndAppState(userConfig, &config.AppState{}),
+				git_config.NewFakeGitConfig(nil),
+				nil,
+			)
+
+			assert.Equal(t, s.expected, instance.GetPager(100, s.panel))
+		})
+	}
+}
+
+func TestConfigPagerRendersSideBySide(t *testing.T) {
+	scenarios := []struct {
+		testName       string
+		pager          string
+		sideBySide     bool
+		pagerOverrides map[string]string
+		panel          string
+		expected       bool
+	}{
+		{
+			testName: "plain pager",
+			pager:    "delta --dark --paging=never",
+			panel:    "files",
+			expected: false,
+		},
+		{
+			testName:   "configured as side by side",
+			pager:      "delta --side-by-side --paging=never",
+			sideBySide: true,
+			panel:      "files",
+			expected:   true,
+		},
+		{
+			testName: "pager uses the column width",
+			pager:    "ydiff -p cat -s --width={{columnWidth}}",
+			panel:    "files",
+			expected: true,
+		},
+		{
+			testName:       "override uses the column width",
+			pager:          "delta --dark --paging=never",
+			pagerOverrides: map[string]string{"commits": "ydiff -p cat -s --width={{columnWidth}}"},
+			panel:          "commits",
+			expected:       true,
+		},
+		{
+			testName:       "override doesn't use the column width",
+			pager:          "ydiff -p cat -s --width={{columnWidth}}",
+			pagerOverrides: map[string]string{"commits": ""},
+			panel:          "commits",
+			expected:       false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.Paging.Pager = config.PagerType(s.pager)
+			userConfig.Git.Paging.SideBySide = s.sideBySide
+			userConfig.Git.Paging.PagerOverrides = s.pagerOverrides
+			instance := NewConfigCommands(
+				common.NewDummyCommonWithUserConfigAndAppState(userConfig, &config.AppState{}),
+				git_config.NewFakeGitConfig(nil),
+				nil,
+			)
+
+			assert.Equal(t, s.expected, instance.PagerRendersSideBySide(s.panel))
+		})
+	}
+}
@@ -158,8 +158,20 @@ func buildBranchCommands(deps commonDeps) *BranchCommands {
 	return NewBranchCommands(gitCommon)
 }
 
+func buildTagCommands(deps commonDeps) *TagCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewTagCommands(gitCommon)
+}
+
 func buildFlowCommands(deps commonDeps) *FlowCommands {
 	gitCommon := buildGitCommon(deps)
 
 	return NewFlowCommands(gitCommon)
 }
+
+func buildWorktreeCommands(deps commonDeps) *WorktreeCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewWorktreeCommands(gitCommon)
+}
@@ -2,8 +2,14 @@ package git_commands
 
 import (
 	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type DiffCommands struct {
@@ -21,16 +27,16 @@ func NewDiffCommands(gitCommon *GitCommon) *DiffCommands {
 func (self *DiffCommands) DiffCmdObj(diffArgs []string) *oscommands.CmdObj {
 	extDiffCmd := self.UserConfig().Git.Paging.ExternalDiffCommand
 	useExtDiff := extDiffCmd != ""
-	ignoreWhitespace := self.UserConfig().Git.IgnoreWhitespaceInDiffView
 
 	return self.cmd.New(
 		NewGitCmd("diff").
 			Config("diff.noprefix=false").
+			Config(diffColorConfigs(self.UserConfig())...).
 			ConfigIf(useExtDiff, "diff.external="+extDiffCmd).
 			ArgIfElse(useExtDiff, "--ext-diff", "--no-ext-diff").
 			Arg("--submodule").
 			Arg(fmt.Sprintf("--color=%s", self.UserConfig().Git.Paging.ColorArg)).
-			ArgIf(ignoreWhitespace, "--ignore-all-space").
+			Arg(diffOptionArgs(self.UserConfig(), false)...).
 			Arg(fmt.Sprintf("--unified=%d", self.UserConfig().Git.DiffContextSize)).
 			Arg(diffArgs...).
 			Dir(self.repoPaths.worktreePath).
@@ -38,6 +44,97 @@ func (self *DiffCommands) DiffCmdObj(diffArgs []string) *oscommands.CmdObj {
 	)
 }
 
+// Returns the arguments for the diff options that the user can change from
+// within lazygit. When the diff is meant to be applied as a patch (plain), we
+// don't pass the options that would make it incomplete.
+func diffOptionArgs(userConfig *config.UserConfig, plain bool) []string {
+	args := []string{}
+	if !plain && userConfig.Git.IgnoreWhitespaceInDiffView {
+		args = append(args, "--ignore-all-space")
+	}
+	if !plain && userConfig.Git.IgnoreBlankLinesInDiffView {
+		args = append(args, "--ignore-blank-lines")
+	}
+	if userConfig.Git.DiffAlgorithm != "" {
+		args = append(args, "--diff-algorithm="+userConfig.Git.DiffAlgorithm)
+	}
+
+	switch userConfig.Git.ColorMoved {
+	case "always":
+		args = append(args, "--color-moved")
+	case "never":
+		args = append(args, "--no-color-moved")
+	}
+
+	return args
+}
+
+// Returns the configs that make git color added and removed lines the way the
+// theme wants. Colors that are the same as git's defaults are left alone, so
+// that whatever the user configured in git keeps working.
+func diffColorConfigs(userConfig *config.UserConfig) []string {
+	theme := userConfig.Gui.Theme.WithPreset()
+	configs := []string{}
+	if !slices.Equal(theme.AddedLinesColor, []string{"green"}) {
+		configs = append(configs, "color.diff.new="+gitColor(theme.AddedLinesColor))
+	}
+	if !slices.Equal(theme.RemovedLinesColor, []string{"red"}) {
+		configs = append(configs, "color.diff.old="+gitColor(theme.RemovedLinesColor))
+	}
+	return configs
+}
+
+// Translates the color attributes of the theme to the syntax of git's color
+// configs
+func gitColor(keys []string) string {
+	words := lo.FilterMap(keys, func(key string, _ int) (string, bool) {
+		switch key {
+		case "default":
+			return "normal", true
+		case "underline":
+			return "ul", true
+		case "strikethrough":
+			return "strike", true
+		case "bold", "reverse", "black", "red", "green", "yellow", "blue", "magenta", "cyan", "white":
+			return key, true
+		}
+		if utils.IsValidHexValue(key) {
+			if len(key) == 4 {
+				// git only knows the long form
+				return string([]byte{'#', key[1], key[1], key[2], key[2], key[3], key[3]}), true
+			}
+			return key, true
+		}
+		return "", false
+	})
+	return strings.Join(words, " ")
+}
+
+// Returns the external diff command to use for showing the diff of a single
+// file in the main view: the one configured for its file type if there is one,
+// or the general one otherwise.
+func externalDiffCommandForPath(userConfig *config.UserConfig, path string) string {
+	if command, ok := fileTypeDiffCommand(userConfig, path, false); ok {
+		return command
+	}
+	return userConfig.Git.Paging.ExternalDiffCommand
+}
+
+func fileTypeDiffCommand(userConfig *config.UserConfig, path string, subprocess bool) (string, bool) {
+	extension := strings.TrimPrefix(filepath.Ext(path), ".")
+	if extension == "" {
+		return "", false
+	}
+
+	for _, command := range userConfig.Git.Paging.FileTypeDiffCommands {
+		if command.Subprocess == subprocess &&
+			lo.ContainsBy(command.Extensions, func(e string) bool { return strings.EqualFold(e, extension) }) {
+			return command.Command, true
+		}
+	}
+	return "", false
+}
+
 // This is a basic generic diff command that can be used for any diff operation
 // (e.g. copying a diff to the clipboard). It will not use a custom pager, and
 // does not use user configs such as ignore whitespace.
@@ -84,8 +181,14 @@ type DiffToolCmdOptions struct {
 }
 
 func (self *DiffCommands) OpenDiffToolCmdObj(opts DiffToolCmdOptions) *oscommands.CmdObj {
+	extCmd, useExtCmd := "", false
+	if !opts.IsDirectory {
+		extCmd, useExtCmd = fileTypeDiffCommand(self.UserConfig(), opts.Filepath, true)
+	}
+
 	return self.cmd.New(NewGitCmd("difftool").
 		Arg("--no-prompt").
+		ArgIf(useExtCmd, "--extcmd="+extCmd).
 		ArgIf(opts.IsDirectory, "--dir-diff").
 		ArgIf(opts.Staged, "--cached").
 		ArgIf(opts.FromCommit != "", opts.FromCommit).
@@ -0,0 +1,98 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffOpenDiffToolCmdObj(t *testing.T) {
+	type scenario struct {
+		testName     string
+		opts         DiffToolCmdOptions
+		fileTypeCmds []config.FileTypeDiffCommand
+		expected     []string
+	}
+
+	fileTypeCmds := []config.FileTypeDiffCommand{
+		{Extensions: []string{"png", "jpg"}, Command: "imgdiff", Subprocess: true},
+		{Extensions: []string{"ipynb"}, Command: "nbdiff"},
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "no file type diff commands",
+			opts:     DiffToolCmdOptions{Filepath: "image.png", Staged: true},
+			expected: []string{"git", "difftool", "--no-prompt", "--cached", "--", "image.png"},
+		},
+		{
+			testName:     "subprocess command for the file's type",
+			opts:         DiffToolCmdOptions{Filepath: "dir/image.JPG", FromCommit: "abc"},
+			fileTypeCmds: fileTypeCmds,
+			expected:     []string{"git", "difftool", "--no-prompt", "--extcmd=imgdiff", "abc", "--", "dir/image.JPG"},
+		},
+		{
+			testName:     "command for the file's type that isn't a subprocess",
+			opts:         DiffToolCmdOptions{Filepath: "notebook.ipynb"},
+			fileTypeCmds: fileTypeCmds,
+			expected:     []string{"git", "difftool", "--no-prompt", "--", "notebook.ipynb"},
+		},
+		{
+			testName:     "directory",
+			opts:         DiffToolCmdOptions{Filepath: "images.png", IsDirectory: true},
+			fileTypeCmds: fileTypeCmds,
+			expected:     []string{"git", "difftool", "--no-prompt", "--dir-diff", "--", "images.png"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			userConfig.Git.Paging.FileTypeDiffCommands = s.fileTypeCmds
+
+			gitCommon := buildGitCommon(commonDeps{runner: oscommands.NewFakeRunner(t), userConfig: userConfig})
+			instance := NewDiffCommands(gitCommon)
+
+			assert.Equal(t, s.expected, instance.OpenDiffToolCmdObj(s.opts).Args())
+		})
+	}
+}
+
+func TestDiffColorConfigs(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		setup    func(theme *config.ThemeConfig)
+		expected []string
+	}{
+		{
+			name:     "git's own colors",
+			setup:    func(theme *config.ThemeConfig) {},
+			expected: []string{},
+		},
+		{
+			name: "custom colors",
+			setup: func(theme *config.ThemeConfig) {
+				theme.AddedLinesColor = []string{"#abc", "underline"}
+				theme.RemovedLinesColor = []string{"default", "bold", "nonsense"}
+			},
+			expected: []string{"color.diff.new=#aabbcc ul", "color.diff.old=normal bold"},
+		},
+		{
+			name: "preset",
+			setup: func(theme *config.ThemeConfig) {
+				theme.Preset = "highContrast"
+			},
+			expected: []string{"color.diff.new=green bold", "color.diff.old=red bold"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			userConfig := config.GetDefaultConfig()
+			s.setup(&userConfig.Gui.Theme)
+			assert.Equal(t, s.expected, diffColorConfigs(userConfig))
+		})
+	}
+}
@@ -5,13 +5,18 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
 )
 
 type FileLoaderConfig interface {
 	GetShowUntrackedFiles() string
+	GetSparseCheckout() bool
 }
 
 type FileLoader struct {
@@ -36,6 +41,15 @@ type GetStatusFileOptions struct {
 	// This is useful for users with bare repos for dotfiles who default to hiding untracked files,
 	// but want to occasionally see them to `git add` a new file.
 	ForceShowUntracked bool
+	// If set, only files at or below this path are returned. Used when
+	// filtering by path.
+	Path string
+	// If true, the number of added and deleted lines is loaded even if the
+	// user has not enabled showing them. Used for sorting by diff size.
+	WithDiffSizes bool
+	// If true, the modification time of each file in the working tree is
+	// loaded. Used for sorting by modification time.
+	WithModificationTimes bool
 }
 
 func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File {
@@ -47,14 +61,21 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 	}
 	untrackedFilesArg := fmt.Sprintf("--untracked-files=%s", untrackedFilesSetting)
 
-	statuses, err := self.gitStatus(GitStatusOptions{NoRenames: opts.NoRenames, UntrackedFilesArg: untrackedFilesArg})
+	statuses, err := self.gitStatus(GitStatusOptions{NoRenames: opts.NoRenames, UntrackedFilesArg: untrackedFilesArg, Path: opts.Path})
 	if err != nil {
 		self.Log.Error(err)
 	}
+
+	if !opts.NoRenames && self.UserConfig().Git.DetectUnstagedRenames {
+		statuses, err = self.withWorktreeRenames(statuses)
+		if err != nil {
+			self.Log.Error(err)
+		}
+	}
 	files := []*models.File{}
 
 	fileDiffs := map[string]FileDiff{}
-	if self.GitCommon.Common.UserConfig().Gui.ShowNumstatInFilesView {
+	if self.GitCommon.Common.UserConfig().Gui.ShowNumstatInFilesView || opts.WithDiffSizes {
 		fileDiffs, err = self.getFileDiffs()
 		if err != nil {
 			self.Log.Error(err)
@@ -82,6 +103,40 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 		files = append(files, file)
 	}
 
+	// Files with the skip-worktree or assume-unchanged bit set don't show up in
+	// git status (unless they have staged changes), so we add them ourselves.
+	// This lists the whole index, which is slow in big repos, so it's opt-in.
+	indexFlags := []FileIndexFlags{}
+	if self.UserConfig().Gui.ShowFilesWithHiddenChanges {
+		indexFlags, err = self.gitFilesWithIndexFlags(opts.Path)
+		if err != nil {
+			self.Log.Error(err)
+		}
+	}
+	for _, flags := range indexFlags {
+		file, ok := lo.Find(files, func(file *models.File) bool { return file.Path == flags.Path })
+		if !ok {
+			file = &models.File{
+				Path:          flags.Path,
+				DisplayString: flags.Path,
+			}
+			models.SetStatusFields(file, "  ")
+			files = append(files, file)
+		}
+
+		file.SkipWorktree = flags.SkipWorktree
+		file.AssumeUnchanged = flags.AssumeUnchanged
+	}
+
+	if opts.WithModificationTimes {
+		for _, file := range files {
+			// deleted files have no modification time, so they end up last
+			if info, err := self.Fs.Stat(file.Path); err == nil {
+				file.ModTime = info.ModTime()
+			}
+		}
+	}
+
 	// Go through the files to see if any of these files are actually worktrees
 	// so that we can render them correctly
 	worktreePaths := linkedWortkreePaths(self.Fs, self.repoPaths.RepoGitDirPath())
@@ -116,7 +171,13 @@ func (self *FileLoader) getFileDiffs() (map[string]FileDiff, error) {
 		return nil, err
 	}
 
-	splitLines := strings.Split(diffs, "\x00")
+	return parseNumstat(diffs), nil
+}
+
+// Parses the output of `git diff --numstat -z --no-renames`. Binary files,
+// which have "-" for both counts, are left out.
+func parseNumstat(output string) map[string]FileDiff {
+	splitLines := strings.Split(output, "\x00")
 
 	fileDiffs := map[string]FileDiff{}
 	for _, line := range splitLines {
@@ -141,13 +202,14 @@ func (self *FileLoader) getFileDiffs() (map[string]FileDiff, error) {
 		}
 	}
 
-	return fileDiffs, nil
+	return fileDiffs
 }
 
 // GitStatus returns the file status of the repo
 type GitStatusOptions struct {
 	NoRenames         bool
 	UntrackedFilesArg string
+	Path              string
 }
 
 type FileStatus struct {
@@ -157,6 +219,53 @@ type FileStatus struct {
 	PreviousPath string
 }
 
+type FileIndexFlags struct {
+	Path            string
+	SkipWorktree    bool
+	AssumeUnchanged bool
+}
+
+// Returns the tracked files that have the skip-worktree or assume-unchanged
+// bit set
+func (self *FileLoader) gitFilesWithIndexFlags(path string) ([]FileIndexFlags, error) {
+	cmdArgs := NewGitCmd("ls-files").
+		Arg("-v", "-z").
+		ArgIf(path != "", "--", path).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseFilesWithIndexFlags(output, self.config.GetSparseCheckout()), nil
+}
+
+// Parses the output of `git ls-files -v -z`, where each entry is a status tag
+// followed by a space and the path. 'S' means skip-worktree, and a lowercase
+// tag means assume-unchanged. In a sparse checkout the skip-worktree bit marks
+// the files outside of the sparse cone, so we ignore it there.
+func parseFilesWithIndexFlags(output string, sparseCheckout bool) []FileIndexFlags {
+	result := []FileIndexFlags{}
+	for _, entry := range strings.Split(output, "\x00") {
+		if len(entry) < 3 {
+			continue
+		}
+
+		tag := entry[:1]
+		flags := FileIndexFlags{
+			Path:            entry[2:],
+			SkipWorktree:    !sparseCheckout && strings.EqualFold(tag, "S"),
+			AssumeUnchanged: tag != strings.ToUpper(tag),
+		}
+		if flags.SkipWorktree || flags.AssumeUnchanged {
+			result = append(result, flags)
+		}
+	}
+
+	return result
+}
+
 func (self *FileLoader) gitDiffNumStat() (string, error) {
 	return self.cmd.New(
 		NewGitCmd("diff").
@@ -177,6 +286,7 @@ func (self *FileLoader) gitStatus(opts GitStatusOptions) ([]FileStatus, error) {
 			"--no-renames",
 			fmt.Sprintf("--find-renames=%d%%", self.UserConfig().Git.RenameSimilarityThreshold),
 		).
+		ArgIf(opts.Path != "", "--", opts.Path).
 		ToArgv()
 
 	statusLines, _, err := self.cmd.New(cmdArgs).DontLog().RunWithOutputs()
@@ -184,6 +294,11 @@ func (self *FileLoader) gitStatus(opts GitStatusOptions) ([]FileStatus, error) {
 		return []FileStatus{}, err
 	}
 
+	return parseStatusLines(statusLines), nil
+}
+
+// Parses the output of `git status --porcelain -z`
+func parseStatusLines(statusLines string) []FileStatus {
 	splitLines := strings.Split(statusLines, "\x00")
 	response := []FileStatus{}
 
@@ -201,8 +316,8 @@ func (self *FileLoader) gitStatus(opts GitStatusOptions) ([]FileStatus, error) {
 			PreviousPath: "",
 		}
 
-		if strings.HasPrefix(status.Change, "R") {
-			// if a line starts with 'R' then the next line is the original file.
+		if strings.Contains(status.Change, "R") {
+			// if a line has an 'R' status then the next line is the original file.
 			status.PreviousPath = splitLines[i+1]
 			status.StatusString = fmt.Sprintf("%s %s -> %s", status.Change, status.PreviousPath, status.Path)
 			i++
@@ -211,5 +326,73 @@ func (self *FileLoader) gitStatus(opts GitStatusOptions) ([]FileStatus, error) {
 		response = append(response, status)
 	}
 
-	return response, nil
+	return response
+}
+
+// Git only detects renames in the working tree if the new file is marked as
+// intent-to-add. So if a file was moved without telling git, it shows up as a
+// deleted file plus an untracked file. To show it as a single rename instead,
+// we mark the untracked files as intent-to-add in a temporary copy of the
+// index and ask git status again about just these files.
+func (self *FileLoader) withWorktreeRenames(statuses []FileStatus) ([]FileStatus, error) {
+	deletedPaths := []string{}
+	untrackedPaths := []string{}
+	for _, status := range statuses {
+		switch status.Change {
+		case " D":
+			deletedPaths = append(deletedPaths, status.Path)
+		case "??":
+			untrackedPaths = append(untrackedPaths, status.Path)
+		}
+	}
+	if len(deletedPaths) == 0 || len(untrackedPaths) == 0 {
+		return statuses, nil
+	}
+
+	indexContent, err := afero.ReadFile(self.Fs, filepath.Join(self.repoPaths.WorktreeGitDirPath(), "index"))
+	if err != nil {
+		return statuses, err
+	}
+	indexFile := filepath.Join(self.os.GetTempDir(), fmt.Sprintf("rename-index-%d", time.Now().UnixNano()))
+	if err := afero.WriteFile(self.Fs, indexFile, indexContent, 0o644); err != nil {
+		return statuses, err
+	}
+	defer func() { _ = self.Fs.Remove(indexFile) }()
+	indexEnv := "GIT_INDEX_FILE=" + indexFile
+
+	addCmdArgs := NewGitCmd("add").Arg("-N", "--").Arg(untrackedPaths...).ToArgv()
+	if err := self.cmd.New(addCmdArgs).AddEnvVars(indexEnv).DontLog().Run(); err != nil {
+		return statuses, err
+	}
+
+	statusCmdArgs := NewGitCmd("status").
+		Arg("--untracked-files=no", "--porcelain", "-z").
+		Arg(fmt.Sprintf("--find-renames=%d%%", self.UserConfig().Git.RenameSimilarityThreshold)).
+		Arg("--").
+		Arg(deletedPaths...).
+		Arg(untrackedPaths...).
+		ToArgv()
+	output, err := self.cmd.New(statusCmdArgs).AddEnvVars(indexEnv).DontLog().RunWithOutput()
+	if err != nil {
+		return statuses, err
+	}
+
+	renamesByPath := map[string]FileStatus{}
+	renamedPreviousPaths := set.New[string]()
+	for _, status := range parseStatusLines(output) {
+		if status.Change == " R" {
+			renamesByPath[status.Path] = status
+			renamedPreviousPaths.Add(status.PreviousPath)
+		}
+	}
+
+	result := []FileStatus{}
+	for _, status := range statuses {
+		if rename, ok := renamesByPath[status.Path]; ok && status.Change == "??" {
+			result = append(result, rename)
+		} else if status.Change != " D" || !renamedPreviousPaths.Includes(status.Path) {
+			result = append(result, status)
+		}
+	}
+	return result, nil
 }
@@ -1,21 +1,28 @@
 package git_commands
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestFileGetStatusFiles(t *testing.T) {
 	type scenario struct {
-		testName               string
-		similarityThreshold    int
-		runner                 oscommands.ICmdObjRunner
-		showNumstatInFilesView bool
-		expectedFiles          []*models.File
+		testName                   string
+		similarityThreshold        int
+		runner                     oscommands.ICmdObjRunner
+		showNumstatInFilesView     bool
+		showFilesWithHiddenChanges bool
+		sparseCheckout             bool
+		detectUnstagedRenames      bool
+		path                       string
+		fs                         afero.Fs
+		expectedFiles              []*models.File
 	}
 
 	scenarios := []scenario{
@@ -26,6 +33,25 @@ func TestFileGetStatusFiles(t *testing.T) {
 				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"}, "", nil),
 			expectedFiles: []*models.File{},
 		},
+		{
+			testName:            "Filtered by path",
+			similarityThreshold: 50,
+			path:                "pkg/app",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%", "--", "pkg/app"},
+					"M  pkg/app/main.go",
+					nil,
+				),
+			expectedFiles: []*models.File{
+				{
+					Path:             "pkg/app/main.go",
+					HasStagedChanges: true,
+					Tracked:          true,
+					DisplayString:    "M  pkg/app/main.go",
+					ShortStatus:      "M ",
+				},
+			},
+		},
 		{
 			testName:            "Several files found",
 			similarityThreshold: 50,
@@ -169,6 +195,72 @@ func TestFileGetStatusFiles(t *testing.T) {
 				},
 			},
 		},
+		{
+			testName:              "File renamed in the working tree",
+			similarityThreshold:   50,
+			detectUnstagedRenames: true,
+			fs:                    fsWithGitIndex(),
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"},
+					" D before.txt\x00?? after.txt\x00?? other.txt",
+					nil,
+				).
+				ExpectGitArgs([]string{"add", "-N", "--", "after.txt", "other.txt"}, "", nil).
+				ExpectGitArgs([]string{"status", "--untracked-files=no", "--porcelain", "-z", "--find-renames=50%", "--", "before.txt", "after.txt", "other.txt"},
+					" R after.txt\x00before.txt\x00 A other.txt",
+					nil,
+				),
+			expectedFiles: []*models.File{
+				{
+					Path:               "after.txt",
+					PreviousPath:       "before.txt",
+					HasStagedChanges:   false,
+					HasUnstagedChanges: true,
+					Tracked:            true,
+					DisplayString:      " R before.txt -> after.txt",
+					ShortStatus:        " R",
+				},
+				{
+					Path:               "other.txt",
+					HasStagedChanges:   false,
+					HasUnstagedChanges: true,
+					Tracked:            false,
+					Added:              true,
+					DisplayString:      "?? other.txt",
+					ShortStatus:        "??",
+				},
+			},
+		},
+		{
+			testName:            "File renamed in the working tree without detecting unstaged renames",
+			similarityThreshold: 50,
+			fs:                  fsWithGitIndex(),
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"},
+					" D before.txt\x00?? after.txt",
+					nil,
+				),
+			expectedFiles: []*models.File{
+				{
+					Path:               "before.txt",
+					HasStagedChanges:   false,
+					HasUnstagedChanges: true,
+					Tracked:            true,
+					Deleted:            true,
+					DisplayString:      " D before.txt",
+					ShortStatus:        " D",
+				},
+				{
+					Path:               "after.txt",
+					HasStagedChanges:   false,
+					HasUnstagedChanges: true,
+					Tracked:            false,
+					Added:              true,
+					DisplayString:      "?? after.txt",
+					ShortStatus:        "??",
+				},
+			},
+		},
 		{
 			testName:            "File with arrow in name",
 			similarityThreshold: 50,
@@ -192,6 +284,57 @@ func TestFileGetStatusFiles(t *testing.T) {
 				},
 			},
 		},
+		{
+			testName:            "Files with skip-worktree or assume-unchanged bits",
+			similarityThreshold: 50,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"}, "M  staged.txt", nil).
+				ExpectGitArgs([]string{"ls-files", "-v", "-z"}, "H normal.txt\x00S skipped.txt\x00h assumed.txt\x00s staged.txt", nil),
+			showFilesWithHiddenChanges: true,
+			expectedFiles: []*models.File{
+				{
+					Path:             "staged.txt",
+					HasStagedChanges: true,
+					Tracked:          true,
+					DisplayString:    "M  staged.txt",
+					ShortStatus:      "M ",
+					SkipWorktree:     true,
+					AssumeUnchanged:  true,
+				},
+				{
+					Path:          "skipped.txt",
+					Tracked:       true,
+					DisplayString: "skipped.txt",
+					ShortStatus:   "  ",
+					SkipWorktree:  true,
+				},
+				{
+					Path:            "assumed.txt",
+					Tracked:         true,
+					DisplayString:   "assumed.txt",
+					ShortStatus:     "  ",
+					AssumeUnchanged: true,
+				},
+			},
+		},
+		{
+			testName:            "Skip-worktree bits in a sparse checkout",
+			similarityThreshold: 50,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"}, "", nil).
+				ExpectGitArgs([]string{"ls-files", "-v", "-z"}, "H normal.txt\x00S outside-cone.txt\x00s assumed.txt", nil),
+			showFilesWithHiddenChanges: true,
+			sparseCheckout:             true,
+			expectedFiles: []*models.File{
+				{
+					Path:            "assumed.txt",
+					Tracked:         true,
+					DisplayString:   "assumed.txt",
+					ShortStatus:     "  ",
+					AssumeUnchanged: true,
+				},
+			},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -200,24 +343,37 @@ func TestFileGetStatusFiles(t *testing.T) {
 
 			userConfig := &config.UserConfig{}
 			userConfig.Gui.ShowNumstatInFilesView = s.showNumstatInFilesView
+			userConfig.Gui.ShowFilesWithHiddenChanges = s.showFilesWithHiddenChanges
 			userConfig.Git.RenameSimilarityThreshold = s.similarityThreshold
+			userConfig.Git.DetectUnstagedRenames = s.detectUnstagedRenames
 
 			loader := &FileLoader{
-				GitCommon:   buildGitCommon(commonDeps{appState: &config.AppState{}, userConfig: userConfig}),
+				GitCommon:   buildGitCommon(commonDeps{appState: &config.AppState{}, userConfig: userConfig, fs: s.fs}),
 				cmd:         cmd,
-				config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
+				config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes", sparseCheckout: s.sparseCheckout},
 				getFileType: func(string) string { return "file" },
 			}
 
-			assert.EqualValues(t, s.expectedFiles, loader.GetStatusFiles(GetStatusFileOptions{}))
+			assert.EqualValues(t, s.expectedFiles, loader.GetStatusFiles(GetStatusFileOptions{Path: s.path}))
 		})
 	}
 }
 
+func fsWithGitIndex() afero.Fs {
+	fs := afero.NewMemMapFs()
+	_ = afero.WriteFile(fs, filepath.Join(MockRepoPaths(".git").WorktreeGitDirPath(), "index"), []byte("index"), 0o644)
+	return fs
+}
+
 type FakeFileLoaderConfig struct {
 	showUntrackedFiles string
+	sparseCheckout     bool
 }
 
 func (self *FakeFileLoaderConfig) GetShowUntrackedFiles() string {
 	return self.showUntrackedFiles
 }
+
+func (self *FakeFileLoaderConfig) GetSparseCheckout() bool {
+	return self.sparseCheckout
+}
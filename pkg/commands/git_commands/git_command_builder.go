@@ -36,16 +36,18 @@ func (self *GitCommandBuilder) ArgIfElse(condition bool, ifTrue string, ifFalse
 	return self.Arg(ifFalse)
 }
 
-func (self *GitCommandBuilder) Config(value string) *GitCommandBuilder {
+func (self *GitCommandBuilder) Config(values ...string) *GitCommandBuilder {
 	// config settings come before the command
-	self.args = append([]string{"-c", value}, self.args...)
+	for _, value := range values {
+		self.args = append([]string{"-c", value}, self.args...)
+	}
 
 	return self
 }
 
-func (self *GitCommandBuilder) ConfigIf(condition bool, ifTrue string) *GitCommandBuilder {
+func (self *GitCommandBuilder) ConfigIf(condition bool, ifTrue ...string) *GitCommandBuilder {
 	if condition {
-		self.Config(ifTrue)
+		self.Config(ifTrue...)
 	}
 
 	return self
@@ -0,0 +1,246 @@
+package git_commands
+
+import (
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+)
+
+// These mirror git's own defaults for gc.auto and gc.autoPackLimit
+const (
+	gcAutoLooseObjectLimit = 6700
+	gcAutoPackLimit        = 50
+)
+
+// A lock file that has been around for this long is almost certainly left over
+// from a git process that crashed or was killed.
+const staleLockFileAge = time.Minute
+
+type HealthCommands struct {
+	*GitCommon
+}
+
+func NewHealthCommands(gitCommon *GitCommon) *HealthCommands {
+	return &HealthCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+type FsckResult struct {
+	// e.g. "dangling commit 1234abcd"
+	DanglingObjects []string
+	// refs that are unreadable or point to objects that don't exist
+	BrokenRefs []string
+	// anything else fsck complained about
+	Problems []string
+}
+
+// e.g. "error: refs/heads/foo: invalid sha1 pointer 1234abcd"
+var brokenRefRegex = regexp.MustCompile(`^error: (refs/\S+|HEAD): `)
+
+// Fsck runs `git fsck` and sorts its complaints into dangling objects (which are
+// harmless and can be pruned), broken refs, and other problems.
+func (self *HealthCommands) Fsck() (*FsckResult, error) {
+	cmdArgs := NewGitCmd("fsck").Arg("--no-progress").ToArgv()
+
+	stdout, stderr, err := self.cmd.New(cmdArgs).DontLog().RunWithOutputs()
+	result := parseFsckOutput(stdout + "\n" + stderr)
+	// fsck exits non-zero when it finds problems, which isn't an error from our
+	// point of view
+	if err != nil && len(result.Problems) == 0 && len(result.BrokenRefs) == 0 {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func parseFsckOutput(output string) *FsckResult {
+	result := &FsckResult{}
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "", strings.HasPrefix(line, "notice:"), strings.HasPrefix(line, "Checking "):
+			continue
+		case strings.HasPrefix(line, "dangling "):
+			result.DanglingObjects = append(result.DanglingObjects, line)
+		case brokenRefRegex.MatchString(line):
+			ref := brokenRefRegex.FindStringSubmatch(line)[1]
+			if !lo.Contains(result.BrokenRefs, ref) {
+				result.BrokenRefs = append(result.BrokenRefs, ref)
+			}
+		default:
+			result.Problems = append(result.Problems, line)
+		}
+	}
+	return result
+}
+
+// DeleteRef deletes a broken ref. update-ref refuses to delete refs whose
+// contents it can't parse, so for those we remove the loose ref file ourselves.
+func (self *HealthCommands) DeleteRef(ref string) error {
+	cmdArgs := NewGitCmd("update-ref").Arg("-d", "--no-deref", ref).ToArgv()
+
+	err := self.cmd.New(cmdArgs).Run()
+	if err == nil {
+		return nil
+	}
+
+	path := filepath.Join(self.repoPaths.RepoGitDirPath(), filepath.FromSlash(ref))
+	if exists, _ := self.os.FileExists(path); !exists {
+		return err
+	}
+	return self.os.RemoveFile(path)
+}
+
+// IndexSize returns the size in bytes of the index of the current worktree
+func (self *HealthCommands) IndexSize() (int64, error) {
+	info, err := self.Fs.Stat(filepath.Join(self.repoPaths.WorktreeGitDirPath(), "index"))
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// UpgradeIndexVersion switches the index to version 4, which compresses path
+// names and is considerably smaller for repos with many files.
+func (self *HealthCommands) UpgradeIndexVersion() error {
+	cmdArgs := NewGitCmd("update-index").Arg("--index-version", "4").ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// StaleLockFiles returns the *.lock files in the git dir (and the worktree's git
+// dir, if that's a different one) that are old enough that no running git
+// process can still be holding them.
+func (self *HealthCommands) StaleLockFiles() ([]string, error) {
+	dirs := lo.Uniq([]string{self.repoPaths.RepoGitDirPath(), self.repoPaths.WorktreeGitDirPath()})
+	cutoff := time.Now().Add(-staleLockFileAge)
+
+	result := []string{}
+	for _, dir := range dirs {
+		err := afero.Walk(self.Fs, dir, func(path string, info fs.FileInfo, err error) error {
+			if err != nil {
+				return nil
+			}
+			if info.IsDir() {
+				// The objects dir can be huge and never contains lock files that
+				// matter to us. Linked worktrees have their own git dirs, which
+				// we don't want to look into either unless it's ours.
+				if path != dir && (info.Name() == "objects" || info.Name() == "worktrees") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if strings.HasSuffix(info.Name(), ".lock") && info.ModTime().Before(cutoff) {
+				result = append(result, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return lo.Uniq(result), nil
+}
+
+type ObjectCounts struct {
+	LooseObjects int
+	Packs        int
+	Garbage      int
+}
+
+// GcNeeded is true if `git gc --auto` would decide to do some work
+func (self *ObjectCounts) GcNeeded() bool {
+	return self.LooseObjects > gcAutoLooseObjectLimit || self.Packs > gcAutoPackLimit
+}
+
+func (self *HealthCommands) CountObjects() (*ObjectCounts, error) {
+	cmdArgs := NewGitCmd("count-objects").Arg("-v").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCountObjectsOutput(output), nil
+}
+
+func parseCountObjectsOutput(output string) *ObjectCounts {
+	result := &ObjectCounts{}
+	for _, line := range strings.Split(output, "\n") {
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "count":
+			result.LooseObjects = n
+		case "packs":
+			result.Packs = n
+		case "garbage":
+			result.Garbage = n
+		}
+	}
+	return result
+}
+
+// SubmodulesOffRecordedCommit returns the paths of the submodules, including
+// nested ones, whose checked out commit differs from the one recorded in their
+// superproject.
+func (self *HealthCommands) SubmodulesOffRecordedCommit() ([]string, error) {
+	cmdArgs := NewGitCmd("submodule").Arg("status", "--recursive").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSubmoduleStatusOutput(output), nil
+}
+
+// Each line is e.g. "+1234abcd path/to/submodule (heads/main)", where the "+"
+// means that the checked out commit isn't the recorded one
+func parseSubmoduleStatusOutput(output string) []string {
+	paths := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		rest, found := strings.CutPrefix(line, "+")
+		if !found {
+			continue
+		}
+		_, path, found := strings.Cut(rest, " ")
+		if !found {
+			continue
+		}
+		if i := strings.LastIndex(path, " ("); i != -1 && strings.HasSuffix(path, ")") {
+			path = path[:i]
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// IsDetachedHead reports whether the repo at the given path (typically a
+// submodule) has a detached HEAD.
+func (self *HealthCommands) IsDetachedHead(path string) bool {
+	cmdArgs := NewGitCmd("symbolic-ref").Arg("-q", "HEAD").Dir(path).ToArgv()
+
+	// symbolic-ref exits with status 1 if HEAD is not a symbolic ref
+	return self.cmd.New(cmdArgs).DontLog().Run() != nil
+}
+
+func (self *HealthCommands) Gc(pruneNow bool) error {
+	cmdArgs := NewGitCmd("gc").ArgIf(pruneNow, "--prune=now").ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
@@ -0,0 +1,199 @@
+package git_commands
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthFsck(t *testing.T) {
+	type scenario struct {
+		testName      string
+		output        string
+		err           error
+		expected      *FsckResult
+		expectedError bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "healthy repo",
+			output:   "",
+			expected: &FsckResult{},
+		},
+		{
+			testName: "dangling objects only",
+			output:   "notice: HEAD points to an unborn branch (main)\ndangling commit 1234abcd\ndangling blob 5678ef01\n",
+			expected: &FsckResult{
+				DanglingObjects: []string{"dangling commit 1234abcd", "dangling blob 5678ef01"},
+			},
+		},
+		{
+			testName: "problems make fsck fail but are still reported",
+			output:   "broken link from    tree 1234abcd\n              to    blob 5678ef01\nmissing blob 5678ef01\ndangling commit 9abcdef0\n",
+			err:      errors.New("exit status 1"),
+			expected: &FsckResult{
+				DanglingObjects: []string{"dangling commit 9abcdef0"},
+				Problems: []string{
+					"broken link from    tree 1234abcd",
+					"to    blob 5678ef01",
+					"missing blob 5678ef01",
+				},
+			},
+		},
+		{
+			testName: "broken refs are reported separately",
+			output:   "error: refs/heads/garbage: badRefContent: garbage\nerror: refs/heads/missing: invalid sha1 pointer 1111111111111111111111111111111111111111\nerror: refs/heads/missing: invalid reflog entry 1111111111111111111111111111111111111111\n",
+			err:      errors.New("exit status 2"),
+			expected: &FsckResult{
+				BrokenRefs: []string{"refs/heads/garbage", "refs/heads/missing"},
+			},
+		},
+		{
+			testName:      "fsck fails without telling us why",
+			output:        "",
+			err:           errors.New("exit status 128"),
+			expectedError: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"fsck", "--no-progress"}, s.output, s.err)
+			instance := NewHealthCommands(buildGitCommon(commonDeps{runner: runner}))
+
+			result, err := instance.Fsck()
+			if s.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expected, result)
+			}
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestHealthCountObjects(t *testing.T) {
+	type scenario struct {
+		testName         string
+		output           string
+		expected         *ObjectCounts
+		expectedGcNeeded bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName:         "freshly packed repo",
+			output:           "count: 0\nsize: 0\nin-pack: 1200\npacks: 1\nsize-pack: 512\nprune-packable: 0\ngarbage: 0\nsize-garbage: 0\n",
+			expected:         &ObjectCounts{LooseObjects: 0, Packs: 1, Garbage: 0},
+			expectedGcNeeded: false,
+		},
+		{
+			testName:         "too many loose objects",
+			output:           "count: 7000\nsize: 28000\nin-pack: 0\npacks: 0\nsize-pack: 0\nprune-packable: 0\ngarbage: 2\nsize-garbage: 8\n",
+			expected:         &ObjectCounts{LooseObjects: 7000, Packs: 0, Garbage: 2},
+			expectedGcNeeded: true,
+		},
+		{
+			testName:         "too many packs",
+			output:           "count: 10\npacks: 51\n",
+			expected:         &ObjectCounts{LooseObjects: 10, Packs: 51, Garbage: 0},
+			expectedGcNeeded: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"count-objects", "-v"}, s.output, nil)
+			instance := NewHealthCommands(buildGitCommon(commonDeps{runner: runner}))
+
+			result, err := instance.CountObjects()
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, result)
+			assert.Equal(t, s.expectedGcNeeded, result.GcNeeded())
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestHealthSubmodulesOffRecordedCommit(t *testing.T) {
+	output := strings.Join([]string{
+		" 1111111111111111111111111111111111111111 at-recorded-commit (heads/main)",
+		"+2222222222222222222222222222222222222222 moved (heads/main-1-g2222222)",
+		"-3333333333333333333333333333333333333333 uninitialised",
+		"+4444444444444444444444444444444444444444 moved/nested",
+		"",
+	}, "\n")
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"submodule", "status", "--recursive"}, output, nil)
+	instance := NewHealthCommands(buildGitCommon(commonDeps{runner: runner}))
+
+	result, err := instance.SubmodulesOffRecordedCommit()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"moved", "moved/nested"}, result)
+	runner.CheckForMissingCalls()
+}
+
+func TestHealthStaleLockFiles(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	gitDir := filepath.Join("repo", ".git")
+	old := time.Now().Add(-time.Hour)
+
+	for _, path := range []string{"index.lock", "refs/heads/main.lock", "objects/pack/tmp.lock"} {
+		fullPath := filepath.Join(gitDir, path)
+		assert.NoError(t, afero.WriteFile(fs, fullPath, []byte{}, 0o644))
+		assert.NoError(t, fs.Chtimes(fullPath, old, old))
+	}
+	// A fresh lock file probably belongs to a git process that is still running
+	assert.NoError(t, afero.WriteFile(fs, filepath.Join(gitDir, "HEAD.lock"), []byte{}, 0o644))
+
+	instance := NewHealthCommands(buildGitCommon(commonDeps{fs: fs, repoPaths: MockRepoPaths("repo")}))
+
+	result, err := instance.StaleLockFiles()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(gitDir, "index.lock"),
+		filepath.Join(gitDir, "refs/heads/main.lock"),
+	}, result)
+}
+
+func TestHealthGc(t *testing.T) {
+	type scenario struct {
+		testName string
+		pruneNow bool
+		expected []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "plain gc",
+			pruneNow: false,
+			expected: []string{"gc"},
+		},
+		{
+			testName: "prune unreachable objects immediately",
+			pruneNow: true,
+			expected: []string{"gc", "--prune=now"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs(s.expected, "", nil)
+			instance := NewHealthCommands(buildGitCommon(commonDeps{runner: runner}))
+
+			assert.NoError(t, instance.Gc(s.pruneNow))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package git_commands
+
+// A pickaxe search finds the commits whose diff adds or removes a given piece
+// of code: with -S, commits that change the number of occurrences of Text;
+// with -G (Regex is true), commits with an added or removed line matching Text.
+type Pickaxe struct {
+	Text  string
+	Regex bool
+}
+
+func (self Pickaxe) Active() bool {
+	return self.Text != ""
+}
+
+func (self Pickaxe) Arg() string {
+	if self.Regex {
+		return "-G" + self.Text
+	}
+	return "-S" + self.Text
+}
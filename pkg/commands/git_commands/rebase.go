@@ -80,6 +80,36 @@ func (self *RebaseCommands) SetCommitAuthor(commits []*models.Commit, start, end
 	})
 }
 
+func (self *RebaseCommands) ResetCommitAuthorDate(commits []*models.Commit, start, end int) error {
+	return self.GenericAmend(commits, start, end, func(_ *models.Commit) error {
+		return self.commit.ResetAuthorDate()
+	})
+}
+
+// Like SetCommitAuthor, but rewrites the whole range in a single rebase by
+// running `git commit --amend` after each of the commits, rather than
+// stopping at every one of them
+func (self *RebaseCommands) SetCommitAuthorWithExec(commits []*models.Commit, start, end int, value string) error {
+	if len(commits)-1 < end {
+		return errors.New("index outside of range of commits")
+	}
+
+	if self.config.NeedsGpgSubprocessForCommit() {
+		return errors.New(self.Tr.DisabledForGPG)
+	}
+
+	hashes := lo.Map(commits[start:end+1], func(commit *models.Commit, _ int) string {
+		return commit.Hash()
+	})
+	execCommand := "git commit --allow-empty --allow-empty-message --no-edit --amend --author=" + self.cmd.Quote(value)
+
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseHashOrRoot: getBaseHashOrRoot(commits, end+1),
+		exec:           execCommand,
+		instruction:    daemon.NewRemoveExecTodosNotAfterCommitsInstruction(hashes),
+	}).Run()
+}
+
 func (self *RebaseCommands) AddCommitCoAuthor(commits []*models.Commit, start, end int, value string) error {
 	return self.GenericAmend(commits, start, end, func(commit *models.Commit) error {
 		return self.commit.AddCoAuthor(commit.Hash(), value)
@@ -163,7 +193,38 @@ func (self *RebaseCommands) InteractiveRebase(commits []*models.Commit, startIdx
 	}).Run()
 }
 
-func (self *RebaseCommands) EditRebase(branchRef string) error {
+// Like InteractiveRebase, but for a set of commits that doesn't have to be
+// contiguous, given by their indices in the commits slice. Only actions that
+// don't depend on the neighbouring commits (e.g. drop or edit) are supported.
+func (self *RebaseCommands) InteractiveRebaseCommits(commits []*models.Commit, indices []int, action todo.TodoCommand) error {
+	baseHashOrRoot := getBaseHashOrRoot(commits, lo.Max(indices)+1)
+
+	changes := lo.FilterMap(indices, func(idx int, _ int) (daemon.ChangeTodoAction, bool) {
+		return daemon.ChangeTodoAction{
+			Hash:      commits[idx].Hash(),
+			NewAction: action,
+		}, !commits[idx].IsMerge()
+	})
+
+	self.os.LogCommand(logTodoChanges(changes), false)
+
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseHashOrRoot: baseHashOrRoot,
+		overrideEditor: true,
+		instruction:    daemon.NewChangeTodoActionsInstruction(changes),
+	}).Run()
+}
+
+// Options for rebasing a branch that affect the dates of the rebased commits
+type RebaseOpts struct {
+	// Sets the author date of the rebased commits to the current time
+	ResetAuthorDate bool
+	// Keeps the committer date of the rebased commits the same as their
+	// author date, rather than setting it to the current time
+	CommitterDateIsAuthorDate bool
+}
+
+func (self *RebaseCommands) EditRebase(branchRef string, opts RebaseOpts) error {
 	msg := utils.ResolvePlaceholderString(
 		self.Tr.Log.EditRebase,
 		map[string]string{
@@ -174,10 +235,11 @@ func (self *RebaseCommands) EditRebase(branchRef string) error {
 	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
 		baseHashOrRoot: branchRef,
 		instruction:    daemon.NewInsertBreakInstruction(),
+		rebaseOpts:     opts,
 	}).Run()
 }
 
-func (self *RebaseCommands) EditRebaseFromBaseCommit(targetBranchName string, baseCommit string) error {
+func (self *RebaseCommands) EditRebaseFromBaseCommit(targetBranchName string, baseCommit string, opts RebaseOpts) error {
 	msg := utils.ResolvePlaceholderString(
 		self.Tr.Log.EditRebaseFromBaseCommit,
 		map[string]string{
@@ -190,6 +252,7 @@ func (self *RebaseCommands) EditRebaseFromBaseCommit(targetBranchName string, ba
 		baseHashOrRoot: baseCommit,
 		onto:           targetBranchName,
 		instruction:    daemon.NewInsertBreakInstruction(),
+		rebaseOpts:     opts,
 	}).Run()
 }
 
@@ -206,6 +269,8 @@ type PrepareInteractiveRebaseCommandOpts struct {
 	instruction                daemon.Instruction
 	overrideEditor             bool
 	keepCommitsThatBecomeEmpty bool
+	exec                       string
+	rebaseOpts                 RebaseOpts
 }
 
 // PrepareInteractiveRebaseCommand returns the cmd for an interactive rebase
@@ -221,7 +286,10 @@ func (self *RebaseCommands) PrepareInteractiveRebaseCommand(opts PrepareInteract
 		ArgIf(opts.keepCommitsThatBecomeEmpty, "--empty=keep").
 		Arg("--no-autosquash").
 		Arg("--rebase-merges").
+		ArgIf(opts.rebaseOpts.ResetAuthorDate, "--reset-author-date").
+		ArgIf(opts.rebaseOpts.CommitterDateIsAuthorDate, "--committer-date-is-author-date").
 		ArgIf(opts.onto != "", "--onto", opts.onto).
+		ArgIf(opts.exec != "", "--exec", opts.exec).
 		Arg(opts.baseHashOrRoot).
 		ToArgv()
 
@@ -382,6 +450,35 @@ func (self *RebaseCommands) MoveTodosUp(commits []*models.Commit) error {
 	return utils.MoveTodosUp(fileName, todosToMove, true, self.config.GetCoreCommentChar())
 }
 
+// ToggleBreakAfter inserts a break todo after the given todo commit, or removes
+// the one that is already there. A nil commit refers to the beginning of the
+// todo list.
+func (self *RebaseCommands) ToggleBreakAfter(commit *models.Commit) error {
+	fileName := filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-merge/git-rebase-todo")
+	var todoToFind *utils.Todo
+	if commit != nil {
+		todoToFind = lo.ToPtr(todoFromCommit(commit))
+	}
+
+	return utils.ToggleBreakAfterTodo(fileName, todoToFind, self.config.GetCoreCommentChar())
+}
+
+// IsPausedAtBreak returns true if the current interactive rebase stopped
+// because it executed a break todo after applying some commits. We don't count
+// a break at the very beginning, since that's how we start interactive rebases
+// ourselves (see EditRebase).
+func (self *RebaseCommands) IsPausedAtBreak() bool {
+	doneTodos, err := utils.ReadRebaseTodoFile(
+		filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-merge/done"),
+		self.config.GetCoreCommentChar(),
+	)
+	if err != nil || len(doneTodos) < 2 {
+		return false
+	}
+
+	return doneTodos[len(doneTodos)-1].Command == todo.Break
+}
+
 // SquashAllAboveFixupCommits squashes all fixup! commits above the given one
 func (self *RebaseCommands) SquashAllAboveFixupCommits(commit *models.Commit) error {
 	hashOrRoot := commit.Hash() + "^"
@@ -448,14 +545,18 @@ func (self *RebaseCommands) BeginInteractiveRebaseForCommitRange(
 }
 
 // RebaseBranch interactive rebases onto a branch
-func (self *RebaseCommands) RebaseBranch(branchName string) error {
-	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{baseHashOrRoot: branchName}).Run()
+func (self *RebaseCommands) RebaseBranch(branchName string, opts RebaseOpts) error {
+	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
+		baseHashOrRoot: branchName,
+		rebaseOpts:     opts,
+	}).Run()
 }
 
-func (self *RebaseCommands) RebaseBranchFromBaseCommit(targetBranchName string, baseCommit string) error {
+func (self *RebaseCommands) RebaseBranchFromBaseCommit(targetBranchName string, baseCommit string, opts RebaseOpts) error {
 	return self.PrepareInteractiveRebaseCommand(PrepareInteractiveRebaseCommandOpts{
 		baseHashOrRoot: baseCommit,
 		onto:           targetBranchName,
+		rebaseOpts:     opts,
 	}).Run()
 }
 
@@ -19,6 +19,7 @@ func TestRebaseRebaseBranch(t *testing.T) {
 	type scenario struct {
 		testName   string
 		arg        string
+		opts       RebaseOpts
 		gitVersion *GitVersion
 		runner     *oscommands.FakeCmdObjRunner
 		test       func(error)
@@ -45,6 +46,17 @@ func TestRebaseRebaseBranch(t *testing.T) {
 				assert.Error(t, err)
 			},
 		},
+		{
+			testName:   "rebase with date options",
+			arg:        "master",
+			opts:       RebaseOpts{ResetAuthorDate: true, CommitterDateIsAuthorDate: true},
+			gitVersion: &GitVersion{2, 26, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rebase", "--interactive", "--autostash", "--keep-empty", "--no-autosquash", "--rebase-merges", "--reset-author-date", "--committer-date-is-author-date", "master"}, "", nil),
+			test: func(err error) {
+				assert.NoError(t, err)
+			},
+		},
 		{
 			testName:   "successful rebase (< 2.26.0)",
 			arg:        "master",
@@ -60,7 +72,7 @@ func TestRebaseRebaseBranch(t *testing.T) {
 	for _, s := range scenarios {
 		t.Run(s.testName, func(t *testing.T) {
 			instance := buildRebaseCommands(commonDeps{runner: s.runner, gitVersion: s.gitVersion})
-			s.test(instance.RebaseBranch(s.arg))
+			s.test(instance.RebaseBranch(s.arg, s.opts))
 		})
 	}
 }
@@ -168,3 +180,88 @@ func TestRebaseDiscardOldFileChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestRebaseSetCommitAuthorWithExec(t *testing.T) {
+	type scenario struct {
+		testName               string
+		gitConfigMockResponses map[string]string
+		start                  int
+		end                    int
+		runner                 *oscommands.FakeCmdObjRunner
+		test                   func(error)
+	}
+
+	commitOpts := []models.NewCommitOpts{
+		{Name: "commit1", Hash: "111111"},
+		{Name: "commit2", Hash: "222222"},
+		{Name: "commit3", Hash: "333333"},
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "returns error when index outside of range of commits",
+			start:    0,
+			end:      3,
+			runner:   oscommands.NewFakeRunner(t),
+			test: func(err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			testName:               "returns error when using gpg",
+			gitConfigMockResponses: map[string]string{"commit.gpgSign": "true"},
+			start:                  0,
+			end:                    1,
+			runner:                 oscommands.NewFakeRunner(t),
+			test: func(err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			testName: "rebases with an exec command after the selected commits",
+			start:    1,
+			end:      1,
+			runner: oscommands.NewFakeRunner(t).ExpectFunc("rebase with exec", func(cmdObj *oscommands.CmdObj) bool {
+				assert.EqualValues(t, []string{
+					"git", "rebase", "--interactive", "--autostash", "--keep-empty", "--no-autosquash", "--rebase-merges",
+					"--exec", `git commit --allow-empty --allow-empty-message --no-edit --amend --author="John Smith <john@example.com>"`,
+					"333333",
+				}, cmdObj.Args())
+				return lo.Contains(cmdObj.GetEnvVars(),
+					daemon.DaemonKindEnvKey+"="+strconv.Itoa(int(daemon.DaemonKindRemoveExecTodosNotAfterCommits)))
+			}, "", nil),
+			test: func(err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "rebases from the root when the range includes the first commit",
+			start:    0,
+			end:      2,
+			runner: oscommands.NewFakeRunner(t).ExpectFunc("rebase with exec from root", func(cmdObj *oscommands.CmdObj) bool {
+				args := cmdObj.Args()
+				return args[len(args)-1] == "--root"
+			}, "", nil),
+			test: func(err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildRebaseCommands(commonDeps{
+				runner:     s.runner,
+				gitVersion: &GitVersion{2, 26, 0, ""},
+				gitConfig:  git_config.NewFakeGitConfig(s.gitConfigMockResponses),
+			})
+
+			hashPool := &utils.StringPool{}
+			commits := lo.Map(commitOpts,
+				func(opts models.NewCommitOpts, _ int) *models.Commit { return models.NewCommit(hashPool, opts) })
+
+			s.test(instance.SetCommitAuthorWithExec(commits, s.start, s.end, "John Smith <john@example.com>"))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -23,13 +23,15 @@ func NewReflogCommitLoader(common *common.Common, cmd oscommands.ICmdObjBuilder)
 }
 
 // GetReflogCommits only returns the new reflog commits since the given lastReflogCommit
-// if none is passed (i.e. it's value is nil) then we get all the reflog commits
-func (self *ReflogCommitLoader) GetReflogCommits(hashPool *utils.StringPool, lastReflogCommit *models.Commit, filterPath string, filterAuthor string) ([]*models.Commit, bool, error) {
+// if none is passed (i.e. it's value is nil) then we get all the reflog commits.
+// If ref is empty we get the reflog of HEAD.
+func (self *ReflogCommitLoader) GetReflogCommits(hashPool *utils.StringPool, lastReflogCommit *models.Commit, ref string, filterPath string, filterAuthor string) ([]*models.Commit, bool, error) {
 	cmdArgs := NewGitCmd("log").
 		Config("log.showSignature=false").
 		Arg("-g").
 		Arg("--format=+%H%x00%ct%x00%gs%x00%P").
 		ArgIf(filterAuthor != "", "--author="+filterAuthor).
+		ArgIf(ref != "", ref).
 		ArgIf(filterPath != "", "--follow", "--name-status", "--", filterPath).
 		ToArgv()
 
@@ -0,0 +1,185 @@
+package git_commands
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/generics/set"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+)
+
+type RerereCommands struct {
+	*GitCommon
+}
+
+func NewRerereCommands(gitCommon *GitCommon) *RerereCommands {
+	return &RerereCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// A resolution that rerere has recorded in .git/rr-cache, identified by the
+// hash of the conflict it resolves.
+type RerereResolution struct {
+	ID   string
+	Date time.Time
+	// The first line of our side of the conflict, to give the user an idea
+	// which conflict this is about; rerere doesn't remember file names.
+	Preview string
+}
+
+func (self *RerereCommands) IsEnabled() bool {
+	return self.config.gitConfig.GetBool("rerere.enabled")
+}
+
+func (self *RerereCommands) SetEnabled(enabled bool) error {
+	value := "false"
+	if enabled {
+		value = "true"
+	}
+	cmdArgs := NewGitCmd("config").Arg("--local", "rerere.enabled", value).ToArgv()
+
+	if err := self.cmd.New(cmdArgs).Run(); err != nil {
+		return err
+	}
+	self.config.DropConfigCache()
+	return nil
+}
+
+func (self *RerereCommands) rrCacheDir() string {
+	return filepath.Join(self.repoPaths.RepoGitDirPath(), "rr-cache")
+}
+
+// RecordedResolutions returns the resolutions in the rr-cache, most recent
+// first. Conflicts that rerere has seen but that were never resolved have no
+// postimage and are left out.
+func (self *RerereCommands) RecordedResolutions() ([]*RerereResolution, error) {
+	entries, err := afero.ReadDir(self.Fs, self.rrCacheDir())
+	if err != nil {
+		if exists, _ := afero.DirExists(self.Fs, self.rrCacheDir()); !exists {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	result := []*RerereResolution{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(self.rrCacheDir(), entry.Name())
+		postimage, err := self.Fs.Stat(filepath.Join(dir, "postimage"))
+		if err != nil {
+			continue
+		}
+		preimage, _ := afero.ReadFile(self.Fs, filepath.Join(dir, "preimage"))
+		result = append(result, &RerereResolution{
+			ID:      entry.Name(),
+			Date:    postimage.ModTime(),
+			Preview: conflictPreview(string(preimage)),
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Date.After(result[j].Date)
+	})
+	return result, nil
+}
+
+func conflictPreview(preimage string) string {
+	inConflict := false
+	for _, line := range strings.Split(preimage, "\n") {
+		if strings.HasPrefix(line, "<<<<<<<") {
+			inConflict = true
+			continue
+		}
+		if inConflict && strings.TrimSpace(line) != "" {
+			return strings.TrimSpace(line)
+		}
+	}
+	return ""
+}
+
+// DeleteRecordedResolution removes a resolution from the rr-cache, so that
+// rerere will no longer apply it.
+func (self *RerereCommands) DeleteRecordedResolution(id string) error {
+	return self.Fs.RemoveAll(filepath.Join(self.rrCacheDir(), id))
+}
+
+// ResolvedPaths returns the paths in the current merge whose conflicts rerere
+// resolved by replaying a previously recorded resolution. rerere tracks the
+// conflicts that it couldn't resolve in MERGE_RR until the merge is committed,
+// so any content conflict that isn't listed there was resolved by rerere. We
+// look at the resolve-undo information as well as the unmerged index entries
+// so that this keeps working after the file has been staged.
+func (self *RerereCommands) ResolvedPaths() []string {
+	if !self.IsEnabled() {
+		return nil
+	}
+
+	unmergedArgs := NewGitCmd("ls-files").Arg("-u", "-z").ToArgv()
+	unmerged, err := self.cmd.New(unmergedArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil
+	}
+	resolveUndoArgs := NewGitCmd("ls-files").Arg("--resolve-undo", "-z").ToArgv()
+	resolveUndo, err := self.cmd.New(resolveUndoArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil
+	}
+
+	unresolved := set.New[string]()
+	content, _ := afero.ReadFile(self.Fs, filepath.Join(self.repoPaths.WorktreeGitDirPath(), "MERGE_RR"))
+	for _, entry := range strings.Split(string(content), "\x00") {
+		if _, path, found := strings.Cut(entry, "\t"); found {
+			unresolved.Add(path)
+		}
+	}
+
+	return lo.Filter(contentConflictPaths(unmerged+resolveUndo), func(path string, _ int) bool {
+		return !unresolved.Includes(path)
+	})
+}
+
+// contentConflictPaths parses the output of `git ls-files -u -z` and returns the
+// paths that have both our and their version, which are the only conflicts
+// rerere deals with.
+func contentConflictPaths(output string) []string {
+	stagesByPath := map[string][]string{}
+	paths := []string{}
+	for _, entry := range strings.Split(output, "\x00") {
+		info, path, found := strings.Cut(entry, "\t")
+		if !found {
+			continue
+		}
+		fields := strings.Fields(info)
+		if len(fields) != 3 {
+			continue
+		}
+		if _, ok := stagesByPath[path]; !ok {
+			paths = append(paths, path)
+		}
+		stagesByPath[path] = append(stagesByPath[path], fields[2])
+	}
+
+	return lo.Filter(paths, func(path string, _ int) bool {
+		return lo.Contains(stagesByPath[path], "2") && lo.Contains(stagesByPath[path], "3")
+	})
+}
+
+// ForgetResolution makes rerere forget how it resolved the conflict in the given
+// path. rerere can only do this while the file is conflicted, so we recreate
+// the conflict first (git remembers it even after the file has been staged);
+// this also throws away the automatic resolution in the working tree.
+func (self *RerereCommands) ForgetResolution(path string) error {
+	checkoutArgs := NewGitCmd("checkout").Arg("-m", "--", path).ToArgv()
+	if err := self.cmd.New(checkoutArgs).Run(); err != nil {
+		return err
+	}
+
+	cmdArgs := NewGitCmd("rerere").Arg("forget", "--", path).ToArgv()
+	return self.cmd.New(cmdArgs).Run()
+}
@@ -0,0 +1,123 @@
+package git_commands
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_config"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRerereSetEnabled(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"config", "--local", "rerere.enabled", "true"}, "", nil).
+		ExpectGitArgs([]string{"config", "--local", "rerere.enabled", "false"}, "", nil)
+	instance := NewRerereCommands(buildGitCommon(commonDeps{runner: runner}))
+
+	assert.NoError(t, instance.SetEnabled(true))
+	assert.NoError(t, instance.SetEnabled(false))
+	runner.CheckForMissingCalls()
+}
+
+func TestRerereRecordedResolutions(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	rrCache := filepath.Join("repo", ".git", "rr-cache")
+	older := time.Now().Add(-48 * time.Hour)
+	newer := time.Now().Add(-time.Hour)
+
+	writeFile := func(path string, content string, modTime time.Time) {
+		fullPath := filepath.Join(rrCache, path)
+		assert.NoError(t, afero.WriteFile(fs, fullPath, []byte(content), 0o644))
+		assert.NoError(t, fs.Chtimes(fullPath, modTime, modTime))
+	}
+
+	writeFile("aaaa/preimage", "line\n<<<<<<<\n\nours\n=======\ntheirs\n>>>>>>>\n", older)
+	writeFile("aaaa/postimage", "line\nours\n", older)
+	writeFile("bbbb/preimage", "<<<<<<<\nfoo\n=======\nbar\n>>>>>>>\n", newer)
+	writeFile("bbbb/postimage", "foobar\n", newer)
+	// never resolved
+	writeFile("cccc/preimage", "<<<<<<<\nx\n=======\ny\n>>>>>>>\n", newer)
+
+	instance := NewRerereCommands(buildGitCommon(commonDeps{fs: fs, repoPaths: MockRepoPaths("repo")}))
+
+	result, err := instance.RecordedResolutions()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bbbb", "aaaa"}, lo.Map(result, func(r *RerereResolution, _ int) string { return r.ID }))
+	assert.Equal(t, []string{"foo", "ours"}, lo.Map(result, func(r *RerereResolution, _ int) string { return r.Preview }))
+
+	assert.NoError(t, instance.DeleteRecordedResolution("bbbb"))
+	result, err = instance.RecordedResolutions()
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+}
+
+func TestRerereRecordedResolutionsWithoutCache(t *testing.T) {
+	instance := NewRerereCommands(buildGitCommon(commonDeps{fs: afero.NewMemMapFs(), repoPaths: MockRepoPaths("repo")}))
+
+	result, err := instance.RecordedResolutions()
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestRerereResolvedPaths(t *testing.T) {
+	unmerged := "100644 1111 1\tauto.txt\x00100644 2222 2\tauto.txt\x00100644 3333 3\tauto.txt\x00" +
+		"100644 1111 1\tmanual.txt\x00100644 2222 2\tmanual.txt\x00100644 3333 3\tmanual.txt\x00" +
+		"100644 1111 1\tdeleted.txt\x00100644 2222 2\tdeleted.txt\x00"
+	resolveUndo := "100644 1111 1\tstaged.txt\x00100644 2222 2\tstaged.txt\x00100644 3333 3\tstaged.txt\x00"
+
+	type scenario struct {
+		testName string
+		enabled  bool
+		runner   *oscommands.FakeCmdObjRunner
+		expected []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "rerere disabled",
+			enabled:  false,
+			runner:   oscommands.NewFakeRunner(t),
+			expected: nil,
+		},
+		{
+			testName: "conflicts that rerere couldn't resolve are listed in MERGE_RR",
+			enabled:  true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"ls-files", "-u", "-z"}, unmerged, nil).
+				ExpectGitArgs([]string{"ls-files", "--resolve-undo", "-z"}, resolveUndo, nil),
+			expected: []string{"auto.txt", "staged.txt"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			assert.NoError(t, afero.WriteFile(fs, filepath.Join("repo", ".git", "MERGE_RR"),
+				[]byte("aaaa\tmanual.txt\x00"), 0o644))
+			gitConfig := git_config.NewFakeGitConfig(map[string]string{"rerere.enabled": lo.Ternary(s.enabled, "true", "false")})
+			instance := NewRerereCommands(buildGitCommon(commonDeps{
+				runner:    s.runner,
+				fs:        fs,
+				repoPaths: MockRepoPaths("repo"),
+				gitConfig: gitConfig,
+			}))
+
+			assert.Equal(t, s.expected, instance.ResolvedPaths())
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestRerereForgetResolution(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"checkout", "-m", "--", "file.txt"}, "", nil).
+		ExpectGitArgs([]string{"rerere", "forget", "--", "file.txt"}, "", nil)
+	instance := NewRerereCommands(buildGitCommon(commonDeps{runner: runner}))
+
+	assert.NoError(t, instance.ForgetResolution("file.txt"))
+	runner.CheckForMissingCalls()
+}
@@ -0,0 +1,363 @@
+package git_commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+)
+
+const (
+	snapshotRefPrefix      = "refs/lazygit/snapshots/"
+	discardBackupRefPrefix = "refs/lazygit/discard-backups/"
+)
+
+type SnapshotCommands struct {
+	*GitCommon
+}
+
+func NewSnapshotCommands(gitCommon *GitCommon) *SnapshotCommands {
+	return &SnapshotCommands{
+		GitCommon: gitCommon,
+	}
+}
+
+// A snapshot of the repo taken before a destructive operation. It's stored as
+// a commit whose parent is the commit that HEAD (or a deleted branch) pointed
+// to, and whose tree is the working tree at the time. The commit is kept alive
+// by a ref under refs/lazygit/snapshots, or under refs/lazygit/discard-backups
+// for backups taken before discarding changes to individual files. The commits
+// view hides these refs when showing the whole graph.
+type Snapshot struct {
+	Ref  string
+	Hash string
+	// The commit that HEAD (or the deleted branch) pointed to, or "" if the
+	// snapshot was taken before the first commit
+	ParentHash string
+	Date       time.Time
+	// What the snapshot was taken before, e.g. "Hard reset"
+	Reason string
+	// If the snapshot was taken before deleting a branch, the name of that
+	// branch; restoring the snapshot recreates it. Otherwise, restoring resets
+	// to the commit HEAD pointed to and brings back the working tree.
+	Branch string
+	// The branch that was checked out when the snapshot was taken, or "" if
+	// HEAD was detached
+	CheckedOutBranch string
+	// If this is a discard backup, the paths whose changes were discarded
+	Paths []string
+}
+
+// Saves the state of HEAD and the working tree. The snapshot's tree is built
+// in a temporary copy of the index so that the real index is left alone; as a
+// consequence the distinction between staged and unstaged changes is not kept.
+// Untracked files are only included if includeUntracked is true, because they
+// are written to the object database, which can be costly for large files.
+func (self *SnapshotCommands) CreateForWorkingTree(reason string, includeUntracked bool) error {
+	addCmdArgs := NewGitCmd("add").ArgIfElse(includeUntracked, "--all", "--update").ToArgv()
+	tree, err := self.writeWorkingTree([][]string{addCmdArgs})
+	if err != nil {
+		return err
+	}
+
+	parent, err := self.headCommit()
+	if err != nil {
+		return err
+	}
+
+	return self.create(snapshotRefPrefix, tree, parent, reason+self.checkedOutBranchTrailer())
+}
+
+// Saves the given paths as they are in the working tree before their changes
+// are discarded, so that the discard can be undone. Only these paths are
+// written to the object database; the rest of the backup's tree is the index.
+func (self *SnapshotCommands) CreateDiscardBackup(paths []string, reason string) error {
+	// git add fails for paths that exist neither in the working tree nor in
+	// the index (e.g. staged deletions), so we remove the missing ones from
+	// the index instead, which ignores paths that aren't there
+	existingPaths := lo.Filter(paths, func(path string, _ int) bool {
+		return self.existsInWorktree(path)
+	})
+	missingPaths, _ := lo.Difference(paths, existingPaths)
+	cmds := [][]string{}
+	if len(existingPaths) > 0 {
+		cmds = append(cmds, NewGitCmd("add").Arg("--all", "--").Arg(existingPaths...).ToArgv())
+	}
+	if len(missingPaths) > 0 {
+		cmds = append(cmds, NewGitCmd("rm").Arg("-r", "--cached", "--ignore-unmatch", "--quiet", "--").Arg(missingPaths...).ToArgv())
+	}
+
+	tree, err := self.writeWorkingTree(cmds)
+	if err != nil {
+		return err
+	}
+
+	parent, err := self.headCommit()
+	if err != nil {
+		return err
+	}
+
+	message := reason + "\n"
+	for _, path := range paths {
+		message += "\nPath: " + path
+	}
+
+	return self.create(discardBackupRefPrefix, tree, parent, message)
+}
+
+// Uses lstat so that symlinks whose target is missing count as existing
+func (self *SnapshotCommands) existsInWorktree(path string) bool {
+	absPath := filepath.Join(self.repoPaths.WorktreePath(), path)
+	if lstater, ok := self.Fs.(afero.Lstater); ok {
+		_, _, err := lstater.LstatIfPossible(absPath)
+		return err == nil
+	}
+
+	_, err := self.Fs.Stat(absPath)
+	return err == nil
+}
+
+// Runs the given commands in a temporary copy of the index, and returns the
+// resulting tree
+func (self *SnapshotCommands) writeWorkingTree(cmds [][]string) (string, error) {
+	indexPath := filepath.Join(self.repoPaths.WorktreeGitDirPath(), "lazygit-snapshot-index")
+	defer func() { _ = self.Fs.Remove(indexPath) }()
+	env := "GIT_INDEX_FILE=" + indexPath
+
+	// In a new repo there may be no index yet, in which case git starts from
+	// an empty one
+	indexContent, err := afero.ReadFile(self.Fs, filepath.Join(self.repoPaths.WorktreeGitDirPath(), "index"))
+	if err == nil {
+		if err := afero.WriteFile(self.Fs, indexPath, indexContent, 0o644); err != nil {
+			return "", err
+		}
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	for _, cmdArgs := range cmds {
+		if err := self.cmd.New(cmdArgs).AddEnvVars(env).DontLog().Run(); err != nil {
+			return "", err
+		}
+	}
+
+	tree, err := self.cmd.New(NewGitCmd("write-tree").ToArgv()).AddEnvVars(env).DontLog().RunWithOutput()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(tree), nil
+}
+
+// Returns the commit that HEAD points to, or "" if there are no commits yet
+func (self *SnapshotCommands) headCommit() (string, error) {
+	output, err := self.cmd.New(NewGitCmd("rev-parse").Arg("--verify", "--quiet", "HEAD").ToArgv()).
+		DontLog().RunWithOutput()
+	if err != nil {
+		// rev-parse --verify --quiet exits with 1 without printing anything if
+		// HEAD doesn't point to a commit
+		if output == "" {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return strings.TrimSpace(output), nil
+}
+
+// Returns a line recording the checked-out branch, to be added to the message
+// of a snapshot, or "" if HEAD is detached
+func (self *SnapshotCommands) checkedOutBranchTrailer() string {
+	output, err := self.cmd.New(NewGitCmd("symbolic-ref").Arg("--quiet", "--short", "HEAD").ToArgv()).
+		DontLog().RunWithOutput()
+	if err != nil {
+		return ""
+	}
+
+	return "\n\nChecked out: " + strings.TrimSpace(output)
+}
+
+// Saves the commit that the given branch points to, so that the branch can
+// be recreated after it's been deleted
+func (self *SnapshotCommands) CreateForBranch(branchName string, reason string) error {
+	ref := "refs/heads/" + branchName
+	return self.create(snapshotRefPrefix, ref+"^{tree}", ref, reason+"\n\nBranch: "+branchName)
+}
+
+// Creates the snapshot commit and its ref. parent may be "" if HEAD is unborn.
+func (self *SnapshotCommands) create(refPrefix string, tree string, parent string, message string) error {
+	cmdArgs := NewGitCmd("commit-tree").
+		Arg(tree).
+		ArgIf(parent != "", "-p", parent).
+		Arg("-m", message).
+		ToArgv()
+
+	hash, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return err
+	}
+
+	ref := refPrefix + strconv.FormatInt(time.Now().UnixNano(), 10)
+	cmdArgs = NewGitCmd("update-ref").Arg(ref, strings.TrimSpace(hash)).ToArgv()
+	if err := self.cmd.New(cmdArgs).DontLog().Run(); err != nil {
+		return err
+	}
+
+	return self.prune(refPrefix)
+}
+
+// Deletes the oldest snapshots so that no more than the configured number
+// are kept
+func (self *SnapshotCommands) prune(refPrefix string) error {
+	snapshots, err := self.list(refPrefix)
+	if err != nil {
+		return err
+	}
+
+	maxCount := self.UserConfig().Git.Snapshots.MaxCount
+	if len(snapshots) <= maxCount {
+		return nil
+	}
+
+	for _, snapshot := range snapshots[maxCount:] {
+		if err := self.Delete(snapshot); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns the snapshots, most recent first
+func (self *SnapshotCommands) List() ([]*Snapshot, error) {
+	return self.list(snapshotRefPrefix)
+}
+
+// Returns the discard backups, most recent first
+func (self *SnapshotCommands) ListDiscardBackups() ([]*Snapshot, error) {
+	return self.list(discardBackupRefPrefix)
+}
+
+// Returns the most recent discard backup if the discard happened after the
+// last entry in HEAD's reflog, meaning that it's the next thing to undo
+func (self *SnapshotCommands) DiscardBackupToUndo() (*Snapshot, error) {
+	backups, err := self.ListDiscardBackups()
+	if err != nil || len(backups) == 0 {
+		return nil, err
+	}
+
+	// With --date=unix, the reflog selector contains the time of the entry,
+	// e.g. HEAD@{1700000000}
+	cmdArgs := NewGitCmd("log").
+		Arg("-g", "-1", "--format=%gd", "--date=unix").
+		ToArgv()
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	selector := strings.TrimSpace(output)
+	if start := strings.LastIndex(selector, "{"); start != -1 {
+		seconds, err := strconv.ParseInt(strings.TrimSuffix(selector[start+1:], "}"), 10, 64)
+		if err == nil && backups[0].Date.Unix() < seconds {
+			return nil, nil
+		}
+	}
+
+	return backups[0], nil
+}
+
+func (self *SnapshotCommands) list(refPrefix string) ([]*Snapshot, error) {
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--sort=-refname", "--format=%(refname)%00%(objectname)%00%(parent)%00%(contents:subject)%00%(contents:body)%01").
+		Arg(refPrefix).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.FilterMap(strings.Split(output, "\x01"), func(record string, _ int) (*Snapshot, bool) {
+		fields := strings.SplitN(strings.TrimPrefix(record, "\n"), "\x00", 5)
+		if len(fields) < 5 {
+			return nil, false
+		}
+
+		nanos, err := strconv.ParseInt(strings.TrimPrefix(fields[0], refPrefix), 10, 64)
+		if err != nil {
+			return nil, false
+		}
+
+		snapshot := &Snapshot{
+			Ref:        fields[0],
+			Hash:       fields[1],
+			ParentHash: fields[2],
+			Date:       time.Unix(0, nanos),
+			Reason:     fields[3],
+		}
+		for _, line := range strings.Split(fields[4], "\n") {
+			if branch, ok := strings.CutPrefix(line, "Branch: "); ok {
+				snapshot.Branch = branch
+			} else if branch, ok := strings.CutPrefix(line, "Checked out: "); ok {
+				snapshot.CheckedOutBranch = branch
+			} else if path, ok := strings.CutPrefix(line, "Path: "); ok {
+				snapshot.Paths = append(snapshot.Paths, path)
+			}
+		}
+
+		return snapshot, true
+	}), nil
+}
+
+func (self *SnapshotCommands) Delete(snapshot *Snapshot) error {
+	cmdArgs := NewGitCmd("update-ref").Arg("-d", snapshot.Ref).ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog().Run()
+}
+
+// Resets the checked-out branch to the commit that HEAD pointed to when the
+// snapshot was taken, and puts back the working tree as it was. Files that
+// didn't exist back then are left alone. If the snapshot was taken before the
+// first commit, only the working tree is put back.
+func (self *SnapshotCommands) RestoreWorkingTree(snapshot *Snapshot) error {
+	if snapshot.ParentHash != "" {
+		cmdArgs := NewGitCmd("reset").Arg("--hard", snapshot.ParentHash).ToArgv()
+		if err := self.cmd.New(cmdArgs).Run(); err != nil {
+			return err
+		}
+	}
+
+	cmdArgs := NewGitCmd("restore").
+		Arg(fmt.Sprintf("--source=%s", snapshot.Hash), "--worktree", "--", ".").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Recreates the branch that was deleted when the snapshot was taken
+func (self *SnapshotCommands) RestoreBranch(snapshot *Snapshot) error {
+	cmdArgs := NewGitCmd("branch").Arg(snapshot.Branch, snapshot.ParentHash).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Puts back the discarded changes to the backup's paths, and deletes the
+// backup so that it isn't restored twice. Staged changes come back as
+// unstaged.
+func (self *SnapshotCommands) RestoreDiscardBackup(snapshot *Snapshot) error {
+	cmdArgs := NewGitCmd("restore").
+		Arg(fmt.Sprintf("--source=%s", snapshot.Hash), "--worktree", "--").
+		Arg(snapshot.Paths...).
+		ToArgv()
+	if err := self.cmd.New(cmdArgs).Run(); err != nil {
+		return err
+	}
+
+	return self.Delete(snapshot)
+}
@@ -0,0 +1,239 @@
+package git_commands
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+var snapshotListArgs = []string{
+	"for-each-ref",
+	"--sort=-refname",
+	"--format=%(refname)%00%(objectname)%00%(parent)%00%(contents:subject)%00%(contents:body)%01",
+	"refs/lazygit/snapshots/",
+}
+
+func snapshotRecord(nanos string, hash string, parent string, subject string, body string) string {
+	return "refs/lazygit/snapshots/" + nanos + "\x00" + hash + "\x00" + parent + "\x00" + subject + "\x00" + body + "\x01\n"
+}
+
+func TestSnapshotList(t *testing.T) {
+	output := snapshotRecord("1700000000000000003", "ccc", "", "Nuke working tree", "") +
+		snapshotRecord("1700000000000000002", "bbb", "222", "Delete branch", "Branch: feature\n") +
+		snapshotRecord("1700000000000000001", "aaa", "111", "Hard reset", "Checked out: main\n")
+
+	runner := oscommands.NewFakeRunner(t).ExpectGitArgs(snapshotListArgs, output, nil)
+	instance := NewSnapshotCommands(buildGitCommon(commonDeps{runner: runner}))
+
+	snapshots, err := instance.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []*Snapshot{
+		{
+			Ref:    "refs/lazygit/snapshots/1700000000000000003",
+			Hash:   "ccc",
+			Date:   time.Unix(0, 1700000000000000003),
+			Reason: "Nuke working tree",
+		},
+		{
+			Ref:        "refs/lazygit/snapshots/1700000000000000002",
+			Hash:       "bbb",
+			ParentHash: "222",
+			Date:       time.Unix(0, 1700000000000000002),
+			Reason:     "Delete branch",
+			Branch:     "feature",
+		},
+		{
+			Ref:              "refs/lazygit/snapshots/1700000000000000001",
+			Hash:             "aaa",
+			ParentHash:       "111",
+			Date:             time.Unix(0, 1700000000000000001),
+			Reason:           "Hard reset",
+			CheckedOutBranch: "main",
+		},
+	}, snapshots)
+	runner.CheckForMissingCalls()
+}
+
+func TestSnapshotCreateForWorkingTree(t *testing.T) {
+	scenarios := []struct {
+		testName         string
+		includeUntracked bool
+		expectedAddArgs  []string
+		revParseOutput   string
+		revParseError    error
+		expectedTreeArgs []string
+	}{
+		{
+			testName:         "tracked files only",
+			includeUntracked: false,
+			expectedAddArgs:  []string{"add", "--update"},
+			revParseOutput:   "head\n",
+			expectedTreeArgs: []string{"commit-tree", "tree", "-p", "head", "-m", "Hard reset\n\nChecked out: main"},
+		},
+		{
+			testName:         "with untracked files",
+			includeUntracked: true,
+			expectedAddArgs:  []string{"add", "--all"},
+			revParseOutput:   "head\n",
+			expectedTreeArgs: []string{"commit-tree", "tree", "-p", "head", "-m", "Hard reset\n\nChecked out: main"},
+		},
+		{
+			testName:         "unborn HEAD",
+			includeUntracked: true,
+			expectedAddArgs:  []string{"add", "--all"},
+			revParseOutput:   "",
+			revParseError:    errors.New("exit status 1"),
+			expectedTreeArgs: []string{"commit-tree", "tree", "-m", "Hard reset\n\nChecked out: main"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			assert.NoError(t, afero.WriteFile(fs, ".git/.git/index", []byte("index"), 0o644))
+
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs(s.expectedAddArgs, "", nil).
+				ExpectGitArgs([]string{"write-tree"}, "tree\n", nil).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "HEAD"}, s.revParseOutput, s.revParseError).
+				ExpectGitArgs([]string{"symbolic-ref", "--quiet", "--short", "HEAD"}, "main\n", nil).
+				ExpectGitArgs(s.expectedTreeArgs, "snapshot\n", nil).
+				ExpectFunc("creates snapshot ref", func(cmdObj *oscommands.CmdObj) bool {
+					args := cmdObj.Args()
+					return len(args) == 4 && args[1] == "update-ref" && strings.HasPrefix(args[2], "refs/lazygit/snapshots/") && args[3] == "snapshot"
+				}, "", nil).
+				ExpectGitArgs(snapshotListArgs, "", nil)
+			instance := NewSnapshotCommands(buildGitCommon(commonDeps{runner: runner, fs: fs}))
+
+			assert.NoError(t, instance.CreateForWorkingTree("Hard reset", s.includeUntracked))
+			runner.CheckForMissingCalls()
+
+			// the temporary copy of the index is removed again
+			exists, _ := afero.Exists(fs, ".git/.git/lazygit-snapshot-index")
+			assert.False(t, exists)
+		})
+	}
+}
+
+func TestSnapshotCreateForBranchPrunesOldSnapshots(t *testing.T) {
+	userConfig := config.GetDefaultConfig()
+	userConfig.Git.Snapshots.MaxCount = 2
+
+	output := snapshotRecord("1700000000000000003", "ccc", "333", "Delete branch", "Branch: feature\n") +
+		snapshotRecord("1700000000000000002", "bbb", "222", "Hard reset", "") +
+		snapshotRecord("1700000000000000001", "aaa", "111", "Hard reset", "")
+
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"commit-tree", "refs/heads/feature^{tree}", "-p", "refs/heads/feature", "-m", "Delete branch\n\nBranch: feature"}, "ccc\n", nil).
+		ExpectFunc("creates snapshot ref", func(cmdObj *oscommands.CmdObj) bool {
+			args := cmdObj.Args()
+			return len(args) == 4 && args[1] == "update-ref" && strings.HasPrefix(args[2], "refs/lazygit/snapshots/") && args[3] == "ccc"
+		}, "", nil).
+		ExpectGitArgs(snapshotListArgs, output, nil).
+		ExpectGitArgs([]string{"update-ref", "-d", "refs/lazygit/snapshots/1700000000000000001"}, "", nil)
+	instance := NewSnapshotCommands(buildGitCommon(commonDeps{runner: runner, userConfig: userConfig}))
+
+	assert.NoError(t, instance.CreateForBranch("feature", "Delete branch"))
+	runner.CheckForMissingCalls()
+}
+
+func TestSnapshotCreateDiscardBackup(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	assert.NoError(t, afero.WriteFile(fs, ".git/.git/index", []byte("index"), 0o644))
+	assert.NoError(t, afero.WriteFile(fs, ".git/modified", []byte("content"), 0o644))
+
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"add", "--all", "--", "modified"}, "", nil).
+		ExpectGitArgs([]string{"rm", "-r", "--cached", "--ignore-unmatch", "--quiet", "--", "deleted"}, "", nil).
+		ExpectGitArgs([]string{"write-tree"}, "tree\n", nil).
+		ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "HEAD"}, "head\n", nil).
+		ExpectGitArgs([]string{"commit-tree", "tree", "-p", "head", "-m", "Discard all changes in file\n\nPath: modified\nPath: deleted"}, "backup\n", nil).
+		ExpectFunc("creates backup ref", func(cmdObj *oscommands.CmdObj) bool {
+			args := cmdObj.Args()
+			return len(args) == 4 && args[1] == "update-ref" && strings.HasPrefix(args[2], "refs/lazygit/discard-backups/") && args[3] == "backup"
+		}, "", nil).
+		ExpectGitArgs([]string{
+			"for-each-ref",
+			"--sort=-refname",
+			"--format=%(refname)%00%(objectname)%00%(parent)%00%(contents:subject)%00%(contents:body)%01",
+			"refs/lazygit/discard-backups/",
+		}, "", nil)
+	instance := NewSnapshotCommands(buildGitCommon(commonDeps{runner: runner, fs: fs}))
+
+	assert.NoError(t, instance.CreateDiscardBackup([]string{"modified", "deleted"}, "Discard all changes in file"))
+	runner.CheckForMissingCalls()
+}
+
+func TestSnapshotRestoreDiscardBackup(t *testing.T) {
+	output := "refs/lazygit/discard-backups/1700000000000000001\x00aaa\x00111\x00Discard all changes in file\x00Path: dir/a\nPath: b\n\x01\n"
+
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{
+			"for-each-ref",
+			"--sort=-refname",
+			"--format=%(refname)%00%(objectname)%00%(parent)%00%(contents:subject)%00%(contents:body)%01",
+			"refs/lazygit/discard-backups/",
+		}, output, nil).
+		ExpectGitArgs([]string{"restore", "--source=aaa", "--worktree", "--", "dir/a", "b"}, "", nil).
+		ExpectGitArgs([]string{"update-ref", "-d", "refs/lazygit/discard-backups/1700000000000000001"}, "", nil)
+	instance := NewSnapshotCommands(buildGitCommon(commonDeps{runner: runner}))
+
+	backups, err := instance.ListDiscardBackups()
+	assert.NoError(t, err)
+	assert.Len(t, backups, 1)
+	assert.Equal(t, []string{"dir/a", "b"}, backups[0].Paths)
+
+	assert.NoError(t, instance.RestoreDiscardBackup(backups[0]))
+	runner.CheckForMissingCalls()
+}
+
+func TestSnapshotDiscardBackupToUndo(t *testing.T) {
+	backupsOutput := "refs/lazygit/discard-backups/1700000000000000001\x00aaa\x00111\x00Discard change\x00Path: a\n\x01\n"
+
+	scenarios := []struct {
+		testName       string
+		reflogOutput   string
+		expectedBackup bool
+	}{
+		{
+			testName:       "discard after last reflog entry",
+			reflogOutput:   "HEAD@{1600000000}\n",
+			expectedBackup: true,
+		},
+		{
+			testName:       "discard in the same second as last reflog entry",
+			reflogOutput:   "HEAD@{1700000000}\n",
+			expectedBackup: true,
+		},
+		{
+			testName:       "discard before last reflog entry",
+			reflogOutput:   "HEAD@{1800000000}\n",
+			expectedBackup: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{
+					"for-each-ref",
+					"--sort=-refname",
+					"--format=%(refname)%00%(objectname)%00%(parent)%00%(contents:subject)%00%(contents:body)%01",
+					"refs/lazygit/discard-backups/",
+				}, backupsOutput, nil).
+				ExpectGitArgs([]string{"log", "-g", "-1", "--format=%gd", "--date=unix"}, s.reflogOutput, nil)
+			instance := NewSnapshotCommands(buildGitCommon(commonDeps{runner: runner}))
+
+			backup, err := instance.DiscardBackupToUndo()
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedBackup, backup != nil)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
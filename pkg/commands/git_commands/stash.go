@@ -2,9 +2,13 @@ package git_commands
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/samber/lo"
 )
 
 type StashCommands struct {
@@ -52,14 +56,34 @@ func (self *StashCommands) Apply(index int) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
-// Push push stash
-func (self *StashCommands) Push(message string) error {
-	cmdArgs := NewGitCmd("stash").Arg("push", "-m", message).
+// Applies a stash entry in another worktree. Stash entries are shared between
+// all worktrees of a repo, so this works for any of them.
+func (self *StashCommands) ApplyInWorktree(index int, worktreePath string) error {
+	cmdArgs := NewGitCmd("stash").Arg("apply", fmt.Sprintf("refs/stash@{%d}", index)).
+		Dir(worktreePath).
 		ToArgv()
 
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// The arguments that the stash menu's options pass to `git stash`. The menu
+// shows them in its tooltips, so they must be the ones we actually run.
+var (
+	StashPushArgs             = []string{"push"}
+	StashKeepIndexArgs        = []string{"push", "--keep-index"}
+	StashIncludeUntrackedArgs = []string{"push", "--include-untracked"}
+	StashStagedArgs           = []string{"push", "--staged"}
+)
+
+// Push push stash
+func (self *StashCommands) Push(message string) error {
+	return self.cmd.New(pushCmdArgs(StashPushArgs, message)).Run()
+}
+
+func pushCmdArgs(stashArgs []string, message string) []string {
+	return NewGitCmd("stash").Arg(stashArgs...).Arg("-m", message).ToArgv()
+}
+
 func (self *StashCommands) Store(hash string, message string) error {
 	trimmedMessage := strings.Trim(message, " \t")
 
@@ -83,12 +107,13 @@ func (self *StashCommands) Hash(index int) (string, error) {
 func (self *StashCommands) ShowStashEntryCmdObj(index int) *oscommands.CmdObj {
 	// "-u" is the same as "--include-untracked", but the latter fails in older git versions for some reason
 	cmdArgs := NewGitCmd("stash").Arg("show").
+		Config(diffColorConfigs(self.UserConfig())...).
 		Arg("-p").
 		Arg("--stat").
 		Arg("-u").
 		Arg(fmt.Sprintf("--color=%s", self.UserConfig().Git.Paging.ColorArg)).
 		Arg(fmt.Sprintf("--unified=%d", self.UserConfig().Git.DiffContextSize)).
-		ArgIf(self.UserConfig().Git.IgnoreWhitespaceInDiffView, "--ignore-all-space").
+		Arg(diffOptionArgs(self.UserConfig(), false)...).
 		Arg(fmt.Sprintf("--find-renames=%d%%", self.UserConfig().Git.RenameSimilarityThreshold)).
 		Arg(fmt.Sprintf("refs/stash@{%d}", index)).
 		Dir(self.repoPaths.worktreePath).
@@ -97,11 +122,124 @@ func (self *StashCommands) ShowStashEntryCmdObj(index int) *oscommands.CmdObj {
 	return self.cmd.New(cmdArgs).DontLog()
 }
 
-func (self *StashCommands) StashAndKeepIndex(message string) error {
-	cmdArgs := NewGitCmd("stash").Arg("push", "--keep-index", "-m", message).
-		ToArgv()
+// Returns the paths of the untracked files stored in a stash entry. These only
+// exist if the entry was created with --include-untracked, in which case they
+// live in the entry's third parent commit.
+func (self *StashCommands) UntrackedFiles(index int) ([]string, error) {
+	untrackedRef := fmt.Sprintf("refs/stash@{%d}^3", index)
 
-	return self.cmd.New(cmdArgs).Run()
+	cmdArgs := NewGitCmd("rev-parse").Arg("--verify", "--quiet", untrackedRef).ToArgv()
+	if err := self.cmd.New(cmdArgs).DontLog().Run(); err != nil {
+		// no untracked files were stashed
+		return []string{}, nil
+	}
+
+	cmdArgs = NewGitCmd("ls-tree").Arg("-r", "--name-only", "-z", untrackedRef).ToArgv()
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Compact(strings.Split(output, "\x00")), nil
+}
+
+// Returns a patch of the changes that a stash entry makes to the given files,
+// suitable for passing to `git apply`. Untracked files need to be passed
+// separately because they are diffed against the entry's third parent.
+func (self *StashCommands) FilesDiff(index int, paths []string, untrackedPaths []string) (string, error) {
+	base := fmt.Sprintf("refs/stash@{%d}^", index)
+
+	diff := func(to string, paths []string) (string, error) {
+		if len(paths) == 0 {
+			return "", nil
+		}
+
+		cmdArgs := NewGitCmd("diff").
+			Config("diff.noprefix=false").
+			Arg("--no-ext-diff", "--binary", "--no-renames", "--color=never").
+			Arg(base, to, "--").
+			Arg(paths...).
+			Dir(self.repoPaths.worktreePath).
+			ToArgv()
+
+		return self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	}
+
+	trackedDiff, err := diff(fmt.Sprintf("refs/stash@{%d}", index), paths)
+	if err != nil {
+		return "", err
+	}
+
+	untrackedDiff, err := diff(fmt.Sprintf("refs/stash@{%d}^3", index), untrackedPaths)
+	if err != nil {
+		return "", err
+	}
+
+	return trackedDiff + untrackedDiff, nil
+}
+
+// Stashes just the changes in the given patch file and removes them from the
+// working tree. The patch must apply on top of the index. `git stash push`
+// can only stash whole files, so we build the stash entry ourselves: an index
+// commit with the current index, and a working tree commit on top of it that
+// has the patch applied, which is the same shape `git stash` would create.
+func (self *StashCommands) StashPatch(patchPath string, message string) error {
+	if strings.TrimSpace(message) == "" {
+		message = "WIP"
+	}
+
+	run := func(cmdArgs []string, envVars ...string) (string, error) {
+		output, err := self.cmd.New(cmdArgs).AddEnvVars(envVars...).DontLog().RunWithOutput()
+		return strings.TrimSpace(output), err
+	}
+
+	headHash, err := run(NewGitCmd("rev-parse").Arg("HEAD").ToArgv())
+	if err != nil {
+		return err
+	}
+
+	indexTree, err := run(NewGitCmd("write-tree").ToArgv())
+	if err != nil {
+		return err
+	}
+
+	indexCommit, err := run(NewGitCmd("commit-tree").Arg("-p", headHash, "-m", "index on "+message, indexTree).ToArgv())
+	if err != nil {
+		return err
+	}
+
+	// Apply the patch in a temporary index so that the real one is left alone
+	indexFile := filepath.Join(self.os.GetTempDir(), fmt.Sprintf("stash-index-%d", time.Now().UnixNano()))
+	defer func() { _ = os.Remove(indexFile) }()
+	indexEnv := "GIT_INDEX_FILE=" + indexFile
+
+	if _, err := run(NewGitCmd("read-tree").Arg(indexTree).ToArgv(), indexEnv); err != nil {
+		return err
+	}
+
+	if _, err := run(NewGitCmd("apply").Arg("--cached", patchPath).ToArgv(), indexEnv); err != nil {
+		return err
+	}
+
+	worktreeTree, err := run(NewGitCmd("write-tree").ToArgv(), indexEnv)
+	if err != nil {
+		return err
+	}
+
+	worktreeCommit, err := run(NewGitCmd("commit-tree").Arg("-p", headHash, "-p", indexCommit, "-m", message, worktreeTree).ToArgv())
+	if err != nil {
+		return err
+	}
+
+	if err := self.Store(worktreeCommit, message); err != nil {
+		return err
+	}
+
+	return self.cmd.New(NewGitCmd("apply").Arg("--reverse", patchPath).ToArgv()).Run()
+}
+
+func (self *StashCommands) StashAndKeepIndex(message string) error {
+	return self.cmd.New(pushCmdArgs(StashKeepIndexArgs, message)).Run()
 }
 
 func (self *StashCommands) StashUnstagedChanges(message string) error {
@@ -127,7 +265,7 @@ func (self *StashCommands) StashUnstagedChanges(message string) error {
 // SaveStagedChanges stashes only the currently staged changes.
 func (self *StashCommands) SaveStagedChanges(message string) error {
 	if self.version.IsAtLeast(2, 35, 0) {
-		return self.cmd.New(NewGitCmd("stash").Arg("push").Arg("--staged").Arg("-m", message).ToArgv()).Run()
+		return self.cmd.New(pushCmdArgs(StashStagedArgs, message)).Run()
 	}
 
 	// Git versions older than 2.35.0 don't support the --staged flag, so we
@@ -188,10 +326,7 @@ func (self *StashCommands) SaveStagedChanges(message string) error {
 }
 
 func (self *StashCommands) StashIncludeUntrackedChanges(message string) error {
-	return self.cmd.New(
-		NewGitCmd("stash").Arg("push", "--include-untracked", "-m", message).
-			ToArgv(),
-	).Run()
+	return self.cmd.New(pushCmdArgs(StashIncludeUntrackedArgs, message)).Run()
 }
 
 func (self *StashCommands) Rename(index int, message string) error {
@@ -92,6 +92,7 @@ func stashEntryFromLine(line string, index int) *models.StashEntry {
 
 	model.Name = msg
 	model.Recency = utils.UnixToTimeAgo(t)
+	model.UnixTimestamp = t
 
 	return model
 }
@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -26,6 +27,15 @@ func TestStashApply(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestStashApplyInWorktree(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"-C", "/path/to/other-worktree", "stash", "apply", "refs/stash@{1}"}, "", nil)
+	instance := buildStashCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.ApplyInWorktree(1, "/path/to/other-worktree"))
+	runner.CheckForMissingCalls()
+}
+
 func TestStashPop(t *testing.T) {
 	runner := oscommands.NewFakeRunner(t).
 		ExpectGitArgs([]string{"stash", "pop", "refs/stash@{1}"}, "", nil)
@@ -203,3 +213,99 @@ func TestStashRename(t *testing.T) {
 		})
 	}
 }
+
+func TestStashUntrackedFiles(t *testing.T) {
+	type scenario struct {
+		testName       string
+		runner         *oscommands.FakeCmdObjRunner
+		expectedResult []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "Stash entry with untracked files",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "refs/stash@{1}^3"}, "abc\n", nil).
+				ExpectGitArgs([]string{"ls-tree", "-r", "--name-only", "-z", "refs/stash@{1}^3"}, "dir/file1\x00file2\x00", nil),
+			expectedResult: []string{"dir/file1", "file2"},
+		},
+		{
+			testName: "Stash entry without untracked files",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "refs/stash@{1}^3"}, "", errors.New("error")),
+			expectedResult: []string{},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildStashCommands(commonDeps{runner: s.runner})
+
+			result, err := instance.UntrackedFiles(1)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedResult, result)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestStashFilesDiff(t *testing.T) {
+	type scenario struct {
+		testName       string
+		paths          []string
+		untrackedPaths []string
+		runner         *oscommands.FakeCmdObjRunner
+		expectedResult string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:       "Tracked files only",
+			paths:          []string{"file1", "file2"},
+			untrackedPaths: []string{},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "diff", "--no-ext-diff", "--binary", "--no-renames", "--color=never", "refs/stash@{2}^", "refs/stash@{2}", "--", "file1", "file2"}, "tracked diff\n", nil),
+			expectedResult: "tracked diff\n",
+		},
+		{
+			testName:       "Tracked and untracked files",
+			paths:          []string{"file1"},
+			untrackedPaths: []string{"new-file"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "diff", "--no-ext-diff", "--binary", "--no-renames", "--color=never", "refs/stash@{2}^", "refs/stash@{2}", "--", "file1"}, "tracked diff\n", nil).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "diff", "--no-ext-diff", "--binary", "--no-renames", "--color=never", "refs/stash@{2}^", "refs/stash@{2}^3", "--", "new-file"}, "untracked diff\n", nil),
+			expectedResult: "tracked diff\nuntracked diff\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			repoPaths := RepoPaths{
+				worktreePath: "/path/to/worktree",
+			}
+			instance := buildStashCommands(commonDeps{runner: s.runner, repoPaths: &repoPaths})
+
+			result, err := instance.FilesDiff(2, s.paths, s.untrackedPaths)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedResult, result)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestStashStashPatch(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rev-parse", "HEAD"}, "headhash\n", nil).
+		ExpectGitArgs([]string{"write-tree"}, "indextree\n", nil).
+		ExpectGitArgs([]string{"commit-tree", "-p", "headhash", "-m", "index on my stash", "indextree"}, "indexcommit\n", nil).
+		ExpectGitArgs([]string{"read-tree", "indextree"}, "", nil).
+		ExpectGitArgs([]string{"apply", "--cached", "/tmp/my.patch"}, "", nil).
+		ExpectGitArgs([]string{"write-tree"}, "worktreetree\n", nil).
+		ExpectGitArgs([]string{"commit-tree", "-p", "headhash", "-p", "indexcommit", "-m", "my stash", "worktreetree"}, "worktreecommit\n", nil).
+		ExpectGitArgs([]string{"stash", "store", "-m", "my stash", "worktreecommit"}, "", nil).
+		ExpectGitArgs([]string{"apply", "--reverse", "/tmp/my.patch"}, "", nil)
+	instance := buildStashCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.StashPatch("/tmp/my.patch", "my stash"))
+	runner.CheckForMissingCalls()
+}
@@ -2,6 +2,7 @@ package git_commands
 
 import (
 	"bufio"
+	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -75,6 +76,8 @@ func (self *SubmoduleCommands) GetConfigs(parentModule *models.SubmoduleConfig)
 				}
 			} else if url, ok := firstMatch(line, `\s*url\s*=\s*(.*)\s*`); ok {
 				configs[lastConfigIdx].Url = url
+			} else if branch, ok := firstMatch(line, `\s*branch\s*=\s*(.*)\s*`); ok {
+				configs[lastConfigIdx].Branch = branch
 			}
 		}
 	}
@@ -172,12 +175,14 @@ func (self *SubmoduleCommands) Delete(submodule *models.SubmoduleConfig) error {
 	return os.RemoveAll(submodule.GitDirPath(self.repoPaths.repoGitDirPath))
 }
 
-func (self *SubmoduleCommands) Add(name string, path string, url string) error {
+// An empty branch means the submodule follows the remote's default branch
+func (self *SubmoduleCommands) Add(name string, path string, url string, branch string) error {
 	cmdArgs := NewGitCmd("submodule").
 		Arg("add").
 		Arg("--force").
 		Arg("--name").
 		Arg(name).
+		ArgIf(branch != "", "-b", branch).
 		Arg("--").
 		Arg(url).
 		Arg(path).
@@ -222,6 +227,31 @@ func (self *SubmoduleCommands) UpdateUrl(submodule *models.SubmoduleConfig, newU
 	return nil
 }
 
+// Sets the branch that `git submodule update --remote` updates the submodule
+// to. An empty branch means the remote's default branch.
+func (self *SubmoduleCommands) SetBranch(submodule *models.SubmoduleConfig, branch string) error {
+	if branch == "" && submodule.Branch == "" {
+		return nil
+	}
+
+	parentDir := ""
+	if submodule.ParentModule != nil {
+		parentDir = submodule.ParentModule.FullPath()
+	}
+
+	// `git submodule set-branch` writes the wrong key when the submodule's name
+	// differs from its path in some git versions, so we're doing it manually
+	key := "submodule." + submodule.Name + ".branch"
+	cmdArgs := NewGitCmd("config").
+		Arg("--file", ".gitmodules").
+		ArgIf(branch != "", key, branch).
+		ArgIf(branch == "", "--unset", key).
+		DirIf(parentDir != "", parentDir).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 func (self *SubmoduleCommands) Init(path string) error {
 	cmdArgs := NewGitCmd("submodule").Arg("init", "--", path).
 		ToArgv()
@@ -236,6 +266,51 @@ func (self *SubmoduleCommands) Update(path string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// Updates the submodule to the latest commit of its tracked branch, streaming
+// the progress to the command log
+func (self *SubmoduleCommands) UpdateRemote(submodule *models.SubmoduleConfig) error {
+	parentDir := ""
+	if submodule.ParentModule != nil {
+		parentDir = submodule.ParentModule.FullPath()
+	}
+	cmdArgs := NewGitCmd("submodule").
+		Arg("update", "--init", "--remote", "--progress", "--", submodule.Path).
+		DirIf(parentDir != "", parentDir).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).StreamOutput().Run()
+}
+
+// Printed by the command that we wrap the user's command in when it fails
+// in a submodule, followed by the submodule's path
+const submoduleForeachFailureMarker = "__lazygit_submodule_foreach_failed__"
+
+// Runs the given shell command in every submodule, recursively. Unlike a plain
+// `git submodule foreach`, this doesn't stop at the first submodule where the
+// command fails; instead it returns the paths of all such submodules. Every
+// other line of output is passed to onLine as it arrives.
+func (self *SubmoduleCommands) Foreach(command string, onLine func(line string)) ([]string, error) {
+	// The newline guards against commands ending in a comment
+	wrappedCommand := fmt.Sprintf(`( %s
+) || echo "%s $displaypath"`, command, submoduleForeachFailureMarker)
+
+	cmdArgs := NewGitCmd("submodule").
+		Arg("foreach", "--recursive", wrappedCommand).
+		ToArgv()
+
+	failedPaths := []string{}
+	err := self.cmd.New(cmdArgs).DontLog().RunAndProcessLines(func(line string) (bool, error) {
+		if path, ok := strings.CutPrefix(line, submoduleForeachFailureMarker+" "); ok {
+			failedPaths = append(failedPaths, path)
+		} else {
+			onLine(line)
+		}
+		return false, nil
+	})
+
+	return failedPaths, err
+}
+
 func (self *SubmoduleCommands) BulkInitCmdObj() *oscommands.CmdObj {
 	cmdArgs := NewGitCmd("submodule").Arg("init").
 		ToArgv()
@@ -264,6 +339,13 @@ func (self *SubmoduleCommands) BulkUpdateRecursivelyCmdObj() *oscommands.CmdObj
 	return self.cmd.New(cmdArgs)
 }
 
+func (self *SubmoduleCommands) BulkUpdateRemoteCmdObj() *oscommands.CmdObj {
+	cmdArgs := NewGitCmd("submodule").Arg("update", "--init", "--remote", "--progress").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs)
+}
+
 func (self *SubmoduleCommands) BulkDeinitCmdObj() *oscommands.CmdObj {
 	cmdArgs := NewGitCmd("submodule").Arg("deinit", "--all", "--force").
 		ToArgv()
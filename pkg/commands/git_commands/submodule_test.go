@@ -0,0 +1,101 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmoduleAdd(t *testing.T) {
+	type scenario struct {
+		testName     string
+		branch       string
+		expectedArgs []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "default branch",
+			branch:       "",
+			expectedArgs: []string{"submodule", "add", "--force", "--name", "lib", "--", "../lib.git", "vendor/lib"},
+		},
+		{
+			testName:     "tracked branch",
+			branch:       "stable",
+			expectedArgs: []string{"submodule", "add", "--force", "--name", "lib", "-b", "stable", "--", "../lib.git", "vendor/lib"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expectedArgs, "", nil)
+			instance := buildSubmoduleCommands(commonDeps{runner: runner})
+
+			assert.NoError(t, instance.Add("lib", "vendor/lib", "../lib.git", s.branch))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestSubmoduleSetBranch(t *testing.T) {
+	parent := &models.SubmoduleConfig{Name: "parent", Path: "parent"}
+
+	type scenario struct {
+		testName     string
+		submodule    *models.SubmoduleConfig
+		branch       string
+		expectedArgs []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "set branch",
+			submodule:    &models.SubmoduleConfig{Name: "lib", Path: "vendor/lib"},
+			branch:       "stable",
+			expectedArgs: []string{"config", "--file", ".gitmodules", "submodule.lib.branch", "stable"},
+		},
+		{
+			testName:     "reset to default branch",
+			submodule:    &models.SubmoduleConfig{Name: "lib", Path: "vendor/lib", Branch: "stable"},
+			branch:       "",
+			expectedArgs: []string{"config", "--file", ".gitmodules", "--unset", "submodule.lib.branch"},
+		},
+		{
+			testName:     "nested submodule",
+			submodule:    &models.SubmoduleConfig{Name: "lib", Path: "lib", ParentModule: parent},
+			branch:       "stable",
+			expectedArgs: []string{"-C", "parent", "config", "--file", ".gitmodules", "submodule.lib.branch", "stable"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expectedArgs, "", nil)
+			instance := buildSubmoduleCommands(commonDeps{runner: runner})
+
+			assert.NoError(t, instance.SetBranch(s.submodule, s.branch))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestSubmoduleForeach(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs(
+			[]string{"submodule", "foreach", "--recursive", "( make test\n) || echo \"__lazygit_submodule_foreach_failed__ $displaypath\""},
+			"Entering 'lib'\nok\nEntering 'vendor/other'\nFAIL\n__lazygit_submodule_foreach_failed__ vendor/other\n",
+			nil,
+		)
+	instance := buildSubmoduleCommands(commonDeps{runner: runner})
+
+	lines := []string{}
+	failedPaths, err := instance.Foreach("make test", func(line string) {
+		lines = append(lines, line)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vendor/other"}, failedPaths)
+	assert.Equal(t, []string{"Entering 'lib'", "ok", "Entering 'vendor/other'", "FAIL"}, lines)
+	runner.CheckForMissingCalls()
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type TagCommands struct {
@@ -37,6 +39,53 @@ func (self *TagCommands) CreateAnnotatedObj(tagName, ref, msg string, force bool
 	return self.cmd.New(cmdArgs)
 }
 
+// Returns the tags whose history contains the given commit, newest first
+func (self *TagCommands) TagsContaining(hash string) ([]string, error) {
+	cmdArgs := NewGitCmd("tag").
+		Arg("--contains", hash, "--sort=-creatordate").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Compact(strings.Split(strings.TrimSpace(output), "\n")), nil
+}
+
+// Returns the subjects of the commits reachable from ref but not from
+// fromTag, newest first. An empty ref means HEAD.
+func (self *TagCommands) CommitSubjectsSince(fromTag string, ref string) ([]string, error) {
+	if ref == "" {
+		ref = "HEAD"
+	}
+	cmdArgs := NewGitCmd("log").
+		Arg("--no-merges", "--format=%s", fromTag+".."+ref, "--").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Compact(strings.Split(strings.TrimSpace(output), "\n")), nil
+}
+
+// Returns the closest tag reachable from the parent of the given tag's commit,
+// or an empty string if there is none
+func (self *TagCommands) PreviousTag(tagName string) string {
+	cmdArgs := NewGitCmd("describe").
+		Arg("--tags", "--abbrev=0", "refs/tags/"+tagName+"^{}^").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(output)
+}
+
 func (self *TagCommands) HasTag(tagName string) bool {
 	cmdArgs := NewGitCmd("show-ref").
 		Arg("--tags", "--quiet", "--verify", "--").
@@ -60,6 +109,48 @@ func (self *TagCommands) Push(task gocui.Task, remoteName string, tagName string
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
 }
 
+// Pushes several tags to the remote at once
+func (self *TagCommands) PushTags(task gocui.Task, remoteName string, tagNames []string) error {
+	cmdArgs := NewGitCmd("push").Arg(remoteName).
+		Arg(lo.Map(tagNames, func(tagName string, _ int) string {
+			return "refs/tags/" + tagName
+		})...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// Returns the names of the tags that exist on the given remote. We can't
+// capture the output of commands that prompt for credentials, so this fails
+// instead if the remote needs any.
+func (self *TagCommands) RemoteTags(remoteName string) ([]string, error) {
+	cmdArgs := NewGitCmd("ls-remote").
+		Arg("--tags", "--refs", remoteName).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).
+		// prevents git from prompting us for input which would freeze the program
+		AddEnvVars("GIT_TERMINAL_PROMPT=0").
+		DontLog().
+		RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseRemoteTags(output), nil
+}
+
+// Output lines look like "<hash>\trefs/tags/<name>"
+func parseRemoteTags(output string) []string {
+	return lo.FilterMap(utils.SplitLines(output), func(line string, _ int) (string, bool) {
+		_, ref, found := strings.Cut(line, "\t")
+		if !found {
+			return "", false
+		}
+		return strings.TrimPrefix(ref, "refs/tags/"), true
+	})
+}
+
 // Return info about an annotated tag in the format:
 //
 //	Tagger:     tagger name <tagger email>
@@ -77,6 +168,42 @@ func (self *TagCommands) ShowAnnotationInfo(tagName string) (string, error) {
 	return self.cmd.New(cmdArgs).RunWithOutput()
 }
 
+// Returns the message of an annotated tag, without its signature
+func (self *TagCommands) GetAnnotationMessage(tagName string) (string, error) {
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--format=%(contents:subject)%0a%0a%(contents:body)").
+		Arg("refs/tags/" + tagName).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
+// Verifies the signature of a signed tag. Returns whether the signature is
+// good, along with the line of gpg's (or ssh's) output that describes it.
+func (self *TagCommands) Verify(tagName string) (bool, string) {
+	cmdArgs := NewGitCmd("tag").Arg("-v", tagName).ToArgv()
+
+	stdout, stderr, err := self.cmd.New(cmdArgs).DontLog().RunWithOutputs()
+	lines := lo.Compact(utils.SplitLines(stderr + "\n" + stdout))
+	// Prefer the line with the verdict (e.g. "Good signature from ..."), but
+	// fall back to anything mentioning the signature, e.g. when there is none
+	summary, found := lo.Find(lines, func(line string) bool {
+		line = strings.ToLower(line)
+		return strings.Contains(line, "good signature") || strings.Contains(line, "bad signature")
+	})
+	if !found {
+		summary, found = lo.Find(lines, func(line string) bool {
+			return strings.Contains(strings.ToLower(line), "signature")
+		})
+	}
+	if !found && len(lines) > 0 {
+		summary = lines[0]
+	}
+
+	return err == nil, strings.TrimSpace(summary)
+}
+
 func (self *TagCommands) IsTagAnnotated(tagName string) (bool, error) {
 	cmdArgs := NewGitCmd("cat-file").
 		Arg("-t").
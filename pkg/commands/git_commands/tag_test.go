@@ -0,0 +1,191 @@
+package git_commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jesseduffield/gocui"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagTagsContaining(t *testing.T) {
+	type scenario struct {
+		testName string
+		output   string
+		expected []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "no tags",
+			output:   "",
+			expected: []string{},
+		},
+		{
+			testName: "some tags",
+			output:   "v1.1\nv1.0\n",
+			expected: []string{"v1.1", "v1.0"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"tag", "--contains", "abc", "--sort=-creatordate"}, s.output, nil)
+			instance := buildTagCommands(commonDeps{runner: runner})
+
+			tags, err := instance.TagsContaining("abc")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, tags)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestTagCommitSubjectsSince(t *testing.T) {
+	type scenario struct {
+		testName    string
+		ref         string
+		expectedRef string
+		output      string
+		expected    []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:    "defaults to HEAD",
+			ref:         "",
+			expectedRef: "v1.0.0..HEAD",
+			output:      "Add feature\nFix bug\n",
+			expected:    []string{"Add feature", "Fix bug"},
+		},
+		{
+			testName:    "explicit ref",
+			ref:         "abc",
+			expectedRef: "v1.0.0..abc",
+			output:      "",
+			expected:    []string{},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"log", "--no-merges", "--format=%s", s.expectedRef, "--"}, s.output, nil)
+			instance := buildTagCommands(commonDeps{runner: runner})
+
+			subjects, err := instance.CommitSubjectsSince("v1.0.0", s.ref)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, subjects)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestTagPreviousTag(t *testing.T) {
+	type scenario struct {
+		testName string
+		output   string
+		err      error
+		expected string
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "previous tag exists",
+			output:   "v1.0.0\n",
+			err:      nil,
+			expected: "v1.0.0",
+		},
+		{
+			testName: "no previous tag",
+			output:   "",
+			err:      errors.New("fatal: No names found, cannot describe anything."),
+			expected: "",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"describe", "--tags", "--abbrev=0", "refs/tags/v1.1.0^{}^"}, s.output, s.err)
+			instance := buildTagCommands(commonDeps{runner: runner})
+
+			assert.Equal(t, s.expected, instance.PreviousTag("v1.1.0"))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestTagGetAnnotationMessage(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"for-each-ref", "--format=%(contents:subject)%0a%0a%(contents:body)", "refs/tags/v1.0"}, "Release 1.0\n\nFirst release\n\n", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	message, err := instance.GetAnnotationMessage("v1.0")
+	assert.NoError(t, err)
+	assert.Equal(t, "Release 1.0\n\nFirst release", message)
+	runner.CheckForMissingCalls()
+}
+
+func TestTagRemoteTags(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"ls-remote", "--tags", "--refs", "origin"}, "abc123\trefs/tags/v1.0\ndef456\trefs/tags/v1.1\n", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	tags, err := instance.RemoteTags("origin")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v1.0", "v1.1"}, tags)
+	runner.CheckForMissingCalls()
+}
+
+func TestTagPushTags(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"push", "origin", "refs/tags/v1.0", "refs/tags/v1.1"}, "", nil)
+	instance := buildTagCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.PushTags(gocui.NewFakeTask(), "origin", []string{"v1.0", "v1.1"}))
+	runner.CheckForMissingCalls()
+}
+
+func TestTagVerify(t *testing.T) {
+	type scenario struct {
+		testName        string
+		output          string
+		err             error
+		expectedOk      bool
+		expectedSummary string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:        "good signature",
+			output:          "object abc\ntype commit\ngpg: Signature made Mon Jan 1 2024\ngpg: Good signature from \"Jane <jane@example.com>\"\n",
+			err:             nil,
+			expectedOk:      true,
+			expectedSummary: "gpg: Good signature from \"Jane <jane@example.com>\"",
+		},
+		{
+			testName:        "unsigned tag",
+			output:          "error: no signature found\n",
+			err:             errors.New("exit status 1"),
+			expectedOk:      false,
+			expectedSummary: "error: no signature found",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"tag", "-v", "v1.0"}, s.output, s.err)
+			instance := buildTagCommands(commonDeps{runner: runner})
+
+			ok, summary := instance.Verify("v1.0")
+			assert.Equal(t, s.expectedOk, ok)
+			assert.Equal(t, s.expectedSummary, summary)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -5,10 +5,13 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type WorkingTreeCommands struct {
@@ -33,6 +36,17 @@ func (self *WorkingTreeCommands) OpenMergeToolCmdObj() *oscommands.CmdObj {
 	return self.cmd.New(NewGitCmd("mergetool").ToArgv())
 }
 
+// Opens the merge tool for a single conflicted file. If the tool is empty, the
+// one configured in git's merge.tool setting is used.
+func (self *WorkingTreeCommands) OpenMergeToolForFileCmdObj(path string, tool string) *oscommands.CmdObj {
+	cmdArgs := NewGitCmd("mergetool").
+		ArgIf(tool != "", "--tool="+tool).
+		Arg("--no-prompt", "--", path).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs)
+}
+
 // StageFile stages a file
 func (self *WorkingTreeCommands) StageFile(path string) error {
 	return self.StageFiles([]string{path}, nil)
@@ -265,16 +279,17 @@ func (self *WorkingTreeCommands) WorktreeFileDiffCmdObj(node models.IFile, plain
 	contextSize := self.UserConfig().Git.DiffContextSize
 	prevPath := node.GetPreviousPath()
 	noIndex := !node.GetIsTracked() && !node.GetHasStagedChanges() && !cached && node.GetIsFile()
-	extDiffCmd := self.UserConfig().Git.Paging.ExternalDiffCommand
+	extDiffCmd := externalDiffCommandForPath(self.UserConfig(), node.GetPath())
 	useExtDiff := extDiffCmd != "" && !plain
 
 	cmdArgs := NewGitCmd("diff").
+		ConfigIf(!plain, diffColorConfigs(self.UserConfig())...).
 		ConfigIf(useExtDiff, "diff.external="+extDiffCmd).
 		ArgIfElse(useExtDiff, "--ext-diff", "--no-ext-diff").
 		Arg("--submodule").
 		Arg(fmt.Sprintf("--unified=%d", contextSize)).
 		Arg(fmt.Sprintf("--color=%s", colorArg)).
-		ArgIf(!plain && self.UserConfig().Git.IgnoreWhitespaceInDiffView, "--ignore-all-space").
+		Arg(diffOptionArgs(self.UserConfig(), plain)...).
 		Arg(fmt.Sprintf("--find-renames=%d%%", self.UserConfig().Git.RenameSimilarityThreshold)).
 		ArgIf(cached, "--cached").
 		ArgIf(noIndex, "--no-index").
@@ -288,6 +303,48 @@ func (self *WorkingTreeCommands) WorktreeFileDiffCmdObj(node models.IFile, plain
 	return self.cmd.New(cmdArgs).DontLog()
 }
 
+// Returns whether git considers the file to be binary, looking at the same
+// versions of it as WorktreeFileDiffCmdObj
+func (self *WorkingTreeCommands) IsBinaryWorktreeFile(node models.IFile, cached bool) bool {
+	noIndex := !node.GetIsTracked() && !node.GetHasStagedChanges() && !cached && node.GetIsFile()
+
+	cmdArgs := NewGitCmd("diff").
+		Arg("--no-ext-diff", "--numstat", "--no-renames").
+		ArgIf(cached, "--cached").
+		ArgIf(noIndex, "--no-index").
+		Arg("--").
+		ArgIf(noIndex, "/dev/null").
+		Arg(node.GetPath()).
+		Dir(self.repoPaths.worktreePath).
+		ToArgv()
+
+	return self.isBinaryNumstat(cmdArgs)
+}
+
+// Returns whether git considers the file to be binary, looking at the same
+// versions of it as ShowFileDiffCmdObj
+func (self *WorkingTreeCommands) IsBinaryFileInDiff(from string, to string, reverse bool, fileName string) bool {
+	cmdArgs := NewGitCmd("diff").
+		Arg("--no-ext-diff", "--numstat", "--no-renames").
+		Arg(from).
+		Arg(to).
+		ArgIf(reverse, "-R").
+		Arg("--").
+		Arg(fileName).
+		Dir(self.repoPaths.worktreePath).
+		ToArgv()
+
+	return self.isBinaryNumstat(cmdArgs)
+}
+
+// git diff --numstat shows "-" for the added and deleted lines of binary files
+func (self *WorkingTreeCommands) isBinaryNumstat(cmdArgs []string) bool {
+	// With --no-index, git diff exits with 1 if the files differ, so we look at
+	// the output regardless of the error
+	output, _ := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	return strings.HasPrefix(output, "-\t-\t")
+}
+
 // ShowFileDiff get the diff of specified from and to. Typically this will be used for a single commit so it'll be 123abc^..123abc
 // but when we're in diff mode it could be any 'from' to any 'to'. The reverse flag is also here thanks to diff mode.
 func (self *WorkingTreeCommands) ShowFileDiff(from string, to string, reverse bool, fileName string, plain bool) (string, error) {
@@ -302,11 +359,12 @@ func (self *WorkingTreeCommands) ShowFileDiffCmdObj(from string, to string, reve
 		colorArg = "never"
 	}
 
-	extDiffCmd := self.UserConfig().Git.Paging.ExternalDiffCommand
+	extDiffCmd := externalDiffCommandForPath(self.UserConfig(), fileName)
 	useExtDiff := extDiffCmd != "" && !plain
 
 	cmdArgs := NewGitCmd("diff").
 		Config("diff.noprefix=false").
+		ConfigIf(!plain, diffColorConfigs(self.UserConfig())...).
 		ConfigIf(useExtDiff, "diff.external="+extDiffCmd).
 		ArgIfElse(useExtDiff, "--ext-diff", "--no-ext-diff").
 		Arg("--submodule").
@@ -316,7 +374,7 @@ func (self *WorkingTreeCommands) ShowFileDiffCmdObj(from string, to string, reve
 		Arg(from).
 		Arg(to).
 		ArgIf(reverse, "-R").
-		ArgIf(!plain && self.UserConfig().Git.IgnoreWhitespaceInDiffView, "--ignore-all-space").
+		Arg(diffOptionArgs(self.UserConfig(), plain)...).
 		Arg("--").
 		Arg(fileName).
 		Dir(self.repoPaths.worktreePath).
@@ -341,6 +399,115 @@ func (self *WorkingTreeCommands) DiscardAnyUnstagedFileChanges() error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// SetSkipWorktree sets or clears the skip-worktree bit of the given files,
+// which makes git ignore changes to them in the working tree
+func (self *WorkingTreeCommands) SetSkipWorktree(paths []string, value bool) error {
+	cmdArgs := NewGitCmd("update-index").
+		ArgIfElse(value, "--skip-worktree", "--no-skip-worktree").
+		Arg("--").
+		Arg(paths...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// SetAssumeUnchanged sets or clears the assume-unchanged bit of the given
+// files, which makes git assume that they haven't changed in the working tree
+func (self *WorkingTreeCommands) SetAssumeUnchanged(paths []string, value bool) error {
+	cmdArgs := NewGitCmd("update-index").
+		ArgIfElse(value, "--assume-unchanged", "--no-assume-unchanged").
+		Arg("--").
+		Arg(paths...).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// ToggleExecutable flips the executable bit of the given file and stages the
+// mode change (but not any content changes), adding the file if it's
+// untracked. Unless we're on Windows, where files don't have an executable
+// bit, the file in the working tree is updated too so that git doesn't report
+// the old mode as an unstaged change. Returns whether the file is executable
+// now.
+func (self *WorkingTreeCommands) ToggleExecutable(path string) (bool, error) {
+	mode, hash, err := self.indexEntry(path)
+	if err != nil {
+		return false, err
+	}
+
+	inIndex := mode != ""
+	// Symlinks, submodules and the like don't have an executable bit
+	if inIndex && mode != "100644" && mode != "100755" {
+		return false, errors.New(utils.ResolvePlaceholderString(self.Tr.CannotToggleExecutableForMode, map[string]string{"mode": mode}))
+	}
+
+	isWindows := self.os.Platform.OS == "windows"
+	executable := mode == "100755"
+	if !inIndex && !isWindows {
+		info, err := self.Fs.Stat(path)
+		if err != nil {
+			return false, err
+		}
+		executable = info.Mode()&0o111 != 0
+	}
+	executable = !executable
+
+	if !isWindows {
+		info, err := self.Fs.Stat(path)
+		if err != nil {
+			return false, err
+		}
+		newMode := info.Mode() &^ 0o111
+		if executable {
+			// like chmod +x, make the file executable for whoever can read it
+			newMode = info.Mode() | (info.Mode()&0o444)>>2
+		}
+		if err := self.Fs.Chmod(path, newMode); err != nil {
+			return false, err
+		}
+	}
+
+	if !inIndex {
+		chmodArg := "--chmod=-x"
+		if executable {
+			chmodArg = "--chmod=+x"
+		}
+		cmdArgs := NewGitCmd("add").Arg(chmodArg, "--", path).ToArgv()
+		return executable, self.cmd.New(cmdArgs).Run()
+	}
+
+	newIndexMode := "100644"
+	if executable {
+		newIndexMode = "100755"
+	}
+	// Using --cacheinfo with the existing blob means that only the mode is
+	// staged, and not the file's content
+	cmdArgs := NewGitCmd("update-index").
+		Arg("--cacheinfo", fmt.Sprintf("%s,%s,%s", newIndexMode, hash, path)).
+		ToArgv()
+	return executable, self.cmd.New(cmdArgs).Run()
+}
+
+// Returns the mode (e.g. "100644") and blob hash of the file in the index, or
+// empty strings if the file isn't in the index
+func (self *WorkingTreeCommands) indexEntry(path string) (string, string, error) {
+	cmdArgs := NewGitCmd("ls-files").
+		Arg("--stage", "--", path).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return "", "", err
+	}
+
+	fields := strings.Fields(output)
+	if len(fields) < 2 {
+		return "", "", nil
+	}
+
+	return fields[0], fields[1], nil
+}
+
 // RemoveTrackedFiles will delete the given file(s) even if they are currently tracked
 func (self *WorkingTreeCommands) RemoveTrackedFiles(name string) error {
 	cmdArgs := NewGitCmd("rm").Arg("-r", "--cached", "--", name).
@@ -356,6 +523,42 @@ func (self *WorkingTreeCommands) RemoveConflictedFile(name string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+// ResolveConflictsWithVersion resolves the conflicts in all the given files by
+// taking either our or their version of each file, and stages the result.
+// Files that don't exist on the chosen side are removed.
+func (self *WorkingTreeCommands) ResolveConflictsWithVersion(files []*models.File, theirs bool) error {
+	// The statuses for which the chosen side has no version of the file
+	deletedOnSide := lo.Ternary(theirs, []string{"DD", "UD", "AU"}, []string{"DD", "DU", "UA"})
+	toRemove, toCheckout := utils.Partition(files, func(file *models.File) bool {
+		return lo.Contains(deletedOnSide, file.ShortStatus)
+	})
+
+	if len(toCheckout) > 0 {
+		paths := lo.Map(toCheckout, func(file *models.File, _ int) string { return file.Path })
+		cmdArgs := NewGitCmd("checkout").
+			Arg(lo.Ternary(theirs, "--theirs", "--ours"), "--").
+			Arg(paths...).
+			ToArgv()
+		if err := self.cmd.New(cmdArgs).Run(); err != nil {
+			return err
+		}
+
+		if err := self.StageFiles(paths, nil); err != nil {
+			return err
+		}
+	}
+
+	if len(toRemove) > 0 {
+		paths := lo.Map(toRemove, func(file *models.File, _ int) string { return file.Path })
+		cmdArgs := NewGitCmd("rm").Arg("--").Arg(paths...).ToArgv()
+		if err := self.cmd.New(cmdArgs).Run(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // RemoveUntrackedFiles runs `git clean -fd`
 func (self *WorkingTreeCommands) RemoveUntrackedFiles() error {
 	cmdArgs := NewGitCmd("clean").Arg("-fd").ToArgv()
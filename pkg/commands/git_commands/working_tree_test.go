@@ -1,12 +1,14 @@
 package git_commands
 
 import (
+	"os"
 	"testing"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -30,6 +32,81 @@ func TestWorkingTreeStageFiles(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestWorkingTreeOpenMergeToolForFile(t *testing.T) {
+	type scenario struct {
+		testName string
+		tool     string
+		runner   *oscommands.FakeCmdObjRunner
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "Use git's configured merge tool",
+			tool:     "",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"mergetool", "--no-prompt", "--", "test.txt"}, "", nil),
+		},
+		{
+			testName: "Override the merge tool",
+			tool:     "meld",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"mergetool", "--tool=meld", "--no-prompt", "--", "test.txt"}, "", nil),
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			assert.NoError(t, instance.OpenMergeToolForFileCmdObj("test.txt", s.tool).Run())
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestWorkingTreeResolveConflictsWithVersion(t *testing.T) {
+	type scenario struct {
+		testName string
+		theirs   bool
+		runner   *oscommands.FakeCmdObjRunner
+	}
+
+	files := []*models.File{
+		{Path: "both-modified.txt", ShortStatus: "UU"},
+		{Path: "deleted-by-us.txt", ShortStatus: "DU"},
+		{Path: "deleted-by-them.txt", ShortStatus: "UD"},
+		{Path: "added-by-us.txt", ShortStatus: "AU"},
+		{Path: "added-by-them.txt", ShortStatus: "UA"},
+		{Path: "both-deleted.txt", ShortStatus: "DD"},
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "Take ours",
+			theirs:   false,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"checkout", "--ours", "--", "both-modified.txt", "deleted-by-them.txt", "added-by-us.txt"}, "", nil).
+				ExpectGitArgs([]string{"add", "--", "both-modified.txt", "deleted-by-them.txt", "added-by-us.txt"}, "", nil).
+				ExpectGitArgs([]string{"rm", "--", "deleted-by-us.txt", "added-by-them.txt", "both-deleted.txt"}, "", nil),
+		},
+		{
+			testName: "Take theirs",
+			theirs:   true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"checkout", "--theirs", "--", "both-modified.txt", "deleted-by-us.txt", "added-by-them.txt"}, "", nil).
+				ExpectGitArgs([]string{"add", "--", "both-modified.txt", "deleted-by-us.txt", "added-by-them.txt"}, "", nil).
+				ExpectGitArgs([]string{"rm", "--", "deleted-by-them.txt", "added-by-us.txt", "both-deleted.txt"}, "", nil),
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			assert.NoError(t, instance.ResolveConflictsWithVersion(files, s.theirs))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestWorkingTreeUnstageFile(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -350,7 +427,11 @@ func TestWorkingTreeShowFileDiff(t *testing.T) {
 		reverse          bool
 		plain            bool
 		ignoreWhitespace bool
+		ignoreBlankLines bool
+		diffAlgorithm    string
 		contextSize      uint64
+		extDiffCmd       string
+		fileTypeCmds     []config.FileTypeDiffCommand
 		runner           *oscommands.FakeCmdObjRunner
 	}
 
@@ -390,13 +471,81 @@ func TestWorkingTreeShowFileDiff(t *testing.T) {
 			runner: oscommands.NewFakeRunner(t).
 				ExpectGitArgs([]string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--no-renames", "--color=always", "1234567890", "0987654321", "--ignore-all-space", "--", "test.txt"}, expectedResult, nil),
 		},
+		{
+			testName:         "Ignore blank lines with custom diff algorithm",
+			from:             "1234567890",
+			to:               "0987654321",
+			reverse:          false,
+			plain:            false,
+			ignoreBlankLines: true,
+			diffAlgorithm:    "histogram",
+			contextSize:      3,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--no-renames", "--color=always", "1234567890", "0987654321", "--ignore-blank-lines", "--diff-algorithm=histogram", "--", "test.txt"}, expectedResult, nil),
+		},
+		{
+			testName:         "Plain diff only uses the diff algorithm",
+			from:             "1234567890",
+			to:               "0987654321",
+			reverse:          false,
+			plain:            true,
+			ignoreWhitespace: true,
+			ignoreBlankLines: true,
+			diffAlgorithm:    "patience",
+			contextSize:      3,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--no-renames", "--color=never", "1234567890", "0987654321", "--diff-algorithm=patience", "--", "test.txt"}, expectedResult, nil),
+		},
+		{
+			testName:     "File type diff command",
+			from:         "1234567890",
+			to:           "0987654321",
+			contextSize:  3,
+			extDiffCmd:   "difft",
+			fileTypeCmds: []config.FileTypeDiffCommand{{Extensions: []string{"png"}, Command: "imgdiff"}, {Extensions: []string{"TXT"}, Command: "txtdiff"}},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "-c", "diff.external=txtdiff", "-c", "diff.noprefix=false", "diff", "--ext-diff", "--submodule", "--unified=3", "--no-renames", "--color=always", "1234567890", "0987654321", "--", "test.txt"}, expectedResult, nil),
+		},
+		{
+			testName:     "Other file types use the external diff command",
+			from:         "1234567890",
+			to:           "0987654321",
+			contextSize:  3,
+			extDiffCmd:   "difft",
+			fileTypeCmds: []config.FileTypeDiffCommand{{Extensions: []string{"png"}, Command: "imgdiff"}},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "-c", "diff.external=difft", "-c", "diff.noprefix=false", "diff", "--ext-diff", "--submodule", "--unified=3", "--no-renames", "--color=always", "1234567890", "0987654321", "--", "test.txt"}, expectedResult, nil),
+		},
+		{
+			testName:     "Subprocess file type diff command is not used for the main view",
+			from:         "1234567890",
+			to:           "0987654321",
+			contextSize:  3,
+			fileTypeCmds: []config.FileTypeDiffCommand{{Extensions: []string{"txt"}, Command: "txttool", Subprocess: true}},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--no-renames", "--color=always", "1234567890", "0987654321", "--", "test.txt"}, expectedResult, nil),
+		},
+		{
+			testName:     "Plain diff doesn't use the file type diff command",
+			from:         "1234567890",
+			to:           "0987654321",
+			plain:        true,
+			contextSize:  3,
+			fileTypeCmds: []config.FileTypeDiffCommand{{Extensions: []string{"txt"}, Command: "txtdiff"}},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "-c", "diff.noprefix=false", "diff", "--no-ext-diff", "--submodule", "--unified=3", "--no-renames", "--color=never", "1234567890", "0987654321", "--", "test.txt"}, expectedResult, nil),
+		},
 	}
 
 	for _, s := range scenarios {
 		t.Run(s.testName, func(t *testing.T) {
 			userConfig := config.GetDefaultConfig()
 			userConfig.Git.IgnoreWhitespaceInDiffView = s.ignoreWhitespace
+			userConfig.Git.IgnoreBlankLinesInDiffView = s.ignoreBlankLines
+			userConfig.Git.DiffAlgorithm = s.diffAlgorithm
 			userConfig.Git.DiffContextSize = s.contextSize
+			userConfig.Git.Paging.ExternalDiffCommand = s.extDiffCmd
+			userConfig.Git.Paging.FileTypeDiffCommands = s.fileTypeCmds
 			repoPaths := RepoPaths{
 				worktreePath: "/path/to/worktree",
 			}
@@ -411,6 +560,53 @@ func TestWorkingTreeShowFileDiff(t *testing.T) {
 	}
 }
 
+func TestWorkingTreeIsBinaryWorktreeFile(t *testing.T) {
+	type scenario struct {
+		testName string
+		file     *models.File
+		cached   bool
+		runner   *oscommands.FakeCmdObjRunner
+		expected bool
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "binary file",
+			file:     &models.File{Path: "image.png", Tracked: true},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "diff", "--no-ext-diff", "--numstat", "--no-renames", "--", "image.png"}, "-\t-\timage.png\n", nil),
+			expected: true,
+		},
+		{
+			testName: "text file",
+			file:     &models.File{Path: "test.txt", Tracked: true},
+			cached:   true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "diff", "--no-ext-diff", "--numstat", "--no-renames", "--cached", "--", "test.txt"}, "1\t2\ttest.txt\n", nil),
+			expected: false,
+		},
+		{
+			testName: "untracked binary file",
+			file:     &models.File{Path: "image.png", Tracked: false},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/path/to/worktree", "diff", "--no-ext-diff", "--numstat", "--no-renames", "--no-index", "--", "/dev/null", "image.png"}, "-\t-\timage.png\n", errors.New("exit status 1")),
+			expected: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			repoPaths := RepoPaths{
+				worktreePath: "/path/to/worktree",
+			}
+
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner, userConfig: config.GetDefaultConfig(), appState: &config.AppState{}, repoPaths: &repoPaths})
+			assert.Equal(t, s.expected, instance.IsBinaryWorktreeFile(s.file, s.cached))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestWorkingTreeCheckoutFile(t *testing.T) {
 	type scenario struct {
 		testName   string
@@ -563,3 +759,76 @@ func TestWorkingTreeResetHard(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkingTreeToggleExecutable(t *testing.T) {
+	type scenario struct {
+		testName           string
+		lsFilesOutput      string
+		fileMode           os.FileMode
+		expectedArgs       []string
+		expectedExecutable bool
+		expectedFileMode   os.FileMode
+		expectedError      string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:           "tracked file becomes executable",
+			lsFilesOutput:      "100644 e69de29bb2d1d6434b8b29ae775ad8c2e48c5391 0\tscript.sh\n",
+			fileMode:           0o644,
+			expectedArgs:       []string{"update-index", "--cacheinfo", "100755,e69de29bb2d1d6434b8b29ae775ad8c2e48c5391,script.sh"},
+			expectedExecutable: true,
+			expectedFileMode:   0o755,
+		},
+		{
+			testName:           "tracked file stops being executable",
+			lsFilesOutput:      "100755 e69de29bb2d1d6434b8b29ae775ad8c2e48c5391 0\tscript.sh\n",
+			fileMode:           0o755,
+			expectedArgs:       []string{"update-index", "--cacheinfo", "100644,e69de29bb2d1d6434b8b29ae775ad8c2e48c5391,script.sh"},
+			expectedExecutable: false,
+			expectedFileMode:   0o644,
+		},
+		{
+			testName:           "untracked file is added as executable",
+			lsFilesOutput:      "",
+			fileMode:           0o600,
+			expectedArgs:       []string{"add", "--chmod=+x", "--", "script.sh"},
+			expectedExecutable: true,
+			expectedFileMode:   0o700,
+		},
+		{
+			testName:         "symlink can't be toggled",
+			lsFilesOutput:    "120000 e69de29bb2d1d6434b8b29ae775ad8c2e48c5391 0\tscript.sh\n",
+			fileMode:         0o644,
+			expectedError:    "The executable bit can only be toggled for regular files, but the file has mode 120000.",
+			expectedFileMode: 0o644,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			assert.NoError(t, afero.WriteFile(fs, "script.sh", []byte("#!/bin/sh\n"), s.fileMode))
+
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"ls-files", "--stage", "--", "script.sh"}, s.lsFilesOutput, nil)
+			if s.expectedArgs != nil {
+				runner.ExpectGitArgs(s.expectedArgs, "", nil)
+			}
+			instance := buildWorkingTreeCommands(commonDeps{runner: runner, fs: fs})
+
+			executable, err := instance.ToggleExecutable("script.sh")
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, s.expectedExecutable, executable)
+			runner.CheckForMissingCalls()
+
+			info, err := fs.Stat("script.sh")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedFileMode, info.Mode().Perm())
+		})
+	}
+}
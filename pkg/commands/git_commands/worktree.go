@@ -27,15 +27,31 @@ type NewWorktreeOpts struct {
 
 	// optional. if empty, and if detach is false, we will checkout the base
 	Branch string
+
+	// if true, the new branch is set up to track the base, which must be a
+	// remote branch. Requires Branch to be set.
+	Track bool
+
+	// if true, the worktree is locked right after it's created so that it
+	// can't be pruned, moved or removed
+	Lock bool
+	// optional. Only used if Lock is true.
+	LockReason string
 }
 
 func (self *WorktreeCommands) New(opts NewWorktreeOpts) error {
 	if opts.Detach && opts.Branch != "" {
 		panic("cannot specify branch when detaching")
 	}
+	if opts.Track && opts.Branch == "" {
+		panic("cannot track without specifying a branch")
+	}
 
 	cmdArgs := NewGitCmd("worktree").Arg("add").
 		ArgIf(opts.Detach, "--detach").
+		ArgIf(opts.Lock, "--lock").
+		ArgIf(opts.Lock && opts.LockReason != "", "--reason", opts.LockReason).
+		ArgIf(opts.Track, "--track").
 		ArgIf(opts.Branch != "", "-b", opts.Branch).
 		Arg(opts.Path, opts.Base)
 
@@ -0,0 +1,55 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorktreeNew(t *testing.T) {
+	type scenario struct {
+		testName     string
+		opts         NewWorktreeOpts
+		expectedArgs []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "check out base",
+			opts:         NewWorktreeOpts{Path: "../wt", Base: "mybranch"},
+			expectedArgs: []string{"worktree", "add", "../wt", "mybranch"},
+		},
+		{
+			testName:     "detached",
+			opts:         NewWorktreeOpts{Path: "../wt", Base: "v1.0", Detach: true},
+			expectedArgs: []string{"worktree", "add", "--detach", "../wt", "v1.0"},
+		},
+		{
+			testName:     "new branch tracking remote branch",
+			opts:         NewWorktreeOpts{Path: "../wt", Base: "origin/feature", Branch: "feature", Track: true},
+			expectedArgs: []string{"worktree", "add", "--track", "-b", "feature", "../wt", "origin/feature"},
+		},
+		{
+			testName:     "locked with reason",
+			opts:         NewWorktreeOpts{Path: "../wt", Base: "mybranch", Lock: true, LockReason: "on usb drive"},
+			expectedArgs: []string{"worktree", "add", "--lock", "--reason", "on usb drive", "../wt", "mybranch"},
+		},
+		{
+			testName:     "locked without reason",
+			opts:         NewWorktreeOpts{Path: "../wt", Base: "mybranch", Branch: "new", Lock: true},
+			expectedArgs: []string{"worktree", "add", "--lock", "-b", "new", "../wt", "mybranch"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).
+				ExpectGitArgs(s.expectedArgs, "", nil)
+			instance := buildWorktreeCommands(commonDeps{runner: runner})
+
+			assert.NoError(t, instance.New(s.opts))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package hosting_service
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Sources of API tokens besides environment variables, see the
+// hostingServiceTokenSources config
+const (
+	// The CLI of the service that the user is logged into, e.g. gh or glab
+	TokenSourceCLI = "cli"
+	// The credentials that git's credential helpers store for the service's
+	// domain
+	TokenSourceGitCredential = "gitCredential"
+)
+
+// Looks up API tokens in the tools that the user is already logged into the
+// hosting service with, so that they don't need to set an environment
+// variable
+type CredentialLookup struct {
+	// Runs the command with the given input and returns its output
+	runCommand func(args []string, stdin string) (string, error)
+
+	mutex sync.Mutex
+	// The tokens found by source, provider and web domain, so that each
+	// command runs only once. Misses aren't cached so that logging in while
+	// lazygit is running takes effect.
+	cache map[string]string
+}
+
+func NewCredentialLookup(runCommand func(args []string, stdin string) (string, error)) *CredentialLookup {
+	return &CredentialLookup{
+		runCommand: runCommand,
+		cache:      map[string]string{},
+	}
+}
+
+// Returns the token of the first source that has one for the service
+func (self *CredentialLookup) getToken(service *Service, sources []string) string {
+	for _, source := range sources {
+		if token := self.getTokenFromSource(service, source); token != "" {
+			return token
+		}
+	}
+	return ""
+}
+
+func (self *CredentialLookup) getTokenFromSource(service *Service, source string) string {
+	webDomain := service.repoInfo["webDomain"]
+	key := strings.Join([]string{source, service.provider, webDomain}, ":")
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if token, ok := self.cache[key]; ok {
+		return token
+	}
+
+	token := ""
+	switch source {
+	case TokenSourceCLI:
+		token = self.getCLIToken(service)
+	case TokenSourceGitCredential:
+		token = self.getGitCredential(webDomain)
+	}
+
+	if token != "" {
+		self.cache[key] = token
+	}
+	return token
+}
+
+func (self *CredentialLookup) getCLIToken(service *Service) string {
+	if len(service.apiAuth.cliTokenCommand) == 0 {
+		return ""
+	}
+
+	args := lo.Map(service.apiAuth.cliTokenCommand, func(arg string, _ int) string {
+		return utils.ResolvePlaceholderString(arg, service.repoInfo)
+	})
+	output, err := self.runCommand(args, "")
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(output)
+}
+
+// Asks git for the password of the service's domain, which for the services
+// we support can be an access token. The web domain may include a path,
+// which git's credential helpers don't need.
+func (self *CredentialLookup) getGitCredential(webDomain string) string {
+	host, _, _ := strings.Cut(webDomain, "/")
+	output, err := self.runCommand([]string{"git", "credential", "fill"}, "protocol=https\nhost="+host+"\n\n")
+	if err != nil {
+		return ""
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		if password, ok := strings.CutPrefix(line, "password="); ok {
+			return strings.TrimSpace(password)
+		}
+	}
+	return ""
+}
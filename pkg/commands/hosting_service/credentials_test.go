@@ -0,0 +1,132 @@
+package hosting_service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/fakes"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredentialLookup(t *testing.T) {
+	type scenario struct {
+		testName         string
+		remoteUrl        string
+		tokenSources     []string
+		outputs          map[string]string
+		expectedToken    string
+		expectedCommands []string
+		// The commands that run again when looking up the token a second
+		// time, because they didn't find one the first time
+		expectedCommandsOnRetry []string
+	}
+
+	credentialInput := func(host string) string {
+		return "git credential fill < protocol=https\nhost=" + host + "\n\n"
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "gh",
+			remoteUrl:    "git@github.com:peter/calculator.git",
+			tokenSources: []string{TokenSourceCLI, TokenSourceGitCredential},
+			outputs: map[string]string{
+				"gh auth token --hostname github.com": "gho_secret\n",
+			},
+			expectedToken:    "gho_secret",
+			expectedCommands: []string{"gh auth token --hostname github.com"},
+		},
+		{
+			testName:     "glab, falling back to git credential",
+			remoteUrl:    "git@gitlab.com:peter/calculator.git",
+			tokenSources: []string{TokenSourceCLI, TokenSourceGitCredential},
+			outputs: map[string]string{
+				credentialInput("gitlab.com"): "protocol=https\nhost=gitlab.com\nusername=peter\npassword=glpat-secret\n",
+			},
+			expectedToken: "glpat-secret",
+			expectedCommands: []string{
+				"glab config get token --host gitlab.com",
+				credentialInput("gitlab.com"),
+			},
+			expectedCommandsOnRetry: []string{"glab config get token --host gitlab.com"},
+		},
+		{
+			testName:     "service without a CLI",
+			remoteUrl:    "git@codeberg.org:peter/calculator.git",
+			tokenSources: []string{TokenSourceCLI, TokenSourceGitCredential},
+			outputs: map[string]string{
+				credentialInput("codeberg.org"): "password=secret\n",
+			},
+			expectedToken:    "secret",
+			expectedCommands: []string{credentialInput("codeberg.org")},
+		},
+		{
+			testName:                "sources in the configured order",
+			remoteUrl:               "git@github.com:peter/calculator.git",
+			tokenSources:            []string{TokenSourceGitCredential},
+			outputs:                 map[string]string{},
+			expectedToken:           "",
+			expectedCommands:        []string{credentialInput("github.com")},
+			expectedCommandsOnRetry: []string{credentialInput("github.com")},
+		},
+		{
+			testName:         "no sources",
+			remoteUrl:        "git@github.com:peter/calculator.git",
+			tokenSources:     []string{},
+			expectedToken:    "",
+			expectedCommands: nil,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			t.Setenv("GITHUB_TOKEN", "")
+			t.Setenv("GH_TOKEN", "")
+			t.Setenv("GITLAB_TOKEN", "")
+			t.Setenv("FORGEJO_TOKEN", "")
+			t.Setenv("GITEA_TOKEN", "")
+
+			var commands []string
+			credentialLookup := NewCredentialLookup(func(args []string, stdin string) (string, error) {
+				command := strings.Join(args, " ")
+				if stdin != "" {
+					command += " < " + stdin
+				}
+				commands = append(commands, command)
+				if output, ok := s.outputs[command]; ok {
+					return output, nil
+				}
+				return "", errors.New("not logged in")
+			})
+
+			tr := i18n.EnglishTranslationSet()
+			log := &fakes.FakeFieldLogger{}
+			mgr := NewHostingServiceMgr(log, tr, s.remoteUrl, nil).WithCredentialLookup(credentialLookup, s.tokenSources)
+			assert.Equal(t, s.expectedToken, mgr.GetReleaseAPIToken())
+			assert.Equal(t, s.expectedCommands, commands)
+
+			// Found tokens are cached, so only the sources that had none are
+			// asked again
+			commands = nil
+			assert.Equal(t, s.expectedToken, mgr.GetReleaseAPIToken())
+			assert.Equal(t, s.expectedCommandsOnRetry, commands)
+		})
+	}
+}
+
+func TestCredentialLookupPrefersEnvironmentVariables(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "env-secret")
+
+	credentialLookup := NewCredentialLookup(func(args []string, stdin string) (string, error) {
+		t.Fatalf("unexpected command %v", args)
+		return "", nil
+	})
+
+	tr := i18n.EnglishTranslationSet()
+	log := &fakes.FakeFieldLogger{}
+	mgr := NewHostingServiceMgr(log, tr, "git@github.com:peter/calculator.git", nil).
+		WithCredentialLookup(credentialLookup, []string{TokenSourceCLI, TokenSourceGitCredential})
+	assert.Equal(t, "env-secret", mgr.GetPullRequestAPIToken())
+}
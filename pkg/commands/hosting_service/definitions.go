@@ -1,5 +1,11 @@
 package hosting_service
 
+import (
+	"net/http"
+
+	"github.com/samber/lo"
+)
+
 // if you want to make a custom regex for a given service feel free to test it out
 // at regoio.herokuapp.com
 var defaultUrlRegexStrings = []string{
@@ -15,8 +21,57 @@ var githubServiceDef = ServiceDefinition{
 	pullRequestURLIntoDefaultBranch: "/compare/{{.From}}?expand=1",
 	pullRequestURLIntoTargetBranch:  "/compare/{{.To}}...{{.From}}?expand=1",
 	commitURL:                       "/commit/{{.CommitHash}}",
+	newReleaseURL:                   "/releases/new?tag={{.Tag}}&title={{.Title}}&body={{.Body}}",
+	forkBranchTemplate:              "{{.owner}}:{{.branch}}",
 	regexStrings:                    defaultUrlRegexStrings,
 	repoURLTemplate:                 defaultRepoURLTemplate,
+	apiAuth: &apiAuthDefinition{
+		tokenEnvVars:    []string{"GITHUB_TOKEN", "GH_TOKEN"},
+		cliTokenCommand: []string{"gh", "auth", "token", "--hostname", "{{.webDomain}}"},
+		authHeader:      "Authorization",
+		authValuePrefix: "Bearer ",
+	},
+	releaseAPI: &releaseAPIDefinition{
+		// {{.apiDomain}} is api.github.com for github.com, and <webDomain>/api/v3
+		// for GitHub Enterprise
+		urlTemplate: "https://{{.apiDomain}}/repos/{{.owner}}/{{.repo}}/releases",
+		notesField:  "body",
+	},
+	pullRequestAPI: &pullRequestAPIDefinition{
+		urlTemplate:          "https://{{.apiDomain}}/repos/{{.owner}}/{{.repo}}/pulls",
+		listQuery:            "?state=open&per_page=100",
+		pipelinesURLTemplate: "https://{{.apiDomain}}/repos/{{.owner}}/{{.repo}}/actions/runs?per_page=1&branch={{.Branch}}",
+		descriptionField:     "body",
+		sourceBranchField:    "head",
+		targetBranchField:    "base",
+		referencePrefix:      "#",
+		parsePullRequests:    parseGitHubPullRequests,
+		parsePipelineStatus:  parseGitHubPipelineStatus,
+		templatePaths: []string{
+			".github/pull_request_template.md",
+			".github/PULL_REQUEST_TEMPLATE.md",
+			"pull_request_template.md",
+			"PULL_REQUEST_TEMPLATE.md",
+			"docs/pull_request_template.md",
+			"docs/PULL_REQUEST_TEMPLATE.md",
+		},
+	},
+	issueAPI: &issueAPIDefinition{
+		urlTemplate:     "https://{{.apiDomain}}/repos/{{.owner}}/{{.repo}}/issues?state=open&per_page=100",
+		assignedQuery:   "&assignee={{.User}}",
+		userURLTemplate: "https://{{.apiDomain}}/user",
+		assignIssue: &issueUpdateDefinition{
+			method:      http.MethodPost,
+			urlTemplate: "https://{{.apiDomain}}/repos/{{.owner}}/{{.repo}}/issues/{{.Number}}/assignees",
+			payload:     assignByName,
+		},
+		labelIssue: &issueUpdateDefinition{
+			method:      http.MethodPost,
+			urlTemplate: "https://{{.apiDomain}}/repos/{{.owner}}/{{.repo}}/issues/{{.Number}}/labels",
+			payload:     labelByName,
+		},
+		parseIssues: parseGitHubIssues,
+	},
 }
 
 var bitbucketServiceDef = ServiceDefinition{
@@ -36,8 +91,60 @@ var gitLabServiceDef = ServiceDefinition{
 	pullRequestURLIntoDefaultBranch: "/-/merge_requests/new?merge_request%5Bsource_branch%5D={{.From}}",
 	pullRequestURLIntoTargetBranch:  "/-/merge_requests/new?merge_request%5Bsource_branch%5D={{.From}}&merge_request%5Btarget_branch%5D={{.To}}",
 	commitURL:                       "/-/commit/{{.CommitHash}}",
+	newReleaseURL:                   "/-/releases/new?tag_name={{.Tag}}",
 	regexStrings:                    defaultUrlRegexStrings,
 	repoURLTemplate:                 defaultRepoURLTemplate,
+	apiAuth: &apiAuthDefinition{
+		tokenEnvVars:    []string{"GITLAB_TOKEN"},
+		cliTokenCommand: []string{"glab", "config", "get", "token", "--host", "{{.webDomain}}"},
+		// Unlike the PRIVATE-TOKEN header, this also accepts the OAuth tokens
+		// that glab and git credential helpers may have
+		authHeader:      "Authorization",
+		authValuePrefix: "Bearer ",
+	},
+	releaseAPI: &releaseAPIDefinition{
+		urlTemplate: "https://{{.webDomain}}/api/v4/projects/{{.projectPath}}/releases",
+		notesField:  "description",
+	},
+	pullRequestAPI: &pullRequestAPIDefinition{
+		urlTemplate: "https://{{.webDomain}}/api/v4/projects/{{.projectPath}}/merge_requests",
+		// Merge requests from forks are created in the fork's project, with
+		// the ID of the target project in the payload
+		projectURLTemplate:   "https://{{.webDomain}}/api/v4/projects/{{.projectPath}}",
+		targetProjectField:   "target_project_id",
+		listQuery:            "?state=opened&per_page=100",
+		pipelinesURLTemplate: "https://{{.webDomain}}/api/v4/projects/{{.projectPath}}/pipelines?per_page=1&ref={{.Branch}}",
+		descriptionField:     "description",
+		sourceBranchField:    "source_branch",
+		targetBranchField:    "target_branch",
+		referencePrefix:      "!",
+		parsePullRequests:    parseGitLabMergeRequests,
+		parsePipelineStatus:  parseGitLabPipelineStatus,
+		templatePaths: []string{
+			".gitlab/merge_request_templates/Default.md",
+			".gitlab/merge_request_templates/default.md",
+		},
+	},
+	issueAPI: &issueAPIDefinition{
+		urlTemplate:     "https://{{.webDomain}}/api/v4/projects/{{.projectPath}}/issues?state=opened&per_page=100",
+		assignedQuery:   "&assignee_username={{.User}}",
+		userURLTemplate: "https://{{.webDomain}}/api/v4/user",
+		assignIssue: &issueUpdateDefinition{
+			method:      http.MethodPut,
+			urlTemplate: "https://{{.webDomain}}/api/v4/projects/{{.projectPath}}/issues/{{.Number}}",
+			payload: func(user *apiUser, _ string, _ []string) map[string]any {
+				return map[string]any{"assignee_ids": []int{user.ID}}
+			},
+		},
+		labelIssue: &issueUpdateDefinition{
+			method:      http.MethodPut,
+			urlTemplate: "https://{{.webDomain}}/api/v4/projects/{{.projectPath}}/issues/{{.Number}}",
+			payload: func(_ *apiUser, label string, _ []string) map[string]any {
+				return map[string]any{"add_labels": label}
+			},
+		},
+		parseIssues: parseGitLabIssues,
+	},
 }
 
 var azdoServiceDef = ServiceDefinition{
@@ -71,8 +178,97 @@ var giteaServiceDef = ServiceDefinition{
 	pullRequestURLIntoDefaultBranch: "/compare/{{.From}}",
 	pullRequestURLIntoTargetBranch:  "/compare/{{.To}}...{{.From}}",
 	commitURL:                       "/commit/{{.CommitHash}}",
+	newReleaseURL:                   "/releases/new?tag={{.Tag}}",
+	forkBranchTemplate:              "{{.owner}}:{{.branch}}",
+	regexStrings:                    defaultUrlRegexStrings,
+	repoURLTemplate:                 defaultRepoURLTemplate,
+	apiAuth: &apiAuthDefinition{
+		tokenEnvVars:    []string{"GITEA_TOKEN"},
+		authHeader:      "Authorization",
+		authValuePrefix: "token ",
+	},
+	releaseAPI:     giteaReleaseAPI,
+	pullRequestAPI: giteaPullRequestAPI(".gitea"),
+	issueAPI:       giteaIssueAPI,
+}
+
+// Forgejo is a fork of Gitea with a compatible web interface and API. Its
+// instances may also accept Gitea tokens and templates.
+var forgejoServiceDef = ServiceDefinition{
+	provider:                        "forgejo",
+	pullRequestURLIntoDefaultBranch: giteaServiceDef.pullRequestURLIntoDefaultBranch,
+	pullRequestURLIntoTargetBranch:  giteaServiceDef.pullRequestURLIntoTargetBranch,
+	commitURL:                       giteaServiceDef.commitURL,
+	newReleaseURL:                   giteaServiceDef.newReleaseURL,
+	forkBranchTemplate:              giteaServiceDef.forkBranchTemplate,
 	regexStrings:                    defaultUrlRegexStrings,
 	repoURLTemplate:                 defaultRepoURLTemplate,
+	apiAuth: &apiAuthDefinition{
+		tokenEnvVars:    []string{"FORGEJO_TOKEN", "GITEA_TOKEN"},
+		authHeader:      "Authorization",
+		authValuePrefix: "token ",
+	},
+	releaseAPI:     giteaReleaseAPI,
+	pullRequestAPI: giteaPullRequestAPI(".forgejo", ".gitea"),
+	issueAPI:       giteaIssueAPI,
+}
+
+var giteaReleaseAPI = &releaseAPIDefinition{
+	urlTemplate: "https://{{.webDomain}}/api/v1/repos/{{.owner}}/{{.repo}}/releases",
+	notesField:  "body",
+}
+
+var giteaIssueAPI = &issueAPIDefinition{
+	urlTemplate:     "https://{{.webDomain}}/api/v1/repos/{{.owner}}/{{.repo}}/issues?state=open&type=issues&limit=50",
+	assignedQuery:   "&assigned_by={{.User}}",
+	userURLTemplate: "https://{{.webDomain}}/api/v1/user",
+	assignIssue: &issueUpdateDefinition{
+		method:        http.MethodPatch,
+		urlTemplate:   "https://{{.webDomain}}/api/v1/repos/{{.owner}}/{{.repo}}/issues/{{.Number}}",
+		payload:       assignByName,
+		keepAssignees: true,
+	},
+	labelIssue: &issueUpdateDefinition{
+		method:      http.MethodPost,
+		urlTemplate: "https://{{.webDomain}}/api/v1/repos/{{.owner}}/{{.repo}}/issues/{{.Number}}/labels",
+		payload:     labelByName,
+	},
+	parseIssues: parseGitHubIssues,
+}
+
+// Payloads of GitHub's and Gitea's issue updates. Gitea replaces the
+// issue's assignees whereas GitHub adds to them, so for Gitea the existing
+// assignees are passed in to be sent along; both add the labels.
+func assignByName(user *apiUser, _ string, assignees []string) map[string]any {
+	return map[string]any{"assignees": lo.Uniq(append(assignees, user.name()))}
+}
+
+func labelByName(_ *apiUser, label string, _ []string) map[string]any {
+	return map[string]any{"labels": []string{label}}
+}
+
+// Gitea's pull request API is modelled after GitHub's, but CI results are
+// only exposed as commit statuses, which both Gitea Actions and external CI
+// systems report to. The template directories are checked in order, with
+// .github as the fallback that Gitea also supports.
+func giteaPullRequestAPI(templateDirs ...string) *pullRequestAPIDefinition {
+	templatePaths := []string{}
+	for _, dir := range append(templateDirs, ".github") {
+		templatePaths = append(templatePaths, dir+"/pull_request_template.md", dir+"/PULL_REQUEST_TEMPLATE.md")
+	}
+
+	return &pullRequestAPIDefinition{
+		urlTemplate:          "https://{{.webDomain}}/api/v1/repos/{{.owner}}/{{.repo}}/pulls",
+		listQuery:            "?state=open&limit=50",
+		pipelinesURLTemplate: "https://{{.webDomain}}/api/v1/repos/{{.owner}}/{{.repo}}/commits/{{.Branch}}/status",
+		descriptionField:     "body",
+		sourceBranchField:    "head",
+		targetBranchField:    "base",
+		referencePrefix:      "#",
+		parsePullRequests:    parseGitHubPullRequests,
+		parsePipelineStatus:  parseGiteaPipelineStatus,
+		templatePaths:        templatePaths,
+	}
 }
 
 var serviceDefinitions = []ServiceDefinition{
@@ -82,6 +278,7 @@ var serviceDefinitions = []ServiceDefinition{
 	azdoServiceDef,
 	bitbucketServerServiceDef,
 	giteaServiceDef,
+	forgejoServiceDef,
 }
 
 var defaultServiceDomains = []ServiceDomain{
@@ -110,4 +307,9 @@ var defaultServiceDomains = []ServiceDomain{
 		gitDomain:         "try.gitea.io",
 		webDomain:         "try.gitea.io",
 	},
+	{
+		serviceDefinition: forgejoServiceDef,
+		gitDomain:         "codeberg.org",
+		webDomain:         "codeberg.org",
+	},
 }
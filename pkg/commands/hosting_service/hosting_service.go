@@ -1,9 +1,14 @@
 package hosting_service
 
 import (
+	"bytes"
+	"encoding/json"
+	"net/http"
 	"net/url"
+	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
@@ -19,6 +24,11 @@ import (
 // and this package's responsibility is to determine which service you're using based on the remote URL,
 // and then which URL you need for whatever use case you have.
 
+// Used for all requests to the services' APIs. Unlike http.DefaultClient it
+// has a timeout, so that a server that doesn't respond can't keep lazygit
+// waiting forever.
+var apiClient = &http.Client{Timeout: 30 * time.Second}
+
 type HostingServiceMgr struct {
 	log       logrus.FieldLogger
 	tr        *i18n.TranslationSet
@@ -26,6 +36,24 @@ type HostingServiceMgr struct {
 
 	// see https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-pull-request-urls
 	configServiceDomains map[string]string
+
+	// see WithRemoteService
+	remoteService RemoteService
+
+	// see WithCredentialLookup
+	credentialLookup *CredentialLookup
+	tokenSources     []string
+}
+
+// The hosting service configured for a specific remote in the remoteServices
+// config, e.g. for a self-hosted Forgejo instance whose domain is not known
+type RemoteService struct {
+	// If set, overrides the service derived from the remote URL
+	Provider  string
+	WebDomain string
+	// If set, checked for an API token before the service's default
+	// environment variables
+	TokenEnvVar string
 }
 
 // NewHostingServiceMgr creates new instance of PullRequest
@@ -38,6 +66,19 @@ func NewHostingServiceMgr(log logrus.FieldLogger, tr *i18n.TranslationSet, remot
 	}
 }
 
+// Makes the API token be looked up in the given sources (see
+// TokenSourceCLI) if none of the service's environment variables is set
+func (self *HostingServiceMgr) WithCredentialLookup(credentialLookup *CredentialLookup, tokenSources []string) *HostingServiceMgr {
+	self.credentialLookup = credentialLookup
+	self.tokenSources = tokenSources
+	return self
+}
+
+func (self *HostingServiceMgr) WithRemoteService(remoteService RemoteService) *HostingServiceMgr {
+	self.remoteService = remoteService
+	return self
+}
+
 func (self *HostingServiceMgr) GetPullRequestURL(from string, to string) (string, error) {
 	gitService, err := self.getService()
 	if err != nil {
@@ -50,6 +91,27 @@ func (self *HostingServiceMgr) GetPullRequestURL(from string, to string) (string
 	return gitService.getPullRequestURLIntoTargetBranch(url.QueryEscape(from), url.QueryEscape(to)), nil
 }
 
+// Returns the URL for creating a pull request in this remote's repo from a
+// branch in a fork of it, e.g. on GitHub a compare URL with the branch
+// qualified by the fork's owner. Returns an error if the service doesn't
+// support that in its URLs.
+func (self *HostingServiceMgr) GetPullRequestURLFromFork(forkRemoteURL string, from string, to string) (string, error) {
+	gitService, err := self.getService()
+	if err != nil {
+		return "", err
+	}
+
+	forkBranch, err := gitService.getForkBranch(forkRemoteURL, url.QueryEscape(from))
+	if err != nil {
+		return "", errors.New(self.tr.PullRequestsFromForksNotSupported)
+	}
+
+	if to == "" {
+		return gitService.getPullRequestURLIntoDefaultBranch(forkBranch), nil
+	}
+	return gitService.getPullRequestURLIntoTargetBranch(forkBranch, url.QueryEscape(to)), nil
+}
+
 func (self *HostingServiceMgr) GetCommitURL(commitHash string) (string, error) {
 	gitService, err := self.getService()
 	if err != nil {
@@ -61,24 +123,140 @@ func (self *HostingServiceMgr) GetCommitURL(commitHash string) (string, error) {
 	return pullRequestURL, nil
 }
 
+// Returns the URL of the page for creating a new release for the given tag,
+// pre-filled with the title and notes where the service supports it.
+func (self *HostingServiceMgr) GetNewReleaseURL(tag string, title string, notes string) (string, error) {
+	gitService, err := self.getService()
+	if err != nil {
+		return "", err
+	}
+
+	if gitService.newReleaseURL == "" {
+		return "", errors.New(self.tr.ReleasesNotSupported)
+	}
+
+	return gitService.getNewReleaseURL(url.QueryEscape(tag), url.QueryEscape(title), url.QueryEscape(notes)), nil
+}
+
+// Returns the API token for creating releases, see GetPullRequestAPIToken.
+// Returns an empty string if no token is found or the service has no release
+// API.
+func (self *HostingServiceMgr) GetReleaseAPIToken() string {
+	gitService, err := self.getService()
+	if err != nil || gitService.releaseAPI == nil {
+		return ""
+	}
+
+	return gitService.getAPIToken()
+}
+
+// Creates a release for the given tag through the service's API and returns
+// the URL of the new release's web page.
+func (self *HostingServiceMgr) CreateRelease(tag string, title string, notes string, token string) (string, error) {
+	req, err := self.newCreateReleaseRequest(tag, title, notes, token)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := apiClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var response struct {
+		HTMLURL string `json:"html_url"`
+		Links   struct {
+			Self string `json:"self"`
+		} `json:"_links"`
+		Message string `json:"message"`
+	}
+	// Error responses aren't necessarily JSON, so a decoding error is only
+	// reported if the request itself succeeded.
+	decodeErr := json.NewDecoder(resp.Body).Decode(&response)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if response.Message != "" {
+			return "", errors.Errorf("%s: %s", resp.Status, response.Message)
+		}
+		return "", errors.New(resp.Status)
+	}
+	if decodeErr != nil {
+		return "", decodeErr
+	}
+
+	if response.HTMLURL != "" {
+		return response.HTMLURL, nil
+	}
+	return response.Links.Self, nil
+}
+
+func (self *HostingServiceMgr) newCreateReleaseRequest(tag string, title string, notes string, token string) (*http.Request, error) {
+	gitService, err := self.getService()
+	if err != nil {
+		return nil, err
+	}
+
+	releaseAPI := gitService.releaseAPI
+	if releaseAPI == nil {
+		return nil, errors.New(self.tr.ReleasesNotSupported)
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"tag_name":            tag,
+		"name":                title,
+		releaseAPI.notesField: notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := utils.ResolvePlaceholderString(releaseAPI.urlTemplate, gitService.repoInfo)
+	req, err := http.NewRequest(http.MethodPost, apiURL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	gitService.setAPIHeaders(req, token)
+
+	return req, nil
+}
+
 func (self *HostingServiceMgr) getService() (*Service, error) {
 	serviceDomain, err := self.getServiceDomain(self.remoteURL)
 	if err != nil {
 		return nil, err
 	}
 
-	repoURL, err := serviceDomain.serviceDefinition.getRepoURLFromRemoteURL(self.remoteURL, serviceDomain.webDomain)
+	repoInfo, err := serviceDomain.serviceDefinition.getRepoInfoFromRemoteURL(self.remoteURL, serviceDomain.webDomain)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Service{
-		repoURL:           repoURL,
+		repoURL:           utils.ResolvePlaceholderString(serviceDomain.serviceDefinition.repoURLTemplate, repoInfo),
+		repoInfo:          repoInfo,
+		tokenEnvVar:       self.remoteService.TokenEnvVar,
+		credentialLookup:  self.credentialLookup,
+		tokenSources:      self.tokenSources,
 		ServiceDefinition: serviceDomain.serviceDefinition,
 	}, nil
 }
 
 func (self *HostingServiceMgr) getServiceDomain(repoURL string) (*ServiceDomain, error) {
+	if self.remoteService.Provider != "" {
+		serviceDefinition, ok := lo.Find(serviceDefinitions, func(serviceDefinition ServiceDefinition) bool {
+			return serviceDefinition.provider == self.remoteService.Provider
+		})
+		if !ok {
+			return nil, errors.Errorf(self.tr.UnknownGitServiceProvider, self.remoteService.Provider, strings.Join(providerNames(), ", "))
+		}
+
+		return &ServiceDomain{
+			webDomain:         self.remoteService.WebDomain,
+			serviceDefinition: serviceDefinition,
+		}, nil
+	}
+
 	candidateServiceDomains := self.getCandidateServiceDomains()
 
 	for _, serviceDomain := range candidateServiceDomains {
@@ -109,11 +287,7 @@ func (self *HostingServiceMgr) getCandidateServiceDomains() []ServiceDomain {
 
 		serviceDefinition, ok := serviceDefinitionByProvider[provider]
 		if !ok {
-			providerNames := lo.Map(serviceDefinitions, func(serviceDefinition ServiceDefinition, _ int) string {
-				return serviceDefinition.provider
-			})
-
-			self.log.Errorf("Unknown git service type: '%s'. Expected one of %s", provider, strings.Join(providerNames, ", "))
+			self.log.Errorf("Unknown git service type: '%s'. Expected one of %s", provider, strings.Join(providerNames(), ", "))
 			continue
 		}
 
@@ -127,6 +301,12 @@ func (self *HostingServiceMgr) getCandidateServiceDomains() []ServiceDomain {
 	return serviceDomains
 }
 
+func providerNames() []string {
+	return lo.Map(serviceDefinitions, func(serviceDefinition ServiceDefinition, _ int) string {
+		return serviceDefinition.provider
+	})
+}
+
 // a service domains pairs a service definition with the actual domain it's being served from.
 // Sometimes the git service is hosted in a custom domains so although it'll use say
 // the github service definition, it'll actually be served from e.g. my-custom-github.com
@@ -141,27 +321,75 @@ type ServiceDefinition struct {
 	pullRequestURLIntoDefaultBranch string
 	pullRequestURLIntoTargetBranch  string
 	commitURL                       string
-	regexStrings                    []string
+	// empty if the service has no page for creating a release
+	newReleaseURL string
+	// How a branch in a fork is referred to when creating a pull request in
+	// the upstream repo. Can use {{.branch}} and the placeholders of the
+	// fork's repoURLTemplate. Empty if the service doesn't support that.
+	forkBranchTemplate string
+	regexStrings       []string
 
 	// can expect 'webdomain' to be passed in. Otherwise, you get to pick what we match in the regex
 	repoURLTemplate string
+
+	// nil if we don't support the service's API at all
+	apiAuth *apiAuthDefinition
+	// nil if we don't support creating releases through the service's API
+	releaseAPI *releaseAPIDefinition
+	// nil if we don't support pull requests through the service's API
+	pullRequestAPI *pullRequestAPIDefinition
+	// nil if we don't support listing issues through the service's API
+	issueAPI *issueAPIDefinition
+}
+
+type apiAuthDefinition struct {
+	// Environment variables that are checked, in order, for an API token
+	tokenEnvVars []string
+	// Command printing the token of the service's CLI, if it has one. Can
+	// use the same placeholders as repoURLTemplate.
+	cliTokenCommand []string
+	// Header used for passing the token, and the prefix of its value
+	authHeader      string
+	authValuePrefix string
 }
 
-func (self ServiceDefinition) getRepoURLFromRemoteURL(url string, webDomain string) (string, error) {
+type releaseAPIDefinition struct {
+	// URL of the endpoint for creating a release. Can use the same placeholders
+	// as repoURLTemplate, plus {{.apiDomain}} and {{.projectPath}} (the
+	// URL-encoded "owner/repo").
+	urlTemplate string
+	// Key of the release notes in the request payload
+	notesField string
+}
+
+// Returns the values of the named groups of the first regex matching the
+// remote url, along with the derived placeholders used in URL templates
+func (self ServiceDefinition) getRepoInfoFromRemoteURL(remoteURL string, webDomain string) (map[string]string, error) {
 	for _, regexStr := range self.regexStrings {
 		re := regexp.MustCompile(regexStr)
-		input := utils.FindNamedMatches(re, url)
+		input := utils.FindNamedMatches(re, remoteURL)
 		if input != nil {
 			input["webDomain"] = webDomain
-			return utils.ResolvePlaceholderString(self.repoURLTemplate, input), nil
+			input["apiDomain"] = webDomain + "/api/v3"
+			if webDomain == "github.com" {
+				input["apiDomain"] = "api.github.com"
+			}
+			input["projectPath"] = url.QueryEscape(input["owner"] + "/" + input["repo"])
+			return input, nil
 		}
 	}
 
-	return "", errors.New("Failed to parse repo information from url")
+	return nil, errors.New("Failed to parse repo information from url")
 }
 
 type Service struct {
-	repoURL string
+	repoURL  string
+	repoInfo map[string]string
+	// see RemoteService.TokenEnvVar
+	tokenEnvVar string
+	// see HostingServiceMgr.WithCredentialLookup
+	credentialLookup *CredentialLookup
+	tokenSources     []string
 	ServiceDefinition
 }
 
@@ -177,6 +405,56 @@ func (self *Service) getCommitURL(commitHash string) string {
 	return self.resolveUrl(self.commitURL, map[string]string{"CommitHash": commitHash})
 }
 
+func (self *Service) getNewReleaseURL(tag string, title string, notes string) string {
+	return self.resolveUrl(self.newReleaseURL, map[string]string{"Tag": tag, "Title": title, "Body": notes})
+}
+
+// Qualifies a branch of the given fork of the service's repo so that it can
+// be used as the source of a pull request in the repo
+func (self *Service) getForkBranch(forkRemoteURL string, branch string) (string, error) {
+	if self.forkBranchTemplate == "" {
+		return "", errors.New("Pull requests from forks are not supported")
+	}
+
+	forkRepoInfo, err := self.getRepoInfoFromRemoteURL(forkRemoteURL, self.repoInfo["webDomain"])
+	if err != nil {
+		return "", err
+	}
+
+	return utils.ResolvePlaceholderString(self.forkBranchTemplate,
+		lo.Assign(forkRepoInfo, map[string]string{"branch": branch})), nil
+}
+
+func (self *Service) getAPIToken() string {
+	if self.apiAuth == nil {
+		return ""
+	}
+
+	if self.tokenEnvVar != "" {
+		if token := os.Getenv(self.tokenEnvVar); token != "" {
+			return token
+		}
+	}
+
+	for _, envVar := range self.apiAuth.tokenEnvVars {
+		if token := os.Getenv(envVar); token != "" {
+			return token
+		}
+	}
+
+	if self.credentialLookup != nil {
+		return self.credentialLookup.getToken(self, self.tokenSources)
+	}
+
+	return ""
+}
+
+func (self *Service) setAPIHeaders(req *http.Request, token string) {
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set(self.apiAuth.authHeader, self.apiAuth.authValuePrefix+token)
+}
+
 func (self *Service) resolveUrl(templateString string, args map[string]string) string {
 	return self.repoURL + utils.ResolvePlaceholderString(templateString, args)
 }
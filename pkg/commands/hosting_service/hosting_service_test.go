@@ -1,6 +1,7 @@
 package hosting_service
 
 import (
+	"io"
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/fakes"
@@ -413,7 +414,29 @@ func TestGetPullRequestURL(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, "https://bitbucket.org/johndoe/social_network/pull-requests/new?source=feature%2Fprofile-page&t=1", url)
 			},
-			expectedLoggedErrors: []string{"Unknown git service type: 'noservice'. Expected one of github, bitbucket, gitlab, azuredevops, bitbucketServer, gitea"},
+			expectedLoggedErrors: []string{"Unknown git service type: 'noservice'. Expected one of github, bitbucket, gitlab, azuredevops, bitbucketServer, gitea, forgejo"},
+		},
+		{
+			testName:  "Opens a link to new pull request on Codeberg",
+			from:      "feature/new",
+			to:        "dev",
+			remoteUrl: "git@codeberg.org:peter/calculator.git",
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://codeberg.org/peter/calculator/compare/dev...feature%2Fnew", url)
+			},
+		},
+		{
+			testName:  "Opens a link to new pull request on a Forgejo Server",
+			from:      "feature/new",
+			remoteUrl: "ssh://git@git.example.com:2222/peter/calculator.git",
+			configServiceDomains: map[string]string{
+				"git.example.com": "forgejo:git.example.com",
+			},
+			test: func(url string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://git.example.com/peter/calculator/compare/feature%2Fnew", url)
+			},
 		},
 		{
 			testName:  "Escapes reserved URL characters in from branch name",
@@ -447,3 +470,283 @@ func TestGetPullRequestURL(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPullRequestURLFromFork(t *testing.T) {
+	type scenario struct {
+		testName      string
+		remoteUrl     string
+		forkRemoteUrl string
+		to            string
+		expectedURL   string
+		expectedErr   string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:      "github, into default branch",
+			remoteUrl:     "https://github.com/jesseduffield/lazygit",
+			forkRemoteUrl: "git@github.com:my-personal-fork/lazygit.git",
+			expectedURL:   "https://github.com/jesseduffield/lazygit/compare/my-personal-fork:feature%2Fnew?expand=1",
+		},
+		{
+			testName:      "github, into target branch",
+			remoteUrl:     "https://github.com/jesseduffield/lazygit",
+			forkRemoteUrl: "https://github.com/my-personal-fork/lazygit",
+			to:            "master",
+			expectedURL:   "https://github.com/jesseduffield/lazygit/compare/master...my-personal-fork:feature%2Fnew?expand=1",
+		},
+		{
+			testName:      "forgejo",
+			remoteUrl:     "git@codeberg.org:peter/calculator.git",
+			forkRemoteUrl: "git@codeberg.org:paul/calculator.git",
+			to:            "main",
+			expectedURL:   "https://codeberg.org/peter/calculator/compare/main...paul:feature%2Fnew",
+		},
+		{
+			testName:      "gitlab",
+			remoteUrl:     "git@gitlab.com:peter/calculator.git",
+			forkRemoteUrl: "git@gitlab.com:paul/calculator.git",
+			expectedErr:   "Creating pull requests from a fork is not supported for this git service",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			tr := i18n.EnglishTranslationSet()
+			log := &fakes.FakeFieldLogger{}
+			hostingServiceMgr := NewHostingServiceMgr(log, tr, s.remoteUrl, nil)
+			url, err := hostingServiceMgr.GetPullRequestURLFromFork(s.forkRemoteUrl, "feature/new", s.to)
+			if s.expectedErr != "" {
+				assert.EqualError(t, err, s.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedURL, url)
+			}
+		})
+	}
+}
+
+func TestGetNewReleaseURL(t *testing.T) {
+	type scenario struct {
+		testName    string
+		remoteUrl   string
+		expectedURL string
+		expectedErr string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:    "github, with title and notes",
+			remoteUrl:   "git@github.com:peter/calculator.git",
+			expectedURL: "https://github.com/peter/calculator/releases/new?tag=v1.0.0&title=First+release&body=-+add+things%0A-+fix+%231",
+		},
+		{
+			testName:    "gitlab",
+			remoteUrl:   "git@gitlab.com:peter/calculator.git",
+			expectedURL: "https://gitlab.com/peter/calculator/-/releases/new?tag_name=v1.0.0",
+		},
+		{
+			testName:    "unsupported service",
+			remoteUrl:   "git@bitbucket.org:peter/calculator.git",
+			expectedErr: "Creating releases is not supported for this git service",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			tr := i18n.EnglishTranslationSet()
+			log := &fakes.FakeFieldLogger{}
+			hostingServiceMgr := NewHostingServiceMgr(log, tr, s.remoteUrl, nil)
+			url, err := hostingServiceMgr.GetNewReleaseURL("v1.0.0", "First release", "- add things\n- fix #1")
+			if s.expectedErr != "" {
+				assert.EqualError(t, err, s.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expectedURL, url)
+			}
+		})
+	}
+}
+
+func TestNewCreateReleaseRequest(t *testing.T) {
+	type scenario struct {
+		testName             string
+		remoteUrl            string
+		configServiceDomains map[string]string
+		expectedURL          string
+		expectedHeader       string
+		expectedHeaderValue  string
+		expectedPayload      string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:            "github",
+			remoteUrl:           "git@github.com:peter/calculator.git",
+			expectedURL:         "https://api.github.com/repos/peter/calculator/releases",
+			expectedHeader:      "Authorization",
+			expectedHeaderValue: "Bearer secret",
+			expectedPayload:     `{"body":"notes","name":"title","tag_name":"v1.0.0"}`,
+		},
+		{
+			testName:             "github enterprise",
+			remoteUrl:            "git@github.work.com:peter/calculator.git",
+			configServiceDomains: map[string]string{"github.work.com": "github:github.work.com"},
+			expectedURL:          "https://github.work.com/api/v3/repos/peter/calculator/releases",
+			expectedHeader:       "Authorization",
+			expectedHeaderValue:  "Bearer secret",
+			expectedPayload:      `{"body":"notes","name":"title","tag_name":"v1.0.0"}`,
+		},
+		{
+			testName:            "gitlab with subgroup",
+			remoteUrl:           "git@gitlab.com:group/subgroup/calculator.git",
+			expectedURL:         "https://gitlab.com/api/v4/projects/group%2Fsubgroup%2Fcalculator/releases",
+			expectedHeader:      "Authorization",
+			expectedHeaderValue: "Bearer secret",
+			expectedPayload:     `{"description":"notes","name":"title","tag_name":"v1.0.0"}`,
+		},
+		{
+			testName:            "gitea",
+			remoteUrl:           "https://try.gitea.io/peter/calculator.git",
+			expectedURL:         "https://try.gitea.io/api/v1/repos/peter/calculator/releases",
+			expectedHeader:      "Authorization",
+			expectedHeaderValue: "token secret",
+			expectedPayload:     `{"body":"notes","name":"title","tag_name":"v1.0.0"}`,
+		},
+		{
+			testName:            "forgejo",
+			remoteUrl:           "https://codeberg.org/peter/calculator.git",
+			expectedURL:         "https://codeberg.org/api/v1/repos/peter/calculator/releases",
+			expectedHeader:      "Authorization",
+			expectedHeaderValue: "token secret",
+			expectedPayload:     `{"body":"notes","name":"title","tag_name":"v1.0.0"}`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			tr := i18n.EnglishTranslationSet()
+			log := &fakes.FakeFieldLogger{}
+			hostingServiceMgr := NewHostingServiceMgr(log, tr, s.remoteUrl, s.configServiceDomains)
+			req, err := hostingServiceMgr.newCreateReleaseRequest("v1.0.0", "title", "notes", "secret")
+			assert.NoError(t, err)
+			assert.Equal(t, "POST", req.Method)
+			assert.Equal(t, s.expectedURL, req.URL.String())
+			assert.Equal(t, s.expectedHeaderValue, req.Header.Get(s.expectedHeader))
+
+			payload, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedPayload, string(payload))
+		})
+	}
+}
+
+func TestGetReleaseAPIToken(t *testing.T) {
+	tr := i18n.EnglishTranslationSet()
+	log := &fakes.FakeFieldLogger{}
+
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv("GH_TOKEN", "gh-secret")
+	t.Setenv("GITLAB_TOKEN", "")
+
+	assert.Equal(t, "gh-secret", NewHostingServiceMgr(log, tr, "git@github.com:peter/calculator.git", nil).GetReleaseAPIToken())
+	assert.Equal(t, "", NewHostingServiceMgr(log, tr, "git@gitlab.com:peter/calculator.git", nil).GetReleaseAPIToken())
+	assert.Equal(t, "", NewHostingServiceMgr(log, tr, "git@bitbucket.org:peter/calculator.git", nil).GetReleaseAPIToken())
+}
+
+func TestGetReleaseAPITokenForgejo(t *testing.T) {
+	tr := i18n.EnglishTranslationSet()
+	log := &fakes.FakeFieldLogger{}
+
+	t.Setenv("FORGEJO_TOKEN", "")
+	t.Setenv("GITEA_TOKEN", "gitea-secret")
+	assert.Equal(t, "gitea-secret", NewHostingServiceMgr(log, tr, "git@codeberg.org:peter/calculator.git", nil).GetReleaseAPIToken())
+
+	t.Setenv("FORGEJO_TOKEN", "forgejo-secret")
+	assert.Equal(t, "forgejo-secret", NewHostingServiceMgr(log, tr, "git@codeberg.org:peter/calculator.git", nil).GetReleaseAPIToken())
+}
+
+func TestWithRemoteService(t *testing.T) {
+	type scenario struct {
+		testName      string
+		remoteUrl     string
+		remoteService RemoteService
+		expectedURL   string
+		expectedToken string
+		expectedErr   string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:  "overrides the service of an unknown domain",
+			remoteUrl: "git@git.example.com:peter/calculator.git",
+			remoteService: RemoteService{
+				Provider:  "forgejo",
+				WebDomain: "forge.example.com",
+			},
+			expectedURL:   "https://forge.example.com/peter/calculator/compare/feature",
+			expectedToken: "forgejo-secret",
+		},
+		{
+			testName:  "overrides the service of a known domain",
+			remoteUrl: "git@github.com:peter/calculator.git",
+			remoteService: RemoteService{
+				Provider:  "gitea",
+				WebDomain: "gitea.example.com:3000",
+			},
+			expectedURL:   "https://gitea.example.com:3000/peter/calculator/compare/feature",
+			expectedToken: "",
+		},
+		{
+			testName:  "token env var without provider",
+			remoteUrl: "git@gitlab.com:peter/calculator.git",
+			remoteService: RemoteService{
+				TokenEnvVar: "WORK_GITLAB_TOKEN",
+			},
+			expectedURL:   "https://gitlab.com/peter/calculator/-/merge_requests/new?merge_request%5Bsource_branch%5D=feature",
+			expectedToken: "work-secret",
+		},
+		{
+			testName:  "falls back to the service's token env vars",
+			remoteUrl: "git@codeberg.org:peter/calculator.git",
+			remoteService: RemoteService{
+				TokenEnvVar: "UNSET_TOKEN",
+			},
+			expectedURL:   "https://codeberg.org/peter/calculator/compare/feature",
+			expectedToken: "forgejo-secret",
+		},
+		{
+			testName:  "unknown provider",
+			remoteUrl: "git@git.example.com:peter/calculator.git",
+			remoteService: RemoteService{
+				Provider:  "sourcehut",
+				WebDomain: "git.example.com",
+			},
+			expectedErr: "Unknown git service provider 'sourcehut' in remoteServices config. Expected one of github, bitbucket, gitlab, azuredevops, bitbucketServer, gitea, forgejo",
+		},
+	}
+
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv("GH_TOKEN", "")
+	t.Setenv("GITEA_TOKEN", "")
+	t.Setenv("FORGEJO_TOKEN", "forgejo-secret")
+	t.Setenv("WORK_GITLAB_TOKEN", "work-secret")
+	t.Setenv("UNSET_TOKEN", "")
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			tr := i18n.EnglishTranslationSet()
+			log := &fakes.FakeFieldLogger{}
+			hostingServiceMgr := NewHostingServiceMgr(log, tr, s.remoteUrl, nil).WithRemoteService(s.remoteService)
+			url, err := hostingServiceMgr.GetPullRequestURL("feature", "")
+			if s.expectedErr != "" {
+				assert.EqualError(t, err, s.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedURL, url)
+			assert.Equal(t, s.expectedToken, hostingServiceMgr.GetReleaseAPIToken())
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package hosting_service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type Issue struct {
+	// e.g. "#12"; this is how the issue is referred to in pull request
+	// descriptions and commit messages
+	Reference string
+	Number    int
+	Title     string
+	Author    string
+	URL       string
+}
+
+type issueAPIDefinition struct {
+	// URL of the endpoint listing the repo's open issues. Can use the same
+	// placeholders as releaseAPIDefinition.urlTemplate.
+	urlTemplate string
+	// Appended to urlTemplate for listing only the issues assigned to the
+	// user given in the {{.User}} placeholder
+	assignedQuery string
+	// URL of the endpoint returning the user that the API token belongs to
+	userURLTemplate string
+	// Requests for assigning an issue to that user and for labelling it, used
+	// when starting work on an issue
+	assignIssue *issueUpdateDefinition
+	labelIssue  *issueUpdateDefinition
+	parseIssues func(body []byte) ([]*Issue, error)
+}
+
+type issueUpdateDefinition struct {
+	method string
+	// Can use the same placeholders as issueAPIDefinition.urlTemplate, plus
+	// {{.Number}} for the number of the issue
+	urlTemplate string
+	// Gets the issue's current assignees if keepAssignees is set
+	payload func(user *apiUser, label string, assignees []string) map[string]any
+	// For APIs where assigning replaces the issue's assignees instead of
+	// adding to them; the issue is fetched from urlTemplate first so that
+	// the payload can include the existing ones
+	keepAssignees bool
+}
+
+// The user that an API token belongs to
+type apiUser struct {
+	ID int `json:"id"`
+	// GitHub and Gitea call the user name "login", GitLab "username"
+	Login    string `json:"login"`
+	Username string `json:"username"`
+}
+
+func (self *apiUser) name() string {
+	return lo.Ternary(self.Login != "", self.Login, self.Username)
+}
+
+// Returns whether the service has an API for accessing its issues, see
+// HasPullRequestAPI
+func (self *HostingServiceMgr) HasIssueAPI() bool {
+	gitService, err := self.getService()
+	return err == nil && gitService.issueAPI != nil && gitService.apiAuth != nil
+}
+
+// Returns the API token for accessing the service's issues, see
+// GetPullRequestAPIToken
+func (self *HostingServiceMgr) GetIssueAPIToken() string {
+	gitService, err := self.getService()
+	if err != nil || gitService.issueAPI == nil {
+		return ""
+	}
+
+	return gitService.getAPIToken()
+}
+
+func (self *HostingServiceMgr) ListIssues(token string) ([]*Issue, error) {
+	req, err := self.newListIssuesRequest(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.listIssues(req)
+}
+
+// Lists the open issues that are assigned to the owner of the token
+func (self *HostingServiceMgr) ListAssignedIssues(token string) ([]*Issue, error) {
+	user, err := self.getAPIUser(token)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := self.newListAssignedIssuesRequest(user, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return self.listIssues(req)
+}
+
+// Assigns the issue to the owner of the token if assign is true, and adds
+// the label to it if it isn't empty
+func (self *HostingServiceMgr) StartWorkOnIssue(issue *Issue, assign bool, label string, token string) error {
+	gitService, err := self.getServiceWithIssueAPI()
+	if err != nil {
+		return err
+	}
+
+	if assign {
+		user, err := self.getAPIUser(token)
+		if err != nil {
+			return err
+		}
+
+		if err := gitService.updateIssue(gitService.issueAPI.assignIssue, issue, user, "", token); err != nil {
+			return err
+		}
+	}
+
+	if label != "" {
+		if err := gitService.updateIssue(gitService.issueAPI.labelIssue, issue, nil, label, token); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (self *HostingServiceMgr) listIssues(req *http.Request) ([]*Issue, error) {
+	body, err := doAPIRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	gitService, err := self.getService()
+	if err != nil {
+		return nil, err
+	}
+	return gitService.issueAPI.parseIssues(body)
+}
+
+func (self *HostingServiceMgr) getServiceWithIssueAPI() (*Service, error) {
+	gitService, err := self.getService()
+	if err != nil {
+		return nil, err
+	}
+
+	if gitService.issueAPI == nil {
+		return nil, errors.New(self.tr.IssueAPINotSupported)
+	}
+
+	return gitService, nil
+}
+
+func (self *HostingServiceMgr) newListIssuesRequest(token string) (*http.Request, error) {
+	gitService, err := self.getServiceWithIssueAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := utils.ResolvePlaceholderString(gitService.issueAPI.urlTemplate, gitService.repoInfo)
+	return gitService.newAPIRequest(http.MethodGet, apiURL, nil, token)
+}
+
+func (self *HostingServiceMgr) newListAssignedIssuesRequest(user *apiUser, token string) (*http.Request, error) {
+	gitService, err := self.getServiceWithIssueAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	issueAPI := gitService.issueAPI
+	apiURL := utils.ResolvePlaceholderString(issueAPI.urlTemplate+issueAPI.assignedQuery,
+		lo.Assign(gitService.repoInfo, map[string]string{"User": url.QueryEscape(user.name())}))
+	return gitService.newAPIRequest(http.MethodGet, apiURL, nil, token)
+}
+
+func (self *HostingServiceMgr) getAPIUser(token string) (*apiUser, error) {
+	gitService, err := self.getServiceWithIssueAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := utils.ResolvePlaceholderString(gitService.issueAPI.userURLTemplate, gitService.repoInfo)
+	req, err := gitService.newAPIRequest(http.MethodGet, apiURL, nil, token)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := doAPIRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var user apiUser
+	if err := json.Unmarshal(body, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (self *Service) updateIssue(update *issueUpdateDefinition, issue *Issue, user *apiUser, label string, token string) error {
+	var assignees []string
+	if update.keepAssignees {
+		var err error
+		assignees, err = self.getIssueAssignees(update, issue, token)
+		if err != nil {
+			return err
+		}
+	}
+
+	req, err := self.newUpdateIssueRequest(update, issue, user, label, assignees, token)
+	if err != nil {
+		return err
+	}
+
+	_, err = doAPIRequest(req)
+	return err
+}
+
+func (self *Service) getIssueAssignees(update *issueUpdateDefinition, issue *Issue, token string) ([]string, error) {
+	req, err := self.newAPIRequest(http.MethodGet, self.issueUpdateURL(update, issue), nil, token)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := doAPIRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseGiteaIssueAssignees(body)
+}
+
+func (self *Service) newUpdateIssueRequest(update *issueUpdateDefinition, issue *Issue, user *apiUser, label string, assignees []string, token string) (*http.Request, error) {
+	payload, err := json.Marshal(update.payload(user, label, assignees))
+	if err != nil {
+		return nil, err
+	}
+
+	return self.newAPIRequest(update.method, self.issueUpdateURL(update, issue), payload, token)
+}
+
+func (self *Service) issueUpdateURL(update *issueUpdateDefinition, issue *Issue) string {
+	return utils.ResolvePlaceholderString(update.urlTemplate,
+		lo.Assign(self.repoInfo, map[string]string{"Number": strconv.Itoa(issue.Number)}))
+}
+
+// Returns the logins of the assignees of a single issue as Gitea returns it
+func parseGiteaIssueAssignees(body []byte) ([]string, error) {
+	var response struct {
+		Assignees []struct {
+			Login string `json:"login"`
+		} `json:"assignees"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	logins := make([]string, 0, len(response.Assignees))
+	for _, assignee := range response.Assignees {
+		logins = append(logins, assignee.Login)
+	}
+	return logins, nil
+}
+
+// Also used for Gitea, whose API returns issues in the same format
+func parseGitHubIssues(body []byte) ([]*Issue, error) {
+	var response []struct {
+		Number  int    `json:"number"`
+		Title   string `json:"title"`
+		HTMLURL string `json:"html_url"`
+		User    struct {
+			Login string `json:"login"`
+		} `json:"user"`
+		// GitHub lists pull requests as issues too; they have this field
+		PullRequest *struct{} `json:"pull_request"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	issues := []*Issue{}
+	for _, issue := range response {
+		if issue.PullRequest != nil {
+			continue
+		}
+		issues = append(issues, &Issue{
+			Reference: "#" + strconv.Itoa(issue.Number),
+			Number:    issue.Number,
+			Title:     issue.Title,
+			Author:    issue.User.Login,
+			URL:       issue.HTMLURL,
+		})
+	}
+	return issues, nil
+}
+
+func parseGitLabIssues(body []byte) ([]*Issue, error) {
+	var response []struct {
+		IID    int    `json:"iid"`
+		Title  string `json:"title"`
+		WebURL string `json:"web_url"`
+		Author struct {
+			Username string `json:"username"`
+		} `json:"author"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	issues := make([]*Issue, 0, len(response))
+	for _, issue := range response {
+		issues = append(issues, &Issue{
+			Reference: "#" + strconv.Itoa(issue.IID),
+			Number:    issue.IID,
+			Title:     issue.Title,
+			Author:    issue.Author.Username,
+			URL:       issue.WebURL,
+		})
+	}
+	return issues, nil
+}
@@ -0,0 +1,200 @@
+package hosting_service
+
+import (
+	"io"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/fakes"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewListIssuesRequest(t *testing.T) {
+	type scenario struct {
+		testName    string
+		remoteUrl   string
+		expectedURL string
+		expectedErr string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:    "github",
+			remoteUrl:   "git@github.com:peter/calculator.git",
+			expectedURL: "https://api.github.com/repos/peter/calculator/issues?state=open&per_page=100",
+		},
+		{
+			testName:    "gitlab",
+			remoteUrl:   "git@gitlab.com:group/subgroup/calculator.git",
+			expectedURL: "https://gitlab.com/api/v4/projects/group%2Fsubgroup%2Fcalculator/issues?state=opened&per_page=100",
+		},
+		{
+			testName:    "forgejo",
+			remoteUrl:   "git@codeberg.org:peter/calculator.git",
+			expectedURL: "https://codeberg.org/api/v1/repos/peter/calculator/issues?state=open&type=issues&limit=50",
+		},
+		{
+			testName:    "unsupported service",
+			remoteUrl:   "git@bitbucket.org:peter/calculator.git",
+			expectedErr: "Listing issues through the API is not supported for this git service",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			tr := i18n.EnglishTranslationSet()
+			log := &fakes.FakeFieldLogger{}
+			req, err := NewHostingServiceMgr(log, tr, s.remoteUrl, nil).newListIssuesRequest("secret")
+			if s.expectedErr != "" {
+				assert.EqualError(t, err, s.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "GET", req.Method)
+			assert.Equal(t, s.expectedURL, req.URL.String())
+		})
+	}
+}
+
+func TestParseIssues(t *testing.T) {
+	gitHubBody := `[
+		{"number": 7, "title": "Crash on startup", "html_url": "https://github.com/peter/calculator/issues/7", "user": {"login": "paul"}},
+		{"number": 8, "title": "Fix crash", "html_url": "https://github.com/peter/calculator/pull/8", "user": {"login": "peter"}, "pull_request": {"url": ""}},
+		{"number": 9, "title": "Dark mode", "html_url": "https://github.com/peter/calculator/issues/9", "user": {"login": "mary"}, "pull_request": null}
+	]`
+	issues, err := parseGitHubIssues([]byte(gitHubBody))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Issue{
+		{Reference: "#7", Number: 7, Title: "Crash on startup", Author: "paul", URL: "https://github.com/peter/calculator/issues/7"},
+		{Reference: "#9", Number: 9, Title: "Dark mode", Author: "mary", URL: "https://github.com/peter/calculator/issues/9"},
+	}, issues)
+
+	gitLabBody := `[{"iid": 3, "title": "Crash on startup", "web_url": "https://gitlab.com/peter/calculator/-/issues/3", "author": {"username": "paul"}}]`
+	issues, err = parseGitLabIssues([]byte(gitLabBody))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Issue{
+		{Reference: "#3", Number: 3, Title: "Crash on startup", Author: "paul", URL: "https://gitlab.com/peter/calculator/-/issues/3"},
+	}, issues)
+}
+
+func TestNewListAssignedIssuesRequest(t *testing.T) {
+	tr := i18n.EnglishTranslationSet()
+	log := &fakes.FakeFieldLogger{}
+
+	req, err := NewHostingServiceMgr(log, tr, "git@github.com:peter/calculator.git", nil).
+		newListAssignedIssuesRequest(&apiUser{Login: "paul"}, "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.github.com/repos/peter/calculator/issues?state=open&per_page=100&assignee=paul", req.URL.String())
+
+	req, err = NewHostingServiceMgr(log, tr, "git@gitlab.com:peter/calculator.git", nil).
+		newListAssignedIssuesRequest(&apiUser{ID: 5, Username: "paul"}, "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com/api/v4/projects/peter%2Fcalculator/issues?state=opened&per_page=100&assignee_username=paul", req.URL.String())
+
+	req, err = NewHostingServiceMgr(log, tr, "git@codeberg.org:peter/calculator.git", nil).
+		newListAssignedIssuesRequest(&apiUser{Login: "paul"}, "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://codeberg.org/api/v1/repos/peter/calculator/issues?state=open&type=issues&limit=50&assigned_by=paul", req.URL.String())
+}
+
+func TestNewUpdateIssueRequest(t *testing.T) {
+	type scenario struct {
+		testName        string
+		remoteUrl       string
+		label           string
+		assignees       []string
+		expectedMethod  string
+		expectedURL     string
+		expectedPayload string
+	}
+
+	user := &apiUser{ID: 5, Login: "paul"}
+	scenarios := []scenario{
+		{
+			testName:        "github, assign",
+			remoteUrl:       "git@github.com:peter/calculator.git",
+			expectedMethod:  "POST",
+			expectedURL:     "https://api.github.com/repos/peter/calculator/issues/7/assignees",
+			expectedPayload: `{"assignees":["paul"]}`,
+		},
+		{
+			testName:        "github, label",
+			remoteUrl:       "git@github.com:peter/calculator.git",
+			label:           "in progress",
+			expectedMethod:  "POST",
+			expectedURL:     "https://api.github.com/repos/peter/calculator/issues/7/labels",
+			expectedPayload: `{"labels":["in progress"]}`,
+		},
+		{
+			testName:        "gitlab, assign",
+			remoteUrl:       "git@gitlab.com:peter/calculator.git",
+			expectedMethod:  "PUT",
+			expectedURL:     "https://gitlab.com/api/v4/projects/peter%2Fcalculator/issues/7",
+			expectedPayload: `{"assignee_ids":[5]}`,
+		},
+		{
+			testName:        "gitlab, label",
+			remoteUrl:       "git@gitlab.com:peter/calculator.git",
+			label:           "in progress",
+			expectedMethod:  "PUT",
+			expectedURL:     "https://gitlab.com/api/v4/projects/peter%2Fcalculator/issues/7",
+			expectedPayload: `{"add_labels":"in progress"}`,
+		},
+		{
+			testName:        "gitea, assign",
+			remoteUrl:       "https://try.gitea.io/peter/calculator.git",
+			expectedMethod:  "PATCH",
+			expectedURL:     "https://try.gitea.io/api/v1/repos/peter/calculator/issues/7",
+			expectedPayload: `{"assignees":["paul"]}`,
+		},
+		{
+			testName:        "gitea, assign when already assigned",
+			remoteUrl:       "https://try.gitea.io/peter/calculator.git",
+			assignees:       []string{"mary", "paul"},
+			expectedMethod:  "PATCH",
+			expectedURL:     "https://try.gitea.io/api/v1/repos/peter/calculator/issues/7",
+			expectedPayload: `{"assignees":["mary","paul"]}`,
+		},
+		{
+			testName:        "gitea, assign keeping other assignees",
+			remoteUrl:       "https://try.gitea.io/peter/calculator.git",
+			assignees:       []string{"mary"},
+			expectedMethod:  "PATCH",
+			expectedURL:     "https://try.gitea.io/api/v1/repos/peter/calculator/issues/7",
+			expectedPayload: `{"assignees":["mary","paul"]}`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			tr := i18n.EnglishTranslationSet()
+			log := &fakes.FakeFieldLogger{}
+			gitService, err := NewHostingServiceMgr(log, tr, s.remoteUrl, nil).getServiceWithIssueAPI()
+			assert.NoError(t, err)
+
+			update := gitService.issueAPI.assignIssue
+			if s.label != "" {
+				update = gitService.issueAPI.labelIssue
+			}
+			req, err := gitService.newUpdateIssueRequest(update, &Issue{Number: 7}, user, s.label, s.assignees, "secret")
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedMethod, req.Method)
+			assert.Equal(t, s.expectedURL, req.URL.String())
+
+			payload, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedPayload, string(payload))
+		})
+	}
+}
+
+func TestParseGiteaIssueAssignees(t *testing.T) {
+	assignees, err := parseGiteaIssueAssignees([]byte(`{"number": 7, "assignees": [{"login": "mary"}, {"login": "paul"}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"mary", "paul"}, assignees)
+
+	assignees, err = parseGiteaIssueAssignees([]byte(`{"number": 7, "assignees": null}`))
+	assert.NoError(t, err)
+	assert.Empty(t, assignees)
+}
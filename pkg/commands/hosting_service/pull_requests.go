@@ -0,0 +1,467 @@
+package hosting_service
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// A pull request (or merge request, in GitLab's terms)
+type PullRequest struct {
+	// e.g. "#12" for GitHub or "!12" for GitLab
+	Reference    string
+	Title        string
+	Author       string
+	SourceBranch string
+	TargetBranch string
+	URL          string
+	Draft        bool
+}
+
+// The status of the latest CI pipeline (or workflow run, in GitHub's terms)
+// of a branch
+type PipelineStatus struct {
+	// e.g. "success", "failed" or "running"; the values are specific to the
+	// service
+	Status string
+	URL    string
+}
+
+type pullRequestAPIDefinition struct {
+	// URL of the endpoint for listing and creating pull requests. Can use the
+	// same placeholders as releaseAPIDefinition.urlTemplate.
+	urlTemplate string
+	// Appended to urlTemplate for listing the open pull requests
+	listQuery string
+	// URL of the endpoint returning the latest pipeline of the branch given
+	// in the {{.Branch}} placeholder
+	pipelinesURLTemplate string
+	// Keys of the fields in the payload for creating a pull request
+	descriptionField  string
+	sourceBranchField string
+	targetBranchField string
+	// If set, pull requests from forks are created in the fork's repo, passing
+	// the numeric ID of the target repo (fetched from projectURLTemplate) in
+	// this field. Otherwise the source branch is qualified using the service's
+	// forkBranchTemplate.
+	targetProjectField string
+	projectURLTemplate string
+	// Prefix of pull request numbers when referring to them, e.g. "#"
+	referencePrefix     string
+	parsePullRequests   func(body []byte, referencePrefix string) ([]*PullRequest, error)
+	parsePipelineStatus func(body []byte) (*PipelineStatus, error)
+	// Paths, relative to the repo root, where the service looks for the
+	// template of a pull request's description
+	templatePaths []string
+}
+
+// Returns whether pull requests can be managed through the service's API,
+// given a token. Unlike GetPullRequestAPIToken this never runs a command, so
+// it's cheap enough for deciding which menu items to enable.
+func (self *HostingServiceMgr) HasPullRequestAPI() bool {
+	gitService, err := self.getService()
+	return err == nil && gitService.pullRequestAPI != nil && gitService.apiAuth != nil
+}
+
+// Returns the API token for managing pull requests, taken from the
+// environment variables of the service (e.g. GITLAB_TOKEN) or else from the
+// hostingServiceTokenSources config. Returns an empty string if no token is
+// found or the service has no pull request API. Looking in the token sources
+// runs commands such as `gh auth token`, so don't call this on the UI thread.
+func (self *HostingServiceMgr) GetPullRequestAPIToken() string {
+	gitService, err := self.getService()
+	if err != nil || gitService.pullRequestAPI == nil {
+		return ""
+	}
+
+	return gitService.getAPIToken()
+}
+
+// Returns the paths of the files that the service uses as the template for
+// the description of a new pull request
+func (self *HostingServiceMgr) GetPullRequestTemplatePaths() []string {
+	gitService, err := self.getService()
+	if err != nil || gitService.pullRequestAPI == nil {
+		return nil
+	}
+
+	return gitService.pullRequestAPI.templatePaths
+}
+
+func (self *HostingServiceMgr) ListPullRequests(token string) ([]*PullRequest, error) {
+	req, err := self.newListPullRequestsRequest(token)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := doAPIRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	gitService, err := self.getService()
+	if err != nil {
+		return nil, err
+	}
+	return gitService.pullRequestAPI.parsePullRequests(body, gitService.pullRequestAPI.referencePrefix)
+}
+
+// Creates a pull request from one branch into another through the service's
+// API and returns the URL of its web page. If forkRemoteURL is not empty, the
+// source branch is in that fork of the repo.
+func (self *HostingServiceMgr) CreatePullRequestViaAPI(forkRemoteURL string, from string, to string, title string, description string, token string) (string, error) {
+	targetProjectID := 0
+	if forkRemoteURL != "" {
+		gitService, err := self.getServiceWithPullRequestAPI()
+		if err != nil {
+			return "", err
+		}
+		if gitService.pullRequestAPI.targetProjectField != "" {
+			targetProjectID, err = self.getProjectID(token)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
+	req, err := self.newCreatePullRequestRequest(forkRemoteURL, targetProjectID, from, to, title, description, token)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := doAPIRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	var response struct {
+		HTMLURL string `json:"html_url"`
+		WebURL  string `json:"web_url"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", err
+	}
+
+	return lo.Ternary(response.HTMLURL != "", response.HTMLURL, response.WebURL), nil
+}
+
+// Returns the status of the latest pipeline of the given branch, or nil if
+// the branch has no pipelines.
+func (self *HostingServiceMgr) GetPipelineStatus(branch string, token string) (*PipelineStatus, error) {
+	req, err := self.newPipelineStatusRequest(branch, token)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := doAPIRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	gitService, err := self.getService()
+	if err != nil {
+		return nil, err
+	}
+	return gitService.pullRequestAPI.parsePipelineStatus(body)
+}
+
+func (self *HostingServiceMgr) getServiceWithPullRequestAPI() (*Service, error) {
+	gitService, err := self.getService()
+	if err != nil {
+		return nil, err
+	}
+
+	if gitService.pullRequestAPI == nil {
+		return nil, errors.New(self.tr.PullRequestAPINotSupported)
+	}
+
+	return gitService, nil
+}
+
+func (self *HostingServiceMgr) newListPullRequestsRequest(token string) (*http.Request, error) {
+	gitService, err := self.getServiceWithPullRequestAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := utils.ResolvePlaceholderString(gitService.pullRequestAPI.urlTemplate, gitService.repoInfo) +
+		gitService.pullRequestAPI.listQuery
+	return gitService.newAPIRequest(http.MethodGet, apiURL, nil, token)
+}
+
+func (self *HostingServiceMgr) getProjectID(token string) (int, error) {
+	gitService, err := self.getServiceWithPullRequestAPI()
+	if err != nil {
+		return 0, err
+	}
+
+	apiURL := utils.ResolvePlaceholderString(gitService.pullRequestAPI.projectURLTemplate, gitService.repoInfo)
+	req, err := gitService.newAPIRequest(http.MethodGet, apiURL, nil, token)
+	if err != nil {
+		return 0, err
+	}
+
+	body, err := doAPIRequest(req)
+	if err != nil {
+		return 0, err
+	}
+
+	var response struct {
+		ID int `json:"id"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return 0, err
+	}
+	return response.ID, nil
+}
+
+func (self *HostingServiceMgr) newCreatePullRequestRequest(forkRemoteURL string, targetProjectID int, from string, to string, title string, description string, token string) (*http.Request, error) {
+	gitService, err := self.getServiceWithPullRequestAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	pullRequestAPI := gitService.pullRequestAPI
+	repoInfo := gitService.repoInfo
+	fields := map[string]any{
+		"title":                          title,
+		pullRequestAPI.descriptionField:  description,
+		pullRequestAPI.targetBranchField: to,
+	}
+
+	switch {
+	case forkRemoteURL == "":
+	case pullRequestAPI.targetProjectField != "":
+		repoInfo, err = gitService.getRepoInfoFromRemoteURL(forkRemoteURL, repoInfo["webDomain"])
+		if err != nil {
+			return nil, err
+		}
+		fields[pullRequestAPI.targetProjectField] = targetProjectID
+	default:
+		from, err = gitService.getForkBranch(forkRemoteURL, from)
+		if err != nil {
+			return nil, errors.New(self.tr.PullRequestsFromForksNotSupported)
+		}
+	}
+	fields[pullRequestAPI.sourceBranchField] = from
+
+	payload, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := utils.ResolvePlaceholderString(pullRequestAPI.urlTemplate, repoInfo)
+	return gitService.newAPIRequest(http.MethodPost, apiURL, payload, token)
+}
+
+func (self *HostingServiceMgr) newPipelineStatusRequest(branch string, token string) (*http.Request, error) {
+	gitService, err := self.getServiceWithPullRequestAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := utils.ResolvePlaceholderString(gitService.pullRequestAPI.pipelinesURLTemplate,
+		lo.Assign(gitService.repoInfo, map[string]string{"Branch": url.QueryEscape(branch)}))
+	return gitService.newAPIRequest(http.MethodGet, apiURL, nil, token)
+}
+
+func (self *Service) newAPIRequest(method string, apiURL string, payload []byte, token string) (*http.Request, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, apiURL, body)
+	if err != nil {
+		return nil, err
+	}
+	self.setAPIHeaders(req, token)
+
+	return req, nil
+}
+
+// Sends the request and returns the body of the response, or an error with
+// the message that the service returned if the request failed
+func doAPIRequest(req *http.Request) ([]byte, error) {
+	resp, err := apiClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if message := apiErrorMessage(body); message != "" {
+			return nil, errors.Errorf("%s: %s", resp.Status, message)
+		}
+		return nil, errors.New(resp.Status)
+	}
+
+	return body, nil
+}
+
+// GitHub returns errors as {"message": "..."}, GitLab either like that or
+// with a list of messages. Error responses aren't necessarily JSON at all.
+func apiErrorMessage(body []byte) string {
+	var response struct {
+		Message json.RawMessage `json:"message"`
+		Error   string          `json:"error"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return ""
+	}
+
+	var message string
+	if err := json.Unmarshal(response.Message, &message); err == nil {
+		return message
+	}
+	var messages []string
+	if err := json.Unmarshal(response.Message, &messages); err == nil && len(messages) > 0 {
+		return messages[0]
+	}
+	return response.Error
+}
+
+func parseGitHubPullRequests(body []byte, referencePrefix string) ([]*PullRequest, error) {
+	var response []struct {
+		Number  int    `json:"number"`
+		Title   string `json:"title"`
+		HTMLURL string `json:"html_url"`
+		Draft   bool   `json:"draft"`
+		User    struct {
+			Login string `json:"login"`
+		} `json:"user"`
+		Head struct {
+			Ref string `json:"ref"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	pullRequests := make([]*PullRequest, 0, len(response))
+	for _, pr := range response {
+		pullRequests = append(pullRequests, &PullRequest{
+			Reference:    referencePrefix + strconv.Itoa(pr.Number),
+			Title:        pr.Title,
+			Author:       pr.User.Login,
+			SourceBranch: pr.Head.Ref,
+			TargetBranch: pr.Base.Ref,
+			URL:          pr.HTMLURL,
+			Draft:        pr.Draft,
+		})
+	}
+	return pullRequests, nil
+}
+
+func parseGitLabMergeRequests(body []byte, referencePrefix string) ([]*PullRequest, error) {
+	var response []struct {
+		IID          int    `json:"iid"`
+		Title        string `json:"title"`
+		WebURL       string `json:"web_url"`
+		Draft        bool   `json:"draft"`
+		SourceBranch string `json:"source_branch"`
+		TargetBranch string `json:"target_branch"`
+		Author       struct {
+			Username string `json:"username"`
+		} `json:"author"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	pullRequests := make([]*PullRequest, 0, len(response))
+	for _, mr := range response {
+		pullRequests = append(pullRequests, &PullRequest{
+			Reference:    referencePrefix + strconv.Itoa(mr.IID),
+			Title:        mr.Title,
+			Author:       mr.Author.Username,
+			SourceBranch: mr.SourceBranch,
+			TargetBranch: mr.TargetBranch,
+			URL:          mr.WebURL,
+			Draft:        mr.Draft,
+		})
+	}
+	return pullRequests, nil
+}
+
+func parseGitHubPipelineStatus(body []byte) (*PipelineStatus, error) {
+	var response struct {
+		WorkflowRuns []struct {
+			Status     string `json:"status"`
+			Conclusion string `json:"conclusion"`
+			HTMLURL    string `json:"html_url"`
+		} `json:"workflow_runs"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	if len(response.WorkflowRuns) == 0 {
+		return nil, nil
+	}
+
+	// Runs that are still in progress have no conclusion yet
+	run := response.WorkflowRuns[0]
+	return &PipelineStatus{
+		Status: lo.Ternary(run.Conclusion != "", run.Conclusion, run.Status),
+		URL:    run.HTMLURL,
+	}, nil
+}
+
+func parseGitLabPipelineStatus(body []byte) (*PipelineStatus, error) {
+	var response []struct {
+		Status string `json:"status"`
+		WebURL string `json:"web_url"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	if len(response) == 0 {
+		return nil, nil
+	}
+
+	return &PipelineStatus{
+		Status: response[0].Status,
+		URL:    response[0].WebURL,
+	}, nil
+}
+
+func parseGiteaPipelineStatus(body []byte) (*PipelineStatus, error) {
+	var response struct {
+		State      string `json:"state"`
+		TotalCount int    `json:"total_count"`
+		Statuses   []struct {
+			TargetURL string `json:"target_url"`
+		} `json:"statuses"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+
+	if response.TotalCount == 0 || len(response.Statuses) == 0 {
+		return nil, nil
+	}
+
+	// The combined state has no URL of its own, so we link to the first
+	// status's target, which is typically the CI run
+	return &PipelineStatus{
+		Status: response.State,
+		URL:    response.Statuses[0].TargetURL,
+	}, nil
+}
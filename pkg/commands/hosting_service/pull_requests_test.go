@@ -0,0 +1,248 @@
+package hosting_service
+
+import (
+	"io"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/fakes"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCreatePullRequestRequest(t *testing.T) {
+	type scenario struct {
+		testName             string
+		remoteUrl            string
+		configServiceDomains map[string]string
+		forkRemoteUrl        string
+		expectedURL          string
+		expectedHeader       string
+		expectedHeaderValue  string
+		expectedPayload      string
+		expectedErr          string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:            "github",
+			remoteUrl:           "git@github.com:peter/calculator.git",
+			expectedURL:         "https://api.github.com/repos/peter/calculator/pulls",
+			expectedHeader:      "Authorization",
+			expectedHeaderValue: "Bearer secret",
+			expectedPayload:     `{"base":"main","body":"description","head":"feature","title":"title"}`,
+		},
+		{
+			testName:            "gitlab",
+			remoteUrl:           "git@gitlab.com:group/subgroup/calculator.git",
+			expectedURL:         "https://gitlab.com/api/v4/projects/group%2Fsubgroup%2Fcalculator/merge_requests",
+			expectedHeader:      "Authorization",
+			expectedHeaderValue: "Bearer secret",
+			expectedPayload:     `{"description":"description","source_branch":"feature","target_branch":"main","title":"title"}`,
+		},
+		{
+			testName:            "github, from fork",
+			remoteUrl:           "git@github.com:peter/calculator.git",
+			forkRemoteUrl:       "git@github.com:paul/calculator.git",
+			expectedURL:         "https://api.github.com/repos/peter/calculator/pulls",
+			expectedHeader:      "Authorization",
+			expectedHeaderValue: "Bearer secret",
+			expectedPayload:     `{"base":"main","body":"description","head":"paul:feature","title":"title"}`,
+		},
+		{
+			testName:            "gitlab, from fork",
+			remoteUrl:           "git@gitlab.com:peter/calculator.git",
+			forkRemoteUrl:       "https://gitlab.com/paul/calculator.git",
+			expectedURL:         "https://gitlab.com/api/v4/projects/paul%2Fcalculator/merge_requests",
+			expectedHeader:      "Authorization",
+			expectedHeaderValue: "Bearer secret",
+			expectedPayload:     `{"description":"description","source_branch":"feature","target_branch":"main","target_project_id":42,"title":"title"}`,
+		},
+		{
+			testName:             "self-hosted gitlab",
+			remoteUrl:            "git@gitlab.work.com:peter/calculator.git",
+			configServiceDomains: map[string]string{"gitlab.work.com": "gitlab:gitlab.work.com"},
+			expectedURL:          "https://gitlab.work.com/api/v4/projects/peter%2Fcalculator/merge_requests",
+			expectedHeader:       "Authorization",
+			expectedHeaderValue:  "Bearer secret",
+			expectedPayload:      `{"description":"description","source_branch":"feature","target_branch":"main","title":"title"}`,
+		},
+		{
+			testName:            "gitea",
+			remoteUrl:           "https://try.gitea.io/peter/calculator.git",
+			expectedURL:         "https://try.gitea.io/api/v1/repos/peter/calculator/pulls",
+			expectedHeader:      "Authorization",
+			expectedHeaderValue: "token secret",
+			expectedPayload:     `{"base":"main","body":"description","head":"feature","title":"title"}`,
+		},
+		{
+			testName:    "unsupported service",
+			remoteUrl:   "git@bitbucket.org:peter/calculator.git",
+			expectedErr: "Managing pull requests through the API is not supported for this git service",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			tr := i18n.EnglishTranslationSet()
+			log := &fakes.FakeFieldLogger{}
+			hostingServiceMgr := NewHostingServiceMgr(log, tr, s.remoteUrl, s.configServiceDomains)
+			req, err := hostingServiceMgr.newCreatePullRequestRequest(s.forkRemoteUrl, 42, "feature", "main", "title", "description", "secret")
+			if s.expectedErr != "" {
+				assert.EqualError(t, err, s.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "POST", req.Method)
+			assert.Equal(t, s.expectedURL, req.URL.String())
+			assert.Equal(t, s.expectedHeaderValue, req.Header.Get(s.expectedHeader))
+
+			payload, err := io.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedPayload, string(payload))
+		})
+	}
+}
+
+func TestNewListPullRequestsRequest(t *testing.T) {
+	tr := i18n.EnglishTranslationSet()
+	log := &fakes.FakeFieldLogger{}
+
+	req, err := NewHostingServiceMgr(log, tr, "git@github.com:peter/calculator.git", nil).newListPullRequestsRequest("secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "GET", req.Method)
+	assert.Equal(t, "https://api.github.com/repos/peter/calculator/pulls?state=open&per_page=100", req.URL.String())
+
+	req, err = NewHostingServiceMgr(log, tr, "git@gitlab.com:peter/calculator.git", nil).newListPullRequestsRequest("secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com/api/v4/projects/peter%2Fcalculator/merge_requests?state=opened&per_page=100", req.URL.String())
+}
+
+func TestNewPipelineStatusRequest(t *testing.T) {
+	tr := i18n.EnglishTranslationSet()
+	log := &fakes.FakeFieldLogger{}
+
+	req, err := NewHostingServiceMgr(log, tr, "git@github.com:peter/calculator.git", nil).newPipelineStatusRequest("feature/a", "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.github.com/repos/peter/calculator/actions/runs?per_page=1&branch=feature%2Fa", req.URL.String())
+
+	req, err = NewHostingServiceMgr(log, tr, "git@gitlab.com:peter/calculator.git", nil).newPipelineStatusRequest("feature/a", "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com/api/v4/projects/peter%2Fcalculator/pipelines?per_page=1&ref=feature%2Fa", req.URL.String())
+
+	req, err = NewHostingServiceMgr(log, tr, "git@codeberg.org:peter/calculator.git", nil).newPipelineStatusRequest("feature/a", "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://codeberg.org/api/v1/repos/peter/calculator/commits/feature%2Fa/status", req.URL.String())
+}
+
+func TestParsePullRequests(t *testing.T) {
+	gitHubBody := `[{"number": 12, "title": "Add things", "html_url": "https://github.com/peter/calculator/pull/12",
+		"draft": true, "user": {"login": "peter"}, "head": {"ref": "feature"}, "base": {"ref": "main"}}]`
+	pullRequests, err := parseGitHubPullRequests([]byte(gitHubBody), "#")
+	assert.NoError(t, err)
+	assert.Equal(t, []*PullRequest{{
+		Reference:    "#12",
+		Title:        "Add things",
+		Author:       "peter",
+		SourceBranch: "feature",
+		TargetBranch: "main",
+		URL:          "https://github.com/peter/calculator/pull/12",
+		Draft:        true,
+	}}, pullRequests)
+
+	gitLabBody := `[{"iid": 3, "title": "Fix things", "web_url": "https://gitlab.com/peter/calculator/-/merge_requests/3",
+		"draft": false, "author": {"username": "paul"}, "source_branch": "fix", "target_branch": "main"}]`
+	pullRequests, err = parseGitLabMergeRequests([]byte(gitLabBody), "!")
+	assert.NoError(t, err)
+	assert.Equal(t, []*PullRequest{{
+		Reference:    "!3",
+		Title:        "Fix things",
+		Author:       "paul",
+		SourceBranch: "fix",
+		TargetBranch: "main",
+		URL:          "https://gitlab.com/peter/calculator/-/merge_requests/3",
+	}}, pullRequests)
+}
+
+func TestParsePipelineStatus(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		parse    func([]byte) (*PipelineStatus, error)
+		body     string
+		expected *PipelineStatus
+	}{
+		{
+			testName: "github, completed run",
+			parse:    parseGitHubPipelineStatus,
+			body:     `{"workflow_runs": [{"status": "completed", "conclusion": "failure", "html_url": "https://github.com/runs/1"}]}`,
+			expected: &PipelineStatus{Status: "failure", URL: "https://github.com/runs/1"},
+		},
+		{
+			testName: "github, run in progress",
+			parse:    parseGitHubPipelineStatus,
+			body:     `{"workflow_runs": [{"status": "in_progress", "conclusion": null, "html_url": "https://github.com/runs/1"}]}`,
+			expected: &PipelineStatus{Status: "in_progress", URL: "https://github.com/runs/1"},
+		},
+		{
+			testName: "github, no runs",
+			parse:    parseGitHubPipelineStatus,
+			body:     `{"workflow_runs": []}`,
+			expected: nil,
+		},
+		{
+			testName: "gitlab",
+			parse:    parseGitLabPipelineStatus,
+			body:     `[{"status": "success", "web_url": "https://gitlab.com/pipelines/1"}]`,
+			expected: &PipelineStatus{Status: "success", URL: "https://gitlab.com/pipelines/1"},
+		},
+		{
+			testName: "gitlab, no pipelines",
+			parse:    parseGitLabPipelineStatus,
+			body:     `[]`,
+			expected: nil,
+		},
+		{
+			testName: "gitea",
+			parse:    parseGiteaPipelineStatus,
+			body:     `{"state": "pending", "total_count": 2, "statuses": [{"target_url": "https://codeberg.org/runs/1"}, {"target_url": ""}]}`,
+			expected: &PipelineStatus{Status: "pending", URL: "https://codeberg.org/runs/1"},
+		},
+		{
+			testName: "gitea, no statuses",
+			parse:    parseGiteaPipelineStatus,
+			body:     `{"state": "", "total_count": 0, "statuses": []}`,
+			expected: nil,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			status, err := s.parse([]byte(s.body))
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, status)
+		})
+	}
+}
+
+func TestAPIErrorMessage(t *testing.T) {
+	assert.Equal(t, "Validation Failed", apiErrorMessage([]byte(`{"message": "Validation Failed"}`)))
+	assert.Equal(t, "Another open merge request already exists", apiErrorMessage([]byte(`{"message": ["Another open merge request already exists"]}`)))
+	assert.Equal(t, "insufficient_scope", apiErrorMessage([]byte(`{"error": "insufficient_scope"}`)))
+	assert.Equal(t, "", apiErrorMessage([]byte(`<html>Bad gateway</html>`)))
+}
+
+func TestGetPullRequestAPIToken(t *testing.T) {
+	tr := i18n.EnglishTranslationSet()
+	log := &fakes.FakeFieldLogger{}
+
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv("GH_TOKEN", "")
+	t.Setenv("GITLAB_TOKEN", "gl-secret")
+	t.Setenv("GITEA_TOKEN", "gitea-secret")
+	t.Setenv("BITBUCKET_TOKEN", "bb-secret")
+
+	assert.Equal(t, "", NewHostingServiceMgr(log, tr, "git@github.com:peter/calculator.git", nil).GetPullRequestAPIToken())
+	assert.Equal(t, "gl-secret", NewHostingServiceMgr(log, tr, "git@gitlab.com:peter/calculator.git", nil).GetPullRequestAPIToken())
+	assert.Equal(t, "gitea-secret", NewHostingServiceMgr(log, tr, "https://try.gitea.io/peter/calculator.git", nil).GetPullRequestAPIToken())
+	assert.Equal(t, "", NewHostingServiceMgr(log, tr, "git@bitbucket.org:peter/calculator.git", nil).GetPullRequestAPIToken())
+}
@@ -13,6 +13,8 @@ type Branch struct {
 	DisplayName string
 	// indicator of when the branch was last checked out e.g. '2d', '3m'
 	Recency string
+	// the unix time that the recency was obtained from, or 0 if there is none
+	RecencyTimestamp int64
 	// how many commits ahead we are from the remote branch (how many commits we can push, assuming we push to our tracked remote branch)
 	AheadForPull string
 	// how many commits behind we are from the remote branch (how many commits we can pull)
@@ -40,6 +42,9 @@ type Branch struct {
 	// determined yet, or up to date with base branch. (We don't need to
 	// distinguish the two, as we don't draw anything in both cases.)
 	BehindBaseBranch atomic.Int32
+	// How many commits we have that our base branch doesn't have. Like
+	// BehindBaseBranch, 0 means either not determined yet, or none.
+	AheadOfBaseBranch atomic.Int32
 }
 
 func (b *Branch) FullRefName() string {
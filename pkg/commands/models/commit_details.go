@@ -0,0 +1,27 @@
+package models
+
+// Metadata of a commit beyond what we load for the commits list, for showing
+// in the header of the main view
+type CommitDetails struct {
+	Hash               string
+	AuthorName         string
+	AuthorEmail        string
+	AuthorTimestamp    int64
+	CommitterName      string
+	CommitterEmail     string
+	CommitterTimestamp int64
+	Parents            []string
+	// The commit message, without the trailers
+	Message  string
+	Trailers []CommitTrailer
+	// Local branches and tags containing the commit
+	Branches []string
+	Tags     []string
+}
+
+// A trailer like "Signed-off-by: John Doe <john@example.com>" at the end of a
+// commit message
+type CommitTrailer struct {
+	Key   string
+	Value string
+}
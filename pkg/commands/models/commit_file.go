@@ -5,6 +5,14 @@ type CommitFile struct {
 	Path string
 
 	ChangeStatus string // e.g. 'A' for added or 'M' for modified. This is based on the result from git diff --name-status
+
+	// Only set for files of a stash entry that were stashed with
+	// --include-untracked; these live in the entry's third parent commit.
+	Untracked bool
+
+	// Only set if the user has enabled showing them
+	LinesAdded   int
+	LinesDeleted int
 }
 
 func (f *CommitFile) ID() string {
@@ -0,0 +1,8 @@
+package models
+
+// The numbers shown by `git log --shortstat` for a commit
+type CommitStats struct {
+	FilesChanged int
+	Insertions   int
+	Deletions    int
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -25,6 +27,13 @@ type File struct {
 
 	// If true, this must be a worktree folder
 	IsWorktree bool
+
+	// Index bits that make git ignore changes to the file in the working tree
+	SkipWorktree    bool
+	AssumeUnchanged bool
+
+	// Only populated when the files are sorted by modification time
+	ModTime time.Time
 }
 
 // sometimes we need to deal with either a node (which contains a file) or an actual file
@@ -37,6 +46,25 @@ type IFile interface {
 	GetIsFile() bool
 }
 
+// Returns true if the file is only listed because it has the skip-worktree or
+// assume-unchanged bit set, i.e. git status doesn't report any changes for it
+func (f *File) HiddenFromStatus() bool {
+	return (f.SkipWorktree || f.AssumeUnchanged) && !f.HasStagedChanges && !f.HasUnstagedChanges
+}
+
+// Returns the names of the index bits that are set for the file, as used by
+// git update-index
+func (f *File) IndexFlagNames() []string {
+	names := []string{}
+	if f.SkipWorktree {
+		names = append(names, "skip-worktree")
+	}
+	if f.AssumeUnchanged {
+		names = append(names, "assume-unchanged")
+	}
+	return names
+}
+
 func (f *File) IsRename() bool {
 	return f.PreviousPath != ""
 }
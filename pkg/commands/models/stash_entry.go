@@ -4,9 +4,10 @@ import "fmt"
 
 // StashEntry : A git stash entry
 type StashEntry struct {
-	Index   int
-	Recency string
-	Name    string
+	Index         int
+	Recency       string
+	UnixTimestamp int64
+	Name          string
 }
 
 func (s *StashEntry) FullRefName() string {
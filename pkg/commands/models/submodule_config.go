@@ -6,6 +6,9 @@ type SubmoduleConfig struct {
 	Name string
 	Path string
 	Url  string
+	// The branch that `git submodule update --remote` follows; empty for the
+	// remote's default branch
+	Branch string
 
 	ParentModule *SubmoduleConfig // nil if top-level
 }
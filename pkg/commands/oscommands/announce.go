@@ -0,0 +1,46 @@
+package oscommands
+
+import (
+	"os"
+	"slices"
+)
+
+// Announce passes the text on to the user's screen reader or braille display,
+// using the output configured in accessibility.output
+func (c *OSCommand) Announce(text string) error {
+	accessibilityConfig := c.UserConfig().Accessibility
+	if accessibilityConfig.Output == "lines" {
+		return appendLine(accessibilityConfig.LinesFile, text)
+	}
+
+	return c.Cmd.New(announceCmdArgs(accessibilityConfig.Output, c.platformNamesFn(), text)).DontLog().Run()
+}
+
+// Whether announcements are quick enough to make them one after the other,
+// rather than skipping the ones that are superseded while speaking
+func (c *OSCommand) AnnouncesInstantly() bool {
+	return c.UserConfig().Accessibility.Output == "lines"
+}
+
+// As with notifications, we pass the text as an argument rather than through a
+// shell so that we don't have to quote it
+func announceCmdArgs(output string, platformNames []string, text string) []string {
+	if output == "say" || (output == "auto" && slices.Contains(platformNames, "darwin")) {
+		return []string{"say", text}
+	}
+
+	// Messages of the default priority interrupt the one that is being spoken,
+	// which is what we want when the user moves quickly through a list
+	return []string{"spd-say", "--application-name", "lazygit", "--", text}
+}
+
+func appendLine(path string, line string) error {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.WriteString(line + "\n")
+	return err
+}
@@ -0,0 +1,60 @@
+package oscommands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnounceCmdArgs(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		output        string
+		platformNames []string
+		expected      []string
+	}{
+		{
+			testName:      "auto on linux",
+			output:        "auto",
+			platformNames: []string{"linux"},
+			expected:      []string{"spd-say", "--application-name", "lazygit", "--", "Pushed"},
+		},
+		{
+			testName:      "auto on darwin",
+			output:        "auto",
+			platformNames: []string{"darwin"},
+			expected:      []string{"say", "Pushed"},
+		},
+		{
+			testName:      "speech dispatcher on darwin",
+			output:        "speechDispatcher",
+			platformNames: []string{"darwin"},
+			expected:      []string{"spd-say", "--application-name", "lazygit", "--", "Pushed"},
+		},
+		{
+			testName:      "say on linux",
+			output:        "say",
+			platformNames: []string{"linux"},
+			expected:      []string{"say", "Pushed"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, announceCmdArgs(s.output, s.platformNames, "Pushed"))
+		})
+	}
+}
+
+func TestAppendLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "announcements")
+
+	assert.NoError(t, appendLine(path, "Pushed"))
+	assert.NoError(t, appendLine(path, "Files: README.md"))
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "Pushed\nFiles: README.md\n", string(content))
+}
@@ -0,0 +1,135 @@
+package oscommands
+
+import (
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/app/daemon"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// Answers the credential prompts of a command that runs lazygit as its
+// GIT_ASKPASS program (see daemon.AskpassInstruction). Unlike prompts that
+// git writes to the terminal, these are also seen when the command doesn't
+// run in a pty, and whatever their wording.
+type askpassServer struct {
+	log      *logrus.Entry
+	dir      string
+	listener net.Listener
+}
+
+func startAskpassServer(
+	log *logrus.Entry,
+	promptUserForCredential func(CredentialType, string) <-chan string,
+	task gocui.Task,
+) (*askpassServer, error) {
+	// Only we can connect to a socket in a directory that only we can access
+	dir, err := os.MkdirTemp("", "lazygit-askpass-*")
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("unix", filepath.Join(dir, "socket"))
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	self := &askpassServer{log: log, dir: dir, listener: listener}
+	go utils.Safe(func() {
+		self.serve(promptUserForCredential, task)
+	})
+	return self, nil
+}
+
+// The environment variables that make git and ssh ask us for credentials. ssh
+// only uses SSH_ASKPASS when it has no terminal to prompt on, unless
+// SSH_ASKPASS_REQUIRE says otherwise.
+func (self *askpassServer) envVars() []string {
+	return append(
+		daemon.ToEnvVars(daemon.NewAskpassInstruction(self.listener.Addr().String())),
+		"GIT_ASKPASS="+getLazygitExecutable(),
+		"SSH_ASKPASS="+getLazygitExecutable(),
+		"SSH_ASKPASS_REQUIRE=force",
+	)
+}
+
+func (self *askpassServer) close() {
+	if err := self.listener.Close(); err != nil {
+		self.log.Error(err)
+	}
+	if err := os.RemoveAll(self.dir); err != nil {
+		self.log.Error(err)
+	}
+}
+
+// Git and ssh ask for one credential at a time, so we handle one connection after
+// the other until the listener is closed
+func (self *askpassServer) serve(promptUserForCredential func(CredentialType, string) <-chan string, task gocui.Task) {
+	for {
+		conn, err := self.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		if err := self.handle(conn, promptUserForCredential, task); err != nil {
+			self.log.Error(err)
+		}
+		_ = conn.Close()
+	}
+}
+
+func (self *askpassServer) handle(conn net.Conn, promptUserForCredential func(CredentialType, string) <-chan string, task gocui.Task) error {
+	var request daemon.AskpassRequest
+	if err := json.NewDecoder(conn).Decode(&request); err != nil {
+		return err
+	}
+
+	credentialType := credentialTypeFromPrompt(request.Prompt)
+	if request.Confirmation {
+		credentialType = Confirmation
+	}
+
+	response := daemon.AskpassResponse{Cancelled: true}
+	if responseChan := promptUserForCredential(credentialType, request.Prompt); responseChan != nil {
+		if task != nil {
+			task.Pause()
+		}
+		answer := <-responseChan
+		if task != nil {
+			task.Continue()
+		}
+		response = daemon.AskpassResponse{Answer: strings.TrimSuffix(answer, "\n")}
+	}
+
+	return json.NewEncoder(conn).Encode(response)
+}
+
+var askpassPrompts = []struct {
+	pattern        *regexp.Regexp
+	credentialType CredentialType
+}{
+	{regexp.MustCompile(`(?i)continue connecting`), Confirmation},
+	{regexp.MustCompile(`(?i)^username`), Username},
+	{regexp.MustCompile(`(?i)passphrase`), Passphrase},
+	{regexp.MustCompile(`\bPIN\b`), PIN},
+	{regexp.MustCompile(`(?i)one-time|\botp\b|2fa|verification code|authentication code`), OneTimePassword},
+}
+
+// Git's own prompts are "Username for '<url>': " and "Password for '<url>': ",
+// but credential helpers and ssh can ask for anything. We ask for a password,
+// masking the input, unless the prompt tells us otherwise.
+func credentialTypeFromPrompt(prompt string) CredentialType {
+	for _, askpassPrompt := range askpassPrompts {
+		if askpassPrompt.pattern.MatchString(prompt) {
+			return askpassPrompt.credentialType
+		}
+	}
+	return Password
+}
@@ -0,0 +1,76 @@
+package oscommands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/app/daemon"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredentialTypeFromPrompt(t *testing.T) {
+	scenarios := []struct {
+		prompt   string
+		expected CredentialType
+	}{
+		{"Username for 'https://github.com': ", Username},
+		{"Password for 'https://peter@github.com': ", Password},
+		{"Enter passphrase for key '/home/peter/.ssh/id_ed25519': ", Passphrase},
+		{"Enter PIN for ECDSA-SK key /home/peter/.ssh/id_ecdsa_sk: ", PIN},
+		{"Enter your one-time code: ", OneTimePassword},
+		{"OTP: ", OneTimePassword},
+		{"Personal access token: ", Password},
+		{"The authenticity of host 'github.com (140.82.121.4)' can't be established.\nED25519 key fingerprint is SHA256:+DiY3wvvV6TuJJhbpZisF/zLDA0zPMSvHdkr4UvCOqU.\nAre you sure you want to continue connecting (yes/no/[fingerprint])? ", Confirmation},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.prompt, func(t *testing.T) {
+			assert.Equal(t, s.expected, credentialTypeFromPrompt(s.prompt))
+		})
+	}
+}
+
+func TestAskpassServer(t *testing.T) {
+	var askedFor []CredentialType
+	promptUserForCredential := func(credentialType CredentialType, _ string) <-chan string {
+		askedFor = append(askedFor, credentialType)
+		ch := make(chan string, 1)
+		ch <- map[CredentialType]string{Username: "peter\n", Password: "secret\n", Confirmation: "yes\n"}[credentialType]
+		return ch
+	}
+
+	server, err := startAskpassServer(utils.NewDummyLog(), promptUserForCredential, nil)
+	assert.NoError(t, err)
+	defer server.close()
+
+	socketPath := server.listener.Addr().String()
+	envVars := strings.Join(server.envVars(), "\n")
+	assert.Contains(t, envVars, "GIT_ASKPASS="+getLazygitExecutable())
+	assert.Contains(t, envVars, "SSH_ASKPASS="+getLazygitExecutable())
+	assert.Contains(t, envVars, "SSH_ASKPASS_REQUIRE=force")
+	assert.Contains(t, envVars, socketPath)
+
+	answer, err := daemon.RequestCredential(socketPath, daemon.AskpassRequest{Prompt: "Username for 'https://github.com': "})
+	assert.NoError(t, err)
+	assert.Equal(t, "peter", answer)
+
+	answer, err = daemon.RequestCredential(socketPath, daemon.AskpassRequest{Prompt: "Password for 'https://peter@github.com': "})
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", answer)
+
+	answer, err = daemon.RequestCredential(socketPath, daemon.AskpassRequest{Prompt: "Allow use of key /home/peter/.ssh/id_ed25519?", Confirmation: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "yes", answer)
+
+	assert.Equal(t, []CredentialType{Username, Password, Confirmation}, askedFor)
+}
+
+func TestAskpassServerWhenNotPrompting(t *testing.T) {
+	server, err := startAskpassServer(utils.NewDummyLog(), failPromptFn, nil)
+	assert.NoError(t, err)
+	defer server.close()
+
+	_, err = daemon.RequestCredential(server.listener.Addr().String(), daemon.AskpassRequest{Prompt: "Password for 'https://peter@github.com': "})
+	assert.EqualError(t, err, "Credential prompt cancelled")
+}
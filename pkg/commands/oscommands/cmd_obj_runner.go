@@ -6,10 +6,12 @@ import (
 	"io"
 	"os/exec"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/go-errors/errors"
+	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/sasha-s/go-deadlock"
 	"github.com/sirupsen/logrus"
@@ -105,7 +107,9 @@ func (self *cmdObjRunner) RunWithOutputAux(cmdObj *CmdObj) (string, error) {
 	}
 
 	t := time.Now()
-	output, err := sanitisedCommandOutput(cmdObj.GetCmd().CombinedOutput())
+	rawOutput, rawErr := cmdObj.GetCmd().CombinedOutput()
+	self.logCmdObjDone(cmdObj, t, string(rawOutput), rawErr)
+	output, err := sanitisedCommandOutput(rawOutput, rawErr)
 	if err != nil {
 		self.log.WithField("command", cmdObj.ToString()).Error(output)
 	}
@@ -128,6 +132,7 @@ func (self *cmdObjRunner) RunWithOutputsAux(cmdObj *CmdObj) (string, string, err
 	cmd.Stdout = &outBuffer
 	cmd.Stderr = &errBuffer
 	err := cmd.Run()
+	self.logCmdObjDone(cmdObj, t, outBuffer.String()+errBuffer.String(), err)
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
 
@@ -167,8 +172,13 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj *CmdObj, onLine func(line st
 		return err
 	}
 
+	// only keep the output around if it's going to be shown in the command log
+	var output strings.Builder
 	for scanner.Scan() {
 		line := scanner.Text()
+		if cmdObj.ShouldLog() {
+			output.WriteString(line + "\n")
+		}
 		stop, err := onLine(line)
 		if err != nil {
 			stdoutPipe.Close()
@@ -185,7 +195,8 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj *CmdObj, onLine func(line st
 		return scanner.Err()
 	}
 
-	_ = cmd.Wait()
+	err = cmd.Wait()
+	self.logCmdObjDone(cmdObj, t, output.String(), err)
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
 
@@ -196,6 +207,21 @@ func (self *cmdObjRunner) logCmdObj(cmdObj *CmdObj) {
 	self.guiIO.logCommandFn(cmdObj.ToString(), true)
 }
 
+func (self *cmdObjRunner) logCmdObjDone(cmdObj *CmdObj, startTime time.Time, output string, err error) {
+	if !cmdObj.ShouldLog() {
+		return
+	}
+
+	self.guiIO.logCommandDoneFn(CommandLogEntry{
+		Command:   cmdObj.ToString(),
+		Args:      slices.Clone(cmdObj.Args()),
+		StartTime: startTime,
+		Duration:  time.Since(startTime),
+		ExitCode:  exitCodeFromError(err),
+		Output:    output,
+	})
+}
+
 func sanitisedCommandOutput(output []byte, err error) (string, error) {
 	outputString := string(output)
 	if err != nil {
@@ -262,10 +288,17 @@ func (self *cmdObjRunner) runAndStreamAux(
 
 	onRun(handler, cmdWriter)
 
+	cancelled := self.terminateOnCancel(cmdObj, cmd)
+
 	err = cmd.Wait()
+	self.logCmdObjDone(cmdObj, t, stdout.String()+stderr.String(), err)
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
 
+	if cancelled() {
+		return gocui.ErrTaskCancelled
+	}
+
 	if err != nil {
 		errStr := stderr.String()
 		if errStr != "" {
@@ -285,6 +318,36 @@ func (self *cmdObjRunner) runAndStreamAux(
 	return nil
 }
 
+// If the command was given a task, terminates the command when the task is
+// cancelled. Must be called after the command has started; the returned
+// function reports whether the command was cancelled, and must only be called
+// once the command has exited.
+func (self *cmdObjRunner) terminateOnCancel(cmdObj *CmdObj, cmd *exec.Cmd) func() bool {
+	task := cmdObj.GetTask()
+	if task == nil {
+		return func() bool { return false }
+	}
+
+	done := make(chan struct{})
+	cancelled := make(chan bool, 1)
+	go utils.Safe(func() {
+		select {
+		case <-task.Cancelled():
+			if err := cmd.Process.Kill(); err != nil {
+				self.log.Error(err)
+			}
+			cancelled <- true
+		case <-done:
+			cancelled <- false
+		}
+	})
+
+	return func() bool {
+		close(done)
+		return <-cancelled
+	}
+}
+
 type CredentialType int
 
 const (
@@ -293,11 +356,16 @@ const (
 	Passphrase
 	PIN
 	Token
+	// e.g. a code from an authenticator app for two-factor authentication
+	OneTimePassword
+	// a yes/no question, e.g. whether ssh should trust the key of a host it
+	// hasn't connected to before. The answer is "yes" or "no".
+	Confirmation
 )
 
 // Whenever we're asked for a password we return a nil channel to tell the
 // caller to kill the process.
-var failPromptFn = func(CredentialType) <-chan string {
+var failPromptFn = func(CredentialType, string) <-chan string {
 	return nil
 }
 
@@ -307,10 +375,19 @@ func (self *cmdObjRunner) runWithCredentialHandling(cmdObj *CmdObj) error {
 		return err
 	}
 
+	askpass, err := startAskpassServer(self.log, promptFn, cmdObj.GetTask())
+	if err != nil {
+		// We can still answer the prompts that we detect in the output
+		self.log.Error(err)
+	} else {
+		defer askpass.close()
+		cmdObj.AddEnvVars(askpass.envVars()...)
+	}
+
 	return self.runAndDetectCredentialRequest(cmdObj, promptFn)
 }
 
-func (self *cmdObjRunner) getCredentialPromptFn(cmdObj *CmdObj) (func(CredentialType) <-chan string, error) {
+func (self *cmdObjRunner) getCredentialPromptFn(cmdObj *CmdObj) (func(CredentialType, string) <-chan string, error) {
 	switch cmdObj.GetCredentialStrategy() {
 	case PROMPT:
 		return self.guiIO.promptForCredentialFn, nil
@@ -327,7 +404,7 @@ func (self *cmdObjRunner) getCredentialPromptFn(cmdObj *CmdObj) (func(Credential
 // The promptUserForCredential argument will be "username", "password" or "passphrase" and expects the user's password/passphrase or username back
 func (self *cmdObjRunner) runAndDetectCredentialRequest(
 	cmdObj *CmdObj,
-	promptUserForCredential func(CredentialType) <-chan string,
+	promptUserForCredential func(CredentialType, string) <-chan string,
 ) error {
 	// setting the output to english so we can parse it for a username/password request
 	cmdObj.AddEnvVars("LANG=en_US.UTF-8", "LC_ALL=en_US.UTF-8")
@@ -344,7 +421,7 @@ func (self *cmdObjRunner) runAndDetectCredentialRequest(
 func (self *cmdObjRunner) processOutput(
 	reader io.Reader,
 	writer io.Writer,
-	promptUserForCredential func(CredentialType) <-chan string,
+	promptUserForCredential func(CredentialType, string) <-chan string,
 	closeFunc func() error,
 	cmdObj *CmdObj,
 ) {
@@ -355,9 +432,9 @@ func (self *cmdObjRunner) processOutput(
 	scanner.Split(bufio.ScanBytes)
 	for scanner.Scan() {
 		newBytes := scanner.Bytes()
-		askFor, ok := checkForCredentialRequest(newBytes)
+		askFor, prompt, ok := checkForCredentialRequest(newBytes)
 		if ok {
-			responseChan := promptUserForCredential(askFor)
+			responseChan := promptUserForCredential(askFor, prompt)
 			if responseChan == nil {
 				// Returning a nil channel means we should terminate the process.
 				// We achieve this by closing the pty that it's running in. Note that this won't
@@ -385,7 +462,7 @@ func (self *cmdObjRunner) processOutput(
 }
 
 // having a function that returns a function because we need to maintain some state inbetween calls hence the closure
-func (self *cmdObjRunner) getCheckForCredentialRequestFunc() func([]byte) (CredentialType, bool) {
+func (self *cmdObjRunner) getCheckForCredentialRequestFunc() func([]byte) (CredentialType, string, bool) {
 	var ttyText strings.Builder
 	prompts := map[string]CredentialType{
 		`Password:`:                              Password,
@@ -396,6 +473,7 @@ func (self *cmdObjRunner) getCheckForCredentialRequestFunc() func([]byte) (Crede
 		`Enter\s*PIN\s*for\s*.+\s*key\s*.+:`:     PIN,
 		`Enter\s*PIN\s*for\s*'.+':`:              PIN,
 		`.*2FA Token.*`:                          Token,
+		`continue\s*connecting\s*\(yes/no.*\?`:   Confirmation,
 	}
 
 	compiledPrompts := map[*regexp.Regexp]CredentialType{}
@@ -406,8 +484,13 @@ func (self *cmdObjRunner) getCheckForCredentialRequestFunc() func([]byte) (Crede
 
 	newlineRegex := regexp.MustCompile("\n")
 
+	// ssh explains what it's asking about in the lines before its question, so
+	// we keep the last few lines to show them along with it
+	const maxRecentLines = 5
+	var recentLines []string
+
 	// this function takes each word of output from the command and builds up a string to see if we're being asked for a password
-	return func(newBytes []byte) (CredentialType, bool) {
+	return func(newBytes []byte) (CredentialType, string, bool) {
 		_, err := ttyText.Write(newBytes)
 		if err != nil {
 			self.log.Error(err)
@@ -415,17 +498,23 @@ func (self *cmdObjRunner) getCheckForCredentialRequestFunc() func([]byte) (Crede
 
 		for pattern, askFor := range compiledPrompts {
 			if match := pattern.Match([]byte(ttyText.String())); match {
+				prompt := strings.Join(append(recentLines, ttyText.String()), "\n")
 				ttyText.Reset()
-				return askFor, true
+				recentLines = nil
+				return askFor, strings.TrimSpace(prompt), true
 			}
 		}
 
 		if indices := newlineRegex.FindIndex([]byte(ttyText.String())); indices != nil {
+			recentLines = append(recentLines, strings.TrimRight(ttyText.String()[:indices[0]], "\r"))
+			if len(recentLines) > maxRecentLines {
+				recentLines = recentLines[1:]
+			}
 			newText := []byte(ttyText.String()[indices[1]:])
 			ttyText.Reset()
 			ttyText.Write(newText)
 		}
-		return 0, false
+		return 0, "", false
 	}
 }
 
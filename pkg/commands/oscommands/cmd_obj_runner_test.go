@@ -16,8 +16,8 @@ func getRunner() *cmdObjRunner {
 	}
 }
 
-func toChanFn(f func(ct CredentialType) string) func(CredentialType) <-chan string {
-	return func(ct CredentialType) <-chan string {
+func toChanFn(f func(ct CredentialType) string) func(CredentialType, string) <-chan string {
+	return func(ct CredentialType, _ string) <-chan string {
 		ch := make(chan string)
 
 		go func() {
@@ -41,6 +41,8 @@ func TestProcessOutput(t *testing.T) {
 			return "pin"
 		case Token:
 			return "token"
+		case Confirmation:
+			return "yes"
 		default:
 			panic("unexpected credential type")
 		}
@@ -112,6 +114,12 @@ func TestProcessOutput(t *testing.T) {
 			output:                  "Password:\nUsername for 'Alice':\n",
 			expectedToWrite:         "passwordusername",
 		},
+		{
+			name:                    "unknown host key",
+			promptUserForCredential: defaultPromptUserForCredential,
+			output:                  "The authenticity of host 'github.com (140.82.121.4)' can't be established.\nED25519 key fingerprint is SHA256:+DiY3wvvV6TuJJhbpZisF/zLDA0zPMSvHdkr4UvCOqU.\nAre you sure you want to continue connecting (yes/no/[fingerprint])? ",
+			expectedToWrite:         "yes",
+		},
 		{
 			name:                    "user submits empty credential",
 			promptUserForCredential: func(ct CredentialType) string { return "" },
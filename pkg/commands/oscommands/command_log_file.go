@@ -0,0 +1,96 @@
+package oscommands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/sasha-s/go-deadlock"
+)
+
+// A command that lazygit ran, as recorded in the command log file
+type CommandLogEntry struct {
+	Command string
+	// The arguments the command was run with, so that it can be run again
+	// exactly as it was. Not written to the file.
+	Args      []string
+	StartTime time.Time
+	Duration  time.Duration
+	// -1 if the command couldn't be started or was killed by a signal
+	ExitCode int
+	// The combined stdout and stderr of the command. Not written to the file.
+	Output string
+}
+
+func (self CommandLogEntry) String() string {
+	return fmt.Sprintf("%s exit=%d duration=%s %s\n",
+		self.StartTime.Format(time.RFC3339),
+		self.ExitCode,
+		self.Duration.Round(time.Millisecond),
+		// keep each entry on a single line
+		strings.ReplaceAll(self.Command, "\n", `\n`),
+	)
+}
+
+// Commands can finish on several goroutines at once
+var commandLogFileMutex deadlock.Mutex
+
+// AppendToCommandLogFile appends the entry to the file at the given path. If
+// that would make the file bigger than maxSize bytes, the file is rotated
+// first: it is renamed to path.1, an existing path.1 to path.2 and so on,
+// keeping at most maxBackups old files.
+func AppendToCommandLogFile(path string, entry CommandLogEntry, maxSize int64, maxBackups int) error {
+	commandLogFileMutex.Lock()
+	defer commandLogFileMutex.Unlock()
+
+	line := entry.String()
+
+	if info, err := os.Stat(path); err == nil && info.Size() > 0 && info.Size()+int64(len(line)) > maxSize {
+		if err := rotateCommandLogFile(path, maxBackups); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.WriteString(line)
+	return err
+}
+
+func rotateCommandLogFile(path string, maxBackups int) error {
+	if maxBackups == 0 {
+		return os.Remove(path)
+	}
+
+	backupPath := func(i int) string { return fmt.Sprintf("%s.%d", path, i) }
+
+	if err := os.Remove(backupPath(maxBackups)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(backupPath(i), backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Rename(path, backupPath(1))
+}
+
+// Returns the exit code of a command from the error returned when running it
+func exitCodeFromError(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
@@ -0,0 +1,69 @@
+package oscommands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandLogEntryString(t *testing.T) {
+	entry := CommandLogEntry{
+		Command:   "git commit -m \"first line\nsecond line\"",
+		StartTime: time.Date(2024, 3, 1, 14, 5, 9, 0, time.UTC),
+		Duration:  1234567 * time.Microsecond,
+		ExitCode:  1,
+	}
+
+	assert.Equal(t,
+		"2024-03-01T14:05:09Z exit=1 duration=1.235s git commit -m \"first line\\nsecond line\"\n",
+		entry.String())
+}
+
+func TestAppendToCommandLogFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "command.log")
+
+	entry := func(command string) CommandLogEntry {
+		return CommandLogEntry{
+			Command:   command,
+			StartTime: time.Date(2024, 3, 1, 14, 5, 9, 0, time.UTC),
+			Duration:  20 * time.Millisecond,
+		}
+	}
+	// each of the lines is 53 bytes long, so two of them fit into 120 bytes
+	line := func(command string) string {
+		return "2024-03-01T14:05:09Z exit=0 duration=20ms " + command + "\n"
+	}
+	readFile := func(path string) string {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "<missing>"
+		}
+		return string(content)
+	}
+
+	for _, command := range []string{"git fetch1", "git fetch2", "git fetch3", "git fetch4", "git fetch5", "git fetch6", "git fetch7"} {
+		assert.NoError(t, AppendToCommandLogFile(path, entry(command), 120, 2))
+	}
+
+	assert.Equal(t, line("git fetch7"), readFile(path))
+	assert.Equal(t, line("git fetch5")+line("git fetch6"), readFile(path+".1"))
+	assert.Equal(t, line("git fetch3")+line("git fetch4"), readFile(path+".2"))
+	assert.Equal(t, "<missing>", readFile(path+".3"))
+}
+
+func TestAppendToCommandLogFileWithoutBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "command.log")
+
+	for _, command := range []string{"git fetch1", "git fetch2", "git fetch3"} {
+		assert.NoError(t, AppendToCommandLogFile(path, CommandLogEntry{Command: command}, 100, 0))
+	}
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "0001-01-01T00:00:00Z exit=0 duration=0s git fetch3\n", string(content))
+	assert.NoFileExists(t, path+".1")
+}
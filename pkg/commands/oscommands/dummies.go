@@ -14,12 +14,13 @@ func NewDummyOSCommand() *OSCommand {
 }
 
 type OSCommandDeps struct {
-	Common       *common.Common
-	Platform     *Platform
-	GetenvFn     func(string) string
-	RemoveFileFn func(string) error
-	Cmd          *CmdObjBuilder
-	TempDir      string
+	Common          *common.Common
+	Platform        *Platform
+	GetenvFn        func(string) string
+	PlatformNamesFn func() []string
+	RemoveFileFn    func(string) error
+	Cmd             *CmdObjBuilder
+	TempDir         string
 }
 
 func NewDummyOSCommandWithDeps(deps OSCommandDeps) *OSCommand {
@@ -33,13 +34,19 @@ func NewDummyOSCommandWithDeps(deps OSCommandDeps) *OSCommand {
 		platform = dummyPlatform
 	}
 
+	platformNamesFn := deps.PlatformNamesFn
+	if platformNamesFn == nil {
+		platformNamesFn = func() []string { return []string{platform.OS} }
+	}
+
 	return &OSCommand{
-		Common:       cmn,
-		Platform:     platform,
-		getenvFn:     deps.GetenvFn,
-		removeFileFn: deps.RemoveFileFn,
-		guiIO:        NewNullGuiIO(utils.NewDummyLog()),
-		tempDir:      deps.TempDir,
+		Common:          cmn,
+		Platform:        platform,
+		getenvFn:        deps.GetenvFn,
+		platformNamesFn: platformNamesFn,
+		removeFileFn:    deps.RemoveFileFn,
+		guiIO:           NewNullGuiIO(utils.NewDummyLog()),
+		tempDir:         deps.TempDir,
 	}
 }
 
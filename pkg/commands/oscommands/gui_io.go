@@ -19,25 +19,31 @@ type guiIO struct {
 	// depending on whether we're directly outputting a command we're about to run that
 	// will be run on the command line, or if we're using something from Go's standard lib.
 	logCommandFn func(str string, isCommandLineCommand bool)
+	// this is called when a command that was logged with logCommandFn has
+	// finished, so that the GUI can keep a record of the commands it ran
+	logCommandDoneFn func(entry CommandLogEntry)
 	// this is for us to directly write the output of a command. We will do this for
 	// certain commands like 'git push'. The GUI will write this to a command output panel.
 	// We need a new cmd writer per command, hence it being a function.
 	newCmdWriterFn func() io.Writer
 	// this allows us to request info from the user like username/password, in the event
 	// that a command requests it.
-	// the 'credential' arg is something like 'username' or 'password'
-	promptForCredentialFn func(credential CredentialType) <-chan string
+	// the 'credential' arg is something like 'username' or 'password', and the
+	// 'prompt' arg is the text the command asked with
+	promptForCredentialFn func(credential CredentialType, prompt string) <-chan string
 }
 
 func NewGuiIO(
 	log *logrus.Entry,
 	logCommandFn func(string, bool),
+	logCommandDoneFn func(CommandLogEntry),
 	newCmdWriterFn func() io.Writer,
-	promptForCredentialFn func(CredentialType) <-chan string,
+	promptForCredentialFn func(CredentialType, string) <-chan string,
 ) *guiIO {
 	return &guiIO{
 		log:                   log,
 		logCommandFn:          logCommandFn,
+		logCommandDoneFn:      logCommandDoneFn,
 		newCmdWriterFn:        newCmdWriterFn,
 		promptForCredentialFn: promptForCredentialFn,
 	}
@@ -49,6 +55,7 @@ func NewNullGuiIO(log *logrus.Entry) *guiIO {
 	return &guiIO{
 		log:                   log,
 		logCommandFn:          func(string, bool) {},
+		logCommandDoneFn:      func(CommandLogEntry) {},
 		newCmdWriterFn:        func() io.Writer { return io.Discard },
 		promptForCredentialFn: failPromptFn,
 	}
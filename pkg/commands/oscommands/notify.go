@@ -0,0 +1,63 @@
+package oscommands
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Notify shows a desktop notification, using the os.notify command if set and
+// the platform's notification mechanism otherwise
+func (c *OSCommand) Notify(title string, message string) error {
+	if commandTemplate := c.UserConfig().OS.Notify; commandTemplate != "" {
+		templateValues := map[string]string{
+			"title":   c.Quote(title),
+			"message": c.Quote(message),
+		}
+		command := utils.ResolvePlaceholderString(commandTemplate, templateValues)
+		return c.Cmd.NewShell(command, c.UserConfig().OS.ShellFunctionsFile).DontLog().Run()
+	}
+
+	return c.Cmd.New(notifyCmdArgs(c.platformNamesFn(), title, message)).DontLog().Run()
+}
+
+// We pass the title and message as arguments rather than through a shell, so
+// that we don't have to worry about quoting them for each shell
+func notifyCmdArgs(platformNames []string, title string, message string) []string {
+	switch {
+	case slices.Contains(platformNames, "darwin"):
+		return []string{
+			"osascript",
+			"-e", "on run argv",
+			"-e", "display notification (item 2 of argv) with title (item 1 of argv)",
+			"-e", "end run",
+			title, message,
+		}
+	case slices.Contains(platformNames, "windows"):
+		return []string{"powershell", "-NoProfile", "-Command", toastScript(title, message)}
+	case slices.Contains(platformNames, "wsl"):
+		return []string{"powershell.exe", "-NoProfile", "-Command", toastScript(title, message)}
+	default:
+		return []string{"notify-send", "--app-name=lazygit", title, message}
+	}
+}
+
+// A PowerShell script showing a toast notification. Toasts need the ID of an
+// installed app, so we borrow the one of PowerShell itself.
+func toastScript(title string, message string) string {
+	quote := func(str string) string {
+		return "'" + strings.ReplaceAll(str, "'", "''") + "'"
+	}
+
+	return fmt.Sprintf(
+		"$m = [Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime]; "+
+			"$x = $m::GetTemplateContent([Windows.UI.Notifications.ToastTemplateType]::ToastText02); "+
+			"$t = $x.GetElementsByTagName('text'); "+
+			"[void]$t.Item(0).AppendChild($x.CreateTextNode(%s)); "+
+			"[void]$t.Item(1).AppendChild($x.CreateTextNode(%s)); "+
+			"$m::CreateToastNotifier('{1AC14E77-02E7-4E5D-B744-2EB1AE5198B7}\\WindowsPowerShell\\v1.0\\powershell.exe').Show([Windows.UI.Notifications.ToastNotification]::new($x))",
+		quote(title), quote(message),
+	)
+}
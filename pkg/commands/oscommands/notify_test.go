@@ -0,0 +1,46 @@
+package oscommands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifyCmdArgs(t *testing.T) {
+	scenarios := []struct {
+		testName      string
+		platformNames []string
+		expected      []string
+	}{
+		{
+			testName:      "linux",
+			platformNames: []string{"linux"},
+			expected:      []string{"notify-send", "--app-name=lazygit", "lazygit", "Push finished"},
+		},
+		{
+			testName:      "darwin",
+			platformNames: []string{"darwin"},
+			expected: []string{
+				"osascript",
+				"-e", "on run argv",
+				"-e", "display notification (item 2 of argv) with title (item 1 of argv)",
+				"-e", "end run",
+				"lazygit", "Push finished",
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, notifyCmdArgs(s.platformNames, "lazygit", "Push finished"))
+		})
+	}
+}
+
+func TestNotifyCmdArgsWindows(t *testing.T) {
+	args := notifyCmdArgs([]string{"wsl", "linux"}, "lazygit", "Rebase failed in Bob's repo")
+
+	assert.Equal(t, []string{"powershell.exe", "-NoProfile", "-Command"}, args[:3])
+	assert.Contains(t, args[3], "CreateTextNode('lazygit')")
+	assert.Contains(t, args[3], "CreateTextNode('Rebase failed in Bob''s repo')")
+}
@@ -24,6 +24,8 @@ type OSCommand struct {
 	getenvFn func(string) string
 	guiIO    *guiIO
 
+	platformNamesFn func() []string
+
 	removeFileFn func(string) error
 
 	Cmd *CmdObjBuilder
@@ -42,14 +44,15 @@ type Platform struct {
 }
 
 // NewOSCommand os command runner
-func NewOSCommand(common *common.Common, config config.AppConfigurer, platform *Platform, guiIO *guiIO) *OSCommand {
+func NewOSCommand(common *common.Common, appConfig config.AppConfigurer, platform *Platform, guiIO *guiIO) *OSCommand {
 	c := &OSCommand{
-		Common:       common,
-		Platform:     platform,
-		getenvFn:     os.Getenv,
-		removeFileFn: os.RemoveAll,
-		guiIO:        guiIO,
-		tempDir:      config.GetTempDir(),
+		Common:          common,
+		Platform:        platform,
+		getenvFn:        os.Getenv,
+		platformNamesFn: config.GetPlatformNames,
+		removeFileFn:    os.RemoveAll,
+		guiIO:           guiIO,
+		tempDir:         appConfig.GetTempDir(),
 	}
 
 	runner := &cmdObjRunner{log: common.Log, guiIO: guiIO}
@@ -77,7 +80,10 @@ func FileType(path string) string {
 }
 
 func (c *OSCommand) OpenFile(filename string) error {
-	commandTemplate := c.UserConfig().OS.Open
+	commandTemplate := c.platformOpenCommand(func(commands config.OpenCommandsConfig) string { return commands.Open })
+	if commandTemplate == "" {
+		commandTemplate = c.UserConfig().OS.Open
+	}
 	if commandTemplate == "" {
 		commandTemplate = config.GetPlatformDefaultConfig().Open
 	}
@@ -89,7 +95,10 @@ func (c *OSCommand) OpenFile(filename string) error {
 }
 
 func (c *OSCommand) OpenLink(link string) error {
-	commandTemplate := c.UserConfig().OS.OpenLink
+	commandTemplate := c.platformOpenCommand(func(commands config.OpenCommandsConfig) string { return commands.OpenLink })
+	if commandTemplate == "" {
+		commandTemplate = c.UserConfig().OS.OpenLink
+	}
 	if commandTemplate == "" {
 		commandTemplate = config.GetPlatformDefaultConfig().OpenLink
 	}
@@ -101,6 +110,59 @@ func (c *OSCommand) OpenLink(link string) error {
 	return c.Cmd.NewShell(command, c.UserConfig().OS.ShellFunctionsFile).Run()
 }
 
+// Returns the command that os.openByPlatform configures for the current
+// machine, or an empty string if there is none
+func (c *OSCommand) platformOpenCommand(getCommand func(config.OpenCommandsConfig) string) string {
+	platformNames := c.platformNamesFn()
+	if c.inSSHSession() {
+		platformNames = append([]string{"ssh"}, platformNames...)
+	}
+
+	for _, name := range platformNames {
+		if command := getCommand(c.UserConfig().OS.OpenByPlatform[name]); command != "" {
+			return command
+		}
+	}
+	return ""
+}
+
+func (c *OSCommand) inSSHSession() bool {
+	return c.getenvFn("SSH_TTY") != "" || c.getenvFn("SSH_CONNECTION") != ""
+}
+
+func (c *OSCommand) OpenDirInNewWindow(dir string) error {
+	templateValues := map[string]string{
+		"dir": c.Quote(dir),
+	}
+
+	command := utils.ResolvePlaceholderString(c.UserConfig().OS.OpenDirInNewWindow, templateValues)
+	return c.Cmd.NewShell(command, c.UserConfig().OS.ShellFunctionsFile).Run()
+}
+
+const defaultOpenInTmuxCmd = "tmux new-window -n {{name}} -c {{dir}} lazygit"
+
+// OpenInTmux opens the given directory in tmux using the configured command,
+// which by default starts lazygit in a new tmux window
+func (c *OSCommand) OpenInTmux(dir string) error {
+	commandTemplate := c.UserConfig().OS.OpenInTmux
+	if commandTemplate == "" {
+		commandTemplate = defaultOpenInTmuxCmd
+	}
+	templateValues := map[string]string{
+		"dir":  c.Quote(dir),
+		"name": c.Quote(filepath.Base(dir)),
+	}
+
+	command := utils.ResolvePlaceholderString(commandTemplate, templateValues)
+	return c.Cmd.NewShell(command, c.UserConfig().OS.ShellFunctionsFile).Run()
+}
+
+// CanOpenInTmux returns false if we're not running inside tmux and the user
+// hasn't configured a custom command for opening directories in tmux
+func (c *OSCommand) CanOpenInTmux() bool {
+	return c.UserConfig().OS.OpenInTmux != "" || c.Getenv("TMUX") != ""
+}
+
 // Quote wraps a message in platform-specific quotation marks
 func (c *OSCommand) Quote(message string) string {
 	return c.Cmd.Quote(message)
@@ -280,6 +342,10 @@ func (c *OSCommand) CopyToClipboard(str string) error {
 		return c.Cmd.NewShell(cmdStr, c.UserConfig().OS.ShellFunctionsFile).Run()
 	}
 
+	if c.useOsc52() {
+		return c.copyToClipboardWithOsc52(str)
+	}
+
 	return clipboard.WriteAll(str)
 }
 
@@ -322,9 +388,15 @@ func (c *OSCommand) GetTempDir() string {
 
 // GetLazygitPath returns the path of the currently executed file
 func GetLazygitPath() string {
+	return `"` + getLazygitExecutable() + `"`
+}
+
+// Unlike GetLazygitPath, not quoted, for programs that git runs without a
+// shell, like GIT_ASKPASS
+func getLazygitExecutable() string {
 	ex, err := os.Executable() // get the executable path for git to use
 	if err != nil {
 		ex = os.Args[0] // fallback to the first call argument if needed
 	}
-	return `"` + filepath.ToSlash(ex) + `"`
+	return filepath.ToSlash(ex)
 }
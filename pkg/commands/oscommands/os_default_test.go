@@ -3,9 +3,12 @@
 package oscommands
 
 import (
+	"os/exec"
 	"testing"
 
 	"github.com/go-errors/errors"
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -139,3 +142,149 @@ func TestOSCommandOpenFileLinux(t *testing.T) {
 		s.test(oSCmd.OpenFile(s.filename))
 	}
 }
+
+func TestOSCommandOpenInTmux(t *testing.T) {
+	type scenario struct {
+		testName string
+		template string
+		dir      string
+		runner   *FakeCmdObjRunner
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "default command",
+			template: "",
+			dir:      "/path/to/my repo",
+			runner: NewFakeRunner(t).
+				ExpectArgs([]string{"bash", "-c", `tmux new-window -n "my repo" -c "/path/to/my repo" lazygit`}, "", nil),
+		},
+		{
+			testName: "custom command",
+			template: "tmux split-window -h -c {{dir}}",
+			dir:      "/path/to/repo",
+			runner: NewFakeRunner(t).
+				ExpectArgs([]string{"bash", "-c", `tmux split-window -h -c "/path/to/repo"`}, "", nil),
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			oSCmd := NewDummyOSCommandWithRunner(s.runner)
+			oSCmd.Platform.OS = "linux"
+			oSCmd.UserConfig().OS.OpenInTmux = s.template
+
+			assert.NoError(t, oSCmd.OpenInTmux(s.dir))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestOSCommandOpenByPlatform(t *testing.T) {
+	openByPlatform := map[string]config.OpenCommandsConfig{
+		"ssh":   {OpenLink: "lemonade open {{link}}"},
+		"wsl":   {Open: "wslview {{filename}}", OpenLink: "wslview {{link}}"},
+		"linux": {OpenLink: "firefox -P work {{link}}"},
+	}
+
+	type scenario struct {
+		testName      string
+		platformNames []string
+		env           map[string]string
+		runner        *FakeCmdObjRunner
+		run           func(*OSCommand) error
+	}
+
+	scenarios := []scenario{
+		{
+			testName:      "link on linux",
+			platformNames: []string{"linux"},
+			runner: NewFakeRunner(t).
+				ExpectArgs([]string{"bash", "-c", `firefox -P work "https://example.com"`}, "", nil),
+			run: func(c *OSCommand) error { return c.OpenLink("https://example.com") },
+		},
+		{
+			testName:      "link on wsl",
+			platformNames: []string{"wsl", "linux"},
+			runner: NewFakeRunner(t).
+				ExpectArgs([]string{"bash", "-c", `wslview "https://example.com"`}, "", nil),
+			run: func(c *OSCommand) error { return c.OpenLink("https://example.com") },
+		},
+		{
+			testName:      "link in an ssh session",
+			platformNames: []string{"wsl", "linux"},
+			env:           map[string]string{"SSH_TTY": "/dev/pts/1"},
+			runner: NewFakeRunner(t).
+				ExpectArgs([]string{"bash", "-c", `lemonade open "https://example.com"`}, "", nil),
+			run: func(c *OSCommand) error { return c.OpenLink("https://example.com") },
+		},
+		{
+			testName:      "file in an ssh session falls through to wsl",
+			platformNames: []string{"wsl", "linux"},
+			env:           map[string]string{"SSH_TTY": "/dev/pts/1"},
+			runner: NewFakeRunner(t).
+				ExpectArgs([]string{"bash", "-c", `wslview "file.txt"`}, "", nil),
+			run: func(c *OSCommand) error { return c.OpenFile("file.txt") },
+		},
+		{
+			testName:      "file on linux falls back to os.open",
+			platformNames: []string{"linux"},
+			runner: NewFakeRunner(t).
+				ExpectArgs([]string{"bash", "-c", `xdg-open "file.txt"`}, "", nil),
+			run: func(c *OSCommand) error { return c.OpenFile("file.txt") },
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			oSCmd := NewDummyOSCommandWithRunner(s.runner)
+			oSCmd.getenvFn = func(name string) string { return s.env[name] }
+			oSCmd.platformNamesFn = func() []string { return s.platformNames }
+			oSCmd.UserConfig().OS.Open = "xdg-open {{filename}}"
+			oSCmd.UserConfig().OS.OpenByPlatform = openByPlatform
+
+			assert.NoError(t, s.run(oSCmd))
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestOSCommandNotifyWithCustomCommand(t *testing.T) {
+	runner := NewFakeRunner(t).
+		ExpectArgs([]string{"bash", "-c", `terminal-notifier -title "lazygit" -message "Push finished in repo"`}, "", nil)
+	oSCmd := NewDummyOSCommandWithRunner(runner)
+	oSCmd.UserConfig().OS.Notify = "terminal-notifier -title {{title}} -message {{message}}"
+
+	assert.NoError(t, oSCmd.Notify("lazygit", "Push finished in repo"))
+	runner.CheckForMissingCalls()
+}
+
+func TestExitCodeFromError(t *testing.T) {
+	assert.Equal(t, 0, exitCodeFromError(nil))
+	assert.Equal(t, 3, exitCodeFromError(exec.Command("sh", "-c", "exit 3").Run()))
+	assert.Equal(t, -1, exitCodeFromError(exec.Command("/nonexistent-command").Run()))
+}
+
+type cancellableTask struct {
+	*gocui.FakeTask
+	cancelled chan struct{}
+}
+
+func (self *cancellableTask) Cancelled() <-chan struct{} {
+	return self.cancelled
+}
+
+func TestOSCommandRunCancelledTask(t *testing.T) {
+	task := &cancellableTask{FakeTask: gocui.NewFakeTask(), cancelled: make(chan struct{})}
+	runner := getRunner()
+	cmdObj := &CmdObj{
+		cmd:                exec.Command("sleep", "10"),
+		runner:             runner,
+		credentialStrategy: PROMPT,
+		task:               task,
+	}
+
+	close(task.cancelled)
+	err := runner.runWithCredentialHandling(cmdObj)
+	assert.ErrorIs(t, err, gocui.ErrTaskCancelled)
+}
@@ -0,0 +1,48 @@
+package oscommands
+
+import (
+	"encoding/base64"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/atotto/clipboard"
+)
+
+// Whether to copy to the clipboard using the OSC 52 escape sequence rather than
+// the system clipboard
+func (c *OSCommand) useOsc52() bool {
+	switch c.UserConfig().OS.ClipboardBackend {
+	case "osc52":
+		return true
+	case "system":
+		return false
+	}
+
+	// In an SSH session the system clipboard is the one of the remote machine,
+	// which is not what the user wants
+	return c.inSSHSession() || clipboard.Unsupported
+}
+
+// Asks the terminal to put the text into the clipboard. Since this goes through
+// the terminal, it also works when lazygit runs on a remote machine.
+func (c *OSCommand) copyToClipboardWithOsc52(str string) error {
+	tty, err := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer tty.Close()
+
+	_, err = io.WriteString(tty, osc52Sequence(str, c.getenvFn("TMUX") != ""))
+	return err
+}
+
+func osc52Sequence(str string, inTmux bool) string {
+	sequence := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(str)) + "\a"
+	if inTmux {
+		// tmux only passes the sequence on to the terminal if it is wrapped in
+		// a passthrough sequence, with all escape characters doubled
+		sequence = "\x1bPtmux;" + strings.ReplaceAll(sequence, "\x1b", "\x1b\x1b") + "\x1b\\"
+	}
+	return sequence
+}
@@ -0,0 +1,71 @@
+package oscommands
+
+import (
+	"testing"
+
+	"github.com/atotto/clipboard"
+	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOsc52Sequence(t *testing.T) {
+	assert.Equal(t, "\x1b]52;c;aGVsbG8=\a", osc52Sequence("hello", false))
+	assert.Equal(t, "\x1bPtmux;\x1b\x1b]52;c;aGVsbG8=\a\x1b\\", osc52Sequence("hello", true))
+}
+
+func TestUseOsc52(t *testing.T) {
+	scenarios := []struct {
+		testName             string
+		backend              string
+		env                  map[string]string
+		clipboardUnsupported bool
+		expected             bool
+	}{
+		{
+			testName: "system",
+			backend:  "system",
+			env:      map[string]string{"SSH_TTY": "/dev/pts/1"},
+			expected: false,
+		},
+		{
+			testName: "osc52",
+			backend:  "osc52",
+			expected: true,
+		},
+		{
+			testName: "auto in an SSH session",
+			backend:  "auto",
+			env:      map[string]string{"SSH_CONNECTION": "10.0.0.1 1234 10.0.0.2 22"},
+			expected: true,
+		},
+		{
+			testName:             "auto without a system clipboard",
+			backend:              "auto",
+			clipboardUnsupported: true,
+			expected:             true,
+		},
+		{
+			testName: "auto locally",
+			backend:  "auto",
+			expected: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			oldUnsupported := clipboard.Unsupported
+			clipboard.Unsupported = s.clipboardUnsupported
+			defer func() { clipboard.Unsupported = oldUnsupported }()
+
+			userConfig := config.GetDefaultConfig()
+			userConfig.OS.ClipboardBackend = s.backend
+			oSCmd := NewDummyOSCommandWithDeps(OSCommandDeps{
+				Common:   common.NewDummyCommonWithUserConfigAndAppState(userConfig, &config.AppState{}),
+				GetenvFn: func(name string) string { return s.env[name] },
+			})
+
+			assert.Equal(t, s.expected, oSCmd.useOsc52())
+		})
+	}
+}
@@ -16,6 +16,15 @@ type patchPresenter struct {
 
 	// line indices for tagged lines (e.g. lines added to a custom patch)
 	incLineIndices *set.Set[int]
+	// if true, the words that changed within modified lines are highlighted
+	highlightWordDiff bool
+	// if true, lines that were moved are colored differently from other
+	// additions and deletions
+	colorMovedLines bool
+	movedLines      map[*PatchLine]movedBlockKind
+	// if true, each line is prefixed with its line numbers in the old and the
+	// new file
+	showLineNumbers bool
 }
 
 // formats the patch as a plain string
@@ -41,6 +50,14 @@ func formatRangePlain(patch *Patch, startIdx int, endIdx int) string {
 type FormatViewOpts struct {
 	// line indices for tagged lines (e.g. lines added to a custom patch)
 	IncLineIndices *set.Set[int]
+	// if true, the words that changed within modified lines are highlighted
+	HighlightWordDiff bool
+	// if true, lines that were moved are colored differently from other
+	// additions and deletions
+	ColorMovedLines bool
+	// if true, each line is prefixed with its line numbers in the old and the
+	// new file
+	ShowLineNumbers bool
 }
 
 // formats the patch for rendering within a view, meaning it's coloured and
@@ -51,9 +68,12 @@ func formatView(patch *Patch, opts FormatViewOpts) string {
 		includedLineIndices = set.New[int]()
 	}
 	presenter := &patchPresenter{
-		patch:          patch,
-		plain:          false,
-		incLineIndices: includedLineIndices,
+		patch:             patch,
+		plain:             false,
+		incLineIndices:    includedLineIndices,
+		highlightWordDiff: opts.HighlightWordDiff,
+		colorMovedLines:   opts.ColorMovedLines,
+		showLineNumbers:   opts.ShowLineNumbers,
 	}
 	return presenter.format()
 }
@@ -65,14 +85,27 @@ func (self *patchPresenter) format() string {
 		return ""
 	}
 
+	var gutters []string
+	if self.showLineNumbers && !self.plain {
+		gutters = self.patch.LineNumberGutters()
+	}
+
 	stringBuilder := &strings.Builder{}
 	lineIdx := 0
 	appendLine := func(line string) {
+		if gutters != nil {
+			_, _ = stringBuilder.WriteString(style.FgBlackLighter.Sprint(gutters[lineIdx]))
+		}
 		_, _ = stringBuilder.WriteString(line + "\n")
 
 		lineIdx++
 	}
 
+	self.movedLines = map[*PatchLine]movedBlockKind{}
+	if self.colorMovedLines && !self.plain {
+		self.movedLines = movedLines(self.patch.hunks)
+	}
+
 	for _, line := range self.patch.header {
 		// always passing false for 'included' here because header lines are not part of the patch
 		appendLine(self.formatLineAux(line, theme.DefaultTextColor.SetBold(), false))
@@ -95,10 +128,17 @@ func (self *patchPresenter) format() string {
 				),
 		)
 
-		for _, line := range hunk.bodyLines {
+		wordRanges := map[int][]wordRange{}
+		if self.highlightWordDiff && !self.plain {
+			wordRanges = changedWordRanges(hunk.bodyLines)
+		}
+
+		for i, line := range hunk.bodyLines {
 			style := self.patchLineStyle(line)
 			if line.IsChange() {
-				appendLine(self.formatLine(line.Content, style, lineIdx))
+				// a moved line is not a modification of the line it's paired with
+				lineWordRanges := lo.Ternary(self.movedLines[line] == notMoved, wordRanges[i], nil)
+				appendLine(self.formatLine(line.Content, style, lineIdx, lineWordRanges))
 			} else {
 				appendLine(self.formatLineAux(line.Content, style, false))
 			}
@@ -109,22 +149,55 @@ func (self *patchPresenter) format() string {
 }
 
 func (self *patchPresenter) patchLineStyle(patchLine *PatchLine) style.TextStyle {
+	// these are the colors that git uses for moved lines by default
+	switch self.movedLines[patchLine] {
+	case moved:
+		return lo.Ternary(patchLine.Kind == ADDITION, style.FgCyan, style.FgMagenta).SetBold()
+	case movedAlternative:
+		return lo.Ternary(patchLine.Kind == ADDITION, style.FgYellow, style.FgBlue).SetBold()
+	}
+
 	switch patchLine.Kind {
 	case ADDITION:
-		return style.FgGreen
+		return theme.AddedLinesColor
 	case DELETION:
-		return style.FgRed
+		return theme.RemovedLinesColor
 	default:
 		return theme.DefaultTextColor
 	}
 }
 
-func (self *patchPresenter) formatLine(str string, textStyle style.TextStyle, index int) string {
+func (self *patchPresenter) formatLine(str string, textStyle style.TextStyle, index int, wordRanges []wordRange) string {
 	included := self.incLineIndices.Includes(index)
 
+	if len(wordRanges) > 0 && !self.plain {
+		return self.formatLineWithWordRanges(str, textStyle, included, wordRanges)
+	}
+
 	return self.formatLineAux(str, textStyle, included)
 }
 
+// Like formatLineAux, but additionally highlights the given ranges of the line,
+// which never include the first character
+func (self *patchPresenter) formatLineWithWordRanges(str string, textStyle style.TextStyle, included bool, wordRanges []wordRange) string {
+	result := self.formatLineAux(str[:1], textStyle, included)
+	highlightStyle := textStyle.SetReverse()
+
+	pos := 1
+	for _, r := range wordRanges {
+		if r.start > pos {
+			result += textStyle.Sprint(str[pos:r.start])
+		}
+		result += highlightStyle.Sprint(str[r.start:r.end])
+		pos = r.end
+	}
+	if pos < len(str) {
+		result += textStyle.Sprint(str[pos:])
+	}
+
+	return result
+}
+
 // 'selected' means you've got it highlighted with your cursor
 // 'included' means the line has been included in the patch (only applicable when
 // building a patch)
@@ -0,0 +1,156 @@
+package patch
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// Returns, for each line of the patch, its line number in the old file and in
+// the new file, or 0 if it doesn't exist in that file
+func (self *Patch) lineNumbers() ([]int, []int) {
+	oldLineNumbers := make([]int, len(self.header))
+	newLineNumbers := make([]int, len(self.header))
+
+	for _, hunk := range self.hunks {
+		// the hunk header
+		oldLineNumbers = append(oldLineNumbers, 0)
+		newLineNumbers = append(newLineNumbers, 0)
+
+		oldLineNumber := hunk.oldStart
+		newLineNumber := hunk.newStart
+		for _, line := range hunk.bodyLines {
+			switch line.Kind {
+			case CONTEXT:
+				oldLineNumbers = append(oldLineNumbers, oldLineNumber)
+				newLineNumbers = append(newLineNumbers, newLineNumber)
+				oldLineNumber++
+				newLineNumber++
+			case DELETION:
+				oldLineNumbers = append(oldLineNumbers, oldLineNumber)
+				newLineNumbers = append(newLineNumbers, 0)
+				oldLineNumber++
+			case ADDITION:
+				oldLineNumbers = append(oldLineNumbers, 0)
+				newLineNumbers = append(newLineNumbers, newLineNumber)
+				newLineNumber++
+			default:
+				oldLineNumbers = append(oldLineNumbers, 0)
+				newLineNumbers = append(newLineNumbers, 0)
+			}
+		}
+	}
+
+	return oldLineNumbers, newLineNumbers
+}
+
+// Returns the gutter to show in front of each line of the patch, containing the
+// line's number in the old and in the new file
+func (self *Patch) LineNumberGutters() []string {
+	oldLineNumbers, newLineNumbers := self.lineNumbers()
+
+	width := len(strconv.Itoa(max(lo.Max(oldLineNumbers), lo.Max(newLineNumbers))))
+	format := func(lineNumber int) string {
+		if lineNumber == 0 {
+			return fmt.Sprintf("%*s", width, "")
+		}
+		return fmt.Sprintf("%*d", width, lineNumber)
+	}
+
+	gutters := make([]string, len(oldLineNumbers))
+	for i := range gutters {
+		gutters[i] = format(oldLineNumbers[i]) + " " + format(newLineNumbers[i]) + " │"
+	}
+	return gutters
+}
+
+// Returns the index of the patch line that shows the given line of the new file,
+// or of the closest line to it if the patch doesn't contain it. Returns false
+// if the patch doesn't contain any lines of the new file.
+func (self *Patch) LineIdxOfNewLineNumber(lineNumber int) (int, bool) {
+	_, newLineNumbers := self.lineNumbers()
+
+	result := -1
+	for i, newLineNumber := range newLineNumbers {
+		if newLineNumber == 0 {
+			continue
+		}
+		if result == -1 || abs(newLineNumber-lineNumber) < abs(newLineNumbers[result]-lineNumber) {
+			result = i
+		}
+	}
+
+	return result, result != -1
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// Returns the path of the file and the line number in the new file of the
+// given line of a diff that may span several files, as shown in the main view.
+// If the line isn't part of a hunk (e.g. it's a commit message or a file
+// header), the first hunk after it is used instead.
+func LocationOfDiffLine(diffLines []string, lineIdx int) (string, int, bool) {
+	if lineIdx < 0 || lineIdx >= len(diffLines) {
+		return "", 0, false
+	}
+
+	hunkStartIdx := -1
+	for i := lineIdx; i >= 0; i-- {
+		if strings.HasPrefix(diffLines[i], "@@ ") {
+			hunkStartIdx = i
+			break
+		}
+		if !isHunkBodyLine(diffLines[i]) {
+			break
+		}
+	}
+
+	if hunkStartIdx == -1 {
+		for i := lineIdx; i < len(diffLines); i++ {
+			if strings.HasPrefix(diffLines[i], "@@ ") {
+				hunkStartIdx = i
+				lineIdx = i
+				break
+			}
+		}
+	}
+
+	if hunkStartIdx == -1 || !hunkHeaderRegexp.MatchString(diffLines[hunkStartIdx]) {
+		return "", 0, false
+	}
+
+	path := ""
+	for i := hunkStartIdx - 1; i >= 0; i-- {
+		if newPath, ok := strings.CutPrefix(diffLines[i], "+++ "); ok {
+			path = strings.TrimSuffix(strings.TrimPrefix(newPath, "b/"), "\t")
+			break
+		}
+		if strings.HasPrefix(diffLines[i], "diff ") {
+			break
+		}
+	}
+
+	if path == "" || path == "/dev/null" {
+		return "", 0, false
+	}
+
+	_, lineNumber, _ := headerInfo(diffLines[hunkStartIdx])
+	for _, line := range diffLines[hunkStartIdx+1 : max(lineIdx, hunkStartIdx+1)] {
+		if kind := newHunkLine(line).Kind; kind == ADDITION || kind == CONTEXT {
+			lineNumber++
+		}
+	}
+
+	return path, lineNumber, true
+}
+
+func isHunkBodyLine(line string) bool {
+	return line == "" || strings.ContainsAny(line[:1], " +-\\")
+}
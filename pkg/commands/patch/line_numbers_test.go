@@ -0,0 +1,205 @@
+package patch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const lineNumbersDiff = `diff --git a/filename b/filename
+index dcd3485..1ba5540 100644
+--- a/filename
++++ b/filename
+@@ -8,4 +8,4 @@ header
+ eight
+-nine
++NINE
+ ten
+ eleven
+@@ -20,2 +20,3 @@
+ twenty
++added
+ twenty-one
+`
+
+func TestLineNumberGutters(t *testing.T) {
+	patch := Parse(lineNumbersDiff)
+
+	assert.Equal(t, []string{
+		"      │",
+		"      │",
+		"      │",
+		"      │",
+		"      │",
+		" 8  8 │",
+		" 9    │",
+		"    9 │",
+		"10 10 │",
+		"11 11 │",
+		"      │",
+		"20 20 │",
+		"   21 │",
+		"21 22 │",
+	}, patch.LineNumberGutters())
+}
+
+func TestLineIdxOfNewLineNumber(t *testing.T) {
+	scenarios := []struct {
+		testName    string
+		lineNumber  int
+		expectedIdx int
+	}{
+		{
+			testName:    "context line",
+			lineNumber:  10,
+			expectedIdx: 8,
+		},
+		{
+			testName:    "added line",
+			lineNumber:  9,
+			expectedIdx: 7,
+		},
+		{
+			testName:    "before the first hunk",
+			lineNumber:  1,
+			expectedIdx: 5,
+		},
+		{
+			testName:    "between hunks",
+			lineNumber:  18,
+			expectedIdx: 11,
+		},
+		{
+			testName:    "after the last hunk",
+			lineNumber:  100,
+			expectedIdx: 13,
+		},
+	}
+
+	patch := Parse(lineNumbersDiff)
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			idx, ok := patch.LineIdxOfNewLineNumber(s.lineNumber)
+			assert.True(t, ok)
+			assert.Equal(t, s.expectedIdx, idx)
+		})
+	}
+}
+
+func TestFormatViewWithLineNumbers(t *testing.T) {
+	patch := Parse(lineNumbersDiff)
+	gutters := patch.LineNumberGutters()
+
+	lines := strings.Split(strings.TrimSuffix(lineNumbersDiff, "\n"), "\n")
+	expected := ""
+	for i, line := range lines {
+		expected += gutters[i] + line + "\n"
+	}
+
+	assert.Equal(t, expected, utils.Decolorise(patch.FormatView(FormatViewOpts{ShowLineNumbers: true})))
+	assert.Equal(t, lineNumbersDiff, utils.Decolorise(patch.FormatView(FormatViewOpts{})))
+}
+
+func TestLocationOfDiffLine(t *testing.T) {
+	diffLines := strings.Split(`commit 1234567
+Author: John Doe <john@doe.com>
+
+    Change things
+
+diff --git a/filename b/filename
+index dcd3485..1ba5540 100644
+--- a/filename
++++ b/filename
+@@ -8,4 +8,4 @@ header
+ eight
+-nine
++NINE
+ ten
+diff --git a/file with spaces b/file with spaces
+new file mode 100644
+index 0000000..1ba5540
+--- /dev/null
++++ b/file with spaces	
+@@ -0,0 +1,2 @@
++one
++two
+diff --git a/deleted b/deleted
+deleted file mode 100644
+index 1ba5540..0000000
+--- a/deleted
++++ /dev/null
+@@ -1,1 +0,0 @@
+-one`, "\n")
+
+	scenarios := []struct {
+		testName           string
+		lineIdx            int
+		expectedPath       string
+		expectedLineNumber int
+		expectedOk         bool
+	}{
+		{
+			testName:           "commit message",
+			lineIdx:            3,
+			expectedPath:       "filename",
+			expectedLineNumber: 8,
+			expectedOk:         true,
+		},
+		{
+			testName:           "hunk header",
+			lineIdx:            9,
+			expectedPath:       "filename",
+			expectedLineNumber: 8,
+			expectedOk:         true,
+		},
+		{
+			testName:           "deleted line",
+			lineIdx:            11,
+			expectedPath:       "filename",
+			expectedLineNumber: 9,
+			expectedOk:         true,
+		},
+		{
+			testName:           "context line after a changed line",
+			lineIdx:            13,
+			expectedPath:       "filename",
+			expectedLineNumber: 10,
+			expectedOk:         true,
+		},
+		{
+			testName:           "file header of a path with spaces",
+			lineIdx:            15,
+			expectedPath:       "file with spaces",
+			expectedLineNumber: 1,
+			expectedOk:         true,
+		},
+		{
+			testName:           "added file",
+			lineIdx:            21,
+			expectedPath:       "file with spaces",
+			expectedLineNumber: 2,
+			expectedOk:         true,
+		},
+		{
+			testName:   "deleted file",
+			lineIdx:    28,
+			expectedOk: false,
+		},
+		{
+			testName:   "out of range",
+			lineIdx:    100,
+			expectedOk: false,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			path, lineNumber, ok := LocationOfDiffLine(diffLines, s.lineIdx)
+			assert.Equal(t, s.expectedOk, ok)
+			assert.Equal(t, s.expectedPath, path)
+			assert.Equal(t, s.expectedLineNumber, lineNumber)
+		})
+	}
+}
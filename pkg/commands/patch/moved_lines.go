@@ -0,0 +1,138 @@
+package patch
+
+import (
+	"unicode"
+)
+
+// Like git's --color-moved=zebra, we look for blocks of deleted lines that
+// appear again, in the same order, as blocks of added lines elsewhere in the
+// patch. Lines in such blocks are rendered in different colors from plain
+// additions and deletions. Where two moved blocks are adjacent, the second one
+// uses alternative colors so that you can tell where one ends and the next
+// begins.
+
+type movedBlockKind int
+
+const (
+	notMoved movedBlockKind = iota
+	moved
+	movedAlternative
+)
+
+// Blocks with fewer alphanumeric characters than this are not considered as
+// moved, so that lines like '}' that appear everywhere don't light up. This is
+// the same threshold that git uses.
+const minMovedBlockAlnumCount = 20
+
+// For patches with more changed lines than this we don't look for moved lines,
+// so that rendering huge patches stays fast
+const maxMovedLinesChangeCount = 10000
+
+type lineRef struct {
+	line *PatchLine
+	// position of the line within the whole patch, used to tell whether two
+	// lines are adjacent
+	index int
+}
+
+// Returns the kind of moved block that each moved line belongs to
+func movedLines(hunks []*Hunk) map[*PatchLine]movedBlockKind {
+	deletions := []lineRef{}
+	additions := []lineRef{}
+	index := 0
+	for _, hunk := range hunks {
+		for _, line := range hunk.bodyLines {
+			switch line.Kind {
+			case DELETION:
+				deletions = append(deletions, lineRef{line: line, index: index})
+			case ADDITION:
+				additions = append(additions, lineRef{line: line, index: index})
+			}
+			index++
+		}
+		// lines in different hunks are never adjacent
+		index++
+	}
+
+	result := map[*PatchLine]movedBlockKind{}
+	if len(deletions)+len(additions) > maxMovedLinesChangeCount {
+		return result
+	}
+
+	markMovedBlocks(deletions, additions, result)
+	markMovedBlocks(additions, deletions, result)
+	return result
+}
+
+// Finds the blocks of lines in `from` that appear as consecutive lines in `to`,
+// and marks the lines of the ones that are big enough in result
+func markMovedBlocks(from []lineRef, to []lineRef, result map[*PatchLine]movedBlockKind) {
+	positionsByContent := map[string][]int{}
+	for i, ref := range to {
+		content := ref.line.Content[1:]
+		positionsByContent[content] = append(positionsByContent[content], i)
+	}
+
+	block := []lineRef{}
+	// the positions in `to` where the last line of the current block was found
+	candidates := []int{}
+	lastKind := notMoved
+	lastBlockEnd := -1
+
+	finishBlock := func() {
+		if len(block) > 0 && alnumCount(block) >= minMovedBlockAlnumCount {
+			kind := moved
+			// adjacent blocks alternate, so that you can tell them apart
+			if lastBlockEnd == block[0].index-1 && lastKind == moved {
+				kind = movedAlternative
+			}
+			for _, ref := range block {
+				result[ref.line] = kind
+			}
+			lastKind = kind
+			lastBlockEnd = block[len(block)-1].index
+		}
+		block = nil
+		candidates = nil
+	}
+
+	for i, ref := range from {
+		content := ref.line.Content[1:]
+
+		if len(block) > 0 && from[i-1].index == ref.index-1 {
+			nextCandidates := []int{}
+			for _, position := range candidates {
+				next := position + 1
+				if next < len(to) && to[next].index == to[position].index+1 && to[next].line.Content[1:] == content {
+					nextCandidates = append(nextCandidates, next)
+				}
+			}
+			if len(nextCandidates) > 0 {
+				block = append(block, ref)
+				candidates = nextCandidates
+				continue
+			}
+		}
+
+		finishBlock()
+
+		if positions := positionsByContent[content]; len(positions) > 0 {
+			block = []lineRef{ref}
+			candidates = positions
+		}
+	}
+
+	finishBlock()
+}
+
+func alnumCount(block []lineRef) int {
+	count := 0
+	for _, ref := range block {
+		for _, r := range ref.line.Content[1:] {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				count++
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,121 @@
+package patch
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMovedLines(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		lines    []string
+		// the kind of each line, by index; lines that aren't listed are not moved
+		expected map[int]movedBlockKind
+	}{
+		{
+			testName: "block moved further down",
+			lines: []string{
+				"-func first() {",
+				"-	return doSomething()",
+				" }",
+				" unchanged line",
+				"+func first() {",
+				"+	return doSomething()",
+			},
+			expected: map[int]movedBlockKind{
+				0: moved,
+				1: moved,
+				4: moved,
+				5: moved,
+			},
+		},
+		{
+			testName: "blocks with few alphanumeric characters are not moved",
+			lines: []string{
+				"-}",
+				"-x := 1",
+				" unchanged line",
+				"+}",
+				"+x := 1",
+			},
+			expected: map[int]movedBlockKind{},
+		},
+		{
+			testName: "plain changes are not moved",
+			lines: []string{
+				"-return computeSomething(a, b)",
+				"+return computeSomethingElse(a, b)",
+			},
+			expected: map[int]movedBlockKind{},
+		},
+		{
+			testName: "adjacent blocks alternate",
+			lines: []string{
+				"-the first line that was moved",
+				"-the second line that was moved",
+				" unchanged line",
+				"+the second line that was moved",
+				"+the first line that was moved",
+			},
+			expected: map[int]movedBlockKind{
+				0: moved,
+				1: movedAlternative,
+				3: moved,
+				4: movedAlternative,
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			bodyLines := make([]*PatchLine, len(s.lines))
+			for i, line := range s.lines {
+				kind := CONTEXT
+				switch line[0] {
+				case '+':
+					kind = ADDITION
+				case '-':
+					kind = DELETION
+				}
+				bodyLines[i] = &PatchLine{Kind: kind, Content: line}
+			}
+
+			kinds := movedLines([]*Hunk{{bodyLines: bodyLines}})
+			result := map[int]movedBlockKind{}
+			for i, line := range bodyLines {
+				if kind := kinds[line]; kind != notMoved {
+					result[i] = kind
+				}
+			}
+
+			assert.Equal(t, s.expected, result)
+		})
+	}
+}
+
+func TestFormatViewWithMovedLines(t *testing.T) {
+	diff := `diff --git a/filename b/filename
+index dcd3485..1ba5540 100644
+--- a/filename
++++ b/filename
+@@ -1,4 +1,3 @@
+-a line that was moved down
+ apple
+ orange
++a line that was moved down
+-grape
+`
+	patch := Parse(diff)
+
+	withoutMovedLines := patch.FormatView(FormatViewOpts{})
+	withMovedLines := patch.FormatView(FormatViewOpts{ColorMovedLines: true})
+
+	assert.Equal(t, diff, utils.Decolorise(withMovedLines))
+	assert.Contains(t, withoutMovedLines, style.FgRed.Sprint("a line that was moved down"))
+	assert.Contains(t, withMovedLines, style.FgMagenta.SetBold().Sprint("a line that was moved down"))
+	assert.Contains(t, withMovedLines, style.FgCyan.SetBold().Sprint("a line that was moved down"))
+	assert.Contains(t, withMovedLines, style.FgRed.Sprint("grape"))
+}
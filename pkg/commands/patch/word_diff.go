@@ -0,0 +1,208 @@
+package patch
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+)
+
+// Word-level highlighting pairs up the lines of a block of deletions with the
+// lines of the block of additions that immediately follows it, and highlights
+// the words that differ between the two lines of each pair. Pairing only makes
+// sense if both blocks have the same number of lines; otherwise we don't know
+// which lines correspond to each other, so we don't highlight anything.
+
+const (
+	// Blocks with more lines than this are not highlighted, so that rendering
+	// large hunks stays fast
+	maxWordDiffBlockLines = 100
+	// Line pairs whose token counts multiply to more than this are not
+	// highlighted, because diffing them is quadratic
+	maxWordDiffTokenPairs = 250 * 250
+)
+
+// A range of bytes within a line's content, end exclusive
+type wordRange struct {
+	start int
+	end   int
+}
+
+type token struct {
+	text  string
+	start int
+	end   int
+}
+
+// Returns, for each line of the hunk body with words to highlight, the ranges
+// of its content (including the leading '+' or '-') to highlight
+func changedWordRanges(bodyLines []*PatchLine) map[int][]wordRange {
+	result := map[int][]wordRange{}
+
+	for i := 0; i < len(bodyLines); {
+		if bodyLines[i].Kind != DELETION {
+			i++
+			continue
+		}
+
+		deletionsStart := i
+		for i < len(bodyLines) && bodyLines[i].Kind == DELETION {
+			i++
+		}
+		additionsStart := i
+		for i < len(bodyLines) && bodyLines[i].Kind == ADDITION {
+			i++
+		}
+
+		count := additionsStart - deletionsStart
+		if count != i-additionsStart || count > maxWordDiffBlockLines {
+			continue
+		}
+
+		for j := range count {
+			deletion := bodyLines[deletionsStart+j].Content
+			addition := bodyLines[additionsStart+j].Content
+			if len(deletion) < 2 || len(addition) < 2 {
+				continue
+			}
+
+			deletionRanges, additionRanges, ok := diffWords(deletion[1:], addition[1:])
+			if !ok {
+				continue
+			}
+			result[deletionsStart+j] = shiftRanges(deletionRanges, 1)
+			result[additionsStart+j] = shiftRanges(additionRanges, 1)
+		}
+	}
+
+	return result
+}
+
+// Returns the ranges of the words of a and b that are not part of their longest
+// common subsequence of words. Returns false if the lines are too different (or
+// too long) for highlighting to be useful.
+func diffWords(a string, b string) ([]wordRange, []wordRange, bool) {
+	tokensA := tokenize(a)
+	tokensB := tokenize(b)
+	if len(tokensA)*len(tokensB) > maxWordDiffTokenPairs {
+		return nil, nil, false
+	}
+
+	// lengths[i][j] is the length of the longest common subsequence of
+	// tokensA[i:] and tokensB[j:]
+	lengths := make([][]int, len(tokensA)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(tokensB)+1)
+	}
+	for i := len(tokensA) - 1; i >= 0; i-- {
+		for j := len(tokensB) - 1; j >= 0; j-- {
+			if tokensA[i].text == tokensB[j].text {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	commonA := make([]bool, len(tokensA))
+	commonB := make([]bool, len(tokensB))
+	hasCommonWord := false
+	for i, j := 0, 0; i < len(tokensA) && j < len(tokensB); {
+		switch {
+		case tokensA[i].text == tokensB[j].text:
+			commonA[i] = true
+			commonB[j] = true
+			if !isWhitespace(tokensA[i].text) {
+				hasCommonWord = true
+			}
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	// if the lines have nothing in common, highlighting every word of them
+	// would just be noise
+	if !hasCommonWord {
+		return nil, nil, false
+	}
+
+	rangesA := uncommonRanges(tokensA, commonA)
+	rangesB := uncommonRanges(tokensB, commonB)
+	if len(rangesA) == 0 && len(rangesB) == 0 {
+		return nil, nil, false
+	}
+
+	return rangesA, rangesB, true
+}
+
+// Splits a line into runs of word characters, runs of whitespace, and single
+// other characters
+func tokenize(str string) []token {
+	tokens := []token{}
+	kindOf := func(r rune) int {
+		switch {
+		case r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			return 0
+		case unicode.IsSpace(r):
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	start := 0
+	for start < len(str) {
+		r, size := utf8.DecodeRuneInString(str[start:])
+		kind := kindOf(r)
+		end := start + size
+		if kind != 2 {
+			for end < len(str) {
+				next, nextSize := utf8.DecodeRuneInString(str[end:])
+				if kindOf(next) != kind {
+					break
+				}
+				end += nextSize
+			}
+		}
+		tokens = append(tokens, token{text: str[start:end], start: start, end: end})
+		start = end
+	}
+
+	return tokens
+}
+
+func isWhitespace(str string) bool {
+	for _, r := range str {
+		if !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the ranges covered by the tokens that are not common, merging adjacent
+// ones
+func uncommonRanges(tokens []token, common []bool) []wordRange {
+	ranges := []wordRange{}
+	for i, token := range tokens {
+		if common[i] {
+			continue
+		}
+		if len(ranges) > 0 && ranges[len(ranges)-1].end == token.start {
+			ranges[len(ranges)-1].end = token.end
+		} else {
+			ranges = append(ranges, wordRange{start: token.start, end: token.end})
+		}
+	}
+	return ranges
+}
+
+func shiftRanges(ranges []wordRange, offset int) []wordRange {
+	return lo.Map(ranges, func(r wordRange, _ int) wordRange {
+		return wordRange{start: r.start + offset, end: r.end + offset}
+	})
+}
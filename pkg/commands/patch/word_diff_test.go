@@ -0,0 +1,163 @@
+package patch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangedWordRanges(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		lines    []string
+		// the highlighted parts of each line, keyed by line index
+		expected map[int][]string
+	}{
+		{
+			testName: "one changed word",
+			lines: []string{
+				" context",
+				"-return foo(a, b)",
+				"+return bar(a, b)",
+			},
+			expected: map[int][]string{
+				1: {"foo"},
+				2: {"bar"},
+			},
+		},
+		{
+			testName: "several changes in one line",
+			lines: []string{
+				"-x := compute(1, 2)",
+				"+y := compute(1, 3)",
+			},
+			expected: map[int][]string{
+				0: {"x", "2"},
+				1: {"y", "3"},
+			},
+		},
+		{
+			testName: "inserted words",
+			lines: []string{
+				"-if ok {",
+				"+if ok && !done {",
+			},
+			expected: map[int][]string{
+				1: {"&& !done "},
+			},
+		},
+		{
+			testName: "lines are paired in order",
+			lines: []string{
+				"-one = 1",
+				"-two = 2",
+				"+one = 10",
+				"+two = 20",
+			},
+			expected: map[int][]string{
+				0: {"1"},
+				1: {"2"},
+				2: {"10"},
+				3: {"20"},
+			},
+		},
+		{
+			testName: "blocks of different sizes are not highlighted",
+			lines: []string{
+				"-one = 1",
+				"-two = 2",
+				"+one = 10",
+			},
+			expected: map[int][]string{},
+		},
+		{
+			testName: "lines with nothing in common are not highlighted",
+			lines: []string{
+				"-foo",
+				"+bar",
+			},
+			expected: map[int][]string{},
+		},
+		{
+			testName: "additions without deletions are not highlighted",
+			lines: []string{
+				"+foo",
+				" bar",
+				"+baz",
+			},
+			expected: map[int][]string{},
+		},
+		{
+			testName: "multibyte characters",
+			lines: []string{
+				"-naïve café",
+				"+naïve thé",
+			},
+			expected: map[int][]string{
+				0: {"café"},
+				1: {"thé"},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			bodyLines := make([]*PatchLine, len(s.lines))
+			for i, line := range s.lines {
+				kind := CONTEXT
+				switch line[0] {
+				case '+':
+					kind = ADDITION
+				case '-':
+					kind = DELETION
+				}
+				bodyLines[i] = &PatchLine{Kind: kind, Content: line}
+			}
+
+			result := map[int][]string{}
+			for i, ranges := range changedWordRanges(bodyLines) {
+				for _, r := range ranges {
+					result[i] = append(result[i], s.lines[i][r.start:r.end])
+				}
+			}
+
+			assert.Equal(t, s.expected, result)
+		})
+	}
+}
+
+func TestChangedWordRangesSkipsLongLines(t *testing.T) {
+	line := strings.Repeat("word ", 300)
+	bodyLines := []*PatchLine{
+		{Kind: DELETION, Content: "-" + line + "old"},
+		{Kind: ADDITION, Content: "+" + line + "new"},
+	}
+
+	assert.Empty(t, changedWordRanges(bodyLines))
+}
+
+func TestFormatViewWithWordDiff(t *testing.T) {
+	diff := `diff --git a/filename b/filename
+index dcd3485..1ba5540 100644
+--- a/filename
++++ b/filename
+@@ -1,3 +1,3 @@
+ apple
+-orange juice
++grape juice
+ ...
+`
+	patch := Parse(diff)
+
+	withoutWordDiff := patch.FormatView(FormatViewOpts{})
+	withWordDiff := patch.FormatView(FormatViewOpts{HighlightWordDiff: true})
+
+	assert.Equal(t, diff, utils.Decolorise(withWordDiff))
+	assert.NotContains(t, withoutWordDiff, style.FgRed.SetReverse().Sprint("orange"))
+	assert.Contains(t, withWordDiff, style.FgRed.SetReverse().Sprint("orange"))
+	assert.Contains(t, withWordDiff, style.FgGreen.SetReverse().Sprint("grape"))
+	assert.Contains(t, withWordDiff, style.FgGreen.Sprint(" juice"))
+}
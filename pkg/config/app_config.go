@@ -7,7 +7,9 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/adrg/xdg"
@@ -31,6 +33,10 @@ type AppConfig struct {
 	userConfigDir         string
 	tempDir               string
 	appState              *AppState
+	envOverrideWarnings   []string
+
+	// userConfigFiles are checked for changes in the background
+	userConfigFilesMutex sync.Mutex
 }
 
 type AppConfigurer interface {
@@ -46,7 +52,14 @@ type AppConfigurer interface {
 	GetUserConfigDir() string
 	ReloadUserConfigForRepo(repoConfigFiles []*ConfigFile) error
 	ReloadChangedUserConfigFiles() (error, bool)
+	HasChangedUserConfigFiles() bool
+	SetGlobalUserConfigValue(path []string, value any) error
+	SetActiveProfile(name string) error
+	GetThemeNames() []string
+	GetThemeConfig(name string) (ThemeConfig, error)
+	SetActiveTheme(name string) error
 	GetTempDir() string
+	GetEnvOverrideWarnings() []string
 
 	GetAppState() *AppState
 	SaveAppState() error
@@ -61,10 +74,15 @@ const (
 )
 
 type ConfigFile struct {
-	Path    string
-	Policy  ConfigFilePolicy
-	modDate time.Time
-	exists  bool
+	Path   string
+	Policy ConfigFilePolicy
+	// If set, the file is only loaded if this returns true for its content.
+	// This is for files that are checked into a repo, which could run
+	// arbitrary commands through custom commands, so we only load them once
+	// the user trusts them.
+	IsTrusted func(content []byte) bool
+	modDate   time.Time
+	exists    bool
 }
 
 // NewAppConfig makes a new app config
@@ -97,11 +115,6 @@ func NewAppConfig(
 		configFiles = []*ConfigFile{configFile}
 	}
 
-	userConfig, err := loadUserConfigWithDefaults(configFiles, false)
-	if err != nil {
-		return nil, err
-	}
-
 	appState, err := loadAppState()
 	if err != nil {
 		return nil, err
@@ -113,7 +126,6 @@ func NewAppConfig(
 		buildDate:             date,
 		debug:                 debuggingFlag,
 		buildSource:           buildSource,
-		userConfig:            userConfig,
 		globalUserConfigFiles: configFiles,
 		userConfigFiles:       configFiles,
 		userConfigDir:         configDir,
@@ -121,6 +133,11 @@ func NewAppConfig(
 		appState:              appState,
 	}
 
+	appConfig.userConfig, err = appConfig.loadUserConfigWithOverrides(configFiles, false)
+	if err != nil {
+		return nil, err
+	}
+
 	return appConfig, nil
 }
 
@@ -184,7 +201,14 @@ func loadUserConfig(configFiles []*ConfigFile, base *UserConfig, isGuiInitialize
 			return nil, err
 		}
 
-		content, err = migrateUserConfig(path, content, isGuiInitialized)
+		if configFile.IsTrusted != nil && !configFile.IsTrusted(content) {
+			continue
+		}
+
+		// A file checked into a repo is migrated in memory only; it's not ours
+		// to change, and writing it would also make it untrusted again
+		writeBack := configFile.IsTrusted == nil
+		content, err = migrateUserConfig(path, content, isGuiInitialized, writeBack)
 		if err != nil {
 			return nil, err
 		}
@@ -205,6 +229,95 @@ func loadUserConfig(configFiles []*ConfigFile, base *UserConfig, isGuiInitialize
 	return base, nil
 }
 
+// Overrides the options of the config with those of the given profile. Like
+// with config files, lists are replaced and maps are merged. Nothing happens if
+// the profile doesn't exist (anymore).
+func applyProfile(userConfig *UserConfig, name string) error {
+	profile, ok := userConfig.Profiles[name]
+	if !ok {
+		return nil
+	}
+
+	content, err := yaml.Marshal(profile)
+	if err != nil {
+		return err
+	}
+
+	existingCustomCommands := userConfig.CustomCommands
+
+	if err := yaml.Unmarshal(content, userConfig); err != nil {
+		return fmt.Errorf("The profile `%s` couldn't be applied.\n%w", name, err)
+	}
+
+	userConfig.CustomCommands = append(userConfig.CustomCommands, existingCustomCommands...)
+
+	if err := userConfig.Validate(); err != nil {
+		return fmt.Errorf("The profile `%s` has a validation error.\n%w", name, err)
+	}
+
+	return nil
+}
+
+// Overrides the theme of the config with the one chosen in the themes menu.
+// For a built-in preset, only the preset changes, so the user's own colors
+// still win, and so does a preset set in the config; a theme file overrides
+// whatever options it sets. Like with profiles, nothing happens if the theme
+// doesn't exist (anymore).
+func applyTheme(userConfig *UserConfig, themesDir string, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	if IsThemePreset(name) {
+		if userConfig.Gui.Theme.Preset == GetDefaultConfig().Gui.Theme.Preset {
+			userConfig.Gui.Theme.Preset = name
+		}
+		return nil
+	}
+
+	content, err := os.ReadFile(filepath.Join(themesDir, name+".yml"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	if err := yaml.Unmarshal(content, &userConfig.Gui.Theme); err != nil {
+		return fmt.Errorf("The theme `%s` couldn't be parsed.\n%w", name, err)
+	}
+
+	if err := userConfig.Validate(); err != nil {
+		return fmt.Errorf("The theme `%s` has a validation error.\n%w", name, err)
+	}
+
+	return nil
+}
+
+// Loads the given config files, and applies the active profile, the active
+// theme and the overrides from environment variables on top
+func (c *AppConfig) loadUserConfigWithOverrides(configFiles []*ConfigFile, isGuiInitialized bool) (*UserConfig, error) {
+	userConfig, err := loadUserConfigWithDefaults(configFiles, isGuiInitialized)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyProfile(userConfig, c.appState.ActiveProfile); err != nil {
+		return nil, err
+	}
+
+	if err := applyTheme(userConfig, c.themesDir(), c.appState.ActiveTheme); err != nil {
+		return nil, err
+	}
+
+	c.envOverrideWarnings, err = applyEnvOverrides(userConfig, os.Environ())
+	if err != nil {
+		return nil, err
+	}
+
+	return userConfig, nil
+}
+
 type ChangesSet = orderedset.OrderedSet[string]
 
 func NewChangesSet() *ChangesSet {
@@ -214,8 +327,9 @@ func NewChangesSet() *ChangesSet {
 // Do any backward-compatibility migrations of things that have changed in the
 // config over time; examples are renaming a key to a better name, moving a key
 // from one container to another, or changing the type of a key (e.g. from bool
-// to an enum).
-func migrateUserConfig(path string, content []byte, isGuiInitialized bool) ([]byte, error) {
+// to an enum). Unless writeBack is set, the migrated content is only returned,
+// and the file is left alone.
+func migrateUserConfig(path string, content []byte, isGuiInitialized bool, writeBack bool) ([]byte, error) {
 	changes := NewChangesSet()
 
 	changedContent, didChange, err := computeMigratedConfig(path, content, changes)
@@ -228,6 +342,10 @@ func migrateUserConfig(path string, content []byte, isGuiInitialized bool) ([]by
 		return content, nil
 	}
 
+	if !writeBack {
+		return changedContent, nil
+	}
+
 	changesText := "The following changes were made:\n\n"
 	changesText += strings.Join(lo.Map(changes.ToSliceFromOldest(), func(change string, _ int) string {
 		return fmt.Sprintf("- %s\n", change)
@@ -507,8 +625,11 @@ func (c *AppConfig) GetUserConfigDir() string {
 }
 
 func (c *AppConfig) ReloadUserConfigForRepo(repoConfigFiles []*ConfigFile) error {
+	c.userConfigFilesMutex.Lock()
+	defer c.userConfigFilesMutex.Unlock()
+
 	configFiles := append(c.globalUserConfigFiles, repoConfigFiles...)
-	userConfig, err := loadUserConfigWithDefaults(configFiles, true)
+	userConfig, err := c.loadUserConfigWithOverrides(configFiles, true)
 	if err != nil {
 		return err
 	}
@@ -519,22 +640,25 @@ func (c *AppConfig) ReloadUserConfigForRepo(repoConfigFiles []*ConfigFile) error
 }
 
 func (c *AppConfig) ReloadChangedUserConfigFiles() (error, bool) {
-	fileHasChanged := func(f *ConfigFile) bool {
-		info, err := os.Stat(f.Path)
-		if err != nil && !os.IsNotExist(err) {
-			// If we can't stat the file, assume it hasn't changed
-			return false
-		}
-		exists := err == nil
-		return exists != f.exists || (exists && info.ModTime() != f.modDate)
-	}
+	c.userConfigFilesMutex.Lock()
+	defer c.userConfigFilesMutex.Unlock()
 
-	if lo.NoneBy(c.userConfigFiles, fileHasChanged) {
+	if lo.NoneBy(c.userConfigFiles, configFileHasChanged) {
 		return nil, false
 	}
 
-	userConfig, err := loadUserConfigWithDefaults(c.userConfigFiles, true)
+	userConfig, err := c.loadUserConfigWithOverrides(c.userConfigFiles, true)
 	if err != nil {
+		// Loading stops at the first broken file, so remember the current state
+		// of all files to report the error only once, rather than every time
+		// we check for changes
+		for _, f := range c.userConfigFiles {
+			info, statErr := os.Stat(f.Path)
+			f.exists = statErr == nil
+			if f.exists {
+				f.modDate = info.ModTime()
+			}
+		}
 		return err, false
 	}
 
@@ -542,10 +666,162 @@ func (c *AppConfig) ReloadChangedUserConfigFiles() (error, bool) {
 	return nil, true
 }
 
+// Sets a single value in the global config file, leaving the rest of the file
+// as it is, and reloads the config. If the config becomes invalid, the file is
+// restored.
+func (c *AppConfig) SetGlobalUserConfigValue(path []string, value any) error {
+	if len(c.globalUserConfigFiles) == 0 {
+		return errors.New("No global config file")
+	}
+
+	// Later files override earlier ones, so this is where the value takes effect
+	configPath := c.globalUserConfigFiles[len(c.globalUserConfigFiles)-1].Path
+	content, err := os.ReadFile(configPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	var rootNode yaml.Node
+	if err := yaml.Unmarshal(content, &rootNode); err != nil {
+		return fmt.Errorf("The config at `%s` couldn't be parsed.\n%w", configPath, err)
+	}
+	if err := yaml_utils.SetValue(&rootNode, path, value); err != nil {
+		return err
+	}
+	newContent, err := yaml_utils.YamlMarshal(&rootNode)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(configPath, newContent, 0o644); err != nil {
+		return err
+	}
+
+	if err, _ := c.ReloadChangedUserConfigFiles(); err != nil {
+		if restoreErr := os.WriteFile(configPath, content, 0o644); restoreErr != nil {
+			return restoreErr
+		}
+		_, _ = c.ReloadChangedUserConfigFiles()
+		return err
+	}
+
+	return nil
+}
+
+// Switches to the profile with the given name, or to no profile if the name is
+// empty, and remembers it in the app state for the next start
+func (c *AppConfig) SetActiveProfile(name string) error {
+	c.userConfigFilesMutex.Lock()
+	defer c.userConfigFilesMutex.Unlock()
+
+	if _, ok := c.userConfig.Profiles[name]; name != "" && !ok {
+		return fmt.Errorf("There is no profile named '%s'", name)
+	}
+
+	previousProfile := c.appState.ActiveProfile
+	c.appState.ActiveProfile = name
+	userConfig, err := c.loadUserConfigWithOverrides(c.userConfigFiles, true)
+	if err != nil {
+		c.appState.ActiveProfile = previousProfile
+		return err
+	}
+
+	c.userConfig = userConfig
+	return c.SaveAppState()
+}
+
+// Theme files are yaml files with the same options as gui.theme, e.g.
+// ~/.config/lazygit/themes/solarized.yml
+func (c *AppConfig) themesDir() string {
+	return filepath.Join(c.userConfigDir, "themes")
+}
+
+// Returns the built-in theme presets followed by the names of the theme files
+func (c *AppConfig) GetThemeNames() []string {
+	names := []string{"default"}
+	presetNames := lo.Keys(themePresets)
+	slices.Sort(presetNames)
+	names = append(names, presetNames...)
+
+	entries, err := os.ReadDir(c.themesDir())
+	if err != nil {
+		return names
+	}
+	for _, entry := range entries {
+		if name, ok := strings.CutSuffix(entry.Name(), ".yml"); ok && !entry.IsDir() && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Returns the theme that the user config would have if the theme with the
+// given name was active, for previewing it
+func (c *AppConfig) GetThemeConfig(name string) (ThemeConfig, error) {
+	c.userConfigFilesMutex.Lock()
+	defer c.userConfigFilesMutex.Unlock()
+
+	previousTheme := c.appState.ActiveTheme
+	c.appState.ActiveTheme = name
+	defer func() { c.appState.ActiveTheme = previousTheme }()
+
+	userConfig, err := c.loadUserConfigWithOverrides(c.userConfigFiles, true)
+	if err != nil {
+		return ThemeConfig{}, err
+	}
+	return userConfig.Gui.Theme, nil
+}
+
+// Switches to the theme with the given name, and remembers it in the app state
+// for the next start
+func (c *AppConfig) SetActiveTheme(name string) error {
+	c.userConfigFilesMutex.Lock()
+	defer c.userConfigFilesMutex.Unlock()
+
+	if !slices.Contains(c.GetThemeNames(), name) {
+		return fmt.Errorf("There is no theme named '%s'", name)
+	}
+
+	previousTheme := c.appState.ActiveTheme
+	c.appState.ActiveTheme = name
+	userConfig, err := c.loadUserConfigWithOverrides(c.userConfigFiles, true)
+	if err != nil {
+		c.appState.ActiveTheme = previousTheme
+		return err
+	}
+
+	c.userConfig = userConfig
+	return c.SaveAppState()
+}
+
+// Only compares modification times, so it's cheap enough to call periodically
+func (c *AppConfig) HasChangedUserConfigFiles() bool {
+	c.userConfigFilesMutex.Lock()
+	defer c.userConfigFilesMutex.Unlock()
+
+	return lo.SomeBy(c.userConfigFiles, configFileHasChanged)
+}
+
+func configFileHasChanged(f *ConfigFile) bool {
+	info, err := os.Stat(f.Path)
+	if err != nil && !os.IsNotExist(err) {
+		// If we can't stat the file, assume it hasn't changed
+		return false
+	}
+	exists := err == nil
+	return exists != f.exists || (exists && info.ModTime() != f.modDate)
+}
+
 func (c *AppConfig) GetTempDir() string {
 	return c.tempDir
 }
 
+// Returns a warning for each environment variable that looks like it overrides
+// a config option, but doesn't refer to one
+func (c *AppConfig) GetEnvOverrideWarnings() []string {
+	return c.envOverrideWarnings
+}
+
 // findConfigFile looks for a possibly existing config file.
 // This function does NOT create any folders or files.
 func findConfigFile(filename string) (exists bool, path string) {
@@ -667,6 +943,82 @@ type AppState struct {
 	ShellCommandsHistory []string `yaml:"customcommandshistory"`
 
 	HideCommandLog bool
+
+	// Path filters that are applied automatically when opening a repo, keyed
+	// by the path of the repo.
+	FilterPathsByRepo map[string]string
+
+	// One of "name", "status", "modificationTime" or "diffSize"
+	FilesSortOrder string
+
+	// Directories that the user collapsed in the file trees, keyed by the path
+	// of the repo.
+	CollapsedDirsByRepo map[string]CollapsedDirs
+
+	// The options that were last used in the merge menu, keyed by the path of
+	// the repo.
+	MergeOptionsByRepo map[string]MergeOptions
+
+	// The options that were last used in the rebase menu, keyed by the path of
+	// the repo.
+	RebaseOptionsByRepo map[string]RebaseOptions
+
+	// The remote chosen for pull requests, commit links and releases in the
+	// create pull request options menu, keyed by the path of the repo.
+	HostingServiceRemoteByRepo map[string]string
+
+	// The side panel width and command log size that the user last chose by
+	// dragging the panel borders with the mouse. Zero means they haven't, and
+	// the sidePanelWidth and commandLogSize configs apply.
+	SidePanelWidth float64
+	CommandLogSize int
+
+	// The arrangement of the side panels chosen in the layout menu, in the
+	// format of the sidePanels and sidePanelPosition configs. Empty means the
+	// configs apply.
+	SidePanels        []string
+	SidePanelPosition string
+
+	// Whether the user trusts the `.lazygit.yml` files checked into repos,
+	// keyed by the path of the file. We ask again when a file's content
+	// changes.
+	RepoConfigFileTrust map[string]RepoConfigFileTrust
+
+	// The name of the config profile chosen in the profiles menu. Empty if
+	// none is.
+	ActiveProfile string
+
+	// The name of the theme chosen in the themes menu: a built-in preset or a
+	// file in the themes directory. Empty if none is. See applyTheme for how
+	// it combines with the theme of the user config.
+	ActiveTheme string
+
+	// The keys of the last keypress recording, so that it can be replayed
+	// after restarting.
+	KeypressRecording []string
+}
+
+type CollapsedDirs struct {
+	Files       []string `yaml:"files,omitempty"`
+	CommitFiles []string `yaml:"commitFiles,omitempty"`
+}
+
+type MergeOptions struct {
+	NoFastForward   bool   `yaml:"noFastForward,omitempty"`
+	FastForwardOnly bool   `yaml:"fastForwardOnly,omitempty"`
+	StrategyOption  string `yaml:"strategyOption,omitempty"`
+	EditMessage     bool   `yaml:"editMessage,omitempty"`
+}
+
+type RebaseOptions struct {
+	ResetAuthorDate           bool `yaml:"resetAuthorDate,omitempty"`
+	CommitterDateIsAuthorDate bool `yaml:"committerDateIsAuthorDate,omitempty"`
+}
+
+type RepoConfigFileTrust struct {
+	// The sha256 of the content that the user made the decision for
+	Hash    string `yaml:"hash"`
+	Trusted bool   `yaml:"trusted"`
 }
 
 func getDefaultAppState() *AppState {
@@ -1,7 +1,10 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -1089,3 +1092,248 @@ func TestAllBranchesLogCmdMigrations(t *testing.T) {
 		})
 	}
 }
+
+func TestReloadChangedUserConfigFiles(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	writeConfig := func(content string, modTime time.Time) {
+		assert.NoError(t, os.WriteFile(configPath, []byte(content), 0o644))
+		assert.NoError(t, os.Chtimes(configPath, modTime, modTime))
+	}
+	startTime := time.Now().Add(-time.Hour)
+
+	writeConfig("gui:\n  sidePanelPosition: right\n", startTime)
+	appConfig := &AppConfig{
+		globalUserConfigFiles: []*ConfigFile{{Path: configPath, Policy: ConfigFilePolicyErrorIfMissing}},
+		appState:              getDefaultAppState(),
+	}
+	assert.NoError(t, appConfig.ReloadUserConfigForRepo(nil))
+	assert.Equal(t, "right", appConfig.GetUserConfig().Gui.SidePanelPosition)
+	assert.False(t, appConfig.HasChangedUserConfigFiles())
+
+	writeConfig("gui:\n  sidePanelPosition: left\n", startTime.Add(time.Minute))
+	assert.True(t, appConfig.HasChangedUserConfigFiles())
+	err, didChange := appConfig.ReloadChangedUserConfigFiles()
+	assert.NoError(t, err)
+	assert.True(t, didChange)
+	assert.Equal(t, "left", appConfig.GetUserConfig().Gui.SidePanelPosition)
+
+	// An invalid config is reported once, and the previous one stays in effect
+	writeConfig("gui:\n  sidePanelPosition: top\n", startTime.Add(2*time.Minute))
+	assert.True(t, appConfig.HasChangedUserConfigFiles())
+	err, didChange = appConfig.ReloadChangedUserConfigFiles()
+	assert.ErrorContains(t, err, "has a validation error")
+	assert.False(t, didChange)
+	assert.Equal(t, "left", appConfig.GetUserConfig().Gui.SidePanelPosition)
+	assert.False(t, appConfig.HasChangedUserConfigFiles())
+}
+
+func TestRepoConfigFileIsMigratedInMemoryOnly(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), ".lazygit.yml")
+	content := "gui:\n  skipUnstageLineWarning: true\n"
+	assert.NoError(t, os.WriteFile(configPath, []byte(content), 0o644))
+
+	configFile := &ConfigFile{
+		Path:      configPath,
+		Policy:    ConfigFilePolicySkipIfMissing,
+		IsTrusted: func([]byte) bool { return true },
+	}
+	userConfig, err := loadUserConfigWithDefaults([]*ConfigFile{configFile}, true)
+	assert.NoError(t, err)
+	assert.True(t, userConfig.Gui.SkipDiscardChangeWarning)
+
+	actualContent, _ := os.ReadFile(configPath)
+	assert.Equal(t, content, string(actualContent))
+}
+
+func TestSetGlobalUserConfigValue(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	assert.NoError(t, os.WriteFile(configPath, []byte("# My config\ngui:\n  showFileTree: false\n"), 0o644))
+
+	appConfig := &AppConfig{
+		globalUserConfigFiles: []*ConfigFile{{Path: configPath, Policy: ConfigFilePolicyErrorIfMissing}},
+		appState:              getDefaultAppState(),
+	}
+	assert.NoError(t, appConfig.ReloadUserConfigForRepo(nil))
+
+	assert.NoError(t, appConfig.SetGlobalUserConfigValue([]string{"gui", "sidePanelPosition"}, "right"))
+	assert.Equal(t, "right", appConfig.GetUserConfig().Gui.SidePanelPosition)
+	content, _ := os.ReadFile(configPath)
+	assert.Equal(t, "# My config\ngui:\n  showFileTree: false\n  sidePanelPosition: right\n", string(content))
+
+	// An invalid value isn't saved
+	err := appConfig.SetGlobalUserConfigValue([]string{"gui", "sidePanelPosition"}, "top")
+	assert.ErrorContains(t, err, "has a validation error")
+	assert.Equal(t, "right", appConfig.GetUserConfig().Gui.SidePanelPosition)
+	content, _ = os.ReadFile(configPath)
+	assert.Equal(t, "# My config\ngui:\n  showFileTree: false\n  sidePanelPosition: right\n", string(content))
+}
+
+func TestApplyProfile(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	assert.NoError(t, os.WriteFile(configPath, []byte(`
+gui:
+  showFileTree: false
+  nerdFontsVersion: "3"
+keybinding:
+  universal:
+    quit: Q
+profiles:
+  demo:
+    gui:
+      nerdFontsVersion: ""
+      screenMode: half
+  broken:
+    gui:
+      sidePanelPosition: top
+`), 0o644))
+
+	scenarios := []struct {
+		name                     string
+		profile                  string
+		expectedNerdFontsVersion string
+		expectedScreenMode       string
+		expectedErr              string
+	}{
+		{
+			name:                     "no profile",
+			profile:                  "",
+			expectedNerdFontsVersion: "3",
+			expectedScreenMode:       "normal",
+		},
+		{
+			name:                     "profile overrides options",
+			profile:                  "demo",
+			expectedNerdFontsVersion: "",
+			expectedScreenMode:       "half",
+		},
+		{
+			name:                     "profile that doesn't exist anymore",
+			profile:                  "work",
+			expectedNerdFontsVersion: "3",
+			expectedScreenMode:       "normal",
+		},
+		{
+			name:        "invalid profile",
+			profile:     "broken",
+			expectedErr: "The profile `broken` has a validation error",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			userConfig, err := loadUserConfigWithDefaults([]*ConfigFile{{Path: configPath, Policy: ConfigFilePolicyErrorIfMissing}}, false)
+			assert.NoError(t, err)
+
+			err = applyProfile(userConfig, s.profile)
+			if s.expectedErr != "" {
+				assert.ErrorContains(t, err, s.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedNerdFontsVersion, userConfig.Gui.NerdFontsVersion)
+			assert.Equal(t, s.expectedScreenMode, userConfig.Gui.ScreenMode)
+			// Options that the profile doesn't mention are left alone
+			assert.False(t, userConfig.Gui.ShowFileTree)
+			assert.Equal(t, "Q", userConfig.Keybinding.Universal.Quit)
+		})
+	}
+}
+
+func TestApplyTheme(t *testing.T) {
+	themesDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(themesDir, "solarized.yml"), []byte(`
+activeBorderColor:
+  - "#268bd2"
+addedLinesColor:
+  - "#859900"
+`), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(themesDir, "broken.yml"), []byte(`
+preset: neon
+`), 0o644))
+
+	scenarios := []struct {
+		name                      string
+		configPreset              string
+		theme                     string
+		expectedPreset            string
+		expectedActiveBorderColor []string
+		expectedAddedLinesColor   []string
+		expectedErr               string
+	}{
+		{
+			name:                      "no theme",
+			theme:                     "",
+			expectedPreset:            "default",
+			expectedActiveBorderColor: []string{"green", "bold"},
+			expectedAddedLinesColor:   []string{"cyan"},
+		},
+		{
+			name:                      "built-in preset",
+			theme:                     "highContrast",
+			expectedPreset:            "highContrast",
+			expectedActiveBorderColor: []string{"green", "bold"},
+			expectedAddedLinesColor:   []string{"cyan"},
+		},
+		{
+			name:                      "built-in preset when the config sets one",
+			configPreset:              "colorblind",
+			theme:                     "highContrast",
+			expectedPreset:            "colorblind",
+			expectedActiveBorderColor: []string{"green", "bold"},
+			expectedAddedLinesColor:   []string{"cyan"},
+		},
+		{
+			name:                      "theme file",
+			theme:                     "solarized",
+			expectedPreset:            "default",
+			expectedActiveBorderColor: []string{"#268bd2"},
+			expectedAddedLinesColor:   []string{"#859900"},
+		},
+		{
+			name:                      "theme file that doesn't exist anymore",
+			theme:                     "dracula",
+			expectedPreset:            "default",
+			expectedActiveBorderColor: []string{"green", "bold"},
+			expectedAddedLinesColor:   []string{"cyan"},
+		},
+		{
+			name:        "invalid theme file",
+			theme:       "broken",
+			expectedErr: "The theme `broken` has a validation error",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			userConfig := GetDefaultConfig()
+			userConfig.Gui.Theme.AddedLinesColor = []string{"cyan"}
+			if s.configPreset != "" {
+				userConfig.Gui.Theme.Preset = s.configPreset
+			}
+
+			err := applyTheme(userConfig, themesDir, s.theme)
+			if s.expectedErr != "" {
+				assert.ErrorContains(t, err, s.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedPreset, userConfig.Gui.Theme.Preset)
+			assert.Equal(t, s.expectedActiveBorderColor, userConfig.Gui.Theme.ActiveBorderColor)
+			assert.Equal(t, s.expectedAddedLinesColor, userConfig.Gui.Theme.AddedLinesColor)
+		})
+	}
+}
+
+func TestGetThemeNames(t *testing.T) {
+	configDir := t.TempDir()
+	themesDir := filepath.Join(configDir, "themes")
+	assert.NoError(t, os.Mkdir(themesDir, 0o755))
+	for _, name := range []string{"solarized.yml", "notes.txt", "colorblind.yml"} {
+		assert.NoError(t, os.WriteFile(filepath.Join(themesDir, name), []byte{}, 0o644))
+	}
+
+	appConfig := &AppConfig{userConfigDir: configDir}
+	assert.Equal(t, []string{"default", "colorblind", "highContrast", "solarized"}, appConfig.GetThemeNames())
+}
@@ -2,6 +2,14 @@
 
 package config
 
+import "runtime"
+
+// GetPlatformNames returns the keys of os.openByPlatform that apply to the
+// platform, most specific first
+func GetPlatformNames() []string {
+	return []string{runtime.GOOS}
+}
+
 // GetPlatformDefaultConfig gets the defaults for the platform
 func GetPlatformDefaultConfig() OSConfig {
 	return OSConfig{
@@ -17,6 +17,16 @@ func isContainer() bool {
 		os.Getenv("CONTAINER") != "")
 }
 
+// GetPlatformNames returns the keys of os.openByPlatform that apply to the
+// platform, most specific first
+func GetPlatformNames() []string {
+	if isWSL() && !isContainer() {
+		return []string{"wsl", "linux"}
+	}
+
+	return []string{"linux"}
+}
+
 // GetPlatformDefaultConfig gets the defaults for the platform
 func GetPlatformDefaultConfig() OSConfig {
 	if isWSL() && !isContainer() {
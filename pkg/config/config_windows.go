@@ -1,5 +1,11 @@
 package config
 
+// GetPlatformNames returns the keys of os.openByPlatform that apply to the
+// platform, most specific first
+func GetPlatformNames() []string {
+	return []string{"windows"}
+}
+
 // GetPlatformDefaultConfig gets the defaults for the platform
 func GetPlatformDefaultConfig() OSConfig {
 	return OSConfig{
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/utils/yaml_utils"
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// A yaml document that is applied on top of all config files
+	configOverrideEnvVar = "LG_CONFIG_OVERRIDE"
+	// Followed by the path of a single config option, with the keys separated
+	// by underscores, e.g. LG_gui_theme_selectedLineBgColor
+	configKeyEnvVarPrefix = "LG_"
+)
+
+// Env vars with our prefix that aren't config options
+var nonConfigKeyEnvVars = []string{"LG_CONFIG_FILE", configOverrideEnvVar}
+
+// Overrides config options with the values of environment variables, so that
+// wrapper scripts and demos can tweak lazygit without touching any files.
+// environ is in the format of os.Environ(). The values of single options are
+// parsed as yaml, so lists can be given as e.g. [a, b]; a single value for a
+// list option is taken as a list of one. Variables with our prefix that don't
+// refer to a config option are skipped, and a warning for each of them is
+// returned, so that a stray variable in the environment doesn't keep lazygit
+// from starting.
+func applyEnvOverrides(userConfig *UserConfig, environ []string) ([]string, error) {
+	var override string
+	var rootNode yaml.Node
+	var warnings []string
+	for _, envVar := range environ {
+		name, value, _ := strings.Cut(envVar, "=")
+		if name == configOverrideEnvVar {
+			override = value
+			continue
+		}
+
+		key, ok := strings.CutPrefix(name, configKeyEnvVarPrefix)
+		if !ok || key == "" || lo.Contains(nonConfigKeyEnvVars, name) {
+			continue
+		}
+
+		path := strings.Split(key, "_")
+		option, ok := lookupByYamlPath(reflect.ValueOf(userConfig), path)
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("The environment variable `%s` doesn't refer to a config option and was ignored. Use %s for options that can't be set individually.", name, configOverrideEnvVar))
+			continue
+		}
+
+		// An empty value would be parsed as null, which leaves the option
+		// unchanged; what's meant is an empty string
+		var parsedValue any = ""
+		if err := yaml.Unmarshal([]byte(value), &parsedValue); err != nil {
+			return nil, fmt.Errorf("The value of the environment variable `%s` couldn't be parsed.\n%w", name, err)
+		}
+		if _, isList := parsedValue.([]any); option.Kind() == reflect.Slice && !isList {
+			parsedValue = []any{parsedValue}
+		}
+		if err := yaml_utils.SetValue(&rootNode, path, parsedValue); err != nil {
+			return nil, err
+		}
+	}
+
+	if override == "" && rootNode.Kind == 0 {
+		return warnings, nil
+	}
+
+	existingCustomCommands := userConfig.CustomCommands
+
+	if err := yaml.Unmarshal([]byte(override), userConfig); err != nil {
+		return nil, fmt.Errorf("The config in `%s` couldn't be parsed.\n%w", configOverrideEnvVar, err)
+	}
+	if rootNode.Kind != 0 {
+		if err := rootNode.Decode(userConfig); err != nil {
+			return nil, fmt.Errorf("The config options in environment variables couldn't be applied.\n%w", err)
+		}
+	}
+
+	userConfig.CustomCommands = append(userConfig.CustomCommands, existingCustomCommands...)
+
+	if err := userConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("The config options in environment variables have a validation error.\n%w", err)
+	}
+
+	return warnings, nil
+}
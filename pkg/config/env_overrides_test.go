@@ -0,0 +1,105 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyEnvOverrides(t *testing.T) {
+	scenarios := []struct {
+		name             string
+		environ          []string
+		test             func(t *testing.T, userConfig *UserConfig)
+		expectedWarnings []string
+		expectedErr      string
+	}{
+		{
+			name:    "no overrides",
+			environ: []string{"HOME=/home/peter", "LG_CONFIG_FILE=/tmp/config.yml"},
+			test: func(t *testing.T, userConfig *UserConfig) {
+				assert.Equal(t, GetDefaultConfig(), userConfig)
+			},
+		},
+		{
+			name: "single options",
+			environ: []string{
+				"LG_gui_theme_selectedLineBgColor=[blue, bold]",
+				"LG_gui_showFileTree=false",
+				"LG_gui_nerdFontsVersion=3",
+				"LG_gui_timeFormat=",
+				"LG_git_autoFetch=false",
+			},
+			test: func(t *testing.T, userConfig *UserConfig) {
+				assert.Equal(t, []string{"blue", "bold"}, userConfig.Gui.Theme.SelectedLineBgColor)
+				assert.False(t, userConfig.Gui.ShowFileTree)
+				assert.Equal(t, "3", userConfig.Gui.NerdFontsVersion)
+				assert.Equal(t, "", userConfig.Gui.TimeFormat)
+				assert.False(t, userConfig.Git.AutoFetch)
+				// Other options are left alone
+				assert.True(t, userConfig.Gui.ShowCommandLog)
+			},
+		},
+		{
+			name: "yaml document",
+			environ: []string{
+				"LG_CONFIG_OVERRIDE={gui: {showFileTree: false, showCommandLog: false}, services: {git.example.com: 'gitlab:git.example.com'}}",
+				// Single options take precedence
+				"LG_gui_showCommandLog=true",
+			},
+			test: func(t *testing.T, userConfig *UserConfig) {
+				assert.False(t, userConfig.Gui.ShowFileTree)
+				assert.True(t, userConfig.Gui.ShowCommandLog)
+				assert.Equal(t, map[string]string{"git.example.com": "gitlab:git.example.com"}, userConfig.Services)
+			},
+		},
+		{
+			name:    "single value for a list option",
+			environ: []string{"LG_gui_theme_selectedLineBgColor=blue"},
+			test: func(t *testing.T, userConfig *UserConfig) {
+				assert.Equal(t, []string{"blue"}, userConfig.Gui.Theme.SelectedLineBgColor)
+			},
+		},
+		{
+			name:    "unknown option",
+			environ: []string{"LG_gui_showFileTee=false", "LG_WORKSPACE=foo", "LG_gui_showFileTree=false"},
+			test: func(t *testing.T, userConfig *UserConfig) {
+				assert.False(t, userConfig.Gui.ShowFileTree)
+			},
+			expectedWarnings: []string{
+				"The environment variable `LG_gui_showFileTee` doesn't refer to a config option and was ignored. Use LG_CONFIG_OVERRIDE for options that can't be set individually.",
+				"The environment variable `LG_WORKSPACE` doesn't refer to a config option and was ignored. Use LG_CONFIG_OVERRIDE for options that can't be set individually.",
+			},
+		},
+		{
+			name:        "invalid value",
+			environ:     []string{"LG_gui_showFileTree=maybe"},
+			expectedErr: "The config options in environment variables couldn't be applied",
+		},
+		{
+			name:        "value that doesn't pass validation",
+			environ:     []string{"LG_gui_sidePanelPosition=top"},
+			expectedErr: "The config options in environment variables have a validation error",
+		},
+		{
+			name:        "malformed yaml document",
+			environ:     []string{"LG_CONFIG_OVERRIDE={gui: "},
+			expectedErr: "The config in `LG_CONFIG_OVERRIDE` couldn't be parsed",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			userConfig := GetDefaultConfig()
+			warnings, err := applyEnvOverrides(userConfig, s.environ)
+			if s.expectedErr != "" {
+				assert.ErrorContains(t, err, s.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedWarnings, warnings)
+			s.test(t, userConfig)
+		})
+	}
+}
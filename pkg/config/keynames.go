@@ -78,7 +78,20 @@ var LabelByKey = map[gocui.Key]string{
 
 var KeyByLabel = lo.Invert(LabelByKey)
 
+// Keys that have to be pressed one after the other are separated by spaces,
+// e.g. "g p"
 func isValidKeybindingKey(key string) bool {
+	if utf8.RuneCountInString(key) > 1 && strings.Contains(key, " ") {
+		keys := strings.Fields(key)
+		return len(keys) > 1 && lo.EveryBy(keys, func(key string) bool {
+			return key != "<disabled>" && isValidSingleKeybindingKey(key)
+		})
+	}
+
+	return isValidSingleKeybindingKey(key)
+}
+
+func isValidSingleKeybindingKey(key string) bool {
 	runeCount := utf8.RuneCountInString(key)
 	if key == "<disabled>" {
 		return true
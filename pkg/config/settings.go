@@ -0,0 +1,172 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/schema"
+	"github.com/karimkhaleel/jsonschema"
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+// A config option as described by the JSON schema of UserConfig, for showing
+// and editing it in the settings menu. Settings with children are sections,
+// like "gui" or "gui.theme".
+type Setting struct {
+	// The yaml keys leading to the option, e.g. ["gui", "showIcons"]
+	Path     []string
+	Schema   *jsonschema.Schema
+	Children []*Setting
+}
+
+func GetSettings() ([]*Setting, error) {
+	var rootSchema jsonschema.Schema
+	if err := json.Unmarshal(schema.ConfigJSON, &rootSchema); err != nil {
+		return nil, err
+	}
+
+	return settingsFromProperties(&rootSchema, resolveSchemaRef(&rootSchema, &rootSchema), nil), nil
+}
+
+func settingsFromProperties(rootSchema *jsonschema.Schema, parentSchema *jsonschema.Schema, parentPath []string) []*Setting {
+	var settings []*Setting
+	for pair := parentSchema.Properties.Oldest(); pair != nil; pair = pair.Next() {
+		setting := &Setting{
+			Path:   append(slices.Clone(parentPath), pair.Key),
+			Schema: resolveSchemaRef(rootSchema, pair.Value),
+		}
+		if setting.Schema.Type == "object" && setting.Schema.Properties != nil {
+			setting.Children = settingsFromProperties(rootSchema, setting.Schema, setting.Path)
+		}
+		settings = append(settings, setting)
+	}
+	return settings
+}
+
+// Properties whose type is a struct refer to its definition in the root schema,
+// but have their own description
+func resolveSchemaRef(rootSchema *jsonschema.Schema, subSchema *jsonschema.Schema) *jsonschema.Schema {
+	key, ok := strings.CutPrefix(subSchema.Ref, "#/$defs/")
+	if !ok {
+		return subSchema
+	}
+
+	resolved := *rootSchema.Definitions[key]
+	if subSchema.Description != "" {
+		resolved.Description = subSchema.Description
+	}
+	return &resolved
+}
+
+func (self *Setting) Key() string {
+	return strings.Join(self.Path, ".")
+}
+
+func (self *Setting) IsSection() bool {
+	return self.Children != nil
+}
+
+// Lists and maps are better edited in the config file
+func (self *Setting) IsEditable() bool {
+	return lo.Contains([]string{"boolean", "integer", "number", "string"}, self.Schema.Type)
+}
+
+// The value of the setting in the given config, formatted like in a config
+// file, but on a single line
+func (self *Setting) FormatValue(userConfig *UserConfig) string {
+	value, ok := lookupByYamlPath(reflect.ValueOf(userConfig), self.Path)
+	if !ok {
+		return ""
+	}
+
+	if value.Kind() == reflect.String {
+		return value.String()
+	}
+
+	var node yaml.Node
+	if err := node.Encode(value.Interface()); err != nil {
+		return ""
+	}
+	node.Style = yaml.FlowStyle
+	formatted, err := yaml.Marshal(&node)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(formatted))
+}
+
+// Converts what the user typed to a value of the setting's type, checking it
+// against the constraints of the schema
+func (self *Setting) ParseValue(input string) (any, error) {
+	switch self.Schema.Type {
+	case "boolean":
+		value, err := strconv.ParseBool(input)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' must be true or false", self.Key())
+		}
+		return value, nil
+	case "integer":
+		value, err := strconv.Atoi(input)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' must be a whole number", self.Key())
+		}
+		return value, self.checkRange(float64(value))
+	case "number":
+		value, err := strconv.ParseFloat(input, 64)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' must be a number", self.Key())
+		}
+		return value, self.checkRange(value)
+	case "string":
+		if allowedValues := self.AllowedValues(); len(allowedValues) > 0 {
+			if err := validateEnum(self.Key(), input, allowedValues); err != nil {
+				return nil, err
+			}
+		}
+		return input, nil
+	}
+
+	return nil, fmt.Errorf("'%s' can only be changed in the config file", self.Key())
+}
+
+func (self *Setting) checkRange(value float64) error {
+	if minimum, err := self.Schema.Minimum.Float64(); err == nil && value < minimum {
+		return fmt.Errorf("'%s' must be at least %s", self.Key(), self.Schema.Minimum)
+	}
+	if maximum, err := self.Schema.Maximum.Float64(); err == nil && value > maximum {
+		return fmt.Errorf("'%s' must be at most %s", self.Key(), self.Schema.Maximum)
+	}
+	return nil
+}
+
+func (self *Setting) AllowedValues() []string {
+	return lo.Map(self.Schema.Enum, func(value any, _ int) string {
+		return fmt.Sprint(value)
+	})
+}
+
+func lookupByYamlPath(value reflect.Value, path []string) (reflect.Value, bool) {
+	for _, key := range path {
+		if value.Kind() == reflect.Pointer {
+			value = value.Elem()
+		}
+		if value.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+
+		field, ok := lo.Find(reflect.VisibleFields(value.Type()), func(field reflect.StructField) bool {
+			return strings.Split(field.Tag.Get("yaml"), ",")[0] == key
+		})
+		if !ok {
+			return reflect.Value{}, false
+		}
+		value = value.FieldByIndex(field.Index)
+	}
+
+	return value, true
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func findSetting(settings []*Setting, path ...string) *Setting {
+	for _, setting := range settings {
+		if setting.Path[len(setting.Path)-1] != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return setting
+		}
+		return findSetting(setting.Children, path[1:]...)
+	}
+	return nil
+}
+
+func TestGetSettings(t *testing.T) {
+	settings, err := GetSettings()
+	assert.NoError(t, err)
+
+	assert.Equal(t, "gui", settings[0].Key())
+	assert.True(t, settings[0].IsSection())
+	assert.Equal(t, "Config relating to the Lazygit UI", settings[0].Schema.Description)
+
+	theme := findSetting(settings, "gui", "theme")
+	assert.True(t, theme.IsSection())
+	assert.Contains(t, lo.Map(theme.Children, func(s *Setting, _ int) string { return s.Key() }), "gui.theme.activeBorderColor")
+
+	filterMode := findSetting(settings, "gui", "filterMode")
+	assert.False(t, filterMode.IsSection())
+	assert.True(t, filterMode.IsEditable())
+	assert.Equal(t, []string{"substring", "fuzzy"}, filterMode.AllowedValues())
+
+	assert.False(t, findSetting(settings, "gui", "theme", "activeBorderColor").IsEditable())
+}
+
+func TestSettingFormatValue(t *testing.T) {
+	settings, err := GetSettings()
+	assert.NoError(t, err)
+
+	userConfig := GetDefaultConfig()
+	assert.Equal(t, "substring", findSetting(settings, "gui", "filterMode").FormatValue(userConfig))
+	assert.Equal(t, "0.3333", findSetting(settings, "gui", "sidePanelWidth").FormatValue(userConfig))
+	assert.Equal(t, "true", findSetting(settings, "git", "autoFetch").FormatValue(userConfig))
+	assert.Equal(t, "[green, bold]", findSetting(settings, "gui", "theme", "activeBorderColor").FormatValue(userConfig))
+}
+
+func TestSettingParseValue(t *testing.T) {
+	settings, err := GetSettings()
+	assert.NoError(t, err)
+
+	scenarios := []struct {
+		path          []string
+		input         string
+		expected      any
+		expectedError string
+	}{
+		{[]string{"git", "autoFetch"}, "false", false, ""},
+		{[]string{"git", "autoFetch"}, "no", nil, "'git.autoFetch' must be true or false"},
+		{[]string{"gui", "expandedSidePanelWeight"}, "3", 3, ""},
+		{[]string{"gui", "expandedSidePanelWeight"}, "3.5", nil, "'gui.expandedSidePanelWeight' must be a whole number"},
+		{[]string{"gui", "sidePanelWidth"}, "0.25", 0.25, ""},
+		{[]string{"gui", "sidePanelWidth"}, "2", nil, "'gui.sidePanelWidth' must be at most 1"},
+		{[]string{"gui", "filterMode"}, "fuzzy", "fuzzy", ""},
+		{[]string{"gui", "filterMode"}, "regex", nil, "Unexpected value 'regex' for 'gui.filterMode'. Allowed values: substring, fuzzy"},
+		{[]string{"git", "branchPrefix"}, "feature/", "feature/", ""},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.input, func(t *testing.T) {
+			value, err := findSetting(settings, s.path...).ParseValue(s.input)
+			if s.expectedError != "" {
+				assert.EqualError(t, err, s.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expected, value)
+			}
+		})
+	}
+}
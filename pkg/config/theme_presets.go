@@ -0,0 +1,69 @@
+package config
+
+import (
+	"reflect"
+)
+
+// IF YOU ADD A PRESET HERE YOU MUST UPDATE THE `Theme presets` SECTION OF docs/Config.md
+// AND THE ENUM OF ThemeConfig.Preset
+var themePresets = map[string]ThemeConfig{
+	"highContrast": {
+		ActiveBorderColor:               []string{"yellow", "bold"},
+		InactiveBorderColor:             []string{"white"},
+		SearchingActiveBorderColor:      []string{"cyan", "bold"},
+		OptionsTextColor:                []string{"white"},
+		SelectedLineBgColor:             []string{"reverse"},
+		InactiveViewSelectedLineBgColor: []string{"underline"},
+		UnstagedChangesColor:            []string{"red", "bold"},
+		AddedLinesColor:                 []string{"green", "bold"},
+		RemovedLinesColor:               []string{"red", "bold"},
+		ConflictMarkerColor:             []string{"yellow", "bold"},
+		GraphColors:                     []string{"yellow", "cyan", "magenta", "green", "white"},
+	},
+	// Uses the Okabe-Ito palette, whose colors can be told apart with
+	// deuteranopia and protanopia
+	"colorblind": {
+		ActiveBorderColor:          []string{"#56B4E9", "bold"},
+		SearchingActiveBorderColor: []string{"#F0E442", "bold"},
+		CherryPickedCommitBgColor:  []string{"#56B4E9"},
+		CherryPickedCommitFgColor:  []string{"#0072B2"},
+		UnstagedChangesColor:       []string{"#E69F00"},
+		AddedLinesColor:            []string{"#56B4E9"},
+		RemovedLinesColor:          []string{"#E69F00"},
+		ConflictMarkerColor:        []string{"#F0E442", "bold"},
+		GraphColors:                []string{"#56B4E9", "#E69F00", "#009E73", "#F0E442", "#CC79A7", "#0072B2", "#D55E00"},
+	},
+}
+
+// Returns whether the given theme name is one of the built-in presets,
+// including "default"
+func IsThemePreset(name string) bool {
+	_, ok := themePresets[name]
+	return ok || name == "default"
+}
+
+// Returns the theme with the colors of its preset filled in. Only the colors
+// that are still at their defaults are taken from the preset, so that the
+// user's own colors win.
+func (c ThemeConfig) WithPreset() ThemeConfig {
+	preset, ok := themePresets[c.Preset]
+	if !ok {
+		return c
+	}
+
+	defaults := reflect.ValueOf(GetDefaultConfig().Gui.Theme)
+	presetValue := reflect.ValueOf(preset)
+	result := reflect.ValueOf(&c).Elem()
+	for i := range result.NumField() {
+		field := result.Field(i)
+		if field.Kind() != reflect.Slice || presetValue.Field(i).IsNil() {
+			continue
+		}
+
+		if reflect.DeepEqual(field.Interface(), defaults.Field(i).Interface()) {
+			field.Set(presetValue.Field(i))
+		}
+	}
+
+	return c
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThemeWithPreset(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		setup    func(theme *ThemeConfig)
+		expected func(theme *ThemeConfig)
+	}{
+		{
+			name:     "default preset changes nothing",
+			setup:    func(theme *ThemeConfig) {},
+			expected: func(theme *ThemeConfig) {},
+		},
+		{
+			name: "preset colors replace the defaults",
+			setup: func(theme *ThemeConfig) {
+				theme.Preset = "colorblind"
+			},
+			expected: func(theme *ThemeConfig) {
+				theme.Preset = "colorblind"
+				theme.ActiveBorderColor = []string{"#56B4E9", "bold"}
+				theme.SearchingActiveBorderColor = []string{"#F0E442", "bold"}
+				theme.CherryPickedCommitBgColor = []string{"#56B4E9"}
+				theme.CherryPickedCommitFgColor = []string{"#0072B2"}
+				theme.UnstagedChangesColor = []string{"#E69F00"}
+				theme.AddedLinesColor = []string{"#56B4E9"}
+				theme.RemovedLinesColor = []string{"#E69F00"}
+				theme.ConflictMarkerColor = []string{"#F0E442", "bold"}
+				theme.GraphColors = []string{"#56B4E9", "#E69F00", "#009E73", "#F0E442", "#CC79A7", "#0072B2", "#D55E00"}
+			},
+		},
+		{
+			name: "user colors win over the preset",
+			setup: func(theme *ThemeConfig) {
+				theme.Preset = "highContrast"
+				theme.AddedLinesColor = []string{"blue"}
+				theme.GraphColors = []string{"red"}
+			},
+			expected: func(theme *ThemeConfig) {
+				*theme = themePresets["highContrast"]
+				theme.Preset = "highContrast"
+				theme.CherryPickedCommitBgColor = []string{"cyan"}
+				theme.CherryPickedCommitFgColor = []string{"blue"}
+				theme.MarkedBaseCommitBgColor = []string{"yellow"}
+				theme.MarkedBaseCommitFgColor = []string{"blue"}
+				theme.DefaultFgColor = []string{"default"}
+				theme.AddedLinesColor = []string{"blue"}
+				theme.GraphColors = []string{"red"}
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			theme := GetDefaultConfig().Gui.Theme
+			s.setup(&theme)
+			expected := GetDefaultConfig().Gui.Theme
+			s.expected(&expected)
+			assert.Equal(t, expected, theme.WithPreset())
+		})
+	}
+}
@@ -1,9 +1,11 @@
 package config
 
 import (
+	"path"
 	"time"
 
 	"github.com/karimkhaleel/jsonschema"
+	"github.com/samber/lo"
 )
 
 type UserConfig struct {
@@ -15,6 +17,12 @@ type UserConfig struct {
 	Update UpdateConfig `yaml:"update"`
 	// Background refreshes
 	Refresher RefresherConfig `yaml:"refresher"`
+	// Desktop notifications when long-running operations finish
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#desktop-notifications
+	Notifications NotificationsConfig `yaml:"notifications"`
+	// Support for screen readers and braille displays
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#accessibility
+	Accessibility AccessibilityConfig `yaml:"accessibility"`
 	// If true, show a confirmation popup before quitting Lazygit
 	ConfirmOnQuit bool `yaml:"confirmOnQuit"`
 	// If true, exit Lazygit when the user presses escape in a context where there is nothing to cancel/close
@@ -26,8 +34,25 @@ type UserConfig struct {
 	// User-configured commands that can be invoked from within Lazygit
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Custom_Command_Keybindings.md
 	CustomCommands []CustomCommand `yaml:"customCommands" jsonschema:"uniqueItems=true"`
+	// Custom formats for copying commits, branches and files to the clipboard, shown as additional entries in the copy menus
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-copy-templates
+	CopyTemplates CopyTemplatesConfig `yaml:"copyTemplates"`
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-pull-request-urls
 	Services map[string]string `yaml:"services"`
+	// Hosting services of specific remotes, keyed by remote name. Takes precedence over 'services'.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#per-remote-hosting-services
+	RemoteServices map[string]RemoteServiceConfig `yaml:"remoteServices"`
+	// The remote whose hosting service is used for creating pull requests, opening commits in the browser, and creating releases, e.g. 'upstream' when 'origin' is your fork. Falls back to 'origin' if the remote doesn't exist.
+	// Can be changed per repo in the create pull request options menu.
+	HostingServiceRemote string `yaml:"hostingServiceRemote"`
+	// Where to look for API tokens of hosting services if none of the service's environment variables (e.g. GITHUB_TOKEN) is set. Tried in order:
+	// - 'cli': the CLI of the service that you're logged into ('gh' for GitHub, 'glab' for GitLab)
+	// - 'gitCredential': the password that git's credential helpers store for the service's domain. Not used by default, since that password isn't necessarily an API token.
+	// Set to an empty list to only use environment variables.
+	HostingServiceTokenSources []string `yaml:"hostingServiceTokenSources" jsonschema:"uniqueItems=true,enum=cli,enum=gitCredential"`
+	// Config for the issues assigned to you on the hosting service, which can be listed from the create pull request options menu
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#issues
+	Issues IssuesConfig `yaml:"issues"`
 	// What to do when opening Lazygit outside of a git repo.
 	// - 'prompt': (default) ask whether to initialize a new repo or open in the most recent repo
 	// - 'create': initialize a new repo
@@ -36,6 +61,10 @@ type UserConfig struct {
 	NotARepository string `yaml:"notARepository" jsonschema:"enum=prompt,enum=create,enum=skip,enum=quit"`
 	// If true, display a confirmation when subprocess terminates. This allows you to view the output of the subprocess before returning to Lazygit.
 	PromptToReturnFromSubprocess bool `yaml:"promptToReturnFromSubprocess"`
+	// Named sets of config options, e.g. for pairing, demos or a different keyboard layout. A profile has the same structure as the config itself, and the options in the active profile override the rest of the config.
+	// Switch profiles in the status panel.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#profiles
+	Profiles map[string]map[string]any `yaml:"profiles"`
 	// Keybindings
 	Keybinding KeybindingConfig `yaml:"keybinding"`
 }
@@ -47,6 +76,53 @@ type RefresherConfig struct {
 	// Re-fetch interval in seconds.
 	// Auto-fetch can be disabled via option 'git.autoFetch'.
 	FetchInterval int `yaml:"fetchInterval" jsonschema:"minimum=0"`
+	// If true, changes to the config files are applied as soon as they are saved. Otherwise, they are applied when lazygit regains focus.
+	AutoReloadConfig bool `yaml:"autoReloadConfig"`
+}
+
+type CommandLogFileConfig struct {
+	// If true, append every command shown in the command log to the file
+	// lazygit-command.log in the repo's .git directory, together with the time
+	// it was started, how long it took and its exit code
+	Enabled bool `yaml:"enabled"`
+	// Size in kilobytes that the file may grow to before it is rotated
+	MaxSize int `yaml:"maxSize" jsonschema:"minimum=1"`
+	// Number of rotated files to keep (named lazygit-command.log.1 etc., the
+	// lowest number being the most recent)
+	MaxBackups int `yaml:"maxBackups" jsonschema:"minimum=0"`
+}
+
+type NotificationsConfig struct {
+	// If true, show a desktop notification when one of the operations enabled
+	// below finishes while the terminal doesn't have focus. This only works in
+	// terminals that report focus changes.
+	Enabled bool `yaml:"enabled"`
+	// Notify when a push finishes
+	Push bool `yaml:"push"`
+	// Notify when a pull finishes
+	Pull bool `yaml:"pull"`
+	// Notify when a fetch started by the user finishes
+	Fetch bool `yaml:"fetch"`
+	// Notify when a rebase finishes or stops. When continuing a rebase with
+	// exec todos (e.g. to run tests for every commit), lazygit runs it in the
+	// terminal and can't tell whether the terminal has focus, so it always
+	// notifies.
+	Rebase bool `yaml:"rebase"`
+}
+
+type AccessibilityConfig struct {
+	// If true, announce the selected item, the text of popups and the results
+	// of operations through the output below, and show as text what is
+	// otherwise only shown by colors (e.g. whether a commit has been pushed).
+	Enabled bool `yaml:"enabled"`
+	// Where announcements go:
+	// - 'auto': speech-dispatcher, or 'say' on macOS
+	// - 'speechDispatcher': speak them with speech-dispatcher's spd-say
+	// - 'say': speak them with macOS's say
+	// - 'lines': append each of them as a line to 'linesFile', e.g. for a braille display or a screen reader that follows the file
+	Output string `yaml:"output" jsonschema:"enum=auto,enum=speechDispatcher,enum=say,enum=lines"`
+	// The file that the 'lines' output appends to
+	LinesFile string `yaml:"linesFile" jsonschema:"example=/tmp/lazygit-announcements"`
 }
 
 type GuiConfig struct {
@@ -57,13 +133,18 @@ type GuiConfig struct {
 	BranchColors map[string]string `yaml:"branchColors" jsonschema:"deprecated"`
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-branch-color
 	BranchColorPatterns map[string]string `yaml:"branchColorPatterns"`
-	// Custom icons for filenames and file extensions
+	// Custom icons for filenames, file extensions, branch status and commits,
+	// overriding those of the icon set
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-files-icon--color
 	CustomIcons CustomIconsConfig `yaml:"customIcons"`
 	// The number of lines you scroll by when scrolling the main window
 	ScrollHeight int `yaml:"scrollHeight" jsonschema:"minimum=1"`
 	// If true, allow scrolling past the bottom of the content in the main window
 	ScrollPastBottom bool `yaml:"scrollPastBottom"`
+	// If true, show a scrollbar on the right edge of views whose content doesn't fit. Clicking or dragging in the scrollbar jumps to that position.
+	ShowScrollbars bool `yaml:"showScrollbars"`
+	// If true, the right edge of the main view shows a condensed overview of the whole diff, with added lines in green, removed lines in red, and regions containing both in yellow. Clicking in it jumps to that region. The whole diff is loaded to build it, and it only recognizes git's own diff format, so it doesn't work with a custom pager.
+	ShowDiffMinimap bool `yaml:"showDiffMinimap"`
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#scroll-off-margin
 	ScrollOffMargin int `yaml:"scrollOffMargin"`
 	// One of: 'margin' (default) | 'jump'
@@ -74,6 +155,13 @@ type GuiConfig struct {
 	// If true, capture mouse events.
 	// When mouse events are captured, it's a little harder to select text: e.g. requiring you to hold the option key when on macOS.
 	MouseEvents bool `yaml:"mouseEvents"`
+	// If true, moving the mouse over a side panel focuses it, without having to click it.
+	FocusFollowsMouse bool `yaml:"focusFollowsMouse"`
+	// If true, clicking an item in a side panel that isn't focused also selects the item (or runs the action of the mouse gesture). If false, the first click only focuses the panel.
+	ClickThrough bool `yaml:"clickThrough"`
+	// If true, typing a number before a movement key or a repeatable action in a side panel list (e.g. 5j, or 3 followed by the key for moving a commit down) repeats it that many times.
+	// The digits start a count instead of jumping to a panel while a list in a side panel is focused, so you may want to remap 'jumpToBlock'.
+	CountPrefixes bool `yaml:"countPrefixes"`
 	// If true, do not show a warning when amending a commit.
 	SkipAmendWarning bool `yaml:"skipAmendWarning"`
 	// If true, do not show a warning when discarding changes in the staging view.
@@ -86,6 +174,7 @@ type GuiConfig struct {
 	SkipRewordInEditorWarning bool `yaml:"skipRewordInEditorWarning"`
 	// Fraction of the total screen width to use for the left side section. You may want to pick a small number (e.g. 0.2) if you're using a narrow screen, so that you can see more of the main section.
 	// Number from 0 to 1.0.
+	// Dragging the border of the main view with the mouse overrides this; double-click the border to go back to this value.
 	SidePanelWidth float64 `yaml:"sidePanelWidth" jsonschema:"maximum=1,minimum=0"`
 	// If true, increase the height of the focused side window; creating an accordion effect.
 	ExpandFocusedSidePanel bool `yaml:"expandFocusedSidePanel"`
@@ -109,6 +198,12 @@ type GuiConfig struct {
 	WrapLinesInStagingView bool `yaml:"wrapLinesInStagingView"`
 	// If true, hunk selection mode will be enabled by default when entering the staging view.
 	UseHunkModeInStagingView bool `yaml:"useHunkModeInStagingView"`
+	// If true, highlight the words that changed within modified lines in the diffs that lazygit renders itself, i.e. in the staging view and when building a custom patch. Deleted lines are paired with the added lines that follow them only if there are as many of each. To keep rendering fast, blocks of more than 100 changed lines and very long lines are not highlighted.
+	HighlightWordDiff bool `yaml:"highlightWordDiff"`
+	// If true, show the line numbers of the old and the new file in front of each line of the diffs that lazygit renders itself, i.e. in the staging view and when building a custom patch.
+	ShowLineNumbersInStagingView bool `yaml:"showLineNumbersInStagingView"`
+	// If true, show the size and type of the old and new version of a binary file (and the dimensions, for images) in the main view, rather than just git's 'Binary files differ' message
+	ShowBinaryFileInfo bool `yaml:"showBinaryFileInfo"`
 	// One of 'auto' (default) | 'en' | 'zh-CN' | 'zh-TW' | 'pl' | 'nl' | 'ja' | 'ko' | 'ru'
 	Language string `yaml:"language" jsonschema:"enum=auto,enum=en,enum=zh-TW,enum=zh-CN,enum=pl,enum=nl,enum=ja,enum=ko,enum=ru"`
 	// Format used when displaying time e.g. commit time.
@@ -117,6 +212,16 @@ type GuiConfig struct {
 	// Format used when displaying time if the time is less than 24 hours ago.
 	// Uses Go's time format syntax: https://pkg.go.dev/time#Time.Format
 	ShortTimeFormat string `yaml:"shortTimeFormat"`
+	// How dates are shown in the commits, reflog, stash and branches panels. One of:
+	// - 'default': the commits and reflog panels show the date in the timeFormat (or the time in the shortTimeFormat), the stash and branches panels show how long ago it was
+	// - 'relative': how long ago it was, e.g. '3d'
+	// - 'absolute': the date in the timeFormat, or the time in the shortTimeFormat if it was today
+	// - 'custom': the date in the customTimeFormat
+	// This can be cycled through from within Lazygit with the '%' key, but that will not change the default.
+	DateDisplay string `yaml:"dateDisplay" jsonschema:"enum=default,enum=relative,enum=absolute,enum=custom"`
+	// Format used when displaying dates if dateDisplay is 'custom'.
+	// Uses Go's time format syntax: https://pkg.go.dev/time#Time.Format
+	CustomTimeFormat string `yaml:"customTimeFormat"`
 	// Config relating to colors and styles.
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#color-attributes
 	Theme ThemeConfig `yaml:"theme"`
@@ -131,12 +236,33 @@ type GuiConfig struct {
 	ShowRootItemInFileTree bool `yaml:"showRootItemInFileTree"`
 	// If true, show the number of lines changed per file in the Files view
 	ShowNumstatInFilesView bool `yaml:"showNumstatInFilesView"`
+	// If true, show the number of lines changed per file in the Commit Files view
+	ShowNumstatInCommitFilesView bool `yaml:"showNumstatInCommitFilesView"`
+	// If true, also list tracked files that have the skip-worktree or
+	// assume-unchanged bit set in the Files view, which git status doesn't
+	// show. This needs an extra `git ls-files` call on every refresh, which
+	// can be slow in big repos. Files that sparse checkout leaves out are not
+	// listed.
+	ShowFilesWithHiddenChanges bool `yaml:"showFilesWithHiddenChanges"`
 	// If true, show a random tip in the command log when Lazygit starts
 	ShowRandomTip bool `yaml:"showRandomTip"`
 	// If true, show the command log
 	ShowCommandLog bool `yaml:"showCommandLog"`
 	// If true, show the bottom line that contains keybinding info and useful buttons. If false, this line will be hidden except to display a loader for an in-progress action.
 	ShowBottomLine bool `yaml:"showBottomLine"`
+	// Segments shown at the right end of the bottom line, in place of the version number and links. Segments whose text is empty are left out.
+	// For example:
+	//   statusBarSegments:
+	//     - template: "{{.Branch}}{{if .Behind}} ↓{{.Behind}}{{end}}{{if .Ahead}} ↑{{.Ahead}}{{end}}"
+	//       color: [blue]
+	//     - template: "{{.Operation}}"
+	//       color: [yellow]
+	//     - command: "git stash list | wc -l"
+	//       template: "stashes: {{.Output}}"
+	//     - template: '{{.Time.Format "15:04"}}'
+	StatusBarSegments []StatusBarSegment `yaml:"statusBarSegments"`
+	// Text shown between the segments of statusBarSegments
+	StatusBarSeparator string `yaml:"statusBarSeparator"`
 	// If true, show jump-to-window keybindings in window titles.
 	ShowPanelJumps bool `yaml:"showPanelJumps"`
 	// Deprecated: use nerdFontsVersion instead
@@ -144,29 +270,67 @@ type GuiConfig struct {
 	// Nerd fonts version to use.
 	// One of: '2' | '3' | empty string (default)
 	// If empty, do not show icons.
+	// Only relevant if iconSet is empty.
 	NerdFontsVersion string `yaml:"nerdFontsVersion" jsonschema:"enum=2,enum=3,enum="`
-	// If true (default), file icons are shown in the file views. Only relevant if NerdFontsVersion is not empty.
+	// The icons to show in front of files, branches, commits etc.
+	// One of: 'nerdFontsV3' | 'nerdFontsV2' | 'emoji' | 'ascii' | 'none' | 'auto' | empty string (default)
+	// 'auto' uses Nerd Fonts in terminals that are known to come with them, and no icons otherwise.
+	// If empty, nerdFontsVersion decides.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#icon-sets
+	IconSet string `yaml:"iconSet" jsonschema:"enum=nerdFontsV3,enum=nerdFontsV2,enum=emoji,enum=ascii,enum=none,enum=auto,enum="`
+	// If true (default), file icons are shown in the file views. Only relevant if an icon set is used.
 	ShowFileIcons bool `yaml:"showFileIcons"`
 	// Length of author name in (non-expanded) commits view. 2 means show initials only.
 	CommitAuthorShortLength int `yaml:"commitAuthorShortLength"`
 	// Length of author name in expanded commits view. 2 means show initials only.
 	CommitAuthorLongLength int `yaml:"commitAuthorLongLength"`
+	// How authors are shown in the commits view. One of:
+	// - 'full' (default): the full name
+	// - 'shortened': the first name and the initial of the last name, e.g. 'Jesse D.'
+	// - 'initials': the initials, e.g. 'JD'
+	// - 'email': the email address
+	// - 'avatar': the initials on a background of the author's color
+	// Full names that don't fit into the commitAuthorShortLength or commitAuthorLongLength are shortened before they are cut off. In narrow views, the author column takes at most a quarter of the width.
+	CommitAuthorFormat string `yaml:"commitAuthorFormat" jsonschema:"enum=full,enum=shortened,enum=initials,enum=email,enum=avatar"`
 	// Length of commit hash in commits view. 0 shows '*' if NF icons aren't on.
 	CommitHashLength int `yaml:"commitHashLength" jsonschema:"minimum=0"`
+	// If true, show the number of files changed, insertions and deletions of each commit in the commits view.
+	// The numbers are loaded in the background as commits come into view, which costs an extra git call per screenful of commits.
+	ShowCommitStats bool `yaml:"showCommitStats"`
+	// If true, the main view of a commit starts with a header showing the full hash, author and committer, the parents (which can be clicked to select them),
+	// the local branches and tags containing the commit, and the trailers of the message, instead of git's own header.
+	// Finding the containing branches and tags walks the history, which can be slow in large repositories.
+	ShowCommitDetailsHeader bool `yaml:"showCommitDetailsHeader"`
 	// If true, show commit hashes alongside branch names in the branches view.
 	ShowBranchCommitHash bool `yaml:"showBranchCommitHash"`
 	// Whether to show the divergence from the base branch in the branches view.
-	// One of: 'none' | 'onlyArrow'  | 'arrowAndNumber'
-	ShowDivergenceFromBaseBranch string `yaml:"showDivergenceFromBaseBranch" jsonschema:"enum=none,enum=onlyArrow,enum=arrowAndNumber"`
-	// Height of the command log view
+	// The base branch is the one of git.mainBranches that the branch was forked off of.
+	// One of: 'none' | 'onlyArrow'  | 'arrowAndNumber' | 'aheadAndBehind'
+	// 'onlyArrow' and 'arrowAndNumber' show how far the branch is behind, 'aheadAndBehind' also how far it is ahead.
+	ShowDivergenceFromBaseBranch string `yaml:"showDivergenceFromBaseBranch" jsonschema:"enum=none,enum=onlyArrow,enum=arrowAndNumber,enum=aheadAndBehind"`
+	// Template for rendering each line of the branches view, replacing the built-in layout. Tabs separate columns, which are aligned across lines.
+	// Available fields: {{.Name}}, {{.Recency}}, {{.AheadBehind}}, {{.Divergence}}, {{.Hash}}, {{.Upstream}}, {{.Subject}}, {{.Icon}}, {{.Worktree}}
+	// For example: "{{.Recency}}\t{{.Name}} {{.AheadBehind}}\t{{.Subject}}"
+	// If empty, the built-in layout is used.
+	BranchLineTemplate string `yaml:"branchLineTemplate"`
+	// Template for rendering each line of the commits views, replacing the built-in layout. Tabs separate columns, which are aligned across lines.
+	// Available fields: {{.Name}}, {{.Hash}}, {{.Author}}, {{.AuthorInitials}}, {{.Age}}, {{.Date}}, {{.Tags}}, {{.Graph}}, {{.Action}}, {{.Mark}}, {{.Divergence}}, {{.Bisect}}, {{.Stats}} (only filled in if showCommitStats is on), {{.Status}} (unpushed, pushed or merged)
+	// For example: "{{.Hash}}\t{{.Age}}\t{{.AuthorInitials}}\t{{.Graph}}{{.Mark}}{{.Tags}}{{.Name}}"
+	// If empty, the built-in layout is used.
+	CommitLineTemplate string `yaml:"commitLineTemplate"`
+	// Height of the command log view. Dragging its top border with the mouse overrides this; double-click the border to go back to this value.
 	CommandLogSize int `yaml:"commandLogSize" jsonschema:"minimum=0"`
+	// Config for keeping a record of the commands shown in the command log in a file, so that you can see afterwards what lazygit did
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#command-log-file
+	CommandLogFile CommandLogFileConfig `yaml:"commandLogFile"`
 	// Whether to split the main window when viewing file changes.
 	// One of: 'auto' | 'always'
 	// If 'auto', only split the main window when a file has both staged and unstaged changes
 	SplitDiff string `yaml:"splitDiff" jsonschema:"enum=auto,enum=always"`
 	// Default size for focused window. Can be changed from within Lazygit with '+' and '_' (but this won't change the default).
-	// One of: 'normal' (default) | 'half' | 'full'
-	ScreenMode string `yaml:"screenMode" jsonschema:"enum=normal,enum=half,enum=full"`
+	// One of: 'normal' (default) | 'half' | 'full' | 'zen'
+	// 'zen' shows only the focused panel and its main view, without the command log and the bottom line; it can be toggled with '~'.
+	ScreenMode string `yaml:"screenMode" jsonschema:"enum=normal,enum=half,enum=full,enum=zen"`
 	// Window border style.
 	// One of 'rounded' (default) | 'single' | 'double' | 'hidden' | 'bold'
 	Border string `yaml:"border" jsonschema:"enum=single,enum=double,enum=rounded,enum=hidden,enum=bold"`
@@ -189,6 +353,15 @@ type GuiConfig struct {
 	SwitchToFilesAfterStashApply bool `yaml:"switchToFilesAfterStashApply"`
 	// If true, when using the panel jump keys (default 1 through 5) and target panel is already active, go to next tab instead
 	SwitchTabsWithPanelJumpKeys bool `yaml:"switchTabsWithPanelJumpKeys"`
+	// The side panels to show, from top to bottom. Panels that are left out are hidden, except while you focus them (e.g. with the panel jump keys).
+	// Several panels can share a slot by listing them in one entry, separated by commas (e.g. 'commits, stash'); the slot shows the first of them unless another one is focused.
+	// Available panels: 'status' | 'files' | 'branches' | 'commits' | 'stash'
+	// This can also be changed at runtime from the layout menu.
+	SidePanels []string `yaml:"sidePanels"`
+	// Where to show the side panels.
+	// One of 'left' (default) | 'right'
+	// In portrait mode, 'right' puts them below the main view.
+	SidePanelPosition string `yaml:"sidePanelPosition" jsonschema:"enum=left,enum=right"`
 }
 
 func (c *GuiConfig) UseFuzzySearch() bool {
@@ -196,6 +369,12 @@ func (c *GuiConfig) UseFuzzySearch() bool {
 }
 
 type ThemeConfig struct {
+	// A built-in set of colors that the other options of the theme start from.
+	// One of 'default' | 'highContrast' | 'colorblind'
+	// 'colorblind' avoids telling things apart by red and green alone, for people with deuteranopia or protanopia.
+	// Colors that you set yourself take precedence over those of the preset.
+	// A preset other than 'default' also takes precedence over the one picked in the themes menu.
+	Preset string `yaml:"preset" jsonschema:"enum=default,enum=highContrast,enum=colorblind"`
 	// Border color of focused window
 	ActiveBorderColor []string `yaml:"activeBorderColor" jsonschema:"minItems=1,uniqueItems=true"`
 	// Border color of non-focused windows
@@ -221,6 +400,16 @@ type ThemeConfig struct {
 	UnstagedChangesColor []string `yaml:"unstagedChangesColor" jsonschema:"minItems=1,uniqueItems=true"`
 	// Default text color
 	DefaultFgColor []string `yaml:"defaultFgColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of added lines in diffs, and of the number of added lines in the files views
+	AddedLinesColor []string `yaml:"addedLinesColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of removed lines in diffs, and of the number of removed lines in the files views
+	RemovedLinesColor []string `yaml:"removedLinesColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Color of the <<<<<<<, ======= and >>>>>>> lines of merge conflicts
+	ConflictMarkerColor []string `yaml:"conflictMarkerColor" jsonschema:"minItems=1,uniqueItems=true"`
+	// Colors to pick from for the lanes of the commit graph. A lane gets its
+	// color from the author of its commit, so each author always gets the same one.
+	// If empty, every author gets a color of their own.
+	GraphColors []string `yaml:"graphColors" jsonschema:"uniqueItems=true"`
 }
 
 type CommitLengthConfig struct {
@@ -260,20 +449,45 @@ type GitConfig struct {
 	// continue a merge or rebase if you've resolved all conflicts. If false, it
 	// won't do either of these things.
 	AutoStageResolvedConflicts bool `yaml:"autoStageResolvedConflicts"`
+	// If true, lazygit will continue the merge or rebase right away when the
+	// last conflicted file has been resolved, instead of asking you first.
+	// Only has an effect if autoStageResolvedConflicts is true. Can be toggled
+	// for the current session from the merge/rebase options menu.
+	AutoContinueAfterResolvingConflicts bool `yaml:"autoContinueAfterResolvingConflicts"`
+	// Merge tool to use when opening a single conflicted file, e.g. 'meld' or
+	// 'vimdiff'. If empty, the tool configured in git's merge.tool setting is used.
+	MergeTool string `yaml:"mergeTool"`
 	// Command used when displaying the current branch git log in the main window
 	BranchLogCmd string `yaml:"branchLogCmd"`
 	// Commands used to display git log of all branches in the main window, they will be cycled in order of appearance (array of strings)
 	AllBranchesLogCmds []string `yaml:"allBranchesLogCmds"`
-	// If true, git diffs are rendered with the `--ignore-all-space` flag, which ignores whitespace changes. Can be toggled from within Lazygit with `<c-w>`.
+	// If true, git diffs are rendered with the `--ignore-all-space` flag, which ignores whitespace changes. Can be toggled from within Lazygit with `<c-w>`, or in the diff options menu (`^`).
 	IgnoreWhitespaceInDiffView bool `yaml:"ignoreWhitespaceInDiffView"`
+	// If true, git diffs are rendered with the `--ignore-blank-lines` flag, which ignores changes whose lines are all blank. Can be toggled from within Lazygit in the diff options menu (`^`).
+	IgnoreBlankLinesInDiffView bool `yaml:"ignoreBlankLinesInDiffView"`
+	// The algorithm used for git diffs. If empty, the one configured in git's diff.algorithm setting is used. Can be changed from within Lazygit in the diff options menu (`^`).
+	DiffAlgorithm string `yaml:"diffAlgorithm" jsonschema:"enum=,enum=myers,enum=minimal,enum=patience,enum=histogram"`
+	// Whether lines that were moved are shown in different colors from lines that were added or deleted, like git's `--color-moved`. One of 'auto' (follow git's diff.colorMoved setting) | 'always' | 'never'. This also applies to the diffs that lazygit renders itself, e.g. in the staging view. Can be toggled from within Lazygit with `|`.
+	ColorMoved string `yaml:"colorMoved" jsonschema:"enum=auto,enum=always,enum=never"`
 	// The number of lines of context to show around each diff hunk. Can be changed from within Lazygit with the `{` and `}` keys.
 	DiffContextSize uint64 `yaml:"diffContextSize"`
 	// The threshold for considering a file to be renamed, in percent. Can be changed from within Lazygit with the `(` and `)` keys.
 	RenameSimilarityThreshold int `yaml:"renameSimilarityThreshold" jsonschema:"minimum=0,maximum=100"`
+	// If true, files that were moved in the working tree without telling git
+	// are shown as a single rename rather than as a deleted and an untracked
+	// file. Detecting these needs a copy of the index and an extra git status
+	// call whenever there are both deleted and untracked files, which can be
+	// slow in big repos.
+	DetectUnstagedRenames bool `yaml:"detectUnstagedRenames"`
 	// If true, do not spawn a separate process when using GPG
 	OverrideGpg bool `yaml:"overrideGpg"`
 	// If true, do not allow force pushes
 	DisableForcePushing bool `yaml:"disableForcePushing"`
+	// Branches that lazygit won't force push or delete, e.g. because other people work on them too. Glob patterns like 'release/*' are supported. This is most useful in a repo's `.lazygit.yml`.
+	ProtectedBranches []string `yaml:"protectedBranches" jsonschema:"uniqueItems=true"`
+	// Config for saving snapshots of the repo before destructive operations, so that they can be undone
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#snapshots
+	Snapshots SnapshotsConfig `yaml:"snapshots"`
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#predefined-commit-message-prefix
 	CommitPrefix []CommitPrefixConfig `yaml:"commitPrefix"`
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#predefined-commit-message-prefix
@@ -296,6 +510,32 @@ type GitConfig struct {
 	// When copying commit hashes to the clipboard, truncate them to this
 	// length. Set to 40 to disable truncation.
 	TruncateCopiedCommitHashesTo int `yaml:"truncateCopiedCommitHashesTo"`
+	// Path of the worktree that is created when using the "Review in worktree"
+	// action. Can contain "{{repoName}}" and "{{branchName}}" placeholders
+	// (slashes in the branch name are replaced by dashes). Relative paths are
+	// resolved against the main worktree of the repo.
+	ReviewWorktreePath string `yaml:"reviewWorktreePath"`
+	// If true, creating a tag while the repo already has a semver tag (e.g.
+	// v1.2.3) first offers to bump its patch, minor or major version.
+	SuggestTagVersionBumps bool `yaml:"suggestTagVersionBumps"`
+	// Go template used to pre-fill the message of a tag created via one of the
+	// version bump options. Available fields are {{.Tag}}, {{.PreviousTag}} and
+	// {{.Changelog}} (a list of the subjects of the commits since the previous
+	// tag, one per line). If empty, the message is left blank.
+	TagMessageTemplate string `yaml:"tagMessageTemplate"`
+	// Shell commands to offer when running a command in all submodules (via
+	// `git submodule foreach`), e.g. "git pull" or "git status --short"
+	SubmoduleCommandPresets []string `yaml:"submoduleCommandPresets"`
+	// How many hours back the activity summary in the status panel looks for
+	// your commits and reflog entries
+	ActivitySummaryHours int `yaml:"activitySummaryHours" jsonschema:"minimum=1"`
+}
+
+func (c *GitConfig) IsProtectedBranch(branchName string) bool {
+	return lo.SomeBy(c.ProtectedBranches, func(pattern string) bool {
+		matches, _ := path.Match(pattern, branchName)
+		return matches
+	})
 }
 
 type PagerType string
@@ -320,6 +560,22 @@ type PagingConfig struct {
 	UseConfig bool `yaml:"useConfig"`
 	// e.g. 'difft --color=always'
 	ExternalDiffCommand string `yaml:"externalDiffCommand"`
+	// If true, Lazygit assumes that the pager or external diff command renders diffs side by side (e.g. `delta --side-by-side` or difftastic), and renders the diff again whenever the main view is resized so that the columns fit. This is assumed anyway if the pager uses the `{{columnWidth}}` placeholder.
+	SideBySide bool `yaml:"sideBySide"`
+	// Pagers to use instead of `pager` when showing the diff of the selected item of a particular panel, keyed by the panel, e.g. 'files', 'localBranches', 'commits', 'commitFiles' or 'stash'. An empty string means that no pager is used for that panel.
+	PagerOverrides map[string]string `yaml:"pagerOverrides"`
+	// External diff commands to use for files with particular extensions, e.g. an image diff tool for png files or a notebook diff tool for ipynb files. They are used when showing the diff of a single file, or when opening the external diff tool for a single file; all other diffs are shown as usual.
+	FileTypeDiffCommands []FileTypeDiffCommand `yaml:"fileTypeDiffCommands"`
+}
+
+type FileTypeDiffCommand struct {
+	// The file extensions (without the leading dot) that the command is used for, e.g. ['png', 'jpg']. Matching is case-insensitive.
+	Extensions []string `yaml:"extensions"`
+	// The command to run. Unless `subprocess` is true, it is used like `externalDiffCommand` to render the diff in the main view, so git passes it the path of the file followed by the old file, old hash, old mode, new file, new hash and new mode.
+	// If `subprocess` is true, it is used like `git difftool --extcmd`, so it is passed the old and the new file.
+	Command string `yaml:"command" jsonschema:"example=nbdiff --color-words"`
+	// If true, the command is run as a subprocess when opening the external diff tool for a file (e.g. to show an image diff in a GUI app), rather than being used for the main view
+	Subprocess bool `yaml:"subprocess"`
 }
 
 type CommitConfig struct {
@@ -341,6 +597,19 @@ type MergingConfig struct {
 	SquashMergeMessage string `yaml:"squashMergeMessage"`
 }
 
+type SnapshotsConfig struct {
+	// If true, save a snapshot of HEAD and the working tree before hard
+	// resets and discarding changes, and of the branch tip before deleting an
+	// unmerged branch. Snapshots can be restored from the discard menu in the
+	// files panel. This also backs up changes discarded from individual files
+	// or hunks so that the discard can be undone. Off by default because it
+	// takes extra time before each of these operations.
+	Enabled bool `yaml:"enabled"`
+	// Number of snapshots (and, separately, discard backups) to keep; the
+	// oldest ones are deleted when a new one is saved
+	MaxCount int `yaml:"maxCount" jsonschema:"minimum=1"`
+}
+
 type LogConfig struct {
 	// One of: 'date-order' | 'author-date-order' | 'topo-order' | 'default'
 	// 'topo-order' makes it easier to read the git log graph, but commits may not
@@ -380,10 +649,24 @@ type KeybindingConfig struct {
 	Commits        KeybindingCommitsConfig        `yaml:"commits"`
 	AmendAttribute KeybindingAmendAttributeConfig `yaml:"amendAttribute"`
 	Stash          KeybindingStashConfig          `yaml:"stash"`
+	Reflog         KeybindingReflogConfig         `yaml:"reflog"`
 	CommitFiles    KeybindingCommitFilesConfig    `yaml:"commitFiles"`
 	Main           KeybindingMainConfig           `yaml:"main"`
 	Submodules     KeybindingSubmodulesConfig     `yaml:"submodules"`
 	CommitMessage  KeybindingCommitMessageConfig  `yaml:"commitMessage"`
+	// What clicking the mouse in a side panel does, keyed by the panel. Valid panels are: files, worktrees, submodules, localBranches, remotes, remoteBranches, tags, commits, reflogCommits, subCommits, commitFiles, stash.
+	Mouse map[string]MouseGesturesConfig `yaml:"mouse"`
+}
+
+// The values are keys of actions in the panel (like in the rest of the
+// keybinding config), which are run on the item that was clicked.
+type MouseGesturesConfig struct {
+	// Instead of the default action for double-clicking an item (e.g. staging a file, or showing the files of a commit). Use <disabled> to do nothing.
+	DoubleClick string `yaml:"doubleClick" jsonschema:"example=<space>"`
+	// The action for right-clicking an item, instead of the menu of the panel's actions that is shown in the files, localBranches, commits and stash panels. Use <disabled> to do nothing.
+	RightClick string `yaml:"rightClick" jsonschema:"example=d"`
+	// The action for clicking an item with the middle mouse button
+	MiddleClick string `yaml:"middleClick" jsonschema:"example=<c-o>"`
 }
 
 // damn looks like we have some inconsistencies here with -alt and -alt1
@@ -457,17 +740,30 @@ type KeybindingUniversalConfig struct {
 	SubmitEditorText                  string   `yaml:"submitEditorText"`
 	ExtrasMenu                        string   `yaml:"extrasMenu"`
 	ToggleWhitespaceInDiffView        string   `yaml:"toggleWhitespaceInDiffView"`
+	ToggleMovedLinesInDiffView        string   `yaml:"toggleMovedLinesInDiffView"`
+	OpenDiffOptionsMenu               string   `yaml:"openDiffOptionsMenu"`
 	IncreaseContextInDiffView         string   `yaml:"increaseContextInDiffView"`
 	DecreaseContextInDiffView         string   `yaml:"decreaseContextInDiffView"`
 	IncreaseRenameSimilarityThreshold string   `yaml:"increaseRenameSimilarityThreshold"`
 	DecreaseRenameSimilarityThreshold string   `yaml:"decreaseRenameSimilarityThreshold"`
 	OpenDiffTool                      string   `yaml:"openDiffTool"`
+	OpenInTmux                        string   `yaml:"openInTmux"`
+	OpenLayoutMenu                    string   `yaml:"openLayoutMenu"`
+	ToggleZenMode                     string   `yaml:"toggleZenMode"`
+	ToggleKeypressRecording           string   `yaml:"toggleKeypressRecording"`
+	ReplayKeypressRecording           string   `yaml:"replayKeypressRecording"`
+	CycleDateDisplay                  string   `yaml:"cycleDateDisplay"`
 }
 
 type KeybindingStatusConfig struct {
 	CheckForUpdate      string `yaml:"checkForUpdate"`
 	RecentRepos         string `yaml:"recentRepos"`
 	AllBranchesLogGraph string `yaml:"allBranchesLogGraph"`
+	HealthChecks        string `yaml:"healthChecks"`
+	ActivitySummary     string `yaml:"activitySummary"`
+	OpenSettings        string `yaml:"openSettings"`
+	SwitchProfile       string `yaml:"switchProfile"`
+	SwitchTheme         string `yaml:"switchTheme"`
 }
 
 type KeybindingFilesConfig struct {
@@ -486,10 +782,16 @@ type KeybindingFilesConfig struct {
 	Fetch                    string `yaml:"fetch"`
 	ToggleTreeView           string `yaml:"toggleTreeView"`
 	OpenMergeTool            string `yaml:"openMergeTool"`
+	OpenMergeToolForFile     string `yaml:"openMergeToolForFile"`
+	ResolveAllConflicts      string `yaml:"resolveAllConflicts"`
+	ViewRerereOptions        string `yaml:"viewRerereOptions"`
 	OpenStatusFilter         string `yaml:"openStatusFilter"`
 	CopyFileInfoToClipboard  string `yaml:"copyFileInfoToClipboard"`
 	CollapseAll              string `yaml:"collapseAll"`
 	ExpandAll                string `yaml:"expandAll"`
+	IntentToAdd              string `yaml:"intentToAdd"`
+	ToggleExecutable         string `yaml:"toggleExecutable"`
+	SortOrder                string `yaml:"sortOrder"`
 }
 
 type KeybindingBranchesConfig struct {
@@ -507,6 +809,8 @@ type KeybindingBranchesConfig struct {
 	FastForward            string `yaml:"fastForward"`
 	CreateTag              string `yaml:"createTag"`
 	PushTag                string `yaml:"pushTag"`
+	PushUnpushedTags       string `yaml:"pushUnpushedTags"`
+	CreateRelease          string `yaml:"createRelease"`
 	SetUpstream            string `yaml:"setUpstream"`
 	FetchRemote            string `yaml:"fetchRemote"`
 	SortOrder              string `yaml:"sortOrder"`
@@ -538,37 +842,57 @@ type KeybindingCommitsConfig struct {
 	ResetCherryPick                string `yaml:"resetCherryPick"`
 	CopyCommitAttributeToClipboard string `yaml:"copyCommitAttributeToClipboard"`
 	OpenLogMenu                    string `yaml:"openLogMenu"`
+	OpenCommitFilters              string `yaml:"openCommitFilters"`
+	SearchHistoryForCode           string `yaml:"searchHistoryForCode"`
+	GoToCommit                     string `yaml:"goToCommit"`
+	FindContainingRefs             string `yaml:"findContainingRefs"`
+	GenerateReleaseNotes           string `yaml:"generateReleaseNotes"`
+	ToggleCommitMarked             string `yaml:"toggleCommitMarked"`
 	OpenInBrowser                  string `yaml:"openInBrowser"`
 	ViewBisectOptions              string `yaml:"viewBisectOptions"`
 	StartInteractiveRebase         string `yaml:"startInteractiveRebase"`
 	SelectCommitsOfCurrentBranch   string `yaml:"selectCommitsOfCurrentBranch"`
+	ToggleBreak                    string `yaml:"toggleBreak"`
 }
 
 type KeybindingAmendAttributeConfig struct {
-	ResetAuthor string `yaml:"resetAuthor"`
-	SetAuthor   string `yaml:"setAuthor"`
-	AddCoAuthor string `yaml:"addCoAuthor"`
+	ResetAuthor     string `yaml:"resetAuthor"`
+	SetAuthor       string `yaml:"setAuthor"`
+	AddCoAuthor     string `yaml:"addCoAuthor"`
+	SelectAuthor    string `yaml:"selectAuthor"`
+	BulkSetAuthor   string `yaml:"bulkSetAuthor"`
+	ResetAuthorDate string `yaml:"resetAuthorDate"`
 }
 
 type KeybindingStashConfig struct {
-	PopStash    string `yaml:"popStash"`
-	RenameStash string `yaml:"renameStash"`
+	PopStash      string `yaml:"popStash"`
+	RenameStash   string `yaml:"renameStash"`
+	ApplyToBranch string `yaml:"applyToBranch"`
+}
+
+type KeybindingReflogConfig struct {
+	SelectRef      string `yaml:"selectRef"`
+	ViewDiffTarget string `yaml:"viewDiffTarget"`
 }
 
 type KeybindingCommitFilesConfig struct {
-	CheckoutCommitFile string `yaml:"checkoutCommitFile"`
+	CheckoutCommitFile  string `yaml:"checkoutCommitFile"`
+	ApplyFilesFromStash string `yaml:"applyFilesFromStash"`
 }
 
 type KeybindingMainConfig struct {
 	ToggleSelectHunk string `yaml:"toggleSelectHunk"`
 	PickBothHunks    string `yaml:"pickBothHunks"`
 	EditSelectHunk   string `yaml:"editSelectHunk"`
+	GoToLine         string `yaml:"goToLine"`
 }
 
 type KeybindingSubmodulesConfig struct {
-	Init     string `yaml:"init"`
-	Update   string `yaml:"update"`
-	BulkMenu string `yaml:"bulkMenu"`
+	Init         string `yaml:"init"`
+	Update       string `yaml:"update"`
+	UpdateRemote string `yaml:"updateRemote"`
+	SetBranch    string `yaml:"setBranch"`
+	BulkMenu     string `yaml:"bulkMenu"`
 }
 
 type KeybindingCommitMessageConfig struct {
@@ -607,6 +931,31 @@ type OSConfig struct {
 	// Command for opening a link. Should contain "{{link}}".
 	OpenLink string `yaml:"openLink,omitempty"`
 
+	// Commands for opening files and links on particular machines, overriding
+	// `open` and `openLink` there. Keys are 'ssh' (when running in an SSH
+	// session), 'wsl', 'linux', 'darwin' and 'windows'; the first of these
+	// that applies to the current machine and sets the command is used. This
+	// lets you share one config file between your local machine, WSL and
+	// remote machines.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#platform-specific-open-commands
+	OpenByPlatform map[string]OpenCommandsConfig `yaml:"openByPlatform,omitempty"`
+
+	// Command for showing a desktop notification. Should contain "{{title}}"
+	// and "{{message}}". If empty, notify-send is used on Linux, osascript on
+	// macOS and a PowerShell toast notification on Windows and WSL.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#desktop-notifications
+	Notify string `yaml:"notify,omitempty" jsonschema:"example=terminal-notifier -title {{title}} -message {{message}}"`
+
+	// Command for opening a directory in a new terminal tab or window, e.g. to
+	// start lazygit in a newly created worktree. Should contain "{{dir}}".
+	OpenDirInNewWindow string `yaml:"openDirInNewWindow,omitempty" jsonschema:"example=wezterm cli spawn --cwd {{dir}} lazygit"`
+
+	// Command for opening a worktree, submodule or recent repo in tmux. Should
+	// contain "{{dir}}", and may contain "{{name}}" for the directory's name.
+	// If empty, a new tmux window running lazygit is opened, which only works
+	// when lazygit itself runs inside tmux.
+	OpenInTmux string `yaml:"openInTmux,omitempty" jsonschema:"example=tmux split-window -h -c {{dir}}"`
+
 	// CopyToClipboardCmd is the command for copying to clipboard.
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-command-for-copying-to-and-pasting-from-clipboard
 	CopyToClipboardCmd string `yaml:"copyToClipboardCmd,omitempty"`
@@ -615,15 +964,86 @@ type OSConfig struct {
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-command-for-copying-to-and-pasting-from-clipboard
 	ReadFromClipboardCmd string `yaml:"readFromClipboardCmd,omitempty"`
 
+	// How to copy to the clipboard when copyToClipboardCmd is not set. One of
+	// 'auto' | 'system' | 'osc52'.
+	// 'system' uses the system clipboard (via pbcopy, xclip, wl-copy etc.);
+	// 'osc52' asks the terminal to set the clipboard using the OSC 52 escape
+	// sequence, which also works in SSH sessions and tmux (with
+	// `set -g allow-passthrough on`). 'auto' uses OSC 52 in SSH sessions and
+	// when no system clipboard is available, and the system clipboard
+	// otherwise. Reading the clipboard always uses the system clipboard.
+	ClipboardBackend string `yaml:"clipboardBackend" jsonschema:"enum=auto,enum=system,enum=osc52"`
+
 	// A shell startup file containing shell aliases or shell functions. This will be sourced before running any shell commands, so that shell functions are available in the `:` command prompt or even in custom commands.
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#using-aliases-or-functions-in-shell-commands
 	ShellFunctionsFile string `yaml:"shellFunctionsFile"`
 }
 
+type OpenCommandsConfig struct {
+	// Command for opening a file. Should contain "{{filename}}".
+	Open string `yaml:"open,omitempty"`
+	// Command for opening a link. Should contain "{{link}}".
+	OpenLink string `yaml:"openLink,omitempty"`
+}
+
 type CustomCommandAfterHook struct {
 	CheckForConflicts bool `yaml:"checkForConflicts"`
 }
 
+type CopyTemplatesConfig struct {
+	// Available fields: Hash, ShortHash, Subject, Author, AuthorEmail, AuthorDate, Tags
+	Commits []CopyTemplate `yaml:"commits"`
+	// Available fields: Name, Upstream, Hash, ShortHash, Subject
+	Branches []CopyTemplate `yaml:"branches"`
+	// Available fields: Name, Path, AbsolutePath
+	Files []CopyTemplate `yaml:"files"`
+}
+
+type RemoteServiceConfig struct {
+	// One of 'github', 'bitbucket', 'bitbucketServer', 'azuredevops', 'gitlab', 'gitea' or 'forgejo'.
+	// If empty, the service is derived from the remote URL as usual.
+	Provider string `yaml:"provider" jsonschema:"enum=,enum=github,enum=bitbucket,enum=bitbucketServer,enum=azuredevops,enum=gitlab,enum=gitea,enum=forgejo"`
+	// The domain (and optionally port and path) of the service's web interface and API, e.g. 'git.example.com'. Required if provider is set.
+	WebDomain string `yaml:"webDomain"`
+	// The name of an environment variable holding the API token for this remote.
+	// If it isn't set, the service's usual variables (e.g. GITEA_TOKEN) are checked.
+	TokenEnvVar string `yaml:"tokenEnvVar"`
+}
+
+type IssuesConfig struct {
+	// Template for the suggested name of the branch created when starting work on an issue, using Go template syntax.
+	// Available fields: {{.Number}}, {{.Title}}, {{.Author}}
+	// The title is lowercased, and everything except letters and digits is replaced with dashes.
+	BranchNameTemplate string `yaml:"branchNameTemplate"`
+	// If true, starting work on an issue adds you to its assignees.
+	// On GitLab this replaces the existing assignees.
+	AssignOnStartWork bool `yaml:"assignOnStartWork"`
+	// If not empty, starting work on an issue adds this label to it, e.g. 'in progress'
+	LabelOnStartWork string `yaml:"labelOnStartWork"`
+}
+
+type StatusBarSegment struct {
+	// The text of the segment, using Go template syntax.
+	// Available fields: {{.Branch}}, {{.Upstream}}, {{.Ahead}}, {{.Behind}}, {{.Operation}}, {{.FilterPath}}, {{.FilterAuthor}}, {{.Time}}, {{.Version}}, {{.Output}}
+	// {{.Ahead}} and {{.Behind}} are the number of commits to push and pull, and are empty if there are none.
+	// {{.Time}} is the current time, which can be formatted like {{.Time.Format "15:04"}}.
+	// If empty, the output of the command is shown.
+	Template string `yaml:"template" jsonschema:"example={{.Branch}} ↑{{.Ahead}} ↓{{.Behind}}"`
+	// A shell command that is run in the repo every refresher.refreshInterval seconds; its output is available as {{.Output}}
+	Command string `yaml:"command"`
+	// The color of the segment, using the same format as the theme colors
+	Color []string `yaml:"color"`
+}
+
+type CopyTemplate struct {
+	// The label of the entry in the copy menu
+	Description string `yaml:"description"`
+	// The text to copy (using Go template syntax for the item's fields)
+	Template string `yaml:"template" jsonschema:"example={{.ShortHash}} ({{.Subject}}, {{.AuthorDate}})"`
+	// The key to select the entry in the copy menu
+	Key string `yaml:"key"`
+}
+
 type CustomCommand struct {
 	// The key to trigger the command. Use a single letter or one of the values from https://github.com/jesseduffield/lazygit/blob/master/docs/keybindings/Custom_Keybindings.md
 	Key string `yaml:"key"`
@@ -634,6 +1054,9 @@ type CustomCommand struct {
 	Context string `yaml:"context" jsonschema:"example=status,example=files,example=worktrees,example=localBranches,example=remotes,example=remoteBranches,example=tags,example=commits,example=reflogCommits,example=subCommits,example=commitFiles,example=stash,example=global"`
 	// The command to run (using Go template syntax for placeholder values)
 	Command string `yaml:"command" jsonschema:"example=git fetch {{.Form.Remote}} {{.Form.Branch}} && git checkout FETCH_HEAD"`
+	// Instead of running a command, run a sequence of built-in actions, given by their keys, as if they had been pressed one after the other. Each key is looked up in the view that has focus at that time. The macro stops at the first action that fails or is disabled.
+	// When using this, only Key, Context and Description can be set.
+	Macro []string `yaml:"macro" jsonschema:"example=a,example=c"`
 	// A list of prompts that will request user input before running the final command
 	Prompts []CustomCommandPrompt `yaml:"prompts"`
 	// Text to display while waiting for command to finish
@@ -668,6 +1091,15 @@ type CustomCommandPrompt struct {
 	// The initial value to appear in the text box.
 	// Only for input prompts.
 	InitialValue string `yaml:"initialValue"`
+	// A command whose output (without leading and trailing whitespace) becomes the initial value. Mutually exclusive with 'initialValue'.
+	// Only for input prompts.
+	InitialValueCommand string `yaml:"initialValueCommand" jsonschema:"example=git config user.email"`
+	// A regex that the entered value must match. If it doesn't, the prompt is shown again.
+	// Only for input prompts.
+	ValidationRegex string `yaml:"validationRegex" jsonschema:"example=^[A-Z]+-[0-9]+$"`
+	// The message to show when the entered value doesn't match 'validationRegex'.
+	// Only for input prompts.
+	ValidationMessage string `yaml:"validationMessage" jsonschema:"example=Enter a ticket number like ABC-123"`
 	// Shows suggestions as the input is entered
 	// Only for input prompts.
 	Suggestions CustomCommandSuggestions `yaml:"suggestions"`
@@ -692,6 +1124,16 @@ type CustomCommandPrompt struct {
 	// Like valueFormat but for the labels. If `labelFormat` is not specified, `valueFormat` is shown instead.
 	// Only for menuFromCommand prompts.
 	LabelFormat string `yaml:"labelFormat" jsonschema:"example={{ .branch | green }}"`
+
+	// Only show the prompt if the answer to an earlier prompt matches a regex. The key of a prompt that isn't shown is set to an empty string.
+	Condition *CustomCommandPromptCondition `yaml:"condition"`
+}
+
+type CustomCommandPromptCondition struct {
+	// The key of the earlier prompt
+	Key string `yaml:"key"`
+	// The regex that its answer must match
+	Matches string `yaml:"matches" jsonschema:"example=^feature$"`
 }
 
 type CustomCommandSuggestions struct {
@@ -715,6 +1157,32 @@ type CustomIconsConfig struct {
 	Filenames map[string]IconProperties `yaml:"filenames"`
 	// Map of file extensions (including the dot) to icon properties (icon and color)
 	Extensions map[string]IconProperties `yaml:"extensions"`
+	// Icons for the status of branches compared to their upstream
+	BranchStatus BranchStatusIconsConfig `yaml:"branchStatus"`
+	// Icons in the commits view
+	Commits CommitIconsConfig `yaml:"commits"`
+}
+
+// Empty values mean that the icon set's icon is used
+type BranchStatusIconsConfig struct {
+	// Shown when the branch is in sync with its upstream (default: ✓)
+	UpToDate string `yaml:"upToDate"`
+	// Shown in front of the number of commits to push (default: ↑)
+	Ahead string `yaml:"ahead"`
+	// Shown in front of the number of commits to pull (default: ↓)
+	Behind string `yaml:"behind"`
+	// Shown when the upstream branch hasn't been fetched yet (default: ?)
+	UpstreamNotFetched string `yaml:"upstreamNotFetched"`
+}
+
+// Empty values mean that the icon set's icon is used
+type CommitIconsConfig struct {
+	// Shown in front of commits. Only relevant if an icon set is used.
+	Commit string `yaml:"commit"`
+	// Shown in front of merge commits. Only relevant if an icon set is used.
+	MergeCommit string `yaml:"mergeCommit"`
+	// Marks commits that are the head of a branch (default: * without an icon set)
+	BranchHead string `yaml:"branchHead"`
 }
 
 type IconProperties struct {
@@ -725,26 +1193,37 @@ type IconProperties struct {
 func GetDefaultConfig() *UserConfig {
 	return &UserConfig{
 		Gui: GuiConfig{
-			ScrollHeight:             2,
-			ScrollPastBottom:         true,
-			ScrollOffMargin:          2,
-			ScrollOffBehavior:        "margin",
-			TabWidth:                 4,
-			MouseEvents:              true,
-			SkipAmendWarning:         false,
-			SkipDiscardChangeWarning: false,
-			SkipStashWarning:         false,
-			SidePanelWidth:           0.3333,
-			ExpandFocusedSidePanel:   false,
-			ExpandedSidePanelWeight:  2,
-			MainPanelSplitMode:       "flexible",
-			EnlargedSideViewLocation: "left",
-			WrapLinesInStagingView:   true,
-			UseHunkModeInStagingView: true,
-			Language:                 "auto",
-			TimeFormat:               "02 Jan 06",
-			ShortTimeFormat:          time.Kitchen,
+			ScrollHeight:                 2,
+			ScrollPastBottom:             true,
+			ShowScrollbars:               true,
+			ShowDiffMinimap:              false,
+			ScrollOffMargin:              2,
+			ScrollOffBehavior:            "margin",
+			TabWidth:                     4,
+			MouseEvents:                  true,
+			FocusFollowsMouse:            false,
+			ClickThrough:                 true,
+			CountPrefixes:                false,
+			SkipAmendWarning:             false,
+			SkipDiscardChangeWarning:     false,
+			SkipStashWarning:             false,
+			SidePanelWidth:               0.3333,
+			ExpandFocusedSidePanel:       false,
+			ExpandedSidePanelWeight:      2,
+			MainPanelSplitMode:           "flexible",
+			EnlargedSideViewLocation:     "left",
+			WrapLinesInStagingView:       true,
+			UseHunkModeInStagingView:     true,
+			HighlightWordDiff:            false,
+			ShowLineNumbersInStagingView: false,
+			ShowBinaryFileInfo:           true,
+			Language:                     "auto",
+			TimeFormat:                   "02 Jan 06",
+			ShortTimeFormat:              time.Kitchen,
+			DateDisplay:                  "default",
+			CustomTimeFormat:             "2006-01-02 15:04",
 			Theme: ThemeConfig{
+				Preset:                          "default",
 				ActiveBorderColor:               []string{"green", "bold"},
 				SearchingActiveBorderColor:      []string{"cyan", "bold"},
 				InactiveBorderColor:             []string{"default"},
@@ -757,48 +1236,70 @@ func GetDefaultConfig() *UserConfig {
 				MarkedBaseCommitFgColor:         []string{"blue"},
 				UnstagedChangesColor:            []string{"red"},
 				DefaultFgColor:                  []string{"default"},
+				AddedLinesColor:                 []string{"green"},
+				RemovedLinesColor:               []string{"red"},
+				ConflictMarkerColor:             []string{"red"},
+				GraphColors:                     []string{},
 			},
 			CommitLength:                 CommitLengthConfig{Show: true},
 			SkipNoStagedFilesWarning:     false,
 			ShowListFooter:               true,
 			ShowCommandLog:               true,
 			ShowBottomLine:               true,
+			StatusBarSegments:            []StatusBarSegment{},
+			StatusBarSeparator:           " | ",
 			ShowPanelJumps:               true,
 			ShowFileTree:                 true,
 			ShowRootItemInFileTree:       true,
 			ShowNumstatInFilesView:       false,
+			ShowNumstatInCommitFilesView: false,
+			ShowFilesWithHiddenChanges:   false,
 			ShowRandomTip:                true,
 			ShowIcons:                    false,
 			NerdFontsVersion:             "",
+			IconSet:                      "",
 			ShowFileIcons:                true,
 			CommitAuthorShortLength:      2,
 			CommitAuthorLongLength:       17,
+			CommitAuthorFormat:           "full",
 			CommitHashLength:             8,
+			ShowCommitStats:              false,
+			ShowCommitDetailsHeader:      false,
 			ShowBranchCommitHash:         false,
 			ShowDivergenceFromBaseBranch: "none",
 			CommandLogSize:               8,
-			SplitDiff:                    "auto",
-			SkipRewordInEditorWarning:    false,
-			ScreenMode:                   "normal",
-			Border:                       "rounded",
-			AnimateExplosion:             true,
-			PortraitMode:                 "auto",
-			FilterMode:                   "substring",
+			CommandLogFile: CommandLogFileConfig{
+				Enabled:    false,
+				MaxSize:    1024,
+				MaxBackups: 3,
+			},
+			SplitDiff:                 "auto",
+			SkipRewordInEditorWarning: false,
+			ScreenMode:                "normal",
+			Border:                    "rounded",
+			AnimateExplosion:          true,
+			PortraitMode:              "auto",
+			FilterMode:                "substring",
 			Spinner: SpinnerConfig{
 				Frames: []string{"|", "/", "-", "\\"},
 				Rate:   50,
 			},
 			StatusPanelView:              "dashboard",
+			SidePanels:                   []string{"status", "files", "branches", "commits", "stash"},
+			SidePanelPosition:            "left",
 			SwitchToFilesAfterStashPop:   true,
 			SwitchToFilesAfterStashApply: true,
 			SwitchTabsWithPanelJumpKeys:  false,
 		},
 		Git: GitConfig{
 			Paging: PagingConfig{
-				ColorArg:            "always",
-				Pager:               "",
-				UseConfig:           false,
-				ExternalDiffCommand: "",
+				ColorArg:             "always",
+				Pager:                "",
+				UseConfig:            false,
+				ExternalDiffCommand:  "",
+				SideBySide:           false,
+				PagerOverrides:       map[string]string{},
+				FileTypeDiffCommands: []FileTypeDiffCommand{},
 			},
 			Commit: CommitConfig{
 				SignOff:               false,
@@ -815,42 +1316,79 @@ func GetDefaultConfig() *UserConfig {
 				ShowGraph:      "always",
 				ShowWholeGraph: false,
 			},
-			LocalBranchSortOrder:         "date",
-			RemoteBranchSortOrder:        "date",
-			SkipHookPrefix:               "WIP",
-			MainBranches:                 []string{"master", "main"},
-			AutoFetch:                    true,
-			AutoRefresh:                  true,
-			AutoForwardBranches:          "onlyMainBranches",
-			FetchAll:                     true,
-			AutoStageResolvedConflicts:   true,
-			BranchLogCmd:                 "git log --graph --color=always --abbrev-commit --decorate --date=relative --pretty=medium {{branchName}} --",
-			AllBranchesLogCmds:           []string{"git log --graph --all --color=always --abbrev-commit --decorate --date=relative  --pretty=medium"},
-			IgnoreWhitespaceInDiffView:   false,
-			DiffContextSize:              3,
-			RenameSimilarityThreshold:    50,
-			DisableForcePushing:          false,
+			LocalBranchSortOrder:       "date",
+			RemoteBranchSortOrder:      "date",
+			SkipHookPrefix:             "WIP",
+			MainBranches:               []string{"master", "main"},
+			AutoFetch:                  true,
+			AutoRefresh:                true,
+			AutoForwardBranches:        "onlyMainBranches",
+			FetchAll:                   true,
+			AutoStageResolvedConflicts: true,
+			BranchLogCmd:               "git log --graph --color=always --abbrev-commit --decorate --date=relative --pretty=medium {{branchName}} --",
+			AllBranchesLogCmds:         []string{"git log --graph --all --color=always --abbrev-commit --decorate --date=relative  --pretty=medium"},
+			IgnoreWhitespaceInDiffView: false,
+			IgnoreBlankLinesInDiffView: false,
+			DiffAlgorithm:              "",
+			ColorMoved:                 "auto",
+			DiffContextSize:            3,
+			RenameSimilarityThreshold:  50,
+			DetectUnstagedRenames:      false,
+			DisableForcePushing:        false,
+			ProtectedBranches:          []string{},
+			Snapshots: SnapshotsConfig{
+				Enabled:  false,
+				MaxCount: 20,
+			},
 			CommitPrefixes:               map[string][]CommitPrefixConfig(nil),
 			BranchPrefix:                 "",
 			ParseEmoji:                   false,
 			TruncateCopiedCommitHashesTo: 12,
+			ReviewWorktreePath:           "../{{repoName}}-review-{{branchName}}",
+			SuggestTagVersionBumps:       false,
+			TagMessageTemplate:           "",
+			SubmoduleCommandPresets:      []string{},
+			ActivitySummaryHours:         24,
 		},
 		Refresher: RefresherConfig{
-			RefreshInterval: 10,
-			FetchInterval:   60,
+			RefreshInterval:  10,
+			FetchInterval:    60,
+			AutoReloadConfig: true,
+		},
+		Notifications: NotificationsConfig{
+			Enabled: false,
+			Push:    true,
+			Pull:    true,
+			Fetch:   true,
+			Rebase:  true,
+		},
+		Accessibility: AccessibilityConfig{
+			Enabled: false,
+			Output:  "auto",
 		},
 		Update: UpdateConfig{
 			Method: "prompt",
 			Days:   14,
 		},
-		ConfirmOnQuit:                false,
-		QuitOnTopLevelReturn:         false,
-		OS:                           OSConfig{},
+		ConfirmOnQuit:        false,
+		QuitOnTopLevelReturn: false,
+		OS: OSConfig{
+			ClipboardBackend: "auto",
+		},
 		DisableStartupPopups:         false,
 		CustomCommands:               []CustomCommand(nil),
 		Services:                     map[string]string(nil),
+		RemoteServices:               map[string]RemoteServiceConfig(nil),
+		HostingServiceRemote:         "origin",
+		HostingServiceTokenSources:   []string{"cli"},
 		NotARepository:               "prompt",
 		PromptToReturnFromSubprocess: true,
+		Profiles:                     map[string]map[string]any(nil),
+		Issues: IssuesConfig{
+			BranchNameTemplate: "{{.Number}}-{{.Title}}",
+			AssignOnStartWork:  false,
+			LabelOnStartWork:   "",
+		},
 		Keybinding: KeybindingConfig{
 			Universal: KeybindingUniversalConfig{
 				Quit:                              "q",
@@ -922,16 +1460,29 @@ func GetDefaultConfig() *UserConfig {
 				SubmitEditorText:                  "<enter>",
 				ExtrasMenu:                        "@",
 				ToggleWhitespaceInDiffView:        "<c-w>",
+				ToggleMovedLinesInDiffView:        "|",
+				OpenDiffOptionsMenu:               "^",
 				IncreaseContextInDiffView:         "}",
 				DecreaseContextInDiffView:         "{",
 				IncreaseRenameSimilarityThreshold: ")",
 				DecreaseRenameSimilarityThreshold: "(",
 				OpenDiffTool:                      "<c-t>",
+				OpenInTmux:                        "M",
+				OpenLayoutMenu:                    "<c-v>",
+				ToggleZenMode:                     "~",
+				ToggleKeypressRecording:           "<c-q>",
+				ReplayKeypressRecording:           "&",
+				CycleDateDisplay:                  "%",
 			},
 			Status: KeybindingStatusConfig{
 				CheckForUpdate:      "u",
 				RecentRepos:         "<enter>",
 				AllBranchesLogGraph: "a",
+				HealthChecks:        "D",
+				ActivitySummary:     "t",
+				OpenSettings:        "s",
+				SwitchProfile:       "c",
+				SwitchTheme:         "T",
 			},
 			Files: KeybindingFilesConfig{
 				CommitChanges:            "c",
@@ -948,11 +1499,17 @@ func GetDefaultConfig() *UserConfig {
 				Fetch:                    "f",
 				ToggleTreeView:           "`",
 				OpenMergeTool:            "M",
+				OpenMergeToolForFile:     "<c-g>",
+				ResolveAllConflicts:      "<c-a>",
+				ViewRerereOptions:        "E",
 				OpenStatusFilter:         "<c-b>",
 				ConfirmDiscard:           "x",
 				CopyFileInfoToClipboard:  "y",
 				CollapseAll:              "-",
 				ExpandAll:                "=",
+				IntentToAdd:              "N",
+				ToggleExecutable:         "<c-x>",
+				SortOrder:                "O",
 			},
 			Branches: KeybindingBranchesConfig{
 				CopyPullRequestURL:     "<c-y>",
@@ -969,6 +1526,8 @@ func GetDefaultConfig() *UserConfig {
 				FastForward:            "f",
 				CreateTag:              "T",
 				PushTag:                "P",
+				PushUnpushedTags:       "U",
+				CreateRelease:          "O",
 				SetUpstream:            "u",
 				FetchRemote:            "f",
 				SortOrder:              "s",
@@ -998,32 +1557,51 @@ func GetDefaultConfig() *UserConfig {
 				ResetCherryPick:                "<c-R>",
 				CopyCommitAttributeToClipboard: "y",
 				OpenLogMenu:                    "<c-l>",
+				OpenCommitFilters:              "<c-f>",
+				SearchHistoryForCode:           "<c-g>",
+				GoToCommit:                     "G",
+				FindContainingRefs:             "<c-b>",
+				GenerateReleaseNotes:           "<c-n>",
+				ToggleCommitMarked:             "<c-space>",
 				OpenInBrowser:                  "o",
 				ViewBisectOptions:              "b",
 				StartInteractiveRebase:         "i",
 				SelectCommitsOfCurrentBranch:   "*",
+				ToggleBreak:                    "I",
 			},
 			AmendAttribute: KeybindingAmendAttributeConfig{
-				ResetAuthor: "a",
-				SetAuthor:   "A",
-				AddCoAuthor: "c",
+				ResetAuthor:     "a",
+				SetAuthor:       "A",
+				AddCoAuthor:     "c",
+				SelectAuthor:    "s",
+				BulkSetAuthor:   "B",
+				ResetAuthorDate: "d",
 			},
 			Stash: KeybindingStashConfig{
-				PopStash:    "g",
-				RenameStash: "r",
+				PopStash:      "g",
+				RenameStash:   "r",
+				ApplyToBranch: "b",
+			},
+			Reflog: KeybindingReflogConfig{
+				SelectRef:      "r",
+				ViewDiffTarget: "d",
 			},
 			CommitFiles: KeybindingCommitFilesConfig{
-				CheckoutCommitFile: "c",
+				CheckoutCommitFile:  "c",
+				ApplyFilesFromStash: "A",
 			},
 			Main: KeybindingMainConfig{
 				ToggleSelectHunk: "a",
 				PickBothHunks:    "b",
 				EditSelectHunk:   "E",
+				GoToLine:         "#",
 			},
 			Submodules: KeybindingSubmodulesConfig{
-				Init:     "i",
-				Update:   "u",
-				BulkMenu: "b",
+				Init:         "i",
+				Update:       "u",
+				UpdateRemote: "U",
+				SetBranch:    "t",
+				BulkMenu:     "b",
 			},
 			CommitMessage: KeybindingCommitMessageConfig{
 				CommitMenu: "<c-o>",
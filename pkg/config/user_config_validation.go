@@ -1,13 +1,18 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"path"
 	"reflect"
+	"regexp"
 	"slices"
 	"strings"
+	"text/template"
 
 	"github.com/jesseduffield/lazygit/pkg/constants"
+	"github.com/samber/lo"
 )
 
 func (config *UserConfig) Validate() error {
@@ -16,13 +21,31 @@ func (config *UserConfig) Validate() error {
 		return err
 	}
 	if err := validateEnum("gui.showDivergenceFromBaseBranch", config.Gui.ShowDivergenceFromBaseBranch,
-		[]string{"none", "onlyArrow", "arrowAndNumber"}); err != nil {
+		[]string{"none", "onlyArrow", "arrowAndNumber", "aheadAndBehind"}); err != nil {
 		return err
 	}
 	if err := validateEnum("git.autoForwardBranches", config.Git.AutoForwardBranches,
 		[]string{"none", "onlyMainBranches", "allBranches"}); err != nil {
 		return err
 	}
+	if err := validateEnum("git.colorMoved", config.Git.ColorMoved,
+		[]string{"auto", "always", "never"}); err != nil {
+		return err
+	}
+	if err := validateEnum("os.clipboardBackend", config.OS.ClipboardBackend,
+		[]string{"auto", "system", "osc52"}); err != nil {
+		return err
+	}
+	if err := validateAccessibility(config.Accessibility); err != nil {
+		return err
+	}
+	if err := validateOpenByPlatform(config.OS.OpenByPlatform); err != nil {
+		return err
+	}
+	if err := validateEnum("git.diffAlgorithm", config.Git.DiffAlgorithm,
+		[]string{"", "myers", "minimal", "patience", "histogram"}); err != nil {
+		return err
+	}
 	if err := validateEnum("git.localBranchSortOrder", config.Git.LocalBranchSortOrder,
 		[]string{"date", "recency", "alphabetical"}); err != nil {
 		return err
@@ -39,12 +62,65 @@ func (config *UserConfig) Validate() error {
 		[]string{"always", "never", "when-maximised"}); err != nil {
 		return err
 	}
+	if err := validateEnum("gui.commitAuthorFormat", config.Gui.CommitAuthorFormat,
+		[]string{"full", "shortened", "initials", "email", "avatar"}); err != nil {
+		return err
+	}
+	if err := validateEnum("gui.dateDisplay", config.Gui.DateDisplay,
+		[]string{"default", "relative", "absolute", "custom"}); err != nil {
+		return err
+	}
+	if err := validateEnum("gui.iconSet", config.Gui.IconSet,
+		[]string{"", "nerdFontsV3", "nerdFontsV2", "emoji", "ascii", "none", "auto"}); err != nil {
+		return err
+	}
+	if err := validateEnum("gui.theme.preset", config.Gui.Theme.Preset,
+		[]string{"default", "highContrast", "colorblind"}); err != nil {
+		return err
+	}
+	if err := validateEnum("gui.sidePanelPosition", config.Gui.SidePanelPosition,
+		[]string{"left", "right"}); err != nil {
+		return err
+	}
+	if err := ValidateSidePanels(config.Gui.SidePanels); err != nil {
+		return err
+	}
+	if err := validateTemplate("gui.branchLineTemplate", config.Gui.BranchLineTemplate); err != nil {
+		return err
+	}
+	if err := validateTemplate("gui.commitLineTemplate", config.Gui.CommitLineTemplate); err != nil {
+		return err
+	}
 	if err := validateKeybindings(config.Keybinding); err != nil {
 		return err
 	}
 	if err := validateCustomCommands(config.CustomCommands); err != nil {
 		return err
 	}
+	if err := validateStatusBarSegments(config.Gui.StatusBarSegments); err != nil {
+		return err
+	}
+	if err := validateCopyTemplates(config.CopyTemplates); err != nil {
+		return err
+	}
+	if err := validateFileTypeDiffCommands(config.Git.Paging.FileTypeDiffCommands); err != nil {
+		return err
+	}
+	if err := validateRemoteServices(config.RemoteServices); err != nil {
+		return err
+	}
+	for _, tokenSource := range config.HostingServiceTokenSources {
+		if err := validateEnum("hostingServiceTokenSources", tokenSource,
+			[]string{"cli", "gitCredential"}); err != nil {
+			return err
+		}
+	}
+	if err := validateTemplate("issues.branchNameTemplate", config.Issues.BranchNameTemplate); err != nil {
+		return err
+	}
+	if err := validateProtectedBranches(config.Git.ProtectedBranches); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -56,6 +132,51 @@ func validateEnum(name string, value string, allowedValues []string) error {
 	return fmt.Errorf("Unexpected value '%s' for '%s'. Allowed values: %s", value, name, allowedValuesStr)
 }
 
+// The side panels that can be arranged with the gui.sidePanels config, in
+// their default order
+var SidePanelNames = []string{"status", "files", "branches", "commits", "stash"}
+
+// The panels that the keybinding.mouse config can have gestures for, by the
+// keys of their contexts
+var MouseGesturePanels = []string{
+	"files", "worktrees", "submodules", "localBranches", "remotes", "remoteBranches", "tags",
+	"commits", "reflogCommits", "subCommits", "commitFiles", "stash",
+}
+
+// SidePanelSlots splits the gui.sidePanels config into the slots of the side
+// section, each containing the names of the panels that share it.
+func SidePanelSlots(sidePanels []string) [][]string {
+	return lo.FilterMap(sidePanels, func(entry string, _ int) ([]string, bool) {
+		names := lo.Compact(lo.Map(strings.Split(entry, ","), func(name string, _ int) string {
+			return strings.TrimSpace(name)
+		}))
+		return names, len(names) > 0
+	})
+}
+
+func ValidateSidePanels(sidePanels []string) error {
+	names := lo.Flatten(SidePanelSlots(sidePanels))
+	if len(names) == 0 {
+		return errors.New("'gui.sidePanels' must contain at least one panel")
+	}
+	for _, name := range names {
+		if err := validateEnum("gui.sidePanels", name, SidePanelNames); err != nil {
+			return err
+		}
+	}
+	if duplicates := lo.FindDuplicates(names); len(duplicates) > 0 {
+		return fmt.Errorf("Panel '%s' appears more than once in 'gui.sidePanels'", duplicates[0])
+	}
+	return nil
+}
+
+func validateTemplate(name string, value string) error {
+	if _, err := template.New(name).Parse(value); err != nil {
+		return fmt.Errorf("Invalid template for '%s': %v", name, err)
+	}
+	return nil
+}
+
 func validateKeybindingsRecurse(path string, node any) error {
 	value := reflect.ValueOf(node)
 	if value.Kind() == reflect.Struct {
@@ -78,6 +199,13 @@ func validateKeybindingsRecurse(path string, node any) error {
 				return err
 			}
 		}
+	} else if value.Kind() == reflect.Map {
+		for _, mapKey := range value.MapKeys() {
+			if err := validateKeybindingsRecurse(
+				fmt.Sprintf("%s[%v]", path, mapKey), value.MapIndex(mapKey).Interface()); err != nil {
+				return err
+			}
+		}
 	} else if value.Kind() == reflect.String {
 		key := node.(string)
 		if !isValidKeybindingKey(key) {
@@ -95,6 +223,12 @@ func validateKeybindings(keybindingConfig KeybindingConfig) error {
 		return err
 	}
 
+	for panel := range keybindingConfig.Mouse {
+		if err := validateEnum("keybinding.mouse", panel, MouseGesturePanels); err != nil {
+			return err
+		}
+	}
+
 	if len(keybindingConfig.Universal.JumpToBlock) != 5 {
 		return fmt.Errorf("keybinding.universal.jumpToBlock must have 5 elements; found %d.",
 			len(keybindingConfig.Universal.JumpToBlock))
@@ -103,6 +237,17 @@ func validateKeybindings(keybindingConfig KeybindingConfig) error {
 	return nil
 }
 
+func validateAccessibility(accessibilityConfig AccessibilityConfig) error {
+	if err := validateEnum("accessibility.output", accessibilityConfig.Output,
+		[]string{"auto", "speechDispatcher", "say", "lines"}); err != nil {
+		return err
+	}
+	if accessibilityConfig.Output == "lines" && accessibilityConfig.LinesFile == "" {
+		return errors.New("'accessibility.linesFile' must be set when 'accessibility.output' is 'lines'")
+	}
+	return nil
+}
+
 func validateCustomCommandKey(key string) error {
 	if !isValidKeybindingKey(key) {
 		return fmt.Errorf("Unrecognized key '%s' for custom command. For permitted values see %s",
@@ -111,6 +256,79 @@ func validateCustomCommandKey(key string) error {
 	return nil
 }
 
+func validateStatusBarSegments(segments []StatusBarSegment) error {
+	for i, segment := range segments {
+		path := fmt.Sprintf("gui.statusBarSegments[%d]", i)
+		if segment.Template == "" && segment.Command == "" {
+			return fmt.Errorf("Either 'template' or 'command' must be set for '%s'", path)
+		}
+		if err := validateTemplate(path, segment.Template); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateFileTypeDiffCommands(commands []FileTypeDiffCommand) error {
+	for i, command := range commands {
+		path := fmt.Sprintf("git.paging.fileTypeDiffCommands[%d]", i)
+		if len(command.Extensions) == 0 {
+			return fmt.Errorf("'extensions' must be set for '%s'", path)
+		}
+		if command.Command == "" {
+			return fmt.Errorf("'command' must be set for '%s'", path)
+		}
+	}
+	return nil
+}
+
+func validateOpenByPlatform(openByPlatform map[string]OpenCommandsConfig) error {
+	for platform := range openByPlatform {
+		if err := validateEnum("os.openByPlatform", platform,
+			[]string{"ssh", "wsl", "linux", "darwin", "windows"}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateCopyTemplates(copyTemplates CopyTemplatesConfig) error {
+	for _, group := range []struct {
+		name      string
+		templates []CopyTemplate
+	}{
+		{"commits", copyTemplates.Commits},
+		{"branches", copyTemplates.Branches},
+		{"files", copyTemplates.Files},
+	} {
+		for i, copyTemplate := range group.templates {
+			path := fmt.Sprintf("copyTemplates.%s[%d]", group.name, i)
+			if err := validateTemplate(path, copyTemplate.Template); err != nil {
+				return err
+			}
+			if !isValidKeybindingKey(copyTemplate.Key) {
+				return fmt.Errorf("Unrecognized key '%s' for '%s'. For permitted values see %s",
+					copyTemplate.Key, path, constants.Links.Docs.CustomKeybindings)
+			}
+		}
+	}
+	return nil
+}
+
+func validateRemoteServices(remoteServices map[string]RemoteServiceConfig) error {
+	for remote, remoteService := range remoteServices {
+		path := fmt.Sprintf("remoteServices.%s", remote)
+		if err := validateEnum(path+".provider", remoteService.Provider,
+			[]string{"", "github", "bitbucket", "bitbucketServer", "azuredevops", "gitlab", "gitea", "forgejo"}); err != nil {
+			return err
+		}
+		if remoteService.Provider != "" && remoteService.WebDomain == "" {
+			return fmt.Errorf("Missing webDomain for '%s'", path)
+		}
+	}
+	return nil
+}
+
 func validateCustomCommands(customCommands []CustomCommand) error {
 	for _, customCommand := range customCommands {
 		if err := validateCustomCommandKey(customCommand.Key); err != nil {
@@ -120,6 +338,7 @@ func validateCustomCommands(customCommands []CustomCommand) error {
 		if len(customCommand.CommandMenu) > 0 {
 			if len(customCommand.Context) > 0 ||
 				len(customCommand.Command) > 0 ||
+				len(customCommand.Macro) > 0 ||
 				len(customCommand.Prompts) > 0 ||
 				len(customCommand.LoadingText) > 0 ||
 				len(customCommand.Output) > 0 ||
@@ -135,11 +354,61 @@ func validateCustomCommands(customCommands []CustomCommand) error {
 			if err := validateCustomCommands(customCommand.CommandMenu); err != nil {
 				return err
 			}
+		} else if len(customCommand.Macro) > 0 {
+			if len(customCommand.Command) > 0 ||
+				len(customCommand.Prompts) > 0 ||
+				len(customCommand.LoadingText) > 0 ||
+				len(customCommand.Output) > 0 ||
+				len(customCommand.OutputTitle) > 0 ||
+				customCommand.After != nil {
+				return fmt.Errorf("Error with custom command with key '%s': it is not allowed to use both macro and any of the other fields except key, context and description.", customCommand.Key)
+			}
+
+			for _, key := range customCommand.Macro {
+				if !isValidKeybindingKey(key) {
+					return fmt.Errorf("Unrecognized key '%s' in macro of custom command with key '%s'. For permitted values see %s",
+						key, customCommand.Key, constants.Links.Docs.CustomKeybindings)
+				}
+			}
 		} else {
 			if err := validateEnum("customCommand.output", customCommand.Output,
 				[]string{"", "none", "terminal", "log", "logWithPty", "popup"}); err != nil {
 				return err
 			}
+			if err := validateCustomCommandPrompts(customCommand.Prompts); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateCustomCommandPrompts(prompts []CustomCommandPrompt) error {
+	for i, prompt := range prompts {
+		if prompt.InitialValue != "" && prompt.InitialValueCommand != "" {
+			return fmt.Errorf("Custom command prompt '%s' cannot have both an initialValue and an initialValueCommand", prompt.Title)
+		}
+		if _, err := regexp.Compile(prompt.ValidationRegex); err != nil {
+			return fmt.Errorf("Invalid validationRegex in custom command prompt '%s': %v", prompt.Title, err)
+		}
+		if prompt.Condition != nil {
+			if !lo.ContainsBy(prompts[:i], func(earlierPrompt CustomCommandPrompt) bool {
+				return earlierPrompt.Key == prompt.Condition.Key
+			}) {
+				return fmt.Errorf("The condition of custom command prompt '%s' refers to '%s', which is not the key of an earlier prompt", prompt.Title, prompt.Condition.Key)
+			}
+			if _, err := regexp.Compile(prompt.Condition.Matches); err != nil {
+				return fmt.Errorf("Invalid condition regex in custom command prompt '%s': %v", prompt.Title, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateProtectedBranches(protectedBranches []string) error {
+	for _, pattern := range protectedBranches {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("Invalid pattern '%s' in git.protectedBranches: %v", pattern, err)
 		}
 	}
 	return nil
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -30,6 +31,74 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "invalid_value", valid: false},
 			},
 		},
+		{
+			name: "Gui.SidePanelPosition",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.SidePanelPosition = value
+			},
+			testCases: []testCase{
+				{value: "left", valid: true},
+				{value: "right", valid: true},
+				{value: "", valid: false},
+				{value: "top", valid: false},
+			},
+		},
+		{
+			name: "Gui.Theme.Preset",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.Theme.Preset = value
+			},
+			testCases: []testCase{
+				{value: "default", valid: true},
+				{value: "highContrast", valid: true},
+				{value: "colorblind", valid: true},
+				{value: "", valid: false},
+				{value: "deuteranopia", valid: false},
+			},
+		},
+		{
+			name: "Gui.CommitAuthorFormat",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.CommitAuthorFormat = value
+			},
+			testCases: []testCase{
+				{value: "full", valid: true},
+				{value: "shortened", valid: true},
+				{value: "initials", valid: true},
+				{value: "email", valid: true},
+				{value: "avatar", valid: true},
+				{value: "", valid: false},
+				{value: "nickname", valid: false},
+			},
+		},
+		{
+			name: "Gui.DateDisplay",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.DateDisplay = value
+			},
+			testCases: []testCase{
+				{value: "default", valid: true},
+				{value: "relative", valid: true},
+				{value: "absolute", valid: true},
+				{value: "custom", valid: true},
+				{value: "", valid: false},
+				{value: "iso", valid: false},
+			},
+		},
+		{
+			name: "Gui.SidePanels",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.SidePanels = strings.Split(value, ";")
+			},
+			testCases: []testCase{
+				{value: "status;files;branches;commits;stash", valid: true},
+				{value: "branches;files", valid: true},
+				{value: "files;commits, stash", valid: true},
+				{value: "", valid: false},
+				{value: "files;unknown", valid: false},
+				{value: "files;commits,files", valid: false},
+			},
+		},
 		{
 			name: "Gui.ShowDivergenceFromBaseBranch",
 			setup: func(config *UserConfig, value string) {
@@ -39,6 +108,7 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "none", valid: true},
 				{value: "onlyArrow", valid: true},
 				{value: "arrowAndNumber", valid: true},
+				{value: "aheadAndBehind", valid: true},
 				{value: "", valid: false},
 				{value: "invalid_value", valid: false},
 			},
@@ -111,6 +181,88 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "invalid_value", valid: false},
 			},
 		},
+		{
+			name: "OS.ClipboardBackend",
+			setup: func(config *UserConfig, value string) {
+				config.OS.ClipboardBackend = value
+			},
+			testCases: []testCase{
+				{value: "auto", valid: true},
+				{value: "system", valid: true},
+				{value: "osc52", valid: true},
+
+				{value: "", valid: false},
+				{value: "invalid_value", valid: false},
+			},
+		},
+		{
+			name: "Accessibility.Output",
+			setup: func(config *UserConfig, value string) {
+				config.Accessibility.Output = value
+			},
+			testCases: []testCase{
+				{value: "auto", valid: true},
+				{value: "speechDispatcher", valid: true},
+				{value: "say", valid: true},
+
+				{value: "", valid: false},
+				{value: "invalid_value", valid: false},
+				// lines needs a file to append to
+				{value: "lines", valid: false},
+			},
+		},
+		{
+			name: "Accessibility.LinesFile",
+			setup: func(config *UserConfig, value string) {
+				config.Accessibility.Output = "lines"
+				config.Accessibility.LinesFile = value
+			},
+			testCases: []testCase{
+				{value: "/tmp/lazygit-announcements", valid: true},
+				{value: "", valid: false},
+			},
+		},
+		{
+			name: "OS.OpenByPlatform",
+			setup: func(config *UserConfig, value string) {
+				config.OS.OpenByPlatform = map[string]OpenCommandsConfig{
+					value: {OpenLink: "open {{link}}"},
+				}
+			},
+			testCases: []testCase{
+				{value: "ssh", valid: true},
+				{value: "wsl", valid: true},
+				{value: "linux", valid: true},
+				{value: "darwin", valid: true},
+				{value: "windows", valid: true},
+
+				{value: "", valid: false},
+				{value: "macos", valid: false},
+			},
+		},
+		{
+			name: "Gui.BranchLineTemplate",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.BranchLineTemplate = value
+			},
+			testCases: []testCase{
+				{value: "", valid: true},
+				{value: "{{.Recency}}\t{{.Name}} {{.AheadBehind}}", valid: true},
+				{value: "{{.Name", valid: false},
+				{value: "{{if .Name}}", valid: false},
+			},
+		},
+		{
+			name: "Gui.CommitLineTemplate",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.CommitLineTemplate = value
+			},
+			testCases: []testCase{
+				{value: "", valid: true},
+				{value: "{{.Hash}}\t{{.Age}}\t{{.Graph}}{{.Name}}", valid: true},
+				{value: "{{.Hash}", valid: false},
+			},
+		},
 		{
 			name: "Keybindings",
 			setup: func(config *UserConfig, value string) {
@@ -122,6 +274,10 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "q", valid: true},
 				{value: "<c-c>", valid: true},
 				{value: "invalid_value", valid: false},
+				{value: "g q", valid: true},
+				{value: "<c-x> <c-c>", valid: true},
+				{value: "g invalid_value", valid: false},
+				{value: "g <disabled>", valid: false},
 			},
 		},
 		{
@@ -137,6 +293,34 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "1,2,3,4,5,6", valid: false},
 			},
 		},
+		{
+			name: "Mouse gesture panel",
+			setup: func(config *UserConfig, value string) {
+				config.Keybinding.Mouse = map[string]MouseGesturesConfig{
+					value: {RightClick: "<space>"},
+				}
+			},
+			testCases: []testCase{
+				{value: "files", valid: true},
+				{value: "commits", valid: true},
+				{value: "status", valid: false},
+				{value: "invalid_value", valid: false},
+			},
+		},
+		{
+			name: "Mouse gesture key",
+			setup: func(config *UserConfig, value string) {
+				config.Keybinding.Mouse = map[string]MouseGesturesConfig{
+					"files": {DoubleClick: value},
+				}
+			},
+			testCases: []testCase{
+				{value: "", valid: true},
+				{value: "<disabled>", valid: true},
+				{value: "<space>", valid: true},
+				{value: "invalid_value", valid: false},
+			},
+		},
 		{
 			name: "Custom command keybinding",
 			setup: func(config *UserConfig, value string) {
@@ -176,6 +360,121 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "invalid_value", valid: false},
 			},
 		},
+		{
+			name: "Copy template",
+			setup: func(config *UserConfig, value string) {
+				config.CopyTemplates.Commits = []CopyTemplate{
+					{Description: "Reference", Template: value, Key: "r"},
+				}
+			},
+			testCases: []testCase{
+				{value: "{{.ShortHash}} ({{.Subject}}, {{.AuthorDate}})", valid: true},
+				{value: "{{.ShortHash", valid: false},
+			},
+		},
+		{
+			name: "Status bar segment",
+			setup: func(config *UserConfig, value string) {
+				config.Gui.StatusBarSegments = []StatusBarSegment{{Template: value}}
+			},
+			testCases: []testCase{
+				{value: "{{.Branch}} ↑{{.Ahead}} ↓{{.Behind}}", valid: true},
+				{value: "{{.Branch", valid: false},
+				{value: "", valid: false},
+			},
+		},
+		{
+			name: "Copy template key",
+			setup: func(config *UserConfig, value string) {
+				config.CopyTemplates.Files = []CopyTemplate{
+					{Description: "Markdown link", Template: "[{{.Name}}]({{.Path}})", Key: value},
+				}
+			},
+			testCases: []testCase{
+				{value: "", valid: true},
+				{value: "m", valid: true},
+				{value: "invalid_value", valid: false},
+			},
+		},
+		{
+			name: "File type diff command",
+			setup: func(config *UserConfig, value string) {
+				config.Git.Paging.FileTypeDiffCommands = []FileTypeDiffCommand{
+					{Extensions: []string{"png"}, Command: value},
+				}
+			},
+			testCases: []testCase{
+				{value: "imgdiff", valid: true},
+				{value: "", valid: false},
+			},
+		},
+		{
+			name: "Remote service provider",
+			setup: func(config *UserConfig, value string) {
+				config.RemoteServices = map[string]RemoteServiceConfig{
+					"upstream": {Provider: value, WebDomain: "git.example.com"},
+				}
+			},
+			testCases: []testCase{
+				{value: "", valid: true},
+				{value: "forgejo", valid: true},
+				{value: "sourcehut", valid: false},
+			},
+		},
+		{
+			name: "Remote service web domain",
+			setup: func(config *UserConfig, value string) {
+				config.RemoteServices = map[string]RemoteServiceConfig{
+					"upstream": {Provider: "gitea", WebDomain: value},
+				}
+			},
+			testCases: []testCase{
+				{value: "git.example.com", valid: true},
+				{value: "", valid: false},
+			},
+		},
+		{
+			name: "Hosting service token sources",
+			setup: func(config *UserConfig, value string) {
+				config.HostingServiceTokenSources = []string{"cli", value}
+			},
+			testCases: []testCase{
+				{value: "gitCredential", valid: true},
+				{value: "keychain", valid: false},
+			},
+		},
+		{
+			name: "Issue branch name template",
+			setup: func(config *UserConfig, value string) {
+				config.Issues.BranchNameTemplate = value
+			},
+			testCases: []testCase{
+				{value: "{{.Number}}-{{.Title}}", valid: true},
+				{value: "{{.Number", valid: false},
+			},
+		},
+		{
+			name: "Protected branches",
+			setup: func(config *UserConfig, value string) {
+				config.Git.ProtectedBranches = []string{"main", value}
+			},
+			testCases: []testCase{
+				{value: "release/*", valid: true},
+				{value: "release/[", valid: false},
+			},
+		},
+		{
+			name: "File type diff command extensions",
+			setup: func(config *UserConfig, value string) {
+				config.Git.Paging.FileTypeDiffCommands = []FileTypeDiffCommand{
+					{Extensions: lo.Compact([]string{value}), Command: "imgdiff"},
+				}
+			},
+			testCases: []testCase{
+				{value: "png", valid: true},
+				{value: "", valid: false},
+			},
+		},
 		{
 			name: "Custom command output",
 			setup: func(config *UserConfig, value string) {
@@ -195,6 +494,57 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "invalid_value", valid: false},
 			},
 		},
+		{
+			name: "Custom command prompt validation regex",
+			setup: func(config *UserConfig, value string) {
+				config.CustomCommands = []CustomCommand{
+					{
+						Prompts: []CustomCommandPrompt{
+							{Type: "input", Key: "Ticket", ValidationRegex: value},
+						},
+					},
+				}
+			},
+			testCases: []testCase{
+				{value: "", valid: true},
+				{value: "^[A-Z]+-[0-9]+$", valid: true},
+				{value: "^(ABC-[0-9]+$", valid: false},
+			},
+		},
+		{
+			name: "Custom command prompt condition",
+			setup: func(config *UserConfig, value string) {
+				config.CustomCommands = []CustomCommand{
+					{
+						Prompts: []CustomCommandPrompt{
+							{Type: "input", Key: "Type"},
+							{Type: "input", Key: "Ticket", Condition: &CustomCommandPromptCondition{Key: value, Matches: "^feature$"}},
+						},
+					},
+				}
+			},
+			testCases: []testCase{
+				{value: "Type", valid: true},
+				{value: "Ticket", valid: false},
+				{value: "Other", valid: false},
+			},
+		},
+		{
+			name: "Custom command prompt initial value",
+			setup: func(config *UserConfig, value string) {
+				config.CustomCommands = []CustomCommand{
+					{
+						Prompts: []CustomCommandPrompt{
+							{Type: "input", Key: "Email", InitialValue: value, InitialValueCommand: "git config user.email"},
+						},
+					},
+				}
+			},
+			testCases: []testCase{
+				{value: "", valid: true},
+				{value: "me@example.com", valid: false},
+			},
+		},
 		{
 			name: "Custom command sub menu",
 			setup: func(config *UserConfig, _ string) {
@@ -246,6 +596,39 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "", valid: false},
 			},
 		},
+		{
+			name: "Custom command macro",
+			setup: func(config *UserConfig, value string) {
+				config.CustomCommands = []CustomCommand{
+					{
+						Key:     "X",
+						Context: "files",
+						Macro:   []string{"a", value},
+					},
+				}
+			},
+			testCases: []testCase{
+				{value: "c", valid: true},
+				{value: "<enter>", valid: true},
+				{value: "invalid_key", valid: false},
+			},
+		},
+		{
+			name: "Custom command macro",
+			setup: func(config *UserConfig, _ string) {
+				config.CustomCommands = []CustomCommand{
+					{
+						Key:     "X",
+						Context: "files",
+						Command: "git commit", // a macro doesn't run a command
+						Macro:   []string{"a", "c"},
+					},
+				}
+			},
+			testCases: []testCase{
+				{value: "", valid: false},
+			},
+		},
 	}
 
 	for _, s := range scenarios {
@@ -0,0 +1,44 @@
+package gui
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// Mirrors lazygit's own log into the app log tab of the extras window. Which
+// entries reach us depends on the logger's level, so without the --debug flag
+// we only see errors.
+type appLogHook struct {
+	gui       *Gui
+	formatter logrus.Formatter
+}
+
+func newAppLogHook(gui *Gui) *appLogHook {
+	return &appLogHook{
+		gui: gui,
+		formatter: &logrus.TextFormatter{
+			DisableColors:   true,
+			FullTimestamp:   true,
+			TimestampFormat: "15:04:05",
+		},
+	}
+}
+
+// Debug entries are too noisy to be useful here
+func (self *appLogHook) Levels() []logrus.Level {
+	return logrus.AllLevels[:logrus.InfoLevel+1]
+}
+
+func (self *appLogHook) Fire(entry *logrus.Entry) error {
+	view := self.gui.Views.AppLog
+	if view == nil {
+		return nil
+	}
+
+	line, err := self.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = view.Write(line)
+	return err
+}
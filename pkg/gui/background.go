@@ -10,6 +10,8 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
+const configFileWatchInterval = time.Second
+
 type BackgroundRoutineMgr struct {
 	gui *Gui
 
@@ -48,8 +50,19 @@ func (self *BackgroundRoutineMgr) startBackgroundRoutines() {
 		}
 	}
 
+	if userConfig.Refresher.AutoReloadConfig {
+		go utils.Safe(self.startConfigFileWatcher)
+	}
+
+	if len(userConfig.Gui.StatusBarSegments) > 0 {
+		refreshInterval := userConfig.Refresher.RefreshInterval
+		if refreshInterval > 0 {
+			go utils.Safe(func() { self.startStatusBarRefresh(refreshInterval) })
+		}
+	}
+
 	if self.gui.Config.GetDebug() {
-		self.goEvery(time.Second*time.Duration(10), self.gui.stopChan, func() error {
+		self.goEvery("", time.Second*time.Duration(10), self.gui.stopChan, func() error {
 			formatBytes := func(b uint64) string {
 				const unit = 1000
 				if b < unit {
@@ -86,19 +99,51 @@ func (self *BackgroundRoutineMgr) startBackgroundFetch() {
 	_ = fetch()
 
 	userConfig := self.gui.UserConfig()
-	self.goEvery(time.Second*time.Duration(userConfig.Refresher.FetchInterval), self.gui.stopChan, fetch)
+	self.goEvery(self.gui.Tr.AutoFetchTask, time.Second*time.Duration(userConfig.Refresher.FetchInterval), self.gui.stopChan, fetch)
 }
 
 func (self *BackgroundRoutineMgr) startBackgroundFilesRefresh(refreshInterval int) {
 	self.gui.waitForIntro.Wait()
 
-	self.goEvery(time.Second*time.Duration(refreshInterval), self.gui.stopChan, func() error {
+	self.goEvery(self.gui.Tr.AutoRefreshTask, time.Second*time.Duration(refreshInterval), self.gui.stopChan, func() error {
 		self.gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
 		return nil
 	})
 }
 
-func (self *BackgroundRoutineMgr) goEvery(interval time.Duration, stop chan struct{}, function func() error) {
+func (self *BackgroundRoutineMgr) startStatusBarRefresh(refreshInterval int) {
+	self.gui.waitForIntro.Wait()
+
+	// The time and the output of the status bar segments' commands change
+	// without any event that would cause a redraw, so we redraw periodically
+	self.gui.helpers.StatusBar.RefreshCommandOutputs()
+	self.goEvery(self.gui.Tr.StatusBarRefreshTask, time.Second*time.Duration(refreshInterval), self.gui.stopChan, func() error {
+		self.gui.helpers.StatusBar.RefreshCommandOutputs()
+		return nil
+	})
+}
+
+// Checking the modification times of the config files is cheap, so we can do
+// it often enough for changes to apply as soon as the user saves the file
+func (self *BackgroundRoutineMgr) startConfigFileWatcher() {
+	self.gui.waitForIntro.Wait()
+
+	ticker := time.NewTicker(configFileWatchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if self.pauseBackgroundRefreshes || !self.gui.Config.HasChangedUserConfigFiles() {
+				continue
+			}
+			self.gui.c.OnUIThread(self.gui.reloadChangedUserConfig)
+		case <-self.gui.stopChan:
+			return
+		}
+	}
+}
+
+func (self *BackgroundRoutineMgr) goEvery(name string, interval time.Duration, stop chan struct{}, function func() error) {
 	done := make(chan struct{})
 	go utils.Safe(func() {
 		ticker := time.NewTicker(interval)
@@ -109,7 +154,7 @@ func (self *BackgroundRoutineMgr) goEvery(interval time.Duration, stop chan stru
 				if self.pauseBackgroundRefreshes {
 					continue
 				}
-				self.gui.c.OnWorker(func(gocui.Task) error {
+				self.gui.c.OnWorkerWithName(name, func(gocui.Task) error {
 					_ = function()
 					done <- struct{}{}
 					return nil
@@ -0,0 +1,63 @@
+package gui
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Lists the background tasks that lazygit is currently busy with, so that the
+// user can see why it's showing as busy, and cancel tasks that are stuck
+func (gui *Gui) handleShowBackgroundTasks() error {
+	tasks := lo.Filter(gui.g.Tasks(), func(task gocui.TaskInfo, _ int) bool {
+		// Work on the UI thread includes the keypress that opened this menu,
+		// and isn't something the user could cancel anyway
+		return task.Kind == gocui.TaskKindWorker
+	})
+
+	menuItems := lo.Map(tasks, func(task gocui.TaskInfo, _ int) *types.MenuItem {
+		name := task.Name
+		if name == "" {
+			name = gui.c.Tr.UnnamedTask
+		}
+
+		status := style.FgGreen.Sprint(gui.c.Tr.TaskRunning)
+		if !task.Busy {
+			status = style.FgYellow.Sprint(gui.c.Tr.TaskPaused)
+		}
+
+		// Most tasks don't check whether they were cancelled, so cancelling
+		// them would only pretend to do something
+		var disabledReason *types.DisabledReason
+		if !task.Cancellable {
+			disabledReason = &types.DisabledReason{Text: gui.c.Tr.TaskCannotBeCancelled}
+		}
+
+		return &types.MenuItem{
+			LabelColumns: []string{
+				name,
+				status,
+				style.FgCyan.Sprint(utils.UnixToTimeAgo(task.StartTime.Unix())),
+			},
+			OnPress: func() error {
+				if !gui.g.CancelTask(task.Id) {
+					return errors.New(gui.c.Tr.TaskAlreadyFinished)
+				}
+				gui.c.Toast(fmt.Sprintf(gui.c.Tr.CancelledTask, name))
+				return nil
+			},
+			Tooltip:        gui.c.Tr.CancelTaskTooltip,
+			DisabledReason: disabledReason,
+		}
+	})
+
+	return gui.c.Menu(types.CreateMenuOptions{
+		Title: gui.c.Tr.BackgroundTasks,
+		Items: menuItems,
+	})
+}
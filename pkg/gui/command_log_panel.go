@@ -3,12 +3,15 @@ package gui
 import (
 	"fmt"
 	"math/rand"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/constants"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 )
 
@@ -52,6 +55,51 @@ func (gui *Gui) LogCommand(cmdStr string, commandLine bool) {
 	fmt.Fprint(gui.Views.Extras, "\n"+textStyle.Sprint(indentedCmdStr))
 }
 
+// Shows the output of a finished command in the extras window, and appends
+// the command to the command log file if enabled
+func (gui *Gui) logCommandDone(entry oscommands.CommandLogEntry) {
+	if len(entry.Args) > 0 {
+		gui.Mutexes.LoggedCommandsMutex.Lock()
+		gui.LoggedCommands = append(gui.LoggedCommands, types.LoggedCommand{Command: entry.Command, Args: entry.Args})
+		gui.Mutexes.LoggedCommandsMutex.Unlock()
+	}
+
+	gui.showCommandOutput(entry)
+
+	fileConfig := gui.c.UserConfig().Gui.CommandLogFile
+	if !fileConfig.Enabled || gui.git == nil {
+		return
+	}
+
+	path := filepath.Join(gui.git.RepoPaths.RepoGitDirPath(), "lazygit-command.log")
+	if err := oscommands.AppendToCommandLogFile(path, entry, int64(fileConfig.MaxSize)*1024, fileConfig.MaxBackups); err != nil {
+		gui.c.Log.Errorf("error when writing to command log file: %v", err)
+	}
+}
+
+func (gui *Gui) showCommandOutput(entry oscommands.CommandLogEntry) {
+	if gui.Views.CommandOutput == nil {
+		return
+	}
+
+	output := strings.TrimRight(entry.Output, "\n")
+	if output == "" {
+		output = style.FgMagenta.Sprint(gui.c.Tr.NoCommandOutput)
+	}
+
+	exitCodeStyle := style.FgGreen
+	if entry.ExitCode != 0 {
+		exitCodeStyle = style.FgRed
+	}
+
+	gui.Views.CommandOutput.Autoscroll = true
+	gui.c.SetViewContent(gui.Views.CommandOutput, strings.Join([]string{
+		style.FgYellow.Sprint(entry.Command),
+		output,
+		exitCodeStyle.Sprintf(gui.c.Tr.CommandExitCode, entry.ExitCode),
+	}, "\n"))
+}
+
 func (gui *Gui) printCommandLogHeader() {
 	introStr := fmt.Sprintf(
 		gui.c.Tr.CommandLogHeader,
@@ -196,7 +196,9 @@ func (self *ContextMgr) Activate(c types.Context, opts types.OnFocusOpts) {
 
 	v.Visible = true
 
-	self.gui.c.GocuiGui().Cursor = v.Editable
+	// Screen readers follow the terminal's cursor, so in accessibility mode we
+	// show it on the selected line
+	self.gui.c.GocuiGui().Cursor = v.Editable || self.gui.c.UserConfig().Accessibility.Enabled
 
 	c.HandleFocus(opts)
 }
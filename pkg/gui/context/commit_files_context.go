@@ -39,7 +39,8 @@ func NewCommitFilesContext(c *ContextCommon) *CommitFilesContext {
 		}
 
 		showFileIcons := icons.IsIconEnabled() && c.UserConfig().Gui.ShowFileIcons
-		lines := presentation.RenderCommitFileTree(viewModel, c.Git().Patch.PatchBuilder, showFileIcons, &c.UserConfig().Gui.CustomIcons)
+		showNumstat := c.UserConfig().Gui.ShowNumstatInCommitFilesView
+		lines := presentation.RenderCommitFileTree(viewModel, c.Git().Patch.PatchBuilder, showFileIcons, showNumstat, &c.UserConfig().Gui.CustomIcons)
 		return lo.Map(lines, func(line string, _ int) []string {
 			return []string{line}
 		})
@@ -92,6 +93,16 @@ func (self *CommitFilesContext) GetFromAndToForDiff() (string, string) {
 	return ref.ParentRefName(), ref.RefName()
 }
 
+// Like GetFromAndToForDiff, but for a single file or directory. Untracked files
+// of a stash entry live in the entry's third parent, so we diff against that.
+func (self *CommitFilesContext) GetFromAndToForNodeDiff(node *filetree.CommitFileNode) (string, string) {
+	from, to := self.GetFromAndToForDiff()
+	if node.File != nil && node.File.Untracked {
+		to += "^3"
+	}
+	return from, to
+}
+
 func (self *CommitFilesContext) ModelSearchResults(searchStr string, caseSensitive bool) []gocui.SearchPosition {
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -41,6 +42,9 @@ type CommitMessageViewModel struct {
 	onConfirm func(string, string) error
 	// invoked when pressing the switch-to-editor key binding
 	onSwitchToEditor func(string) error
+	// if set, the commit menu offers inserting a reference to one of the
+	// returned issues into the description
+	listIssues func() ([]*hosting_service.Issue, error)
 
 	// the following two fields are used for the display of the "hooks disabled" subtitle
 	forceSkipHooks  bool
@@ -152,6 +156,7 @@ func (self *CommitMessageContext) SetPanelState(
 	initialMessage string,
 	onConfirm func(string, string) error,
 	onSwitchToEditor func(string) error,
+	listIssues func() ([]*hosting_service.Issue, error),
 	forceSkipHooks bool,
 	skipHooksPrefix string,
 ) {
@@ -160,6 +165,7 @@ func (self *CommitMessageContext) SetPanelState(
 	self.viewModel.initialMessage = initialMessage
 	self.viewModel.onConfirm = onConfirm
 	self.viewModel.onSwitchToEditor = onSwitchToEditor
+	self.viewModel.listIssues = listIssues
 	self.viewModel.forceSkipHooks = forceSkipHooks
 	self.viewModel.skipHooksPrefix = skipHooksPrefix
 	self.GetView().Title = summaryTitle
@@ -198,6 +204,14 @@ func (self *CommitMessageContext) SwitchToEditor(message string) error {
 	return self.viewModel.onSwitchToEditor(message)
 }
 
+func (self *CommitMessageContext) ListIssues() ([]*hosting_service.Issue, error) {
+	return self.viewModel.listIssues()
+}
+
+func (self *CommitMessageContext) CanInsertIssueReference() bool {
+	return self.viewModel.listIssues != nil
+}
+
 func (self *CommitMessageContext) CanSwitchToEditor() bool {
 	return self.viewModel.onSwitchToEditor != nil
 }
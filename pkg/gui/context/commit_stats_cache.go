@@ -0,0 +1,75 @@
+package context
+
+import (
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/sasha-s/go-deadlock"
+)
+
+// Caches the shortstat numbers of commits by hash. Computing them costs a git
+// call, so we only load them for the commits that are actually rendered, in the
+// background, and re-render once they are available.
+type CommitStatsCache struct {
+	mutex   deadlock.Mutex
+	stats   map[string]*models.CommitStats
+	loading *set.Set[string]
+}
+
+func NewCommitStatsCache() *CommitStatsCache {
+	return &CommitStatsCache{
+		stats:   map[string]*models.CommitStats{},
+		loading: set.New[string](),
+	}
+}
+
+// Returns the stats of the given commits that are cached already. The missing
+// ones are loaded in the background, and onLoaded is called on the UI thread
+// when they have arrived.
+func (self *CommitStatsCache) Get(c *ContextCommon, commits []*models.Commit, onLoaded func()) map[string]*models.CommitStats {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	result := make(map[string]*models.CommitStats, len(commits))
+	missing := []string{}
+	for _, commit := range commits {
+		hash := commit.Hash()
+		if hash == "" {
+			continue
+		}
+
+		if stats, ok := self.stats[hash]; ok {
+			result[hash] = stats
+		} else if !self.loading.Includes(hash) {
+			missing = append(missing, hash)
+		}
+	}
+
+	if len(missing) > 0 {
+		self.loading.Add(missing...)
+
+		c.OnWorkerWithName(c.Tr.LoadCommitStatsTask, func(gocui.Task) error {
+			stats, err := c.Git().Commit.GetCommitsStats(missing)
+
+			self.mutex.Lock()
+			self.loading.RemoveSlice(missing)
+			if err != nil {
+				// Cache empty stats so that we don't keep retrying on every render
+				c.Log.Error(err)
+				stats = map[string]*models.CommitStats{}
+			}
+			for _, hash := range missing {
+				self.stats[hash] = stats[hash]
+			}
+			self.mutex.Unlock()
+
+			c.OnUIThread(func() error {
+				onLoaded()
+				return nil
+			})
+			return nil
+		})
+	}
+
+	return result
+}
@@ -47,6 +47,8 @@ const (
 	SUBMODULES_CONTEXT_KEY         types.ContextKey = "submodules"
 	SUGGESTIONS_CONTEXT_KEY        types.ContextKey = "suggestions"
 	COMMAND_LOG_CONTEXT_KEY        types.ContextKey = "cmdLog"
+	COMMAND_OUTPUT_CONTEXT_KEY     types.ContextKey = "commandOutput"
+	APP_LOG_CONTEXT_KEY            types.ContextKey = "appLog"
 )
 
 var AllContextKeys = []types.ContextKey{
@@ -78,6 +80,8 @@ var AllContextKeys = []types.ContextKey{
 	SUBMODULES_CONTEXT_KEY,
 	SUGGESTIONS_CONTEXT_KEY,
 	COMMAND_LOG_CONTEXT_KEY,
+	COMMAND_OUTPUT_CONTEXT_KEY,
+	APP_LOG_CONTEXT_KEY,
 }
 
 type ContextTree struct {
@@ -109,6 +113,8 @@ type ContextTree struct {
 	CommitMessage               *CommitMessageContext
 	CommitDescription           types.Context
 	CommandLog                  types.Context
+	CommandOutput               types.Context
+	AppLog                      types.Context
 
 	// display contexts
 	AppStatus     types.Context
@@ -153,6 +159,8 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.Normal,
 
 		self.Suggestions,
+		self.AppLog,
+		self.CommandOutput,
 		self.CommandLog,
 		self.AppStatus,
 		self.Options,
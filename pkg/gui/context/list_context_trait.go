@@ -2,6 +2,7 @@ package context
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -58,6 +59,28 @@ func (self *ListContextTrait) FocusLine() {
 	})
 
 	self.setFooter()
+	self.announceSelection()
+}
+
+// For screen readers, which need to be told the text of the selected line and
+// where it is in the list
+func (self *ListContextTrait) announceSelection() {
+	if !self.c.UserConfig().Accessibility.Enabled || !self.c.Context().IsCurrent(self) {
+		return
+	}
+
+	selectedLineIdx := self.list.GetSelectedLineIdx()
+	if selectedLineIdx < 0 || selectedLineIdx >= self.list.Len() {
+		return
+	}
+
+	displayStrings := self.getDisplayStrings(selectedLineIdx, selectedLineIdx+1)
+	if len(displayStrings) == 0 {
+		return
+	}
+
+	self.c.AnnounceSelection(self, strings.Join(displayStrings[0], " ")+", "+
+		formatListFooter(selectedLineIdx, self.list.Len()))
 }
 
 func (self *ListContextTrait) refreshViewport() {
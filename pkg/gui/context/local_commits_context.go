@@ -9,7 +9,9 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
@@ -31,6 +33,8 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 		c,
 	)
 
+	var ctx *LocalCommitsContext
+
 	getDisplayStrings := func(startIdx int, endIdx int) [][]string {
 		var selectedCommitHashPtr *string
 
@@ -43,6 +47,14 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 
 		hasRebaseUpdateRefsConfig := c.Git().Config.GetRebaseUpdateRefs()
 
+		var commitStats map[string]*models.CommitStats
+		if c.UserConfig().Gui.ShowCommitStats {
+			visibleCommits := c.Model().Commits[min(startIdx, len(c.Model().Commits)):min(endIdx, len(c.Model().Commits))]
+			commitStats = viewModel.commitStats.Get(c, visibleCommits, func() {
+				ctx.HandleRender()
+			})
+		}
+
 		return presentation.GetCommitListDisplayStrings(
 			c.Common,
 			c.Model().Commits,
@@ -51,15 +63,16 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 			hasRebaseUpdateRefsConfig,
 			c.State().GetRepoState().GetScreenMode() != types.SCREEN_NORMAL,
 			c.Modes().CherryPicking.SelectedHashSet(),
+			c.Modes().MarkedCommits.HashSetFor(string(LOCAL_COMMITS_CONTEXT_KEY)),
 			c.Modes().Diffing.Ref,
 			c.Modes().MarkedBaseCommit.GetHash(),
-			c.UserConfig().Gui.TimeFormat,
-			c.UserConfig().Gui.ShortTimeFormat,
+			commitStats,
 			time.Now(),
 			c.UserConfig().Git.ParseEmoji,
 			selectedCommitHashPtr,
 			startIdx,
 			endIdx,
+			c.Views().Commits.InnerWidth(),
 			shouldShowGraph(c),
 			c.Model().BisectInfo,
 		)
@@ -101,16 +114,24 @@ func NewLocalCommitsContext(c *ContextCommon) *LocalCommitsContext {
 			if !found {
 				firstRealCommit = 0
 			}
+			content := fmt.Sprintf("--- %s ---", c.Tr.CommitsSectionHeader)
+			if c.Model().RebasePausedAtBreak {
+				// Make it obvious where the rebase is waiting for the user
+				content = style.FgYellow.Sprintf("--- %s ---", utils.ResolvePlaceholderString(
+					c.Tr.RebasePausedAtBreakSectionHeader,
+					map[string]string{"key": c.UserConfig().Keybinding.Universal.CreateRebaseOptionsMenu},
+				))
+			}
 			result = append(result, &NonModelItem{
 				Index:   firstRealCommit,
-				Content: fmt.Sprintf("--- %s ---", c.Tr.CommitsSectionHeader),
+				Content: content,
 			})
 		}
 
 		return result
 	}
 
-	ctx := &LocalCommitsContext{
+	ctx = &LocalCommitsContext{
 		LocalCommitsViewModel: viewModel,
 		SearchTrait:           NewSearchTrait(c),
 		ListContextTrait: &ListContextTrait{
@@ -148,6 +169,8 @@ type LocalCommitsViewModel struct {
 
 	// If this is true we'll use git log --all when fetching the commits.
 	showWholeGitGraph bool
+
+	commitStats *CommitStatsCache
 }
 
 func NewLocalCommitsViewModel(getModel func() []*models.Commit, c *ContextCommon) *LocalCommitsViewModel {
@@ -155,6 +178,7 @@ func NewLocalCommitsViewModel(getModel func() []*models.Commit, c *ContextCommon
 		ListViewModel:     NewListViewModel(getModel),
 		limitCommits:      true,
 		showWholeGitGraph: c.UserConfig().Git.Log.ShowWholeGraph,
+		commitStats:       NewCommitStatsCache(),
 	}
 
 	return self
@@ -56,6 +56,8 @@ type MenuViewModel struct {
 	promptLines               []string
 	columnAlignment           []utils.Alignment
 	allowFilteringKeybindings bool
+	onSelectionChange         func(*types.MenuItem)
+	onClose                   func()
 	*FilteredListViewModel[*types.MenuItem]
 }
 
@@ -117,6 +119,28 @@ func (self *MenuViewModel) SetAllowFilteringKeybindings(allow bool) {
 	self.allowFilteringKeybindings = allow
 }
 
+func (self *MenuViewModel) SetOnSelectionChange(onSelectionChange func(*types.MenuItem)) {
+	self.onSelectionChange = onSelectionChange
+}
+
+func (self *MenuViewModel) OnSelectionChange(item *types.MenuItem) {
+	if self.onSelectionChange != nil {
+		self.onSelectionChange(item)
+	}
+}
+
+func (self *MenuViewModel) SetOnClose(onClose func()) {
+	self.onClose = onClose
+}
+
+// Only calls the callback once, even if the menu loses focus several times
+func (self *MenuViewModel) OnClose() {
+	if onClose := self.onClose; onClose != nil {
+		self.onClose = nil
+		onClose()
+	}
+}
+
 // TODO: move into presentation package
 func (self *MenuViewModel) GetDisplayStrings(_ int, _ int) [][]string {
 	menuItems := self.FilteredListViewModel.GetItems()
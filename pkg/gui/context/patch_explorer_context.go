@@ -2,6 +2,7 @@ package context
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/patch_exploring"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	deadlock "github.com/sasha-s/go-deadlock"
@@ -120,7 +121,10 @@ func (self *PatchExplorerContext) GetContentToRender() string {
 		return ""
 	}
 
-	return self.GetState().RenderForLineIndices(self.GetIncludedLineIndices())
+	return self.GetState().RenderForLineIndices(self.GetIncludedLineIndices(), patch.FormatViewOpts{
+		HighlightWordDiff: self.c.UserConfig().Gui.HighlightWordDiff,
+		ColorMovedLines:   self.c.Git().Config.GetColorMoved(),
+	})
 }
 
 func (self *PatchExplorerContext) NavigateTo(selectedLineIdx int) {
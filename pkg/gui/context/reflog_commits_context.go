@@ -8,9 +8,27 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
 
+// What the diff of the selected reflog entry is shown against
+type ReflogDiffTarget int
+
+const (
+	// The entry's own changes, i.e. against its parent
+	ReflogDiffTargetParent ReflogDiffTarget = iota
+	// The commit that HEAD currently points to
+	ReflogDiffTargetHead
+	// The working tree, including uncommitted changes
+	ReflogDiffTargetWorkingTree
+)
+
 type ReflogCommitsContext struct {
 	*FilteredListViewModel[*models.Commit]
 	*ListContextTrait
+
+	// The ref whose reflog we're showing, e.g. refs/heads/master. Empty for
+	// HEAD.
+	ref string
+
+	diffTarget ReflogDiffTarget
 }
 
 var (
@@ -31,10 +49,10 @@ func NewReflogCommitsContext(c *ContextCommon) *ReflogCommitsContext {
 			viewModel.GetItems(),
 			c.State().GetRepoState().GetScreenMode() != types.SCREEN_NORMAL,
 			c.Modes().CherryPicking.SelectedHashSet(),
+			c.Modes().MarkedCommits.HashSetFor(string(REFLOG_COMMITS_CONTEXT_KEY)),
 			c.Modes().Diffing.Ref,
 			time.Now(),
-			c.UserConfig().Gui.TimeFormat,
-			c.UserConfig().Gui.ShortTimeFormat,
+			&c.UserConfig().Gui,
 			c.UserConfig().Git.ParseEmoji,
 		)
 	}
@@ -59,6 +77,22 @@ func NewReflogCommitsContext(c *ContextCommon) *ReflogCommitsContext {
 	}
 }
 
+func (self *ReflogCommitsContext) GetRef() string {
+	return self.ref
+}
+
+func (self *ReflogCommitsContext) SetRef(ref string) {
+	self.ref = ref
+}
+
+func (self *ReflogCommitsContext) GetDiffTarget() ReflogDiffTarget {
+	return self.diffTarget
+}
+
+func (self *ReflogCommitsContext) SetDiffTarget(target ReflogDiffTarget) {
+	self.diffTarget = target
+}
+
 func (self *ReflogCommitsContext) CanRebase() bool {
 	return false
 }
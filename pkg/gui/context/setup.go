@@ -113,6 +113,24 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 				Focusable:  true,
 			}),
 		),
+		CommandOutput: NewSimpleContext(
+			NewBaseContext(NewBaseContextOpts{
+				Kind:       types.EXTRAS_CONTEXT,
+				View:       c.Views().CommandOutput,
+				WindowName: "extras",
+				Key:        COMMAND_OUTPUT_CONTEXT_KEY,
+				Focusable:  true,
+			}),
+		),
+		AppLog: NewSimpleContext(
+			NewBaseContext(NewBaseContextOpts{
+				Kind:       types.EXTRAS_CONTEXT,
+				View:       c.Views().AppLog,
+				WindowName: "extras",
+				Key:        APP_LOG_CONTEXT_KEY,
+				Focusable:  true,
+			}),
+		),
 		Snake: NewSimpleContext(
 			NewBaseContext(NewBaseContextOpts{
 				Kind:       types.SIDE_CONTEXT,
@@ -1,6 +1,8 @@
 package context
 
 import (
+	"time"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -27,7 +29,7 @@ func NewStashContext(
 	)
 
 	getDisplayStrings := func(_ int, _ int) [][]string {
-		return presentation.GetStashEntryListDisplayStrings(viewModel.GetItems(), c.Modes().Diffing.Ref)
+		return presentation.GetStashEntryListDisplayStrings(viewModel.GetItems(), c.Modes().Diffing.Ref, &c.UserConfig().Gui, time.Now())
 	}
 
 	return &StashContext{
@@ -66,15 +66,16 @@ func NewSubCommitsContext(
 			hasRebaseUpdateRefsConfig,
 			c.State().GetRepoState().GetScreenMode() != types.SCREEN_NORMAL,
 			c.Modes().CherryPicking.SelectedHashSet(),
+			c.Modes().MarkedCommits.HashSetFor(string(SUB_COMMITS_CONTEXT_KEY)),
 			c.Modes().Diffing.Ref,
 			"",
-			c.UserConfig().Gui.TimeFormat,
-			c.UserConfig().Gui.ShortTimeFormat,
+			nil,
 			time.Now(),
 			c.UserConfig().Git.ParseEmoji,
 			selectedCommitHashPtr,
 			startIdx,
 			endIdx,
+			c.Views().SubCommits.InnerWidth(),
 			shouldShowGraph(c),
 			git_commands.NewNullBisectInfo(),
 		)
@@ -147,6 +148,8 @@ type SubCommitsViewModel struct {
 
 	limitCommits    bool
 	showBranchHeads bool
+	// set when the commits are the results of a pickaxe search
+	pickaxe git_commands.Pickaxe
 }
 
 func (self *SubCommitsViewModel) SetRef(ref models.Ref) {
@@ -173,6 +176,14 @@ func (self *SubCommitsViewModel) GetShowBranchHeads() bool {
 	return self.showBranchHeads
 }
 
+func (self *SubCommitsViewModel) SetPickaxe(value git_commands.Pickaxe) {
+	self.pickaxe = value
+}
+
+func (self *SubCommitsViewModel) GetPickaxe() git_commands.Pickaxe {
+	return self.pickaxe
+}
+
 func (self *SubCommitsContext) CanRebase() bool {
 	return false
 }
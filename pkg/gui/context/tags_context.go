@@ -30,7 +30,9 @@ func NewTagsContext(
 		return presentation.GetTagListDisplayStrings(
 			viewModel.GetItems(),
 			c.State().GetItemOperation,
-			c.Modes().Diffing.Ref, c.Tr, c.UserConfig())
+			c.Modes().Diffing.Ref,
+			c.Model().RemoteTags[tagsComparisonRemote(c.Model())],
+			c.Tr, c.UserConfig())
 	}
 
 	return &TagsContext{
@@ -73,3 +75,17 @@ func (self *TagsContext) RefForAdjustingLineNumberInDiff() string {
 func (self *TagsContext) ShowBranchHeadsInSubCommits() bool {
 	return true
 }
+
+// The remote that the tags panel shows each tag's status against: origin if
+// there is one, or otherwise the first remote
+func tagsComparisonRemote(model *types.Model) string {
+	if len(model.Remotes) == 0 {
+		return ""
+	}
+	for _, remote := range model.Remotes {
+		if remote.Name == "origin" {
+			return remote.Name
+		}
+	}
+	return model.Remotes[0].Name
+}
@@ -1,24 +1,24 @@
 package context
 
 import (
-	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/samber/lo"
 )
 
 type WorkingTreeContext struct {
 	*filetree.FileTreeViewModel
 	*ListContextTrait
-	*SearchTrait
+	*SearchHistory
 }
 
 var (
 	_ types.IListContext       = (*WorkingTreeContext)(nil)
-	_ types.ISearchableContext = (*WorkingTreeContext)(nil)
+	_ types.IFilterableContext = (*WorkingTreeContext)(nil)
 )
 
 func NewWorkingTreeContext(c *ContextCommon) *WorkingTreeContext {
@@ -27,6 +27,9 @@ func NewWorkingTreeContext(c *ContextCommon) *WorkingTreeContext {
 		c.Common,
 		c.UserConfig().Gui.ShowFileTree,
 	)
+	if sortOrder := c.GetAppState().FilesSortOrder; sortOrder != "" {
+		viewModel.SetSortOrder(sortOrder)
+	}
 
 	getDisplayStrings := func(_ int, _ int) [][]string {
 		showFileIcons := icons.IsIconEnabled() && c.UserConfig().Gui.ShowFileIcons
@@ -38,7 +41,7 @@ func NewWorkingTreeContext(c *ContextCommon) *WorkingTreeContext {
 	}
 
 	ctx := &WorkingTreeContext{
-		SearchTrait:       NewSearchTrait(c),
+		SearchHistory:     NewSearchHistory(),
 		FileTreeViewModel: viewModel,
 		ListContextTrait: &ListContextTrait{
 			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
@@ -56,11 +59,43 @@ func NewWorkingTreeContext(c *ContextCommon) *WorkingTreeContext {
 		},
 	}
 
-	ctx.GetView().SetOnSelectItem(ctx.SearchTrait.onSelectItemWrapper(ctx.OnSearchSelect))
-
 	return ctx
 }
 
-func (self *WorkingTreeContext) ModelSearchResults(searchStr string, caseSensitive bool) []gocui.SearchPosition {
-	return nil
+// used for type switch
+func (self *WorkingTreeContext) IsFilterableContext() {}
+
+func (self *WorkingTreeContext) SetFilter(filter string, useFuzzySearch bool) {
+	self.SetTextFilter(filter, useFuzzySearch)
+}
+
+func (self *WorkingTreeContext) GetFilter() string {
+	return self.GetTextFilter()
+}
+
+func (self *WorkingTreeContext) ReApplyFilter(useFuzzySearch bool) {
+	self.SetTextFilter(self.GetTextFilter(), useFuzzySearch)
+	self.ClampSelection()
+}
+
+func (self *WorkingTreeContext) IsFiltering() bool {
+	return self.GetTextFilter() != ""
+}
+
+// Keeps the selected file selected when the filter is cleared
+func (self *WorkingTreeContext) ClearFilter() {
+	selectedNode := self.GetSelected()
+
+	self.SetTextFilter("", false)
+
+	if selectedNode != nil {
+		if index, found := self.GetIndexForPath(selectedNode.GetInternalPath()); found {
+			self.SetSelection(index)
+		}
+	}
+	self.ClampSelection()
+}
+
+func (self *WorkingTreeContext) FilterPrefix(tr *i18n.TranslationSet) string {
+	return tr.FilterPrefix
 }
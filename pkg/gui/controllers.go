@@ -27,10 +27,14 @@ func (gui *Gui) resetHelpersAndControllers() {
 	helperCommon := gui.c
 	recordDirectoryHelper := helpers.NewRecordDirectoryHelper(helperCommon)
 	reposHelper := helpers.NewRecentReposHelper(helperCommon, recordDirectoryHelper, gui.onNewRepo)
-	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon)
-	refsHelper := helpers.NewRefsHelper(helperCommon, rebaseHelper)
+	notificationHelper := helpers.NewNotificationHelper(helperCommon)
+	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon, notificationHelper)
+	snapshotHelper := helpers.NewSnapshotHelper(helperCommon)
+	countPrefixHelper := helpers.NewCountPrefixHelper(helperCommon)
+	refsHelper := helpers.NewRefsHelper(helperCommon, rebaseHelper, snapshotHelper)
 	suggestionsHelper := helpers.NewSuggestionsHelper(helperCommon)
-	worktreeHelper := helpers.NewWorktreeHelper(helperCommon, reposHelper, refsHelper, suggestionsHelper)
+	filesHelper := helpers.NewFilesHelper(helperCommon)
+	worktreeHelper := helpers.NewWorktreeHelper(helperCommon, reposHelper, refsHelper, suggestionsHelper, filesHelper)
 
 	setCommitSummary := gui.getCommitMessageSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitMessage })
 	setCommitDescription := gui.getCommitMessageSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitDescription })
@@ -74,6 +78,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		helperCommon,
 		rebaseHelper,
 	)
+	markedCommitsHelper := helpers.NewMarkedCommitsHelper(helperCommon)
 	bisectHelper := helpers.NewBisectHelper(helperCommon)
 	windowHelper := helpers.NewWindowHelper(helperCommon, viewHelper)
 	modeHelper := helpers.NewModeHelper(
@@ -81,6 +86,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		diffHelper,
 		patchBuildingHelper,
 		cherryPickHelper,
+		markedCommitsHelper,
 		rebaseHelper,
 		bisectHelper,
 	)
@@ -97,14 +103,15 @@ func (gui *Gui) resetHelpersAndControllers() {
 		Staging:         stagingHelper,
 		Bisect:          bisectHelper,
 		Suggestions:     suggestionsHelper,
-		Files:           helpers.NewFilesHelper(helperCommon),
+		Files:           filesHelper,
 		WorkingTree:     helpers.NewWorkingTreeHelper(helperCommon, refsHelper, commitsHelper, gpgHelper),
 		Tags:            helpers.NewTagsHelper(helperCommon, commitsHelper, gpgHelper),
-		BranchesHelper:  helpers.NewBranchesHelper(helperCommon, worktreeHelper),
+		BranchesHelper:  helpers.NewBranchesHelper(helperCommon, worktreeHelper, snapshotHelper),
 		GPG:             helpers.NewGpgHelper(helperCommon),
 		MergeAndRebase:  rebaseHelper,
 		MergeConflicts:  mergeConflictsHelper,
 		CherryPick:      cherryPickHelper,
+		MarkedCommits:   markedCommitsHelper,
 		Upstream:        helpers.NewUpstreamHelper(helperCommon, suggestionsHelper.GetRemoteBranchesSuggestionsFunc),
 		AmendHelper:     helpers.NewAmendHelper(helperCommon, gpgHelper),
 		FixupHelper:     helpers.NewFixupHelper(helperCommon),
@@ -128,9 +135,18 @@ func (gui *Gui) resetHelpersAndControllers() {
 			modeHelper,
 			appStatusHelper,
 		),
-		Search:     searchHelper,
-		Worktree:   worktreeHelper,
-		SubCommits: helpers.NewSubCommitsHelper(helperCommon, refreshHelper),
+		PanelResize:   helpers.NewPanelResizeHelper(helperCommon, windowHelper),
+		Scrollbar:     helpers.NewScrollbarHelper(helperCommon, viewHelper),
+		StatusBar:     helpers.NewStatusBarHelper(helperCommon),
+		Search:        searchHelper,
+		Worktree:      worktreeHelper,
+		SubCommits:    helpers.NewSubCommitsHelper(helperCommon, refreshHelper),
+		CopyTemplates: helpers.NewCopyTemplatesHelper(helperCommon),
+		Notification:  notificationHelper,
+		Snapshot:      snapshotHelper,
+		Macro:         helpers.NewMacroHelper(helperCommon, countPrefixHelper),
+		CountPrefix:   countPrefixHelper,
+		Accessibility: helpers.NewAccessibilityHelper(helperCommon),
 	}
 
 	gui.CustomCommandsClient = custom_commands.NewClient(
@@ -260,6 +276,14 @@ func (gui *Gui) resetHelpersAndControllers() {
 		))
 	}
 
+	// attached before the controllers below so that their keys (like the one
+	// for focusing the main view) take precedence over the digits of a count
+	for _, context := range gui.c.Context().AllList() {
+		if context.GetKind() == types.SIDE_CONTEXT {
+			controllers.AttachControllers(context, controllers.NewCountPrefixController(common, context))
+		}
+	}
+
 	for _, context := range []types.Context{
 		gui.State.Contexts.Status,
 		gui.State.Contexts.Files,
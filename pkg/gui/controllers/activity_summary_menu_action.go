@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Summarizes what the user did in the repo over the last hours (the commits
+// they authored and the rest of HEAD's reflog), e.g. for a standup, and lets
+// them view or copy the summary.
+type ActivitySummaryMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *ActivitySummaryMenuAction) Call() error {
+	hours := self.c.UserConfig().Git.ActivitySummaryHours
+	title := fmt.Sprintf(self.c.Tr.ActivitySummaryTitle, hours)
+
+	show := func(summary string) error {
+		self.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: self.c.MainViewPairs().Normal,
+			Main: &types.ViewUpdateOpts{
+				Title: title,
+				Task:  types.NewRenderStringTask(summary),
+			},
+		})
+		return nil
+	}
+
+	copyToClipboard := func(summary string) error {
+		self.c.LogAction(self.c.Tr.Actions.CopyActivitySummaryToClipboard)
+		if err := self.c.OS().CopyToClipboard(summary); err != nil {
+			return err
+		}
+		self.c.Toast(self.c.Tr.ActivitySummaryCopiedToClipboard)
+		return nil
+	}
+
+	item := func(label string, handle func(string) error, key types.Key) *types.MenuItem {
+		return &types.MenuItem{
+			Label: label,
+			OnPress: func() error {
+				return self.withSummary(hours, handle)
+			},
+			Key: key,
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: title,
+		Items: []*types.MenuItem{
+			item(self.c.Tr.ShowActivitySummary, show, 's'),
+			item(self.c.Tr.CopyActivitySummary, copyToClipboard, 'c'),
+		},
+	})
+}
+
+func (self *ActivitySummaryMenuAction) withSummary(hours int, f func(string) error) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingActivitySummaryStatus, func(gocui.Task) error {
+		now := time.Now()
+		since := now.Add(-time.Duration(hours) * time.Hour)
+
+		commits, err := self.c.Git().Activity.GetOwnCommitsSince(since)
+		if err != nil {
+			return err
+		}
+		reflogEntries, err := self.c.Git().Activity.GetReflogEntriesSince(since)
+		if err != nil {
+			return err
+		}
+		if len(commits) == 0 && len(reflogEntries) == 0 {
+			return fmt.Errorf(self.c.Tr.NoRecentActivity, hours)
+		}
+
+		guiConfig := self.c.UserConfig().Gui
+		formatDate := func(date time.Time) string {
+			return utils.UnixToDateSmart(now, date.Unix(), guiConfig.TimeFormat, guiConfig.ShortTimeFormat)
+		}
+
+		self.c.OnUIThread(func() error {
+			return f(formatActivitySummary(commits, reflogEntries, formatDate))
+		})
+
+		return nil
+	})
+}
+
+// Matches the reflog entries of commits, e.g. "commit: foo" and
+// "commit (amend): foo"
+var commitReflogEntryRegex = regexp.MustCompile(`^commit( \([^)]*\))?:`)
+
+// Renders the activity as markdown: the commits grouped by branch, with the
+// most recently active branch first, followed by the rest of the reflog.
+// Reflog entries of commits are left out because those commits are already
+// listed.
+func formatActivitySummary(
+	commits []git_commands.ActivityCommit,
+	reflogEntries []git_commands.ActivityReflogEntry,
+	formatDate func(time.Time) string,
+) string {
+	sections := []string{}
+
+	if len(commits) > 0 {
+		branches := lo.Uniq(lo.Map(commits, func(commit git_commands.ActivityCommit, _ int) string {
+			return commit.Branch
+		}))
+		branchSections := lo.Map(branches, func(branch string, _ int) string {
+			entries := lo.FilterMap(commits, func(commit git_commands.ActivityCommit, _ int) (string, bool) {
+				return fmt.Sprintf("- %s %s (%s)", formatDate(commit.Date), commit.Subject, commit.ShortHash),
+					commit.Branch == branch
+			})
+			return "### " + branch + "\n\n" + strings.Join(entries, "\n")
+		})
+		sections = append(sections, "## Commits\n\n"+strings.Join(branchSections, "\n\n"))
+	}
+
+	otherEntries := lo.FilterMap(reflogEntries, func(entry git_commands.ActivityReflogEntry, _ int) (string, bool) {
+		return fmt.Sprintf("- %s %s", formatDate(entry.Date), entry.Action),
+			!commitReflogEntryRegex.MatchString(entry.Action)
+	})
+	if len(otherEntries) > 0 {
+		sections = append(sections, "## Other activity\n\n"+strings.Join(otherEntries, "\n"))
+	}
+
+	return strings.Join(sections, "\n\n") + "\n"
+}
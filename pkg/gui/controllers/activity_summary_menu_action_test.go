@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_formatActivitySummary(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2024, 1, 1, hour, 0, 0, 0, time.UTC)
+	}
+	formatDate := func(date time.Time) string {
+		return date.Format("15:04")
+	}
+
+	commits := []git_commands.ActivityCommit{
+		{ShortHash: "aaa", Date: at(15), Branch: "feature", Subject: "Add foo"},
+		{ShortHash: "bbb", Date: at(12), Branch: "master", Subject: "Fix bar"},
+		{ShortHash: "ccc", Date: at(10), Branch: "feature", Subject: "Prepare foo"},
+	}
+	reflogEntries := []git_commands.ActivityReflogEntry{
+		{ShortHash: "aaa", Date: at(15), Action: "commit (amend): Add foo"},
+		{ShortHash: "ddd", Date: at(14), Action: "checkout: moving from master to feature"},
+		{ShortHash: "bbb", Date: at(12), Action: "commit: Fix bar"},
+		{ShortHash: "eee", Date: at(11), Action: "rebase (finish): returning to refs/heads/master"},
+	}
+
+	scenarios := []struct {
+		name          string
+		commits       []git_commands.ActivityCommit
+		reflogEntries []git_commands.ActivityReflogEntry
+		expected      string
+	}{
+		{
+			name:          "commits and other activity",
+			commits:       commits,
+			reflogEntries: reflogEntries,
+			expected: "## Commits\n\n" +
+				"### feature\n\n" +
+				"- 15:00 Add foo (aaa)\n" +
+				"- 10:00 Prepare foo (ccc)\n\n" +
+				"### master\n\n" +
+				"- 12:00 Fix bar (bbb)\n\n" +
+				"## Other activity\n\n" +
+				"- 14:00 checkout: moving from master to feature\n" +
+				"- 11:00 rebase (finish): returning to refs/heads/master\n",
+		},
+		{
+			name:          "only commits in the reflog",
+			commits:       commits[1:2],
+			reflogEntries: reflogEntries[2:3],
+			expected: "## Commits\n\n" +
+				"### master\n\n" +
+				"- 12:00 Fix bar (bbb)\n",
+		},
+		{
+			name:          "no commits",
+			commits:       nil,
+			reflogEntries: reflogEntries[1:2],
+			expected: "## Other activity\n\n" +
+				"- 14:00 checkout: moving from master to feature\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, formatActivitySummary(s.commits, s.reflogEntries, formatDate))
+		})
+	}
+}
@@ -68,6 +68,22 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Tooltip:           self.c.Tr.CopyCommitAttributeToClipboardTooltip,
 			OpensMenu:         true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.FindContainingRefs),
+			Handler:           self.withItem(self.findContainingRefs),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.FindContainingRefs,
+			Tooltip:           self.c.Tr.FindContainingRefsTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.GenerateReleaseNotes),
+			Handler:           self.withItemsRange(self.generateReleaseNotes),
+			GetDisabledReason: self.require(self.itemRangeSelected(self.canGenerateReleaseNotes)),
+			Description:       self.c.Tr.GenerateReleaseNotes,
+			Tooltip:           self.c.Tr.GenerateReleaseNotesForCommitsTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.OpenInBrowser),
 			Handler:           self.withItem(self.openInBrowser),
@@ -118,6 +134,13 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Handler:     self.c.Helpers().CherryPick.Reset,
 			Description: self.c.Tr.ResetCherryPick,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.ToggleCommitMarked),
+			Handler:           self.withItemsRange(self.toggleMarked),
+			GetDisabledReason: self.require(self.itemRangeSelected(self.canMarkCommits)),
+			Description:       self.c.Tr.ToggleCommitMarked,
+			Tooltip:           self.c.Tr.ToggleCommitMarkedTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.OpenDiffTool),
 			Handler:           self.withItem(self.openDiffTool),
@@ -227,6 +250,18 @@ func (self *BasicCommitsController) copyCommitAttribute(commit *models.Commit) e
 
 	items = append(items, &commitTagsItem)
 
+	if markedCommits, _ := self.c.Helpers().MarkedCommits.MarkedCommits(self.context, self.context.GetCommits()); len(markedCommits) > 0 {
+		items = append(items, &types.MenuItem{
+			Label: self.c.Tr.MarkedCommitHashes,
+			OnPress: func() error {
+				return self.copyMarkedCommitHashesToClipboard(markedCommits)
+			},
+			Key: 'M',
+		})
+	}
+
+	items = append(items, self.c.Helpers().CopyTemplates.CommitMenuItems(commit)...)
+
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.Actions.CopyCommitAttributeToClipboard,
 		Items: items,
@@ -243,6 +278,18 @@ func (self *BasicCommitsController) copyCommitHashToClipboard(commit *models.Com
 	return nil
 }
 
+func (self *BasicCommitsController) copyMarkedCommitHashesToClipboard(markedCommits []*models.Commit) error {
+	hashes := lo.Map(markedCommits, func(commit *models.Commit, _ int) string { return commit.Hash() })
+
+	self.c.LogAction(self.c.Tr.Actions.CopyMarkedCommitHashes)
+	if err := self.c.OS().CopyToClipboard(strings.Join(hashes, "\n")); err != nil {
+		return err
+	}
+
+	self.c.Toast(self.c.Tr.MarkedCommitHashesCopiedToClipboard)
+	return nil
+}
+
 func (self *BasicCommitsController) copyCommitURLToClipboard(commit *models.Commit) error {
 	url, err := self.c.Helpers().Host.GetCommitURL(commit.Hash())
 	if err != nil {
@@ -356,6 +403,30 @@ func (self *BasicCommitsController) openInBrowser(commit *models.Commit) error {
 	return nil
 }
 
+func (self *BasicCommitsController) findContainingRefs(commit *models.Commit) error {
+	return (&ContainingRefsMenuAction{c: self.c}).Call(commit)
+}
+
+func (self *BasicCommitsController) canGenerateReleaseNotes(selectedCommits []*models.Commit, startIdx int, endIdx int) *types.DisabledReason {
+	if lo.SomeBy(selectedCommits, func(commit *models.Commit) bool { return commit.IsTODO() }) {
+		return &types.DisabledReason{Text: self.c.Tr.CannotGenerateReleaseNotesForTodos}
+	}
+
+	return nil
+}
+
+// Generates release notes for the selected commits, including the oldest one
+func (self *BasicCommitsController) generateReleaseNotes(selectedCommits []*models.Commit, startIdx int, endIdx int) error {
+	newest := selectedCommits[0]
+	oldest := selectedCommits[len(selectedCommits)-1]
+	from := ""
+	if !oldest.IsFirstCommit() {
+		from = oldest.ParentRefName()
+	}
+
+	return (&ReleaseNotesMenuAction{c: self.c}).Call(from, newest.Hash(), oldest.ShortHash()+".."+newest.ShortHash())
+}
+
 func (self *BasicCommitsController) newBranch(commit *models.Commit) error {
 	return self.c.Helpers().Refs.NewBranch(commit.RefName(), commit.Description(), "")
 }
@@ -369,6 +440,14 @@ func (self *BasicCommitsController) checkout(commit *models.Commit) error {
 }
 
 func (self *BasicCommitsController) copyRange(*models.Commit) error {
+	commits := self.context.GetCommits()
+	if markedCommits, _ := self.c.Helpers().MarkedCommits.MarkedCommits(self.context, commits); len(markedCommits) > 0 {
+		if err := self.c.Helpers().CherryPick.CopyCommits(markedCommits, commits, self.context); err != nil {
+			return err
+		}
+		return self.c.Helpers().MarkedCommits.Reset()
+	}
+
 	return self.c.Helpers().CherryPick.CopyRange(self.context.GetCommits(), self.context)
 }
 
@@ -382,6 +461,21 @@ func (self *BasicCommitsController) canCopyCommits(selectedCommits []*models.Com
 	return nil
 }
 
+func (self *BasicCommitsController) toggleMarked(selectedCommits []*models.Commit, startIdx int, endIdx int) error {
+	self.c.Helpers().MarkedCommits.Toggle(self.context, selectedCommits)
+	return nil
+}
+
+func (self *BasicCommitsController) canMarkCommits(selectedCommits []*models.Commit, startIdx int, endIdx int) *types.DisabledReason {
+	for _, commit := range selectedCommits {
+		if commit.Hash() == "" {
+			return &types.DisabledReason{Text: self.c.Tr.CannotMarkNonCommit, ShowErrorInPanel: true}
+		}
+	}
+
+	return nil
+}
+
 func (self *BasicCommitsController) handleOldCherryPickKey() error {
 	msg := utils.ResolvePlaceholderString(self.c.Tr.OldCherryPickKeyWarning,
 		map[string]string{
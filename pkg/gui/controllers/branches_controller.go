@@ -6,9 +6,11 @@ import (
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -121,8 +123,8 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Branches.MergeIntoCurrentBranch),
-			Handler:           opts.Guards.OutsideFilterMode(self.merge),
-			GetDisabledReason: self.require(self.singleItemSelected(self.notMergingIntoYourself)),
+			Handler:           opts.Guards.OutsideFilterMode(self.withItems(self.merge)),
+			GetDisabledReason: self.require(self.itemsSelected(self.noneMergingIntoYourself)),
 			Description:       self.c.Tr.Merge,
 			Tooltip:           self.c.Tr.MergeBranchTooltip,
 			DisplayOnScreen:   true,
@@ -435,7 +437,7 @@ func (self *BranchesController) handleCreatePullRequest(selectedBranch *models.B
 	if !selectedBranch.IsTrackingRemote() {
 		return errors.New(self.c.Tr.PullRequestNoUpstream)
 	}
-	return self.createPullRequest(selectedBranch.UpstreamBranch, "")
+	return self.createPullRequest(selectedBranch.UpstreamRemote, "", selectedBranch.UpstreamBranch, "")
 }
 
 func (self *BranchesController) handleCreatePullRequestMenu(selectedBranch *models.Branch) error {
@@ -453,7 +455,7 @@ func (self *BranchesController) copyPullRequestURL() error {
 		return errors.New(self.c.Tr.NoBranchOnRemote)
 	}
 
-	url, err := self.c.Helpers().Host.GetPullRequestURL(branch.Name, "")
+	url, err := self.c.Helpers().Host.GetPullRequestURL("", "", branch.Name, "")
 	if err != nil {
 		return err
 	}
@@ -619,9 +621,13 @@ func (self *BranchesController) delete(branches []*models.Branch) error {
 	})
 }
 
-func (self *BranchesController) merge() error {
-	selectedBranchName := self.context().GetSelected().Name
-	return self.c.Helpers().MergeAndRebase.MergeRefIntoCheckedOutBranch(selectedBranchName)
+func (self *BranchesController) merge(branches []*models.Branch) error {
+	if len(branches) > 1 {
+		branchNames := lo.Map(branches, func(branch *models.Branch, _ int) string { return branch.Name })
+		return self.c.Helpers().MergeAndRebase.OctopusMergeRefsIntoCheckedOutBranch(branchNames)
+	}
+
+	return self.c.Helpers().MergeAndRebase.MergeRefIntoCheckedOutBranch(branches[0].Name)
 }
 
 func (self *BranchesController) rebase(branch *models.Branch) error {
@@ -800,7 +806,8 @@ func (self *BranchesController) createPullRequestMenu(selectedBranch *models.Bra
 					if !checkedOutBranch.IsTrackingRemote() || !selectedBranch.IsTrackingRemote() {
 						return errors.New(self.c.Tr.PullRequestNoUpstream)
 					}
-					return self.createPullRequest(checkedOutBranch.UpstreamBranch, selectedBranch.UpstreamBranch)
+					return self.createPullRequest(checkedOutBranch.UpstreamRemote, selectedBranch.UpstreamRemote,
+						checkedOutBranch.UpstreamBranch, selectedBranch.UpstreamBranch)
 				},
 			},
 		)
@@ -808,10 +815,337 @@ func (self *BranchesController) createPullRequestMenu(selectedBranch *models.Bra
 	}
 
 	menuItems = append(menuItems, menuItemsForBranch(selectedBranch)...)
+	menuItems = append(menuItems, self.hostingServiceMenuItems(selectedBranch)...)
 
 	return self.c.Menu(types.CreateMenuOptions{Title: fmt.Sprint(self.c.Tr.CreatePullRequestOptions), Items: menuItems})
 }
 
+// Menu items for choosing the remote whose hosting service pull requests are
+// created in, and for managing pull requests (merge requests on GitLab)
+// through the API of that service. If the branch's upstream is on a different
+// remote, e.g. a fork, pull requests are created from there.
+func (self *BranchesController) hostingServiceMenuItems(branch *models.Branch) []*types.MenuItem {
+	remote := self.c.Helpers().Host.GetHostingServiceRemote()
+	section := &types.MenuSection{Title: self.c.Tr.HostingServiceSection}
+
+	// The API tokens are only looked up when an item is pressed, because
+	// that may run commands such as `gh auth token`
+	var noAPIReason *types.DisabledReason
+	if !self.c.Helpers().Host.HasPullRequestAPI(remote) {
+		noAPIReason = &types.DisabledReason{Text: self.c.Tr.PullRequestAPINotSupported}
+	}
+	noUpstreamReason := noAPIReason
+	if noUpstreamReason == nil && !branch.IsTrackingRemote() {
+		noUpstreamReason = &types.DisabledReason{Text: self.c.Tr.PullRequestNoUpstream}
+	}
+
+	// Pipelines run on the remote that the branch is pushed to
+	pipelineDisabledReason := &types.DisabledReason{Text: self.c.Tr.PullRequestNoUpstream}
+	if branch.IsTrackingRemote() {
+		pipelineDisabledReason = nil
+		if !self.c.Helpers().Host.HasPullRequestAPI(branch.UpstreamRemote) {
+			pipelineDisabledReason = &types.DisabledReason{Text: self.c.Tr.PullRequestAPINotSupported}
+		}
+	}
+
+	var noIssueAPIReason *types.DisabledReason
+	if !self.c.Helpers().Host.HasIssueAPI(remote) {
+		noIssueAPIReason = &types.DisabledReason{Text: self.c.Tr.IssueAPINotSupported}
+	}
+
+	var selectRemoteDisabledReason *types.DisabledReason
+	if len(self.c.Model().Remotes) < 2 {
+		selectRemoteDisabledReason = &types.DisabledReason{Text: self.c.Tr.OnlyOneRemote}
+	}
+
+	return []*types.MenuItem{
+		{
+			Label: utils.ResolvePlaceholderString(self.c.Tr.SelectHostingServiceRemote, map[string]string{"remote": remote}),
+			OnPress: func() error {
+				return self.selectHostingServiceRemote(branch, remote)
+			},
+			Key:            'r',
+			DisabledReason: selectRemoteDisabledReason,
+			Section:        section,
+		},
+		{
+			Label: self.c.Tr.CreatePullRequestViaAPI,
+			OnPress: func() error {
+				return self.withPullRequestAPIToken(remote, func(token string) error {
+					return self.promptForTargetBranchNameAndCreatePullRequestViaAPI(branch, remote, token)
+				})
+			},
+			DisabledReason: noUpstreamReason,
+			Section:        section,
+		},
+		{
+			Label: self.c.Tr.ListOpenPullRequests,
+			OnPress: func() error {
+				return self.withPullRequestAPIToken(remote, func(token string) error {
+					return self.listPullRequests(remote, token)
+				})
+			},
+			DisabledReason: noAPIReason,
+			Section:        section,
+		},
+		{
+			Label: self.c.Tr.ShowPipelineStatus,
+			OnPress: func() error {
+				return self.withPullRequestAPIToken(branch.UpstreamRemote, func(token string) error {
+					return self.showPipelineStatus(branch, token)
+				})
+			},
+			DisabledReason: pipelineDisabledReason,
+			Section:        section,
+		},
+		{
+			Label: self.c.Tr.ListAssignedIssues,
+			OnPress: func() error {
+				return self.withAPIToken(func() string {
+					return self.c.Helpers().Host.GetIssueAPIToken(remote)
+				}, func(token string) error {
+					return self.listAssignedIssues(branch, remote, token)
+				})
+			},
+			Key:            'i',
+			DisabledReason: noIssueAPIReason,
+			Section:        section,
+		},
+	}
+}
+
+func (self *BranchesController) withPullRequestAPIToken(remote string, f func(token string) error) error {
+	return self.withAPIToken(func() string {
+		return self.c.Helpers().Host.GetPullRequestAPIToken(remote)
+	}, f)
+}
+
+// Looks up an API token on a worker, since that may run commands, and then
+// calls f with it on the UI thread. Fails if no token is found.
+func (self *BranchesController) withAPIToken(getToken func() string, f func(token string) error) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LookingUpAPITokenStatus, func(gocui.Task) error {
+		token := getToken()
+		if token == "" {
+			return errors.New(self.c.Tr.PullRequestAPINoToken)
+		}
+
+		self.c.OnUIThread(func() error {
+			return f(token)
+		})
+		return nil
+	})
+}
+
+// Lets the user pick the hosting service remote, then reopens the create
+// pull request options menu so that they can continue with the new remote
+func (self *BranchesController) selectHostingServiceRemote(branch *models.Branch, currentRemote string) error {
+	menuItems := lo.Map(self.c.Model().Remotes, func(remote *models.Remote, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label:  remote.Name,
+			Widget: types.MakeMenuRadioButton(remote.Name == currentRemote),
+			OnPress: func() error {
+				self.c.Helpers().Host.SetHostingServiceRemote(remote.Name)
+				return self.handleCreatePullRequestMenu(branch)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.HostingServiceRemoteTitle, Items: menuItems})
+}
+
+func (self *BranchesController) promptForTargetBranchNameAndCreatePullRequestViaAPI(fromBranch *models.Branch, toRemote string, token string) error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               fmt.Sprintf("%s → %s/", fromBranch.ShortUpstreamRefName(), toRemote),
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteBranchesForRemoteSuggestionsFunc(toRemote),
+		HandleConfirm: func(toBranch string) error {
+			// Pre-fill the title with the subject of the branch's tip commit,
+			// and the description with the service's template if the repo has one
+			title, err := self.c.Git().Commit.GetCommitSubject(fromBranch.CommitHash)
+			if err != nil {
+				return err
+			}
+			description := self.c.Helpers().Host.GetPullRequestTemplate(toRemote)
+
+			// Called on a worker, so the token can be looked up there
+			var listIssues func() ([]*hosting_service.Issue, error)
+			if self.c.Helpers().Host.HasIssueAPI(toRemote) {
+				listIssues = func() ([]*hosting_service.Issue, error) {
+					issueToken := self.c.Helpers().Host.GetIssueAPIToken(toRemote)
+					if issueToken == "" {
+						return nil, errors.New(self.c.Tr.PullRequestAPINoToken)
+					}
+					return self.c.Helpers().Host.ListIssues(toRemote, issueToken)
+				}
+			}
+
+			self.c.Helpers().Commits.OpenCommitMessagePanel(
+				&helpers.OpenCommitMessagePanelOpts{
+					CommitIndex:      context.NoCommitIndex,
+					InitialMessage:   title + "\n" + description,
+					SummaryTitle:     self.c.Tr.PullRequestTitleTitle,
+					DescriptionTitle: self.c.Tr.PullRequestDescriptionTitle,
+					PreserveMessage:  false,
+					ListIssues:       listIssues,
+					OnConfirm: func(title string, description string) error {
+						return self.c.WithWaitingStatus(self.c.Tr.CreatingPullRequestStatus, func(gocui.Task) error {
+							self.c.LogAction(self.c.Tr.Actions.CreatePullRequestViaAPI)
+							url, err := self.c.Helpers().Host.CreatePullRequestViaAPI(
+								fromBranch.UpstreamRemote, toRemote, fromBranch.UpstreamBranch, toBranch, title, description, token)
+							if err != nil {
+								return err
+							}
+
+							self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.PullRequestCreated, map[string]string{"url": url}))
+							return nil
+						})
+					},
+				},
+			)
+			return nil
+		},
+	})
+
+	return nil
+}
+
+func (self *BranchesController) listPullRequests(remote string, token string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.FetchingPullRequestsStatus, func(gocui.Task) error {
+		pullRequests, err := self.c.Helpers().Host.ListPullRequests(remote, token)
+		if err != nil {
+			return err
+		}
+		if len(pullRequests) == 0 {
+			return errors.New(self.c.Tr.NoOpenPullRequests)
+		}
+
+		menuItems := lo.Map(pullRequests, func(pr *hosting_service.PullRequest, _ int) *types.MenuItem {
+			title := pr.Title
+			if pr.Draft {
+				title = style.FgBlue.Sprint(self.c.Tr.PullRequestDraft) + " " + title
+			}
+			return &types.MenuItem{
+				LabelColumns: []string{
+					style.FgYellow.Sprint(pr.Reference),
+					title,
+					style.FgCyan.Sprint(pr.Author),
+					fmt.Sprintf("%s → %s", pr.SourceBranch, pr.TargetBranch),
+				},
+				OnPress: func() error {
+					self.c.LogAction(self.c.Tr.Actions.OpenPullRequest)
+					return self.c.OS().OpenLink(pr.URL)
+				},
+			}
+		})
+
+		self.c.OnUIThread(func() error {
+			return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.ListOpenPullRequests, Items: menuItems})
+		})
+		return nil
+	})
+}
+
+func (self *BranchesController) listAssignedIssues(branch *models.Branch, remote string, token string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.FetchingIssuesStatus, func(gocui.Task) error {
+		issues, err := self.c.Helpers().Host.ListAssignedIssues(remote, token)
+		if err != nil {
+			return err
+		}
+		if len(issues) == 0 {
+			return errors.New(self.c.Tr.NoAssignedIssues)
+		}
+
+		menuItems := lo.Map(issues, func(issue *hosting_service.Issue, _ int) *types.MenuItem {
+			return &types.MenuItem{
+				LabelColumns: []string{
+					style.FgYellow.Sprint(issue.Reference),
+					issue.Title,
+					style.FgCyan.Sprint(issue.Author),
+				},
+				OnPress: func() error {
+					return self.issueMenu(branch, remote, token, issue)
+				},
+			}
+		})
+
+		self.c.OnUIThread(func() error {
+			return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.ListAssignedIssues, Items: menuItems})
+		})
+		return nil
+	})
+}
+
+func (self *BranchesController) issueMenu(branch *models.Branch, remote string, token string, issue *hosting_service.Issue) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: issue.Reference + " " + issue.Title,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.StartWorkOnIssue,
+				OnPress: func() error {
+					return self.startWorkOnIssue(branch, remote, token, issue)
+				},
+				Key:     's',
+				Tooltip: self.c.Tr.StartWorkOnIssueTooltip,
+			},
+			{
+				Label: self.c.Tr.OpenIssueInBrowser,
+				OnPress: func() error {
+					self.c.LogAction(self.c.Tr.Actions.OpenIssue)
+					return self.c.OS().OpenLink(issue.URL)
+				},
+				Key: 'o',
+			},
+		},
+	})
+}
+
+// Creates a branch for the issue off the given branch, then assigns and
+// labels the issue if configured to
+func (self *BranchesController) startWorkOnIssue(branch *models.Branch, remote string, token string, issue *hosting_service.Issue) error {
+	branchName, err := self.c.Helpers().Host.GetIssueBranchName(issue)
+	if err != nil {
+		return err
+	}
+
+	return self.c.Helpers().Refs.NewBranchAndThen(branch.FullRefName(), branch.RefName(), branchName, func(string) {
+		issuesConfig := self.c.UserConfig().Issues
+		if !issuesConfig.AssignOnStartWork && issuesConfig.LabelOnStartWork == "" {
+			return
+		}
+
+		_ = self.c.WithWaitingStatus(self.c.Tr.UpdatingIssueStatus, func(gocui.Task) error {
+			self.c.LogAction(self.c.Tr.Actions.StartWorkOnIssue)
+			return self.c.Helpers().Host.StartWorkOnIssue(remote, issue, token)
+		})
+	})
+}
+
+func (self *BranchesController) showPipelineStatus(branch *models.Branch, token string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.FetchingPipelineStatusStatus, func(gocui.Task) error {
+		status, err := self.c.Helpers().Host.GetPipelineStatus(branch.UpstreamRemote, branch.UpstreamBranch, token)
+		if err != nil {
+			return err
+		}
+		if status == nil {
+			return errors.New(self.c.Tr.NoPipelinesForBranch)
+		}
+
+		self.c.OnUIThread(func() error {
+			self.c.Confirm(types.ConfirmOpts{
+				Title: self.c.Tr.ShowPipelineStatus,
+				Prompt: utils.ResolvePlaceholderString(self.c.Tr.PipelineStatusPrompt, map[string]string{
+					"branch": branch.ShortUpstreamRefName(),
+					"status": status.Status,
+				}),
+				HandleConfirm: func() error {
+					return self.c.OS().OpenLink(status.URL)
+				},
+			})
+			return nil
+		})
+		return nil
+	})
+}
+
 func (self *BranchesController) promptForTargetBranchNameAndCreatePullRequest(fromBranch *models.Branch, toRemote string) error {
 	remoteDoesNotExist := lo.NoneBy(self.c.Model().Remotes, func(remote *models.Remote) bool {
 		return remote.Name == toRemote
@@ -825,15 +1159,15 @@ func (self *BranchesController) promptForTargetBranchNameAndCreatePullRequest(fr
 		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteBranchesForRemoteSuggestionsFunc(toRemote),
 		HandleConfirm: func(toBranch string) error {
 			self.c.Log.Debugf("PR will target branch '%s' on remote '%s'", toBranch, toRemote)
-			return self.createPullRequest(fromBranch.UpstreamBranch, toBranch)
+			return self.createPullRequest(fromBranch.UpstreamRemote, toRemote, fromBranch.UpstreamBranch, toBranch)
 		},
 	})
 
 	return nil
 }
 
-func (self *BranchesController) createPullRequest(from string, to string) error {
-	url, err := self.c.Helpers().Host.GetPullRequestURL(from, to)
+func (self *BranchesController) createPullRequest(fromRemote string, toRemote string, from string, to string) error {
+	url, err := self.c.Helpers().Host.GetPullRequestURL(fromRemote, toRemote, from, to)
 	if err != nil {
 		return err
 	}
@@ -865,11 +1199,10 @@ func (self *BranchesController) branchesAreReal(selectedBranches []*models.Branc
 	return nil
 }
 
-func (self *BranchesController) notMergingIntoYourself(branch *models.Branch) *types.DisabledReason {
-	selectedBranchName := branch.Name
+func (self *BranchesController) noneMergingIntoYourself(branches []*models.Branch) *types.DisabledReason {
 	checkedOutBranch := self.c.Helpers().Refs.GetCheckedOutRef().Name
 
-	if checkedOutBranch == selectedBranchName {
+	if lo.SomeBy(branches, func(branch *models.Branch) bool { return branch.Name == checkedOutBranch }) {
 		return &types.DisabledReason{Text: self.c.Tr.CantMergeBranchIntoItself}
 	}
 
@@ -1,7 +1,12 @@
 package controllers
 
 import (
+	"errors"
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type CommandLogController struct {
@@ -21,11 +26,92 @@ func NewCommandLogController(
 }
 
 func (self *CommandLogController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
-	bindings := []*types.Binding{}
+	bindings := []*types.Binding{
+		{
+			Key:             opts.GetKey(opts.Config.Universal.GoInto),
+			Handler:         self.openLoggedCommandsMenu,
+			Description:     self.c.Tr.OpenLoggedCommandsMenu,
+			Tooltip:         self.c.Tr.OpenLoggedCommandsMenuTooltip,
+			OpensMenu:       true,
+			DisplayOnScreen: true,
+		},
+	}
 
 	return bindings
 }
 
+func (self *CommandLogController) openLoggedCommandsMenu() error {
+	loggedCommands := self.c.State().GetLoggedCommands()
+	if len(loggedCommands) == 0 {
+		return errors.New(self.c.Tr.NoLoggedCommands)
+	}
+
+	// Most recent first, and each command only once
+	commands := lo.UniqBy(lo.Reverse(loggedCommands), func(command types.LoggedCommand) string {
+		return command.Command
+	})
+	menuItems := lo.Map(commands, func(command types.LoggedCommand, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label: strings.ReplaceAll(command.Command, "\n", " "),
+			OnPress: func() error {
+				return self.openLoggedCommandMenu(command)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.LoggedCommands,
+		Items: menuItems,
+	})
+}
+
+func (self *CommandLogController) openLoggedCommandMenu(command types.LoggedCommand) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: command.Command,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.CopyToClipboardMenu,
+				Key:   'c',
+				OnPress: func() error {
+					return self.copyCommand(command.Command)
+				},
+			},
+			{
+				Label: self.c.Tr.RerunCommand,
+				Key:   'r',
+				OnPress: func() error {
+					return self.rerunCommand(command)
+				},
+			},
+		},
+	})
+}
+
+func (self *CommandLogController) copyCommand(command string) error {
+	if err := self.c.OS().CopyToClipboard(command); err != nil {
+		return err
+	}
+
+	self.c.Toast(self.c.Tr.CommandCopiedToClipboard)
+	return nil
+}
+
+// We run the command with the arguments it was logged with rather than parsing
+// the logged string, since the latter doesn't quote arguments reliably
+func (self *CommandLogController) rerunCommand(command types.LoggedCommand) error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.RerunCommand,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.RerunCommandPrompt, map[string]string{
+			"command": command.Command,
+		}),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.RerunCommand)
+			return self.c.RunSubprocessAndRefresh(self.c.OS().Cmd.New(command.Args))
+		},
+	})
+	return nil
+}
+
 func (self *CommandLogController) GetOnFocusLost() func(types.OnFocusLostOpts) {
 	return func(types.OnFocusLostOpts) {
 		self.c.Views().Extras.Autoscroll = true
@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/filtering"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Lets the user narrow down the commits by author, date range and message.
+// Unlike the filtering menu, these filters are combined with each other and
+// with the path filter rather than replacing it.
+type CommitFiltersMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *CommitFiltersMenuAction) Call() error {
+	filter := &self.c.Modes().Filtering
+
+	promptItem := func(label string, value string, key types.Key, prompt func()) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{label, style.FgCyan.Sprint(value)},
+			OnPress: func() error {
+				prompt()
+				return nil
+			},
+			Key:     key,
+			Tooltip: self.c.Tr.CommitFilterPromptTooltip,
+		}
+	}
+
+	menuItems := []*types.MenuItem{
+		promptItem(self.c.Tr.CommitFilterAuthor, filter.GetAuthor(), 'a', func() {
+			self.c.Prompt(types.PromptOpts{
+				Title:               self.c.Tr.EnterAuthor,
+				InitialContent:      filter.GetAuthor(),
+				FindSuggestionsFunc: self.c.Helpers().Suggestions.GetAuthorsSuggestionsFunc(),
+				HandleConfirm: func(response string) error {
+					return self.apply(func(f *filtering.Filtering) { f.SetAuthor(strings.TrimSpace(response)) })
+				},
+			})
+		}),
+		promptItem(self.c.Tr.CommitFilterSince, filter.GetSince(), 's', func() {
+			self.c.Prompt(types.PromptOpts{
+				Title:          self.c.Tr.EnterSinceDate,
+				InitialContent: filter.GetSince(),
+				HandleConfirm: func(response string) error {
+					return self.apply(func(f *filtering.Filtering) { f.SetSince(strings.TrimSpace(response)) })
+				},
+			})
+		}),
+		promptItem(self.c.Tr.CommitFilterUntil, filter.GetUntil(), 'u', func() {
+			self.c.Prompt(types.PromptOpts{
+				Title:          self.c.Tr.EnterUntilDate,
+				InitialContent: filter.GetUntil(),
+				HandleConfirm: func(response string) error {
+					return self.apply(func(f *filtering.Filtering) { f.SetUntil(strings.TrimSpace(response)) })
+				},
+			})
+		}),
+		promptItem(self.c.Tr.CommitFilterMessage, filter.GetGrep(), 'g', func() {
+			self.c.Prompt(types.PromptOpts{
+				Title:          self.c.Tr.EnterCommitMessagePattern,
+				InitialContent: filter.GetGrep(),
+				HandleConfirm: func(response string) error {
+					return self.apply(func(f *filtering.Filtering) { f.SetGrep(strings.TrimSpace(response)) })
+				},
+			})
+		}),
+		{
+			LabelColumns: []string{
+				self.c.Tr.CommitFilterNoMerges,
+				style.FgCyan.Sprint(lo.Ternary(filter.GetNoMerges(), self.c.Tr.CommitFilterOn, self.c.Tr.CommitFilterOff)),
+			},
+			OnPress: func() error {
+				return self.apply(func(f *filtering.Filtering) { f.SetNoMerges(!f.GetNoMerges()) })
+			},
+			Key: 'm',
+		},
+		{
+			Label: self.c.Tr.ClearCommitFilters,
+			OnPress: func() error {
+				return self.apply(func(f *filtering.Filtering) { f.ResetCommitFilters() })
+			},
+			Key:     'c',
+			Tooltip: self.c.Tr.ClearCommitFiltersTooltip,
+			DisabledReason: lo.Ternary(!filter.HasCommitFilters(),
+				&types.DisabledReason{Text: self.c.Tr.NoCommitFilters}, nil),
+		},
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.CommitFilters, Items: menuItems})
+}
+
+func (self *CommitFiltersMenuAction) apply(update func(*filtering.Filtering)) error {
+	filter := &self.c.Modes().Filtering
+	wasActive := filter.Active()
+	update(filter)
+
+	if !filter.Active() {
+		if wasActive {
+			return self.c.Helpers().Mode.ClearFiltering()
+		}
+		return nil
+	}
+
+	if !wasActive {
+		filter.SetSelectedCommitHash(self.c.Contexts().LocalCommits.GetSelectedCommitHash())
+	}
+
+	self.c.Refresh(types.RefreshOptions{Scope: helpers.ScopesToRefreshWhenFilteringModeChanges(), Then: func() {
+		self.c.Contexts().LocalCommits.SetSelection(0)
+		self.c.Contexts().LocalCommits.HandleFocus(types.OnFocusOpts{})
+	}})
+
+	return nil
+}
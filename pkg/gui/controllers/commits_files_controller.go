@@ -12,6 +12,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/constants"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -58,6 +59,13 @@ func (self *CommitFilesController) GetKeybindings(opts types.KeybindingsOpts) []
 			Tooltip:           self.c.Tr.CheckoutCommitFileTooltip,
 			DisplayOnScreen:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.CommitFiles.ApplyFilesFromStash),
+			Handler:           self.withItems(self.applyFromStash),
+			GetDisabledReason: self.require(self.itemsSelected(self.canApplyFromStash)),
+			Description:       self.c.Tr.ApplyFilesFromStash,
+			Tooltip:           self.c.Tr.ApplyFilesFromStashTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Remove),
 			Handler:           self.withItems(self.discard),
@@ -148,20 +156,47 @@ func (self *CommitFilesController) GetOnRenderToMain() func() {
 			return
 		}
 
-		from, to := self.context().GetFromAndToForDiff()
+		from, to := self.context().GetFromAndToForNodeDiff(node)
 		from, reverse := self.c.Modes().Diffing.GetFromAndReverseArgsForDiff(from)
 
 		cmdObj := self.c.Git().WorkingTree.ShowFileDiffCmdObj(from, to, reverse, node.GetPath(), false)
-		task := types.NewRunPtyTask(cmdObj.GetCmd())
-
-		self.c.RenderToMainViews(types.RefreshMainOpts{
+		opts := types.RefreshMainOpts{
 			Pair: self.c.MainViewPairs().Normal,
 			Main: &types.ViewUpdateOpts{
 				Title:    self.c.Tr.Patch,
 				SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
-				Task:     task,
+				Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
 			},
 			Secondary: secondaryPatchPanelUpdateOpts(self.c),
+		}
+
+		var mainCheck *helpers.BinaryFileCheck
+		if node.File != nil {
+			path := node.GetPath()
+			oldVersion := helpers.FileVersionSource{Ref: from}
+			newVersion := helpers.FileVersionSource{Ref: to}
+			if reverse {
+				oldVersion, newVersion = newVersion, oldVersion
+			}
+			mainCheck = &helpers.BinaryFileCheck{
+				Path: path,
+				IsBinary: func() bool {
+					return self.c.Git().WorkingTree.IsBinaryFileInDiff(from, to, reverse, path)
+				},
+				OldVersion: oldVersion,
+				NewVersion: newVersion,
+			}
+		}
+
+		self.c.Helpers().Diff.RenderWithBinaryFileInfo(opts, mainCheck, nil, func() bool {
+			selectedNode := self.context().GetSelected()
+			if self.c.Context().CurrentSide() != self.context() || selectedNode == nil ||
+				selectedNode.GetPath() != node.GetPath() {
+				return false
+			}
+			selectedFrom, selectedTo := self.context().GetFromAndToForNodeDiff(selectedNode)
+			selectedFrom, selectedReverse := self.c.Modes().Diffing.GetFromAndReverseArgsForDiff(selectedFrom)
+			return selectedFrom == from && selectedTo == to && selectedReverse == reverse
 		})
 	}
 }
@@ -269,22 +304,30 @@ func (self *CommitFilesController) openCopyMenu() error {
 		Key: 'c',
 	}
 
+	items := []*types.MenuItem{
+		copyNameItem,
+		copyRelativePathItem,
+		copyAbsolutePathItem,
+		copyFileDiffItem,
+		copyAllDiff,
+		copyFileContentItem,
+	}
+	if node != nil {
+		for _, item := range self.c.Helpers().CopyTemplates.FileMenuItems(node.GetPath()) {
+			item.DisabledReason = self.require(self.singleItemSelected())()
+			items = append(items, item)
+		}
+	}
+
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.CopyToClipboardMenu,
-		Items: []*types.MenuItem{
-			copyNameItem,
-			copyRelativePathItem,
-			copyAbsolutePathItem,
-			copyFileDiffItem,
-			copyAllDiff,
-			copyFileContentItem,
-		},
+		Items: items,
 	})
 }
 
 func (self *CommitFilesController) checkout(node *filetree.CommitFileNode) error {
 	self.c.LogAction(self.c.Tr.Actions.CheckoutFile)
-	_, to := self.context().GetFromAndToForDiff()
+	_, to := self.context().GetFromAndToForNodeDiff(node)
 	if err := self.c.Git().WorkingTree.CheckoutFile(to, node.GetPath()); err != nil {
 		return err
 	}
@@ -293,6 +336,43 @@ func (self *CommitFilesController) checkout(node *filetree.CommitFileNode) error
 	return nil
 }
 
+func (self *CommitFilesController) applyFromStash(selectedNodes []*filetree.CommitFileNode) error {
+	stashEntry := self.context().GetRef().(*models.StashEntry)
+
+	paths := []string{}
+	untrackedPaths := []string{}
+	for _, node := range normalisedSelectedCommitFileNodes(selectedNodes) {
+		_ = node.ForEachFile(func(file *models.CommitFile) error {
+			if file.Untracked {
+				untrackedPaths = append(untrackedPaths, file.GetPath())
+			} else {
+				paths = append(paths, file.GetPath())
+			}
+			return nil
+		})
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.ApplyStashFiles)
+	patch, err := self.c.Git().Stash.FilesDiff(stashEntry.Index, paths, untrackedPaths)
+	if err != nil {
+		return err
+	}
+	if err := self.c.Git().Patch.ApplyPatch(patch, git_commands.ApplyPatchOpts{}); err != nil {
+		return err
+	}
+
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+	return nil
+}
+
+func (self *CommitFilesController) canApplyFromStash(_ []*filetree.CommitFileNode) *types.DisabledReason {
+	if _, ok := self.context().GetRef().(*models.StashEntry); !ok {
+		return &types.DisabledReason{Text: self.c.Tr.CanOnlyApplyFilesFromStash}
+	}
+
+	return nil
+}
+
 func (self *CommitFilesController) discard(selectedNodes []*filetree.CommitFileNode) error {
 	parentContext := self.c.Context().Current().GetParentContext()
 	if parentContext == nil || parentContext.GetKey() != context.LOCAL_COMMITS_CONTEXT_KEY {
@@ -503,6 +583,7 @@ func (self *CommitFilesController) enterCommitFile(node *filetree.CommitFileNode
 
 func (self *CommitFilesController) handleToggleCommitFileDirCollapsed(node *filetree.CommitFileNode) error {
 	self.context().CommitFileTreeViewModel.ToggleCollapsed(node.GetInternalPath())
+	self.c.Helpers().Files.SaveCollapsedDirs()
 
 	self.c.PostRefreshUpdate(self.context())
 
@@ -519,6 +600,7 @@ func (self *CommitFilesController) toggleTreeView() error {
 
 func (self *CommitFilesController) collapseAll() error {
 	self.context().CommitFileTreeViewModel.CollapseAll()
+	self.c.Helpers().Files.SaveCollapsedDirs()
 
 	self.c.PostRefreshUpdate(self.context())
 
@@ -527,6 +609,7 @@ func (self *CommitFilesController) collapseAll() error {
 
 func (self *CommitFilesController) expandAll() error {
 	self.context().CommitFileTreeViewModel.ExpandAll()
+	self.c.Helpers().Files.SaveCollapsedDirs()
 
 	self.c.PostRefreshUpdate(self.context())
 
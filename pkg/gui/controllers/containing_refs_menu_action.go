@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Lists the branches and tags whose history contains a given commit, and lets
+// the user check out or diff against any of them.
+type ContainingRefsMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *ContainingRefsMenuAction) Call(commit *models.Commit) error {
+	hash := commit.Hash()
+
+	return self.c.WithWaitingStatus(self.c.Tr.FindingContainingRefsStatus, func(gocui.Task) error {
+		localBranches, remoteBranches, err := self.c.Git().Branch.BranchesContaining(hash)
+		if err != nil {
+			return err
+		}
+
+		tags, err := self.c.Git().Tag.TagsContaining(hash)
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.showMenu(commit, localBranches, remoteBranches, tags)
+		})
+
+		return nil
+	})
+}
+
+func (self *ContainingRefsMenuAction) showMenu(commit *models.Commit, localBranches []string, remoteBranches []string, tags []string) error {
+	if len(localBranches) == 0 && len(remoteBranches) == 0 && len(tags) == 0 {
+		return errors.New(self.c.Tr.NoRefsContainCommit)
+	}
+
+	menuItems := []*types.MenuItem{}
+	addItems := func(names []string, kind string, kindStyle style.TextStyle, checkout func(name string) error) {
+		for _, name := range names {
+			menuItems = append(menuItems, &types.MenuItem{
+				LabelColumns: []string{kindStyle.Sprint(kind), name},
+				OnPress: func() error {
+					return self.showRefMenu(name, func() error { return checkout(name) })
+				},
+			})
+		}
+	}
+
+	addItems(localBranches, self.c.Tr.ContainingRefLocalBranch, style.FgGreen, func(name string) error {
+		self.c.LogAction(self.c.Tr.Actions.CheckoutBranch)
+		return self.c.Helpers().Refs.CheckoutRef(name, types.CheckoutRefOptions{})
+	})
+	addItems(remoteBranches, self.c.Tr.ContainingRefRemoteBranch, style.FgRed, func(name string) error {
+		_, localBranchName, found := self.c.Helpers().Refs.ParseRemoteBranchName(name)
+		if !found {
+			localBranchName = name
+		}
+		return self.c.Helpers().Refs.CheckoutRemoteBranch(name, localBranchName)
+	})
+	addItems(tags, self.c.Tr.ContainingRefTag, style.FgMagenta, func(name string) error {
+		self.c.LogAction(self.c.Tr.Actions.CheckoutTag)
+		return self.c.Helpers().Refs.CheckoutRef("refs/tags/"+name, types.CheckoutRefOptions{})
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.ContainingRefsTitle,
+			map[string]string{"hash": commit.ShortHash()}),
+		Items: menuItems,
+	})
+}
+
+func (self *ContainingRefsMenuAction) showRefMenu(name string, checkout func() error) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: name,
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.Checkout,
+				OnPress: checkout,
+				Key:     'c',
+			},
+			{
+				Label: fmt.Sprintf("%s %s", self.c.Tr.Diff, name),
+				OnPress: func() error {
+					self.c.Modes().Diffing.Ref = name
+					self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+					return nil
+				},
+				Key: 'd',
+			},
+		},
+	})
+}
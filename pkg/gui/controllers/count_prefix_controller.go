@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"strconv"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// This controller is for all side panel lists. It lets the user type a count
+// before a repeatable action when the countPrefixes config is enabled.
+
+var _ types.IController = &CountPrefixController{}
+
+type CountPrefixController struct {
+	baseController
+	c       *ControllerCommon
+	context types.Context
+}
+
+func NewCountPrefixController(
+	c *ControllerCommon,
+	context types.Context,
+) *CountPrefixController {
+	return &CountPrefixController{
+		baseController: baseController{},
+		c:              c,
+		context:        context,
+	}
+}
+
+func (self *CountPrefixController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	if !self.c.UserConfig().Gui.CountPrefixes {
+		return nil
+	}
+
+	bindings := []*types.Binding{}
+	for digit := range 10 {
+		bindings = append(bindings, &types.Binding{
+			Key: opts.GetKey(strconv.Itoa(digit)),
+			Handler: func() error {
+				self.c.Helpers().CountPrefix.AddDigit(digit)
+				return nil
+			},
+		})
+	}
+
+	return bindings
+}
+
+func (self *CountPrefixController) Context() types.Context {
+	return self.context
+}
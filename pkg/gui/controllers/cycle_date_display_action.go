@@ -0,0 +1,35 @@
+package controllers
+
+import (
+	"github.com/samber/lo"
+)
+
+type CycleDateDisplayAction struct {
+	c *ControllerCommon
+}
+
+// 'default' isn't part of the cycle, because it shows some panels one way and
+// some the other
+var dateDisplays = []string{"relative", "absolute", "custom"}
+
+func (self *CycleDateDisplayAction) Call() error {
+	guiConfig := &self.c.UserConfig().Gui
+	index := lo.IndexOf(dateDisplays, guiConfig.DateDisplay)
+	guiConfig.DateDisplay = dateDisplays[(index+1)%len(dateDisplays)]
+
+	self.c.PostRefreshUpdate(self.c.Contexts().LocalCommits)
+	self.c.PostRefreshUpdate(self.c.Contexts().SubCommits)
+	self.c.PostRefreshUpdate(self.c.Contexts().ReflogCommits)
+	self.c.PostRefreshUpdate(self.c.Contexts().Stash)
+	self.c.PostRefreshUpdate(self.c.Contexts().Branches)
+
+	switch guiConfig.DateDisplay {
+	case "relative":
+		self.c.Toast(self.c.Tr.ShowingRelativeDates)
+	case "absolute":
+		self.c.Toast(self.c.Tr.ShowingAbsoluteDates)
+	default:
+		self.c.Toast(self.c.Tr.ShowingCustomDates)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Lets the user change the options that are used for rendering diffs for the
+// rest of the session
+type DiffOptionsMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *DiffOptionsMenuAction) Call() error {
+	gitConfig := &self.c.UserConfig().Git
+
+	var ignoreDisabledReason *types.DisabledReason
+	if self.isInPatchExplorer() {
+		// Diffs that we apply as patches need to be complete
+		ignoreDisabledReason = &types.DisabledReason{Text: self.c.Tr.IgnoreWhitespaceNotSupportedHere}
+	}
+
+	menuItems := []*types.MenuItem{
+		{
+			Label:   self.c.Tr.IgnoreWhitespace,
+			Tooltip: self.c.Tr.ToggleWhitespaceInDiffViewTooltip,
+			Widget:  types.MakeMenuCheckBox(gitConfig.IgnoreWhitespaceInDiffView),
+			OnPress: func() error {
+				return (&ToggleWhitespaceAction{c: self.c}).Call()
+			},
+			DisabledReason: ignoreDisabledReason,
+			Key:            'w',
+		},
+		{
+			Label:   self.c.Tr.IgnoreBlankLines,
+			Tooltip: self.c.Tr.IgnoreBlankLinesTooltip,
+			Widget:  types.MakeMenuCheckBox(gitConfig.IgnoreBlankLinesInDiffView),
+			OnPress: func() error {
+				gitConfig.IgnoreBlankLinesInDiffView = !gitConfig.IgnoreBlankLinesInDiffView
+				self.rerender()
+				return nil
+			},
+			DisabledReason: ignoreDisabledReason,
+			Key:            'b',
+		},
+		{
+			Label:   self.c.Tr.ColorMovedLines,
+			Tooltip: self.c.Tr.ToggleMovedLinesInDiffViewTooltip,
+			Widget:  types.MakeMenuCheckBox(self.c.Git().Config.GetColorMoved()),
+			OnPress: func() error {
+				return (&ToggleMovedLinesAction{c: self.c}).Call()
+			},
+			Key: 'm',
+		},
+	}
+
+	var algorithmDisabledReason *types.DisabledReason
+	if self.c.Git().Patch.PatchBuilder.Active() {
+		algorithmDisabledReason = &types.DisabledReason{Text: self.c.Tr.CantChangeDiffAlgorithmError}
+	}
+
+	algorithmSection := &types.MenuSection{Title: self.c.Tr.DiffAlgorithm}
+	for _, algorithm := range []string{"", "myers", "minimal", "patience", "histogram"} {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:   lo.Ternary(algorithm == "", self.c.Tr.DefaultDiffAlgorithm, algorithm),
+			Tooltip: lo.Ternary(algorithm == "", self.c.Tr.DefaultDiffAlgorithmTooltip, ""),
+			Widget:  types.MakeMenuRadioButton(gitConfig.DiffAlgorithm == algorithm),
+			OnPress: func() error {
+				gitConfig.DiffAlgorithm = algorithm
+				self.rerender()
+				return nil
+			},
+			DisabledReason: algorithmDisabledReason,
+			Section:        algorithmSection,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.DiffOptionsTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *DiffOptionsMenuAction) isInPatchExplorer() bool {
+	return lo.Contains(
+		[]types.ContextKey{
+			context.STAGING_MAIN_CONTEXT_KEY,
+			context.STAGING_SECONDARY_CONTEXT_KEY,
+			context.PATCH_BUILDING_MAIN_CONTEXT_KEY,
+		},
+		self.c.Context().Current().GetKey(),
+	)
+}
+
+func (self *DiffOptionsMenuAction) rerender() {
+	switch self.c.Context().Current().GetKey() {
+	// the staging and patch building contexts need to reload their diffs
+	case context.PATCH_BUILDING_MAIN_CONTEXT_KEY:
+		self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.PATCH_BUILDING}})
+	case context.STAGING_MAIN_CONTEXT_KEY, context.STAGING_SECONDARY_CONTEXT_KEY:
+		self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.STAGING}})
+	default:
+		self.c.Context().CurrentSide().HandleFocus(types.OnFocusOpts{})
+	}
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -183,6 +185,28 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Description: self.c.Tr.OpenMergeTool,
 			Tooltip:     self.c.Tr.OpenMergeToolTooltip,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.OpenMergeToolForFile),
+			Handler:           self.withItem(self.openMergeToolForFile),
+			GetDisabledReason: self.require(self.singleItemSelected(self.isConflictedFile)),
+			Description:       self.c.Tr.OpenMergeToolForFile,
+			Tooltip:           self.c.Tr.OpenMergeToolForFileTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.ResolveAllConflicts),
+			Handler:           self.createResolveAllConflictsMenu,
+			GetDisabledReason: self.require(self.anyConflictedFiles),
+			Description:       self.c.Tr.ResolveAllConflicts,
+			Tooltip:           self.c.Tr.ResolveAllConflictsTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ViewRerereOptions),
+			Handler:     self.openRerereMenu,
+			Description: self.c.Tr.ViewRerereOptions,
+			Tooltip:     self.c.Tr.ViewRerereOptionsTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Files.Fetch),
 			Handler:     self.fetch,
@@ -203,6 +227,26 @@ func (self *FilesController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Tooltip:           self.c.Tr.ExpandAllTooltip,
 			GetDisabledReason: self.require(self.isInTreeMode),
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.IntentToAdd),
+			Handler:           self.withItems(self.intentToAdd),
+			GetDisabledReason: self.require(self.itemsSelected(self.canIntentToAdd)),
+			Description:       self.c.Tr.IntentToAdd,
+			Tooltip:           self.c.Tr.IntentToAddTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Files.ToggleExecutable),
+			Handler:           self.withItem(self.toggleExecutable),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canToggleExecutable)),
+			Description:       self.c.Tr.ToggleExecutable,
+			Tooltip:           self.c.Tr.ToggleExecutableTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.SortOrder),
+			Handler:     self.createSortMenu,
+			Description: self.c.Tr.SortOrder,
+			OpensMenu:   true,
+		},
 	}
 }
 
@@ -279,6 +323,21 @@ func (self *FilesController) GetOnRenderToMain() func() {
 
 			self.c.Helpers().MergeConflicts.ResetMergeState()
 
+			if node.File != nil && node.File.HiddenFromStatus() {
+				message := utils.ResolvePlaceholderString(self.c.Tr.FileHiddenFromStatusWarning, map[string]string{
+					"flags": strings.Join(node.File.IndexFlagNames(), " and "),
+					"key":   self.c.UserConfig().Keybinding.Files.IgnoreFile,
+				})
+				self.c.RenderToMainViews(types.RefreshMainOpts{
+					Pair: self.c.MainViewPairs().Normal,
+					Main: &types.ViewUpdateOpts{
+						Title: self.c.Tr.DiffTitle,
+						Task:  types.NewRenderStringTask(message),
+					},
+				})
+				return
+			}
+
 			split := self.c.UserConfig().Gui.SplitDiff == "always" || (node.GetHasUnstagedChanges() && node.GetHasStagedChanges())
 			mainShowsStaged := !split && node.GetHasStagedChanges()
 
@@ -287,14 +346,25 @@ func (self *FilesController) GetOnRenderToMain() func() {
 			if mainShowsStaged {
 				title = self.c.Tr.StagedChanges
 			}
+			var task types.UpdateTask = types.NewRunPtyTask(cmdObj.GetCmd())
+			if node.File != nil && !self.c.Git().Status.WorkingTreeState().None() {
+				// Finding out whether rerere resolved the file takes a few git
+				// calls, so we do it in the task rather than on the UI thread
+				file := node.File
+				task = types.NewRunPtyTaskWithPrefixFn(cmdObj.GetCmd(), func() string {
+					return self.rerereResolvedNote(file)
+				})
+			}
 			refreshOpts := types.RefreshMainOpts{
 				Pair: self.c.MainViewPairs().Normal,
 				Main: &types.ViewUpdateOpts{
-					Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
+					Task:     task,
 					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
 					Title:    title,
 				},
 			}
+			mainCheck := self.binaryFileCheck(node, mainShowsStaged)
+			var secondaryCheck *helpers.BinaryFileCheck
 
 			if split {
 				cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(node, false, true)
@@ -309,9 +379,15 @@ func (self *FilesController) GetOnRenderToMain() func() {
 					SubTitle: self.c.Helpers().Diff.IgnoringWhitespaceSubTitle(),
 					Task:     types.NewRunPtyTask(cmdObj.GetCmd()),
 				}
+				secondaryCheck = self.binaryFileCheck(node, true)
 			}
 
-			self.c.RenderToMainViews(refreshOpts)
+			path := node.GetPath()
+			self.c.Helpers().Diff.RenderWithBinaryFileInfo(refreshOpts, mainCheck, secondaryCheck, func() bool {
+				selectedNode := self.context().GetSelected()
+				return self.c.Context().CurrentSide() == self.context() &&
+					selectedNode != nil && selectedNode.GetPath() == path
+			})
 		})
 	}
 }
@@ -344,12 +420,14 @@ var stageStatusMap = map[string]string{
 	" A": "A ",
 	"AM": "A ",
 	"MD": "D ",
+	" R": "R ",
 }
 
 var unstageStatusMap = map[string]string{
 	"A ": "??",
 	"M ": " M",
 	"D ": " D",
+	"R ": " R",
 }
 
 func (self *FilesController) optimisticStage(file *models.File) bool {
@@ -428,7 +506,28 @@ func (self *FilesController) pressWithLock(selectedNodes []*filetree.FileNode) e
 		})
 	}
 
-	selectedNodes = normalisedSelectedNodes(selectedNodes)
+	// A rename is staged or unstaged as one unit, so we pass both the new and
+	// the old path to git. When staging, we only do this for renames in the
+	// working tree; for a staged rename the old path is already gone from the
+	// index and the working tree, so git add would fail on it.
+	toStagePaths := func(nodes []*filetree.FileNode) []string {
+		return lo.FlatMap(nodes, func(node *filetree.FileNode, _ int) []string {
+			if node.File != nil && node.File.IsRename() && node.File.ShortStatus[1] == 'R' {
+				return node.File.Names()
+			}
+			return []string{node.GetPath()}
+		})
+	}
+	toUnstagePaths := func(nodes []*filetree.FileNode) []string {
+		return lo.FlatMap(nodes, func(node *filetree.FileNode, _ int) []string {
+			if node.File != nil {
+				return node.File.Names()
+			}
+			return []string{node.GetPath()}
+		})
+	}
+
+	selectedNodes = self.expandDirsIfFiltering(normalisedSelectedNodes(selectedNodes))
 
 	// If any node has unstaged changes, we'll stage all the selected unstaged nodes (staging already staged deleted files/folders would fail).
 	// Otherwise, we unstage all the selected nodes.
@@ -437,17 +536,20 @@ func (self *FilesController) pressWithLock(selectedNodes []*filetree.FileNode) e
 	if len(unstagedSelectedNodes) > 0 {
 		var extraArgs []string
 
-		if self.context().GetFilter() == filetree.DisplayTracked {
+		if self.context().GetStatusFilter() == filetree.DisplayTracked {
 			extraArgs = []string{"-u"}
 		}
 
 		self.c.LogAction(self.c.Tr.Actions.StageFile)
 
+		// must be computed before the optimistic change updates the statuses
+		paths := toStagePaths(unstagedSelectedNodes)
+
 		if err := self.optimisticChange(unstagedSelectedNodes, self.optimisticStage); err != nil {
 			return err
 		}
 
-		if err := self.c.Git().WorkingTree.StageFiles(toPaths(unstagedSelectedNodes), extraArgs); err != nil {
+		if err := self.c.Git().WorkingTree.StageFiles(paths, extraArgs); err != nil {
 			return err
 		}
 	} else {
@@ -471,7 +573,7 @@ func (self *FilesController) pressWithLock(selectedNodes []*filetree.FileNode) e
 		}
 
 		if len(trackedNodes) > 0 {
-			if err := self.c.Git().WorkingTree.UnstageTrackedFiles(toPaths(trackedNodes)); err != nil {
+			if err := self.c.Git().WorkingTree.UnstageTrackedFiles(toUnstagePaths(trackedNodes)); err != nil {
 				return err
 			}
 		}
@@ -491,6 +593,116 @@ func (self *FilesController) press(nodes []*filetree.FileNode) error {
 	return nil
 }
 
+func (self *FilesController) intentToAdd(nodes []*filetree.FileNode) error {
+	paths := untrackedFilePaths(nodes)
+
+	self.c.LogAction(self.c.Tr.Actions.IntentToAdd)
+	if err := self.c.Git().WorkingTree.StageFiles(paths, []string{"-N"}); err != nil {
+		return err
+	}
+
+	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+	return nil
+}
+
+func (self *FilesController) canIntentToAdd(nodes []*filetree.FileNode) *types.DisabledReason {
+	if len(untrackedFilePaths(nodes)) == 0 {
+		return &types.DisabledReason{Text: self.c.Tr.OnlyUntrackedFilesCanBeIntentToAdd}
+	}
+
+	return nil
+}
+
+func untrackedFilePaths(nodes []*filetree.FileNode) []string {
+	paths := []string{}
+	for _, node := range normalisedSelectedNodes(nodes) {
+		_ = node.ForEachFile(func(file *models.File) error {
+			if file.ShortStatus == "??" {
+				paths = append(paths, file.Path)
+			}
+			return nil
+		})
+	}
+
+	return paths
+}
+
+func (self *FilesController) toggleExecutable(node *filetree.FileNode) error {
+	self.c.LogAction(self.c.Tr.Actions.ToggleExecutable)
+	executable, err := self.c.Git().WorkingTree.ToggleExecutable(node.GetPath())
+	if err != nil {
+		return err
+	}
+
+	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+
+	message := self.c.Tr.FileIsNoLongerExecutable
+	if executable {
+		message = self.c.Tr.FileIsNowExecutable
+	}
+	self.c.Toast(utils.ResolvePlaceholderString(message, map[string]string{"path": node.GetPath()}))
+	return nil
+}
+
+func (self *FilesController) createSortMenu() error {
+	type sortMenuOption struct {
+		key         types.Key
+		label       string
+		description string
+		sortOrder   string
+	}
+	sortOptions := []sortMenuOption{
+		{label: self.c.Tr.SortFilesByName, key: 'n', sortOrder: filetree.SortFilesByName},
+		{label: self.c.Tr.SortFilesByStatus, description: self.c.Tr.SortFilesByStatusHint, key: 's', sortOrder: filetree.SortFilesByStatus},
+		{label: self.c.Tr.SortFilesByMtime, description: self.c.Tr.SortFilesByMtimeHint, key: 'm', sortOrder: filetree.SortFilesByModificationTime},
+		{label: self.c.Tr.SortFilesByDiffSize, description: self.c.Tr.SortFilesByDiffSizeHint, key: 'd', sortOrder: filetree.SortFilesByDiffSize},
+	}
+	currentValue := self.context().GetSortOrder()
+
+	menuItems := lo.Map(sortOptions, func(opt sortMenuOption, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{
+				opt.label,
+				style.FgYellow.Sprint(opt.description),
+			},
+			OnPress: func() error {
+				return self.setSortOrder(opt.sortOrder)
+			},
+			Key:    opt.key,
+			Widget: types.MakeMenuRadioButton(opt.sortOrder == currentValue),
+		}
+	})
+	return self.c.Menu(types.CreateMenuOptions{
+		Title:  self.c.Tr.SortOrder,
+		Items:  menuItems,
+		Prompt: self.c.Tr.SortOrderPromptFiles,
+	})
+}
+
+func (self *FilesController) setSortOrder(sortOrder string) error {
+	if self.context().GetSortOrder() == sortOrder {
+		return nil
+	}
+
+	self.c.GetAppState().FilesSortOrder = sortOrder
+	self.c.SaveAppStateAndLogError()
+
+	self.context().SetSortOrder(sortOrder)
+
+	// Sorting by diff size or modification time needs information that we
+	// only load when required, so reload the files
+	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}, Mode: types.ASYNC})
+	return nil
+}
+
+func (self *FilesController) canToggleExecutable(node *filetree.FileNode) *types.DisabledReason {
+	if node.File == nil {
+		return &types.DisabledReason{Text: self.c.Tr.ToggleExecutableOnlyForFiles}
+	}
+
+	return nil
+}
+
 func (self *FilesController) Context() types.Context {
 	return self.context()
 }
@@ -513,6 +725,7 @@ func (self *FilesController) enter() error {
 
 func (self *FilesController) collapseAll() error {
 	self.context().FileTreeViewModel.CollapseAll()
+	self.c.Helpers().Files.SaveCollapsedDirs()
 
 	self.c.PostRefreshUpdate(self.context())
 
@@ -521,6 +734,7 @@ func (self *FilesController) collapseAll() error {
 
 func (self *FilesController) expandAll() error {
 	self.context().FileTreeViewModel.ExpandAll()
+	self.c.Helpers().Files.SaveCollapsedDirs()
 
 	self.c.PostRefreshUpdate(self.context())
 
@@ -606,7 +820,123 @@ func (self *FilesController) handleNonInlineConflict(file *models.File) error {
 	})
 }
 
+func (self *FilesController) isConflictedFile(node *filetree.FileNode) *types.DisabledReason {
+	if node.File == nil || !node.File.HasMergeConflicts {
+		return &types.DisabledReason{Text: self.c.Tr.FileHasNoConflicts}
+	}
+
+	return nil
+}
+
+func (self *FilesController) openMergeToolForFile(node *filetree.FileNode) error {
+	return self.c.Helpers().WorkingTree.OpenMergeToolForFile(node.GetPath())
+}
+
+func (self *FilesController) anyConflictedFiles() *types.DisabledReason {
+	if len(self.conflictedFiles()) == 0 {
+		return &types.DisabledReason{Text: self.c.Tr.NoConflictedFiles}
+	}
+
+	return nil
+}
+
+func (self *FilesController) conflictedFiles() []*models.File {
+	return lo.Filter(self.c.Model().Files, func(file *models.File, _ int) bool {
+		return file.HasMergeConflicts
+	})
+}
+
+func (self *FilesController) createResolveAllConflictsMenu() error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ResolveAllConflicts,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.TakeOursForAllConflicts,
+				OnPress: func() error {
+					return self.resolveAllConflicts(false)
+				},
+				Key: 'o',
+			},
+			{
+				Label: self.c.Tr.TakeTheirsForAllConflicts,
+				OnPress: func() error {
+					return self.resolveAllConflicts(true)
+				},
+				Key: 't',
+			},
+		},
+	})
+}
+
+func (self *FilesController) resolveAllConflicts(theirs bool) error {
+	files := self.conflictedFiles()
+	paths := lo.Map(files, func(file *models.File, _ int) string {
+		return file.ShortStatus + " " + file.Path
+	})
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.ResolveAllConflicts,
+		Prompt: utils.ResolvePlaceholderString(
+			lo.Ternary(theirs, self.c.Tr.TakeTheirsForAllConflictsPrompt, self.c.Tr.TakeOursForAllConflictsPrompt),
+			map[string]string{"files": strings.Join(paths, "\n")},
+		),
+		HandleConfirm: func() error {
+			self.c.LogAction(lo.Ternary(theirs, self.c.Tr.Actions.ResolveAllConflictsWithTheirs, self.c.Tr.Actions.ResolveAllConflictsWithOurs))
+			if err := self.c.Git().WorkingTree.ResolveConflictsWithVersion(files, theirs); err != nil {
+				return err
+			}
+
+			self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+			return nil
+		},
+	})
+
+	return nil
+}
+
+func (self *FilesController) openRerereMenu() error {
+	return (&RerereMenuAction{c: self.c}).Call()
+}
+
+// Binary files are shown with information about their old and new version,
+// since git's diff of them isn't very useful
+func (self *FilesController) binaryFileCheck(node *filetree.FileNode, cached bool) *helpers.BinaryFileCheck {
+	if node.File == nil {
+		return nil
+	}
+
+	oldVersion := helpers.FileVersionSource{Ref: ""}
+	newVersion := helpers.FileVersionSource{WorkingCopy: true}
+	if cached {
+		oldVersion = helpers.FileVersionSource{Ref: "HEAD"}
+		newVersion = helpers.FileVersionSource{Ref: ""}
+	}
+	return &helpers.BinaryFileCheck{
+		Path: node.GetPath(),
+		IsBinary: func() bool {
+			return self.c.Git().WorkingTree.IsBinaryWorktreeFile(node, cached)
+		},
+		OldVersion: oldVersion,
+		NewVersion: newVersion,
+	}
+}
+
+// rerereResolvedNote returns a note for the main view if the conflicts in the
+// given file were resolved automatically by rerere, so that the user remembers
+// to review the result.
+func (self *FilesController) rerereResolvedNote(file *models.File) string {
+	if !lo.Contains(self.c.Git().Rerere.ResolvedPaths(), file.Path) {
+		return ""
+	}
+	return style.FgYellow.Sprint(self.c.Tr.ResolvedByRerereNote) + "\n\n"
+}
+
 func (self *FilesController) toggleStagedAll() error {
+	if self.context().IsFiltering() {
+		// only stage or unstage the files that match the filter
+		return self.press([]*filetree.FileNode{self.context().FileTreeViewModel.GetRoot()})
+	}
+
 	if err := self.toggleStagedAllWithLock(); err != nil {
 		return err
 	}
@@ -636,7 +966,7 @@ func (self *FilesController) toggleStagedAllWithLock() error {
 			return err
 		}
 
-		onlyTrackedFiles := self.context().GetFilter() == filetree.DisplayTracked
+		onlyTrackedFiles := self.context().GetStatusFilter() == filetree.DisplayTracked
 		if err := self.c.Git().WorkingTree.StageAll(onlyTrackedFiles); err != nil {
 			return err
 		}
@@ -728,6 +1058,20 @@ func (self *FilesController) exclude(node *filetree.FileNode) error {
 }
 
 func (self *FilesController) ignoreOrExcludeMenu(node *filetree.FileNode) error {
+	// Without listing the files that have the bits set we couldn't tell
+	// whether toggling sets or clears them, nor show the file afterwards
+	var indexFlagsDisabledReason *types.DisabledReason
+	if !self.c.UserConfig().Gui.ShowFilesWithHiddenChanges {
+		indexFlagsDisabledReason = &types.DisabledReason{Text: self.c.Tr.IndexFlagsNeedHiddenChangesEnabled}
+	} else if node.File == nil || !node.File.Tracked {
+		indexFlagsDisabledReason = &types.DisabledReason{Text: self.c.Tr.IndexFlagsOnlyForTrackedFiles}
+	}
+
+	skipWorktreeDisabledReason := indexFlagsDisabledReason
+	if skipWorktreeDisabledReason == nil && self.c.Git().Config.GetSparseCheckout() {
+		skipWorktreeDisabledReason = &types.DisabledReason{Text: self.c.Tr.SkipWorktreeInSparseCheckout}
+	}
+
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.Actions.IgnoreExcludeFile,
 		Items: []*types.MenuItem{
@@ -751,10 +1095,59 @@ func (self *FilesController) ignoreOrExcludeMenu(node *filetree.FileNode) error
 				},
 				Key: 'e',
 			},
+			{
+				LabelColumns: []string{self.c.Tr.ToggleSkipWorktree},
+				OnPress: func() error {
+					return self.toggleIndexFlag(node.File, "skip-worktree", node.File.SkipWorktree,
+						self.c.Tr.Actions.ToggleSkipWorktree, self.c.Git().WorkingTree.SetSkipWorktree)
+				},
+				Key:            's',
+				Tooltip:        self.c.Tr.ToggleSkipWorktreeTooltip,
+				DisabledReason: skipWorktreeDisabledReason,
+			},
+			{
+				LabelColumns: []string{self.c.Tr.ToggleAssumeUnchanged},
+				OnPress: func() error {
+					return self.toggleIndexFlag(node.File, "assume-unchanged", node.File.AssumeUnchanged,
+						self.c.Tr.Actions.ToggleAssumeUnchanged, self.c.Git().WorkingTree.SetAssumeUnchanged)
+				},
+				Key:            'a',
+				Tooltip:        self.c.Tr.ToggleAssumeUnchangedTooltip,
+				DisabledReason: indexFlagsDisabledReason,
+			},
 		},
 	})
 }
 
+// Setting the bit hides the file's changes from git status, so we ask for
+// confirmation first; clearing it doesn't need any
+func (self *FilesController) toggleIndexFlag(file *models.File, flag string, isSet bool, action string, set func([]string, bool) error) error {
+	toggle := func() error {
+		self.c.LogAction(action)
+		if err := set([]string{file.Path}, !isSet); err != nil {
+			return err
+		}
+
+		self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+		return nil
+	}
+
+	if isSet {
+		return toggle()
+	}
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.HideFileChangesTitle,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.HideFileChangesPrompt, map[string]string{
+			"flag": flag,
+			"path": file.Path,
+		}),
+		HandleConfirm: toggle,
+	})
+
+	return nil
+}
+
 func (self *FilesController) refresh() error {
 	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
 	return nil
@@ -824,7 +1217,7 @@ func (self *FilesController) isResolvingConflicts() bool {
 }
 
 func (self *FilesController) handleStatusFilterPressed() error {
-	currentFilter := self.context().GetFilter()
+	currentFilter := self.context().GetStatusFilter()
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.FilteringMenuTitle,
 		Items: []*types.MenuItem{
@@ -892,7 +1285,7 @@ func (self *FilesController) filteringLabel(filter filetree.FileTreeDisplayFilte
 }
 
 func (self *FilesController) setStatusFiltering(filter filetree.FileTreeDisplayFilter) error {
-	previousFilter := self.context().GetFilter()
+	previousFilter := self.context().GetStatusFilter()
 
 	self.context().FileTreeViewModel.SetStatusFilter(filter)
 	self.c.Contexts().Files.GetView().Subtitle = self.filteringLabel(filter)
@@ -964,11 +1357,18 @@ func (self *FilesController) switchToMerge() error {
 }
 
 func (self *FilesController) createStashMenu() error {
+	stagedTooltip := self.c.Tr.StashStagedChangesTooltip
+	// Older git versions can't stash staged changes with a single command
+	if !self.c.Git().Version.IsOlderThan(2, 35, 0) {
+		stagedTooltip = stashTooltip(stagedTooltip, git_commands.StashStagedArgs)
+	}
+
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.StashOptions,
 		Items: []*types.MenuItem{
 			{
-				Label: self.c.Tr.StashAllChanges,
+				Label:   self.c.Tr.StashAllChanges,
+				Tooltip: stashTooltip(self.c.Tr.StashAllChangesTooltip, git_commands.StashPushArgs),
 				OnPress: func() error {
 					if !self.c.Helpers().WorkingTree.IsWorkingTreeDirty() {
 						return errors.New(self.c.Tr.NoFilesToStash)
@@ -978,7 +1378,8 @@ func (self *FilesController) createStashMenu() error {
 				Key: 'a',
 			},
 			{
-				Label: self.c.Tr.StashAllChangesKeepIndex,
+				Label:   self.c.Tr.StashAllChangesKeepIndex,
+				Tooltip: stashTooltip(self.c.Tr.StashAllChangesKeepIndexTooltip, git_commands.StashKeepIndexArgs),
 				OnPress: func() error {
 					if !self.c.Helpers().WorkingTree.IsWorkingTreeDirty() {
 						return errors.New(self.c.Tr.NoFilesToStash)
@@ -989,14 +1390,16 @@ func (self *FilesController) createStashMenu() error {
 				Key: 'i',
 			},
 			{
-				Label: self.c.Tr.StashIncludeUntrackedChanges,
+				Label:   self.c.Tr.StashIncludeUntrackedChanges,
+				Tooltip: stashTooltip(self.c.Tr.StashIncludeUntrackedChangesTooltip, git_commands.StashIncludeUntrackedArgs),
 				OnPress: func() error {
 					return self.handleStashSave(self.c.Git().Stash.StashIncludeUntrackedChanges, self.c.Tr.Actions.StashIncludeUntrackedChanges)
 				},
 				Key: 'U',
 			},
 			{
-				Label: self.c.Tr.StashStagedChanges,
+				Label:   self.c.Tr.StashStagedChanges,
+				Tooltip: stagedTooltip,
 				OnPress: func() error {
 					// there must be something in staging otherwise the current implementation mucks the stash up
 					if !self.c.Helpers().WorkingTree.AnyStagedFiles() {
@@ -1007,7 +1410,8 @@ func (self *FilesController) createStashMenu() error {
 				Key: 's',
 			},
 			{
-				Label: self.c.Tr.StashUnstagedChanges,
+				Label:   self.c.Tr.StashUnstagedChanges,
+				Tooltip: self.c.Tr.StashUnstagedChangesTooltip,
 				OnPress: func() error {
 					if !self.c.Helpers().WorkingTree.IsWorkingTreeDirty() {
 						return errors.New(self.c.Tr.NoFilesToStash)
@@ -1024,6 +1428,11 @@ func (self *FilesController) createStashMenu() error {
 	})
 }
 
+// Appends the git command that a stash option runs to its description
+func stashTooltip(description string, stashArgs []string) string {
+	return description + "\n\ngit stash " + strings.Join(stashArgs, " ")
+}
+
 func (self *FilesController) openCopyMenu() error {
 	node := self.context().GetSelected()
 
@@ -1115,15 +1524,23 @@ func (self *FilesController) openCopyMenu() error {
 		Key: 'a',
 	}
 
+	items := []*types.MenuItem{
+		copyNameItem,
+		copyRelativePathItem,
+		copyAbsolutePathItem,
+		copyFileDiffItem,
+		copyAllDiff,
+	}
+	if node != nil {
+		for _, item := range self.c.Helpers().CopyTemplates.FileMenuItems(node.GetPath()) {
+			item.DisabledReason = self.require(self.singleItemSelected())()
+			items = append(items, item)
+		}
+	}
+
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.CopyToClipboardMenu,
-		Items: []*types.MenuItem{
-			copyNameItem,
-			copyRelativePathItem,
-			copyAbsolutePathItem,
-			copyFileDiffItem,
-			copyAllDiff,
-		},
+		Items: items,
 	})
 }
 
@@ -1155,6 +1572,7 @@ func (self *FilesController) handleToggleDirCollapsed() error {
 	}
 
 	self.context().FileTreeViewModel.ToggleCollapsed(node.GetInternalPath())
+	self.c.Helpers().Files.SaveCollapsedDirs()
 
 	self.c.PostRefreshUpdate(self.c.Contexts().Files)
 
@@ -1193,6 +1611,7 @@ func (self *FilesController) fetch() error {
 	return self.c.WithWaitingStatus(self.c.Tr.FetchingStatus, func(task gocui.Task) error {
 		self.c.LogAction("Fetch")
 		err := self.c.Git().Sync.Fetch(task)
+		self.c.Helpers().Notification.NotifyIfUnfocused(helpers.NotifiableFetch, err)
 
 		if err != nil && strings.Contains(err.Error(), "exit status 128") {
 			return errors.New(self.c.Tr.PassUnameWrong)
@@ -1201,6 +1620,7 @@ func (self *FilesController) fetch() error {
 		self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS, types.REMOTES, types.TAGS}, Mode: types.SYNC})
 
 		if err == nil {
+			self.c.Helpers().Tags.RefreshRemoteTags()
 			err = self.c.Helpers().BranchesHelper.AutoForwardBranches()
 		}
 
@@ -1208,6 +1628,20 @@ func (self *FilesController) fetch() error {
 	})
 }
 
+// When filtering by text, a directory only shows the files that match the
+// filter, so we act on those files rather than on the whole directory.
+func (self *FilesController) expandDirsIfFiltering(nodes []*filetree.FileNode) []*filetree.FileNode {
+	if !self.context().IsFiltering() {
+		return nodes
+	}
+
+	return lo.FlatMap(nodes, func(node *filetree.FileNode, _ int) []*filetree.FileNode {
+		return lo.Map(node.GetLeaves(), func(leaf *filetree.Node[models.File], _ int) *filetree.FileNode {
+			return filetree.NewFileNode(leaf)
+		})
+	})
+}
+
 // Couldn't think of a better term than 'normalised'. Alas.
 // The idea is that when you select a range of nodes, you will often have both
 // a node and its parent node selected. If we are trying to discard changes to the
@@ -1250,6 +1684,19 @@ func filterNodesHaveUnstagedChanges(nodes []*filetree.FileNode) []*filetree.File
 	})
 }
 
+// Returns the paths of all files in the given nodes, including the old paths
+// of renamed files
+func filePathsOfNodes(nodes []*filetree.FileNode) []string {
+	paths := []string{}
+	for _, node := range nodes {
+		_ = node.ForEachFile(func(file *models.File) error {
+			paths = append(paths, file.Names()...)
+			return nil
+		})
+	}
+	return lo.Uniq(paths)
+}
+
 func findSubmoduleNode(nodes []*filetree.FileNode, submodules []*models.SubmoduleConfig) *models.File {
 	for _, node := range nodes {
 		submoduleNode := node.FindFirstFileBy(func(f *models.File) bool {
@@ -1311,13 +1758,20 @@ func (self *FilesController) remove(selectedNodes []*filetree.FileNode) error {
 	discardAllChangesItem := types.MenuItem{
 		Label: self.c.Tr.DiscardAllChanges,
 		OnPress: func() error {
+			nodes := self.expandDirsIfFiltering(normalisedSelectedNodes(selectedNodes))
+			if err := self.c.Helpers().Snapshot.BackupBeforeDiscard(
+				filePathsOfNodes(nodes), self.c.Tr.Actions.DiscardAllChangesInFile,
+			); err != nil {
+				return err
+			}
+
 			self.c.LogAction(self.c.Tr.Actions.DiscardAllChangesInFile)
 
 			if self.context().IsSelectingRange() {
 				defer self.context().CancelRangeSelect()
 			}
 
-			for _, node := range selectedNodes {
+			for _, node := range nodes {
 				if err := self.c.Git().WorkingTree.DiscardAllDirChanges(node); err != nil {
 					return err
 				}
@@ -1338,13 +1792,20 @@ func (self *FilesController) remove(selectedNodes []*filetree.FileNode) error {
 	discardUnstagedChangesItem := types.MenuItem{
 		Label: self.c.Tr.DiscardUnstagedChanges,
 		OnPress: func() error {
+			nodes := self.expandDirsIfFiltering(normalisedSelectedNodes(selectedNodes))
+			if err := self.c.Helpers().Snapshot.BackupBeforeDiscard(
+				filePathsOfNodes(nodes), self.c.Tr.Actions.DiscardAllUnstagedChangesInFile,
+			); err != nil {
+				return err
+			}
+
 			self.c.LogAction(self.c.Tr.Actions.DiscardAllUnstagedChangesInFile)
 
 			if self.context().IsSelectingRange() {
 				defer self.context().CancelRangeSelect()
 			}
 
-			for _, node := range selectedNodes {
+			for _, node := range nodes {
 				if err := self.c.Git().WorkingTree.DiscardUnstagedDirChanges(node); err != nil {
 					return err
 				}
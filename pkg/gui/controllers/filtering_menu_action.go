@@ -91,6 +91,44 @@ func (self *FilteringMenuAction) Call() error {
 		Tooltip: tooltip,
 	})
 
+	savedPath := self.c.Helpers().Mode.SavedFilterPath()
+	if path := self.c.Modes().Filtering.GetPath(); path != "" && path != savedPath {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: fmt.Sprintf("%s '%s'", self.c.Tr.SaveFilterPathForRepo, path),
+			OnPress: func() error {
+				self.c.Helpers().Mode.SetSavedFilterPath(path)
+				return nil
+			},
+			Tooltip: self.c.Tr.SaveFilterPathForRepoTooltip,
+		})
+	}
+
+	if savedPath != "" {
+		if self.c.Modes().Filtering.GetPath() == savedPath {
+			menuItems = append(menuItems, &types.MenuItem{
+				Label:   self.c.Tr.WidenSavedFilterScope,
+				OnPress: self.c.Helpers().Mode.ToggleSavedFilterPath,
+				Key:     't',
+				Tooltip: self.c.Tr.WidenSavedFilterScopeTooltip,
+			})
+		} else {
+			menuItems = append(menuItems, &types.MenuItem{
+				Label:   fmt.Sprintf("%s '%s'", self.c.Tr.ApplySavedFilterPath, savedPath),
+				OnPress: self.c.Helpers().Mode.ToggleSavedFilterPath,
+				Key:     't',
+				Tooltip: tooltip,
+			})
+		}
+
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: fmt.Sprintf("%s '%s'", self.c.Tr.ForgetSavedFilterPath, savedPath),
+			OnPress: func() error {
+				self.c.Helpers().Mode.SetSavedFilterPath("")
+				return nil
+			},
+		})
+	}
+
 	if self.c.Modes().Filtering.Active() {
 		menuItems = append(menuItems, &types.MenuItem{
 			Label:   self.c.Tr.ExitFilterMode,
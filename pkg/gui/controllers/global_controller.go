@@ -143,6 +143,50 @@ func (self *GlobalController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Description: self.c.Tr.ToggleWhitespaceInDiffView,
 			Tooltip:     self.c.Tr.ToggleWhitespaceInDiffViewTooltip,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleMovedLinesInDiffView),
+			Handler:     self.toggleMovedLines,
+			Description: self.c.Tr.ToggleMovedLinesInDiffView,
+			Tooltip:     self.c.Tr.ToggleMovedLinesInDiffViewTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenDiffOptionsMenu),
+			Handler:     opts.Guards.NoPopupPanel(self.openDiffOptionsMenu),
+			Description: self.c.Tr.DiffOptions,
+			Tooltip:     self.c.Tr.DiffOptionsTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleZenMode),
+			Handler:     opts.Guards.NoPopupPanel(self.toggleZenMode),
+			Description: self.c.Tr.ToggleZenMode,
+			Tooltip:     self.c.Tr.ToggleZenModeTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.OpenLayoutMenu),
+			Handler:     opts.Guards.NoPopupPanel(self.createLayoutMenu),
+			Description: self.c.Tr.OpenLayoutMenu,
+			Tooltip:     self.c.Tr.OpenLayoutMenuTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ToggleKeypressRecording),
+			Handler:     self.c.Helpers().Macro.ToggleRecording,
+			Description: self.c.Tr.ToggleKeypressRecording,
+			Tooltip:     self.c.Tr.ToggleKeypressRecordingTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.ReplayKeypressRecording),
+			Handler:     opts.Guards.NoPopupPanel(self.c.Helpers().Macro.ReplayRecording),
+			Description: self.c.Tr.ReplayKeypressRecording,
+			Tooltip:     self.c.Tr.ReplayKeypressRecordingTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.CycleDateDisplay),
+			Handler:     opts.Guards.NoPopupPanel(self.cycleDateDisplay),
+			Description: self.c.Tr.CycleDateDisplay,
+			Tooltip:     self.c.Tr.CycleDateDisplayTooltip,
+		},
 	}
 }
 
@@ -171,6 +215,10 @@ func (self *GlobalController) prevScreenMode() error {
 	return (&ScreenModeActions{c: self.c}).Prev()
 }
 
+func (self *GlobalController) toggleZenMode() error {
+	return (&ScreenModeActions{c: self.c}).ToggleZen()
+}
+
 func (self *GlobalController) createOptionsMenu() error {
 	return (&OptionsMenuAction{c: self.c}).Call()
 }
@@ -190,6 +238,10 @@ func (self *GlobalController) createFilteringMenu() error {
 	return (&FilteringMenuAction{c: self.c}).Call()
 }
 
+func (self *GlobalController) createLayoutMenu() error {
+	return (&LayoutMenuAction{c: self.c}).Call()
+}
+
 func (self *GlobalController) createDiffingMenu() error {
 	return (&DiffingMenuAction{c: self.c}).Call()
 }
@@ -224,6 +276,18 @@ func (self *GlobalController) toggleWhitespace() error {
 	return (&ToggleWhitespaceAction{c: self.c}).Call()
 }
 
+func (self *GlobalController) toggleMovedLines() error {
+	return (&ToggleMovedLinesAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) cycleDateDisplay() error {
+	return (&CycleDateDisplayAction{c: self.c}).Call()
+}
+
+func (self *GlobalController) openDiffOptionsMenu() error {
+	return (&DiffOptionsMenuAction{c: self.c}).Call()
+}
+
 func (self *GlobalController) canShowRebaseOptions() *types.DisabledReason {
 	if self.c.Model().WorkingTreeStateAtLastCommitRefresh.None() {
 		return &types.DisabledReason{
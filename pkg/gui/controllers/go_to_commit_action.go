@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Selects an arbitrary commit in the commits view, given its hash or a ref
+// pointing to it.
+type GoToCommitAction struct {
+	c *ControllerCommon
+}
+
+func (self *GoToCommitAction) Call() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.EnterCommitHashOrRef,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetCommitSuggestionsFunc(),
+		HandleConfirm: func(response string) error {
+			ref := strings.TrimSpace(response)
+			if ref == "" {
+				return nil
+			}
+
+			hash, err := self.c.Git().Commit.ResolveCommitHash(ref)
+			if err != nil || hash == "" {
+				return errors.New(utils.ResolvePlaceholderString(self.c.Tr.CommitNotFound, map[string]string{"ref": ref}))
+			}
+
+			return self.c.Helpers().Commits.GoToCommit(hash)
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,329 @@
+package controllers
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Above this size, switching to index version 4 is worth it
+const largeIndexSize = 32 * 1024 * 1024
+
+type healthCheckStatus int
+
+const (
+	healthCheckOK healthCheckStatus = iota
+	healthCheckWarning
+	healthCheckError
+)
+
+type healthCheck struct {
+	name   string
+	status healthCheckStatus
+	detail string
+	// describes the remediation; shown as the tooltip of the menu item
+	tooltip string
+	// remediation; nil if there is nothing to do
+	onPress func() error
+}
+
+type HealthChecksMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *HealthChecksMenuAction) Call() error {
+	return self.c.WithWaitingStatus(self.c.Tr.RunningHealthChecks, func(gocui.Task) error {
+		// fsck reports dangling objects and broken refs as well as actual
+		// corruption, and it's by far the slowest check, so we only run it once
+		fsckResult, fsckErr := self.c.Git().Health.Fsck()
+
+		checks := []*healthCheck{
+			self.fsckCheck(fsckResult, fsckErr),
+			self.danglingObjectsCheck(fsckResult, fsckErr),
+			self.brokenRefsCheck(fsckResult, fsckErr),
+			self.indexSizeCheck(),
+			self.staleLockFilesCheck(),
+			self.detachedSubmodulesCheck(),
+			self.gcCheck(),
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.showResults(checks)
+		})
+		return nil
+	})
+}
+
+func (self *HealthChecksMenuAction) showResults(checks []*healthCheck) error {
+	menuItems := lo.Map(checks, func(check *healthCheck, _ int) *types.MenuItem {
+		onPress := check.onPress
+		tooltip := check.tooltip
+		if onPress == nil {
+			onPress = func() error { return nil }
+			tooltip = self.c.Tr.HealthCheckPassedTooltip
+		}
+		return &types.MenuItem{
+			LabelColumns: []string{self.statusLabel(check.status), check.name, check.detail},
+			OnPress:      onPress,
+			Tooltip:      tooltip,
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.HealthChecksTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *HealthChecksMenuAction) statusLabel(status healthCheckStatus) string {
+	switch status {
+	case healthCheckWarning:
+		return style.FgYellow.Sprint(self.c.Tr.HealthCheckWarning)
+	case healthCheckError:
+		return style.FgRed.Sprint(self.c.Tr.HealthCheckError)
+	default:
+		return style.FgGreen.Sprint(self.c.Tr.HealthCheckOK)
+	}
+}
+
+func (self *HealthChecksMenuAction) failedCheck(name string, err error) *healthCheck {
+	return &healthCheck{name: name, status: healthCheckError, detail: err.Error()}
+}
+
+func (self *HealthChecksMenuAction) fsckCheck(result *git_commands.FsckResult, err error) *healthCheck {
+	name := self.c.Tr.HealthCheckFsck
+	if err != nil {
+		return self.failedCheck(name, err)
+	}
+
+	if len(result.Problems) == 0 {
+		return &healthCheck{name: name, status: healthCheckOK, detail: self.c.Tr.FsckNoProblems}
+	}
+
+	return &healthCheck{
+		name:    name,
+		status:  healthCheckError,
+		detail:  utils.ResolvePlaceholderString(self.c.Tr.FsckProblemsCount, map[string]string{"count": fmt.Sprint(len(result.Problems))}),
+		tooltip: self.c.Tr.ShowFsckProblemsTooltip,
+		onPress: func() error {
+			self.c.Alert(self.c.Tr.FsckProblems, strings.Join(result.Problems, "\n"))
+			return nil
+		},
+	}
+}
+
+func (self *HealthChecksMenuAction) danglingObjectsCheck(result *git_commands.FsckResult, err error) *healthCheck {
+	name := self.c.Tr.HealthCheckDanglingObjects
+	if err != nil {
+		return self.failedCheck(name, err)
+	}
+
+	if len(result.DanglingObjects) == 0 {
+		return &healthCheck{name: name, status: healthCheckOK, detail: self.c.Tr.HealthCheckNone}
+	}
+
+	count := fmt.Sprint(len(result.DanglingObjects))
+	return &healthCheck{
+		name:    name,
+		status:  healthCheckWarning,
+		detail:  utils.ResolvePlaceholderString(self.c.Tr.DanglingObjectsCount, map[string]string{"count": count}),
+		tooltip: self.c.Tr.PruneDanglingObjectsTooltip,
+		onPress: func() error {
+			self.c.Confirm(types.ConfirmOpts{
+				Title:  self.c.Tr.PruneDanglingObjects,
+				Prompt: utils.ResolvePlaceholderString(self.c.Tr.PruneDanglingObjectsPrompt, map[string]string{"count": count}),
+				HandleConfirm: func() error {
+					return self.runGc(self.c.Tr.Actions.PruneDanglingObjects, true)
+				},
+			})
+			return nil
+		},
+	}
+}
+
+func (self *HealthChecksMenuAction) brokenRefsCheck(result *git_commands.FsckResult, err error) *healthCheck {
+	name := self.c.Tr.HealthCheckBrokenRefs
+	if err != nil {
+		return self.failedCheck(name, err)
+	}
+
+	refs := result.BrokenRefs
+	if len(refs) == 0 {
+		return &healthCheck{name: name, status: healthCheckOK, detail: self.c.Tr.HealthCheckNone}
+	}
+
+	return &healthCheck{
+		name:    name,
+		status:  healthCheckError,
+		detail:  strings.Join(refs, ", "),
+		tooltip: self.c.Tr.DeleteBrokenRefsTooltip,
+		onPress: func() error {
+			self.c.Confirm(types.ConfirmOpts{
+				Title:  self.c.Tr.DeleteBrokenRefs,
+				Prompt: utils.ResolvePlaceholderString(self.c.Tr.DeleteBrokenRefsPrompt, map[string]string{"refs": strings.Join(refs, "\n")}),
+				HandleConfirm: func() error {
+					self.c.LogAction(self.c.Tr.Actions.DeleteBrokenRefs)
+					for _, ref := range refs {
+						if err := self.c.Git().Health.DeleteRef(ref); err != nil {
+							return err
+						}
+					}
+					self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+					return nil
+				},
+			})
+			return nil
+		},
+	}
+}
+
+func (self *HealthChecksMenuAction) indexSizeCheck() *healthCheck {
+	name := self.c.Tr.HealthCheckIndexSize
+	size, err := self.c.Git().Health.IndexSize()
+	if err != nil {
+		// A repo without any commits or staged files has no index yet
+		return &healthCheck{name: name, status: healthCheckOK, detail: self.c.Tr.HealthCheckNone}
+	}
+
+	detail := utils.FormatByteSize(size)
+	if size < largeIndexSize {
+		return &healthCheck{name: name, status: healthCheckOK, detail: detail}
+	}
+
+	return &healthCheck{
+		name:    name,
+		status:  healthCheckWarning,
+		detail:  detail,
+		tooltip: self.c.Tr.UpgradeIndexVersionTooltip,
+		onPress: func() error {
+			self.c.LogAction(self.c.Tr.Actions.UpgradeIndexVersion)
+			return self.c.Git().Health.UpgradeIndexVersion()
+		},
+	}
+}
+
+func (self *HealthChecksMenuAction) staleLockFilesCheck() *healthCheck {
+	name := self.c.Tr.HealthCheckLockFiles
+	lockFiles, err := self.c.Git().Health.StaleLockFiles()
+	if err != nil {
+		return self.failedCheck(name, err)
+	}
+
+	if len(lockFiles) == 0 {
+		return &healthCheck{name: name, status: healthCheckOK, detail: self.c.Tr.HealthCheckNone}
+	}
+
+	gitDir := self.c.Git().RepoPaths.RepoGitDirPath()
+	relativePaths := lo.Map(lockFiles, func(path string, _ int) string {
+		if rel, err := filepath.Rel(gitDir, path); err == nil {
+			return rel
+		}
+		return path
+	})
+
+	return &healthCheck{
+		name:    name,
+		status:  healthCheckError,
+		detail:  strings.Join(relativePaths, ", "),
+		tooltip: self.c.Tr.RemoveStaleLockFilesTooltip,
+		onPress: func() error {
+			self.c.Confirm(types.ConfirmOpts{
+				Title:  self.c.Tr.RemoveStaleLockFiles,
+				Prompt: utils.ResolvePlaceholderString(self.c.Tr.RemoveStaleLockFilesPrompt, map[string]string{"files": strings.Join(relativePaths, "\n")}),
+				HandleConfirm: func() error {
+					self.c.LogAction(self.c.Tr.Actions.RemoveStaleLockFiles)
+					for _, path := range lockFiles {
+						if err := self.c.OS().RemoveFile(path); err != nil {
+							return err
+						}
+					}
+					self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+					return nil
+				},
+			})
+			return nil
+		},
+	}
+}
+
+func (self *HealthChecksMenuAction) detachedSubmodulesCheck() *healthCheck {
+	name := self.c.Tr.HealthCheckDetachedSubmodules
+	if len(self.c.Model().Submodules) == 0 {
+		return &healthCheck{name: name, status: healthCheckOK, detail: self.c.Tr.HealthCheckNone}
+	}
+
+	offRecordedCommit, err := self.c.Git().Health.SubmodulesOffRecordedCommit()
+	if err != nil {
+		return self.failedCheck(name, err)
+	}
+
+	// A submodule that is detached at the recorded commit is just what
+	// 'git submodule update' leaves behind; only once it's detached somewhere
+	// else could commits made there get lost
+	detached := lo.Filter(self.c.Model().Submodules, func(submodule *models.SubmoduleConfig, _ int) bool {
+		return lo.Contains(offRecordedCommit, submodule.FullPath()) &&
+			self.c.Git().Health.IsDetachedHead(submodule.FullPath())
+	})
+
+	if len(detached) == 0 {
+		return &healthCheck{name: name, status: healthCheckOK, detail: self.c.Tr.HealthCheckNone}
+	}
+
+	return &healthCheck{
+		name:   name,
+		status: healthCheckWarning,
+		detail: strings.Join(lo.Map(detached, func(submodule *models.SubmoduleConfig, _ int) string {
+			return submodule.FullName()
+		}), ", "),
+		tooltip: self.c.Tr.DetachedSubmodulesTooltip,
+		onPress: func() error {
+			self.c.Context().Push(self.c.Contexts().Submodules, types.OnFocusOpts{})
+			return nil
+		},
+	}
+}
+
+func (self *HealthChecksMenuAction) gcCheck() *healthCheck {
+	name := self.c.Tr.HealthCheckGc
+	counts, err := self.c.Git().Health.CountObjects()
+	if err != nil {
+		return self.failedCheck(name, err)
+	}
+
+	detail := utils.ResolvePlaceholderString(self.c.Tr.GcNeededDetail, map[string]string{
+		"looseObjects": fmt.Sprint(counts.LooseObjects),
+		"packs":        fmt.Sprint(counts.Packs),
+	})
+	if !counts.GcNeeded() {
+		return &healthCheck{name: name, status: healthCheckOK, detail: detail}
+	}
+
+	return &healthCheck{
+		name:    name,
+		status:  healthCheckWarning,
+		detail:  detail,
+		tooltip: self.c.Tr.RunGcTooltip,
+		onPress: func() error {
+			return self.runGc(self.c.Tr.Actions.RunGc, false)
+		},
+	}
+}
+
+func (self *HealthChecksMenuAction) runGc(action string, pruneNow bool) error {
+	return self.c.WithWaitingStatus(self.c.Tr.RunningGc, func(gocui.Task) error {
+		self.c.LogAction(action)
+		if err := self.c.Git().Health.Gc(pruneNow); err != nil {
+			return err
+		}
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+		return nil
+	})
+}
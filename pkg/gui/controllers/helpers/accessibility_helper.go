@@ -0,0 +1,113 @@
+package helpers
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Tells users of screen readers and braille displays what's going on, when
+// accessibility mode is enabled: which item is selected, what a popup says, and
+// how an operation went.
+type AccessibilityHelper struct {
+	c *HelperCommon
+
+	mutex sync.Mutex
+	// Whether an announcement is being spoken at the moment
+	announcing bool
+	// The latest announcement that came in while another one was being
+	// spoken. Any earlier ones are skipped, because they're out of date by the
+	// time we could get to them.
+	pending string
+
+	// So that we only announce the selection when it changes, rather than
+	// whenever its list is rendered
+	lastSelectionContextKey types.ContextKey
+	lastSelection           string
+}
+
+func NewAccessibilityHelper(c *HelperCommon) *AccessibilityHelper {
+	return &AccessibilityHelper{
+		c: c,
+	}
+}
+
+func (self *AccessibilityHelper) IsEnabled() bool {
+	return self.c.UserConfig().Accessibility.Enabled
+}
+
+func (self *AccessibilityHelper) Announce(text string) {
+	if !self.IsEnabled() {
+		return
+	}
+
+	// Screen readers can't do anything with colors or the padding of columns
+	text = strings.Join(strings.Fields(utils.Decolorise(text)), " ")
+	if text == "" {
+		return
+	}
+
+	if self.c.OS().AnnouncesInstantly() {
+		self.announce(text)
+		return
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.announcing {
+		self.pending = text
+		return
+	}
+	self.announcing = true
+
+	self.c.OnWorker(func(gocui.Task) error {
+		for {
+			self.announce(text)
+
+			self.mutex.Lock()
+			text = self.pending
+			self.pending = ""
+			if text == "" {
+				self.announcing = false
+			}
+			self.mutex.Unlock()
+
+			if text == "" {
+				return nil
+			}
+		}
+	})
+}
+
+// Announces the selected line of a list, together with the title of the list
+// if the selection moved to it from somewhere else
+func (self *AccessibilityHelper) AnnounceSelection(context types.Context, line string) {
+	if !self.IsEnabled() {
+		return
+	}
+
+	if context.GetKey() == self.lastSelectionContextKey && line == self.lastSelection {
+		return
+	}
+
+	text := line
+	if context.GetKey() != self.lastSelectionContextKey {
+		if title := context.GetView().Title; title != "" {
+			text = title + ": " + line
+		}
+	}
+	self.lastSelectionContextKey = context.GetKey()
+	self.lastSelection = line
+
+	self.Announce(text)
+}
+
+func (self *AccessibilityHelper) announce(text string) {
+	if err := self.c.OS().Announce(text); err != nil {
+		self.c.Log.Errorf("error when announcing text: %v", err)
+	}
+}
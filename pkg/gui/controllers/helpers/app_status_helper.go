@@ -24,6 +24,8 @@ func NewAppStatusHelper(c *HelperCommon, statusMgr func() *status.StatusManager,
 }
 
 func (self *AppStatusHelper) Toast(message string, kind types.ToastKind) {
+	self.c.Announce(message)
+
 	if self.c.RunningIntegrationTest() {
 		// Don't bother showing toasts in integration tests. You can't check for
 		// them anyway, and they would only slow down the test unnecessarily by
@@ -59,7 +61,7 @@ func (self appStatusHelperTask) Continue() {
 
 // withWaitingStatus wraps a function and shows a waiting status while the function is still executing
 func (self *AppStatusHelper) WithWaitingStatus(message string, f func(gocui.Task) error) {
-	self.c.OnWorker(func(task gocui.Task) error {
+	self.c.OnWorkerWithName(message, func(task gocui.Task) error {
 		return self.WithWaitingStatusImpl(message, f, task)
 	})
 }
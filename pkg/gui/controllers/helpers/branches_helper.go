@@ -17,16 +17,22 @@ import (
 type BranchesHelper struct {
 	c              *HelperCommon
 	worktreeHelper *WorktreeHelper
+	snapshotHelper *SnapshotHelper
 }
 
-func NewBranchesHelper(c *HelperCommon, worktreeHelper *WorktreeHelper) *BranchesHelper {
+func NewBranchesHelper(c *HelperCommon, worktreeHelper *WorktreeHelper, snapshotHelper *SnapshotHelper) *BranchesHelper {
 	return &BranchesHelper{
 		c:              c,
 		worktreeHelper: worktreeHelper,
+		snapshotHelper: snapshotHelper,
 	}
 }
 
 func (self *BranchesHelper) ConfirmLocalDelete(branches []*models.Branch) error {
+	if err := self.checkNoneProtected(lo.Map(branches, func(branch *models.Branch, _ int) string { return branch.Name })); err != nil {
+		return err
+	}
+
 	if len(branches) > 1 {
 		if lo.SomeBy(branches, func(branch *models.Branch) bool { return self.checkedOutByOtherWorktree(branch) }) {
 			return errors.New(self.c.Tr.SomeBranchesCheckedOutByWorktreeError)
@@ -42,6 +48,12 @@ func (self *BranchesHelper) ConfirmLocalDelete(branches []*models.Branch) error
 
 	doDelete := func() error {
 		return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(_ gocui.Task) error {
+			if !allBranchesMerged {
+				if err := self.snapshotHelper.SnapshotBranches(branches, self.c.Tr.Actions.DeleteLocalBranch); err != nil {
+					return err
+				}
+			}
+
 			self.c.LogAction(self.c.Tr.Actions.DeleteLocalBranch)
 			branchNames := lo.Map(branches, func(branch *models.Branch, _ int) string { return branch.Name })
 			if err := self.c.Git().Branch.LocalDelete(branchNames, true); err != nil {
@@ -83,6 +95,10 @@ func (self *BranchesHelper) ConfirmLocalDelete(branches []*models.Branch) error
 }
 
 func (self *BranchesHelper) ConfirmDeleteRemote(remoteBranches []*models.RemoteBranch, resetRemoteBranchesSelection bool) error {
+	if err := self.checkNoneProtected(lo.Map(remoteBranches, func(branch *models.RemoteBranch, _ int) string { return branch.Name })); err != nil {
+		return err
+	}
+
 	var title string
 	if len(remoteBranches) == 1 {
 		title = utils.ResolvePlaceholderString(
@@ -127,6 +143,12 @@ func (self *BranchesHelper) ConfirmDeleteRemote(remoteBranches []*models.RemoteB
 }
 
 func (self *BranchesHelper) ConfirmLocalAndRemoteDelete(branches []*models.Branch) error {
+	if err := self.checkNoneProtected(lo.FlatMap(branches, func(branch *models.Branch, _ int) []string {
+		return []string{branch.Name, branch.UpstreamBranch}
+	})); err != nil {
+		return err
+	}
+
 	if lo.SomeBy(branches, func(branch *models.Branch) bool { return self.checkedOutByOtherWorktree(branch) }) {
 		return errors.New(self.c.Tr.SomeBranchesCheckedOutByWorktreeError)
 	}
@@ -168,6 +190,12 @@ func (self *BranchesHelper) ConfirmLocalAndRemoteDelete(branches []*models.Branc
 		Prompt: prompt,
 		HandleConfirm: func() error {
 			return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(task gocui.Task) error {
+				if !allBranchesMerged {
+					if err := self.snapshotHelper.SnapshotBranches(branches, self.c.Tr.Actions.DeleteLocalBranch); err != nil {
+						return err
+					}
+				}
+
 				// Delete the remote branches first so that we keep the local ones
 				// in case of failure
 				remoteBranches := lo.Map(branches, func(branch *models.Branch, _ int) *models.RemoteBranch {
@@ -303,3 +331,12 @@ func (self *BranchesHelper) AutoForwardBranches() error {
 
 	return err
 }
+
+func (self *BranchesHelper) checkNoneProtected(branchNames []string) error {
+	for _, branchName := range branchNames {
+		if self.c.UserConfig().Git.IsProtectedBranch(branchName) {
+			return fmt.Errorf(self.c.Tr.CantDeleteProtectedBranch, branchName)
+		}
+	}
+	return nil
+}
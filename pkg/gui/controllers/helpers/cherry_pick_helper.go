@@ -36,25 +36,29 @@ func (self *CherryPickHelper) getData() *cherrypicking.CherryPicking {
 func (self *CherryPickHelper) CopyRange(commitsList []*models.Commit, context types.IListContext) error {
 	startIdx, endIdx := context.GetList().GetSelectionRange()
 
+	return self.CopyCommits(commitsList[startIdx:endIdx+1], commitsList, context)
+}
+
+// Like CopyRange, but for any subset of the commits in commitsList, e.g. the
+// marked commits
+func (self *CherryPickHelper) CopyCommits(selectedCommits []*models.Commit, commitsList []*models.Commit, context types.IListContext) error {
 	if err := self.resetIfNecessary(context); err != nil {
 		return err
 	}
 
 	commitSet := self.getData().SelectedHashSet()
 
-	allCommitsCopied := lo.EveryBy(commitsList[startIdx:endIdx+1], func(commit *models.Commit) bool {
+	allCommitsCopied := lo.EveryBy(selectedCommits, func(commit *models.Commit) bool {
 		return commitSet.Includes(commit.Hash())
 	})
 
 	// if all selected commits are already copied, we'll uncopy them
 	if allCommitsCopied {
-		for index := startIdx; index <= endIdx; index++ {
-			commit := commitsList[index]
+		for _, commit := range selectedCommits {
 			self.getData().Remove(commit, commitsList)
 		}
 	} else {
-		for index := startIdx; index <= endIdx; index++ {
-			commit := commitsList[index]
+		for _, commit := range selectedCommits {
 			self.getData().Add(commit, commitsList)
 		}
 	}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
@@ -123,6 +125,9 @@ type OpenCommitMessagePanelOpts struct {
 	OnConfirm        func(summary string, description string) error
 	OnSwitchToEditor func(string) error
 	InitialMessage   string
+	// If set, the commit menu offers inserting a reference to one of the
+	// returned issues into the description
+	ListIssues func() ([]*hosting_service.Issue, error)
 
 	// The following two fields are only for the display of the "(hooks
 	// disabled)" display in the commit message panel. They have no effect on
@@ -148,6 +153,7 @@ func (self *CommitsHelper) OpenCommitMessagePanel(opts *OpenCommitMessagePanelOp
 		opts.InitialMessage,
 		onConfirm,
 		opts.OnSwitchToEditor,
+		opts.ListIssues,
 		opts.ForceSkipHooks,
 		opts.SkipHooksPrefix,
 	)
@@ -226,6 +232,15 @@ func (self *CommitsHelper) OpenCommitMenu(suggestionFunc func(string) []*types.S
 			Key: 'p',
 		},
 	}
+	if self.c.Contexts().CommitMessage.CanInsertIssueReference() {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: self.c.Tr.InsertIssueReference,
+			OnPress: func() error {
+				return self.insertIssueReference()
+			},
+			Key: 'i',
+		})
+	}
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.CommitMenuTitle,
 		Items: menuItems,
@@ -247,6 +262,36 @@ func (self *CommitsHelper) addCoAuthor(suggestionFunc func(string) []*types.Sugg
 	return nil
 }
 
+// Fetches the issues for the commit message panel, lets the user pick one of
+// them, and inserts its reference (e.g. "#123") at the cursor position in the
+// description
+func (self *CommitsHelper) insertIssueReference() error {
+	return self.c.WithWaitingStatus(self.c.Tr.FetchingIssuesStatus, func(gocui.Task) error {
+		issues, err := self.c.Contexts().CommitMessage.ListIssues()
+		if err != nil {
+			return err
+		}
+		if len(issues) == 0 {
+			return errors.New(self.c.Tr.NoOpenIssues)
+		}
+
+		self.c.OnUIThread(func() error {
+			self.c.Prompt(types.PromptOpts{
+				Title:               self.c.Tr.InsertIssueReferencePromptTitle,
+				FindSuggestionsFunc: IssueSuggestionsFunc(issues, self.c.UserConfig().Gui.UseFuzzySearch()),
+				HandleConfirm: func(reference string) error {
+					view := self.c.Views().CommitDescription
+					view.TextArea.TypeString(reference)
+					view.RenderTextArea()
+					return nil
+				},
+			})
+			return nil
+		})
+		return nil
+	})
+}
+
 func (self *CommitsHelper) pasteCommitMessageFromClipboard() error {
 	message, err := self.c.OS().PasteFromClipboard()
 	if err != nil {
@@ -266,3 +311,50 @@ func (self *CommitsHelper) pasteCommitMessageFromClipboard() error {
 		},
 	})
 }
+
+// Selects the commit with the given hash in the commits view, loading the rest
+// of the history if it isn't among the commits loaded so far.
+func (self *CommitsHelper) GoToCommit(hash string) error {
+	commitsContext := self.c.Contexts().LocalCommits
+	if self.c.Context().Current() != commitsContext {
+		self.c.Context().Push(commitsContext, types.OnFocusOpts{})
+	}
+
+	if self.selectCommit(hash) {
+		return nil
+	}
+
+	if !commitsContext.GetLimitCommits() {
+		return self.commitNotFoundError(hash)
+	}
+
+	// Only the first 300 commits are loaded initially, so load the rest of the
+	// history and look again.
+	commitsContext.SetLimitCommits(false)
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingCommits, func(gocui.Task) error {
+		self.c.Refresh(types.RefreshOptions{Mode: types.SYNC, Scope: []types.RefreshableView{types.COMMITS}})
+
+		self.c.OnUIThread(func() error {
+			if !self.selectCommit(hash) {
+				return self.commitNotFoundError(hash)
+			}
+			return nil
+		})
+		return nil
+	})
+}
+
+func (self *CommitsHelper) selectCommit(hash string) bool {
+	commitsContext := self.c.Contexts().LocalCommits
+	if !commitsContext.SelectCommitByHash(hash) {
+		return false
+	}
+
+	self.c.PostRefreshUpdate(commitsContext)
+	return true
+}
+
+func (self *CommitsHelper) commitNotFoundError(hash string) error {
+	return errors.New(utils.ResolvePlaceholderString(self.c.Tr.CommitNotInCurrentHistory,
+		map[string]string{"hash": utils.ShortHash(hash)}))
+}
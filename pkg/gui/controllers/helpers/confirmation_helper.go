@@ -179,6 +179,12 @@ func (self *ConfirmationHelper) CreatePopupPanel(ctx goContext.Context, opts typ
 	self.c.State().GetRepoState().SetCurrentPopupOpts(&opts)
 
 	self.c.Context().Push(self.c.Contexts().Confirmation, types.OnFocusOpts{})
+
+	announcement := opts.Title
+	if opts.Prompt != "" && !opts.Mask {
+		announcement += ": " + opts.Prompt
+	}
+	self.c.Announce(announcement)
 }
 
 func (self *ConfirmationHelper) setKeyBindings(cancel goContext.CancelFunc, opts types.CreatePopupPanelOpts) {
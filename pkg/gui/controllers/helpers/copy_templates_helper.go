@@ -0,0 +1,129 @@
+package helpers
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Turns the user's copyTemplates config into entries for the copy menus.
+
+type CopyTemplatesHelper struct {
+	c *HelperCommon
+}
+
+func NewCopyTemplatesHelper(c *HelperCommon) *CopyTemplatesHelper {
+	return &CopyTemplatesHelper{
+		c: c,
+	}
+}
+
+type CommitCopyFields struct {
+	Hash        string
+	ShortHash   string
+	Subject     string
+	Author      string
+	AuthorEmail string
+	AuthorDate  string
+	Tags        string
+}
+
+type BranchCopyFields struct {
+	Name      string
+	Upstream  string
+	Hash      string
+	ShortHash string
+	Subject   string
+}
+
+type FileCopyFields struct {
+	Name         string
+	Path         string
+	AbsolutePath string
+}
+
+func (self *CopyTemplatesHelper) CommitMenuItems(commit *models.Commit) []*types.MenuItem {
+	return self.menuItems(self.c.UserConfig().CopyTemplates.Commits, CommitCopyFields{
+		Hash:        commit.Hash(),
+		ShortHash:   commit.ShortHash(),
+		Subject:     commit.Name,
+		Author:      commit.AuthorName,
+		AuthorEmail: commit.AuthorEmail,
+		AuthorDate:  formatAuthorDate(commit.UnixTimestamp, self.c.UserConfig().Gui.TimeFormat),
+		Tags:        strings.Join(commit.Tags, ", "),
+	})
+}
+
+func (self *CopyTemplatesHelper) BranchMenuItems(branch *models.Branch) []*types.MenuItem {
+	return self.menuItems(self.c.UserConfig().CopyTemplates.Branches, BranchCopyFields{
+		Name:      branch.Name,
+		Upstream:  lo.Ternary(branch.IsTrackingRemote(), branch.ShortUpstreamRefName(), ""),
+		Hash:      branch.CommitHash,
+		ShortHash: utils.ShortHash(branch.CommitHash),
+		Subject:   branch.Subject,
+	})
+}
+
+func (self *CopyTemplatesHelper) FileMenuItems(path string) []*types.MenuItem {
+	return self.menuItems(self.c.UserConfig().CopyTemplates.Files, FileCopyFields{
+		Name:         filepath.Base(path),
+		Path:         path,
+		AbsolutePath: filepath.Join(self.c.Git().RepoPaths.RepoPath(), path),
+	})
+}
+
+func (self *CopyTemplatesHelper) menuItems(copyTemplates []config.CopyTemplate, fields any) []*types.MenuItem {
+	return lo.Map(copyTemplates, func(copyTemplate config.CopyTemplate, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label: copyTemplate.Description,
+			OnPress: func() error {
+				text, err := renderCopyTemplate(copyTemplate.Template, fields)
+				if err != nil {
+					return err
+				}
+
+				self.c.LogAction(self.c.Tr.Actions.CopyToClipboard)
+				if err := self.c.OS().CopyToClipboard(text); err != nil {
+					return err
+				}
+
+				truncatedText := utils.TruncateWithEllipsis(strings.ReplaceAll(text, "\n", " "), 50)
+				self.c.Toast(fmt.Sprintf("'%s' %s", truncatedText, self.c.Tr.CopiedToClipboard))
+				return nil
+			},
+			Key: keybindings.GetKey(copyTemplate.Key),
+		}
+	})
+}
+
+// Commits that haven't been made yet, like TODOs of a rebase, have no date
+func formatAuthorDate(unixTimestamp int64, timeFormat string) string {
+	if unixTimestamp == 0 {
+		return ""
+	}
+
+	return time.Unix(unixTimestamp, 0).Format(timeFormat)
+}
+
+func renderCopyTemplate(templateStr string, fields any) (string, error) {
+	tmpl, err := template.New("copy").Option("missingkey=error").Parse(templateStr)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, fields); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,54 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderCopyTemplate(t *testing.T) {
+	scenarios := []struct {
+		name          string
+		template      string
+		fields        any
+		expected      string
+		expectedError bool
+	}{
+		{
+			name:     "commit reference",
+			template: "{{.ShortHash}} ({{.Subject}}, {{.AuthorDate}})",
+			fields:   CommitCopyFields{ShortHash: "abc1234", Subject: "Fix the thing", AuthorDate: "01 Feb 24"},
+			expected: "abc1234 (Fix the thing, 01 Feb 24)",
+		},
+		{
+			name:     "markdown link to a file",
+			template: "[{{.Name}}]({{.Path}})",
+			fields:   FileCopyFields{Name: "main.go", Path: "cmd/main.go"},
+			expected: "[main.go](cmd/main.go)",
+		},
+		{
+			name:          "unknown field",
+			template:      "{{.Author}}",
+			fields:        BranchCopyFields{Name: "feature"},
+			expectedError: true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			result, err := renderCopyTemplate(s.template, s.fields)
+			if s.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, s.expected, result)
+			}
+		})
+	}
+}
+
+func TestFormatAuthorDate(t *testing.T) {
+	assert.Equal(t, "", formatAuthorDate(0, time.DateOnly))
+	assert.Equal(t, "2024-02-01", formatAuthorDate(time.Date(2024, 2, 1, 12, 0, 0, 0, time.Local).Unix(), time.DateOnly))
+}
@@ -0,0 +1,66 @@
+package helpers
+
+import (
+	"errors"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Large enough for any list, and small enough that a typo doesn't keep us busy
+// for ages
+const maxCountPrefix = 9999
+
+// Keeps track of the number that the user typed before a key, like in vim
+// (e.g. 5j), so that repeatable actions can be run that many times.
+type CountPrefixHelper struct {
+	c *HelperCommon
+
+	count int
+	// Whether the key that is currently being handled was a digit of the count
+	addedDigit bool
+}
+
+func NewCountPrefixHelper(c *HelperCommon) *CountPrefixHelper {
+	return &CountPrefixHelper{
+		c: c,
+	}
+}
+
+// The pending count, or zero if none has been typed
+func (self *CountPrefixHelper) Count() int {
+	return self.count
+}
+
+func (self *CountPrefixHelper) AddDigit(digit int) {
+	self.count = min(self.count*10+digit, maxCountPrefix)
+	self.addedDigit = true
+}
+
+// Runs the handler of a binding, as many times as the pending count says if
+// the binding is repeatable. Any key other than the digits of the count uses up
+// the count, so that it doesn't linger until the next repeatable action.
+func (self *CountPrefixHelper) Call(binding *types.Binding, call func() error) error {
+	count := self.count
+	if count == 0 || !binding.Repeatable {
+		self.addedDigit = false
+		err := call()
+		if !self.addedDigit && !errors.Is(err, gocui.ErrKeybindingNotHandled) {
+			self.count = 0
+		}
+		return err
+	}
+
+	self.count = 0
+	for i := range count {
+		// The first time round, the handler itself reports why it's disabled
+		if i > 0 && binding.IsDisabled() {
+			return nil
+		}
+		if err := call(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -21,10 +21,15 @@ func NewCredentialsHelper(
 // We return a channel rather than returning the string directly so that the calling function knows
 // when the prompt has been created (before the user has entered anything) so that it can
 // note that we're now waiting on user input and lazygit isn't processing anything.
-func (self *CredentialsHelper) PromptUserForCredential(passOrUname oscommands.CredentialType) <-chan string {
+func (self *CredentialsHelper) PromptUserForCredential(passOrUname oscommands.CredentialType, prompt string) <-chan string {
 	ch := make(chan string)
 
 	self.c.OnUIThread(func() error {
+		if passOrUname == oscommands.Confirmation {
+			self.confirm(prompt, ch)
+			return nil
+		}
+
 		title, mask := self.getTitleAndMask(passOrUname)
 
 		self.c.Prompt(types.PromptOpts{
@@ -49,6 +54,26 @@ func (self *CredentialsHelper) PromptUserForCredential(passOrUname oscommands.Cr
 	return ch
 }
 
+// The prompt is ssh's question, e.g. whether to trust an unknown host key,
+// including the fingerprint the user needs to check before answering
+func (self *CredentialsHelper) confirm(prompt string, ch chan<- string) {
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.CredentialsConfirmation,
+		Prompt: prompt,
+		HandleConfirm: func() error {
+			ch <- "yes\n"
+
+			self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+			return nil
+		},
+		HandleClose: func() error {
+			ch <- "no\n"
+
+			return nil
+		},
+	})
+}
+
 func (self *CredentialsHelper) getTitleAndMask(passOrUname oscommands.CredentialType) (string, bool) {
 	switch passOrUname {
 	case oscommands.Username:
@@ -61,6 +86,8 @@ func (self *CredentialsHelper) getTitleAndMask(passOrUname oscommands.Credential
 		return self.c.Tr.CredentialsPIN, true
 	case oscommands.Token:
 		return self.c.Tr.CredentialsToken, true
+	case oscommands.OneTimePassword:
+		return self.c.Tr.CredentialsOneTimePassword, true
 	}
 
 	// should never land here
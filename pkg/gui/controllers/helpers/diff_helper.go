@@ -1,13 +1,17 @@
 package helpers
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 
+	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
@@ -51,8 +55,9 @@ func (self *DiffHelper) DiffArgs() []string {
 // diff for the selected commit(s). We need to pass both the selected commit
 // and the refRange for a range selection. If the refRange is nil (meaning that
 // either there's no range, or it can't be diffed for some reason), then we want
-// to fall back to rendering the diff for the single commit.
-func (self *DiffHelper) GetUpdateTaskForRenderingCommitsDiff(commit *models.Commit, refRange *types.RefRange) types.UpdateTask {
+// to fall back to rendering the diff for the single commit. An active pickaxe
+// limits the single commit's diff to the files that match it.
+func (self *DiffHelper) GetUpdateTaskForRenderingCommitsDiff(commit *models.Commit, refRange *types.RefRange, pickaxe git_commands.Pickaxe) types.UpdateTask {
 	if refRange != nil {
 		from, to := refRange.From, refRange.To
 		args := []string{from.ParentRefName(), to.RefName(), "--stat", "-p"}
@@ -77,10 +82,27 @@ func (self *DiffHelper) GetUpdateTaskForRenderingCommitsDiff(commit *models.Comm
 		return types.NewRunPtyTaskWithPrefix(cmdObj.GetCmd(), prefix)
 	}
 
-	cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Hash(), self.FilterPathsForCommit(commit))
+	cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Hash(), self.FilterPathsForCommit(commit), pickaxe)
+	if self.c.UserConfig().Gui.ShowCommitDetailsHeader {
+		hash := commit.Hash()
+		return types.NewRunPtyTaskWithPrefixFn(cmdObj.GetCmd(), func() string {
+			return self.commitDetailsHeader(hash)
+		})
+	}
 	return types.NewRunPtyTask(cmdObj.GetCmd())
 }
 
+// Runs git commands, so don't call this on the UI thread
+func (self *DiffHelper) commitDetailsHeader(hash string) string {
+	details, err := self.c.Git().Commit.GetCommitDetails(hash)
+	if err != nil {
+		self.c.Log.Error(err)
+		return ""
+	}
+
+	return presentation.GetCommitDetailsHeader(details, self.c.Tr, self.c.UserConfig().Gui.TimeFormat)
+}
+
 func (self *DiffHelper) FilterPathsForCommit(commit *models.Commit) []string {
 	filterPath := self.c.Modes().Filtering.GetPath()
 	if filterPath != "" {
@@ -192,9 +214,10 @@ func (self *DiffHelper) OpenDiffToolForRef(selectedRef models.Ref) error {
 // AdjustLineNumber is used to adjust a line number in the diff that's currently
 // being viewed, so that it corresponds to the line number in the actual working
 // copy state of the file. It is used when clicking on a delta hyperlink in a
-// diff, or when pressing `e` in the staging or patch building panels. It works
-// by getting a diff of what's being viewed in the main view against the working
-// copy, and then using that diff to adjust the line number.
+// diff, or when pressing `e` in the staging or patch building panels or in the
+// focused main view. It works by getting a diff of what's being viewed in the
+// main view against the working copy, and then using that diff to adjust the
+// line number.
 // path is the file path of the file being viewed
 // linenumber is the line number to adjust (one-based)
 // viewname is the name of the view that shows the diff. We need to pass it
@@ -230,3 +253,93 @@ func (self *DiffHelper) adjustLineNumber(linenumber int, diffArgs ...string) int
 	patch := patch.Parse(diff)
 	return patch.AdjustLineNumber(linenumber)
 }
+
+// Where to read one version of a file from
+type FileVersionSource struct {
+	// The ref to read the file from, or "" for the index
+	Ref string
+	// If true, the file is read from the working copy instead of from a ref
+	WorkingCopy bool
+}
+
+// A file whose diff is shown in a main view, to be replaced with information
+// about its old and new version if git considers it binary
+type BinaryFileCheck struct {
+	Path       string
+	IsBinary   func() bool
+	OldVersion FileVersionSource
+	NewVersion FileVersionSource
+}
+
+// Renders opts, and then replaces the diff in the main and secondary view with
+// information about the file's old and new version if the corresponding check
+// finds that it is binary, since git's diff of binary files isn't very useful.
+// Either check may be nil. Finding out whether the file is binary and reading
+// its versions takes a few git calls, so we do it on a worker; isStillShown is
+// called on the UI thread afterwards, so that we don't replace the diff of a
+// file that was selected in the meantime.
+func (self *DiffHelper) RenderWithBinaryFileInfo(
+	opts types.RefreshMainOpts,
+	mainCheck *BinaryFileCheck,
+	secondaryCheck *BinaryFileCheck,
+	isStillShown func() bool,
+) {
+	self.c.RenderToMainViews(opts)
+
+	if !self.c.UserConfig().Gui.ShowBinaryFileInfo || (mainCheck == nil && secondaryCheck == nil) {
+		return
+	}
+
+	self.c.OnWorker(func(gocui.Task) error {
+		mainInfo, isMainBinary := self.binaryFileInfo(mainCheck)
+		secondaryInfo, isSecondaryBinary := self.binaryFileInfo(secondaryCheck)
+		if !isMainBinary && !isSecondaryBinary {
+			return nil
+		}
+
+		self.c.OnUIThread(func() error {
+			if !isStillShown() {
+				return nil
+			}
+
+			if isMainBinary {
+				opts.Main = withStringTask(opts.Main, mainInfo)
+			}
+			if isSecondaryBinary {
+				opts.Secondary = withStringTask(opts.Secondary, secondaryInfo)
+			}
+			self.c.RenderToMainViews(opts)
+			return nil
+		})
+		return nil
+	})
+}
+
+func (self *DiffHelper) binaryFileInfo(check *BinaryFileCheck) (string, bool) {
+	if check == nil || !check.IsBinary() {
+		return "", false
+	}
+
+	return presentation.GetBinaryFileInfo(
+		check.Path,
+		self.readFileVersion(check.Path, check.OldVersion),
+		self.readFileVersion(check.Path, check.NewVersion),
+		self.c.Tr,
+	), true
+}
+
+func withStringTask(opts *types.ViewUpdateOpts, str string) *types.ViewUpdateOpts {
+	result := *opts
+	result.Task = types.NewRenderStringTask(str)
+	return &result
+}
+
+func (self *DiffHelper) readFileVersion(path string, source FileVersionSource) presentation.BinaryFileVersion {
+	if source.WorkingCopy {
+		content, err := os.ReadFile(filepath.Join(self.c.Git().RepoPaths.WorktreePath(), path))
+		return presentation.BinaryFileVersion{Content: content, Exists: err == nil}
+	}
+
+	content, err := self.c.Git().Commit.ShowFileContentCmdObj(source.Ref, path).RunWithOutput()
+	return presentation.BinaryFileVersion{Content: []byte(content), Exists: err == nil}
+}
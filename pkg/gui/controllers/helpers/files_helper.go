@@ -3,6 +3,7 @@ package helpers
 import (
 	"path/filepath"
 
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/samber/lo"
 )
 
@@ -81,3 +82,23 @@ func (self *FilesHelper) OpenFile(filename string) error {
 	}
 	return nil
 }
+
+// Remembers which directories are collapsed in the Files and Commit Files
+// trees, so that they are still collapsed the next time the repo is opened.
+func (self *FilesHelper) SaveCollapsedDirs() {
+	appState := self.c.GetAppState()
+	repoPath := self.c.Git().RepoPaths.RepoPath()
+	collapsedDirs := config.CollapsedDirs{
+		Files:       self.c.Contexts().Files.CollapsedPaths().Paths(),
+		CommitFiles: self.c.Contexts().CommitFiles.CollapsedPaths().Paths(),
+	}
+	if len(collapsedDirs.Files) == 0 && len(collapsedDirs.CommitFiles) == 0 {
+		delete(appState.CollapsedDirsByRepo, repoPath)
+	} else {
+		if appState.CollapsedDirsByRepo == nil {
+			appState.CollapsedDirsByRepo = map[string]config.CollapsedDirs{}
+		}
+		appState.CollapsedDirsByRepo[repoPath] = collapsedDirs
+	}
+	self.c.SaveAppStateAndLogError()
+}
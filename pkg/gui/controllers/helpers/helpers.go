@@ -27,6 +27,7 @@ type Helpers struct {
 	MergeAndRebase *MergeAndRebaseHelper
 	MergeConflicts *MergeConflictsHelper
 	CherryPick     *CherryPickHelper
+	MarkedCommits  *MarkedCommitsHelper
 	Host           *HostHelper
 	PatchBuilding  *PatchBuildingHelper
 	Staging        *StagingHelper
@@ -50,9 +51,18 @@ type Helpers struct {
 	AppStatus         *AppStatusHelper
 	InlineStatus      *InlineStatusHelper
 	WindowArrangement *WindowArrangementHelper
+	PanelResize       *PanelResizeHelper
+	Scrollbar         *ScrollbarHelper
+	StatusBar         *StatusBarHelper
 	Search            *SearchHelper
 	Worktree          *WorktreeHelper
 	SubCommits        *SubCommitsHelper
+	CopyTemplates     *CopyTemplatesHelper
+	Notification      *NotificationHelper
+	Snapshot          *SnapshotHelper
+	Macro             *MacroHelper
+	CountPrefix       *CountPrefixHelper
+	Accessibility     *AccessibilityHelper
 }
 
 func NewStubHelpers() *Helpers {
@@ -66,6 +76,7 @@ func NewStubHelpers() *Helpers {
 		MergeAndRebase:    &MergeAndRebaseHelper{},
 		MergeConflicts:    &MergeConflictsHelper{},
 		CherryPick:        &CherryPickHelper{},
+		MarkedCommits:     &MarkedCommitsHelper{},
 		Host:              &HostHelper{},
 		PatchBuilding:     &PatchBuildingHelper{},
 		Staging:           &StagingHelper{},
@@ -87,8 +98,17 @@ func NewStubHelpers() *Helpers {
 		AppStatus:         &AppStatusHelper{},
 		InlineStatus:      &InlineStatusHelper{},
 		WindowArrangement: &WindowArrangementHelper{},
+		PanelResize:       &PanelResizeHelper{},
+		Scrollbar:         &ScrollbarHelper{},
+		StatusBar:         &StatusBarHelper{},
 		Search:            &SearchHelper{},
 		Worktree:          &WorktreeHelper{},
 		SubCommits:        &SubCommitsHelper{},
+		CopyTemplates:     &CopyTemplatesHelper{},
+		Notification:      &NotificationHelper{},
+		Snapshot:          &SnapshotHelper{},
+		Macro:             &MacroHelper{},
+		CountPrefix:       &CountPrefixHelper{},
+		Accessibility:     &AccessibilityHelper{},
 	}
 }
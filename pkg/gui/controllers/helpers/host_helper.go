@@ -1,29 +1,95 @@
 package helpers
 
 import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 // this helper just wraps our hosting_service package
 
 type HostHelper struct {
-	c *HelperCommon
+	c                *HelperCommon
+	credentialLookup *hosting_service.CredentialLookup
 }
 
 func NewHostHelper(
 	c *HelperCommon,
 ) *HostHelper {
-	return &HostHelper{
+	self := &HostHelper{
 		c: c,
 	}
+	self.credentialLookup = hosting_service.NewCredentialLookup(self.runCredentialCommand)
+	return self
 }
 
-func (self *HostHelper) GetPullRequestURL(from string, to string) (string, error) {
-	mgr, err := self.getHostingServiceMgr()
+// Returns the remote whose hosting service is used for pull requests, commit
+// links and releases: the one chosen for the repo in the create pull request
+// options menu, or else the hostingServiceRemote config. Falls back to origin
+// if that remote doesn't exist.
+func (self *HostHelper) GetHostingServiceRemote() string {
+	remote := self.c.GetAppState().HostingServiceRemoteByRepo[self.c.Git().RepoPaths.RepoPath()]
+	if remote == "" {
+		remote = self.c.UserConfig().HostingServiceRemote
+	}
+
+	remoteExists := lo.SomeBy(self.c.Model().Remotes, func(r *models.Remote) bool {
+		return r.Name == remote
+	})
+	if !remoteExists {
+		return "origin"
+	}
+	return remote
+}
+
+func (self *HostHelper) SetHostingServiceRemote(remote string) {
+	appState := self.c.GetAppState()
+	if appState.HostingServiceRemoteByRepo == nil {
+		appState.HostingServiceRemoteByRepo = map[string]string{}
+	}
+	appState.HostingServiceRemoteByRepo[self.c.Git().RepoPaths.RepoPath()] = remote
+	self.c.SaveAppStateAndLogError()
+}
+
+// Returns the URL for creating a pull request from a branch on fromRemote
+// into a branch on toRemote. An empty toRemote means the hosting service
+// remote. If the remotes differ, the pull request is created in toRemote's
+// repo from the fork, where the service supports that, and in the fork's repo
+// otherwise.
+func (self *HostHelper) GetPullRequestURL(fromRemote string, toRemote string, from string, to string) (string, error) {
+	if toRemote == "" {
+		toRemote = self.GetHostingServiceRemote()
+	}
+
+	mgr, err := self.getHostingServiceMgrForRemote(toRemote)
 	if err != nil {
 		return "", err
 	}
-	return mgr.GetPullRequestURL(from, to)
+	if fromRemote == "" || fromRemote == toRemote {
+		return mgr.GetPullRequestURL(from, to)
+	}
+
+	forkRemoteURL, err := self.c.Git().Remote.GetRemoteURL(fromRemote)
+	if err != nil {
+		return "", err
+	}
+	url, err := mgr.GetPullRequestURLFromFork(forkRemoteURL, from, to)
+	if err == nil {
+		return url, nil
+	}
+
+	self.c.Log.Warnf("Creating pull request in fork '%s' instead of '%s': %v", fromRemote, toRemote, err)
+	forkMgr, err := self.getHostingServiceMgrForRemote(fromRemote)
+	if err != nil {
+		return "", err
+	}
+	return forkMgr.GetPullRequestURL(from, to)
 }
 
 func (self *HostHelper) GetCommitURL(commitHash string) (string, error) {
@@ -34,13 +100,198 @@ func (self *HostHelper) GetCommitURL(commitHash string) (string, error) {
 	return mgr.GetCommitURL(commitHash)
 }
 
+func (self *HostHelper) GetNewReleaseURL(tag string, title string, notes string) (string, error) {
+	mgr, err := self.getHostingServiceMgr()
+	if err != nil {
+		return "", err
+	}
+	return mgr.GetNewReleaseURL(tag, title, notes)
+}
+
+// Returns an empty string if releases can't be created through the API of
+// the repo's hosting service, e.g. because no token is configured. May run
+// commands to look up the token, so call it on a worker.
+func (self *HostHelper) GetReleaseAPIToken() string {
+	mgr, err := self.getHostingServiceMgr()
+	if err != nil {
+		return ""
+	}
+	return mgr.GetReleaseAPIToken()
+}
+
+func (self *HostHelper) CreateRelease(tag string, title string, notes string, token string) (string, error) {
+	mgr, err := self.getHostingServiceMgr()
+	if err != nil {
+		return "", err
+	}
+	return mgr.CreateRelease(tag, title, notes, token)
+}
+
+// The following functions take the name of the remote whose hosting service
+// should be used, so that pull requests can be managed on remotes other than
+// origin, e.g. on a self-hosted GitLab instance.
+
+// Returns whether pull requests can be managed through the API of the
+// remote's hosting service, without looking up a token
+func (self *HostHelper) HasPullRequestAPI(remote string) bool {
+	mgr, err := self.getHostingServiceMgrForRemote(remote)
+	return err == nil && mgr.HasPullRequestAPI()
+}
+
+// Returns an empty string if pull requests can't be managed through the API
+// of the remote's hosting service, e.g. because no token is configured. May
+// run commands to look up the token, so call it on a worker.
+func (self *HostHelper) GetPullRequestAPIToken(remote string) string {
+	mgr, err := self.getHostingServiceMgrForRemote(remote)
+	if err != nil {
+		return ""
+	}
+	return mgr.GetPullRequestAPIToken()
+}
+
+// Returns the contents of the first template for pull request descriptions
+// that exists in the worktree, or an empty string if there is none
+func (self *HostHelper) GetPullRequestTemplate(remote string) string {
+	mgr, err := self.getHostingServiceMgrForRemote(remote)
+	if err != nil {
+		return ""
+	}
+
+	for _, path := range mgr.GetPullRequestTemplatePaths() {
+		content, err := os.ReadFile(filepath.Join(self.c.Git().RepoPaths.WorktreePath(), path))
+		if err == nil {
+			return strings.TrimSpace(string(content))
+		}
+	}
+	return ""
+}
+
+func (self *HostHelper) ListPullRequests(remote string, token string) ([]*hosting_service.PullRequest, error) {
+	mgr, err := self.getHostingServiceMgrForRemote(remote)
+	if err != nil {
+		return nil, err
+	}
+	return mgr.ListPullRequests(token)
+}
+
+// Creates a pull request in toRemote's repo from a branch on fromRemote,
+// which may be a fork of it
+func (self *HostHelper) CreatePullRequestViaAPI(fromRemote string, toRemote string, from string, to string, title string, description string, token string) (string, error) {
+	mgr, err := self.getHostingServiceMgrForRemote(toRemote)
+	if err != nil {
+		return "", err
+	}
+
+	forkRemoteURL := ""
+	if fromRemote != toRemote {
+		forkRemoteURL, err = self.c.Git().Remote.GetRemoteURL(fromRemote)
+		if err != nil {
+			return "", err
+		}
+	}
+	return mgr.CreatePullRequestViaAPI(forkRemoteURL, from, to, title, description, token)
+}
+
+// Returns whether issues can be listed through the API of the remote's
+// hosting service, without looking up a token
+func (self *HostHelper) HasIssueAPI(remote string) bool {
+	mgr, err := self.getHostingServiceMgrForRemote(remote)
+	return err == nil && mgr.HasIssueAPI()
+}
+
+// Returns an empty string if issues can't be listed through the API of the
+// remote's hosting service. May run commands to look up the token, so call
+// it on a worker.
+func (self *HostHelper) GetIssueAPIToken(remote string) string {
+	mgr, err := self.getHostingServiceMgrForRemote(remote)
+	if err != nil {
+		return ""
+	}
+	return mgr.GetIssueAPIToken()
+}
+
+func (self *HostHelper) ListIssues(remote string, token string) ([]*hosting_service.Issue, error) {
+	mgr, err := self.getHostingServiceMgrForRemote(remote)
+	if err != nil {
+		return nil, err
+	}
+	return mgr.ListIssues(token)
+}
+
+// Lists the open issues of the remote's repo that are assigned to the owner
+// of the token
+func (self *HostHelper) ListAssignedIssues(remote string, token string) ([]*hosting_service.Issue, error) {
+	mgr, err := self.getHostingServiceMgrForRemote(remote)
+	if err != nil {
+		return nil, err
+	}
+	return mgr.ListAssignedIssues(token)
+}
+
+// Assigns and labels the issue as configured in the issues config
+func (self *HostHelper) StartWorkOnIssue(remote string, issue *hosting_service.Issue, token string) error {
+	mgr, err := self.getHostingServiceMgrForRemote(remote)
+	if err != nil {
+		return err
+	}
+	issuesConfig := self.c.UserConfig().Issues
+	return mgr.StartWorkOnIssue(issue, issuesConfig.AssignOnStartWork, issuesConfig.LabelOnStartWork, token)
+}
+
+// Returns the name of the branch for working on the issue, according to the
+// issues.branchNameTemplate config
+func (self *HostHelper) GetIssueBranchName(issue *hosting_service.Issue) (string, error) {
+	return issueBranchName(self.c.UserConfig().Issues.BranchNameTemplate, issue)
+}
+
+var nonAlphanumericRegex = regexp.MustCompile(`[^\p{L}\p{N}]+`)
+
+func issueBranchName(branchNameTemplate string, issue *hosting_service.Issue) (string, error) {
+	title := nonAlphanumericRegex.ReplaceAllString(strings.ToLower(issue.Title), "-")
+	return utils.ResolveTemplate(branchNameTemplate, map[string]any{
+		"Number": issue.Number,
+		"Title":  strings.Trim(title, "-"),
+		"Author": issue.Author,
+	}, nil)
+}
+
+func (self *HostHelper) GetPipelineStatus(remote string, branch string, token string) (*hosting_service.PipelineStatus, error) {
+	mgr, err := self.getHostingServiceMgrForRemote(remote)
+	if err != nil {
+		return nil, err
+	}
+	return mgr.GetPipelineStatus(branch, token)
+}
+
+func (self *HostHelper) getHostingServiceMgr() (*hosting_service.HostingServiceMgr, error) {
+	return self.getHostingServiceMgrForRemote(self.GetHostingServiceRemote())
+}
+
 // getting this on every request rather than storing it in state in case our remoteURL changes
 // from one invocation to the next.
-func (self *HostHelper) getHostingServiceMgr() (*hosting_service.HostingServiceMgr, error) {
-	remoteUrl, err := self.c.Git().Remote.GetRemoteURL("origin")
+func (self *HostHelper) getHostingServiceMgrForRemote(remote string) (*hosting_service.HostingServiceMgr, error) {
+	remoteUrl, err := self.c.Git().Remote.GetRemoteURL(remote)
 	if err != nil {
 		return nil, err
 	}
 	configServices := self.c.UserConfig().Services
-	return hosting_service.NewHostingServiceMgr(self.c.Log, self.c.Tr, remoteUrl, configServices), nil
+	remoteService := self.c.UserConfig().RemoteServices[remote]
+	return hosting_service.NewHostingServiceMgr(self.c.Log, self.c.Tr, remoteUrl, configServices).
+		WithRemoteService(hosting_service.RemoteService{
+			Provider:    remoteService.Provider,
+			WebDomain:   remoteService.WebDomain,
+			TokenEnvVar: remoteService.TokenEnvVar,
+		}).
+		WithCredentialLookup(self.credentialLookup, self.c.UserConfig().HostingServiceTokenSources), nil
+}
+
+// Runs a command that prints a stored API token. Git must not prompt for
+// credentials that it doesn't have, neither in the terminal nor through a
+// credential manager's login window.
+func (self *HostHelper) runCredentialCommand(args []string, stdin string) (string, error) {
+	return self.c.OS().Cmd.New(args).
+		SetStdin(stdin).
+		AddEnvVars("GIT_TERMINAL_PROMPT=0", "GCM_INTERACTIVE=never").
+		DontLog().
+		RunWithOutput()
 }
@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueBranchName(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		template string
+		issue    *hosting_service.Issue
+		expected string
+	}{
+		{
+			name:     "default template",
+			template: "{{.Number}}-{{.Title}}",
+			issue:    &hosting_service.Issue{Number: 12, Title: "Crash when opening a file"},
+			expected: "12-crash-when-opening-a-file",
+		},
+		{
+			name:     "punctuation is collapsed and trimmed",
+			template: "{{.Number}}-{{.Title}}",
+			issue:    &hosting_service.Issue{Number: 3, Title: "[UI] Don't crash on `git stash` (again!)"},
+			expected: "3-ui-don-t-crash-on-git-stash-again",
+		},
+		{
+			name:     "non-ascii letters are kept",
+			template: "{{.Number}}-{{.Title}}",
+			issue:    &hosting_service.Issue{Number: 5, Title: "Übersetzung für Größe"},
+			expected: "5-übersetzung-für-größe",
+		},
+		{
+			name:     "author",
+			template: "{{.Author}}/issue-{{.Number}}",
+			issue:    &hosting_service.Issue{Number: 7, Title: "Dark mode", Author: "paul"},
+			expected: "paul/issue-7",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			branchName, err := issueBranchName(s.template, s.issue)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, branchName)
+		})
+	}
+}
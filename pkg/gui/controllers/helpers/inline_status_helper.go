@@ -66,15 +66,15 @@ func (self *InlineStatusHelper) WithInlineStatus(opts InlineStatusOpts, f func(g
 	context := self.c.ContextForKey(opts.ContextKey).(types.IListContext)
 	view := context.GetView()
 	visible := view.Visible && self.windowHelper.TopViewInWindow(context.GetWindowName(), false) == view
+	message := presentation.ItemOperationToString(opts.Operation, self.c.Tr)
 	if visible && context.IsItemVisible(opts.Item) {
-		self.c.OnWorker(func(task gocui.Task) error {
+		self.c.OnWorkerWithName(message, func(task gocui.Task) error {
 			self.start(opts)
 			defer self.stop(opts)
 
 			return f(inlineStatusHelperTask{task, self, opts})
 		})
 	} else {
-		message := presentation.ItemOperationToString(opts.Operation, self.c.Tr)
 		_ = self.c.WithWaitingStatus(message, func(t gocui.Task) error {
 			// We still need to set the item operation, because it might be used
 			// for other (non-presentation) purposes
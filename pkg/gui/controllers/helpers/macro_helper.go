@@ -0,0 +1,148 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Runs built-in actions by their keys, as if the user had pressed them, for
+// macros and for replaying keypress recordings. Each key is looked up in
+// whatever view has focus at the time, so that e.g. a menu can be opened and an
+// item picked from it.
+type MacroHelper struct {
+	c                 *HelperCommon
+	countPrefixHelper *CountPrefixHelper
+
+	running      bool
+	recording    bool
+	recordedKeys []string
+}
+
+func NewMacroHelper(c *HelperCommon, countPrefixHelper *CountPrefixHelper) *MacroHelper {
+	return &MacroHelper{
+		c:                 c,
+		countPrefixHelper: countPrefixHelper,
+	}
+}
+
+// The first failing key ends the run, so that e.g. we don't push if committing
+// didn't work
+func (self *MacroHelper) Run(keys []string) error {
+	// Nested macros could easily end up calling each other forever
+	if self.running {
+		return errors.New(self.c.Tr.MacroCannotRunMacro)
+	}
+	self.running = true
+	defer func() { self.running = false }()
+
+	for _, key := range keys {
+		if err := self.runKey(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (self *MacroHelper) runKey(key string) error {
+	viewName := self.c.Context().Current().GetViewName()
+	bindings, _ := self.c.GetInitialKeybindingsWithCustomCommands()
+
+	// Like gocui, we try the bindings of the focused view before the global
+	// ones, and move on to the next binding if one doesn't handle the key
+	for _, bindingViewName := range []string{viewName, ""} {
+		for _, binding := range bindings {
+			if binding.ViewName != bindingViewName || binding.Modifier != gocui.ModNone ||
+				binding.Key != keybindings.GetKey(key) || binding.Handler == nil {
+				continue
+			}
+
+			if binding.GetDisabledReason != nil {
+				if disabledReason := binding.GetDisabledReason(); disabledReason != nil {
+					if disabledReason.AllowFurtherDispatching {
+						continue
+					}
+					return fmt.Errorf(self.c.Tr.MacroStepDisabled, key, disabledReason.Text)
+				}
+			}
+
+			err := self.countPrefixHelper.Call(binding, binding.Handler)
+			if errors.Is(err, gocui.ErrKeybindingNotHandled) {
+				continue
+			}
+			return err
+		}
+	}
+
+	return fmt.Errorf(self.c.Tr.MacroStepNotFound, key, viewName)
+}
+
+func (self *MacroHelper) IsRecording() bool {
+	return self.recording
+}
+
+func (self *MacroHelper) ToggleRecording() error {
+	if !self.recording {
+		self.recording = true
+		self.recordedKeys = nil
+		self.c.Toast(self.c.Tr.KeypressRecordingStarted)
+		return nil
+	}
+
+	self.recording = false
+	if len(self.recordedKeys) == 0 {
+		self.c.Toast(self.c.Tr.KeypressRecordingEmpty)
+		return nil
+	}
+
+	self.c.GetAppState().KeypressRecording = self.recordedKeys
+	self.c.SaveAppStateAndLogError()
+	self.c.Toast(fmt.Sprintf(self.c.Tr.KeypressRecordingSaved, strings.Join(self.recordedKeys, " ")))
+	return nil
+}
+
+// Called for every keypress that a binding has handled. Text typed into an
+// editor doesn't have a binding, so it can't be recorded.
+func (self *MacroHelper) RecordKey(binding *types.Binding) {
+	if !self.recording || binding.Modifier != gocui.ModNone || gocui.IsMouseKey(binding.Key) {
+		return
+	}
+
+	self.recordedKeys = append(self.recordedKeys, keybindings.LabelFromKey(binding.Key))
+}
+
+func (self *MacroHelper) ReplayRecording() error {
+	if self.recording {
+		return errors.New(self.c.Tr.CannotReplayWhileRecording)
+	}
+
+	keys := self.c.GetAppState().KeypressRecording
+	if len(keys) == 0 {
+		return errors.New(self.c.Tr.NoKeypressRecording)
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title:          fmt.Sprintf(self.c.Tr.ReplayKeypressRecordingTitle, strings.Join(keys, " ")),
+		InitialContent: "1",
+		HandleConfirm: func(input string) error {
+			times, err := strconv.Atoi(strings.TrimSpace(input))
+			if err != nil || times < 1 {
+				return errors.New(self.c.Tr.InvalidReplayCount)
+			}
+
+			for range times {
+				if err := self.Run(keys); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+	return nil
+}
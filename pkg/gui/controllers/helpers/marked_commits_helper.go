@@ -0,0 +1,60 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/marked_commits"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Marked commits let the user build up a non-contiguous set of commits in a
+// commits view, which operations like cherry-pick copy and drop then act on
+// instead of the selected range.
+type MarkedCommitsHelper struct {
+	c *HelperCommon
+}
+
+func NewMarkedCommitsHelper(c *HelperCommon) *MarkedCommitsHelper {
+	return &MarkedCommitsHelper{
+		c: c,
+	}
+}
+
+func (self *MarkedCommitsHelper) getData() *marked_commits.MarkedCommits {
+	return self.c.Modes().MarkedCommits
+}
+
+func (self *MarkedCommitsHelper) Toggle(context types.Context, commits []*models.Commit) {
+	self.getData().Toggle(string(context.GetKey()), commits)
+	self.rerender()
+}
+
+// Returns true if there are marked commits in the given context
+func (self *MarkedCommitsHelper) IsActiveIn(context types.Context) bool {
+	return self.getData().ActiveIn(string(context.GetKey()))
+}
+
+// Returns the commits marked in the given context, in the order in which they
+// appear in the list, along with their indices in the list.
+func (self *MarkedCommitsHelper) MarkedCommits(context types.Context, commitsList []*models.Commit) ([]*models.Commit, []int) {
+	if !self.IsActiveIn(context) {
+		return nil, nil
+	}
+
+	return self.getData().Find(commitsList)
+}
+
+func (self *MarkedCommitsHelper) Reset() error {
+	self.getData().Reset()
+	self.rerender()
+	return nil
+}
+
+func (self *MarkedCommitsHelper) rerender() {
+	for _, context := range []types.Context{
+		self.c.Contexts().LocalCommits,
+		self.c.Contexts().ReflogCommits,
+		self.c.Contexts().SubCommits,
+	} {
+		self.c.PostRefreshUpdate(context)
+	}
+}
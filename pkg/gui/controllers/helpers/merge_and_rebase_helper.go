@@ -10,6 +10,8 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -17,14 +19,17 @@ import (
 )
 
 type MergeAndRebaseHelper struct {
-	c *HelperCommon
+	c                  *HelperCommon
+	notificationHelper *NotificationHelper
 }
 
 func NewMergeAndRebaseHelper(
 	c *HelperCommon,
+	notificationHelper *NotificationHelper,
 ) *MergeAndRebaseHelper {
 	return &MergeAndRebaseHelper{
-		c: c,
+		c:                  c,
+		notificationHelper: notificationHelper,
 	}
 }
 
@@ -63,6 +68,19 @@ func (self *MergeAndRebaseHelper) CreateRebaseOptionsMenu() error {
 		}
 	})
 
+	autoContinue := self.c.UserConfig().Git.AutoContinueAfterResolvingConflicts
+	menuItems = append(menuItems, &types.MenuItem{
+		Label:  self.c.Tr.AutoContinueAfterConflicts,
+		Widget: types.MakeMenuCheckBox(autoContinue),
+		OnPress: func() error {
+			// Only changed for the current session; to make it stick, set it in the config
+			self.c.UserConfig().Git.AutoContinueAfterResolvingConflicts = !autoContinue
+			return nil
+		},
+		Key:     'u',
+		Tooltip: self.c.Tr.AutoContinueAfterConflictsTooltip,
+	})
+
 	title := self.c.Git().Status.WorkingTreeState().OptionsMenuTitle(self.c.Tr)
 	return self.c.Menu(types.CreateMenuOptions{Title: title, Items: menuItems})
 }
@@ -106,11 +124,16 @@ func (self *MergeAndRebaseHelper) genericMergeCommand(command string) error {
 
 	if needsSubprocess {
 		// TODO: see if we should be calling more of the code from self.Git.Rebase.GenericMergeOrRebaseAction
-		return self.c.RunSubprocessAndRefresh(
-			self.c.Git().Rebase.GenericMergeOrRebaseActionCmdObj(commandType, command),
-		)
+		cmdObj := self.c.Git().Rebase.GenericMergeOrRebaseActionCmdObj(commandType, command)
+		if effectiveStatus == models.WORKING_TREE_STATE_REBASING {
+			return self.notificationHelper.RunSubprocessAndRefresh(NotifiableRebase, cmdObj)
+		}
+		return self.c.RunSubprocessAndRefresh(cmdObj)
 	}
 	result := self.c.Git().Rebase.GenericMergeOrRebaseAction(commandType, command)
+	if effectiveStatus == models.WORKING_TREE_STATE_REBASING && command != REBASE_OPTION_ABORT {
+		self.notificationHelper.NotifyIfUnfocused(NotifiableRebase, result)
+	}
 	if err := self.CheckMergeOrRebase(result); err != nil {
 		return err
 	}
@@ -222,44 +245,48 @@ func (self *MergeAndRebaseHelper) AbortMergeOrRebaseWithConfirm() error {
 // PromptToContinueRebase asks the user if they want to continue the rebase/merge that's in progress
 func (self *MergeAndRebaseHelper) PromptToContinueRebase() error {
 	self.c.Confirm(types.ConfirmOpts{
-		Title:  self.c.Tr.Continue,
-		Prompt: fmt.Sprintf(self.c.Tr.ConflictsResolved, self.c.Git().Status.WorkingTreeState().CommandName()),
-		HandleConfirm: func() error {
-			// By the time we get here, we might have unstaged changes again,
-			// e.g. if the user had to fix build errors after resolving the
-			// conflicts, but after lazygit opened the prompt already. Ask again
-			// to auto-stage these.
-
-			// Need to refresh the files to be really sure if this is the case.
-			// We would otherwise be relying on lazygit's auto-refresh on focus,
-			// but this is not supported by all terminals or on all platforms.
-			self.c.Refresh(types.RefreshOptions{
-				Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES},
-			})
-
-			root := self.c.Contexts().Files.FileTreeViewModel.GetRoot()
-			if root.GetHasUnstagedChanges() {
-				self.c.Confirm(types.ConfirmOpts{
-					Title:  self.c.Tr.Continue,
-					Prompt: self.c.Tr.UnstagedFilesAfterConflictsResolved,
-					HandleConfirm: func() error {
-						self.c.LogAction(self.c.Tr.Actions.StageAllFiles)
-						if err := self.c.Git().WorkingTree.StageAll(true); err != nil {
-							return err
-						}
-
-						return self.genericMergeCommand(REBASE_OPTION_CONTINUE)
-					},
-				})
+		Title:         self.c.Tr.Continue,
+		Prompt:        fmt.Sprintf(self.c.Tr.ConflictsResolved, self.c.Git().Status.WorkingTreeState().CommandName()),
+		HandleConfirm: self.ContinueAfterConflictsResolved,
+	})
 
-				return nil
-			}
+	return nil
+}
 
-			return self.genericMergeCommand(REBASE_OPTION_CONTINUE)
-		},
+// ContinueAfterConflictsResolved continues the rebase/merge that's in
+// progress, asking the user first if there are still unstaged changes
+func (self *MergeAndRebaseHelper) ContinueAfterConflictsResolved() error {
+	// By the time we get here, we might have unstaged changes again,
+	// e.g. if the user had to fix build errors after resolving the
+	// conflicts, but after lazygit opened the prompt already. Ask again
+	// to auto-stage these.
+
+	// Need to refresh the files to be really sure if this is the case.
+	// We would otherwise be relying on lazygit's auto-refresh on focus,
+	// but this is not supported by all terminals or on all platforms.
+	self.c.Refresh(types.RefreshOptions{
+		Mode: types.SYNC, Scope: []types.RefreshableView{types.FILES},
 	})
 
-	return nil
+	root := self.c.Contexts().Files.FileTreeViewModel.GetRoot()
+	if root.GetHasUnstagedChanges() {
+		self.c.Confirm(types.ConfirmOpts{
+			Title:  self.c.Tr.Continue,
+			Prompt: self.c.Tr.UnstagedFilesAfterConflictsResolved,
+			HandleConfirm: func() error {
+				self.c.LogAction(self.c.Tr.Actions.StageAllFiles)
+				if err := self.c.Git().WorkingTree.StageAll(true); err != nil {
+					return err
+				}
+
+				return self.genericMergeCommand(REBASE_OPTION_CONTINUE)
+			},
+		})
+
+		return nil
+	}
+
+	return self.genericMergeCommand(REBASE_OPTION_CONTINUE)
 }
 
 func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
@@ -279,6 +306,22 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 		baseBranchDisabledReason = &types.DisabledReason{Text: self.c.Tr.CouldNotDetermineBaseBranch}
 	}
 
+	options := self.getRebaseOptions()
+	rebaseOpts := git_commands.RebaseOpts{
+		ResetAuthorDate:           options.ResetAuthorDate,
+		CommitterDateIsAuthorDate: options.CommitterDateIsAuthorDate,
+	}
+	// Changing an option re-opens the menu so that more options can be changed
+	// before rebasing
+	updateOptions := func(update func(*config.RebaseOptions)) func() error {
+		return func() error {
+			update(&options)
+			self.saveRebaseOptions(options)
+			return self.RebaseOntoRef(ref)
+		}
+	}
+	optionsSection := &types.MenuSection{Title: self.c.Tr.RebaseOptionsSection}
+
 	menuItems := []*types.MenuItem{
 		{
 			Label: utils.ResolvePlaceholderString(self.c.Tr.SimpleRebase,
@@ -292,10 +335,11 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 					baseCommit := self.c.Modes().MarkedBaseCommit.GetHash()
 					var err error
 					if baseCommit != "" {
-						err = self.c.Git().Rebase.RebaseBranchFromBaseCommit(ref, baseCommit)
+						err = self.c.Git().Rebase.RebaseBranchFromBaseCommit(ref, baseCommit, rebaseOpts)
 					} else {
-						err = self.c.Git().Rebase.RebaseBranch(ref)
+						err = self.c.Git().Rebase.RebaseBranch(ref, rebaseOpts)
 					}
+					self.notificationHelper.NotifyIfUnfocused(NotifiableRebase, err)
 					err = self.CheckMergeOrRebase(err)
 					if err == nil {
 						return self.ResetMarkedBaseCommit()
@@ -316,9 +360,9 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 				baseCommit := self.c.Modes().MarkedBaseCommit.GetHash()
 				var err error
 				if baseCommit != "" {
-					err = self.c.Git().Rebase.EditRebaseFromBaseCommit(ref, baseCommit)
+					err = self.c.Git().Rebase.EditRebaseFromBaseCommit(ref, baseCommit, rebaseOpts)
 				} else {
-					err = self.c.Git().Rebase.EditRebase(ref)
+					err = self.c.Git().Rebase.EditRebase(ref, rebaseOpts)
 				}
 				if err = self.CheckMergeOrRebase(err); err != nil {
 					return err
@@ -343,10 +387,11 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 					baseCommit := self.c.Modes().MarkedBaseCommit.GetHash()
 					var err error
 					if baseCommit != "" {
-						err = self.c.Git().Rebase.RebaseBranchFromBaseCommit(baseBranch, baseCommit)
+						err = self.c.Git().Rebase.RebaseBranchFromBaseCommit(baseBranch, baseCommit, rebaseOpts)
 					} else {
-						err = self.c.Git().Rebase.RebaseBranch(baseBranch)
+						err = self.c.Git().Rebase.RebaseBranch(baseBranch, rebaseOpts)
 					}
+					self.notificationHelper.NotifyIfUnfocused(NotifiableRebase, err)
 					err = self.CheckMergeOrRebase(err)
 					if err == nil {
 						return self.ResetMarkedBaseCommit()
@@ -355,6 +400,26 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 				})
 			},
 		},
+		{
+			Label:  self.c.Tr.RebaseResetAuthorDate,
+			Widget: types.MakeMenuCheckBox(options.ResetAuthorDate),
+			OnPress: updateOptions(func(options *config.RebaseOptions) {
+				options.ResetAuthorDate = !options.ResetAuthorDate
+			}),
+			Key:     'd',
+			Tooltip: self.c.Tr.RebaseResetAuthorDateTooltip,
+			Section: optionsSection,
+		},
+		{
+			Label:  self.c.Tr.RebaseKeepDates,
+			Widget: types.MakeMenuCheckBox(options.CommitterDateIsAuthorDate),
+			OnPress: updateOptions(func(options *config.RebaseOptions) {
+				options.CommitterDateIsAuthorDate = !options.CommitterDateIsAuthorDate
+			}),
+			Key:     'c',
+			Tooltip: self.c.Tr.RebaseKeepDatesTooltip,
+			Section: optionsSection,
+		},
 	}
 
 	title := utils.ResolvePlaceholderString(
@@ -372,21 +437,41 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 	})
 }
 
-func (self *MergeAndRebaseHelper) MergeRefIntoCheckedOutBranch(refName string) error {
+func (self *MergeAndRebaseHelper) checkCanMerge() error {
 	if self.c.Git().Branch.IsHeadDetached() {
 		return errors.New("Cannot merge branch in detached head state. You might have checked out a commit directly or a remote branch, in which case you should checkout the local branch you want to be on")
 	}
+
+	return nil
+}
+
+func (self *MergeAndRebaseHelper) MergeRefIntoCheckedOutBranch(refName string) error {
+	if err := self.checkCanMerge(); err != nil {
+		return err
+	}
 	checkedOutBranchName := self.c.Model().Branches[0].Name
 	if checkedOutBranchName == refName {
 		return errors.New(self.c.Tr.CantMergeBranchIntoItself)
 	}
 
+	options := self.getMergeOptions()
+	// Changing an option re-opens the menu so that more options can be changed
+	// before merging
+	updateOptions := func(update func(*config.MergeOptions)) func() error {
+		return func() error {
+			update(&options)
+			self.saveMergeOptions(options)
+			return self.MergeRefIntoCheckedOutBranch(refName)
+		}
+	}
+	optionsSection := &types.MenuSection{Title: self.c.Tr.MergeOptionsSection}
+
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.Merge,
 		Items: []*types.MenuItem{
 			{
 				Label:   self.c.Tr.RegularMerge,
-				OnPress: self.RegularMerge(refName),
+				OnPress: self.RegularMerge(refName, checkedOutBranchName, options),
 				Key:     'm',
 				Tooltip: utils.ResolvePlaceholderString(
 					self.c.Tr.RegularMergeTooltip,
@@ -398,7 +483,7 @@ func (self *MergeAndRebaseHelper) MergeRefIntoCheckedOutBranch(refName string) e
 			},
 			{
 				Label:   self.c.Tr.SquashMergeUncommittedTitle,
-				OnPress: self.SquashMergeUncommitted(refName),
+				OnPress: self.SquashMergeUncommitted(refName, options),
 				Key:     's',
 				Tooltip: utils.ResolvePlaceholderString(
 					self.c.Tr.SquashMergeUncommitted,
@@ -409,7 +494,7 @@ func (self *MergeAndRebaseHelper) MergeRefIntoCheckedOutBranch(refName string) e
 			},
 			{
 				Label:   self.c.Tr.SquashMergeCommittedTitle,
-				OnPress: self.SquashMergeCommitted(refName, checkedOutBranchName),
+				OnPress: self.SquashMergeCommitted(refName, checkedOutBranchName, options),
 				Key:     'S',
 				Tooltip: utils.ResolvePlaceholderString(
 					self.c.Tr.SquashMergeCommitted,
@@ -419,30 +504,231 @@ func (self *MergeAndRebaseHelper) MergeRefIntoCheckedOutBranch(refName string) e
 					},
 				),
 			},
+			{
+				Label:   self.c.Tr.PreviewMerge,
+				OnPress: self.PreviewMerge(refName, checkedOutBranchName),
+				Key:     'p',
+				Tooltip: utils.ResolvePlaceholderString(
+					self.c.Tr.PreviewMergeTooltip,
+					map[string]string{
+						"checkedOutBranch": checkedOutBranchName,
+						"selectedBranch":   refName,
+					},
+				),
+			},
+			{
+				Label:  self.c.Tr.MergeNoFastForward,
+				Widget: types.MakeMenuCheckBox(options.NoFastForward),
+				OnPress: updateOptions(func(options *config.MergeOptions) {
+					options.NoFastForward = !options.NoFastForward
+					options.FastForwardOnly = false
+				}),
+				Key:     'n',
+				Tooltip: self.c.Tr.MergeNoFastForwardTooltip,
+				Section: optionsSection,
+			},
+			{
+				Label:  self.c.Tr.MergeFastForwardOnly,
+				Widget: types.MakeMenuCheckBox(options.FastForwardOnly),
+				OnPress: updateOptions(func(options *config.MergeOptions) {
+					options.FastForwardOnly = !options.FastForwardOnly
+					options.NoFastForward = false
+				}),
+				Key:     'f',
+				Tooltip: self.c.Tr.MergeFastForwardOnlyTooltip,
+				Section: optionsSection,
+			},
+			{
+				Label: utils.ResolvePlaceholderString(self.c.Tr.MergeStrategyOption, map[string]string{
+					"option": lo.Ternary(options.StrategyOption == "", self.c.Tr.MergeStrategyOptionDefault, options.StrategyOption),
+				}),
+				OnPress: func() error {
+					return self.createStrategyOptionMenu(options.StrategyOption, func(strategyOption string) error {
+						return updateOptions(func(options *config.MergeOptions) {
+							options.StrategyOption = strategyOption
+						})()
+					})
+				},
+				OpensMenu: true,
+				Key:       'x',
+				Tooltip:   self.c.Tr.MergeStrategyOptionTooltip,
+				Section:   optionsSection,
+			},
+			{
+				Label:  self.c.Tr.MergeEditMessage,
+				Widget: types.MakeMenuCheckBox(options.EditMessage),
+				OnPress: updateOptions(func(options *config.MergeOptions) {
+					options.EditMessage = !options.EditMessage
+				}),
+				Key:     'e',
+				Tooltip: self.c.Tr.MergeEditMessageTooltip,
+				Section: optionsSection,
+			},
 		},
 	})
 }
 
-func (self *MergeAndRebaseHelper) RegularMerge(refName string) func() error {
+func (self *MergeAndRebaseHelper) createStrategyOptionMenu(current string, onSelect func(string) error) error {
+	strategyOptions := []string{"", "ours", "theirs", "patience", "ignore-all-space"}
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.MergeStrategyOptionTitle,
+		Items: lo.Map(strategyOptions, func(strategyOption string, _ int) *types.MenuItem {
+			return &types.MenuItem{
+				Label:   lo.Ternary(strategyOption == "", self.c.Tr.MergeStrategyOptionDefault, "-X "+strategyOption),
+				Widget:  types.MakeMenuRadioButton(strategyOption == current),
+				OnPress: func() error { return onSelect(strategyOption) },
+			}
+		}),
+	})
+}
+
+func (self *MergeAndRebaseHelper) getMergeOptions() config.MergeOptions {
+	return self.c.GetAppState().MergeOptionsByRepo[self.c.Git().RepoPaths.RepoPath()]
+}
+
+func (self *MergeAndRebaseHelper) saveMergeOptions(options config.MergeOptions) {
+	appState := self.c.GetAppState()
+	if appState.MergeOptionsByRepo == nil {
+		appState.MergeOptionsByRepo = map[string]config.MergeOptions{}
+	}
+	appState.MergeOptionsByRepo[self.c.Git().RepoPaths.RepoPath()] = options
+	self.c.SaveAppStateAndLogError()
+}
+
+func (self *MergeAndRebaseHelper) getRebaseOptions() config.RebaseOptions {
+	return self.c.GetAppState().RebaseOptionsByRepo[self.c.Git().RepoPaths.RepoPath()]
+}
+
+func (self *MergeAndRebaseHelper) saveRebaseOptions(options config.RebaseOptions) {
+	appState := self.c.GetAppState()
+	if appState.RebaseOptionsByRepo == nil {
+		appState.RebaseOptionsByRepo = map[string]config.RebaseOptions{}
+	}
+	appState.RebaseOptionsByRepo[self.c.Git().RepoPaths.RepoPath()] = options
+	self.c.SaveAppStateAndLogError()
+}
+
+// OctopusMergeRefsIntoCheckedOutBranch merges all the given refs into the
+// checked out branch in a single merge commit
+func (self *MergeAndRebaseHelper) OctopusMergeRefsIntoCheckedOutBranch(refNames []string) error {
+	if err := self.checkCanMerge(); err != nil {
+		return err
+	}
+	checkedOutBranchName := self.c.Model().Branches[0].Name
+	if lo.Contains(refNames, checkedOutBranchName) {
+		return errors.New(self.c.Tr.CantMergeBranchIntoItself)
+	}
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.OctopusMerge,
+		Prompt: utils.ResolvePlaceholderString(
+			self.c.Tr.OctopusMergePrompt,
+			map[string]string{
+				"checkedOutBranch": checkedOutBranchName,
+				"branches":         strings.Join(refNames, "\n"),
+			},
+		),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.OctopusMerge)
+			err := self.c.Git().Branch.OctopusMerge(refNames)
+			// If the branches conflict with each other (rather than just with
+			// the checked out branch), git gives up and leaves the working
+			// tree untouched, so there are no conflicts for the user to resolve
+			if err != nil && strings.Contains(err.Error(), "Merge with strategy octopus failed") {
+				self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+				return errors.New(self.c.Tr.OctopusMergeFailed)
+			}
+			return self.CheckMergeOrRebase(err)
+		},
+	})
+
+	return nil
+}
+
+// PreviewMerge shows in the main view which files would conflict when merging
+// the given ref into the checked out branch, followed by the diff that the
+// merge would produce (including conflict markers)
+func (self *MergeAndRebaseHelper) PreviewMerge(refName string, checkedOutBranchName string) func() error {
 	return func() error {
+		// Computing the merge can take a while in big repos
+		return self.c.WithWaitingStatus(self.c.Tr.PreviewingMergeStatus, func(gocui.Task) error {
+			preview, err := self.c.Git().Branch.PreviewMerge(refName)
+			if err != nil {
+				return err
+			}
+
+			placeholders := map[string]string{
+				"checkedOutBranch": checkedOutBranchName,
+				"selectedBranch":   refName,
+			}
+			summary := utils.ResolvePlaceholderString(self.c.Tr.MergePreviewClean, placeholders)
+			if len(preview.ConflictedFiles) > 0 {
+				summary = utils.ResolvePlaceholderString(self.c.Tr.MergePreviewConflicts, placeholders) + "\n\n" +
+					strings.Join(lo.Map(preview.ConflictedFiles, func(path string, _ int) string {
+						return "  " + style.FgRed.Sprint(path)
+					}), "\n")
+			}
+
+			diff, err := self.c.Git().Diff.DiffCmdObj([]string{"HEAD", preview.TreeID}).DontLog().RunWithOutput()
+			if err != nil {
+				return err
+			}
+
+			self.c.OnUIThread(func() error {
+				self.c.RenderToMainViews(types.RefreshMainOpts{
+					Pair: self.c.MainViewPairs().Normal,
+					Main: &types.ViewUpdateOpts{
+						Title: self.c.Tr.MergePreviewTitle,
+						Task:  types.NewRenderStringTask(summary + "\n\n" + diff),
+					},
+				})
+				return nil
+			})
+
+			return nil
+		})
+	}
+}
+
+func (self *MergeAndRebaseHelper) RegularMerge(refName string, checkedOutBranchName string, options config.MergeOptions) func() error {
+	merge := func(message string) error {
 		self.c.LogAction(self.c.Tr.Actions.Merge)
-		err := self.c.Git().Branch.Merge(refName, git_commands.MergeOpts{})
+		err := self.c.Git().Branch.Merge(refName, git_commands.MergeOpts{
+			FastForwardOnly: options.FastForwardOnly,
+			NoFastForward:   options.NoFastForward,
+			StrategyOption:  options.StrategyOption,
+			Message:         message,
+		})
 		return self.CheckMergeOrRebase(err)
 	}
+
+	return func() error {
+		if !options.EditMessage {
+			return merge("")
+		}
+
+		self.c.Prompt(types.PromptOpts{
+			Title:          self.c.Tr.MergeCommitMessageTitle,
+			InitialContent: fmt.Sprintf("Merge branch '%s' into %s", refName, checkedOutBranchName),
+			HandleConfirm:  merge,
+		})
+
+		return nil
+	}
 }
 
-func (self *MergeAndRebaseHelper) SquashMergeUncommitted(refName string) func() error {
+func (self *MergeAndRebaseHelper) SquashMergeUncommitted(refName string, options config.MergeOptions) func() error {
 	return func() error {
 		self.c.LogAction(self.c.Tr.Actions.SquashMerge)
-		err := self.c.Git().Branch.Merge(refName, git_commands.MergeOpts{Squash: true})
+		err := self.c.Git().Branch.Merge(refName, git_commands.MergeOpts{Squash: true, StrategyOption: options.StrategyOption})
 		return self.CheckMergeOrRebase(err)
 	}
 }
 
-func (self *MergeAndRebaseHelper) SquashMergeCommitted(refName, checkedOutBranchName string) func() error {
+func (self *MergeAndRebaseHelper) SquashMergeCommitted(refName, checkedOutBranchName string, options config.MergeOptions) func() error {
 	return func() error {
 		self.c.LogAction(self.c.Tr.Actions.SquashMerge)
-		err := self.c.Git().Branch.Merge(refName, git_commands.MergeOpts{Squash: true})
+		err := self.c.Git().Branch.Merge(refName, git_commands.MergeOpts{Squash: true, StrategyOption: options.StrategyOption})
 		if err = self.CheckMergeOrRebase(err); err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
@@ -15,6 +16,7 @@ type ModeHelper struct {
 	diffHelper           *DiffHelper
 	patchBuildingHelper  *PatchBuildingHelper
 	cherryPickHelper     *CherryPickHelper
+	markedCommitsHelper  *MarkedCommitsHelper
 	mergeAndRebaseHelper *MergeAndRebaseHelper
 	bisectHelper         *BisectHelper
 	suppressRebasingMode bool
@@ -25,6 +27,7 @@ func NewModeHelper(
 	diffHelper *DiffHelper,
 	patchBuildingHelper *PatchBuildingHelper,
 	cherryPickHelper *CherryPickHelper,
+	markedCommitsHelper *MarkedCommitsHelper,
 	mergeAndRebaseHelper *MergeAndRebaseHelper,
 	bisectHelper *BisectHelper,
 ) *ModeHelper {
@@ -33,6 +36,7 @@ func NewModeHelper(
 		diffHelper:           diffHelper,
 		patchBuildingHelper:  patchBuildingHelper,
 		cherryPickHelper:     cherryPickHelper,
+		markedCommitsHelper:  markedCommitsHelper,
 		mergeAndRebaseHelper: mergeAndRebaseHelper,
 		bisectHelper:         bisectHelper,
 	}
@@ -77,15 +81,7 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 		{
 			IsActive: self.c.Modes().Filtering.Active,
 			InfoLabel: func() string {
-				filterContent := lo.Ternary(self.c.Modes().Filtering.GetPath() != "", self.c.Modes().Filtering.GetPath(), self.c.Modes().Filtering.GetAuthor())
-				return self.withResetButton(
-					fmt.Sprintf(
-						"%s '%s'",
-						self.c.Tr.FilteringBy,
-						filterContent,
-					),
-					style.FgRed,
-				)
+				return self.withResetButton(self.filteringLabel(), style.FgRed)
 			},
 			CancelLabel: func() string {
 				return self.c.Tr.ExitFilterMode
@@ -105,6 +101,29 @@ func (self *ModeHelper) Statuses() []ModeStatus {
 			},
 			Reset: self.mergeAndRebaseHelper.ResetMarkedBaseCommit,
 		},
+		{
+			IsActive: self.c.Modes().MarkedCommits.Active,
+			InfoLabel: func() string {
+				markedCount := self.c.Modes().MarkedCommits.Count()
+				text := self.c.Tr.CommitsMarked
+				if markedCount == 1 {
+					text = self.c.Tr.CommitMarked
+				}
+
+				return self.withResetButton(
+					fmt.Sprintf(
+						"%d %s",
+						markedCount,
+						text,
+					),
+					style.FgMagenta,
+				)
+			},
+			CancelLabel: func() string {
+				return self.c.Tr.ResetMarkedCommits
+			},
+			Reset: self.markedCommitsHelper.Reset,
+		},
 		{
 			IsActive: self.c.Modes().CherryPicking.Active,
 			InfoLabel: func() string {
@@ -207,14 +226,101 @@ func (self *ModeHelper) ClearFiltering() error {
 	return nil
 }
 
+func (self *ModeHelper) filteringLabel() string {
+	filter := self.c.Modes().Filtering
+	path := filter.GetPath()
+
+	// When only filtering by author we show it the same way as a path
+	mainFilter := lo.Ternary(path != "", path, filter.GetAuthor())
+	commitFilters := []string{}
+	if path != "" && filter.GetAuthor() != "" {
+		commitFilters = append(commitFilters, self.commitFilterLabel(self.c.Tr.CommitFilterAuthorLabel, filter.GetAuthor()))
+	}
+	if filter.GetSince() != "" {
+		commitFilters = append(commitFilters, self.commitFilterLabel(self.c.Tr.CommitFilterSinceLabel, filter.GetSince()))
+	}
+	if filter.GetUntil() != "" {
+		commitFilters = append(commitFilters, self.commitFilterLabel(self.c.Tr.CommitFilterUntilLabel, filter.GetUntil()))
+	}
+	if filter.GetGrep() != "" {
+		commitFilters = append(commitFilters, self.commitFilterLabel(self.c.Tr.CommitFilterMessageLabel, filter.GetGrep()))
+	}
+	if filter.GetNoMerges() {
+		commitFilters = append(commitFilters, self.c.Tr.CommitFilterNoMergesLabel)
+	}
+
+	label := self.c.Tr.FilteringCommits
+	if mainFilter != "" {
+		label = fmt.Sprintf("%s '%s'", self.c.Tr.FilteringBy, mainFilter)
+	}
+	if len(commitFilters) > 0 {
+		label += fmt.Sprintf(" (%s)", strings.Join(commitFilters, ", "))
+	}
+	if path != "" && path == self.SavedFilterPath() {
+		label += " " + self.c.Tr.SavedForThisRepo
+	}
+	return label
+}
+
+func (self *ModeHelper) commitFilterLabel(template string, value string) string {
+	return utils.ResolvePlaceholderString(template, map[string]string{"value": value})
+}
+
+// The path filter that is applied automatically when opening the current repo,
+// or an empty string if there is none.
+func (self *ModeHelper) SavedFilterPath() string {
+	return self.c.GetAppState().FilterPathsByRepo[self.c.Git().RepoPaths.RepoPath()]
+}
+
+// Passing an empty path forgets the saved path filter for the current repo.
+func (self *ModeHelper) SetSavedFilterPath(path string) {
+	appState := self.c.GetAppState()
+	repoPath := self.c.Git().RepoPaths.RepoPath()
+	if path == "" {
+		delete(appState.FilterPathsByRepo, repoPath)
+	} else {
+		if appState.FilterPathsByRepo == nil {
+			appState.FilterPathsByRepo = map[string]string{}
+		}
+		appState.FilterPathsByRepo[repoPath] = path
+	}
+	self.c.SaveAppStateAndLogError()
+}
+
+// Switches between filtering by the saved path filter and showing the whole
+// repo, without forgetting the saved path filter.
+func (self *ModeHelper) ToggleSavedFilterPath() error {
+	savedPath := self.SavedFilterPath()
+	if savedPath == "" {
+		return nil
+	}
+
+	if self.c.Modes().Filtering.GetPath() == savedPath {
+		return self.ClearFiltering()
+	}
+
+	self.c.Modes().Filtering.Reset()
+	self.c.Modes().Filtering.SetSelectedCommitHash(self.c.Contexts().LocalCommits.GetSelectedCommitHash())
+	self.c.Modes().Filtering.SetPath(savedPath)
+	self.c.Refresh(types.RefreshOptions{
+		Scope: ScopesToRefreshWhenFilteringModeChanges(),
+		Then: func() {
+			self.c.Contexts().LocalCommits.SetSelection(0)
+			self.c.PostRefreshUpdate(self.c.Contexts().LocalCommits)
+		},
+	})
+	return nil
+}
+
 // Stashes really only need to be refreshed when filtering by path, not by author, but it's too much
-// work to distinguish this, and refreshing stashes is fast, so we don't bother
+// work to distinguish this, and refreshing stashes is fast, so we don't bother. Same for files.
 func ScopesToRefreshWhenFilteringModeChanges() []types.RefreshableView {
 	return []types.RefreshableView{
 		types.COMMITS,
 		types.SUB_COMMITS,
 		types.REFLOG,
 		types.STASH,
+		types.FILES,
 	}
 }
 
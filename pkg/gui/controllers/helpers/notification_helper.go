@@ -0,0 +1,121 @@
+package helpers
+
+import (
+	"sync/atomic"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// An operation that we can show a desktop notification for when it finishes
+type NotifiableOperation int
+
+const (
+	NotifiablePush NotifiableOperation = iota
+	NotifiablePull
+	NotifiableFetch
+	NotifiableRebase
+)
+
+type NotificationHelper struct {
+	c *HelperCommon
+
+	// We assume that the terminal has focus until it tells us otherwise, so
+	// that we never notify in terminals that don't report focus changes
+	terminalUnfocused atomic.Bool
+
+	// Set while running a subprocess that we want to notify about when it
+	// finishes
+	subprocessOperation atomic.Pointer[NotifiableOperation]
+}
+
+func NewNotificationHelper(c *HelperCommon) *NotificationHelper {
+	return &NotificationHelper{
+		c: c,
+	}
+}
+
+func (self *NotificationHelper) SetTerminalFocused(focused bool) {
+	self.terminalUnfocused.Store(!focused)
+}
+
+// Shows a desktop notification about the finished operation, if notifications
+// are enabled for it and the terminal doesn't have focus
+func (self *NotificationHelper) NotifyIfUnfocused(operation NotifiableOperation, err error) {
+	if !self.terminalUnfocused.Load() {
+		return
+	}
+
+	self.notify(operation, err)
+}
+
+// Runs the subprocess, showing a desktop notification as soon as it finishes
+// (rather than after the user has returned to lazygit). We can't tell whether
+// the terminal has focus while the subprocess is running, so we always notify.
+func (self *NotificationHelper) RunSubprocessAndRefresh(operation NotifiableOperation, cmdObj *oscommands.CmdObj) error {
+	self.subprocessOperation.Store(&operation)
+	defer self.subprocessOperation.Store(nil)
+
+	return self.c.RunSubprocessAndRefresh(cmdObj)
+}
+
+// Called by the gui when a subprocess finishes, before waiting for the user
+// to return to lazygit
+func (self *NotificationHelper) OnSubprocessFinished(err error) {
+	if operation := self.subprocessOperation.Swap(nil); operation != nil {
+		self.notify(*operation, err)
+	}
+}
+
+func (self *NotificationHelper) notify(operation NotifiableOperation, err error) {
+	notificationsConfig := self.c.UserConfig().Notifications
+	if !notificationsConfig.Enabled || !isNotificationEnabled(notificationsConfig, operation) {
+		return
+	}
+
+	messageTemplate := self.c.Tr.OperationFinishedNotification
+	if err != nil {
+		messageTemplate = self.c.Tr.OperationFailedNotification
+	}
+	message := utils.ResolvePlaceholderString(messageTemplate, map[string]string{
+		"operation": self.operationName(operation),
+		"repo":      self.c.Git().RepoPaths.RepoName(),
+	})
+
+	// Don't hold up the operation's caller while the notification command runs
+	self.c.OnWorker(func(gocui.Task) error {
+		if err := self.c.OS().Notify("lazygit", message); err != nil {
+			self.c.Log.Errorf("error when showing notification: %v", err)
+		}
+		return nil
+	})
+}
+
+func (self *NotificationHelper) operationName(operation NotifiableOperation) string {
+	switch operation {
+	case NotifiablePush:
+		return self.c.Tr.Push
+	case NotifiablePull:
+		return self.c.Tr.Pull
+	case NotifiableFetch:
+		return self.c.Tr.Fetch
+	default:
+		return self.c.Tr.RebaseBranch
+	}
+}
+
+func isNotificationEnabled(notificationsConfig config.NotificationsConfig, operation NotifiableOperation) bool {
+	switch operation {
+	case NotifiablePush:
+		return notificationsConfig.Push
+	case NotifiablePull:
+		return notificationsConfig.Pull
+	case NotifiableFetch:
+		return notificationsConfig.Fetch
+	case NotifiableRebase:
+		return notificationsConfig.Rebase
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+package helpers
+
+import (
+	"math"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Lets the user resize the side panels and the command log by dragging the
+// borders between them and the main view with the mouse. The chosen sizes are
+// stored in the app state so that they survive restarts.
+
+type panelBorder int
+
+const (
+	noBorder panelBorder = iota
+	// between the side panels and the main view (or above the main view in
+	// portrait mode)
+	sidePanelBorder
+	// between the main view and the command log below it
+	extrasBorder
+)
+
+type PanelResizeHelper struct {
+	c            *HelperCommon
+	windowHelper *WindowHelper
+
+	draggedBorder panelBorder
+	// where the mouse was last seen while dragging a border
+	lastX, lastY int
+}
+
+func NewPanelResizeHelper(c *HelperCommon, windowHelper *WindowHelper) *PanelResizeHelper {
+	return &PanelResizeHelper{
+		c:            c,
+		windowHelper: windowHelper,
+	}
+}
+
+// HandleMouseEvent is called before the handler of any mouse binding of the
+// side, main and extras views, and returns true if the event was about
+// resizing a panel, in which case the view's own handler shouldn't run.
+func (self *PanelResizeHelper) HandleMouseEvent(viewName string, modifier gocui.Modifier, opts gocui.ViewMouseBindingOpts) bool {
+	view, err := self.c.GocuiGui().View(viewName)
+	if err != nil {
+		return false
+	}
+
+	// opts contains coordinates relative to the view's content; we need them
+	// relative to the screen
+	x0, y0, _, _ := view.Dimensions()
+	x := opts.X - view.OriginX() + x0 + 1
+	y := opts.Y - view.OriginY() + y0 + 1
+
+	switch {
+	case opts.Key == gocui.MouseLeft && modifier == gocui.ModNone:
+		self.draggedBorder = self.borderAt(x, y)
+		self.lastX, self.lastY = x, y
+		if self.draggedBorder != noBorder && opts.IsDoubleClick {
+			self.resetSize(self.draggedBorder)
+			self.draggedBorder = noBorder
+		}
+		return self.draggedBorder != noBorder
+
+	case opts.Key == gocui.MouseLeft && modifier == gocui.ModMotion:
+		if self.draggedBorder == noBorder {
+			return false
+		}
+		self.resize(x, y)
+		return true
+
+	case opts.Key == gocui.MouseRelease:
+		if self.draggedBorder == noBorder {
+			return false
+		}
+		// gocui reports the first move after pressing the button as a
+		// release, so we only stop dragging if the mouse didn't move
+		if x != self.lastX || y != self.lastY {
+			self.resize(x, y)
+			return true
+		}
+		self.draggedBorder = noBorder
+		self.c.SaveAppStateAndLogError()
+		return true
+	}
+
+	return false
+}
+
+type screenRect struct {
+	x0, y0, x1, y1 int
+}
+
+func (self *PanelResizeHelper) windowRect(windowName string) (screenRect, bool) {
+	view := self.windowHelper.TopViewInWindow(windowName, false)
+	if view == nil {
+		return screenRect{}, false
+	}
+	x0, y0, x1, y1 := view.Dimensions()
+	return screenRect{x0, y0, x1, y1}, true
+}
+
+func (self *PanelResizeHelper) portraitMode() bool {
+	width, height := self.c.GocuiGui().Size()
+	return shouldUsePortraitMode(WindowArrangementArgs{
+		Width:      width,
+		Height:     height,
+		UserConfig: self.c.UserConfig(),
+		ScreenMode: self.c.State().GetRepoState().GetScreenMode(),
+	})
+}
+
+// The borders are drawn as the frames of the adjacent views, so each border
+// is two characters thick and we accept a click on either of them.
+func (self *PanelResizeHelper) borderAt(x, y int) panelBorder {
+	if self.c.State().GetRepoState().GetScreenMode() != types.SCREEN_NORMAL {
+		return noBorder
+	}
+
+	main, ok := self.windowRect("main")
+	if !ok {
+		return noBorder
+	}
+	mainSection := main
+	if extras, ok := self.windowRect("extras"); ok {
+		mainSection.y1 = extras.y1
+		if (y == extras.y0 || y == extras.y0-1) && x >= extras.x0 && x <= extras.x1 {
+			return extrasBorder
+		}
+	}
+
+	// Either of the two frame columns next to the border can be dragged. One of
+	// them may also hold the scrollbar of a view, in which case the scrollbar
+	// wins and the border has to be dragged by the other one.
+	onRight := self.windowHelper.SidePanelsOnRight()
+	if self.portraitMode() {
+		borderY := lo.Ternary(onRight, mainSection.y1+1, mainSection.y0)
+		if (y == borderY || y == borderY-1) && x >= mainSection.x0 && x <= mainSection.x1 {
+			return sidePanelBorder
+		}
+	} else {
+		borderX := lo.Ternary(onRight, mainSection.x1+1, mainSection.x0)
+		if (x == borderX || x == borderX-1) && y >= mainSection.y0 && y <= mainSection.y1 {
+			return sidePanelBorder
+		}
+	}
+
+	return noBorder
+}
+
+func (self *PanelResizeHelper) resize(x, y int) {
+	self.lastX, self.lastY = x, y
+
+	switch self.draggedBorder {
+	case sidePanelBorder:
+		width, height := self.c.GocuiGui().Size()
+		onRight := self.windowHelper.SidePanelsOnRight()
+		var ratio float64
+		if self.portraitMode() {
+			ratio = float64(lo.Ternary(onRight, height-y, y+1)) / float64(height)
+		} else {
+			ratio = float64(lo.Ternary(onRight, width-x, x+1)) / float64(width)
+		}
+		self.c.GetAppState().SidePanelWidth = math.Round(lo.Clamp(ratio, 0.1, 0.9)*1000) / 1000
+	case extrasBorder:
+		main, ok1 := self.windowRect("main")
+		extras, ok2 := self.windowRect("extras")
+		if !ok1 || !ok2 {
+			return
+		}
+		// leave at least a few lines for the main view; the command log's
+		// frame is not part of its size
+		self.c.GetAppState().CommandLogSize = lo.Clamp(extras.y1-y-1, 1, max(extras.y1-main.y0-4, 1))
+	}
+}
+
+func (self *PanelResizeHelper) resetSize(border panelBorder) {
+	switch border {
+	case sidePanelBorder:
+		self.c.GetAppState().SidePanelWidth = 0
+	case extrasBorder:
+		self.c.GetAppState().CommandLogSize = 0
+	}
+	self.c.SaveAppStateAndLogError()
+}
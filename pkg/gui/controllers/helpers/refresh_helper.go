@@ -1,6 +1,7 @@
 package helpers
 
 import (
+	"fmt"
 	"strings"
 	"sync"
 	"time"
@@ -102,7 +103,7 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) {
 			// everything happens fast and it's better to have everything update
 			// in the one frame
 			if !self.c.InDemo() && options.Mode == types.ASYNC {
-				self.c.OnWorker(func(t gocui.Task) error {
+				self.c.OnWorkerWithName(fmt.Sprintf(self.c.Tr.RefreshTask, name), func(t gocui.Task) error {
 					f()
 					return nil
 				})
@@ -324,6 +325,10 @@ func (self *RefreshHelper) refreshCommitsWithLimit() error {
 			Limit:                self.c.Contexts().LocalCommits.GetLimitCommits(),
 			FilterPath:           self.c.Modes().Filtering.GetPath(),
 			FilterAuthor:         self.c.Modes().Filtering.GetAuthor(),
+			FilterSince:          self.c.Modes().Filtering.GetSince(),
+			FilterUntil:          self.c.Modes().Filtering.GetUntil(),
+			FilterGrep:           self.c.Modes().Filtering.GetGrep(),
+			FilterNoMerges:       self.c.Modes().Filtering.GetNoMerges(),
 			IncludeRebaseCommits: true,
 			RefName:              self.refForLog(),
 			RefForPushedStatus:   checkedOutRef,
@@ -338,6 +343,8 @@ func (self *RefreshHelper) refreshCommitsWithLimit() error {
 	self.c.Model().Commits = commits
 	self.RefreshAuthors(commits)
 	self.c.Model().WorkingTreeStateAtLastCommitRefresh = self.c.Git().Status.WorkingTreeState()
+	self.c.Model().RebasePausedAtBreak = self.c.Model().WorkingTreeStateAtLastCommitRefresh.Rebasing &&
+		self.c.Git().Rebase.IsPausedAtBreak()
 	if checkedOutRef != nil {
 		self.c.Model().CheckedOutBranch = checkedOutRef.RefName()
 	} else {
@@ -361,6 +368,11 @@ func (self *RefreshHelper) refreshSubCommitsWithLimit() error {
 			Limit:                   self.c.Contexts().SubCommits.GetLimitCommits(),
 			FilterPath:              self.c.Modes().Filtering.GetPath(),
 			FilterAuthor:            self.c.Modes().Filtering.GetAuthor(),
+			FilterSince:             self.c.Modes().Filtering.GetSince(),
+			FilterUntil:             self.c.Modes().Filtering.GetUntil(),
+			FilterGrep:              self.c.Modes().Filtering.GetGrep(),
+			FilterNoMerges:          self.c.Modes().Filtering.GetNoMerges(),
+			Pickaxe:                 self.c.Contexts().SubCommits.GetPickaxe(),
 			IncludeRebaseCommits:    false,
 			RefName:                 self.c.Contexts().SubCommits.GetRef().FullRefName(),
 			RefToShowDivergenceFrom: self.c.Contexts().SubCommits.GetRefToShowDivergenceFrom(),
@@ -402,6 +414,17 @@ func (self *RefreshHelper) refreshCommitFilesContext() error {
 	if err != nil {
 		return err
 	}
+
+	if stashEntry, ok := self.c.Contexts().CommitFiles.GetRef().(*models.StashEntry); ok && !self.c.Modes().Diffing.Active() {
+		untrackedPaths, err := self.c.Git().Stash.UntrackedFiles(stashEntry.Index)
+		if err != nil {
+			return err
+		}
+		for _, path := range untrackedPaths {
+			files = append(files, &models.CommitFile{Path: path, ChangeStatus: "A", Untracked: true})
+		}
+	}
+
 	self.c.Model().CommitFiles = files
 	self.c.Contexts().CommitFiles.CommitFileTreeViewModel.SetTree()
 
@@ -419,6 +442,8 @@ func (self *RefreshHelper) refreshRebaseCommits() error {
 	}
 	self.c.Model().Commits = updatedCommits
 	self.c.Model().WorkingTreeStateAtLastCommitRefresh = self.c.Git().Status.WorkingTreeState()
+	self.c.Model().RebasePausedAtBreak = self.c.Model().WorkingTreeStateAtLastCommitRefresh.Rebasing &&
+		self.c.Git().Rebase.IsPausedAtBreak()
 
 	self.refreshView(self.c.Contexts().LocalCommits)
 	return nil
@@ -566,7 +591,10 @@ func (self *RefreshHelper) refreshStateFiles() error {
 
 	files := self.c.Git().Loaders.FileLoader.
 		GetStatusFiles(git_commands.GetStatusFileOptions{
-			ForceShowUntracked: self.c.Contexts().Files.ForceShowUntracked(),
+			ForceShowUntracked:    self.c.Contexts().Files.ForceShowUntracked(),
+			Path:                  self.c.Modes().Filtering.GetPath(),
+			WithDiffSizes:         fileTreeViewModel.GetSortOrder() == filetree.SortFilesByDiffSize,
+			WithModificationTimes: fileTreeViewModel.GetSortOrder() == filetree.SortFilesByModificationTime,
 		})
 
 	conflictFileCount := 0
@@ -577,18 +605,23 @@ func (self *RefreshHelper) refreshStateFiles() error {
 	}
 
 	if self.c.Git().Status.WorkingTreeState().Any() && conflictFileCount == 0 && prevConflictFileCount > 0 {
-		self.c.OnUIThread(func() error { return self.mergeAndRebaseHelper.PromptToContinueRebase() })
+		self.c.OnUIThread(func() error {
+			if self.c.UserConfig().Git.AutoContinueAfterResolvingConflicts {
+				return self.mergeAndRebaseHelper.ContinueAfterConflictsResolved()
+			}
+			return self.mergeAndRebaseHelper.PromptToContinueRebase()
+		})
 	}
 
 	fileTreeViewModel.RWMutex.Lock()
 
 	// only taking over the filter if it hasn't already been set by the user.
 	if conflictFileCount > 0 && prevConflictFileCount == 0 {
-		if fileTreeViewModel.GetFilter() == filetree.DisplayAll {
+		if fileTreeViewModel.GetStatusFilter() == filetree.DisplayAll {
 			fileTreeViewModel.SetStatusFilter(filetree.DisplayConflicted)
 			self.c.Contexts().Files.GetView().Subtitle = self.c.Tr.FilterLabelConflictingFiles
 		}
-	} else if conflictFileCount == 0 && fileTreeViewModel.GetFilter() == filetree.DisplayConflicted {
+	} else if conflictFileCount == 0 && fileTreeViewModel.GetStatusFilter() == filetree.DisplayConflicted {
 		fileTreeViewModel.SetStatusFilter(filetree.DisplayAll)
 		self.c.Contexts().Files.GetView().Subtitle = ""
 	}
@@ -612,14 +645,14 @@ func (self *RefreshHelper) refreshReflogCommits() error {
 	// and we get an out of bounds exception
 	model := self.c.Model()
 
-	refresh := func(stateCommits *[]*models.Commit, filterPath string, filterAuthor string) error {
+	refresh := func(stateCommits *[]*models.Commit, ref string, filterPath string, filterAuthor string) error {
 		var lastReflogCommit *models.Commit
-		if filterPath == "" && filterAuthor == "" && len(*stateCommits) > 0 {
+		if ref == "" && filterPath == "" && filterAuthor == "" && len(*stateCommits) > 0 {
 			lastReflogCommit = (*stateCommits)[0]
 		}
 
 		commits, onlyObtainedNewReflogCommits, err := self.c.Git().Loaders.ReflogCommitLoader.
-			GetReflogCommits(self.c.Model().HashPool, lastReflogCommit, filterPath, filterAuthor)
+			GetReflogCommits(self.c.Model().HashPool, lastReflogCommit, ref, filterPath, filterAuthor)
 		if err != nil {
 			return err
 		}
@@ -632,12 +665,15 @@ func (self *RefreshHelper) refreshReflogCommits() error {
 		return nil
 	}
 
-	if err := refresh(&model.ReflogCommits, "", ""); err != nil {
+	if err := refresh(&model.ReflogCommits, "", "", ""); err != nil {
 		return err
 	}
 
-	if self.c.Modes().Filtering.Active() {
-		if err := refresh(&model.FilteredReflogCommits, self.c.Modes().Filtering.GetPath(), self.c.Modes().Filtering.GetAuthor()); err != nil {
+	// The reflogs panel can show the reflog of a ref other than HEAD, in which
+	// case it needs its own list just like in filtering mode
+	ref := self.c.Contexts().ReflogCommits.GetRef()
+	if ref != "" || self.c.Modes().Filtering.Active() {
+		if err := refresh(&model.FilteredReflogCommits, ref, self.c.Modes().Filtering.GetPath(), self.c.Modes().Filtering.GetAuthor()); err != nil {
 			return err
 		}
 	} else {
@@ -714,7 +750,7 @@ func (self *RefreshHelper) refreshStatus() {
 	workingTreeState := self.c.Git().Status.WorkingTreeState()
 	linkedWorktreeName := self.worktreeHelper.GetLinkedWorktreeName()
 
-	repoName := self.c.Git().RepoPaths.RepoName()
+	repoName := presentation.FormatRepoBreadcrumb(self.c.State().GetRepoPathStack().Items(), self.c.Git().RepoPaths.RepoName())
 
 	status := presentation.FormatStatus(repoName, currentBranch, types.ItemOperationNone, linkedWorktreeName, workingTreeState, self.c.Tr, self.c.UserConfig())
 
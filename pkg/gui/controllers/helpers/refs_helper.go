@@ -18,16 +18,19 @@ import (
 type RefsHelper struct {
 	c *HelperCommon
 
-	rebaseHelper *MergeAndRebaseHelper
+	rebaseHelper   *MergeAndRebaseHelper
+	snapshotHelper *SnapshotHelper
 }
 
 func NewRefsHelper(
 	c *HelperCommon,
 	rebaseHelper *MergeAndRebaseHelper,
+	snapshotHelper *SnapshotHelper,
 ) *RefsHelper {
 	return &RefsHelper{
-		c:            c,
-		rebaseHelper: rebaseHelper,
+		c:              c,
+		rebaseHelper:   rebaseHelper,
+		snapshotHelper: snapshotHelper,
 	}
 }
 
@@ -172,6 +175,12 @@ func (self *RefsHelper) GetCheckedOutRef() *models.Branch {
 }
 
 func (self *RefsHelper) ResetToRef(ref string, strength string, envVars []string) error {
+	if strength == "hard" {
+		if err := self.snapshotHelper.SnapshotWorkingTree(self.c.Tr.Actions.HardReset, false); err != nil {
+			return err
+		}
+	}
+
 	if err := self.c.Git().Commit.ResetToCommit(ref, strength, envVars); err != nil {
 		return err
 	}
@@ -319,6 +328,11 @@ func (self *RefsHelper) CreateCheckoutMenu(commit *models.Commit) error {
 }
 
 func (self *RefsHelper) NewBranch(from string, fromFormattedName string, suggestedBranchName string) error {
+	return self.NewBranchAndThen(from, fromFormattedName, suggestedBranchName, nil)
+}
+
+// Like NewBranch, but calls onCreated once the new branch is checked out
+func (self *RefsHelper) NewBranchAndThen(from string, fromFormattedName string, suggestedBranchName string, onCreated func(newBranchName string)) error {
 	message := utils.ResolvePlaceholderString(
 		self.c.Tr.NewBranchNameBranchOff,
 		map[string]string{
@@ -335,7 +349,7 @@ func (self *RefsHelper) NewBranch(from string, fromFormattedName string, suggest
 		}
 	}
 
-	refresh := func() {
+	refresh := func(newBranchName string) {
 		if self.c.Context().Current() != self.c.Contexts().Branches {
 			self.c.Context().Push(self.c.Contexts().Branches, types.OnFocusOpts{})
 		}
@@ -344,6 +358,10 @@ func (self *RefsHelper) NewBranch(from string, fromFormattedName string, suggest
 		self.c.Contexts().Branches.SetSelection(0)
 
 		self.c.Refresh(types.RefreshOptions{Mode: types.BLOCK_UI, KeepBranchSelectionIndex: true})
+
+		if onCreated != nil {
+			onCreated(newBranchName)
+		}
 	}
 
 	self.c.Prompt(types.PromptOpts{
@@ -371,7 +389,7 @@ func (self *RefsHelper) NewBranch(from string, fromFormattedName string, suggest
 							}
 							err := self.c.Git().Stash.Pop(0)
 							// Branch switch successful so re-render the UI even if the pop operation failed (e.g. conflict).
-							refresh()
+							refresh(newBranchName)
 							return err
 						},
 					})
@@ -382,7 +400,7 @@ func (self *RefsHelper) NewBranch(from string, fromFormattedName string, suggest
 				return err
 			}
 
-			refresh()
+			refresh(newBranchName)
 			return nil
 		},
 	})
@@ -14,6 +14,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/env"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -48,10 +49,24 @@ func (self *ReposHelper) EnterSubmodule(submodule *models.SubmoduleConfig) error
 		return err
 	}
 	self.c.State().GetRepoPathStack().Push(wd)
+	// When entering a nested submodule directly, pretend we went through its
+	// parent submodules so that going back up returns to them one at a time
+	for _, parent := range submoduleAncestors(submodule) {
+		self.c.State().GetRepoPathStack().Push(filepath.Join(wd, parent.FullPath()))
+	}
 
 	return self.DispatchSwitchToRepo(submodule.FullPath(), context.NO_CONTEXT)
 }
 
+// Returns the submodules that the given one is nested in, outermost first
+func submoduleAncestors(submodule *models.SubmoduleConfig) []*models.SubmoduleConfig {
+	ancestors := []*models.SubmoduleConfig{}
+	for parent := submodule.ParentModule; parent != nil; parent = parent.ParentModule {
+		ancestors = append([]*models.SubmoduleConfig{parent}, ancestors...)
+	}
+	return ancestors
+}
+
 func (self *ReposHelper) getCurrentBranch(path string) string {
 	readHeadFile := func(path string) (string, error) {
 		headFile, err := os.ReadFile(filepath.Join(path, "HEAD"))
@@ -116,7 +131,33 @@ func (self *ReposHelper) CreateRecentReposMenu() error {
 
 	wg.Wait()
 
-	menuItems := lo.Map(recentRepoPaths, func(path string, _ int) *types.MenuItem {
+	menuItems := self.recentRepoMenuItems(recentRepoPaths, &currentBranches, func(path string) error {
+		// if we were in a submodule, we want to forget about that stack of repos
+		// so that hitting escape in the new repo does nothing
+		self.c.State().GetRepoPathStack().Clear()
+		return self.DispatchSwitchToRepo(path, context.NO_CONTEXT)
+	})
+
+	if len(recentRepoPaths) > 0 && self.c.OS().CanOpenInTmux() {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: self.c.Tr.OpenRecentRepoInTmux,
+			OnPress: func() error {
+				return self.c.Menu(types.CreateMenuOptions{
+					Title: self.c.Tr.OpenRecentRepoInTmux,
+					Items: self.recentRepoMenuItems(recentRepoPaths, &currentBranches, self.OpenInTmux),
+				})
+			},
+			Key:       keybindings.GetKey(self.c.UserConfig().Keybinding.Universal.OpenInTmux),
+			Tooltip:   self.c.Tr.OpenInTmuxTooltip,
+			OpensMenu: true,
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.RecentRepos, Items: menuItems})
+}
+
+func (self *ReposHelper) recentRepoMenuItems(paths []string, currentBranches *sync.Map, onPress func(path string) error) []*types.MenuItem {
+	return lo.Map(paths, func(path string, _ int) *types.MenuItem {
 		branchName, _ := currentBranches.Load(path)
 		if icons.IsIconEnabled() {
 			branchName = icons.BRANCH_ICON + " " + fmt.Sprintf("%v", branchName)
@@ -129,15 +170,30 @@ func (self *ReposHelper) CreateRecentReposMenu() error {
 				style.FgMagenta.Sprint(path),
 			},
 			OnPress: func() error {
-				// if we were in a submodule, we want to forget about that stack of repos
-				// so that hitting escape in the new repo does nothing
-				self.c.State().GetRepoPathStack().Clear()
-				return self.DispatchSwitchToRepo(path, context.NO_CONTEXT)
+				return onPress(path)
 			},
 		}
 	})
+}
 
-	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.RecentRepos, Items: menuItems})
+// Opens the given repo, worktree or submodule directory in tmux, without
+// leaving the current repo
+func (self *ReposHelper) OpenInTmux(path string) error {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.OpenInTmux)
+	return self.c.OS().OpenInTmux(absPath)
+}
+
+func (self *ReposHelper) OpenInTmuxDisabledReason() *types.DisabledReason {
+	if !self.c.OS().CanOpenInTmux() {
+		return &types.DisabledReason{Text: self.c.Tr.NotInTmux}
+	}
+
+	return nil
 }
 
 func (self *ReposHelper) DispatchSwitchToRepo(path string, contextKey types.ContextKey) error {
@@ -0,0 +1,139 @@
+package helpers
+
+import (
+	"math"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Lets the user click or drag in the scrollbar on the right edge of a view to
+// scroll it to the corresponding position. Like scrolling with the mouse
+// wheel, this only moves the viewport, not the selection.
+
+type ScrollbarHelper struct {
+	c          *HelperCommon
+	viewHelper *ViewHelper
+
+	// the view whose scrollbar is being dragged, if any
+	draggedViewName string
+	// where the mouse was last seen while dragging
+	lastX, lastY int
+}
+
+func NewScrollbarHelper(c *HelperCommon, viewHelper *ViewHelper) *ScrollbarHelper {
+	return &ScrollbarHelper{
+		c:          c,
+		viewHelper: viewHelper,
+	}
+}
+
+// HandleMouseEvent is called before the handler of any mouse binding of a
+// scrollable view, and returns true if the event was about the scrollbar, in
+// which case the view's own handler shouldn't run.
+func (self *ScrollbarHelper) HandleMouseEvent(viewName string, modifier gocui.Modifier, opts gocui.ViewMouseBindingOpts) bool {
+	view, err := self.c.GocuiGui().View(viewName)
+	if err != nil {
+		return false
+	}
+
+	// opts contains coordinates relative to the view's content; we need them
+	// relative to the screen
+	x0, y0, _, _ := view.Dimensions()
+	x := opts.X - view.OriginX() + x0 + 1
+	y := opts.Y - view.OriginY() + y0 + 1
+
+	switch {
+	case opts.Key == gocui.MouseLeft && modifier == gocui.ModNone:
+		self.draggedViewName = ""
+		if !self.isOnScrollbar(view, x, y) {
+			return false
+		}
+		self.draggedViewName = viewName
+		self.lastX, self.lastY = x, y
+		self.scrollTo(view, y)
+		return true
+
+	case opts.Key == gocui.MouseLeft && modifier == gocui.ModMotion:
+		if self.draggedViewName != viewName {
+			return false
+		}
+		self.lastX, self.lastY = x, y
+		self.scrollTo(view, y)
+		return true
+
+	case opts.Key == gocui.MouseRelease:
+		if self.draggedViewName != viewName {
+			return false
+		}
+		// gocui reports the first move after pressing the button as a
+		// release, so we only stop dragging if the mouse didn't move
+		if x != self.lastX || y != self.lastY {
+			self.lastX, self.lastY = x, y
+			self.scrollTo(view, y)
+			return true
+		}
+		self.draggedViewName = ""
+		return true
+	}
+
+	return false
+}
+
+func (self *ScrollbarHelper) isOnScrollbar(view *gocui.View, x, y int) bool {
+	if !(self.c.UserConfig().Gui.ShowScrollbars || view.Minimap != nil) || !view.Frame {
+		return false
+	}
+
+	_, y0, x1, y1 := view.Dimensions()
+	return x == x1 && y > y0 && y < y1 && self.maxOriginY(view) > 0
+}
+
+func (self *ScrollbarHelper) maxOriginY(view *gocui.View) int {
+	return view.ViewLinesHeight() - view.InnerHeight()
+}
+
+// Scrolls the view so that the top of the scrollbar maps to the start of the
+// content and the bottom to its end
+func (self *ScrollbarHelper) scrollTo(view *gocui.View, y int) {
+	_, y0, _, _ := view.Dimensions()
+	trackHeight := view.InnerHeight()
+	if trackHeight < 2 {
+		return
+	}
+
+	position := lo.Clamp(y-y0-1, 0, trackHeight-1)
+	targetOriginY := int(math.Round(float64(position) / float64(trackHeight-1) * float64(self.maxOriginY(view))))
+	if view.Minimap != nil {
+		// each row of the minimap stands for a region of the content, and we
+		// want that region to end up in the middle of the view
+		line := position * view.ViewLinesHeight() / trackHeight
+		targetOriginY = lo.Clamp(line-trackHeight/2, 0, self.maxOriginY(view))
+	}
+
+	delta := targetOriginY - view.OriginY()
+	if delta < 0 {
+		view.ScrollUp(-delta)
+	} else if delta > 0 {
+		view.ScrollDown(delta)
+		// views showing the output of a command only read as many lines as
+		// they need, so we need to read the ones we scrolled to
+		if manager := self.c.GetViewBufferManagerForView(view); manager != nil {
+			manager.ReadLines(delta)
+		}
+	}
+
+	if context, ok := self.viewHelper.ContextForView(view.Name()); ok {
+		if listContext, ok := context.(types.IListContext); ok {
+			// gocui has moved the cursor to where we clicked; put it back on
+			// the selected line without scrolling to it
+			selectedViewIdx := listContext.ModelIndexToViewIndex(listContext.GetList().GetSelectedLineIdx())
+			view.SetCursorY(selectedViewIdx - view.OriginY())
+
+			if listContext.RenderOnlyVisibleLines() {
+				listContext.HandleRender()
+			}
+		}
+	}
+}
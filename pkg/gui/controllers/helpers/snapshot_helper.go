@@ -0,0 +1,165 @@
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Saves snapshots of the repo before destructive operations, and lets the
+// user restore them
+type SnapshotHelper struct {
+	c *HelperCommon
+}
+
+func NewSnapshotHelper(c *HelperCommon) *SnapshotHelper {
+	return &SnapshotHelper{
+		c: c,
+	}
+}
+
+// Saves HEAD and the working tree before an operation that would lose them.
+// includeUntracked should only be true for operations that delete untracked
+// files. If this fails, the caller shouldn't go ahead with the operation.
+func (self *SnapshotHelper) SnapshotWorkingTree(reason string, includeUntracked bool) error {
+	if !self.c.UserConfig().Git.Snapshots.Enabled {
+		return nil
+	}
+
+	if err := self.c.Git().Snapshot.CreateForWorkingTree(reason, includeUntracked); err != nil {
+		return fmt.Errorf(self.c.Tr.SnapshotFailed, err)
+	}
+
+	return nil
+}
+
+// Saves the tips of the given branches before they are deleted
+func (self *SnapshotHelper) SnapshotBranches(branches []*models.Branch, reason string) error {
+	if !self.c.UserConfig().Git.Snapshots.Enabled {
+		return nil
+	}
+
+	for _, branch := range branches {
+		if err := self.c.Git().Snapshot.CreateForBranch(branch.Name, reason); err != nil {
+			return fmt.Errorf(self.c.Tr.SnapshotFailed, err)
+		}
+	}
+
+	return nil
+}
+
+// Backs up the given paths before their changes are discarded, so that the
+// discard can be undone. The paths must be exactly the files that are about
+// to be discarded.
+func (self *SnapshotHelper) BackupBeforeDiscard(paths []string, reason string) error {
+	if !self.c.UserConfig().Git.Snapshots.Enabled || len(paths) == 0 {
+		return nil
+	}
+
+	if err := self.c.Git().Snapshot.CreateDiscardBackup(paths, reason); err != nil {
+		return fmt.Errorf(self.c.Tr.SnapshotFailed, err)
+	}
+
+	return nil
+}
+
+func (self *SnapshotHelper) CreateRestoreMenu() error {
+	snapshots, err := self.c.Git().Snapshot.List()
+	if err != nil {
+		return err
+	}
+
+	menuItems := lo.Map(snapshots, func(snapshot *git_commands.Snapshot, _ int) *types.MenuItem {
+		target := utils.ShortHash(snapshot.Hash)
+		if snapshot.Branch != "" {
+			target = snapshot.Branch
+		}
+
+		return &types.MenuItem{
+			LabelColumns: []string{
+				style.FgCyan.Sprint(utils.UnixToTimeAgo(snapshot.Date.Unix())),
+				snapshot.Reason,
+				style.FgYellow.Sprint(target),
+			},
+			OnPress: func() error {
+				return self.confirmRestore(snapshot)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.RestoreSnapshot,
+		Items: menuItems,
+	})
+}
+
+func (self *SnapshotHelper) confirmRestore(snapshot *git_commands.Snapshot) error {
+	if snapshot.Branch != "" {
+		self.c.LogAction(self.c.Tr.Actions.RestoreSnapshot)
+		if err := self.c.Git().Snapshot.RestoreBranch(snapshot); err != nil {
+			return err
+		}
+
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+		return nil
+	}
+
+	prompt, err := self.restorePrompt(snapshot)
+	if err != nil {
+		return err
+	}
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.RestoreSnapshot,
+		Prompt: prompt,
+		HandleConfirm: func() error {
+			// Restoring is destructive itself, so we always take a snapshot
+			// first, regardless of the config. Restoring doesn't delete
+			// untracked files, so we don't need to include them.
+			if err := self.c.Git().Snapshot.CreateForWorkingTree(self.c.Tr.Actions.RestoreSnapshot, false); err != nil {
+				return fmt.Errorf(self.c.Tr.SnapshotFailed, err)
+			}
+
+			self.c.LogAction(self.c.Tr.Actions.RestoreSnapshot)
+			if err := self.c.Git().Snapshot.RestoreWorkingTree(snapshot); err != nil {
+				return err
+			}
+
+			self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+			return nil
+		},
+	})
+
+	return nil
+}
+
+// Restoring resets whichever branch is checked out now, so we name it in the
+// prompt, and warn if the snapshot was taken on a different one
+func (self *SnapshotHelper) restorePrompt(snapshot *git_commands.Snapshot) (string, error) {
+	if snapshot.ParentHash == "" {
+		return self.c.Tr.RestoreSnapshotNoCommitsYetPrompt, nil
+	}
+
+	branchInfo, err := self.c.Git().Branch.CurrentBranchInfo()
+	if err != nil {
+		return "", err
+	}
+
+	prompt := utils.ResolvePlaceholderString(self.c.Tr.RestoreSnapshotPrompt, map[string]string{
+		"branch": branchInfo.DisplayName,
+		"commit": utils.ShortHash(snapshot.ParentHash),
+	})
+	if snapshot.CheckedOutBranch != "" && snapshot.CheckedOutBranch != branchInfo.RefName {
+		prompt = utils.ResolvePlaceholderString(self.c.Tr.RestoreSnapshotOnOtherBranchWarning, map[string]string{
+			"snapshotBranch": snapshot.CheckedOutBranch,
+			"branch":         branchInfo.DisplayName,
+		}) + "\n\n" + prompt
+	}
+
+	return prompt, nil
+}
@@ -0,0 +1,135 @@
+package helpers
+
+import (
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Renders the segments of the gui.statusBarSegments config, which replace the
+// version number and links at the right end of the bottom line.
+
+type StatusBarHelper struct {
+	c *HelperCommon
+
+	mutex sync.Mutex
+	// the output of the segments' commands, keyed by the index of the segment
+	outputs map[int]string
+}
+
+func NewStatusBarHelper(c *HelperCommon) *StatusBarHelper {
+	return &StatusBarHelper{
+		c:       c,
+		outputs: map[int]string{},
+	}
+}
+
+// The fields available in the templates of the gui.statusBarSegments config
+type StatusBarFields struct {
+	Branch       string
+	Upstream     string
+	Ahead        string
+	Behind       string
+	Operation    string
+	FilterPath   string
+	FilterAuthor string
+	Time         time.Time
+	Version      string
+	// the output of the segment's command
+	Output string
+}
+
+func (self *StatusBarHelper) Enabled() bool {
+	return len(self.c.UserConfig().Gui.StatusBarSegments) > 0
+}
+
+func (self *StatusBarHelper) InformationStr() string {
+	self.mutex.Lock()
+	outputs := maps.Clone(self.outputs)
+	self.mutex.Unlock()
+
+	userConfig := self.c.UserConfig()
+	return RenderStatusBarSegments(userConfig.Gui.StatusBarSegments, userConfig.Gui.StatusBarSeparator, self.fields(), outputs)
+}
+
+func (self *StatusBarHelper) fields() StatusBarFields {
+	fields := StatusBarFields{
+		Operation:    self.c.Model().WorkingTreeStateAtLastCommitRefresh.Title(self.c.Tr),
+		FilterPath:   self.c.Modes().Filtering.GetPath(),
+		FilterAuthor: self.c.Modes().Filtering.GetAuthor(),
+		Time:         time.Now(),
+		Version:      self.c.GetConfig().GetVersion(),
+	}
+
+	if branches := self.c.Model().Branches; len(branches) > 0 {
+		branch := branches[0]
+		fields.Branch = branch.Name
+		fields.Upstream = branch.ShortUpstreamRefName()
+		// left empty if zero so that templates can use {{if .Ahead}}
+		if branch.IsAheadForPull() {
+			fields.Ahead = branch.AheadForPull
+		}
+		if branch.IsBehindForPull() {
+			fields.Behind = branch.BehindForPull
+		}
+	}
+
+	return fields
+}
+
+// Runs the commands of the segments that have one and re-renders the bottom
+// line with their output. Called periodically from a background routine.
+func (self *StatusBarHelper) RefreshCommandOutputs() {
+	outputs := map[int]string{}
+	for i, segment := range self.c.UserConfig().Gui.StatusBarSegments {
+		if segment.Command == "" {
+			continue
+		}
+
+		output, err := self.c.OS().Cmd.NewShell(segment.Command, self.c.UserConfig().OS.ShellFunctionsFile).
+			DontLog().RunWithOutput()
+		output = strings.TrimSpace(output)
+		if err != nil {
+			self.c.Log.Errorf("Status bar command '%s' failed: %v", segment.Command, err)
+			output = style.FgRed.Sprint(output)
+		}
+		outputs[i] = output
+	}
+
+	self.mutex.Lock()
+	self.outputs = outputs
+	self.mutex.Unlock()
+
+	self.c.Render()
+}
+
+func RenderStatusBarSegments(segments []config.StatusBarSegment, separator string, fields StatusBarFields, outputs map[int]string) string {
+	texts := []string{}
+	for i, segment := range segments {
+		fields.Output = outputs[i]
+		text := fields.Output
+		if segment.Template != "" {
+			var err error
+			text, err = utils.ResolveTemplate(segment.Template, fields, nil)
+			if err != nil {
+				text = style.FgRed.Sprint(err.Error())
+			}
+		}
+		if text == "" {
+			continue
+		}
+
+		if len(segment.Color) > 0 {
+			text = theme.GetTextStyle(segment.Color, false).Sprint(text)
+		}
+		texts = append(texts, text)
+	}
+
+	return strings.Join(texts, separator)
+}
@@ -0,0 +1,67 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderStatusBarSegments(t *testing.T) {
+	fields := StatusBarFields{
+		Branch: "feature",
+		Ahead:  "2",
+		Time:   time.Date(2024, 2, 1, 9, 30, 0, 0, time.UTC),
+	}
+
+	scenarios := []struct {
+		name     string
+		segments []config.StatusBarSegment
+		outputs  map[int]string
+		expected string
+	}{
+		{
+			name: "templates",
+			segments: []config.StatusBarSegment{
+				{Template: "{{.Branch}}{{if .Ahead}} ↑{{.Ahead}}{{end}}{{if .Behind}} ↓{{.Behind}}{{end}}"},
+				{Template: `{{.Time.Format "15:04"}}`},
+			},
+			expected: "feature ↑2 | 09:30",
+		},
+		{
+			name: "empty segments are left out",
+			segments: []config.StatusBarSegment{
+				{Template: "{{.Operation}}"},
+				{Template: "{{.Branch}}"},
+				{Template: "{{.FilterPath}}"},
+			},
+			expected: "feature",
+		},
+		{
+			name: "command output",
+			segments: []config.StatusBarSegment{
+				{Template: "{{.Branch}}"},
+				{Command: "echo hello"},
+				{Command: "git stash list | wc -l", Template: "stashes: {{.Output}}"},
+			},
+			outputs:  map[int]string{1: "hello", 2: "3"},
+			expected: "feature | hello | stashes: 3",
+		},
+		{
+			name: "unknown field",
+			segments: []config.StatusBarSegment{
+				{Template: "{{.Author}}"},
+			},
+			expected: `template: template:1:2: executing "template" at <.Author>: can't evaluate field Author in type helpers.StatusBarFields`,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			result := RenderStatusBarSegments(s.segments, " | ", fields, s.outputs)
+			assert.Equal(t, s.expected, utils.Decolorise(result))
+		})
+	}
+}
@@ -29,6 +29,7 @@ type ViewSubCommitsOpts struct {
 	TitleRef                string
 	Context                 types.Context
 	ShowBranchHeads         bool
+	Pickaxe                 git_commands.Pickaxe
 }
 
 func (self *SubCommitsHelper) ViewSubCommits(opts ViewSubCommitsOpts) error {
@@ -37,6 +38,11 @@ func (self *SubCommitsHelper) ViewSubCommits(opts ViewSubCommitsOpts) error {
 			Limit:                   true,
 			FilterPath:              self.c.Modes().Filtering.GetPath(),
 			FilterAuthor:            self.c.Modes().Filtering.GetAuthor(),
+			FilterSince:             self.c.Modes().Filtering.GetSince(),
+			FilterUntil:             self.c.Modes().Filtering.GetUntil(),
+			FilterGrep:              self.c.Modes().Filtering.GetGrep(),
+			FilterNoMerges:          self.c.Modes().Filtering.GetNoMerges(),
+			Pickaxe:                 opts.Pickaxe,
 			IncludeRebaseCommits:    false,
 			RefName:                 opts.Ref.FullRefName(),
 			RefForPushedStatus:      opts.Ref,
@@ -61,6 +67,7 @@ func (self *SubCommitsHelper) ViewSubCommits(opts ViewSubCommitsOpts) error {
 	subCommitsContext.SetRefToShowDivergenceFrom(opts.RefToShowDivergenceFrom)
 	subCommitsContext.SetLimitCommits(true)
 	subCommitsContext.SetShowBranchHeads(opts.ShowBranchHeads)
+	subCommitsContext.SetPickaxe(opts.Pickaxe)
 	subCommitsContext.ClearSearchString()
 	subCommitsContext.GetView().ClearSearch()
 	subCommitsContext.GetView().TitlePrefix = opts.Context.GetView().TitlePrefix
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -195,6 +196,19 @@ func (self *SuggestionsHelper) GetRefsSuggestionsFunc() func(string) []*types.Su
 	return FilterFunc(refNames, self.c.UserConfig().Gui.UseFuzzySearch())
 }
 
+// Like GetRefsSuggestionsFunc, but also suggests the hashes of recently
+// checked-out commits, taken from the reflog.
+func (self *SuggestionsHelper) GetCommitSuggestionsFunc() func(string) []*types.Suggestion {
+	recentHashes := lo.Uniq(lo.Map(self.c.Model().ReflogCommits, func(commit *models.Commit, _ int) string {
+		return commit.ShortHash()
+	}))
+
+	refNames := append(append(append(self.getBranchNames(), self.getTagNames()...), self.getRemoteBranchNames("/")...),
+		utils.Limit(recentHashes, 50)...)
+
+	return FilterFunc(refNames, self.c.UserConfig().Gui.UseFuzzySearch())
+}
+
 func (self *SuggestionsHelper) GetAuthorsSuggestionsFunc() func(string) []*types.Suggestion {
 	authors := lo.Map(lo.Values(self.c.Model().Authors), func(author *models.Author, _ int) string {
 		return author.Combined()
@@ -205,6 +219,28 @@ func (self *SuggestionsHelper) GetAuthorsSuggestionsFunc() func(string) []*types
 	return FilterFunc(authors, self.c.UserConfig().Gui.UseFuzzySearch())
 }
 
+// Suggests issues by their reference and title, and completes to the
+// reference
+func IssueSuggestionsFunc(issues []*hosting_service.Issue, useFuzzySearch bool) func(string) []*types.Suggestion {
+	labels := lo.Map(issues, func(issue *hosting_service.Issue, _ int) string {
+		return issue.Reference + " " + issue.Title
+	})
+	referencesByLabel := lo.SliceToMap(issues, func(issue *hosting_service.Issue) (string, string) {
+		return issue.Reference + " " + issue.Title, issue.Reference
+	})
+
+	return func(input string) []*types.Suggestion {
+		matches := labels
+		if input != "" {
+			matches = utils.FilterStrings(input, labels, useFuzzySearch)
+		}
+
+		return lo.Map(matches, func(label string, _ int) *types.Suggestion {
+			return &types.Suggestion{Value: referencesByLabel[label], Label: label}
+		})
+	}
+}
+
 func FilterFunc(options []string, useFuzzySearch bool) func(string) []*types.Suggestion {
 	return func(input string) []*types.Suggestion {
 		var matches []string
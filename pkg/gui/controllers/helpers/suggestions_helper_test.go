@@ -0,0 +1,34 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/hosting_service"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueSuggestionsFunc(t *testing.T) {
+	issues := []*hosting_service.Issue{
+		{Reference: "#7", Title: "Crash on startup"},
+		{Reference: "#12", Title: "Dark mode"},
+		{Reference: "#123", Title: "Crash when pushing"},
+	}
+	findSuggestions := IssueSuggestionsFunc(issues, false)
+
+	assert.Equal(t, []*types.Suggestion{
+		{Value: "#7", Label: "#7 Crash on startup"},
+		{Value: "#12", Label: "#12 Dark mode"},
+		{Value: "#123", Label: "#123 Crash when pushing"},
+	}, findSuggestions(""))
+
+	assert.Equal(t, []*types.Suggestion{
+		{Value: "#7", Label: "#7 Crash on startup"},
+		{Value: "#123", Label: "#123 Crash when pushing"},
+	}, findSuggestions("crash"))
+
+	assert.Equal(t, []*types.Suggestion{
+		{Value: "#12", Label: "#12 Dark mode"},
+		{Value: "#123", Label: "#123 Crash when pushing"},
+	}, findSuggestions("#12"))
+}
@@ -1,11 +1,18 @@
 package helpers
 
 import (
+	"errors"
+	"strings"
+
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type TagsHelper struct {
@@ -53,10 +60,137 @@ func (self *TagsHelper) OpenCreateTagPrompt(ref string, onCreate func()) error {
 		})
 	}
 
+	openPanel := func(initialMessage string) error {
+		self.commitsHelper.OpenCommitMessagePanel(
+			&OpenCommitMessagePanelOpts{
+				CommitIndex:      context.NoCommitIndex,
+				InitialMessage:   initialMessage,
+				SummaryTitle:     self.c.Tr.TagNameTitle,
+				DescriptionTitle: self.c.Tr.TagMessageTitle,
+				PreserveMessage:  false,
+				OnConfirm:        onConfirm,
+			},
+		)
+
+		return nil
+	}
+
+	if !self.c.UserConfig().Git.SuggestTagVersionBumps {
+		return openPanel("")
+	}
+
+	tagNames := lo.Map(self.c.Model().Tags, func(tag *models.Tag, _ int) string { return tag.Name })
+	latest, latestName := utils.LatestSemVer(tagNames)
+	if latest == nil {
+		return openPanel("")
+	}
+
+	bumpItem := func(label string, version utils.SemVer, key types.Key) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{label, style.FgYellow.Sprint(version.String())},
+			OnPress: func() error {
+				message, err := self.tagMessageForVersion(version.String(), latestName, ref)
+				if err != nil {
+					return err
+				}
+				return openPanel(version.String() + "\n" + message)
+			},
+			Key: key,
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.CreateTagMenuTitle, map[string]string{
+			"latestTag": latestName,
+		}),
+		Items: []*types.MenuItem{
+			bumpItem(self.c.Tr.BumpPatchVersion, latest.BumpPatch(), 'p'),
+			bumpItem(self.c.Tr.BumpMinorVersion, latest.BumpMinor(), 'm'),
+			bumpItem(self.c.Tr.BumpMajorVersion, latest.BumpMajor(), 'M'),
+			{
+				LabelColumns: []string{self.c.Tr.CustomTagName},
+				OnPress: func() error {
+					return openPanel("")
+				},
+				Key: 'c',
+			},
+		},
+	})
+}
+
+// Resolves the user's tag message template for a tag created by bumping the
+// version of previousTag
+func (self *TagsHelper) tagMessageForVersion(tagName string, previousTag string, ref string) (string, error) {
+	template := self.c.UserConfig().Git.TagMessageTemplate
+	if template == "" {
+		return "", nil
+	}
+
+	subjects, err := self.c.Git().Tag.CommitSubjectsSince(previousTag, ref)
+	if err != nil {
+		return "", err
+	}
+
+	changelog := strings.Join(lo.Map(subjects, func(subject string, _ int) string {
+		return "- " + subject
+	}), "\n")
+
+	message, err := utils.ResolveTemplate(template, map[string]string{
+		"Tag":         tagName,
+		"PreviousTag": previousTag,
+		"Changelog":   changelog,
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(message), nil
+}
+
+// Lets the user edit the message (and name) of an annotated tag. Tags can't be
+// changed in place, so we recreate the tag pointing at the same commit.
+func (self *TagsHelper) OpenEditTagMessagePanel(tag *models.Tag) error {
+	isAnnotated, err := self.c.Git().Tag.IsTagAnnotated(tag.Name)
+	if err != nil {
+		return err
+	}
+	if !isAnnotated {
+		return errors.New(self.c.Tr.CannotEditLightweightTag)
+	}
+
+	message, err := self.c.Git().Tag.GetAnnotationMessage(tag.Name)
+	if err != nil {
+		return err
+	}
+
+	onConfirm := func(tagName string, description string) error {
+		self.c.Confirm(types.ConfirmOpts{
+			Title: self.c.Tr.EditTagMessageTitle,
+			Prompt: utils.ResolvePlaceholderString(self.c.Tr.EditTagMessagePrompt,
+				map[string]string{"tagName": tag.Name}),
+			HandleConfirm: func() error {
+				self.c.LogAction(self.c.Tr.Actions.EditTagMessage)
+				// Peel the old tag so that the new one points at the commit, not
+				// at the old tag object
+				renamed := tagName != tag.Name
+				command := self.c.Git().Tag.CreateAnnotatedObj(tagName, tag.FullRefName()+"^{}", description, !renamed)
+
+				return self.gpg.WithGpgHandling(command, git_commands.TagGpgSign, self.c.Tr.CreatingTag, func() error {
+					if !renamed {
+						return nil
+					}
+					return self.c.Git().Tag.LocalDelete(tag.Name)
+				}, []types.RefreshableView{types.COMMITS, types.TAGS})
+			},
+		})
+
+		return nil
+	}
+
 	self.commitsHelper.OpenCommitMessagePanel(
 		&OpenCommitMessagePanelOpts{
 			CommitIndex:      context.NoCommitIndex,
-			InitialMessage:   "",
+			InitialMessage:   tag.Name + "\n" + message,
 			SummaryTitle:     self.c.Tr.TagNameTitle,
 			DescriptionTitle: self.c.Tr.TagMessageTitle,
 			PreserveMessage:  false,
@@ -66,3 +200,45 @@ func (self *TagsHelper) OpenCreateTagPrompt(ref string, onCreate func()) error {
 
 	return nil
 }
+
+// Lists the tags of every remote so that the tags panel can show which tags
+// only exist locally. Runs git on the calling goroutine, so call it from a
+// background one. We only call it after a fetch that the user started, so
+// that the remotes have just been reached; remotes that would need to ask
+// for credentials are skipped.
+func (self *TagsHelper) RefreshRemoteTags() {
+	remoteTags := map[string]*set.Set[string]{}
+	for _, remote := range self.c.Model().Remotes {
+		tagNames, err := self.c.Git().Tag.RemoteTags(remote.Name)
+		if err != nil {
+			self.c.Log.Warnf("Error listing tags of remote %s: %v", remote.Name, err)
+			continue
+		}
+		remoteTags[remote.Name] = set.NewFromSlice(tagNames)
+	}
+
+	self.c.OnUIThread(func() error {
+		self.c.Model().RemoteTags = remoteTags
+		self.c.Contexts().Tags.HandleRender()
+		return nil
+	})
+}
+
+// Records that we've pushed the given tags to the remote, or deleted them
+// from it, without listing the remote's tags again
+func (self *TagsHelper) UpdateRemoteTags(remoteName string, tagNames []string, onRemote bool) {
+	self.c.OnUIThread(func() error {
+		remoteTags, ok := self.c.Model().RemoteTags[remoteName]
+		if !ok {
+			// We don't know which other tags the remote has, so keep it unknown
+			return nil
+		}
+		if onRemote {
+			remoteTags.Add(tagNames...)
+		} else {
+			remoteTags.RemoveSlice(tagNames)
+		}
+		self.c.Contexts().Tags.HandleRender()
+		return nil
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 	"golang.org/x/exp/slices"
 )
 
@@ -72,6 +73,17 @@ type WindowArrangementArgs struct {
 	InSearchPrompt bool
 	// One of '' (not searching), 'Search: ', and 'Filter: '
 	SearchPrefix string
+	// The side panel width ratio that the user chose by dragging the border
+	// between the side panels and the main view; zero if they haven't, in
+	// which case the sidePanelWidth config is used
+	ResizedSidePanelWidth float64
+	// Likewise for the height of the command log, set by dragging the border
+	// above the extras window
+	ResizedCommandLogSize int
+	// The side panels and their position as chosen in the layout menu; if
+	// empty, the sidePanels and sidePanelPosition configs are used
+	ChosenSidePanels        []string
+	ChosenSidePanelPosition string
 }
 
 func (self *WindowArrangementHelper) GetWindowDimensions(informationStr string, appStatus string) map[string]boxlayout.Dimensions {
@@ -101,6 +113,11 @@ func (self *WindowArrangementHelper) GetWindowDimensions(informationStr string,
 		IsAnyModeActive:     self.modeHelper.IsAnyModeActive(),
 		InSearchPrompt:      repoState.InSearchPrompt(),
 		SearchPrefix:        searchPrefix,
+
+		ResizedSidePanelWidth:   self.c.GetAppState().SidePanelWidth,
+		ResizedCommandLogSize:   self.c.GetAppState().CommandLogSize,
+		ChosenSidePanels:        self.c.GetAppState().SidePanels,
+		ChosenSidePanelPosition: self.c.GetAppState().SidePanelPosition,
 	}
 
 	return GetWindowDimensions(args)
@@ -129,33 +146,39 @@ func GetWindowDimensions(args WindowArrangementArgs) map[string]boxlayout.Dimens
 		sidePanelsDirection = boxlayout.ROW
 	}
 
-	showInfoSection := args.UserConfig.Gui.ShowBottomLine ||
-		args.InSearchPrompt ||
-		args.IsAnyModeActive ||
-		args.AppStatus != ""
+	// In zen mode the bottom line is only shown while searching, so that
+	// the search prompt remains visible
+	showInfoSection := args.InSearchPrompt ||
+		(args.ScreenMode != types.SCREEN_ZEN &&
+			(args.UserConfig.Gui.ShowBottomLine || args.IsAnyModeActive || args.AppStatus != ""))
 	infoSectionSize := 0
 	if showInfoSection {
 		infoSectionSize = 1
 	}
 
+	midSectionChildren := []*boxlayout.Box{
+		{
+			Direction:           boxlayout.ROW,
+			Weight:              sideSectionWeight,
+			ConditionalChildren: sidePanelChildren(args),
+		},
+		{
+			Direction: boxlayout.ROW,
+			Weight:    mainSectionWeight,
+			Children:  mainPanelChildren(args),
+		},
+	}
+	if sidePanelsOnRight(args) {
+		midSectionChildren = lo.Reverse(midSectionChildren)
+	}
+
 	root := &boxlayout.Box{
 		Direction: boxlayout.ROW,
 		Children: []*boxlayout.Box{
 			{
 				Direction: sidePanelsDirection,
 				Weight:    1,
-				Children: []*boxlayout.Box{
-					{
-						Direction:           boxlayout.ROW,
-						Weight:              sideSectionWeight,
-						ConditionalChildren: sidePanelChildren(args),
-					},
-					{
-						Direction: boxlayout.ROW,
-						Weight:    mainSectionWeight,
-						Children:  mainPanelChildren(args),
-					},
-				},
+				Children:  midSectionChildren,
 			},
 			{
 				Direction: boxlayout.COLUMN,
@@ -171,6 +194,41 @@ func GetWindowDimensions(args WindowArrangementArgs) map[string]boxlayout.Dimens
 	return MergeMaps(layerOneWindows, limitWindows)
 }
 
+func sidePanelsOnRight(args WindowArrangementArgs) bool {
+	position := args.ChosenSidePanelPosition
+	if position == "" {
+		position = args.UserConfig.Gui.SidePanelPosition
+	}
+	return position == "right"
+}
+
+func sidePanelSlots(args WindowArrangementArgs) [][]string {
+	return chosenOrConfiguredSidePanelSlots(args.ChosenSidePanels, args.UserConfig.Gui.SidePanels)
+}
+
+func chosenOrConfiguredSidePanelSlots(chosen []string, configured []string) [][]string {
+	if slots := config.SidePanelSlots(chosen); len(slots) > 0 {
+		return slots
+	}
+	return config.SidePanelSlots(configured)
+}
+
+// Returns the side windows to show from top to bottom: for each slot the
+// window in it that has focus, or else its first window. A hidden window is
+// shown at the bottom while it has focus.
+func visibleSideWindows(args WindowArrangementArgs) []string {
+	result := lo.Map(sidePanelSlots(args), func(slot []string, _ int) string {
+		if lo.Contains(slot, args.CurrentSideWindow) {
+			return args.CurrentSideWindow
+		}
+		return slot[0]
+	})
+	if lo.Contains(config.SidePanelNames, args.CurrentSideWindow) && !lo.Contains(result, args.CurrentSideWindow) {
+		result = append(result, args.CurrentSideWindow)
+	}
+	return result
+}
+
 func mainPanelChildren(args WindowArrangementArgs) []*boxlayout.Box {
 	mainPanelsDirection := boxlayout.ROW
 	if splitMainPanelSideBySide(args) {
@@ -184,7 +242,7 @@ func mainPanelChildren(args WindowArrangementArgs) []*boxlayout.Box {
 			Weight:    1,
 		},
 	}
-	if args.ShowExtrasWindow {
+	if args.ShowExtrasWindow && (args.ScreenMode != types.SCREEN_ZEN || args.CurrentStaticWindow == "extras") {
 		result = append(result, &boxlayout.Box{
 			Window: "extras",
 			Size:   getExtrasWindowSize(args),
@@ -206,8 +264,9 @@ func MergeMaps[K comparable, V any](maps ...map[K]V) map[K]V {
 
 func mainSectionChildren(args WindowArrangementArgs) []*boxlayout.Box {
 	// if we're not in split mode we can just show the one main panel. Likewise if
-	// the main panel is focused and we're in full-screen mode
-	if !args.SplitMainPanel || (args.ScreenMode == types.SCREEN_FULL && args.CurrentWindow == "main") {
+	// the main panel is focused and we're in full-screen or zen mode
+	maximised := args.ScreenMode == types.SCREEN_FULL || args.ScreenMode == types.SCREEN_ZEN
+	if !args.SplitMainPanel || (maximised && args.CurrentWindow == "main") {
 		return []*boxlayout.Box{
 			{
 				Window: "main",
@@ -216,7 +275,7 @@ func mainSectionChildren(args WindowArrangementArgs) []*boxlayout.Box {
 		}
 	}
 
-	if args.CurrentWindow == "secondary" && args.ScreenMode == types.SCREEN_FULL {
+	if args.CurrentWindow == "secondary" && maximised {
 		return []*boxlayout.Box{
 			{
 				Window: "secondary",
@@ -239,6 +298,9 @@ func mainSectionChildren(args WindowArrangementArgs) []*boxlayout.Box {
 
 func getMidSectionWeights(args WindowArrangementArgs) (int, int) {
 	sidePanelWidthRatio := args.UserConfig.Gui.SidePanelWidth
+	if args.ResizedSidePanelWidth > 0 {
+		sidePanelWidthRatio = args.ResizedSidePanelWidth
+	}
 	// Using 120 so that the default of 0.3333 will remain consistent with previous behavior
 	const maxColumnCount = 120
 	mainSectionWeight := int(math.Round(maxColumnCount * (1 - sidePanelWidthRatio)))
@@ -249,11 +311,14 @@ func getMidSectionWeights(args WindowArrangementArgs) (int, int) {
 	}
 
 	if args.CurrentWindow == "main" || args.CurrentWindow == "secondary" {
-		if args.ScreenMode == types.SCREEN_HALF || args.ScreenMode == types.SCREEN_FULL {
+		if args.ScreenMode == types.SCREEN_HALF || args.ScreenMode == types.SCREEN_FULL || args.ScreenMode == types.SCREEN_ZEN {
 			sideSectionWeight = 0
 		}
 	} else {
-		if args.ScreenMode == types.SCREEN_HALF {
+		if args.ScreenMode == types.SCREEN_ZEN {
+			// the focused side panel and its main view share the screen
+			mainSectionWeight = sideSectionWeight
+		} else if args.ScreenMode == types.SCREEN_HALF {
 			if args.UserConfig.Gui.EnlargedSideViewLocation == "top" {
 				mainSectionWeight = sideSectionWeight * 2
 			} else {
@@ -395,6 +460,8 @@ func getExtrasWindowSize(args WindowArrangementArgs) int {
 	// The 'extras' window contains the command log context
 	if args.CurrentStaticWindow == "extras" {
 		baseSize = 1000 // my way of saying 'fill the available space'
+	} else if args.ResizedCommandLogSize > 0 {
+		baseSize = args.ResizedCommandLogSize
 	} else if args.Height < 40 {
 		baseSize = 1
 	} else {
@@ -423,7 +490,9 @@ func getDefaultStashWindowBox(args WindowArrangementArgs) *boxlayout.Box {
 
 func sidePanelChildren(args WindowArrangementArgs) func(width int, height int) []*boxlayout.Box {
 	return func(width int, height int) []*boxlayout.Box {
-		if args.ScreenMode == types.SCREEN_FULL || args.ScreenMode == types.SCREEN_HALF {
+		windows := visibleSideWindows(args)
+
+		if args.ScreenMode == types.SCREEN_FULL || args.ScreenMode == types.SCREEN_HALF || args.ScreenMode == types.SCREEN_ZEN {
 			fullHeightBox := func(window string) *boxlayout.Box {
 				if window == args.CurrentSideWindow {
 					return &boxlayout.Box{
@@ -438,13 +507,9 @@ func sidePanelChildren(args WindowArrangementArgs) func(width int, height int) [
 				}
 			}
 
-			return []*boxlayout.Box{
-				fullHeightBox("status"),
-				fullHeightBox("files"),
-				fullHeightBox("branches"),
-				fullHeightBox("commits"),
-				fullHeightBox("stash"),
-			}
+			return lo.Map(windows, func(window string, _ int) *boxlayout.Box {
+				return fullHeightBox(window)
+			})
 		} else if height >= 28 {
 			accordionMode := args.UserConfig.Gui.ExpandFocusedSidePanel
 			accordionBox := func(defaultBox *boxlayout.Box) *boxlayout.Box {
@@ -458,16 +523,22 @@ func sidePanelChildren(args WindowArrangementArgs) func(width int, height int) [
 				return defaultBox
 			}
 
-			return []*boxlayout.Box{
-				{
-					Window: "status",
-					Size:   3,
-				},
-				accordionBox(&boxlayout.Box{Window: "files", Weight: 1}),
-				accordionBox(&boxlayout.Box{Window: "branches", Weight: 1}),
-				accordionBox(&boxlayout.Box{Window: "commits", Weight: 1}),
-				accordionBox(getDefaultStashWindowBox(args)),
+			boxes := lo.Map(windows, func(window string, _ int) *boxlayout.Box {
+				switch window {
+				case "status":
+					return &boxlayout.Box{Window: "status", Size: 3}
+				case "stash":
+					return accordionBox(getDefaultStashWindowBox(args))
+				default:
+					return accordionBox(&boxlayout.Box{Window: window, Weight: 1})
+				}
+			})
+			// If only fixed-size panels are shown, let the last one take up
+			// the remaining space
+			if !lo.SomeBy(boxes, func(box *boxlayout.Box) bool { return box.Weight > 0 }) {
+				boxes[len(boxes)-1] = &boxlayout.Box{Window: boxes[len(boxes)-1].Window, Weight: 1}
 			}
+			return boxes
 		}
 
 		squashedHeight := 1
@@ -489,12 +560,8 @@ func sidePanelChildren(args WindowArrangementArgs) func(width int, height int) [
 			}
 		}
 
-		return []*boxlayout.Box{
-			squashedSidePanelBox("status"),
-			squashedSidePanelBox("files"),
-			squashedSidePanelBox("branches"),
-			squashedSidePanelBox("commits"),
-			squashedSidePanelBox("stash"),
-		}
+		return lo.Map(windows, func(window string, _ int) *boxlayout.Box {
+			return squashedSidePanelBox(window)
+		})
 	}
 }
@@ -283,6 +283,172 @@ func TestGetWindowDimensions(t *testing.T) {
 			B: information
 			`,
 		},
+		{
+			name: "side panel and command log resized with the mouse",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.UserConfig.Gui.SidePanelWidth = 0.8
+				args.ShowExtrasWindow = true
+				args.ResizedSidePanelWidth = 0.5
+				args.ResizedCommandLogSize = 5
+			},
+			expected: `
+			╭status──────────────────────────────╮╭main───────────────────────────────╮
+			│                                    ││                                   │
+			╰────────────────────────────────────╯│                                   │
+			╭files───────────────────────────────╮│                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			╰────────────────────────────────────╯│                                   │
+			╭branches────────────────────────────╮│                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			╰────────────────────────────────────╯│                                   │
+			╭commits─────────────────────────────╮│                                   │
+			│                                    ││                                   │
+			│                                    │╰───────────────────────────────────╯
+			│                                    │╭extras─────────────────────────────╮
+			│                                    ││                                   │
+			│                                    ││                                   │
+			╰────────────────────────────────────╯│                                   │
+			╭stash───────────────────────────────╮│                                   │
+			│                                    ││                                   │
+			╰────────────────────────────────────╯╰───────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
+		{
+			name: "stash hidden and branches moved to the top",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.UserConfig.Gui.SidePanels = []string{"branches", "status", "files", "commits"}
+			},
+			expected: `
+			╭branches───────────────╮╭main────────────────────────────────────────────╮
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭status─────────────────╮│                                                │
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭files──────────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭commits────────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯╰────────────────────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
+		{
+			name: "commits and stash sharing a slot, stash focused",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.UserConfig.Gui.SidePanels = []string{"status", "files", "branches", "commits, stash"}
+				args.CurrentSideWindow = "stash"
+			},
+			expected: `
+			╭status─────────────────╮╭main────────────────────────────────────────────╮
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭files──────────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭branches───────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯│                                                │
+			╭stash──────────────────╮│                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			│                       ││                                                │
+			╰───────────────────────╯╰────────────────────────────────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
+		{
+			name: "hidden panel focused, side panels on the right as chosen in the layout menu",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.UserConfig.Gui.SidePanels = []string{"status", "files", "branches", "commits"}
+				args.ChosenSidePanelPosition = "right"
+				args.CurrentSideWindow = "stash"
+			},
+			expected: `
+			╭main────────────────────────────────────────────╮╭status─────────────────╮
+			│                                                ││                       │
+			│                                                │╰───────────────────────╯
+			│                                                │╭files──────────────────╮
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                │╰───────────────────────╯
+			│                                                │╭branches───────────────╮
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                │╰───────────────────────╯
+			│                                                │╭commits────────────────╮
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                │╰───────────────────────╯
+			│                                                │╭stash──────────────────╮
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			│                                                ││                       │
+			╰────────────────────────────────────────────────╯╰───────────────────────╯
+			<options──────────────────────────────────────────────────────>A<B────────>
+			A: statusSpacer1
+			B: information
+			`,
+		},
 		{
 			name: "half screen mode, enlargedSideViewLocation left",
 			mutateArgs: func(args *WindowArrangementArgs) {
@@ -347,6 +513,86 @@ func TestGetWindowDimensions(t *testing.T) {
 			B: information
 			`,
 		},
+		{
+			name: "zen mode",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.Height = 20
+				args.ScreenMode = types.SCREEN_ZEN
+				args.ShowExtrasWindow = true
+				args.AppStatus = "Rebasing"
+			},
+			expected: `
+			╭status──────────────────────────────╮╭main───────────────────────────────╮
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			╰────────────────────────────────────╯╰───────────────────────────────────╯
+			`,
+		},
+		{
+			name: "zen mode, main view focused",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.Height = 20
+				args.ScreenMode = types.SCREEN_ZEN
+				args.CurrentWindow = "main"
+				args.SplitMainPanel = true
+			},
+			expected: `
+			╭main─────────────────────────────────────────────────────────────────────╮
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			│                                                                         │
+			╰─────────────────────────────────────────────────────────────────────────╯
+			`,
+		},
+		{
+			name: "zen mode, search prompt shown",
+			mutateArgs: func(args *WindowArrangementArgs) {
+				args.Height = 6
+				args.ScreenMode = types.SCREEN_ZEN
+				args.InSearchPrompt = true
+				args.SearchPrefix = "Search: "
+			},
+			expected: `
+			╭status──────────────────────────────╮╭main───────────────────────────────╮
+			│                                    ││                                   │
+			│                                    ││                                   │
+			│                                    ││                                   │
+			╰────────────────────────────────────╯╰───────────────────────────────────╯
+			<A─────><search───────────────────────────────────────────────────────────>
+			A: searchPrefix
+			`,
+		},
 		{
 			name: "search mode",
 			mutateArgs: func(args *WindowArrangementArgs) {
@@ -500,17 +746,17 @@ func renderLayout(windows map[string]boxlayout.Dimensions) string {
 		return cmp.Compare(dimensionsA.X0, dimensionsB.X0)
 	})
 
+	// excluding the limit window because it overlaps with everything. In future
+	// we should have a concept of layers and then our test can assert against
+	// each layer.
+	windowNames = lo.Without(windowNames, "limit")
+
 	// Uniquify windows by dimensions (so perfectly overlapping windows are de-duped). This prevents getting 'fileshes' as a label where the files and branches windows overlap.
 	// branches windows overlap.
 	windowNames = lo.UniqBy(windowNames, func(windowName string) boxlayout.Dimensions {
 		return windows[windowName]
 	})
 
-	// excluding the limit window because it overlaps with everything. In future
-	// we should have a concept of layers and then our test can assert against
-	// each layer.
-	windowNames = lo.Without(windowNames, "limit")
-
 	// get width/height by getting the max values of the dimensions
 	width := 0
 	height := 0
@@ -533,7 +779,9 @@ func renderLayout(windows map[string]boxlayout.Dimensions) string {
 		dimensions := windows[windowName]
 
 		zeroWidth := dimensions.X0 == dimensions.X1+1
-		if zeroWidth {
+		// e.g. the windows of a hidden bottom line
+		belowScreen := dimensions.Y0 >= height
+		if zeroWidth || belowScreen {
 			continue
 		}
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -134,6 +135,27 @@ func (self *WindowHelper) WindowForView(viewName string) string {
 	return context.GetWindowName()
 }
 
+// Returns the side windows that are part of the layout, from top to bottom.
+// Hidden windows are left out.
 func (self *WindowHelper) SideWindows() []string {
-	return []string{"status", "files", "branches", "commits", "stash"}
+	return lo.Flatten(self.SidePanelSlots())
+}
+
+// Returns all side windows in their default order, including hidden ones
+func (self *WindowHelper) AllSideWindows() []string {
+	return config.SidePanelNames
+}
+
+// Returns the slots of the side section from top to bottom, as configured or
+// as chosen in the layout menu, each with the windows that share it
+func (self *WindowHelper) SidePanelSlots() [][]string {
+	return chosenOrConfiguredSidePanelSlots(self.c.GetAppState().SidePanels, self.c.UserConfig().Gui.SidePanels)
+}
+
+func (self *WindowHelper) SidePanelsOnRight() bool {
+	position := self.c.GetAppState().SidePanelPosition
+	if position == "" {
+		position = self.c.UserConfig().Gui.SidePanelPosition
+	}
+	return position == "right"
 }
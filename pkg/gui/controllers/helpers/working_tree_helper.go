@@ -47,7 +47,13 @@ func (self *WorkingTreeHelper) AnyTrackedFiles() bool {
 }
 
 func AnyTrackedFiles(files []*models.File) bool {
-	return lo.SomeBy(files, func(f *models.File) bool { return f.Tracked })
+	return lo.SomeBy(files, func(f *models.File) bool { return f.Tracked && !f.HiddenFromStatus() })
+}
+
+// Returns false if there are no files, or if all files are only listed
+// because of their skip-worktree or assume-unchanged bits
+func AnyFilesWithChanges(files []*models.File) bool {
+	return lo.SomeBy(files, func(f *models.File) bool { return !f.HiddenFromStatus() })
 }
 
 func (self *WorkingTreeHelper) IsWorkingTreeDirty() bool {
@@ -83,6 +89,24 @@ func (self *WorkingTreeHelper) OpenMergeTool() error {
 	return nil
 }
 
+// Opens the merge tool for the given conflicted file, and stages the file if
+// the tool exits successfully
+func (self *WorkingTreeHelper) OpenMergeToolForFile(path string) error {
+	self.c.LogAction(self.c.Tr.Actions.OpenMergeTool)
+	success, err := self.c.RunSubprocess(
+		self.c.Git().WorkingTree.OpenMergeToolForFileCmdObj(path, self.c.UserConfig().Git.MergeTool),
+	)
+	if success {
+		self.c.LogAction(self.c.Tr.Actions.StageResolvedFiles)
+		if err := self.c.Git().WorkingTree.StageFile(path); err != nil {
+			return err
+		}
+	}
+
+	self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	return err
+}
+
 func (self *WorkingTreeHelper) HandleCommitPressWithMessage(initialMessage string, forceSkipHooks bool) error {
 	return self.WithEnsureCommittableFiles(func() error {
 		self.commitsHelper.OpenCommitMessagePanel(
@@ -190,7 +214,7 @@ func (self *WorkingTreeHelper) WithEnsureCommittableFiles(handler func() error)
 		return err
 	}
 
-	if len(self.c.Model().Files) == 0 {
+	if !AnyFilesWithChanges(self.c.Model().Files) {
 		return errors.New(self.c.Tr.NoFilesStagedTitle)
 	}
 
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"errors"
+	"path/filepath"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
@@ -10,6 +11,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type WorktreeHelper struct {
@@ -17,14 +19,16 @@ type WorktreeHelper struct {
 	reposHelper       *ReposHelper
 	refsHelper        *RefsHelper
 	suggestionsHelper *SuggestionsHelper
+	filesHelper       *FilesHelper
 }
 
-func NewWorktreeHelper(c *HelperCommon, reposHelper *ReposHelper, refsHelper *RefsHelper, suggestionsHelper *SuggestionsHelper) *WorktreeHelper {
+func NewWorktreeHelper(c *HelperCommon, reposHelper *ReposHelper, refsHelper *RefsHelper, suggestionsHelper *SuggestionsHelper, filesHelper *FilesHelper) *WorktreeHelper {
 	return &WorktreeHelper{
 		c:                 c,
 		reposHelper:       reposHelper,
 		refsHelper:        refsHelper,
 		suggestionsHelper: suggestionsHelper,
+		filesHelper:       filesHelper,
 	}
 }
 
@@ -58,7 +62,7 @@ func (self *WorktreeHelper) NewWorktree() error {
 	branch := self.refsHelper.GetCheckedOutRef()
 	currentBranchName := branch.RefName()
 
-	f := func(detached bool) {
+	f := func(detached bool, lock bool) {
 		self.c.Prompt(types.PromptOpts{
 			Title:               self.c.Tr.NewWorktreeBase,
 			InitialContent:      currentBranchName,
@@ -66,7 +70,7 @@ func (self *WorktreeHelper) NewWorktree() error {
 			HandleConfirm: func(base string) error {
 				// we assume that the base can be checked out
 				canCheckoutBase := true
-				return self.NewWorktreeCheckout(base, canCheckoutBase, detached, context.WORKTREES_CONTEXT_KEY)
+				return self.NewWorktreeCheckout(base, canCheckoutBase, detached, lock, context.WORKTREES_CONTEXT_KEY)
 			},
 		})
 	}
@@ -79,45 +83,67 @@ func (self *WorktreeHelper) NewWorktree() error {
 			{
 				LabelColumns: []string{utils.ResolvePlaceholderString(self.c.Tr.CreateWorktreeFrom, placeholders)},
 				OnPress: func() error {
-					f(false)
+					f(false, false)
 					return nil
 				},
 			},
 			{
 				LabelColumns: []string{utils.ResolvePlaceholderString(self.c.Tr.CreateWorktreeFromDetached, placeholders)},
 				OnPress: func() error {
-					f(true)
+					f(true, false)
 					return nil
 				},
 			},
+			{
+				LabelColumns: []string{utils.ResolvePlaceholderString(self.c.Tr.CreateLockedWorktreeFrom, placeholders)},
+				OnPress: func() error {
+					f(false, true)
+					return nil
+				},
+				Tooltip: self.c.Tr.CreateLockedWorktreeTooltip,
+			},
 		},
 	})
 }
 
-func (self *WorktreeHelper) NewWorktreeCheckout(base string, canCheckoutBase bool, detached bool, contextKey types.ContextKey) error {
+func (self *WorktreeHelper) NewWorktreeCheckout(base string, canCheckoutBase bool, detached bool, lock bool, contextKey types.ContextKey) error {
 	opts := git_commands.NewWorktreeOpts{
 		Base:   base,
 		Detach: detached,
-	}
-
-	f := func() error {
-		return self.c.WithWaitingStatus(self.c.Tr.AddingWorktree, func(gocui.Task) error {
-			self.c.LogAction(self.c.Tr.Actions.AddWorktree)
-			if err := self.c.Git().Worktree.New(opts); err != nil {
-				return err
-			}
-
-			return self.reposHelper.DispatchSwitchTo(opts.Path, self.c.Tr.ErrWorktreeMovedOrRemoved, contextKey)
-		})
+		Lock:   lock,
 	}
 
 	self.c.Prompt(types.PromptOpts{
-		Title: self.c.Tr.NewWorktreePath,
+		Title:               self.c.Tr.NewWorktreePath,
+		FindSuggestionsFunc: self.getWorktreePathSuggestionsFunc(base),
 		HandleConfirm: func(path string) error {
 			opts.Path = path
 
 			if detached {
-				return f()
+				return self.askForLockReasonIfLocking(opts, contextKey)
+			}
+
+			if remoteBranch := self.findRemoteBranch(base); remoteBranch != nil {
+				title := utils.ResolvePlaceholderString(self.c.Tr.NewBranchNameTrackingRemoteBranch, map[string]string{
+					"remoteBranch": base,
+					"default":      remoteBranch.Name,
+				})
+				// prompt for the name of the new branch that tracks the remote
+				// branch, where a blank means we use the remote branch's name
+				self.c.Prompt(types.PromptOpts{
+					Title: title,
+					HandleConfirm: func(branchName string) error {
+						if branchName == "" {
+							branchName = remoteBranch.Name
+						}
+						opts.Branch = branchName
+						opts.Track = true
+
+						return self.askForLockReasonIfLocking(opts, contextKey)
+					},
+				})
+
+				return nil
 			}
 
 			if canCheckoutBase {
@@ -128,7 +154,7 @@ func (self *WorktreeHelper) NewWorktreeCheckout(base string, canCheckoutBase boo
 					HandleConfirm: func(branchName string) error {
 						opts.Branch = branchName
 
-						return f()
+						return self.askForLockReasonIfLocking(opts, contextKey)
 					},
 				})
 
@@ -145,7 +171,7 @@ func (self *WorktreeHelper) NewWorktreeCheckout(base string, canCheckoutBase boo
 
 					opts.Branch = branchName
 
-					return f()
+					return self.askForLockReasonIfLocking(opts, contextKey)
 				},
 			})
 
@@ -156,6 +182,106 @@ func (self *WorktreeHelper) NewWorktreeCheckout(base string, canCheckoutBase boo
 	return nil
 }
 
+func (self *WorktreeHelper) askForLockReasonIfLocking(opts git_commands.NewWorktreeOpts, contextKey types.ContextKey) error {
+	if !opts.Lock {
+		return self.chooseHowToCreateWorktree(opts, contextKey)
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.WorktreeLockReason,
+		HandleConfirm: func(reason string) error {
+			opts.LockReason = reason
+
+			return self.chooseHowToCreateWorktree(opts, contextKey)
+		},
+	})
+
+	return nil
+}
+
+// Opening the new worktree in a new window is only possible if the user has
+// configured how to do that; otherwise there's nothing to choose, and we
+// switch to the new worktree right away.
+func (self *WorktreeHelper) chooseHowToCreateWorktree(opts git_commands.NewWorktreeOpts, contextKey types.ContextKey) error {
+	if self.c.UserConfig().OS.OpenDirInNewWindow == "" {
+		return self.createWorktree(opts, contextKey, false)
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CreateWorktreeMenuTitle,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.CreateWorktreeAndSwitch,
+				OnPress: func() error {
+					return self.createWorktree(opts, contextKey, false)
+				},
+				Key: 's',
+			},
+			{
+				Label: self.c.Tr.CreateWorktreeAndOpenInNewWindow,
+				OnPress: func() error {
+					return self.createWorktree(opts, contextKey, true)
+				},
+				Key:     'w',
+				Tooltip: self.c.Tr.CreateWorktreeAndOpenInNewWindowTooltip,
+			},
+		},
+	})
+}
+
+func (self *WorktreeHelper) createWorktree(opts git_commands.NewWorktreeOpts, contextKey types.ContextKey, openInNewWindow bool) error {
+	return self.c.WithWaitingStatus(self.c.Tr.AddingWorktree, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.AddWorktree)
+		if err := self.c.Git().Worktree.New(opts); err != nil {
+			return err
+		}
+
+		if openInNewWindow {
+			self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.WORKTREES, types.BRANCHES}})
+
+			absPath, err := filepath.Abs(opts.Path)
+			if err != nil {
+				return err
+			}
+			self.c.LogAction(self.c.Tr.Actions.OpenWorktreeInNewWindow)
+			return self.c.OS().OpenDirInNewWindow(absPath)
+		}
+
+		return self.reposHelper.DispatchSwitchTo(opts.Path, self.c.Tr.ErrWorktreeMovedOrRemoved, contextKey)
+	})
+}
+
+// Suggests paths for a worktree of the given ref: one next to the repo, and
+// one next to each existing linked worktree
+func (self *WorktreeHelper) getWorktreePathSuggestionsFunc(ref string) func(string) []*types.Suggestion {
+	name := ref
+	if remoteBranch := self.findRemoteBranch(ref); remoteBranch != nil {
+		name = remoteBranch.Name
+	}
+	name = strings.ReplaceAll(name, "/", "-")
+
+	paths := []string{filepath.Join("..", self.c.Git().RepoPaths.RepoName()+"-"+name)}
+	for _, worktree := range self.c.Model().Worktrees {
+		if !worktree.IsMain {
+			paths = append(paths, filepath.Join(filepath.Dir(worktree.Path), name))
+		}
+	}
+
+	return FilterFunc(lo.Uniq(paths), self.c.UserConfig().Gui.UseFuzzySearch())
+}
+
+func (self *WorktreeHelper) findRemoteBranch(ref string) *models.RemoteBranch {
+	for _, remote := range self.c.Model().Remotes {
+		for _, branch := range remote.Branches {
+			if branch.FullName() == ref {
+				return branch
+			}
+		}
+	}
+
+	return nil
+}
+
 func (self *WorktreeHelper) Switch(worktree *models.Worktree, contextKey types.ContextKey) error {
 	if worktree.IsCurrent {
 		return errors.New(self.c.Tr.AlreadyInWorktree)
@@ -230,21 +356,148 @@ func (self *WorktreeHelper) ViewWorktreeOptions(context types.IListContext, ref
 func (self *WorktreeHelper) ViewBranchWorktreeOptions(branchName string, canCheckoutBase bool) error {
 	placeholders := map[string]string{"ref": branchName}
 
+	var removeReviewWorktreeDisabledReason *types.DisabledReason
+	if self.existingReviewWorktree(branchName) == nil {
+		removeReviewWorktreeDisabledReason = &types.DisabledReason{Text: self.c.Tr.NoReviewWorktree}
+	}
+
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.WorktreeTitle,
 		Items: []*types.MenuItem{
 			{
 				LabelColumns: []string{utils.ResolvePlaceholderString(self.c.Tr.CreateWorktreeFrom, placeholders)},
 				OnPress: func() error {
-					return self.NewWorktreeCheckout(branchName, canCheckoutBase, false, context.LOCAL_BRANCHES_CONTEXT_KEY)
+					return self.NewWorktreeCheckout(branchName, canCheckoutBase, false, false, context.LOCAL_BRANCHES_CONTEXT_KEY)
 				},
 			},
 			{
 				LabelColumns: []string{utils.ResolvePlaceholderString(self.c.Tr.CreateWorktreeFromDetached, placeholders)},
 				OnPress: func() error {
-					return self.NewWorktreeCheckout(branchName, canCheckoutBase, true, context.LOCAL_BRANCHES_CONTEXT_KEY)
+					return self.NewWorktreeCheckout(branchName, canCheckoutBase, true, false, context.LOCAL_BRANCHES_CONTEXT_KEY)
+				},
+			},
+			{
+				LabelColumns: []string{utils.ResolvePlaceholderString(self.c.Tr.CreateLockedWorktreeFrom, placeholders)},
+				OnPress: func() error {
+					return self.NewWorktreeCheckout(branchName, canCheckoutBase, false, true, context.LOCAL_BRANCHES_CONTEXT_KEY)
+				},
+				Tooltip: self.c.Tr.CreateLockedWorktreeTooltip,
+			},
+			{
+				LabelColumns: []string{utils.ResolvePlaceholderString(self.c.Tr.ReviewInWorktree, placeholders)},
+				OnPress: func() error {
+					return self.ReviewInWorktree(branchName, false)
 				},
+				Tooltip: utils.ResolvePlaceholderString(self.c.Tr.ReviewInWorktreeTooltip, placeholders),
+			},
+			{
+				LabelColumns: []string{utils.ResolvePlaceholderString(self.c.Tr.ReviewInWorktreeAndOpenInEditor, placeholders)},
+				OnPress: func() error {
+					return self.ReviewInWorktree(branchName, true)
+				},
+				Tooltip: utils.ResolvePlaceholderString(self.c.Tr.ReviewInWorktreeTooltip, placeholders),
+			},
+			{
+				LabelColumns: []string{utils.ResolvePlaceholderString(self.c.Tr.RemoveReviewWorktree, placeholders)},
+				OnPress: func() error {
+					return self.RemoveReviewWorktree(branchName)
+				},
+				DisabledReason: removeReviewWorktreeDisabledReason,
 			},
 		},
 	})
 }
+
+// Switches to (or opens in the editor) a dedicated worktree for reviewing the
+// given ref, creating it first if it doesn't exist yet. If the ref is a local
+// branch that is already checked out in a linked worktree, that worktree is
+// reused.
+func (self *WorktreeHelper) ReviewInWorktree(ref string, openInEditor bool) error {
+	worktree := self.existingReviewWorktree(ref)
+	if worktree == nil {
+		worktree, _ = lo.Find(self.c.Model().Worktrees, func(worktree *models.Worktree) bool {
+			return !worktree.IsMain && worktree.Branch == ref
+		})
+	}
+
+	if worktree != nil {
+		if openInEditor {
+			return self.filesHelper.OpenDirInEditor(worktree.Path)
+		}
+		return self.Switch(worktree, context.LOCAL_BRANCHES_CONTEXT_KEY)
+	}
+
+	opts := git_commands.NewWorktreeOpts{
+		Path: self.reviewWorktreePath(ref),
+		Base: ref,
+		// We can only check out the branch itself if it's a local branch that
+		// isn't checked out anywhere else; otherwise we review a detached HEAD.
+		Detach: !self.isLocalBranchNotCheckedOut(ref),
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.AddingWorktree, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.AddReviewWorktree)
+		if err := self.c.Git().Worktree.New(opts); err != nil {
+			return err
+		}
+
+		if openInEditor {
+			self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.WORKTREES, types.BRANCHES}})
+			self.c.OnUIThread(func() error {
+				return self.filesHelper.OpenDirInEditor(opts.Path)
+			})
+			return nil
+		}
+
+		return self.reposHelper.DispatchSwitchTo(opts.Path, self.c.Tr.ErrWorktreeMovedOrRemoved, context.LOCAL_BRANCHES_CONTEXT_KEY)
+	})
+}
+
+func (self *WorktreeHelper) RemoveReviewWorktree(ref string) error {
+	worktree := self.existingReviewWorktree(ref)
+	if worktree == nil {
+		return errors.New(self.c.Tr.NoReviewWorktree)
+	}
+
+	if worktree.IsCurrent {
+		return errors.New(self.c.Tr.CantDeleteCurrentWorktree)
+	}
+
+	return self.Remove(worktree, false)
+}
+
+func (self *WorktreeHelper) existingReviewWorktree(ref string) *models.Worktree {
+	path := self.reviewWorktreePath(ref)
+	worktree, _ := lo.Find(self.c.Model().Worktrees, func(worktree *models.Worktree) bool {
+		return filepath.Clean(worktree.Path) == path
+	})
+	return worktree
+}
+
+func (self *WorktreeHelper) reviewWorktreePath(ref string) string {
+	path := utils.ResolvePlaceholderString(
+		self.c.UserConfig().Git.ReviewWorktreePath,
+		map[string]string{
+			"repoName":   self.c.Git().RepoPaths.RepoName(),
+			"branchName": strings.ReplaceAll(ref, "/", "-"),
+		},
+	)
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(self.c.Git().RepoPaths.RepoPath(), path)
+	}
+
+	return filepath.Clean(path)
+}
+
+func (self *WorktreeHelper) isLocalBranchNotCheckedOut(ref string) bool {
+	branch, ok := lo.Find(self.c.Model().Branches, func(branch *models.Branch) bool {
+		return branch.Name == ref
+	})
+	if !ok {
+		return false
+	}
+
+	_, checkedOut := git_commands.WorktreeForBranch(branch, self.c.Model().Worktrees)
+	return !checkedOut
+}
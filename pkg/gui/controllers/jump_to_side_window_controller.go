@@ -30,7 +30,7 @@ func (self *JumpToSideWindowController) Context() types.Context {
 }
 
 func (self *JumpToSideWindowController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
-	windows := self.c.Helpers().Window.SideWindows()
+	windows := self.c.Helpers().Window.AllSideWindows()
 
 	if len(opts.Config.Universal.JumpToBlock) != len(windows) {
 		log.Fatal("Jump to block keybindings cannot be set. Exactly 5 keybindings must be supplied.")
@@ -0,0 +1,213 @@
+package controllers
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Lets the user rearrange the side panels at runtime: change their order, hide
+// them, let several panels share a slot, and choose on which side of the main
+// view they are shown. The choices are stored in the app state and take
+// precedence over the gui.sidePanels and gui.sidePanelPosition configs.
+type LayoutMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *LayoutMenuAction) Call() error {
+	onRight := self.c.Helpers().Window.SidePanelsOnRight()
+	slots := self.c.Helpers().Window.SidePanelSlots()
+
+	menuItems := []*types.MenuItem{
+		{
+			Label:   self.c.Tr.SidePanelsOnLeft,
+			Widget:  types.MakeMenuRadioButton(!onRight),
+			OnPress: func() error { return self.setPosition("left") },
+			Key:     'l',
+		},
+		{
+			Label:   self.c.Tr.SidePanelsOnRight,
+			Tooltip: self.c.Tr.SidePanelsOnRightTooltip,
+			Widget:  types.MakeMenuRadioButton(onRight),
+			OnPress: func() error { return self.setPosition("right") },
+			Key:     'r',
+		},
+	}
+
+	panelsSection := &types.MenuSection{Title: self.c.Tr.SidePanelsSection}
+	shownPanels := lo.Flatten(slots)
+	hiddenPanels := lo.Without(config.SidePanelNames, shownPanels...)
+	for _, panel := range append(shownPanels, hiddenPanels...) {
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{self.panelTitle(panel), self.panelDescription(panel, slots)},
+			Widget:       types.MakeMenuCheckBox(lo.Contains(shownPanels, panel)),
+			Section:      panelsSection,
+			OnPress: func() error {
+				return self.panelMenu(panel, slots)
+			},
+			OpensMenu: true,
+		})
+	}
+
+	menuItems = append(menuItems, &types.MenuItem{
+		Label:   self.c.Tr.ResetLayout,
+		Tooltip: self.c.Tr.ResetLayoutTooltip,
+		OnPress: func() error {
+			self.c.GetAppState().SidePanels = nil
+			self.c.GetAppState().SidePanelPosition = ""
+			self.c.SaveAppStateAndLogError()
+			return self.Call()
+		},
+		DisabledReason: lo.Ternary(self.isCustomized(), nil,
+			&types.DisabledReason{Text: self.c.Tr.LayoutIsNotCustomized}),
+		Key: 'R',
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.LayoutMenuTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *LayoutMenuAction) panelMenu(panel string, slots [][]string) error {
+	slotIdx := slices.IndexFunc(slots, func(slot []string) bool { return lo.Contains(slot, panel) })
+	shown := slotIdx != -1
+
+	var hideDisabledReason *types.DisabledReason
+	if shown && len(lo.Flatten(slots)) == 1 {
+		hideDisabledReason = &types.DisabledReason{Text: self.c.Tr.CannotHideLastSidePanel}
+	}
+	moveDisabledReason := func(targetIdx int) *types.DisabledReason {
+		if !shown {
+			return &types.DisabledReason{Text: self.c.Tr.SidePanelIsHidden}
+		}
+		if targetIdx < 0 || targetIdx >= len(slots) {
+			return &types.DisabledReason{Text: self.c.Tr.CannotMoveAnyFurther}
+		}
+		return nil
+	}
+
+	menuItems := []*types.MenuItem{
+		{
+			Label:          lo.Ternary(shown, self.c.Tr.HideSidePanel, self.c.Tr.ShowSidePanel),
+			DisabledReason: hideDisabledReason,
+			OnPress: func() error {
+				if shown {
+					return self.setSlots(removePanelFromSlots(slots, panel))
+				}
+				return self.setSlots(append(slices.Clone(slots), []string{panel}))
+			},
+			Key: 'v',
+		},
+		{
+			Label:          self.c.Tr.MoveSidePanelUp,
+			DisabledReason: moveDisabledReason(slotIdx - 1),
+			OnPress: func() error {
+				return self.setSlots(swapSlots(slots, slotIdx, slotIdx-1))
+			},
+			Key: 'u',
+		},
+		{
+			Label:          self.c.Tr.MoveSidePanelDown,
+			DisabledReason: moveDisabledReason(slotIdx + 1),
+			OnPress: func() error {
+				return self.setSlots(swapSlots(slots, slotIdx, slotIdx+1))
+			},
+			Key: 'd',
+		},
+	}
+
+	if shown && len(slots[slotIdx]) > 1 {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label: self.c.Tr.GiveSidePanelOwnSlot,
+			OnPress: func() error {
+				newSlots := removePanelFromSlots(slots, panel)
+				newSlots = slices.Insert(newSlots, slotIdx+1, []string{panel})
+				return self.setSlots(newSlots)
+			},
+			Key: 's',
+		})
+	} else {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:          self.c.Tr.ShareSlotWithPanelAbove,
+			Tooltip:        self.c.Tr.ShareSlotWithPanelAboveTooltip,
+			DisabledReason: moveDisabledReason(slotIdx - 1),
+			OnPress: func() error {
+				newSlots := slices.Clone(slots)
+				newSlots[slotIdx-1] = append(slices.Clone(newSlots[slotIdx-1]), panel)
+				return self.setSlots(slices.Delete(newSlots, slotIdx, slotIdx+1))
+			},
+			Key: 's',
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.panelTitle(panel),
+		Items: menuItems,
+	})
+}
+
+func (self *LayoutMenuAction) panelTitle(panel string) string {
+	switch panel {
+	case "status":
+		return self.c.Tr.StatusTitle
+	case "files":
+		return self.c.Tr.FilesTitle
+	case "branches":
+		return self.c.Tr.BranchesTitle
+	case "commits":
+		return self.c.Tr.CommitsTitle
+	case "stash":
+		return self.c.Tr.StashTitle
+	}
+	return panel
+}
+
+func (self *LayoutMenuAction) panelDescription(panel string, slots [][]string) string {
+	slot, found := lo.Find(slots, func(slot []string) bool { return lo.Contains(slot, panel) })
+	if !found {
+		return style.FgBlue.Sprint(self.c.Tr.SidePanelHidden)
+	}
+	if len(slot) == 1 {
+		return ""
+	}
+	others := lo.Map(lo.Without(slot, panel), func(other string, _ int) string { return self.panelTitle(other) })
+	return style.FgBlue.Sprint(utils.ResolvePlaceholderString(self.c.Tr.SidePanelSharesSlotWith,
+		map[string]string{"panels": strings.Join(others, ", ")}))
+}
+
+func (self *LayoutMenuAction) isCustomized() bool {
+	return len(self.c.GetAppState().SidePanels) > 0 || self.c.GetAppState().SidePanelPosition != ""
+}
+
+func (self *LayoutMenuAction) setPosition(position string) error {
+	self.c.GetAppState().SidePanelPosition = position
+	self.c.SaveAppStateAndLogError()
+	return self.Call()
+}
+
+func (self *LayoutMenuAction) setSlots(slots [][]string) error {
+	self.c.GetAppState().SidePanels = lo.Map(slots, func(slot []string, _ int) string {
+		return strings.Join(slot, ", ")
+	})
+	self.c.SaveAppStateAndLogError()
+	return self.Call()
+}
+
+func removePanelFromSlots(slots [][]string, panel string) [][]string {
+	return lo.FilterMap(slots, func(slot []string, _ int) ([]string, bool) {
+		remaining := lo.Without(slot, panel)
+		return remaining, len(remaining) > 0
+	})
+}
+
+func swapSlots(slots [][]string, i, j int) [][]string {
+	result := slices.Clone(slots)
+	result[i], result[j] = result[j], result[i]
+	return result
+}
@@ -2,7 +2,10 @@ package controllers
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type ListControllerFactory struct {
@@ -23,6 +26,15 @@ func (self *ListControllerFactory) Create(context types.IListContext) *ListContr
 	}
 }
 
+// The panels whose items show a menu of the panel's actions when right-clicked,
+// unless the user configured a different right-click gesture
+var contextMenuContextKeys = []types.ContextKey{
+	context.FILES_CONTEXT_KEY,
+	context.LOCAL_BRANCHES_CONTEXT_KEY,
+	context.LOCAL_COMMITS_CONTEXT_KEY,
+	context.STASH_CONTEXT_KEY,
+}
+
 type ListController struct {
 	baseController
 	c *ControllerCommon
@@ -169,19 +181,60 @@ func (self *ListController) HandleClick(opts gocui.ViewMouseBindingOpts) error {
 		return err
 	}
 
-	if newSelectedLineIdx > self.context.GetList().Len()-1 {
+	if newSelectedLineIdx > self.context.GetList().Len()-1 || !self.clickActsOnItem(alreadyFocused) {
 		return nil
 	}
 
 	self.context.GetList().SetSelection(newSelectedLineIdx)
 
-	if opts.IsDoubleClick && alreadyFocused && self.context.GetOnClick() != nil {
-		return self.context.GetOnClick()()
+	if opts.IsDoubleClick && alreadyFocused {
+		if key := self.mouseGestures().DoubleClick; key != "" {
+			self.context.HandleFocus(types.OnFocusOpts{})
+			return self.runMouseGesture(key)
+		}
+		if self.context.GetOnClick() != nil {
+			return self.context.GetOnClick()()
+		}
 	}
 	self.context.HandleFocus(types.OnFocusOpts{})
 	return nil
 }
 
+// Selects the clicked item and runs the action for the gesture
+func (self *ListController) handleMouseGesture(opts gocui.ViewMouseBindingOpts, action func() error) error {
+	alreadyFocused := self.isFocused()
+
+	if err := self.pushContextIfNotFocused(); err != nil {
+		return err
+	}
+
+	newSelectedLineIdx := self.context.ViewIndexToModelIndex(opts.Y)
+	if newSelectedLineIdx > self.context.GetList().Len()-1 || !self.clickActsOnItem(alreadyFocused) {
+		return nil
+	}
+
+	self.context.GetList().SetSelection(newSelectedLineIdx)
+	self.context.HandleFocus(types.OnFocusOpts{})
+	return action()
+}
+
+func (self *ListController) runMouseGesture(key string) error {
+	if key == "<disabled>" {
+		return nil
+	}
+
+	return self.c.Helpers().Macro.Run([]string{key})
+}
+
+// Without click-through, the first click on a panel only focuses it
+func (self *ListController) clickActsOnItem(alreadyFocused bool) bool {
+	return alreadyFocused || self.c.UserConfig().Gui.ClickThrough
+}
+
+func (self *ListController) mouseGestures() config.MouseGesturesConfig {
+	return self.c.UserConfig().Keybinding.Mouse[string(self.context.GetKey())]
+}
+
 func (self *ListController) pushContextIfNotFocused() error {
 	if !self.isFocused() {
 		self.c.Context().Push(self.context, types.OnFocusOpts{})
@@ -196,10 +249,10 @@ func (self *ListController) isFocused() bool {
 
 func (self *ListController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
 	bindings := []*types.Binding{
-		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.PrevItemAlt), Handler: self.HandlePrevLine},
-		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.PrevItem), Handler: self.HandlePrevLine},
-		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.NextItemAlt), Handler: self.HandleNextLine},
-		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.NextItem), Handler: self.HandleNextLine},
+		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.PrevItemAlt), Handler: self.HandlePrevLine, Repeatable: true},
+		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.PrevItem), Handler: self.HandlePrevLine, Repeatable: true},
+		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.NextItemAlt), Handler: self.HandleNextLine, Repeatable: true},
+		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.NextItem), Handler: self.HandleNextLine, Repeatable: true},
 		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.PrevPage), Handler: self.HandlePrevPage, Description: self.c.Tr.PrevPage},
 		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.NextPage), Handler: self.HandleNextPage, Description: self.c.Tr.NextPage},
 		{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.GotoTop), Handler: self.HandleGotoTop, Description: self.c.Tr.GotoTop, Alternative: "<home>"},
@@ -214,8 +267,8 @@ func (self *ListController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 		bindings = append(bindings,
 			[]*types.Binding{
 				{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.ToggleRangeSelect), Handler: self.HandleToggleRangeSelect, Description: self.c.Tr.ToggleRangeSelect},
-				{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.RangeSelectDown), Handler: self.HandleRangeSelectDown, Description: self.c.Tr.RangeSelectDown},
-				{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.RangeSelectUp), Handler: self.HandleRangeSelectUp, Description: self.c.Tr.RangeSelectUp},
+				{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.RangeSelectDown), Handler: self.HandleRangeSelectDown, Description: self.c.Tr.RangeSelectDown, Repeatable: true},
+				{Tag: "navigation", Key: opts.GetKey(opts.Config.Universal.RangeSelectUp), Handler: self.HandleRangeSelectUp, Description: self.c.Tr.RangeSelectUp, Repeatable: true},
 			}...,
 		)
 	}
@@ -224,7 +277,7 @@ func (self *ListController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 }
 
 func (self *ListController) GetMouseKeybindings(opts types.KeybindingsOpts) []*gocui.ViewMouseBinding {
-	return []*gocui.ViewMouseBinding{
+	bindings := []*gocui.ViewMouseBinding{
 		{
 			ViewName: self.context.GetViewName(),
 			Key:      gocui.MouseWheelUp,
@@ -241,4 +294,31 @@ func (self *ListController) GetMouseKeybindings(opts types.KeybindingsOpts) []*g
 			Handler:  func(gocui.ViewMouseBindingOpts) error { return self.HandleScrollDown() },
 		},
 	}
+
+	mouseGestures := self.mouseGestures()
+	gestureActions := map[gocui.Key]func() error{}
+	if mouseGestures.RightClick != "" {
+		gestureActions[gocui.MouseRight] = func() error { return self.runMouseGesture(mouseGestures.RightClick) }
+	} else if lo.Contains(contextMenuContextKeys, self.context.GetKey()) {
+		gestureActions[gocui.MouseRight] = (&OptionsMenuAction{c: self.c}).CallForContextMenu
+	}
+	if mouseGestures.MiddleClick != "" {
+		gestureActions[gocui.MouseMiddle] = func() error { return self.runMouseGesture(mouseGestures.MiddleClick) }
+	}
+
+	for _, key := range []gocui.Key{gocui.MouseRight, gocui.MouseMiddle} {
+		action, ok := gestureActions[key]
+		if !ok {
+			continue
+		}
+		bindings = append(bindings, &gocui.ViewMouseBinding{
+			ViewName: self.context.GetViewName(),
+			Key:      key,
+			Handler: func(opts gocui.ViewMouseBindingOpts) error {
+				return self.handleMouseGesture(opts, action)
+			},
+		})
+	}
+
+	return bindings
 }
@@ -1,6 +1,8 @@
 package controllers
 
 import (
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/go-errors/errors"
@@ -31,6 +33,10 @@ type LocalCommitsController struct {
 	c *ControllerCommon
 
 	pullFiles PullFilesFn
+
+	// Set once we've shown an error while dragging commits with the mouse, so
+	// that we don't show it again for every row the mouse moves over
+	dragErrorShown bool
 }
 
 var _ types.IController = &LocalCommitsController{}
@@ -136,6 +142,13 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 				"editKey": keybindings.Label(editCommitKey),
 			}),
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.ToggleBreak),
+			Handler:           self.withItem(self.toggleBreak),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canToggleBreak)),
+			Description:       self.c.Tr.ToggleBreak,
+			Tooltip:           self.c.Tr.ToggleBreakTooltip,
+		},
 		{
 			Key:     opts.GetKey(opts.Config.Commits.PickCommit),
 			Handler: opts.Guards.OutsideFilterMode(self.withItems(self.pick)),
@@ -175,6 +188,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 				self.canMoveDown,
 			)),
 			Description: self.c.Tr.MoveDownCommit,
+			Repeatable:  true,
 		},
 		{
 			Key:     opts.GetKey(opts.Config.Commits.MoveUpCommit),
@@ -184,6 +198,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 				self.canMoveUp,
 			)),
 			Description: self.c.Tr.MoveUpCommit,
+			Repeatable:  true,
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.PasteCommits),
@@ -244,11 +259,56 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			Tooltip:     self.c.Tr.OpenLogMenuTooltip,
 			OpensMenu:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.OpenCommitFilters),
+			Handler:     self.handleOpenCommitFilters,
+			Description: self.c.Tr.CommitFilters,
+			Tooltip:     self.c.Tr.CommitFiltersTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.SearchHistoryForCode),
+			Handler:     self.handleSearchHistoryForCode,
+			Description: self.c.Tr.SearchHistoryForCode,
+			Tooltip:     self.c.Tr.SearchHistoryForCodeTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Commits.GoToCommit),
+			Handler:     self.handleGoToCommit,
+			Description: self.c.Tr.GoToCommit,
+			Tooltip:     self.c.Tr.GoToCommitTooltip,
+		},
 	}
 
 	return bindings
 }
 
+func (self *LocalCommitsController) GetMouseKeybindings(opts types.KeybindingsOpts) []*gocui.ViewMouseBinding {
+	return []*gocui.ViewMouseBinding{
+		{
+			ViewName: self.context().GetViewName(),
+			Key:      gocui.MouseLeft,
+			Modifier: gocui.ModMotion,
+			Handler: func(opts gocui.ViewMouseBindingOpts) error {
+				err := self.handleDrag(opts)
+				self.context().FocusLine()
+				return err
+			},
+		},
+		{
+			ViewName: self.context().GetViewName(),
+			Key:      gocui.MouseRelease,
+			Handler: func(gocui.ViewMouseBindingOpts) error {
+				self.dragErrorShown = false
+				// gocui moves the view's cursor to wherever the mouse is
+				self.context().FocusLine()
+				return nil
+			},
+		},
+	}
+}
+
 func (self *LocalCommitsController) GetOnRenderToMain() func() {
 	return func() {
 		self.c.Helpers().Diff.WithDiffModeCheck(func() {
@@ -266,9 +326,11 @@ func (self *LocalCommitsController) GetOnRenderToMain() func() {
 			} else if commit.Action == todo.Exec {
 				task = types.NewRenderStringTask(
 					self.c.Tr.ExecCommandHere + "\n\n" + commit.Name)
+			} else if commit.Action == todo.Break {
+				task = types.NewRenderStringTask(self.c.Tr.BreakHere)
 			} else {
 				refRange := self.context().GetSelectedRefRangeForDiffFiles()
-				task = self.c.Helpers().Diff.GetUpdateTaskForRenderingCommitsDiff(commit, refRange)
+				task = self.c.Helpers().Diff.GetUpdateTaskForRenderingCommitsDiff(commit, refRange, git_commands.Pickaxe{})
 			}
 
 			self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -437,6 +499,10 @@ func (self *LocalCommitsController) rewordEditor(commit *models.Commit) error {
 }
 
 func (self *LocalCommitsController) drop(selectedCommits []*models.Commit, startIdx int, endIdx int) error {
+	if markedCommits, markedIndices := self.c.Helpers().MarkedCommits.MarkedCommits(self.context(), self.c.Model().Commits); len(markedCommits) > 0 {
+		return self.dropMarked(markedCommits, markedIndices)
+	}
+
 	if self.isRebasing() {
 		groupedTodos := lo.GroupBy(selectedCommits, func(c *models.Commit) bool {
 			return c.Action == todo.UpdateRef
@@ -492,6 +558,39 @@ func (self *LocalCommitsController) drop(selectedCommits []*models.Commit, start
 	return nil
 }
 
+func (self *LocalCommitsController) dropMarked(markedCommits []*models.Commit, markedIndices []int) error {
+	if self.isRebasing() {
+		if err := self.updateTodos(todo.Drop, markedCommits); err != nil {
+			return err
+		}
+		return self.c.Helpers().MarkedCommits.Reset()
+	}
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.DropCommitTitle,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.DropMarkedCommitsPrompt,
+			map[string]string{"count": strconv.Itoa(len(markedCommits))}),
+		HandleConfirm: func() error {
+			// Keep the selected commit selected; the dropped commits above it
+			// will disappear from the list
+			selectedIdx := self.context().GetSelectedLineIdx()
+			self.context().SetSelection(selectedIdx - lo.CountBy(markedIndices, func(idx int) bool { return idx < selectedIdx }))
+
+			if err := self.c.Helpers().MarkedCommits.Reset(); err != nil {
+				return err
+			}
+
+			return self.c.WithWaitingStatus(self.c.Tr.DroppingStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.DropCommit)
+				err := self.c.Git().Rebase.InteractiveRebaseCommits(self.c.Model().Commits, markedIndices, todo.Drop)
+				return self.c.Helpers().MergeAndRebase.CheckMergeOrRebase(err)
+			})
+		},
+	})
+
+	return nil
+}
+
 func (self *LocalCommitsController) dropMergeCommit(commitIdx int) error {
 	err := self.c.Git().Rebase.DropMergeCommit(self.c.Model().Commits, commitIdx)
 	return self.c.Helpers().MergeAndRebase.CheckMergeOrRebase(err)
@@ -533,7 +632,7 @@ func (self *LocalCommitsController) startInteractiveRebaseWithEdit(
 	return self.c.WithWaitingStatus(self.c.Tr.RebasingStatus, func(gocui.Task) error {
 		self.c.LogAction(self.c.Tr.Actions.EditCommit)
 		selectionRangeAndMode := self.getSelectionRangeAndMode()
-		err := self.c.Git().Rebase.EditRebase(commitsToEdit[len(commitsToEdit)-1].Hash())
+		err := self.c.Git().Rebase.EditRebase(commitsToEdit[len(commitsToEdit)-1].Hash(), git_commands.RebaseOpts{})
 		return self.c.Helpers().MergeAndRebase.CheckMergeOrRebaseWithRefreshOptions(
 			err,
 			types.RefreshOptions{Mode: types.BLOCK_UI, Then: func() {
@@ -637,6 +736,75 @@ func (self *LocalCommitsController) updateTodos(action todo.TodoCommand, selecte
 	return nil
 }
 
+// The todo that a break for the given commit goes after. For a break todo,
+// that's the todo below it, so that toggling removes the break again; nil
+// means the beginning of the todo list.
+func (self *LocalCommitsController) todoBeforeBreak(commit *models.Commit) (*models.Commit, bool) {
+	if commit.Action != todo.Break {
+		return commit, true
+	}
+
+	commits := self.c.Model().Commits
+	idx := self.context().GetSelectedLineIdx()
+	if idx+1 >= len(commits) || !commits[idx+1].IsTODO() || commits[idx+1].Status == models.StatusConflicted {
+		return nil, true
+	}
+
+	previous := commits[idx+1]
+	// Exec and break todos have no hash, so we can't tell them apart
+	if previous.Action == todo.Exec || previous.Action == todo.Break {
+		return nil, false
+	}
+	return previous, true
+}
+
+func (self *LocalCommitsController) canToggleBreak(commit *models.Commit) *types.DisabledReason {
+	if !self.c.Model().WorkingTreeStateAtLastCommitRefresh.Rebasing {
+		return &types.DisabledReason{Text: self.c.Tr.BreakIsOnlyAllowedDuringRebase}
+	}
+
+	if !commit.IsTODO() || commit.Status == models.StatusConflicted {
+		return &types.DisabledReason{Text: self.c.Tr.MustSelectTodoCommits}
+	}
+
+	if commit.Action == todo.Exec {
+		return &types.DisabledReason{Text: self.c.Tr.ChangingThisActionIsNotAllowed}
+	}
+
+	if _, ok := self.todoBeforeBreak(commit); !ok {
+		return &types.DisabledReason{Text: self.c.Tr.ChangingThisActionIsNotAllowed}
+	}
+
+	return nil
+}
+
+func (self *LocalCommitsController) toggleBreak(commit *models.Commit) error {
+	todoCommit, _ := self.todoBeforeBreak(commit)
+
+	self.c.LogAction(self.c.Tr.Actions.ToggleBreak)
+	if err := self.c.Git().Rebase.ToggleBreakAfter(todoCommit); err != nil {
+		return err
+	}
+
+	// The break is shown directly above the commit it follows, so keep that
+	// commit selected
+	if commit.Action != todo.Break {
+		idx := self.context().GetSelectedLineIdx()
+		commits := self.c.Model().Commits
+		if idx > 0 && commits[idx-1].Action == todo.Break {
+			self.context().MoveSelection(-1)
+		} else {
+			self.context().MoveSelection(1)
+		}
+	}
+
+	self.c.Refresh(types.RefreshOptions{
+		Mode: types.SYNC, Scope: []types.RefreshableView{types.REBASE_COMMITS},
+	})
+
+	return nil
+}
+
 func (self *LocalCommitsController) rewordEnabled(commit *models.Commit) *types.DisabledReason {
 	// for now we do not support setting 'reword' on TODO commits because it requires an editor
 	// and that means we either unconditionally wait around for the subprocess to ask for
@@ -712,6 +880,106 @@ func (self *LocalCommitsController) moveUp(selectedCommits []*models.Commit, sta
 	})
 }
 
+// Moves the selected commits to the row that the mouse was dragged to, one
+// step at a time. Outside of a rebase we start an interactive rebase first so
+// that the moves only need to touch the todo file; the user can continue the
+// rebase when they're done rearranging.
+func (self *LocalCommitsController) handleDrag(opts gocui.ViewMouseBindingOpts) error {
+	if self.c.Modes().Filtering.Active() || self.context().GetList().Len() == 0 {
+		return nil
+	}
+
+	if self.dragTargetIdx(opts.Y) == self.context().GetSelectedLineIdx() {
+		return nil
+	}
+
+	if !self.isRebasing() {
+		if err := self.startInteractiveRebaseForDrag(); err != nil {
+			self.showDragError(err.Error())
+			return nil
+		}
+	}
+
+	// Computed again because starting the rebase may have added update-ref
+	// todos above the dragged commits
+	targetIdx := self.dragTargetIdx(opts.Y)
+	for {
+		selectedIdx := self.context().GetSelectedLineIdx()
+		if selectedIdx == targetIdx {
+			return nil
+		}
+
+		selectedCommits, startIdx, endIdx := self.context().GetSelectedItems()
+		moveUp := targetIdx < selectedIdx
+		disabledReason := self.midRebaseMoveCommandEnabled(selectedCommits, startIdx, endIdx)
+		if disabledReason == nil {
+			if moveUp {
+				disabledReason = self.canMoveUp(selectedCommits, startIdx, endIdx)
+			} else {
+				disabledReason = self.canMoveDown(selectedCommits, startIdx, endIdx)
+			}
+		}
+		if disabledReason != nil {
+			self.showDragError(disabledReason.Text)
+			return nil
+		}
+
+		var err error
+		if moveUp {
+			err = self.moveUp(selectedCommits, startIdx, endIdx)
+		} else {
+			err = self.moveDown(selectedCommits, startIdx, endIdx)
+		}
+		if err != nil {
+			return err
+		}
+
+		if self.context().GetSelectedLineIdx() == selectedIdx {
+			return nil
+		}
+	}
+}
+
+func (self *LocalCommitsController) dragTargetIdx(y int) int {
+	return lo.Clamp(self.context().ViewIndexToModelIndex(y), 0, self.context().GetList().Len()-1)
+}
+
+func (self *LocalCommitsController) showDragError(message string) {
+	if !self.dragErrorShown {
+		self.dragErrorShown = true
+		self.c.ErrorToast(message)
+	}
+}
+
+func (self *LocalCommitsController) startInteractiveRebaseForDrag() error {
+	selectedCommits, startIdx, endIdx := self.context().GetSelectedItems()
+	if disabledReason := self.midRebaseMoveCommandEnabled(selectedCommits, startIdx, endIdx); disabledReason != nil {
+		return errors.New(disabledReason.Text)
+	}
+
+	baseCommit, err := self.findCommitForQuickStartInteractiveRebase()
+	if err != nil {
+		return err
+	}
+	if endIdx >= lo.IndexOf(self.c.Model().Commits, baseCommit) {
+		return errors.New(self.c.Tr.CannotDragCommitsOutsideBranch)
+	}
+
+	selectionRangeAndMode := self.getSelectionRangeAndMode()
+	err = self.c.WithWaitingStatusSync(self.c.Tr.RebasingStatus, func() error {
+		self.c.LogAction(self.c.Tr.Actions.StartInteractiveRebase)
+		err := self.c.Git().Rebase.EditRebase(baseCommit.Hash(), git_commands.RebaseOpts{})
+		return self.c.Helpers().MergeAndRebase.CheckMergeOrRebaseWithRefreshOptions(
+			err, types.RefreshOptions{Mode: types.SYNC})
+	})
+	if err != nil {
+		return err
+	}
+
+	self.restoreSelectionRangeAndMode(selectionRangeAndMode)
+	return nil
+}
+
 func (self *LocalCommitsController) amendTo(commit *models.Commit) error {
 	var handleCommit func() error
 
@@ -775,12 +1043,35 @@ func (self *LocalCommitsController) amendAttribute(commits []*models.Commit, sta
 				Key:     opts.GetKey(opts.Config.AmendAttribute.SetAuthor),
 				Tooltip: self.c.Tr.SetAuthorTooltip,
 			},
+			{
+				Label:   self.c.Tr.ResetAuthorDate,
+				OnPress: func() error { return self.resetAuthorDate(start, end) },
+				Key:     opts.GetKey(opts.Config.AmendAttribute.ResetAuthorDate),
+				Tooltip: self.c.Tr.ResetAuthorDateTooltip,
+			},
 			{
 				Label:   self.c.Tr.AddCoAuthor,
 				OnPress: func() error { return self.addCoAuthor(start, end) },
 				Key:     opts.GetKey(opts.Config.AmendAttribute.AddCoAuthor),
 				Tooltip: self.c.Tr.AddCoAuthorTooltip,
 			},
+			{
+				Label: self.c.Tr.SelectAuthor,
+				OnPress: func() error {
+					return self.chooseAuthor(func(value string) error { return self.setAuthorTo(start, end, value) })
+				},
+				Key:       opts.GetKey(opts.Config.AmendAttribute.SelectAuthor),
+				Tooltip:   self.c.Tr.SelectAuthorTooltip,
+				OpensMenu: true,
+			},
+			{
+				Label:          self.c.Tr.BulkSetAuthor,
+				OnPress:        func() error { return self.bulkSetAuthor(start, end) },
+				Key:            opts.GetKey(opts.Config.AmendAttribute.BulkSetAuthor),
+				Tooltip:        self.c.Tr.BulkSetAuthorTooltip,
+				DisabledReason: self.canBulkSetAuthor(),
+				OpensMenu:      true,
+			},
 		},
 	})
 }
@@ -797,26 +1088,115 @@ func (self *LocalCommitsController) resetAuthor(start, end int) error {
 	})
 }
 
+func (self *LocalCommitsController) resetAuthorDate(start, end int) error {
+	return self.c.WithWaitingStatus(self.c.Tr.AmendingStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.ResetCommitAuthorDate)
+		if err := self.c.Git().Rebase.ResetCommitAuthorDate(self.c.Model().Commits, start, end); err != nil {
+			return err
+		}
+
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+		return nil
+	})
+}
+
 func (self *LocalCommitsController) setAuthor(start, end int) error {
 	self.c.Prompt(types.PromptOpts{
 		Title:               self.c.Tr.SetAuthorPromptTitle,
 		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetAuthorsSuggestionsFunc(),
 		HandleConfirm: func(value string) error {
-			return self.c.WithWaitingStatus(self.c.Tr.AmendingStatus, func(gocui.Task) error {
-				self.c.LogAction(self.c.Tr.Actions.SetCommitAuthor)
-				if err := self.c.Git().Rebase.SetCommitAuthor(self.c.Model().Commits, start, end, value); err != nil {
-					return err
-				}
+			return self.setAuthorTo(start, end, value)
+		},
+	})
 
-				self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	return nil
+}
+
+func (self *LocalCommitsController) setAuthorTo(start, end int, value string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.AmendingStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.SetCommitAuthor)
+		if err := self.c.Git().Rebase.SetCommitAuthor(self.c.Model().Commits, start, end, value); err != nil {
+			return err
+		}
+
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+		return nil
+	})
+}
+
+// Lets the user pick one of the authors of the loaded commits, or enter a new
+// one via a prompt
+func (self *LocalCommitsController) chooseAuthor(onChoose func(value string) error) error {
+	authors := lo.Map(lo.Values(self.c.Model().Authors), func(author *models.Author, _ int) string {
+		return author.Combined()
+	})
+	slices.Sort(authors)
+
+	menuItems := []*types.MenuItem{
+		{
+			Label: self.c.Tr.EnterNewAuthor,
+			OnPress: func() error {
+				self.c.Prompt(types.PromptOpts{
+					Title:               self.c.Tr.SetAuthorPromptTitle,
+					FindSuggestionsFunc: self.c.Helpers().Suggestions.GetAuthorsSuggestionsFunc(),
+					HandleConfirm:       onChoose,
+				})
 				return nil
-			})
+			},
+			Key: 'n',
 		},
+	}
+	for _, author := range authors {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:   author,
+			OnPress: func() error { return onChoose(author) },
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.SelectAuthor,
+		Items: menuItems,
 	})
+}
+
+func (self *LocalCommitsController) canBulkSetAuthor() *types.DisabledReason {
+	if self.isRebasing() {
+		return &types.DisabledReason{Text: self.c.Tr.AlreadyRebasing}
+	}
 
 	return nil
 }
 
+func (self *LocalCommitsController) bulkSetAuthor(start, end int) error {
+	return self.chooseAuthor(func(value string) error {
+		prompt := utils.ResolvePlaceholderString(self.c.Tr.BulkSetAuthorPrompt, map[string]string{
+			"count":  strconv.Itoa(end - start + 1),
+			"author": value,
+		})
+		// All commits above the range are rewritten too, so they count when
+		// deciding whether a force-push will be needed
+		if lo.SomeBy(self.c.Model().Commits[:end+1], func(commit *models.Commit) bool {
+			return commit.Status == models.StatusPushed
+		}) {
+			prompt = style.FgRed.Sprint(self.c.Tr.BulkSetAuthorPushedWarning) + "\n\n" + prompt
+		}
+
+		self.c.Confirm(types.ConfirmOpts{
+			Title:  self.c.Tr.BulkSetAuthorTitle,
+			Prompt: prompt,
+			HandleConfirm: func() error {
+				return self.c.WithWaitingStatus(self.c.Tr.AmendingStatus, func(gocui.Task) error {
+					self.c.LogAction(self.c.Tr.Actions.BulkSetCommitAuthor)
+					err := self.c.Git().Rebase.SetCommitAuthorWithExec(self.c.Model().Commits, start, end, value)
+					return self.c.Helpers().MergeAndRebase.CheckMergeOrRebase(err)
+				})
+			},
+		})
+
+		return nil
+	})
+}
+
 func (self *LocalCommitsController) addCoAuthor(start, end int) error {
 	self.c.Prompt(types.PromptOpts{
 		Title:               self.c.Tr.AddCoAuthorPromptTitle,
@@ -890,7 +1270,7 @@ func (self *LocalCommitsController) revert(commits []*models.Commit, start, end
 
 func (self *LocalCommitsController) createFixupCommit(commit *models.Commit) error {
 	var disabledReasonWhenFilesAreNeeded *types.DisabledReason
-	if len(self.c.Model().Files) == 0 {
+	if !helpers.AnyFilesWithChanges(self.c.Model().Files) {
 		disabledReasonWhenFilesAreNeeded = &types.DisabledReason{
 			Text:             self.c.Tr.NoFilesStagedTitle,
 			ShowErrorInPanel: true,
@@ -1150,6 +1530,18 @@ func (self *LocalCommitsController) openSearch() error {
 	return self.c.Helpers().Search.OpenSearchPrompt(self.context())
 }
 
+func (self *LocalCommitsController) handleOpenCommitFilters() error {
+	return (&CommitFiltersMenuAction{c: self.c}).Call()
+}
+
+func (self *LocalCommitsController) handleSearchHistoryForCode() error {
+	return (&PickaxeSearchMenuAction{c: self.c}).Call()
+}
+
+func (self *LocalCommitsController) handleGoToCommit() error {
+	return (&GoToCommitAction{c: self.c}).Call()
+}
+
 func (self *LocalCommitsController) handleOpenLogMenu() error {
 	return self.c.Menu(types.CreateMenuOptions{
 		Title: self.c.Tr.LogMenuTitle,
@@ -1431,6 +1823,12 @@ func (self *LocalCommitsController) canDropCommits(selectedCommits []*models.Com
 		return &types.DisabledReason{Text: self.c.Tr.NotAllowedMidCherryPickOrRevert}
 	}
 
+	// When there are marked commits, it is those that get dropped rather than
+	// the selection
+	if markedCommits, _ := self.c.Helpers().MarkedCommits.MarkedCommits(self.context(), self.c.Model().Commits); len(markedCommits) > 0 {
+		selectedCommits = markedCommits
+	}
+
 	if !self.isRebasing() {
 		if len(selectedCommits) > 1 && lo.SomeBy(selectedCommits, func(c *models.Commit) bool { return c.IsMerge() }) {
 			return &types.DisabledReason{Text: self.c.Tr.DroppingMergeRequiresSingleSelection}
@@ -1,7 +1,10 @@
 package controllers
 
 import (
+	"errors"
+
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/patch"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -51,6 +54,12 @@ func (self *MainViewController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			Description: self.c.Tr.StartSearch,
 			Tag:         "navigation",
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Universal.Edit),
+			Handler:     self.editFileAtLine,
+			Description: self.c.Tr.EditFileAtLine,
+			Tooltip:     self.c.Tr.EditFileAtLineTooltip,
+		},
 	}
 }
 
@@ -105,6 +114,22 @@ func (self *MainViewController) onClickInOtherViewOfMainViewPair(opts gocui.View
 	return nil
 }
 
+func (self *MainViewController) editFileAtLine() error {
+	view := self.context.GetView()
+	lineIdx, ok := view.BufferLineIdx(0)
+	if !ok {
+		lineIdx = 0
+	}
+
+	path, lineNumber, ok := patch.LocationOfDiffLine(view.BufferLines(), lineIdx)
+	if !ok {
+		return errors.New(self.c.Tr.NoDiffLineToEdit)
+	}
+
+	lineNumber = self.c.Helpers().Diff.AdjustLineNumber(path, lineNumber, view.Name())
+	return self.c.Helpers().Files.EditFileAtLine(path, lineNumber)
+}
+
 func (self *MainViewController) openSearch() error {
 	if manager := self.c.GetViewBufferManagerForView(self.context.GetView()); manager != nil {
 		manager.ReadToEnd(func() {
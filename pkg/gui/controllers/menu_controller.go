@@ -64,6 +64,16 @@ func (self *MenuController) GetOnFocus() func(types.OnFocusOpts) {
 		selectedMenuItem := self.context().GetSelected()
 		if selectedMenuItem != nil {
 			self.c.Views().Tooltip.SetContent(self.c.Helpers().Confirmation.TooltipForMenuItem(selectedMenuItem))
+			self.context().OnSelectionChange(selectedMenuItem)
+		}
+	}
+}
+
+func (self *MenuController) GetOnFocusLost() func(types.OnFocusLostOpts) {
+	return func(opts types.OnFocusLostOpts) {
+		// Filtering the menu doesn't close it
+		if opts.NewContextKey != context.SEARCH_CONTEXT_KEY {
+			self.context().OnClose()
 		}
 	}
 }
@@ -118,6 +118,12 @@ func (self *MergeConflictsController) GetKeybindings(opts types.KeybindingsOpts)
 			Tooltip:         self.c.Tr.OpenMergeToolTooltip,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.OpenMergeToolForFile),
+			Handler:     self.openMergeToolForFile,
+			Description: self.c.Tr.OpenMergeToolForFile,
+			Tooltip:     self.c.Tr.OpenMergeToolForFileTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.Return),
 			Handler:     self.Escape,
@@ -179,6 +185,10 @@ func (self *MergeConflictsController) HandleScrollDown() error {
 	return nil
 }
 
+func (self *MergeConflictsController) openMergeToolForFile() error {
+	return self.c.Helpers().WorkingTree.OpenMergeToolForFile(self.context().GetState().GetPath())
+}
+
 func (self *MergeConflictsController) Context() types.Context {
 	return self.context()
 }
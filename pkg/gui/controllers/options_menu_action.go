@@ -17,28 +17,7 @@ func (self *OptionsMenuAction) Call() error {
 	menuItems := []*types.MenuItem{}
 
 	appendBindings := func(bindings []*types.Binding, section *types.MenuSection) {
-		menuItems = append(menuItems,
-			lo.Map(bindings, func(binding *types.Binding, _ int) *types.MenuItem {
-				var disabledReason *types.DisabledReason
-				if binding.GetDisabledReason != nil {
-					disabledReason = binding.GetDisabledReason()
-				}
-				return &types.MenuItem{
-					OpensMenu: binding.OpensMenu,
-					Label:     binding.GetDescription(),
-					OnPress: func() error {
-						if binding.Handler == nil {
-							return nil
-						}
-
-						return self.c.IGuiCommon.CallKeybindingHandler(binding)
-					},
-					Key:            binding.Key,
-					Tooltip:        binding.Tooltip,
-					DisabledReason: disabledReason,
-					Section:        section,
-				}
-			})...)
+		menuItems = append(menuItems, self.menuItems(bindings, section)...)
 	}
 
 	appendBindings(local, &types.MenuSection{Title: self.c.Tr.KeybindingsMenuSectionLocal, Column: 1})
@@ -54,6 +33,43 @@ func (self *OptionsMenuAction) Call() error {
 	})
 }
 
+// Shows only the actions of the focused panel, for right-clicking one of its
+// items
+func (self *OptionsMenuAction) CallForContextMenu() error {
+	local, _, _ := self.getBindings(self.c.Context().Current())
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title:                     self.c.Tr.ContextMenuTitle,
+		Items:                     self.menuItems(local, nil),
+		ColumnAlignment:           []utils.Alignment{utils.AlignRight, utils.AlignLeft},
+		AllowFilteringKeybindings: true,
+	})
+}
+
+func (self *OptionsMenuAction) menuItems(bindings []*types.Binding, section *types.MenuSection) []*types.MenuItem {
+	return lo.Map(bindings, func(binding *types.Binding, _ int) *types.MenuItem {
+		var disabledReason *types.DisabledReason
+		if binding.GetDisabledReason != nil {
+			disabledReason = binding.GetDisabledReason()
+		}
+		return &types.MenuItem{
+			OpensMenu: binding.OpensMenu,
+			Label:     binding.GetDescription(),
+			OnPress: func() error {
+				if binding.Handler == nil {
+					return nil
+				}
+
+				return self.c.IGuiCommon.CallKeybindingHandler(binding)
+			},
+			Key:            binding.Key,
+			Tooltip:        binding.Tooltip,
+			DisabledReason: disabledReason,
+			Section:        section,
+		}
+	})
+}
+
 // Returns three slices of bindings: local, global, and navigation
 func (self *OptionsMenuAction) getBindings(context types.Context) ([]*types.Binding, []*types.Binding, []*types.Binding) {
 	var bindingsGlobal, bindingsPanel, bindingsNavigation []*types.Binding
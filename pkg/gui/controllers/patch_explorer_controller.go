@@ -1,6 +1,8 @@
 package controllers
 
 import (
+	"errors"
+	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
@@ -107,6 +109,12 @@ func (self *PatchExplorerController) GetKeybindings(opts types.KeybindingsOpts)
 			Tooltip:         self.c.Tr.ToggleSelectHunkTooltip,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Main.GoToLine),
+			Handler:     self.withLock(self.HandleGoToLine),
+			Description: self.c.Tr.GoToLine,
+			Tooltip:     self.c.Tr.GoToLineTooltip,
+		},
 		{
 			Tag:         "navigation",
 			Key:         opts.GetKey(opts.Config.Universal.PrevPage),
@@ -288,6 +296,25 @@ func (self *PatchExplorerController) HandleGotoBottom() error {
 	return nil
 }
 
+func (self *PatchExplorerController) HandleGoToLine() error {
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.GoToLinePromptTitle,
+		HandleConfirm: func(value string) error {
+			lineNumber, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || lineNumber < 1 {
+				return errors.New(self.c.Tr.InvalidLineNumber)
+			}
+
+			return self.withRenderAndFocus(func() error {
+				self.context.GetState().SelectNewLineNumber(lineNumber)
+				return nil
+			})()
+		},
+	})
+
+	return nil
+}
+
 func (self *PatchExplorerController) HandleMouseDown() error {
 	self.context.GetState().SelectNewLineForRange(self.context.GetViewTrait().SelectedLineIdx())
 
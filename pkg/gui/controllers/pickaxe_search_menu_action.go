@@ -0,0 +1,56 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// Searches the history of the checked-out branch for commits that added or
+// removed some code, and shows them in the sub-commits view.
+type PickaxeSearchMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *PickaxeSearchMenuAction) Call() error {
+	promptItem := func(label string, title string, regex bool, key types.Key) *types.MenuItem {
+		return &types.MenuItem{
+			Label: label,
+			OnPress: func() error {
+				self.c.Prompt(types.PromptOpts{
+					Title: title,
+					HandleConfirm: func(response string) error {
+						if response == "" {
+							return nil
+						}
+						return self.search(git_commands.Pickaxe{Text: response, Regex: regex})
+					},
+				})
+				return nil
+			},
+			Key: key,
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.SearchHistoryForCode,
+		Items: []*types.MenuItem{
+			promptItem(self.c.Tr.PickaxeString, self.c.Tr.EnterPickaxeString, false, 's'),
+			promptItem(self.c.Tr.PickaxeRegex, self.c.Tr.EnterPickaxeRegex, true, 'g'),
+		},
+	})
+}
+
+func (self *PickaxeSearchMenuAction) search(pickaxe git_commands.Pickaxe) error {
+	branch := self.c.Helpers().Refs.GetCheckedOutRef()
+	if branch == nil {
+		return nil
+	}
+
+	return self.c.Helpers().SubCommits.ViewSubCommits(helpers.ViewSubCommitsOpts{
+		Ref:      branch,
+		TitleRef: pickaxe.Arg(),
+		Context:  self.c.Contexts().LocalCommits,
+		Pickaxe:  pickaxe,
+	})
+}
@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+// Switches between the profiles defined in the 'profiles' config. The choice
+// is kept in the app state, so it also applies the next time lazygit starts.
+type ProfilesMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *ProfilesMenuAction) Call() error {
+	profiles := self.c.UserConfig().Profiles
+	activeProfile := self.c.GetAppState().ActiveProfile
+
+	names := lo.Keys(profiles)
+	slices.Sort(names)
+
+	noProfileItem := &types.MenuItem{
+		Label:   self.c.Tr.NoProfile,
+		OnPress: func() error { return self.switchTo("") },
+		Widget:  types.MakeMenuRadioButton(activeProfile == ""),
+	}
+	menuItems := append([]*types.MenuItem{noProfileItem}, lo.Map(names, func(name string, _ int) *types.MenuItem {
+		// Show what the profile changes
+		content, _ := yaml.Marshal(profiles[name])
+		return &types.MenuItem{
+			Label:   name,
+			OnPress: func() error { return self.switchTo(name) },
+			Widget:  types.MakeMenuRadioButton(activeProfile == name),
+			Tooltip: string(content),
+		}
+	})...)
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.SwitchProfileTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *ProfilesMenuAction) switchTo(name string) error {
+	self.c.LogAction(self.c.Tr.Actions.SwitchProfile)
+	oldConfig := self.c.UserConfig()
+	if err := self.c.GetConfig().SetActiveProfile(name); err != nil {
+		return err
+	}
+	if err := self.c.ApplyChangedUserConfig(oldConfig); err != nil {
+		return err
+	}
+
+	if name == "" {
+		self.c.Toast(self.c.Tr.SwitchedToNoProfile)
+	} else {
+		self.c.Toast(fmt.Sprintf(self.c.Tr.SwitchedToProfile, name))
+	}
+	return nil
+}
@@ -1,9 +1,12 @@
 package controllers
 
 import (
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type ReflogCommitsController struct {
@@ -29,6 +32,27 @@ func NewReflogCommitsController(
 	}
 }
 
+func (self *ReflogCommitsController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:         opts.GetKey(opts.Config.Reflog.SelectRef),
+			Handler:     self.openRefMenu,
+			Description: self.c.Tr.ViewReflogOfRef,
+			Tooltip:     self.c.Tr.ViewReflogOfRefTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Reflog.ViewDiffTarget),
+			Handler:     self.openDiffTargetMenu,
+			Description: self.c.Tr.ReflogDiffTarget,
+			Tooltip:     self.c.Tr.ReflogDiffTargetTooltip,
+			OpensMenu:   true,
+		},
+	}
+
+	return bindings
+}
+
 func (self *ReflogCommitsController) Context() types.Context {
 	return self.context()
 }
@@ -44,10 +68,10 @@ func (self *ReflogCommitsController) GetOnRenderToMain() func() {
 			var task types.UpdateTask
 			if commit == nil {
 				task = types.NewRenderStringTask("No reflog history")
+			} else if self.context().GetDiffTarget() != context.ReflogDiffTargetParent {
+				task = self.diffAgainstCurrentStateTask(commit)
 			} else {
-				cmdObj := self.c.Git().Commit.ShowCmdObj(commit.Hash(), self.c.Helpers().Diff.FilterPathsForCommit(commit))
-
-				task = types.NewRunPtyTask(cmdObj.GetCmd())
+				task = self.c.Helpers().Diff.GetUpdateTaskForRenderingCommitsDiff(commit, nil, git_commands.Pickaxe{})
 			}
 
 			self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -60,3 +84,103 @@ func (self *ReflogCommitsController) GetOnRenderToMain() func() {
 		})
 	}
 }
+
+func (self *ReflogCommitsController) openRefMenu() error {
+	refItem := func(label string, ref string, section *types.MenuSection) *types.MenuItem {
+		return &types.MenuItem{
+			Label:   label,
+			Section: section,
+			OnPress: func() error {
+				return self.showReflogOf(ref, label)
+			},
+		}
+	}
+
+	menuItems := []*types.MenuItem{refItem("HEAD", "", nil)}
+	if len(self.c.Model().StashEntries) > 0 {
+		menuItems = append(menuItems, refItem("stash", "refs/stash", nil))
+	}
+
+	localSection := &types.MenuSection{Title: self.c.Tr.LocalBranchesTitle}
+	for _, branch := range self.c.Model().Branches {
+		if branch.DetachedHead {
+			continue
+		}
+		menuItems = append(menuItems, refItem(branch.Name, branch.FullRefName(), localSection))
+	}
+
+	remoteSection := &types.MenuSection{Title: self.c.Tr.RemoteBranchesTitle}
+	for _, remote := range self.c.Model().Remotes {
+		for _, branch := range remote.Branches {
+			menuItems = append(menuItems, refItem(branch.FullName(), branch.FullRefName(), remoteSection))
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ViewReflogOfRef,
+		Items: menuItems,
+	})
+}
+
+func (self *ReflogCommitsController) showReflogOf(ref string, label string) error {
+	self.context().SetRef(ref)
+	if ref == "" {
+		self.context().GetView().Subtitle = ""
+	} else {
+		self.context().GetView().Subtitle = label
+	}
+	self.context().SetSelection(0)
+
+	self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.REFLOG}})
+	return nil
+}
+
+// Shows what going back to the given reflog entry would change, compared to
+// HEAD or to the working tree
+func (self *ReflogCommitsController) diffAgainstCurrentStateTask(commit *models.Commit) types.UpdateTask {
+	var args []string
+	var prefixTemplate string
+	if self.context().GetDiffTarget() == context.ReflogDiffTargetHead {
+		args = []string{"HEAD", commit.Hash()}
+		prefixTemplate = self.c.Tr.ShowingReflogDiffAgainstHead
+	} else {
+		// Without a second commit, git diffs against the working tree; -R
+		// makes it show the changes going from the working tree to the entry
+		args = []string{"-R", commit.Hash()}
+		prefixTemplate = self.c.Tr.ShowingReflogDiffAgainstWorkingTree
+	}
+
+	args = append(args, "--stat", "-p", "--")
+	if filterPath := self.c.Modes().Filtering.GetPath(); filterPath != "" {
+		args = append(args, filterPath)
+	}
+
+	cmdObj := self.c.Git().Diff.DiffCmdObj(args)
+	prefix := style.FgYellow.Sprintf("%s\n\n", utils.ResolvePlaceholderString(prefixTemplate,
+		map[string]string{"ref": commit.ShortHash()}))
+	return types.NewRunPtyTaskWithPrefix(cmdObj.GetCmd(), prefix)
+}
+
+func (self *ReflogCommitsController) openDiffTargetMenu() error {
+	targetItem := func(label string, target context.ReflogDiffTarget, key types.Key) *types.MenuItem {
+		return &types.MenuItem{
+			Label: label,
+			OnPress: func() error {
+				self.context().SetDiffTarget(target)
+				self.c.PostRefreshUpdate(self.context())
+				return nil
+			},
+			Key:    key,
+			Widget: types.MakeMenuRadioButton(self.context().GetDiffTarget() == target),
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ReflogDiffTarget,
+		Items: []*types.MenuItem{
+			targetItem(self.c.Tr.ReflogDiffAgainstParent, context.ReflogDiffTargetParent, 'p'),
+			targetItem(self.c.Tr.ReflogDiffAgainstHead, context.ReflogDiffTargetHead, 'h'),
+			targetItem(self.c.Tr.ReflogDiffAgainstWorkingTree, context.ReflogDiffTargetWorkingTree, 'w'),
+		},
+	})
+}
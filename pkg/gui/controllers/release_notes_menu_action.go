@@ -0,0 +1,187 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Generates release notes for the commits between two refs, grouped either by
+// conventional-commit type or by author, and lets the user view or copy them.
+type ReleaseNotesMenuAction struct {
+	c *ControllerCommon
+}
+
+type releaseNotesGrouping int
+
+const (
+	releaseNotesByType releaseNotesGrouping = iota
+	releaseNotesByAuthor
+)
+
+// `from` is exclusive and may be empty to include all ancestors of `to`
+func (self *ReleaseNotesMenuAction) Call(from string, to string, rangeLabel string) error {
+	title := fmt.Sprintf("%s: %s", self.c.Tr.ReleaseNotesTitle, rangeLabel)
+
+	show := func(notes string) error {
+		self.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: self.c.MainViewPairs().Normal,
+			Main: &types.ViewUpdateOpts{
+				Title: title,
+				Task:  types.NewRenderStringTask(notes),
+			},
+		})
+		return nil
+	}
+
+	copyToClipboard := func(notes string) error {
+		self.c.LogAction(self.c.Tr.Actions.CopyReleaseNotesToClipboard)
+		if err := self.c.OS().CopyToClipboard(notes); err != nil {
+			return err
+		}
+		self.c.Toast(self.c.Tr.ReleaseNotesCopiedToClipboard)
+		return nil
+	}
+
+	item := func(label string, grouping releaseNotesGrouping, handle func(string) error, key types.Key) *types.MenuItem {
+		return &types.MenuItem{
+			Label: label,
+			OnPress: func() error {
+				return self.withReleaseNotes(from, to, grouping, handle)
+			},
+			Key: key,
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: title,
+		Items: []*types.MenuItem{
+			item(self.c.Tr.ShowReleaseNotesByType, releaseNotesByType, show, 't'),
+			item(self.c.Tr.ShowReleaseNotesByAuthor, releaseNotesByAuthor, show, 'a'),
+			item(self.c.Tr.CopyReleaseNotesByType, releaseNotesByType, copyToClipboard, 'T'),
+			item(self.c.Tr.CopyReleaseNotesByAuthor, releaseNotesByAuthor, copyToClipboard, 'A'),
+		},
+	})
+}
+
+func (self *ReleaseNotesMenuAction) withReleaseNotes(from string, to string, grouping releaseNotesGrouping, f func(string) error) error {
+	return self.c.WithWaitingStatus(self.c.Tr.GeneratingReleaseNotesStatus, func(gocui.Task) error {
+		commits, err := self.c.Git().Commit.GetCommitSummariesInRange(from, to)
+		if err != nil {
+			return err
+		}
+		if len(commits) == 0 {
+			return errors.New(self.c.Tr.NoCommitsForReleaseNotes)
+		}
+
+		self.c.OnUIThread(func() error {
+			return f(formatReleaseNotes(commits, grouping))
+		})
+
+		return nil
+	})
+}
+
+// Matches e.g. "feat: foo", "fix(ui): foo" and "refactor!: foo"
+var conventionalCommitRegex = regexp.MustCompile(`^(\w+)(?:\(([^)]*)\))?(!)?:\s*(.+)$`)
+
+type conventionalCommitSection struct {
+	commitType string
+	title      string
+}
+
+var conventionalCommitSections = []conventionalCommitSection{
+	{"feat", "Features"},
+	{"fix", "Bug fixes"},
+	{"perf", "Performance improvements"},
+	{"refactor", "Refactoring"},
+	{"docs", "Documentation"},
+	{"test", "Tests"},
+	{"build", "Build system"},
+	{"ci", "Continuous integration"},
+	{"chore", "Chores"},
+	{"revert", "Reverts"},
+}
+
+const (
+	breakingChangesSection = "Breaking changes"
+	otherChangesSection    = "Other changes"
+)
+
+// Renders the given commits as markdown, one section per group. Sections keep
+// the order of the commits within them.
+func formatReleaseNotes(commits []git_commands.CommitSummary, grouping releaseNotesGrouping) string {
+	sectionTitles := []string{}
+	entriesBySection := map[string][]string{}
+	add := func(section string, entry string) {
+		if _, ok := entriesBySection[section]; !ok {
+			sectionTitles = append(sectionTitles, section)
+		}
+		entriesBySection[section] = append(entriesBySection[section], entry)
+	}
+
+	switch grouping {
+	case releaseNotesByType:
+		for _, commit := range commits {
+			section, entry := conventionalCommitEntry(commit)
+			add(section, entry)
+		}
+
+		order := append([]string{breakingChangesSection},
+			lo.Map(conventionalCommitSections, func(section conventionalCommitSection, _ int) string {
+				return section.title
+			})...)
+		order = append(order, otherChangesSection)
+		sectionTitles = lo.Filter(order, func(title string, _ int) bool {
+			_, ok := entriesBySection[title]
+			return ok
+		})
+	case releaseNotesByAuthor:
+		for _, commit := range commits {
+			add(commit.Author, fmt.Sprintf("- %s (%s)", commit.Subject, commit.ShortHash))
+		}
+
+		// Most active authors first
+		sort.SliceStable(sectionTitles, func(i, j int) bool {
+			return len(entriesBySection[sectionTitles[i]]) > len(entriesBySection[sectionTitles[j]])
+		})
+	}
+
+	sections := lo.Map(sectionTitles, func(title string, _ int) string {
+		return "## " + title + "\n\n" + strings.Join(entriesBySection[title], "\n")
+	})
+
+	return strings.Join(sections, "\n\n") + "\n"
+}
+
+func conventionalCommitEntry(commit git_commands.CommitSummary) (string, string) {
+	match := conventionalCommitRegex.FindStringSubmatch(commit.Subject)
+	if match == nil {
+		return otherChangesSection, fmt.Sprintf("- %s (%s)", commit.Subject, commit.ShortHash)
+	}
+
+	commitType, scope, breaking, description := strings.ToLower(match[1]), match[2], match[3], match[4]
+	if scope != "" {
+		description = fmt.Sprintf("**%s:** %s", scope, description)
+	}
+	entry := fmt.Sprintf("- %s (%s)", description, commit.ShortHash)
+
+	if breaking != "" {
+		return breakingChangesSection, entry
+	}
+
+	for _, section := range conventionalCommitSections {
+		if section.commitType == commitType {
+			return section.title, entry
+		}
+	}
+
+	return otherChangesSection, entry
+}
@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_formatReleaseNotes(t *testing.T) {
+	commits := []git_commands.CommitSummary{
+		{ShortHash: "aaa", Author: "Jane", Subject: "fix(ui): crash on startup"},
+		{ShortHash: "bbb", Author: "John", Subject: "Update readme"},
+		{ShortHash: "ccc", Author: "Jane", Subject: "feat!: drop old config format"},
+		{ShortHash: "ddd", Author: "Jane", Subject: "feat: add release notes"},
+		{ShortHash: "eee", Author: "John", Subject: "Fix: typo"},
+	}
+
+	scenarios := []struct {
+		name     string
+		grouping releaseNotesGrouping
+		expected string
+	}{
+		{
+			name:     "by type",
+			grouping: releaseNotesByType,
+			expected: "## Breaking changes\n\n" +
+				"- drop old config format (ccc)\n\n" +
+				"## Features\n\n" +
+				"- add release notes (ddd)\n\n" +
+				"## Bug fixes\n\n" +
+				"- **ui:** crash on startup (aaa)\n" +
+				"- typo (eee)\n\n" +
+				"## Other changes\n\n" +
+				"- Update readme (bbb)\n",
+		},
+		{
+			name:     "by author",
+			grouping: releaseNotesByAuthor,
+			expected: "## Jane\n\n" +
+				"- fix(ui): crash on startup (aaa)\n" +
+				"- feat!: drop old config format (ccc)\n" +
+				"- feat: add release notes (ddd)\n\n" +
+				"## John\n\n" +
+				"- Update readme (bbb)\n" +
+				"- Fix: typo (eee)\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, formatReleaseNotes(commits, s.grouping))
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Shows whether rerere ("reuse recorded resolution") is enabled, which
+// conflicts of the current merge it resolved automatically, and which
+// resolutions it has recorded, and lets the user forget them.
+type RerereMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *RerereMenuAction) Call() error {
+	resolutions, err := self.c.Git().Rerere.RecordedResolutions()
+	if err != nil {
+		return err
+	}
+
+	enabled := self.c.Git().Rerere.IsEnabled()
+	menuItems := []*types.MenuItem{
+		{
+			Label:   self.c.Tr.EnableRerere,
+			Tooltip: self.c.Tr.EnableRerereTooltip,
+			Widget:  types.MakeMenuCheckBox(enabled),
+			OnPress: func() error {
+				self.c.LogAction(self.c.Tr.Actions.ToggleRerere)
+				if err := self.c.Git().Rerere.SetEnabled(!enabled); err != nil {
+					return err
+				}
+				return self.Call()
+			},
+			Key: 'e',
+		},
+	}
+
+	resolvedSection := &types.MenuSection{Title: self.c.Tr.ResolvedByRerereSection}
+	for _, path := range self.c.Git().Rerere.ResolvedPaths() {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:   path,
+			Tooltip: self.c.Tr.ForgetRerereResolutionTooltip,
+			Section: resolvedSection,
+			OnPress: func() error {
+				return self.forgetResolution(path)
+			},
+		})
+	}
+
+	recordedSection := &types.MenuSection{Title: self.c.Tr.RecordedResolutionsSection}
+	if len(resolutions) == 0 {
+		menuItems = append(menuItems, &types.MenuItem{
+			Label:          self.c.Tr.NoRecordedResolutions,
+			Section:        recordedSection,
+			OnPress:        func() error { return nil },
+			DisabledReason: &types.DisabledReason{Text: self.c.Tr.NoRecordedResolutions},
+		})
+	}
+	for _, resolution := range resolutions {
+		menuItems = append(menuItems, &types.MenuItem{
+			LabelColumns: []string{
+				style.FgYellow.Sprint(utils.ShortHash(resolution.ID)),
+				style.FgBlue.Sprint(utils.UnixToTimeAgo(resolution.Date.Unix())),
+				resolution.Preview,
+			},
+			Tooltip: self.c.Tr.DeleteRecordedResolutionTooltip,
+			Section: recordedSection,
+			OnPress: func() error {
+				return self.deleteRecordedResolution(resolution)
+			},
+		})
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.RerereTitle,
+		Items: menuItems,
+	})
+}
+
+func (self *RerereMenuAction) forgetResolution(path string) error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.ForgetRerereResolution,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.ForgetRerereResolutionPrompt, map[string]string{"path": path}),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.ForgetRerereResolution)
+			if err := self.c.Git().Rerere.ForgetResolution(path); err != nil {
+				return err
+			}
+
+			self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+			return nil
+		},
+	})
+
+	return nil
+}
+
+func (self *RerereMenuAction) deleteRecordedResolution(resolution *git_commands.RerereResolution) error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.ForgetRerereResolution,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.DeleteRecordedResolutionPrompt, map[string]string{
+			"id": utils.ShortHash(resolution.ID),
+		}),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.ForgetRerereResolution)
+			if err := self.c.Git().Rerere.DeleteRecordedResolution(resolution.ID); err != nil {
+				return err
+			}
+			return self.Call()
+		},
+	})
+
+	return nil
+}
@@ -33,6 +33,19 @@ func (self *ScreenModeActions) Prev() error {
 	return nil
 }
 
+func (self *ScreenModeActions) ToggleZen() error {
+	repoState := self.c.State().GetRepoState()
+	if repoState.GetScreenMode() == types.SCREEN_ZEN {
+		repoState.SetScreenMode(repoState.GetScreenModeBeforeZenMode())
+	} else {
+		repoState.SetScreenModeBeforeZenMode(repoState.GetScreenMode())
+		repoState.SetScreenMode(types.SCREEN_ZEN)
+	}
+
+	self.rerenderViewsWithScreenModeDependentContent()
+	return nil
+}
+
 // these views need to be re-rendered when the screen mode changes. The commits view,
 // for example, will show authorship information in half and full screen mode.
 func (self *ScreenModeActions) rerenderViewsWithScreenModeDependentContent() {
@@ -0,0 +1,132 @@
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Long values (e.g. templates) would make the menu too wide
+const maxSettingValueLength = 40
+
+// Lets the user browse the config options section by section, and change the
+// simple ones without opening the config file. Changes are written to the
+// global config file.
+type SettingsMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *SettingsMenuAction) Call() error {
+	settings, err := config.GetSettings()
+	if err != nil {
+		return err
+	}
+
+	return self.showSection(self.c.Tr.SettingsTitle, settings)
+}
+
+func (self *SettingsMenuAction) showSection(title string, settings []*config.Setting) error {
+	userConfig := self.c.UserConfig()
+	defaultConfig := config.GetDefaultConfig()
+
+	menuItems := lo.Map(settings, func(setting *config.Setting, _ int) *types.MenuItem {
+		name := setting.Path[len(setting.Path)-1]
+		if setting.IsSection() {
+			return &types.MenuItem{
+				LabelColumns: []string{name + "/", "", ""},
+				OnPress: func() error {
+					return self.showSection(setting.Key(), setting.Children)
+				},
+				Tooltip: setting.Schema.Description,
+			}
+		}
+
+		value := setting.FormatValue(userConfig)
+		defaultValue := setting.FormatValue(defaultConfig)
+		valueColumn := utils.TruncateWithEllipsis(value, maxSettingValueLength)
+		defaultColumn := ""
+		if value != defaultValue {
+			valueColumn = style.FgYellow.Sprint(valueColumn)
+			defaultColumn = fmt.Sprintf(self.c.Tr.SettingDefaultValue,
+				utils.TruncateWithEllipsis(defaultValue, maxSettingValueLength))
+		}
+
+		var disabledReason *types.DisabledReason
+		if !setting.IsEditable() {
+			disabledReason = &types.DisabledReason{Text: self.c.Tr.SettingOnlyInConfigFile}
+		}
+
+		return &types.MenuItem{
+			LabelColumns: []string{name, valueColumn, style.FgCyan.Sprint(defaultColumn)},
+			OnPress: func() error {
+				return self.edit(setting, value, func() error {
+					return self.showSection(title, settings)
+				})
+			},
+			Tooltip:        setting.Schema.Description,
+			DisabledReason: disabledReason,
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: title,
+		Items: menuItems,
+	})
+}
+
+// Booleans are toggled, enums are picked from a menu, and everything else is
+// typed in. Afterwards we go back to the section the setting is in.
+func (self *SettingsMenuAction) edit(setting *config.Setting, currentValue string, backToSection func() error) error {
+	save := func(input string) error {
+		value, err := setting.ParseValue(input)
+		if err != nil {
+			return err
+		}
+		if err := self.save(setting, value); err != nil {
+			return err
+		}
+		return backToSection()
+	}
+
+	if setting.Schema.Type == "boolean" {
+		return save(fmt.Sprint(currentValue != "true"))
+	}
+
+	if allowedValues := setting.AllowedValues(); len(allowedValues) > 0 {
+		return self.c.Menu(types.CreateMenuOptions{
+			Title: setting.Key(),
+			Items: lo.Map(allowedValues, func(allowedValue string, _ int) *types.MenuItem {
+				return &types.MenuItem{
+					Label:   allowedValue,
+					OnPress: func() error { return save(allowedValue) },
+					Widget:  types.MakeMenuRadioButton(allowedValue == currentValue),
+				}
+			}),
+		})
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title:          setting.Key(),
+		InitialContent: currentValue,
+		HandleConfirm:  save,
+	})
+	return nil
+}
+
+func (self *SettingsMenuAction) save(setting *config.Setting, value any) error {
+	self.c.LogAction(self.c.Tr.Actions.ChangeSetting)
+	oldConfig := self.c.UserConfig()
+	if err := self.c.GetConfig().SetGlobalUserConfigValue(setting.Path, value); err != nil {
+		return err
+	}
+	if err := self.c.ApplyChangedUserConfig(oldConfig); err != nil {
+		return err
+	}
+
+	self.c.Toast(fmt.Sprintf(self.c.Tr.SettingSaved, setting.Key()))
+	return nil
+}
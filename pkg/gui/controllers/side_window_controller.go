@@ -3,6 +3,7 @@ package controllers
 import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type SideWindowControllerFactory struct {
@@ -53,7 +54,7 @@ func (self *SideWindowController) previousSideWindow() error {
 	windows := self.c.Helpers().Window.SideWindows()
 	currentWindow := self.c.Helpers().Window.CurrentWindow()
 	var newWindow string
-	if currentWindow == "" || currentWindow == windows[0] {
+	if !lo.Contains(windows, currentWindow) || currentWindow == windows[0] {
 		newWindow = windows[len(windows)-1]
 	} else {
 		for i := range windows {
@@ -77,7 +78,7 @@ func (self *SideWindowController) nextSideWindow() error {
 	windows := self.c.Helpers().Window.SideWindows()
 	currentWindow := self.c.Helpers().Window.CurrentWindow()
 	var newWindow string
-	if currentWindow == "" || currentWindow == windows[len(windows)-1] {
+	if !lo.Contains(windows, currentWindow) || currentWindow == windows[len(windows)-1] {
 		newWindow = windows[0]
 	} else {
 		for i := range windows {
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -55,6 +56,12 @@ func (self *StagingController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Tooltip:         self.c.Tr.DiscardSelectionTooltip,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Files.ViewStashOptions),
+			Handler:     self.StashSelection,
+			Description: self.c.Tr.StashSelection,
+			Tooltip:     self.c.Tr.StashSelectionTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Universal.OpenFile),
 			Handler:     self.OpenFile,
@@ -219,9 +226,21 @@ func (self *StagingController) DiscardSelection() error {
 
 	return self.c.ConfirmIf(!self.staged && !self.c.UserConfig().Gui.SkipDiscardChangeWarning,
 		types.ConfirmOpts{
-			Title:         self.c.Tr.DiscardChangeTitle,
-			Prompt:        self.c.Tr.DiscardChangePrompt,
-			HandleConfirm: func() error { return self.applySelectionAndRefresh(true) },
+			Title:  self.c.Tr.DiscardChangeTitle,
+			Prompt: self.c.Tr.DiscardChangePrompt,
+			HandleConfirm: func() error {
+				// Discarding from the staged view only unstages, so there's
+				// nothing to back up
+				if !self.staged {
+					if err := self.c.Helpers().Snapshot.BackupBeforeDiscard(
+						[]string{self.FilePath()}, self.c.Tr.DiscardChangeTitle,
+					); err != nil {
+						return err
+					}
+				}
+
+				return self.applySelectionAndRefresh(true)
+			},
 		})
 }
 
@@ -280,6 +299,71 @@ func (self *StagingController) applySelection(reverse bool) error {
 	return nil
 }
 
+func (self *StagingController) StashSelection() error {
+	if self.staged {
+		return errors.New(self.c.Tr.CanOnlyStashUnstagedLines)
+	}
+
+	if self.c.UserConfig().Git.DiffContextSize == 0 {
+		return fmt.Errorf(self.c.Tr.Actions.NotEnoughContextToStash,
+			keybindings.Label(self.c.UserConfig().Keybinding.Universal.IncreaseContextInDiffView))
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.StashChanges,
+		HandleConfirm: func(message string) error {
+			if err := self.stashSelection(message); err != nil {
+				return err
+			}
+
+			self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES, types.STAGING, types.STASH}})
+			return nil
+		},
+	})
+
+	return nil
+}
+
+func (self *StagingController) stashSelection(message string) error {
+	self.context.GetMutex().Lock()
+	defer self.context.GetMutex().Unlock()
+
+	state := self.context.GetState()
+	path := self.FilePath()
+	if path == "" {
+		return nil
+	}
+
+	firstLineIdx, lastLineIdx := state.SelectedPatchRange()
+	patchToStash := patch.
+		Parse(state.GetDiff()).
+		Transform(patch.TransformOpts{
+			IncludedLineIndices: patch.ExpandRange(firstLineIdx, lastLineIdx),
+			FileNameOverride:    path,
+		}).
+		FormatPlain()
+
+	if patchToStash == "" {
+		return nil
+	}
+
+	self.c.LogAction(self.c.Tr.Actions.StashSelectedLines)
+	patchPath, err := self.c.Git().Patch.SaveTemporaryPatch(patchToStash)
+	if err != nil {
+		return err
+	}
+	if err := self.c.Git().Stash.StashPatch(patchPath, message); err != nil {
+		return err
+	}
+
+	if state.SelectingRange() {
+		firstLine, _ := state.SelectedViewRange()
+		state.SelectLine(firstLine)
+	}
+
+	return nil
+}
+
 func (self *StagingController) EditHunkAndRefresh() error {
 	if err := self.editHunk(); err != nil {
 		return err
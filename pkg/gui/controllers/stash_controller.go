@@ -1,11 +1,17 @@
 package controllers
 
 import (
+	"errors"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type StashController struct {
@@ -64,6 +70,13 @@ func (self *StashController) GetKeybindings(opts types.KeybindingsOpts) []*types
 			Description:       self.c.Tr.NewBranch,
 			Tooltip:           self.c.Tr.NewBranchFromStashTooltip,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Stash.ApplyToBranch),
+			Handler:           self.withItem(self.handleApplyToBranch),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.ApplyStashToBranch,
+			Tooltip:           self.c.Tr.ApplyStashToBranchTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Stash.RenameStash),
 			Handler:           self.withItem(self.handleRenameStashEntry),
@@ -155,6 +168,62 @@ func (self *StashController) handleStashPop(stashEntry *models.StashEntry) error
 	return nil
 }
 
+func (self *StashController) handleApplyToBranch(stashEntry *models.StashEntry) error {
+	self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.ApplyStashToBranchPrompt,
+			map[string]string{"stashName": stashEntry.RefName()}),
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetBranchNameSuggestionsFunc(),
+		HandleConfirm: func(response string) error {
+			return self.applyToBranch(stashEntry, strings.TrimSpace(response))
+		},
+	})
+
+	return nil
+}
+
+func (self *StashController) applyToBranch(stashEntry *models.StashEntry, branchName string) error {
+	branch, found := lo.Find(self.c.Model().Branches, func(branch *models.Branch) bool {
+		return branch.Name == branchName
+	})
+	if !found {
+		return errors.New(utils.ResolvePlaceholderString(self.c.Tr.StashBranchNotFound,
+			map[string]string{"branch": branchName}))
+	}
+
+	// If the branch is checked out in another worktree we can't switch to it
+	// here, but we can apply the stash entry over there instead
+	if worktree, ok := git_commands.WorktreeForBranch(branch, self.c.Model().Worktrees); ok && !worktree.IsCurrent {
+		self.c.LogAction(self.c.Tr.Actions.ApplyStashToBranch)
+		if err := self.c.Git().Stash.ApplyInWorktree(stashEntry.Index, worktree.Path); err != nil {
+			return err
+		}
+		self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.StashAppliedInWorktree,
+			map[string]string{"worktree": worktree.Name}))
+		return nil
+	}
+
+	needsCheckout := branchName != self.c.Model().CheckedOutBranch
+	if needsCheckout && self.c.Helpers().WorkingTree.IsWorkingTreeDirty() {
+		return errors.New(self.c.Tr.CannotApplyStashToBranchWithChanges)
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.CheckingOutStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.ApplyStashToBranch)
+		if needsCheckout {
+			if err := self.c.Git().Branch.Checkout(branchName, git_commands.CheckoutOptions{}); err != nil {
+				return err
+			}
+			self.c.Contexts().Branches.SetSelection(0)
+			self.c.Contexts().LocalCommits.SetSelection(0)
+		}
+
+		err := self.c.Git().Stash.Apply(stashEntry.Index)
+		// The branch may have changed even if applying failed (e.g. conflicts)
+		self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, KeepBranchSelectionIndex: true})
+		return err
+	})
+}
+
 func (self *StashController) handleStashDrop(stashEntries []*models.StashEntry) error {
 	self.c.Confirm(types.ConfirmOpts{
 		Title:  self.c.Tr.StashDrop,
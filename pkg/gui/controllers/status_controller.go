@@ -63,6 +63,42 @@ func (self *StatusController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Handler:     func() error { self.switchToOrRotateAllBranchesLogs(); return nil },
 			Description: self.c.Tr.AllBranchesLogGraph,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.HealthChecks),
+			Handler:     self.openHealthChecksMenu,
+			Description: self.c.Tr.HealthChecks,
+			Tooltip:     self.c.Tr.HealthChecksTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.ActivitySummary),
+			Handler:     self.openActivitySummaryMenu,
+			Description: self.c.Tr.ActivitySummary,
+			Tooltip:     self.c.Tr.ActivitySummaryTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.OpenSettings),
+			Handler:     self.openSettingsMenu,
+			Description: self.c.Tr.Settings,
+			Tooltip:     self.c.Tr.SettingsTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Status.SwitchProfile),
+			Handler:           self.openProfilesMenu,
+			GetDisabledReason: self.profilesDefined,
+			Description:       self.c.Tr.SwitchProfile,
+			Tooltip:           self.c.Tr.SwitchProfileTooltip,
+			OpensMenu:         true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.SwitchTheme),
+			Handler:     self.openThemesMenu,
+			Description: self.c.Tr.SwitchTheme,
+			Tooltip:     self.c.Tr.SwitchThemeTooltip,
+			OpensMenu:   true,
+		},
 	}
 
 	return bindings
@@ -106,7 +142,7 @@ func (self *StatusController) onClick(opts gocui.ViewMouseBindingOpts) error {
 	self.c.Context().Push(self.Context(), types.OnFocusOpts{})
 
 	upstreamStatus := utils.Decolorise(presentation.BranchStatus(currentBranch, types.ItemOperationNone, self.c.Tr, time.Now(), self.c.UserConfig()))
-	repoName := self.c.Git().RepoPaths.RepoName()
+	repoName := presentation.FormatRepoBreadcrumb(self.c.State().GetRepoPathStack().Items(), self.c.Git().RepoPaths.RepoName())
 	workingTreeState := self.c.Git().Status.WorkingTreeState()
 	if workingTreeState.Any() {
 		workingTreeStatus := fmt.Sprintf("(%s)", workingTreeState.LowerCaseTitle(self.c.Tr))
@@ -236,3 +272,30 @@ func (self *StatusController) showDashboard() {
 func (self *StatusController) handleCheckForUpdate() error {
 	return self.c.Helpers().Update.CheckForUpdateInForeground()
 }
+
+func (self *StatusController) openHealthChecksMenu() error {
+	return (&HealthChecksMenuAction{c: self.c}).Call()
+}
+
+func (self *StatusController) openActivitySummaryMenu() error {
+	return (&ActivitySummaryMenuAction{c: self.c}).Call()
+}
+
+func (self *StatusController) openSettingsMenu() error {
+	return (&SettingsMenuAction{c: self.c}).Call()
+}
+
+func (self *StatusController) openProfilesMenu() error {
+	return (&ProfilesMenuAction{c: self.c}).Call()
+}
+
+func (self *StatusController) openThemesMenu() error {
+	return (&ThemesMenuAction{c: self.c}).Call()
+}
+
+func (self *StatusController) profilesDefined() *types.DisabledReason {
+	if len(self.c.UserConfig().Profiles) == 0 {
+		return &types.DisabledReason{Text: self.c.Tr.NoProfilesDefined}
+	}
+	return nil
+}
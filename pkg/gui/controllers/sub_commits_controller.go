@@ -1,6 +1,9 @@
 package controllers
 
 import (
+	"regexp"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -46,7 +49,7 @@ func (self *SubCommitsController) GetOnRenderToMain() func() {
 				task = types.NewRenderStringTask("No commits")
 			} else {
 				refRange := self.context().GetSelectedRefRangeForDiffFiles()
-				task = self.c.Helpers().Diff.GetUpdateTaskForRenderingCommitsDiff(commit, refRange)
+				task = self.c.Helpers().Diff.GetUpdateTaskForRenderingCommitsDiff(commit, refRange, self.context().GetPickaxe())
 			}
 
 			self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -57,10 +60,38 @@ func (self *SubCommitsController) GetOnRenderToMain() func() {
 					Task:     task,
 				},
 			})
+
+			// The view's search results are updated as the diff is written to
+			// it, so this highlights the matches once they arrive.
+			if highlight := pickaxeHighlight(self.context().GetPickaxe()); highlight != "" {
+				self.c.Views().Main.UpdateSearchResults(highlight, nil)
+			}
 		})
 	}
 }
 
+func (self *SubCommitsController) GetOnFocusLost() func(types.OnFocusLostOpts) {
+	return func(opts types.OnFocusLostOpts) {
+		if !self.context().GetPickaxe().Active() {
+			return
+		}
+
+		// Keep the highlighting while the user is looking at the diff itself
+		if opts.NewContextKey != context.NORMAL_MAIN_CONTEXT_KEY && opts.NewContextKey != context.SEARCH_CONTEXT_KEY {
+			self.c.Views().Main.ClearSearch()
+		}
+	}
+}
+
+// The view can only highlight literal text, so for a regex pickaxe we only
+// highlight if it doesn't contain any special characters.
+func pickaxeHighlight(pickaxe git_commands.Pickaxe) string {
+	if pickaxe.Regex && regexp.QuoteMeta(pickaxe.Text) != pickaxe.Text {
+		return ""
+	}
+	return pickaxe.Text
+}
+
 func (self *SubCommitsController) GetOnFocus() func(types.OnFocusOpts) {
 	return func(types.OnFocusOpts) {
 		context := self.context()
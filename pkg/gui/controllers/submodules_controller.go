@@ -1,8 +1,10 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
@@ -12,6 +14,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type SubmodulesController struct {
@@ -69,6 +72,20 @@ func (self *SubmodulesController) GetKeybindings(opts types.KeybindingsOpts) []*
 			Tooltip:           self.c.Tr.SubmoduleUpdateTooltip,
 			DisplayOnScreen:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Submodules.UpdateRemote),
+			Handler:           self.withItem(self.updateRemote),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.UpdateSubmoduleToRemote,
+			Tooltip:           self.c.Tr.UpdateSubmoduleToRemoteTooltip,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Submodules.SetBranch),
+			Handler:           self.withItem(self.setBranch),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.SetSubmoduleBranch,
+			Tooltip:           self.c.Tr.SetSubmoduleBranchTooltip,
+		},
 		{
 			Key:             opts.GetKey(opts.Config.Universal.New),
 			Handler:         self.add,
@@ -88,6 +105,13 @@ func (self *SubmodulesController) GetKeybindings(opts types.KeybindingsOpts) []*
 			Description:       self.c.Tr.Initialize,
 			Tooltip:           self.c.Tr.InitSubmoduleTooltip,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.OpenInTmux),
+			Handler:           self.withItem(self.openInTmux),
+			GetDisabledReason: self.require(self.singleItemSelected(), self.c.Helpers().Repos.OpenInTmuxDisabledReason),
+			Description:       self.c.Tr.OpenInTmux,
+			Tooltip:           self.c.Tr.OpenInTmuxTooltip,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Submodules.BulkMenu),
 			Handler:     self.openBulkActionsMenu,
@@ -115,11 +139,15 @@ func (self *SubmodulesController) GetOnRenderToMain() func() {
 				task = types.NewRenderStringTask("No submodules")
 			} else {
 				prefix := fmt.Sprintf(
-					"Name: %s\nPath: %s\nUrl:  %s\n\n",
+					"Name: %s\nPath: %s\nUrl:  %s\n",
 					style.FgGreen.Sprint(submodule.FullName()),
 					style.FgYellow.Sprint(submodule.FullPath()),
 					style.FgCyan.Sprint(submodule.Url),
 				)
+				if submodule.Branch != "" {
+					prefix += fmt.Sprintf("Branch: %s\n", style.FgMagenta.Sprint(submodule.Branch))
+				}
+				prefix += "\n"
 
 				file := self.c.Helpers().WorkingTree.FileForSubmodule(submodule)
 				if file == nil {
@@ -145,6 +173,10 @@ func (self *SubmodulesController) enter(submodule *models.SubmoduleConfig) error
 	return self.c.Helpers().Repos.EnterSubmodule(submodule)
 }
 
+func (self *SubmodulesController) openInTmux(submodule *models.SubmoduleConfig) error {
+	return self.c.Helpers().Repos.OpenInTmux(submodule.FullPath())
+}
+
 func (self *SubmodulesController) add() error {
 	self.c.Prompt(types.PromptOpts{
 		Title: self.c.Tr.NewSubmoduleUrl,
@@ -159,16 +191,23 @@ func (self *SubmodulesController) add() error {
 						Title:          self.c.Tr.NewSubmodulePath,
 						InitialContent: submoduleName,
 						HandleConfirm: func(submodulePath string) error {
-							return self.c.WithWaitingStatus(self.c.Tr.AddingSubmoduleStatus, func(gocui.Task) error {
-								self.c.LogAction(self.c.Tr.Actions.AddSubmodule)
-								err := self.c.Git().Submodule.Add(submoduleName, submodulePath, submoduleUrl)
-								if err != nil {
-									return err
-								}
-
-								self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES}})
-								return nil
+							self.c.Prompt(types.PromptOpts{
+								Title: self.c.Tr.NewSubmoduleBranch,
+								HandleConfirm: func(submoduleBranch string) error {
+									return self.c.WithWaitingStatus(self.c.Tr.AddingSubmoduleStatus, func(gocui.Task) error {
+										self.c.LogAction(self.c.Tr.Actions.AddSubmodule)
+										err := self.c.Git().Submodule.Add(submoduleName, submodulePath, submoduleUrl, strings.TrimSpace(submoduleBranch))
+										if err != nil {
+											return err
+										}
+
+										self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES}})
+										return nil
+									})
+								},
 							})
+
+							return nil
 						},
 					})
 
@@ -204,6 +243,28 @@ func (self *SubmodulesController) editURL(submodule *models.SubmoduleConfig) err
 	return nil
 }
 
+func (self *SubmodulesController) setBranch(submodule *models.SubmoduleConfig) error {
+	self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.SetSubmoduleBranchPrompt,
+			map[string]string{"name": submodule.FullName()}),
+		InitialContent: submodule.Branch,
+		HandleConfirm: func(branch string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.SettingSubmoduleBranchStatus, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.SetSubmoduleBranch)
+				err := self.c.Git().Submodule.SetBranch(submodule, strings.TrimSpace(branch))
+				if err != nil {
+					return err
+				}
+
+				self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES, types.FILES}})
+				return nil
+			})
+		},
+	})
+
+	return nil
+}
+
 func (self *SubmodulesController) init(submodule *models.SubmoduleConfig) error {
 	return self.c.WithWaitingStatus(self.c.Tr.InitializingSubmoduleStatus, func(gocui.Task) error {
 		self.c.LogAction(self.c.Tr.Actions.InitialiseSubmodule)
@@ -267,6 +328,27 @@ func (self *SubmodulesController) openBulkActionsMenu() error {
 				},
 				Key: 'r',
 			},
+			{
+				LabelColumns: []string{self.c.Tr.BulkUpdateRemoteSubmodules, style.FgYellow.Sprint(self.c.Git().Submodule.BulkUpdateRemoteCmdObj().ToString())},
+				OnPress: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.RunningCommand, func(gocui.Task) error {
+						self.c.LogAction(self.c.Tr.Actions.BulkUpdateRemoteSubmodules)
+						if err := self.c.Git().Submodule.BulkUpdateRemoteCmdObj().StreamOutput().Run(); err != nil {
+							return err
+						}
+
+						self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES, types.FILES}})
+						return nil
+					})
+				},
+				Key: 'R',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.RunCommandInAllSubmodules, style.FgCyan.Sprint("git submodule foreach --recursive <command>")},
+				OnPress:      self.runCommandInAllSubmodules,
+				Key:          'c',
+				OpensMenu:    true,
+			},
 			{
 				LabelColumns: []string{self.c.Tr.BulkDeinitSubmodules, style.FgRed.Sprint(self.c.Git().Submodule.BulkDeinitCmdObj().ToString())},
 				OnPress: func() error {
@@ -286,6 +368,77 @@ func (self *SubmodulesController) openBulkActionsMenu() error {
 	})
 }
 
+// Offers the configured command presets, if any, before prompting for a command
+func (self *SubmodulesController) runCommandInAllSubmodules() error {
+	presets := self.c.UserConfig().Git.SubmoduleCommandPresets
+	if len(presets) == 0 {
+		return self.promptForCommandToRunInAllSubmodules()
+	}
+
+	menuItems := lo.Map(presets, func(command string, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label: command,
+			OnPress: func() error {
+				return self.runCommandInEachSubmodule(command)
+			},
+		}
+	})
+	menuItems = append(menuItems, &types.MenuItem{
+		Label:     self.c.Tr.CustomSubmoduleCommand,
+		Key:       'c',
+		OpensMenu: true,
+		OnPress:   self.promptForCommandToRunInAllSubmodules,
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.RunCommandInAllSubmodules,
+		Items: menuItems,
+	})
+}
+
+func (self *SubmodulesController) promptForCommandToRunInAllSubmodules() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:         self.c.Tr.SubmoduleCommandPrompt,
+		HandleConfirm: self.runCommandInEachSubmodule,
+	})
+
+	return nil
+}
+
+// Streams the output into the command log, and lists the submodules where the
+// command failed once it has run in all of them
+func (self *SubmodulesController) runCommandInEachSubmodule(command string) error {
+	if strings.TrimSpace(command) == "" {
+		return nil
+	}
+
+	return self.c.WithWaitingStatus(self.c.Tr.RunningCommand, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.RunCommandInAllSubmodules)
+		self.c.LogCommand("git submodule foreach --recursive "+command, true)
+		failedPaths, err := self.c.Git().Submodule.Foreach(command, func(line string) {
+			self.c.LogCommand(line, false)
+		})
+		if err != nil {
+			return err
+		}
+
+		self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES, types.FILES}})
+
+		if len(failedPaths) > 0 {
+			message := utils.ResolvePlaceholderString(
+				self.c.Tr.SubmoduleCommandFailed,
+				map[string]string{
+					"count": strconv.Itoa(len(failedPaths)),
+				},
+			)
+			return errors.New(message + "\n\n" + strings.Join(failedPaths, "\n"))
+		}
+
+		self.c.Toast(self.c.Tr.SubmoduleCommandSucceeded)
+		return nil
+	})
+}
+
 func (self *SubmodulesController) update(submodule *models.SubmoduleConfig) error {
 	return self.c.WithWaitingStatus(self.c.Tr.UpdatingSubmoduleStatus, func(gocui.Task) error {
 		self.c.LogAction(self.c.Tr.Actions.UpdateSubmodule)
@@ -299,6 +452,19 @@ func (self *SubmodulesController) update(submodule *models.SubmoduleConfig) erro
 	})
 }
 
+func (self *SubmodulesController) updateRemote(submodule *models.SubmoduleConfig) error {
+	return self.c.WithWaitingStatus(self.c.Tr.UpdatingSubmoduleToRemoteStatus, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.UpdateSubmoduleToRemote)
+		err := self.c.Git().Submodule.UpdateRemote(submodule)
+		if err != nil {
+			return err
+		}
+
+		self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES, types.FILES}})
+		return nil
+	})
+}
+
 func (self *SubmodulesController) remove(submodule *models.SubmoduleConfig) error {
 	self.c.Confirm(types.ConfirmOpts{
 		Title:  self.c.Tr.RemoveSubmodule,
@@ -69,6 +69,14 @@ func (self *SwitchToFocusedMainViewController) onClickSecondary(opts gocui.ViewM
 }
 
 func (self *SwitchToFocusedMainViewController) handleFocusMainView() error {
+	// With the default keybindings, 0 is also a digit of a count prefix; it
+	// only focuses the main view if it can't continue a count
+	countPrefix := self.c.Helpers().CountPrefix
+	if countPrefix.Count() > 0 && self.c.UserConfig().Keybinding.Universal.FocusMainView == "0" {
+		countPrefix.AddDigit(0)
+		return nil
+	}
+
 	return self.focusMainView(self.c.Contexts().Normal)
 }
 
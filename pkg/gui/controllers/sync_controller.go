@@ -9,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -174,6 +175,7 @@ func (self *SyncController) pullWithLock(task gocui.Task, opts PullFilesOptions)
 			FastForwardOnly: opts.FastForwardOnly,
 		},
 	)
+	self.c.Helpers().Notification.NotifyIfUnfocused(helpers.NotifiablePull, err)
 
 	return self.c.Helpers().MergeAndRebase.CheckMergeOrRebase(err)
 }
@@ -205,6 +207,7 @@ func (self *SyncController) pushAux(currentBranch *models.Branch, opts pushOpts)
 				UpstreamBranch: opts.upstreamBranch,
 				SetUpstream:    opts.setUpstream,
 			})
+		self.c.Helpers().Notification.NotifyIfUnfocused(helpers.NotifiablePush, err)
 		if err != nil {
 			if !opts.force && !opts.forceWithLease && strings.Contains(err.Error(), "Updates were rejected") {
 				if opts.remoteBranchStoredLocally {
@@ -215,6 +218,9 @@ func (self *SyncController) pushAux(currentBranch *models.Branch, opts pushOpts)
 				if forcePushDisabled {
 					return errors.New(self.c.Tr.UpdatesRejectedAndForcePushDisabled)
 				}
+				if err := self.checkNotProtected(currentBranch, opts); err != nil {
+					return err
+				}
 				self.c.Confirm(types.ConfirmOpts{
 					Title:  self.c.Tr.ForcePush,
 					Prompt: self.forcePushPrompt(),
@@ -239,6 +245,9 @@ func (self *SyncController) requestToForcePush(currentBranch *models.Branch, opt
 	if forcePushDisabled {
 		return errors.New(self.c.Tr.ForcePushDisabled)
 	}
+	if err := self.checkNotProtected(currentBranch, opts); err != nil {
+		return err
+	}
 
 	self.c.Confirm(types.ConfirmOpts{
 		Title:  self.c.Tr.ForcePush,
@@ -252,6 +261,17 @@ func (self *SyncController) requestToForcePush(currentBranch *models.Branch, opt
 	return nil
 }
 
+// We check the branch we push to as well as the local one, since they can have
+// different names
+func (self *SyncController) checkNotProtected(currentBranch *models.Branch, opts pushOpts) error {
+	for _, branchName := range []string{currentBranch.Name, currentBranch.UpstreamBranch, opts.upstreamBranch} {
+		if branchName != "" && self.c.UserConfig().Git.IsProtectedBranch(branchName) {
+			return fmt.Errorf(self.c.Tr.CantForcePushProtectedBranch, branchName)
+		}
+	}
+	return nil
+}
+
 func (self *SyncController) forcePushPrompt() string {
 	return utils.ResolvePlaceholderString(
 		self.c.Tr.ForcePushPrompt,
@@ -1,12 +1,16 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -53,6 +57,13 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Tooltip:         self.c.Tr.NewTagTooltip,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.Edit),
+			Handler:           self.withItem(self.c.Helpers().Tags.OpenEditTagMessagePanel),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.EditTagMessage,
+			Tooltip:           self.c.Tr.EditTagMessageTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Remove),
 			Handler:           self.withItem(self.delete),
@@ -70,6 +81,20 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Tooltip:           self.c.Tr.PushTagTooltip,
 			DisplayOnScreen:   true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.PushUnpushedTags),
+			Handler:     self.pushUnpushed,
+			Description: self.c.Tr.PushUnpushedTags,
+			Tooltip:     self.c.Tr.PushUnpushedTagsTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.CreateRelease),
+			Handler:           self.withItem(self.createRelease),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.CreateRelease,
+			Tooltip:           self.c.Tr.CreateReleaseTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.ViewResetOptions),
 			Handler:           self.withItem(self.createResetMenu),
@@ -79,6 +104,14 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			DisplayOnScreen:   true,
 			OpensMenu:         true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.GenerateReleaseNotes),
+			Handler:           self.withItemsRange(self.generateReleaseNotes),
+			GetDisabledReason: self.require(self.itemRangeSelected()),
+			Description:       self.c.Tr.GenerateReleaseNotes,
+			Tooltip:           self.c.Tr.GenerateReleaseNotesForTagsTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key: opts.GetKey(opts.Config.Universal.OpenDiffTool),
 			Handler: self.withItem(func(selectedTag *models.Tag) error {
@@ -101,8 +134,9 @@ func (self *TagsController) GetOnRenderToMain() func() {
 				task = types.NewRenderStringTask("No tags")
 			} else {
 				cmdObj := self.c.Git().Branch.GetGraphCmdObj(tag.FullRefName())
-				prefix := self.getTagInfo(tag) + "\n\n---\n\n"
-				task = types.NewRunCommandTaskWithPrefix(cmdObj.GetCmd(), prefix)
+				task = types.NewRunCommandTaskWithPrefixFn(cmdObj.GetCmd(), func() string {
+					return self.getTagInfo(tag) + "\n\n---\n\n"
+				})
 			}
 
 			self.c.RenderToMainViews(types.RefreshMainOpts{
@@ -116,6 +150,8 @@ func (self *TagsController) GetOnRenderToMain() func() {
 	}
 }
 
+// Runs git commands (verifying a signed tag can take a while), so don't call
+// this on the UI thread
 func (self *TagsController) getTagInfo(tag *models.Tag) string {
 	tagIsAnnotated, err := self.c.Git().Tag.IsTagAnnotated(tag.Name)
 	if err != nil {
@@ -126,6 +162,9 @@ func (self *TagsController) getTagInfo(tag *models.Tag) string {
 		info := fmt.Sprintf("%s: %s", self.c.Tr.AnnotatedTag, style.AttrBold.Sprint(style.FgYellow.Sprint(tag.Name)))
 		output, err := self.c.Git().Tag.ShowAnnotationInfo(tag.Name)
 		if err == nil {
+			if isSigned(output) {
+				info += "\n" + self.getSignatureInfo(tag)
+			}
 			info += "\n\n" + strings.TrimRight(filterOutPgpSignature(output), "\n")
 		}
 		return info
@@ -134,15 +173,26 @@ func (self *TagsController) getTagInfo(tag *models.Tag) string {
 	return fmt.Sprintf("%s: %s", self.c.Tr.LightweightTag, style.AttrBold.Sprint(style.FgYellow.Sprint(tag.Name)))
 }
 
+func (self *TagsController) getSignatureInfo(tag *models.Tag) string {
+	ok, summary := self.c.Git().Tag.Verify(tag.Name)
+	summaryStyle := lo.Ternary(ok, style.FgGreen, style.FgRed)
+	return fmt.Sprintf("%s: %s", self.c.Tr.TagSignature, summaryStyle.Sprint(summary))
+}
+
+func isSigned(annotationInfo string) bool {
+	return strings.Contains(annotationInfo, "-----BEGIN PGP SIGNATURE-----") ||
+		strings.Contains(annotationInfo, "-----BEGIN SSH SIGNATURE-----")
+}
+
 func filterOutPgpSignature(output string) string {
 	lines := strings.Split(output, "\n")
 	inPgpSignature := false
 	filteredLines := lo.Filter(lines, func(line string, _ int) bool {
-		if line == "-----END PGP SIGNATURE-----" {
+		if line == "-----END PGP SIGNATURE-----" || line == "-----END SSH SIGNATURE-----" {
 			inPgpSignature = false
 			return false
 		}
-		if line == "-----BEGIN PGP SIGNATURE-----" {
+		if line == "-----BEGIN PGP SIGNATURE-----" || line == "-----BEGIN SSH SIGNATURE-----" {
 			inPgpSignature = true
 		}
 		return !inPgpSignature
@@ -176,46 +226,40 @@ func (self *TagsController) remoteDelete(tag *models.Tag) error {
 		},
 	)
 
-	self.c.Prompt(types.PromptOpts{
-		Title:               title,
-		InitialContent:      "origin",
-		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
-		HandleConfirm: func(upstream string) error {
-			confirmTitle := utils.ResolvePlaceholderString(
-				self.c.Tr.DeleteTagTitle,
-				map[string]string{
-					"tagName": tag.Name,
-				},
-			)
-			confirmPrompt := utils.ResolvePlaceholderString(
-				self.c.Tr.DeleteRemoteTagPrompt,
-				map[string]string{
-					"tagName":  tag.Name,
-					"upstream": upstream,
-				},
-			)
-
-			self.c.Confirm(types.ConfirmOpts{
-				Title:  confirmTitle,
-				Prompt: confirmPrompt,
-				HandleConfirm: func() error {
-					return self.c.WithInlineStatus(tag, types.ItemOperationDeleting, context.TAGS_CONTEXT_KEY, func(task gocui.Task) error {
-						self.c.LogAction(self.c.Tr.Actions.DeleteRemoteTag)
-						if err := self.c.Git().Remote.DeleteRemoteTag(task, upstream, tag.Name); err != nil {
-							return err
-						}
-						self.c.Toast(self.c.Tr.RemoteTagDeletedMessage)
-						self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
-						return nil
-					})
-				},
-			})
+	return self.selectRemote(title, self.tagStatusOnRemote(tag), func(upstream string) error {
+		confirmTitle := utils.ResolvePlaceholderString(
+			self.c.Tr.DeleteTagTitle,
+			map[string]string{
+				"tagName": tag.Name,
+			},
+		)
+		confirmPrompt := utils.ResolvePlaceholderString(
+			self.c.Tr.DeleteRemoteTagPrompt,
+			map[string]string{
+				"tagName":  tag.Name,
+				"upstream": upstream,
+			},
+		)
+
+		self.c.Confirm(types.ConfirmOpts{
+			Title:  confirmTitle,
+			Prompt: confirmPrompt,
+			HandleConfirm: func() error {
+				return self.c.WithInlineStatus(tag, types.ItemOperationDeleting, context.TAGS_CONTEXT_KEY, func(task gocui.Task) error {
+					self.c.LogAction(self.c.Tr.Actions.DeleteRemoteTag)
+					if err := self.c.Git().Remote.DeleteRemoteTag(task, upstream, tag.Name); err != nil {
+						return err
+					}
+					self.c.Helpers().Tags.UpdateRemoteTags(upstream, []string{tag.Name}, false)
+					self.c.Toast(self.c.Tr.RemoteTagDeletedMessage)
+					self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
+					return nil
+				})
+			},
+		})
 
-			return nil
-		},
+		return nil
 	})
-
-	return nil
 }
 
 func (self *TagsController) localAndRemoteDelete(tag *models.Tag) error {
@@ -226,50 +270,44 @@ func (self *TagsController) localAndRemoteDelete(tag *models.Tag) error {
 		},
 	)
 
-	self.c.Prompt(types.PromptOpts{
-		Title:               title,
-		InitialContent:      "origin",
-		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
-		HandleConfirm: func(upstream string) error {
-			confirmTitle := utils.ResolvePlaceholderString(
-				self.c.Tr.DeleteTagTitle,
-				map[string]string{
-					"tagName": tag.Name,
-				},
-			)
-			confirmPrompt := utils.ResolvePlaceholderString(
-				self.c.Tr.DeleteLocalAndRemoteTagPrompt,
-				map[string]string{
-					"tagName":  tag.Name,
-					"upstream": upstream,
-				},
-			)
-
-			self.c.Confirm(types.ConfirmOpts{
-				Title:  confirmTitle,
-				Prompt: confirmPrompt,
-				HandleConfirm: func() error {
-					return self.c.WithInlineStatus(tag, types.ItemOperationDeleting, context.TAGS_CONTEXT_KEY, func(task gocui.Task) error {
-						self.c.LogAction(self.c.Tr.Actions.DeleteRemoteTag)
-						if err := self.c.Git().Remote.DeleteRemoteTag(task, upstream, tag.Name); err != nil {
-							return err
-						}
-
-						self.c.LogAction(self.c.Tr.Actions.DeleteLocalTag)
-						if err := self.c.Git().Tag.LocalDelete(tag.Name); err != nil {
-							return err
-						}
-						self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
-						return nil
-					})
-				},
-			})
+	return self.selectRemote(title, self.tagStatusOnRemote(tag), func(upstream string) error {
+		confirmTitle := utils.ResolvePlaceholderString(
+			self.c.Tr.DeleteTagTitle,
+			map[string]string{
+				"tagName": tag.Name,
+			},
+		)
+		confirmPrompt := utils.ResolvePlaceholderString(
+			self.c.Tr.DeleteLocalAndRemoteTagPrompt,
+			map[string]string{
+				"tagName":  tag.Name,
+				"upstream": upstream,
+			},
+		)
+
+		self.c.Confirm(types.ConfirmOpts{
+			Title:  confirmTitle,
+			Prompt: confirmPrompt,
+			HandleConfirm: func() error {
+				return self.c.WithInlineStatus(tag, types.ItemOperationDeleting, context.TAGS_CONTEXT_KEY, func(task gocui.Task) error {
+					self.c.LogAction(self.c.Tr.Actions.DeleteRemoteTag)
+					if err := self.c.Git().Remote.DeleteRemoteTag(task, upstream, tag.Name); err != nil {
+						return err
+					}
+					self.c.Helpers().Tags.UpdateRemoteTags(upstream, []string{tag.Name}, false)
+
+					self.c.LogAction(self.c.Tr.Actions.DeleteLocalTag)
+					if err := self.c.Git().Tag.LocalDelete(tag.Name); err != nil {
+						return err
+					}
+					self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS}})
+					return nil
+				})
+			},
+		})
 
-			return nil
-		},
+		return nil
 	})
-
-	return nil
 }
 
 func (self *TagsController) delete(tag *models.Tag) error {
@@ -320,27 +358,132 @@ func (self *TagsController) push(tag *models.Tag) error {
 		},
 	)
 
-	self.c.Prompt(types.PromptOpts{
-		Title:               title,
-		InitialContent:      "origin",
-		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
-		HandleConfirm: func(response string) error {
-			return self.c.WithInlineStatus(tag, types.ItemOperationPushing, context.TAGS_CONTEXT_KEY, func(task gocui.Task) error {
-				self.c.LogAction(self.c.Tr.Actions.PushTag)
-				err := self.c.Git().Tag.Push(task, response, tag.Name)
-
-				// Render again to remove the inline status:
-				self.c.OnUIThread(func() error {
-					self.c.Contexts().Tags.HandleRender()
-					return nil
-				})
+	return self.selectRemote(title, self.tagStatusOnRemote(tag), func(remote string) error {
+		return self.c.WithInlineStatus(tag, types.ItemOperationPushing, context.TAGS_CONTEXT_KEY, func(task gocui.Task) error {
+			self.c.LogAction(self.c.Tr.Actions.PushTag)
+			err := self.c.Git().Tag.Push(task, remote, tag.Name)
+			if err == nil {
+				self.c.Helpers().Tags.UpdateRemoteTags(remote, []string{tag.Name}, true)
+			}
+
+			// Render again to remove the inline status:
+			self.c.OnUIThread(func() error {
+				self.c.Contexts().Tags.HandleRender()
+				return nil
+			})
 
+			return err
+		})
+	})
+}
+
+// Pushes all local tags that the chosen remote doesn't have. We list the
+// remote's tags afresh rather than relying on the ones shown in the tags
+// panel, which may be outdated.
+func (self *TagsController) pushUnpushed() error {
+	getStatus := func(remoteTags *set.Set[string]) string {
+		unpushedCount := len(lo.Filter(self.c.Model().Tags, func(tag *models.Tag, _ int) bool {
+			return !remoteTags.Includes(tag.Name)
+		}))
+		return utils.ResolvePlaceholderString(
+			self.c.Tr.RemoteUnpushedTagsCount,
+			map[string]string{
+				"count": strconv.Itoa(unpushedCount),
+			},
+		)
+	}
+
+	return self.selectRemote(self.c.Tr.PushUnpushedTagsTitle, getStatus, func(remote string) error {
+		return self.c.WithWaitingStatus(self.c.Tr.ListingRemoteTagsStatus, func(gocui.Task) error {
+			remoteTagNames, err := self.c.Git().Tag.RemoteTags(remote)
+			if err != nil {
 				return err
+			}
+			remoteTags := set.NewFromSlice(remoteTagNames)
+
+			unpushed := lo.FilterMap(self.c.Model().Tags, func(tag *models.Tag, _ int) (string, bool) {
+				return tag.Name, !remoteTags.Includes(tag.Name)
 			})
-		},
+
+			self.c.OnUIThread(func() error {
+				self.c.Model().RemoteTags[remote] = remoteTags
+				self.c.Contexts().Tags.HandleRender()
+
+				if len(unpushed) == 0 {
+					return errors.New(utils.ResolvePlaceholderString(
+						self.c.Tr.NoUnpushedTags,
+						map[string]string{
+							"remote": remote,
+						},
+					))
+				}
+
+				self.c.Confirm(types.ConfirmOpts{
+					Title: self.c.Tr.PushUnpushedTags,
+					Prompt: utils.ResolvePlaceholderString(
+						self.c.Tr.PushUnpushedTagsPrompt,
+						map[string]string{
+							"remote":   remote,
+							"tagNames": strings.Join(unpushed, "\n"),
+						},
+					),
+					HandleConfirm: func() error {
+						return self.c.WithWaitingStatus(self.c.Tr.PushingStatus, func(task gocui.Task) error {
+							self.c.LogAction(self.c.Tr.Actions.PushTags)
+							if err := self.c.Git().Tag.PushTags(task, remote, unpushed); err != nil {
+								return err
+							}
+							self.c.Helpers().Tags.UpdateRemoteTags(remote, unpushed, true)
+							self.c.Toast(utils.ResolvePlaceholderString(
+								self.c.Tr.TagsPushedMessage,
+								map[string]string{
+									"count":  strconv.Itoa(len(unpushed)),
+									"remote": remote,
+								},
+							))
+							return nil
+						})
+					},
+				})
+				return nil
+			})
+
+			return nil
+		})
 	})
+}
 
-	return nil
+// Shows a menu of the repo's remotes. Next to each remote we show what
+// getStatus returns for the remote's tags, if we've listed them.
+func (self *TagsController) selectRemote(title string, getStatus func(remoteTags *set.Set[string]) string, onSelect func(remote string) error) error {
+	remotes := self.c.Model().Remotes
+	if len(remotes) == 0 {
+		return errors.New(self.c.Tr.NoRemotes)
+	}
+
+	menuItems := lo.Map(remotes, func(remote *models.Remote, _ int) *types.MenuItem {
+		status := ""
+		if remoteTags, ok := self.c.Model().RemoteTags[remote.Name]; ok {
+			status = getStatus(remoteTags)
+		}
+		return &types.MenuItem{
+			LabelColumns: []string{remote.Name, style.FgCyan.Sprint(status)},
+			OnPress: func() error {
+				return onSelect(remote.Name)
+			},
+		}
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: title,
+		Items: menuItems,
+	})
+}
+
+func (self *TagsController) tagStatusOnRemote(tag *models.Tag) func(remoteTags *set.Set[string]) string {
+	return func(remoteTags *set.Set[string]) string {
+		return lo.Ternary(remoteTags.Includes(tag.Name), self.c.Tr.RemoteHasTag, self.c.Tr.RemoteLacksTag)
+	}
 }
 
 func (self *TagsController) createResetMenu(tag *models.Tag) error {
@@ -354,6 +497,90 @@ func (self *TagsController) create() error {
 	})
 }
 
+// With a range of tags selected, generates release notes for the commits
+// between the bottom and the top one; with a single tag selected, for the
+// commits since the tag preceding it in history.
+func (self *TagsController) generateReleaseNotes(selectedTags []*models.Tag, startIdx int, endIdx int) error {
+	to := selectedTags[0]
+	from := ""
+	if len(selectedTags) > 1 {
+		from = selectedTags[len(selectedTags)-1].Name
+	} else {
+		from = self.c.Git().Tag.PreviousTag(to.Name)
+	}
+
+	if from == "" {
+		return (&ReleaseNotesMenuAction{c: self.c}).Call("", to.FullRefName(), to.Name)
+	}
+
+	return (&ReleaseNotesMenuAction{c: self.c}).Call("refs/tags/"+from, to.FullRefName(), from+".."+to.Name)
+}
+
+// Lets the user edit the title and notes of a release for the tag, pre-filled
+// with release notes for the commits since the previous tag, and then creates
+// it through the hosting service's API. Without an API token we open the
+// service's new release page instead.
+func (self *TagsController) createRelease(tag *models.Tag) error {
+	return self.c.WithWaitingStatus(self.c.Tr.GeneratingReleaseNotesStatus, func(gocui.Task) error {
+		from := self.c.Git().Tag.PreviousTag(tag.Name)
+		if from != "" {
+			from = "refs/tags/" + from
+		}
+		commits, err := self.c.Git().Commit.GetCommitSummariesInRange(from, tag.FullRefName())
+		if err != nil {
+			return err
+		}
+
+		notes := ""
+		if len(commits) > 0 {
+			notes = strings.TrimSuffix(formatReleaseNotes(commits, releaseNotesByType), "\n")
+		}
+
+		self.c.OnUIThread(func() error {
+			self.c.Helpers().Commits.OpenCommitMessagePanel(
+				&helpers.OpenCommitMessagePanelOpts{
+					CommitIndex:      context.NoCommitIndex,
+					InitialMessage:   tag.Name + "\n" + notes,
+					SummaryTitle:     self.c.Tr.ReleaseTitleTitle,
+					DescriptionTitle: self.c.Tr.ReleaseNotesTitle,
+					PreserveMessage:  false,
+					OnConfirm: func(title string, notes string) error {
+						return self.submitRelease(tag, title, notes)
+					},
+				},
+			)
+			return nil
+		})
+
+		return nil
+	})
+}
+
+func (self *TagsController) submitRelease(tag *models.Tag, title string, notes string) error {
+	// Looking up the token may run commands, so it's done on the worker
+	return self.c.WithWaitingStatus(self.c.Tr.CreatingReleaseStatus, func(gocui.Task) error {
+		token := self.c.Helpers().Host.GetReleaseAPIToken()
+		if token == "" {
+			url, err := self.c.Helpers().Host.GetNewReleaseURL(tag.Name, title, notes)
+			if err != nil {
+				return err
+			}
+
+			self.c.LogAction(self.c.Tr.Actions.OpenNewReleasePage)
+			return self.c.OS().OpenLink(url)
+		}
+
+		self.c.LogAction(self.c.Tr.Actions.CreateRelease)
+		url, err := self.c.Helpers().Host.CreateRelease(tag.Name, title, notes, token)
+		if err != nil {
+			return err
+		}
+
+		self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.ReleaseCreated, map[string]string{"url": url}))
+		return nil
+	})
+}
+
 func (self *TagsController) context() *context.TagsContext {
 	return self.c.Contexts().Tags
 }
@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Switches between the built-in theme presets and the theme files in the
+// themes directory. The selected theme is previewed while the menu is open,
+// and the choice is kept in the app state, so it also applies the next time
+// lazygit starts.
+type ThemesMenuAction struct {
+	c *ControllerCommon
+}
+
+func (self *ThemesMenuAction) Call() error {
+	// A preset set in the config wins over the one chosen here, so choosing
+	// another preset wouldn't do anything
+	pinnedPreset := ""
+	if themeConfig, err := self.c.GetConfig().GetThemeConfig(""); err == nil && themeConfig.Preset != "default" {
+		pinnedPreset = themeConfig.Preset
+	}
+
+	activeTheme := self.c.GetAppState().ActiveTheme
+	if activeTheme == "" || (pinnedPreset != "" && config.IsThemePreset(activeTheme)) {
+		activeTheme = self.c.UserConfig().Gui.Theme.Preset
+	}
+
+	names := self.c.GetConfig().GetThemeNames()
+	themeNames := map[*types.MenuItem]string{}
+	menuItems := lo.Map(names, func(name string, _ int) *types.MenuItem {
+		item := &types.MenuItem{
+			Label:   name,
+			OnPress: func() error { return self.switchTo(name) },
+			Widget:  types.MakeMenuRadioButton(activeTheme == name),
+		}
+		if pinnedPreset != "" && name != pinnedPreset && config.IsThemePreset(name) {
+			item.DisabledReason = &types.DisabledReason{Text: fmt.Sprintf(self.c.Tr.ThemePresetPinned, pinnedPreset)}
+		}
+		themeNames[item] = name
+		return item
+	})
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.SwitchThemeTitle,
+		Items: menuItems,
+		// Start with the active theme, so that opening the menu doesn't change
+		// the colors yet
+		SelectedIndex: max(lo.IndexOf(names, activeTheme), 0),
+		OnSelectionChange: func(item *types.MenuItem) {
+			name, ok := themeNames[item]
+			if !ok {
+				self.c.RenderTheme(self.c.UserConfig().Gui.Theme)
+				return
+			}
+			self.preview(name)
+		},
+		OnClose: func() {
+			self.c.RenderTheme(self.c.UserConfig().Gui.Theme)
+		},
+	})
+}
+
+func (self *ThemesMenuAction) preview(name string) {
+	themeConfig, err := self.c.GetConfig().GetThemeConfig(name)
+	if err != nil {
+		// Pressing the item shows the error
+		self.c.Log.Error(err)
+		return
+	}
+
+	self.c.RenderTheme(themeConfig)
+}
+
+func (self *ThemesMenuAction) switchTo(name string) error {
+	self.c.LogAction(self.c.Tr.Actions.SwitchTheme)
+	oldConfig := self.c.UserConfig()
+	if err := self.c.GetConfig().SetActiveTheme(name); err != nil {
+		return err
+	}
+	if err := self.c.ApplyChangedUserConfig(oldConfig); err != nil {
+		return err
+	}
+	self.c.RenderTheme(self.c.UserConfig().Gui.Theme)
+
+	self.c.Toast(fmt.Sprintf(self.c.Tr.SwitchedToTheme, name))
+	return nil
+}
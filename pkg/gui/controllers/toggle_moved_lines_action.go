@@ -0,0 +1,32 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+type ToggleMovedLinesAction struct {
+	c *ControllerCommon
+}
+
+func (self *ToggleMovedLinesAction) Call() error {
+	self.c.UserConfig().Git.ColorMoved = lo.Ternary(self.c.Git().Config.GetColorMoved(), "never", "always")
+
+	if _, ok := self.c.Context().Current().(types.IPatchExplorerContext); ok {
+		// The staging and patch building views render their patches themselves,
+		// so re-render them rather than reloading the diff
+		for _, context := range []types.IPatchExplorerContext{
+			self.c.Contexts().Staging,
+			self.c.Contexts().StagingSecondary,
+			self.c.Contexts().CustomPatchBuilder,
+		} {
+			if context.GetState() != nil {
+				context.Render()
+			}
+		}
+		return nil
+	}
+
+	self.c.Context().CurrentSide().HandleFocus(types.OnFocusOpts{})
+	return nil
+}
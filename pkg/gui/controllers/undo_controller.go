@@ -3,8 +3,10 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -81,6 +83,17 @@ func (self *UndoController) reflogUndo() error {
 		return errors.New(self.c.Tr.CantUndoWhileRebasing)
 	}
 
+	// Discarded changes aren't recorded in the reflog, so we keep backups of
+	// them separately; if the last discard happened after the last reflog
+	// entry, that's what we undo
+	discardBackup, err := self.c.Git().Snapshot.DiscardBackupToUndo()
+	if err != nil {
+		return err
+	}
+	if discardBackup != nil {
+		return self.undoDiscard(discardBackup)
+	}
+
 	return self.parseReflogForActions(func(counter int, action reflogAction) (bool, error) {
 		if counter != 0 {
 			return false, nil
@@ -137,6 +150,24 @@ func (self *UndoController) reflogUndo() error {
 	})
 }
 
+func (self *UndoController) undoDiscard(backup *git_commands.Snapshot) error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.Actions.Undo,
+		Prompt: fmt.Sprintf(self.c.Tr.UndoDiscardPrompt, strings.Join(backup.Paths, ", ")),
+		HandleConfirm: func() error {
+			self.c.LogAction(self.c.Tr.Actions.UndoDiscard)
+			if err := self.c.Git().Snapshot.RestoreDiscardBackup(backup); err != nil {
+				return err
+			}
+
+			self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+			return nil
+		},
+	})
+
+	return nil
+}
+
 func (self *UndoController) reflogRedo() error {
 	redoEnvVars := []string{"GIT_REFLOG_ACTION=[lazygit redo]"}
 	redoingStatus := self.c.Tr.RedoingStatus
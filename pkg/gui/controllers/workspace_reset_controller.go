@@ -36,6 +36,10 @@ func (self *FilesController) createResetMenu() error {
 						Title:  self.c.Tr.Actions.NukeWorkingTree,
 						Prompt: self.c.Tr.NukeTreeConfirmation,
 						HandleConfirm: func() error {
+							if err := self.c.Helpers().Snapshot.SnapshotWorkingTree(self.c.Tr.Actions.NukeWorkingTree, true); err != nil {
+								return err
+							}
+
 							self.c.LogAction(self.c.Tr.Actions.NukeWorkingTree)
 							if err := self.c.Git().WorkingTree.ResetAndClean(); err != nil {
 								return err
@@ -62,6 +66,10 @@ func (self *FilesController) createResetMenu() error {
 				red.Sprint("git checkout -- ."),
 			},
 			OnPress: func() error {
+				if err := self.c.Helpers().Snapshot.SnapshotWorkingTree(self.c.Tr.Actions.DiscardUnstagedFileChanges, false); err != nil {
+					return err
+				}
+
 				self.c.LogAction(self.c.Tr.Actions.DiscardUnstagedFileChanges)
 				if err := self.c.Git().WorkingTree.DiscardAnyUnstagedFileChanges(); err != nil {
 					return err
@@ -80,6 +88,10 @@ func (self *FilesController) createResetMenu() error {
 				red.Sprint("git clean -fd"),
 			},
 			OnPress: func() error {
+				if err := self.c.Helpers().Snapshot.SnapshotWorkingTree(self.c.Tr.Actions.RemoveUntrackedFiles, true); err != nil {
+					return err
+				}
+
 				self.c.LogAction(self.c.Tr.Actions.RemoveUntrackedFiles)
 				if err := self.c.Git().WorkingTree.RemoveUntrackedFiles(); err != nil {
 					return err
@@ -103,6 +115,9 @@ func (self *FilesController) createResetMenu() error {
 				if !self.c.Helpers().WorkingTree.IsWorkingTreeDirty() {
 					return errors.New(self.c.Tr.NoTrackedStagedFilesStash)
 				}
+				if err := self.c.Helpers().Snapshot.SnapshotWorkingTree(self.c.Tr.Actions.RemoveStagedFiles, false); err != nil {
+					return err
+				}
 				if err := self.c.Git().Stash.SaveStagedChanges("[lazygit] tmp stash"); err != nil {
 					return err
 				}
@@ -164,6 +179,10 @@ func (self *FilesController) createResetMenu() error {
 						Title:  self.c.Tr.Actions.HardReset,
 						Prompt: self.c.Tr.ResetHardConfirmation,
 						HandleConfirm: func() error {
+							if err := self.c.Helpers().Snapshot.SnapshotWorkingTree(self.c.Tr.Actions.HardReset, false); err != nil {
+								return err
+							}
+
 							self.c.LogAction(self.c.Tr.Actions.HardReset)
 							if err := self.c.Git().WorkingTree.ResetHard("HEAD"); err != nil {
 								return err
@@ -178,6 +197,13 @@ func (self *FilesController) createResetMenu() error {
 			},
 			Key: 'h',
 		},
+		{
+			Label:     self.c.Tr.RestoreSnapshot,
+			OnPress:   self.c.Helpers().Snapshot.CreateRestoreMenu,
+			OpensMenu: true,
+			Key:       'r',
+			Tooltip:   self.c.Tr.RestoreSnapshotTooltip,
+		},
 	}
 
 	return self.c.Menu(types.CreateMenuOptions{Title: "", Items: menuItems})
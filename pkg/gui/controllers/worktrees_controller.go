@@ -62,6 +62,13 @@ func (self *WorktreesController) GetKeybindings(opts types.KeybindingsOpts) []*t
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.OpenInEditor,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.OpenInTmux),
+			Handler:           self.withItem(self.openInTmux),
+			GetDisabledReason: self.require(self.singleItemSelected(), self.c.Helpers().Repos.OpenInTmuxDisabledReason),
+			Description:       self.c.Tr.OpenInTmux,
+			Tooltip:           self.c.Tr.OpenInTmuxTooltip,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Remove),
 			Handler:           self.withItem(self.remove),
@@ -140,6 +147,10 @@ func (self *WorktreesController) open(worktree *models.Worktree) error {
 	return self.c.Helpers().Files.OpenDirInEditor(worktree.Path)
 }
 
+func (self *WorktreesController) openInTmux(worktree *models.Worktree) error {
+	return self.c.Helpers().Repos.OpenInTmux(worktree.Path)
+}
+
 func (self *WorktreesController) context() *context.WorktreesContext {
 	return self.c.Contexts().Worktrees
 }
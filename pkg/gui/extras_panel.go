@@ -3,7 +3,7 @@ package gui
 import (
 	"io"
 
-	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -16,7 +16,7 @@ func (gui *Gui) handleCreateExtrasMenuPanel() error {
 				Label: gui.c.Tr.ToggleShowCommandLog,
 				OnPress: func() error {
 					currentContext := gui.c.Context().CurrentStatic()
-					if gui.c.State().GetShowExtrasWindow() && currentContext.GetKey() == context.COMMAND_LOG_CONTEXT_KEY {
+					if gui.c.State().GetShowExtrasWindow() && currentContext.GetKind() == types.EXTRAS_CONTEXT {
 						gui.c.Context().Pop()
 					}
 					show := !gui.c.State().GetShowExtrasWindow()
@@ -30,62 +30,91 @@ func (gui *Gui) handleCreateExtrasMenuPanel() error {
 				Label:   gui.c.Tr.FocusCommandLog,
 				OnPress: gui.handleFocusCommandLog,
 			},
+			{
+				Label:   gui.c.Tr.ShowBackgroundTasks,
+				OnPress: gui.handleShowBackgroundTasks,
+			},
 		},
 	})
 }
 
 func (gui *Gui) handleFocusCommandLog() error {
+	return gui.handleFocusExtrasView("extras")
+}
+
+func (gui *Gui) handleFocusExtrasView(viewName string) error {
+	context, ok := gui.helpers.View.ContextForView(viewName)
+	if !ok {
+		return nil
+	}
+
 	gui.c.State().SetShowExtrasWindow(true)
 	// TODO: is this necessary? Can't I just call 'return from context'?
-	gui.State.Contexts.CommandLog.SetParentContext(gui.c.Context().CurrentSide())
-	gui.c.Context().Push(gui.State.Contexts.CommandLog, types.OnFocusOpts{})
+	context.SetParentContext(gui.c.Context().CurrentSide())
+	gui.c.Context().Push(context, types.OnFocusOpts{})
 	return nil
 }
 
+// The views shown as tabs of the extras window
+func (gui *Gui) extrasViews() []*gocui.View {
+	return []*gocui.View{gui.Views.Extras, gui.Views.CommandOutput, gui.Views.AppLog}
+}
+
+// The context of the extras window's selected tab
+func (gui *Gui) currentExtrasContext() types.Context {
+	return gui.helpers.Window.GetContextForWindow("extras")
+}
+
 func (gui *Gui) scrollUpExtra() error {
-	gui.Views.Extras.Autoscroll = false
+	view := gui.currentExtrasContext().GetView()
+	view.Autoscroll = false
 
-	gui.scrollUpView(gui.Views.Extras)
+	gui.scrollUpView(view)
 
 	return nil
 }
 
 func (gui *Gui) scrollDownExtra() error {
-	gui.Views.Extras.Autoscroll = false
+	view := gui.currentExtrasContext().GetView()
+	view.Autoscroll = false
 
-	gui.scrollDownView(gui.Views.Extras)
+	gui.scrollDownView(view)
 
 	return nil
 }
 
 func (gui *Gui) pageUpExtrasPanel() error {
-	gui.Views.Extras.Autoscroll = false
+	context := gui.currentExtrasContext()
+	context.GetView().Autoscroll = false
 
-	gui.Views.Extras.ScrollUp(gui.Contexts().CommandLog.GetViewTrait().PageDelta())
+	context.GetView().ScrollUp(context.GetViewTrait().PageDelta())
 
 	return nil
 }
 
 func (gui *Gui) pageDownExtrasPanel() error {
-	gui.Views.Extras.Autoscroll = false
+	context := gui.currentExtrasContext()
+	context.GetView().Autoscroll = false
 
-	gui.Views.Extras.ScrollDown(gui.Contexts().CommandLog.GetViewTrait().PageDelta())
+	context.GetView().ScrollDown(context.GetViewTrait().PageDelta())
 
 	return nil
 }
 
 func (gui *Gui) goToExtrasPanelTop() error {
-	gui.Views.Extras.Autoscroll = false
+	view := gui.currentExtrasContext().GetView()
+	view.Autoscroll = false
 
-	gui.Views.Extras.ScrollUp(gui.Views.Extras.ViewLinesHeight())
+	view.ScrollUp(view.ViewLinesHeight())
 
 	return nil
 }
 
 func (gui *Gui) goToExtrasPanelBottom() error {
-	gui.Views.Extras.Autoscroll = true
+	view := gui.currentExtrasContext().GetView()
+	view.Autoscroll = true
 
-	gui.Views.Extras.ScrollDown(gui.Views.Extras.ViewLinesHeight())
+	view.ScrollDown(view.ViewLinesHeight())
 
 	return nil
 }
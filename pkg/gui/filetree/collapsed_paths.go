@@ -1,6 +1,10 @@
 package filetree
 
-import "github.com/jesseduffield/generics/set"
+import (
+	"slices"
+
+	"github.com/jesseduffield/generics/set"
+)
 
 type CollapsedPaths struct {
 	collapsedPaths *set.Set[string]
@@ -41,3 +45,15 @@ func (self *CollapsedPaths) ExpandAll() {
 	// Could be cleaner if Set had a Clear() method...
 	self.collapsedPaths.RemoveSlice(self.collapsedPaths.ToSlice())
 }
+
+// Returns the collapsed paths in sorted order, for persisting them
+func (self *CollapsedPaths) Paths() []string {
+	paths := self.collapsedPaths.ToSlice()
+	slices.Sort(paths)
+	return paths
+}
+
+func (self *CollapsedPaths) SetPaths(paths []string) {
+	self.ExpandAll()
+	self.collapsedPaths.Add(paths...)
+}
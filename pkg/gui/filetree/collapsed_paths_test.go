@@ -0,0 +1,21 @@
+package filetree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollapsedPathsSetPaths(t *testing.T) {
+	collapsedPaths := NewCollapsedPaths()
+	collapsedPaths.Collapse("old")
+
+	collapsedPaths.SetPaths([]string{"dir2", "dir1/sub", "dir1"})
+
+	assert.False(t, collapsedPaths.IsCollapsed("old"))
+	assert.True(t, collapsedPaths.IsCollapsed("dir1/sub"))
+	assert.Equal(t, []string{"dir1", "dir1/sub", "dir2"}, collapsedPaths.Paths())
+
+	collapsedPaths.SetPaths(nil)
+	assert.Equal(t, []string{}, collapsedPaths.Paths())
+}
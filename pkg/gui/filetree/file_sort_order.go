@@ -0,0 +1,123 @@
+package filetree
+
+import (
+	"slices"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+)
+
+// These values are persisted in the app state, so don't change them
+const (
+	SortFilesByName             = "name"
+	SortFilesByStatus           = "status"
+	SortFilesByModificationTime = "modificationTime"
+	SortFilesByDiffSize         = "diffSize"
+)
+
+// Sorts the children of each node according to the given sort order. The
+// tree is expected to be sorted by name already; since we use a stable sort,
+// name ordering is retained among nodes that compare equal. In tree mode,
+// directories are still shown before files, and a directory is ranked by the
+// files it contains.
+func sortFileNodes(node *Node[models.File], sortOrder string, recurse bool) {
+	if sortOrder == SortFilesByName || sortOrder == "" || node.IsFile() {
+		return
+	}
+
+	compare := fileNodeComparator(sortOrder)
+	slices.SortStableFunc(node.Children, func(a, b *Node[models.File]) int {
+		if !a.IsFile() && b.IsFile() {
+			return -1
+		}
+		if a.IsFile() && !b.IsFile() {
+			return 1
+		}
+
+		return compare(a, b)
+	})
+
+	if recurse {
+		for _, child := range node.Children {
+			sortFileNodes(child, sortOrder, recurse)
+		}
+	}
+}
+
+func fileNodeComparator(sortOrder string) func(a, b *Node[models.File]) int {
+	switch sortOrder {
+	case SortFilesByStatus:
+		return func(a, b *Node[models.File]) int {
+			return statusRank(a) - statusRank(b)
+		}
+	case SortFilesByModificationTime:
+		return func(a, b *Node[models.File]) int {
+			// most recently modified first
+			return latestModTime(b).Compare(latestModTime(a))
+		}
+	case SortFilesByDiffSize:
+		return func(a, b *Node[models.File]) int {
+			// largest diff first
+			return diffSize(b) - diffSize(a)
+		}
+	default:
+		return func(a, b *Node[models.File]) int { return 0 }
+	}
+}
+
+// Lower ranks are shown first. A directory takes the lowest rank of the files
+// inside it.
+func statusRank(node *Node[models.File]) int {
+	if node.IsFile() {
+		return fileStatusRank(node.File)
+	}
+
+	rank := fileStatusRank(nil)
+	for _, child := range node.Children {
+		rank = min(rank, statusRank(child))
+	}
+	return rank
+}
+
+func fileStatusRank(file *models.File) int {
+	switch {
+	case file == nil:
+		return 5
+	case file.HasMergeConflicts:
+		return 0
+	case file.Tracked && file.HasUnstagedChanges:
+		return 1
+	case file.HasStagedChanges:
+		return 2
+	case !file.Tracked:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func latestModTime(node *Node[models.File]) time.Time {
+	if node.IsFile() {
+		return node.File.ModTime
+	}
+
+	var latest time.Time
+	for _, child := range node.Children {
+		if modTime := latestModTime(child); modTime.After(latest) {
+			latest = modTime
+		}
+	}
+	return latest
+}
+
+func diffSize(node *Node[models.File]) int {
+	if node.IsFile() {
+		return node.File.LinesAdded + node.File.LinesDeleted
+	}
+
+	size := 0
+	for _, child := range node.Children {
+		size += diffSize(child)
+	}
+	return size
+}
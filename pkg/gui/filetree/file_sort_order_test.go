@@ -0,0 +1,72 @@
+package filetree
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortFileNodes(t *testing.T) {
+	now := time.Now()
+	files := []*models.File{
+		{Path: "a", Tracked: true, HasStagedChanges: true, LinesAdded: 1, ModTime: now.Add(-time.Hour)},
+		{Path: "b", Tracked: false, LinesAdded: 10, ModTime: now},
+		{Path: "c", Tracked: true, HasMergeConflicts: true, HasUnstagedChanges: true, LinesAdded: 2, LinesDeleted: 2},
+		{Path: "d", Tracked: true, HasUnstagedChanges: true, LinesDeleted: 3, ModTime: now.Add(-time.Minute)},
+		{Path: "dir/e", Tracked: true, HasUnstagedChanges: true, LinesAdded: 1, ModTime: now.Add(-2 * time.Hour)},
+		{Path: "dir/f", Tracked: false, LinesAdded: 20, ModTime: now.Add(-3 * time.Hour)},
+	}
+
+	scenarios := []struct {
+		name         string
+		sortOrder    string
+		expectedFlat []string
+		expectedTree []string
+	}{
+		{
+			name:         "by name",
+			sortOrder:    SortFilesByName,
+			expectedFlat: []string{"c", "dir/e", "a", "d", "dir/f", "b"},
+			expectedTree: []string{"dir", "dir/e", "dir/f", "a", "b", "c", "d"},
+		},
+		{
+			name:         "by status",
+			sortOrder:    SortFilesByStatus,
+			expectedFlat: []string{"c", "dir/e", "d", "a", "dir/f", "b"},
+			expectedTree: []string{"dir", "dir/e", "dir/f", "c", "d", "a", "b"},
+		},
+		{
+			name:         "by modification time",
+			sortOrder:    SortFilesByModificationTime,
+			expectedFlat: []string{"b", "d", "a", "dir/e", "dir/f", "c"},
+			expectedTree: []string{"dir", "dir/e", "dir/f", "b", "d", "a", "c"},
+		},
+		{
+			name:         "by diff size",
+			sortOrder:    SortFilesByDiffSize,
+			expectedFlat: []string{"dir/f", "b", "c", "d", "dir/e", "a"},
+			expectedTree: []string{"dir", "dir/f", "dir/e", "b", "c", "d", "a"},
+		},
+	}
+
+	paths := func(root *Node[models.File]) []string {
+		return lo.Map(root.Flatten(NewCollapsedPaths())[1:], func(node *Node[models.File], _ int) string {
+			return node.GetPath()
+		})
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			flat := BuildFlatTreeFromFiles(files, false)
+			sortFileNodes(flat, s.sortOrder, false)
+			assert.Equal(t, s.expectedFlat, paths(flat))
+
+			tree := BuildTreeFromFiles(files, false)
+			sortFileNodes(tree, s.sortOrder, true)
+			assert.Equal(t, s.expectedTree, paths(tree))
+		})
+	}
+}
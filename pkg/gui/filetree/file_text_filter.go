@@ -0,0 +1,45 @@
+package filetree
+
+import (
+	"path"
+	"strings"
+
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/sahilm/fuzzy"
+	"github.com/samber/lo"
+)
+
+// Returns the files whose path matches the filter. If the filter contains glob
+// characters it is matched as a glob against the whole path, or against the
+// file name if the filter doesn't contain a slash. Otherwise we do a substring
+// or fuzzy match on the path, like we do for filtering other lists.
+func filterFilesByText(files []*models.File, filter string, useFuzzySearch bool) []*models.File {
+	if isGlob(filter) {
+		return lo.Filter(files, func(file *models.File, _ int) bool {
+			return matchesGlob(file.Path, filter)
+		})
+	}
+
+	paths := lo.Map(files, func(file *models.File, _ int) string { return file.Path })
+	matchingIndices := set.NewFromSlice(lo.Map(utils.Find(filter, paths, useFuzzySearch), func(match fuzzy.Match, _ int) int {
+		return match.Index
+	}))
+	return lo.Filter(files, func(_ *models.File, index int) bool {
+		return matchingIndices.Includes(index)
+	})
+}
+
+func isGlob(filter string) bool {
+	return strings.ContainsAny(filter, "*?[")
+}
+
+func matchesGlob(filePath string, pattern string) bool {
+	if !strings.Contains(pattern, "/") {
+		filePath = path.Base(filePath)
+	}
+
+	matched, err := path.Match(pattern, filePath)
+	return err == nil && matched
+}
@@ -0,0 +1,65 @@
+package filetree
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterFilesByText(t *testing.T) {
+	files := []*models.File{
+		{Path: "cmd/main.go"},
+		{Path: "pkg/gui/gui.go"},
+		{Path: "pkg/gui/gui_test.go"},
+		{Path: "docs/Config.md"},
+		{Path: "README.md"},
+	}
+
+	scenarios := []struct {
+		name           string
+		filter         string
+		useFuzzySearch bool
+		expected       []string
+	}{
+		{
+			name:     "substring",
+			filter:   "gui",
+			expected: []string{"pkg/gui/gui.go", "pkg/gui/gui_test.go"},
+		},
+		{
+			name:     "substring is case insensitive",
+			filter:   "readme",
+			expected: []string{"README.md"},
+		},
+		{
+			name:           "fuzzy keeps the original order",
+			filter:         "pgg",
+			useFuzzySearch: true,
+			expected:       []string{"pkg/gui/gui.go", "pkg/gui/gui_test.go"},
+		},
+		{
+			name:     "glob without slash matches the file name",
+			filter:   "*.md",
+			expected: []string{"docs/Config.md", "README.md"},
+		},
+		{
+			name:     "glob with slash matches the whole path",
+			filter:   "pkg/*/*_test.go",
+			expected: []string{"pkg/gui/gui_test.go"},
+		},
+		{
+			name:     "no matches",
+			filter:   "*.rs",
+			expected: []string{},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			result := filterFilesByText(files, s.filter, s.useFuzzySearch)
+			assert.Equal(t, s.expected, lo.Map(result, func(file *models.File, _ int) string { return file.Path }))
+		})
+	}
+}
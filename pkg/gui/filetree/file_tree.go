@@ -46,8 +46,12 @@ type IFileTree interface {
 	GetFile(path string) *models.File
 	GetAllItems() []*FileNode
 	GetAllFiles() []*models.File
-	GetFilter() FileTreeDisplayFilter
+	GetStatusFilter() FileTreeDisplayFilter
 	GetRoot() *FileNode
+	SetSortOrder(sortOrder string)
+	GetSortOrder() string
+	SetTextFilter(filter string, useFuzzySearch bool)
+	GetTextFilter() string
 }
 
 type FileTree struct {
@@ -56,6 +60,9 @@ type FileTree struct {
 	showTree       bool
 	common         *common.Common
 	filter         FileTreeDisplayFilter
+	sortOrder      string
+	textFilter     string
+	useFuzzy       bool
 	collapsedPaths *CollapsedPaths
 }
 
@@ -67,6 +74,7 @@ func NewFileTree(getFiles func() []*models.File, common *common.Common, showTree
 		common:         common,
 		showTree:       showTree,
 		filter:         DisplayAll,
+		sortOrder:      SortFilesByName,
 		collapsedPaths: NewCollapsedPaths(),
 	}
 }
@@ -80,6 +88,15 @@ func (self *FileTree) ExpandToPath(path string) {
 }
 
 func (self *FileTree) getFilesForDisplay() []*models.File {
+	files := self.getFilesForStatusFilter()
+	if self.textFilter == "" {
+		return files
+	}
+
+	return filterFilesByText(files, self.textFilter, self.useFuzzy)
+}
+
+func (self *FileTree) getFilesForStatusFilter() []*models.File {
 	switch self.filter {
 	case DisplayAll:
 		return self.getFiles()
@@ -113,6 +130,25 @@ func (self *FileTree) SetStatusFilter(filter FileTreeDisplayFilter) {
 	self.SetTree()
 }
 
+func (self *FileTree) SetTextFilter(filter string, useFuzzySearch bool) {
+	self.textFilter = filter
+	self.useFuzzy = useFuzzySearch
+	self.SetTree()
+}
+
+func (self *FileTree) GetTextFilter() string {
+	return self.textFilter
+}
+
+func (self *FileTree) SetSortOrder(sortOrder string) {
+	self.sortOrder = sortOrder
+	self.SetTree()
+}
+
+func (self *FileTree) GetSortOrder() string {
+	return self.sortOrder
+}
+
 func (self *FileTree) ToggleShowTree() {
 	self.showTree = !self.showTree
 	self.SetTree()
@@ -174,6 +210,7 @@ func (self *FileTree) SetTree() {
 	} else {
 		self.tree = BuildFlatTreeFromFiles(filesForDisplay, showRootItem)
 	}
+	sortFileNodes(self.tree, self.sortOrder, self.showTree)
 }
 
 func (self *FileTree) IsCollapsed(path string) bool {
@@ -210,6 +247,6 @@ func (self *FileTree) CollapsedPaths() *CollapsedPaths {
 	return self.collapsedPaths
 }
 
-func (self *FileTree) GetFilter() FileTreeDisplayFilter {
+func (self *FileTree) GetStatusFilter() FileTreeDisplayFilter {
 	return self.filter
 }
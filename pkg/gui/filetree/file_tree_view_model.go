@@ -220,3 +220,19 @@ func (self *FileTreeViewModel) ExpandAll() {
 		self.SetSelectedLineIdx(index)
 	}
 }
+
+// Keep the selected item selected when changing the sort order
+func (self *FileTreeViewModel) SetSortOrder(sortOrder string) {
+	selectedNode := self.GetSelected()
+
+	self.IFileTree.SetSortOrder(sortOrder)
+
+	if selectedNode == nil {
+		return
+	}
+
+	index, found := self.GetIndexForPath(selectedNode.path)
+	if found {
+		self.SetSelectedLineIdx(index)
+	}
+}
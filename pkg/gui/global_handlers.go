@@ -153,6 +153,26 @@ func (gui *Gui) handleCopySelectedSideContextItemToClipboard() error {
 	return gui.handleCopySelectedSideContextItemToClipboardWithTruncation(-1)
 }
 
+// If the user has configured copy templates for branches, offer them in a menu
+// alongside the branch name; otherwise just copy the branch name.
+func (gui *Gui) handleCopySelectedBranchToClipboard() error {
+	branch := gui.State.Contexts.Branches.GetSelected()
+	if branch == nil || len(gui.UserConfig().CopyTemplates.Branches) == 0 {
+		return gui.handleCopySelectedSideContextItemToClipboard()
+	}
+
+	copyNameItem := &types.MenuItem{
+		Label:   gui.c.Tr.BranchName,
+		OnPress: gui.handleCopySelectedSideContextItemToClipboard,
+		Key:     'n',
+	}
+
+	return gui.c.Menu(types.CreateMenuOptions{
+		Title: gui.c.Tr.CopyToClipboardMenu,
+		Items: append([]*types.MenuItem{copyNameItem}, gui.helpers.CopyTemplates.BranchMenuItems(branch)...),
+	})
+}
+
 func (gui *Gui) handleCopySelectedSideContextItemCommitHashToClipboard() error {
 	return gui.handleCopySelectedSideContextItemToClipboardWithTruncation(
 		gui.UserConfig().Git.TruncateCopiedCommitHashesTo)
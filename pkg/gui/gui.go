@@ -6,13 +6,17 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazycore/pkg/boxlayout"
 	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
@@ -30,6 +34,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/diffing"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/filtering"
 	"github.com/jesseduffield/lazygit/pkg/gui/modes/marked_base_commit"
+	"github.com/jesseduffield/lazygit/pkg/gui/modes/marked_commits"
 	"github.com/jesseduffield/lazygit/pkg/gui/popup"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
@@ -58,6 +63,16 @@ var OverlappingEdges = false
 
 type Repo string
 
+// A pty task that we remember so that we can run it again when the main view
+// is resized
+type ptyTask struct {
+	// A copy of the command as it was before we started it; an exec.Cmd can
+	// only be run once
+	cmd       *exec.Cmd
+	getPrefix func() string
+	key       string
+}
+
 // Gui wraps the gocui Gui object which handles rendering and events
 type Gui struct {
 	*common.Common
@@ -84,7 +99,12 @@ type Gui struct {
 	// from within a pty. The point of keeping track of them is so that if we re-size
 	// the window, we can tell the pty it needs to resize accordingly.
 	viewPtmxMap map[string]*os.File
-	stopChan    chan struct{}
+	// pty tasks whose output depends on the width of the view they're rendered
+	// in, so that we can run them again when the view is resized
+	viewPtyTasksToRerunOnResize map[string]ptyTask
+	// the executables of pagers that failed, so that we don't use them again
+	failedPagers *set.Set[string]
+	stopChan     chan struct{}
 
 	// when lazygit is opened outside a git directory we want to open to the most
 	// recent repo with the recent repos popup showing
@@ -104,6 +124,10 @@ type Gui struct {
 	// Log of the commands/actions logged in the Command Log panel.
 	GuiLog []string
 
+	// The commands in the Command Log panel that can be run on the command
+	// line, so that the user can copy or re-run them
+	LoggedCommands []types.LoggedCommand
+
 	// the extras window contains things like the command log
 	ShowExtrasWindow bool
 
@@ -185,6 +209,13 @@ func (self *StateAccessor) SetShowExtrasWindow(value bool) {
 	self.gui.ShowExtrasWindow = value
 }
 
+func (self *StateAccessor) GetLoggedCommands() []types.LoggedCommand {
+	self.gui.Mutexes.LoggedCommandsMutex.Lock()
+	defer self.gui.Mutexes.LoggedCommandsMutex.Unlock()
+
+	return slices.Clone(self.gui.LoggedCommands)
+}
+
 func (self *StateAccessor) GetRetainOriginalDir() bool {
 	return self.gui.RetainOriginalDir
 }
@@ -246,6 +277,8 @@ type GuiRepoState struct {
 	ViewsSetup bool
 
 	ScreenMode types.ScreenMode
+	// the screen mode to go back to when leaving zen mode
+	ScreenModeBeforeZenMode types.ScreenMode
 
 	CurrentPopupOpts *types.CreatePopupPanelOpts
 }
@@ -284,6 +317,14 @@ func (self *GuiRepoState) SetScreenMode(value types.ScreenMode) {
 	self.ScreenMode = value
 }
 
+func (self *GuiRepoState) GetScreenModeBeforeZenMode() types.ScreenMode {
+	return self.ScreenModeBeforeZenMode
+}
+
+func (self *GuiRepoState) SetScreenModeBeforeZenMode(value types.ScreenMode) {
+	self.ScreenModeBeforeZenMode = value
+}
+
 func (self *GuiRepoState) InSearchPrompt() bool {
 	return self.SearchState.SearchType() != types.SearchTypeNone
 }
@@ -331,22 +372,12 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.Context
 	}
 
 	gui.g.SetFocusHandler(func(Focused bool) error {
+		gui.helpers.Notification.SetTerminalFocused(Focused)
+
 		if Focused {
 			gui.git.Config.DropConfigCache()
 
-			oldConfig := gui.Config.GetUserConfig()
-			reloadErr, didChange := gui.Config.ReloadChangedUserConfigFiles()
-			if didChange && reloadErr == nil {
-				gui.c.Log.Info("User config changed - reloading")
-				reloadErr = gui.onUserConfigLoaded()
-				if err := gui.resetKeybindings(); err != nil {
-					return err
-				}
-
-				if err := gui.checkForChangedConfigsThatDontAutoReload(oldConfig, gui.Config.GetUserConfig()); err != nil {
-					return err
-				}
-			}
+			reloadErr := gui.reloadChangedUserConfig()
 
 			gui.c.Log.Info("Receiving focus - refreshing")
 			gui.helpers.Refresh.Refresh(types.RefreshOptions{Mode: types.ASYNC})
@@ -356,6 +387,8 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.Context
 		return nil
 	})
 
+	gui.g.SetHoverHandler(gui.onViewHovered)
+
 	gui.g.SetOpenHyperlinkFunc(func(url string, viewname string) error {
 		if strings.HasPrefix(url, "lazygit-edit:") {
 			re := regexp.MustCompile(`^lazygit-edit://(.+?)(?::(\d+))?$`)
@@ -372,6 +405,10 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.Context
 			return gui.helpers.Files.EditFiles([]string{filepath})
 		}
 
+		if hash, ok := strings.CutPrefix(url, presentation.CommitHyperlinkPrefix); ok {
+			return gui.helpers.Commits.GoToCommit(hash)
+		}
+
 		if err := gui.os.OpenLink(url); err != nil {
 			return fmt.Errorf(gui.Tr.FailedToOpenURL, url, err)
 		}
@@ -397,8 +434,11 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.Context
 
 func (gui *Gui) getPerRepoConfigFiles() []*config.ConfigFile {
 	repoConfigFiles := []*config.ConfigFile{
-		// TODO: add filepath.Join(gui.git.RepoPaths.RepoPath(), ".lazygit.yml"),
-		// with trust prompt
+		{
+			Path:      gui.repoConfigFilePath(),
+			Policy:    config.ConfigFilePolicySkipIfMissing,
+			IsTrusted: gui.isRepoConfigFileTrusted,
+		},
 		{
 			Path:   filepath.Join(gui.git.RepoPaths.RepoGitDirPath(), "lazygit.yml"),
 			Policy: config.ConfigFilePolicySkipIfMissing,
@@ -418,6 +458,44 @@ func (gui *Gui) getPerRepoConfigFiles() []*config.ConfigFile {
 	return repoConfigFiles
 }
 
+// Applies the changes to any of the config files since they were last loaded.
+// This happens when the terminal regains focus, and when the background watcher
+// notices a change.
+func (gui *Gui) reloadChangedUserConfig() error {
+	oldConfig := gui.Config.GetUserConfig()
+	reloadErr, didChange := gui.Config.ReloadChangedUserConfigFiles()
+	if reloadErr != nil {
+		// Keep going with the config we have, so that the user can fix the
+		// file without restarting
+		gui.c.ErrorToast(reloadErr.Error())
+		return nil
+	}
+	if !didChange {
+		return nil
+	}
+
+	gui.c.Log.Info("User config changed - reloading")
+	if err := gui.applyChangedUserConfig(oldConfig); err != nil {
+		return err
+	}
+
+	gui.c.Toast(gui.c.Tr.ConfigReloaded)
+	gui.askToTrustRepoConfigFile()
+	return nil
+}
+
+// Makes the gui use the user config that was just reloaded
+func (gui *Gui) applyChangedUserConfig(oldConfig *config.UserConfig) error {
+	if err := gui.onUserConfigLoaded(); err != nil {
+		return err
+	}
+	if err := gui.resetKeybindings(); err != nil {
+		return err
+	}
+
+	return gui.checkForChangedConfigsThatDontAutoReload(oldConfig, gui.Config.GetUserConfig())
+}
+
 func (gui *Gui) onUserConfigLoaded() error {
 	userConfig := gui.Config.GetUserConfig()
 	gui.Common.SetUserConfig(userConfig)
@@ -448,11 +526,7 @@ func (gui *Gui) onUserConfigLoaded() error {
 	gui.ShowExtrasWindow = userConfig.Gui.ShowCommandLog && !gui.c.GetAppState().HideCommandLog
 
 	authors.SetCustomAuthors(userConfig.Gui.AuthorColors)
-	if userConfig.Gui.NerdFontsVersion != "" {
-		icons.SetNerdFontsVersion(userConfig.Gui.NerdFontsVersion)
-	} else if userConfig.Gui.ShowIcons {
-		icons.SetNerdFontsVersion("2")
-	}
+	icons.SetIconSet(icons.IconSetName(&userConfig.Gui, os.Getenv), userConfig.Gui.CustomIcons)
 
 	if len(userConfig.Gui.BranchColorPatterns) > 0 {
 		presentation.SetCustomBranches(userConfig.Gui.BranchColorPatterns, true)
@@ -470,6 +544,7 @@ func (gui *Gui) checkForChangedConfigsThatDontAutoReload(oldConfig *config.UserC
 		"Git.AutoRefresh",
 		"Refresher.RefreshInterval",
 		"Refresher.FetchInterval",
+		"Refresher.AutoReloadConfig",
 		"Update.Method",
 		"Update.Days",
 	}
@@ -548,6 +623,15 @@ func (gui *Gui) resetState(startArgs appTypes.StartArgs) types.Context {
 
 	initialScreenMode := initialScreenMode(startArgs, gui.Config)
 
+	filterPath := startArgs.FilterPath
+	if filterPath == "" {
+		filterPath = gui.Config.GetAppState().FilterPathsByRepo[gui.git.RepoPaths.RepoPath()]
+	}
+
+	collapsedDirs := gui.Config.GetAppState().CollapsedDirsByRepo[gui.git.RepoPaths.RepoPath()]
+	contextTree.Files.CollapsedPaths().SetPaths(collapsedDirs.Files)
+	contextTree.CommitFiles.CollapsedPaths().SetPaths(collapsedDirs.CommitFiles)
+
 	gui.State = &GuiRepoState{
 		ViewsSetup: false,
 		Model: &types.Model{
@@ -560,14 +644,16 @@ func (gui *Gui) resetState(startArgs appTypes.StartArgs) types.Context {
 			BisectInfo:            git_commands.NewNullBisectInfo(),
 			FilesTrie:             patricia.NewTrie(),
 			Authors:               map[string]*models.Author{},
+			RemoteTags:            map[string]*set.Set[string]{},
 			MainBranches:          git_commands.NewMainBranches(gui.c.Common, gui.os.Cmd),
 			HashPool:              &utils.StringPool{},
 		},
 		Modes: &types.Modes{
-			Filtering:        filtering.New(startArgs.FilterPath, ""),
+			Filtering:        filtering.New(filterPath, ""),
 			CherryPicking:    cherrypicking.New(),
 			Diffing:          diffing.New(),
 			MarkedBaseCommit: marked_base_commit.New(),
+			MarkedCommits:    marked_commits.New(),
 		},
 		ScreenMode: initialScreenMode,
 		// TODO: only use contexts from context manager
@@ -617,6 +703,8 @@ func parseScreenModeArg(screenModeArg string) types.ScreenMode {
 		return types.SCREEN_HALF
 	case "full":
 		return types.SCREEN_FULL
+	case "zen":
+		return types.SCREEN_ZEN
 	default:
 		return types.SCREEN_NORMAL
 	}
@@ -661,17 +749,19 @@ func NewGui(
 	test integrationTypes.IntegrationTest,
 ) (*Gui, error) {
 	gui := &Gui{
-		Common:               cmn,
-		gitVersion:           gitVersion,
-		Config:               config,
-		Updater:              updater,
-		statusManager:        status.NewStatusManager(),
-		viewBufferManagerMap: map[string]*tasks.ViewBufferManager{},
-		viewPtmxMap:          map[string]*os.File{},
-		showRecentRepos:      showRecentRepos,
-		RepoPathStack:        &utils.StringStack{},
-		RepoStateMap:         map[Repo]*GuiRepoState{},
-		GuiLog:               []string{},
+		Common:                      cmn,
+		gitVersion:                  gitVersion,
+		Config:                      config,
+		Updater:                     updater,
+		statusManager:               status.NewStatusManager(),
+		viewBufferManagerMap:        map[string]*tasks.ViewBufferManager{},
+		viewPtmxMap:                 map[string]*os.File{},
+		viewPtyTasksToRerunOnResize: map[string]ptyTask{},
+		failedPagers:                set.New[string](),
+		showRecentRepos:             showRecentRepos,
+		RepoPathStack:               &utils.StringStack{},
+		RepoStateMap:                map[Repo]*GuiRepoState{},
+		GuiLog:                      []string{},
 
 		// initializing this to true for the time being; it will be reset to the
 		// real value after loading the user config:
@@ -709,6 +799,7 @@ func NewGui(
 	guiIO := oscommands.NewGuiIO(
 		cmn.Log,
 		gui.LogCommand,
+		gui.logCommandDone,
 		gui.getCmdWriter,
 		credentialsHelper.PromptUserForCredential,
 	)
@@ -724,6 +815,8 @@ func NewGui(
 	gui.BackgroundRoutineMgr = &BackgroundRoutineMgr{gui: gui}
 	gui.stateAccessor = &StateAccessor{gui: gui}
 
+	cmn.Log.Logger.AddHook(newAppLogHook(gui))
+
 	return gui, nil
 }
 
@@ -804,6 +897,20 @@ func (gui *Gui) viewTabMap() map[string][]context.TabView {
 				ViewName: "submodules",
 			},
 		},
+		"extras": {
+			{
+				Tab:      gui.c.Tr.CommandLog,
+				ViewName: "extras",
+			},
+			{
+				Tab:      gui.c.Tr.CommandOutputTitle,
+				ViewName: "commandOutput",
+			},
+			{
+				Tab:      gui.c.Tr.AppLogTitle,
+				ViewName: "appLog",
+			},
+		},
 	}
 
 	return result
@@ -951,7 +1058,16 @@ func (gui *Gui) runSubprocess(cmdObj *oscommands.CmdObj) error {
 
 	fmt.Fprintf(os.Stdout, "\n%s\n\n", style.FgBlue.Sprint("+ "+strings.Join(subprocess.Args, " ")))
 
+	startTime := time.Now()
 	err := subprocess.Run()
+	gui.logCommandDone(oscommands.CommandLogEntry{
+		Command:   cmdObj.ToString(),
+		Args:      slices.Clone(cmdObj.Args()),
+		StartTime: startTime,
+		Duration:  time.Since(startTime),
+		ExitCode:  subprocess.ProcessState.ExitCode(),
+	})
+	gui.helpers.Notification.OnSubprocessFinished(err)
 
 	subprocess.Stdout = io.Discard
 	subprocess.Stderr = io.Discard
@@ -1080,8 +1196,11 @@ func (gui *Gui) showBreakingChangesMessage() {
 
 // setColorScheme sets the color scheme for the app based on the user config
 func (gui *Gui) setColorScheme() {
-	userConfig := gui.UserConfig()
-	theme.UpdateTheme(userConfig.Gui.Theme)
+	gui.setThemeColors(gui.UserConfig().Gui.Theme)
+}
+
+func (gui *Gui) setThemeColors(themeConfig config.ThemeConfig) {
+	theme.UpdateTheme(themeConfig)
 
 	gui.g.FgColor = theme.InactiveBorderColor
 	gui.g.SelFgColor = theme.ActiveBorderColor
@@ -1089,6 +1208,25 @@ func (gui *Gui) setColorScheme() {
 	gui.g.SelFrameColor = theme.ActiveBorderColor
 }
 
+// Makes all views use the colors of the given theme right away, without
+// changing the user config, e.g. for previewing a theme
+func (gui *Gui) renderTheme(themeConfig config.ThemeConfig) {
+	gui.setThemeColors(themeConfig)
+	gui.configureViewProperties()
+	// The graph has its colors baked in
+	presentation.ClearPipeSetCache()
+
+	for _, context := range gui.c.Context().AllList() {
+		context.HandleRender()
+	}
+
+	if current := gui.c.Context().CurrentStatic(); current.GetKind() == types.SIDE_CONTEXT {
+		current.HandleRenderToMain()
+	} else {
+		current.HandleRender()
+	}
+}
+
 func (gui *Gui) onUIThread(f func() error) {
 	gui.g.Update(func(*gocui.Gui) error {
 		return f()
@@ -124,6 +124,10 @@ func (self *guiCommon) OnWorker(f func(gocui.Task) error) {
 	self.gui.onWorker(f)
 }
 
+func (self *guiCommon) OnWorkerWithName(name string, f func(gocui.Task) error) {
+	self.gui.g.OnWorkerWithName(name, f)
+}
+
 func (self *guiCommon) RenderToMainViews(opts types.RefreshMainOpts) {
 	self.gui.refreshMainViews(opts)
 }
@@ -157,6 +161,14 @@ func (self *guiCommon) ResetKeybindings() error {
 	return self.gui.resetKeybindings()
 }
 
+func (self *guiCommon) ApplyChangedUserConfig(oldConfig *config.UserConfig) error {
+	return self.gui.applyChangedUserConfig(oldConfig)
+}
+
+func (self *guiCommon) RenderTheme(themeConfig config.ThemeConfig) {
+	self.gui.renderTheme(themeConfig)
+}
+
 func (self *guiCommon) IsAnyModeActive() bool {
 	return self.gui.helpers.Mode.IsAnyModeActive()
 }
@@ -177,6 +189,14 @@ func (self *guiCommon) InDemo() bool {
 	return self.gui.integrationTest != nil && self.gui.integrationTest.IsDemo()
 }
 
+func (self *guiCommon) Announce(text string) {
+	self.gui.helpers.Accessibility.Announce(text)
+}
+
+func (self *guiCommon) AnnounceSelection(context types.Context, line string) {
+	self.gui.helpers.Accessibility.AnnounceSelection(context, line)
+}
+
 func (self *guiCommon) WithInlineStatus(item types.HasUrn, operation types.ItemOperation, contextKey types.ContextKey, f func(gocui.Task) error) error {
 	self.gui.helpers.InlineStatus.WithInlineStatus(helpers.InlineStatusOpts{Item: item, Operation: operation, ContextKey: contextKey}, f)
 	return nil
@@ -50,13 +50,36 @@ func (self *GuiDriver) PressKey(keyStr string) {
 }
 
 func (self *GuiDriver) Click(x, y int) {
+	self.clickButton(x, y, tcell.ButtonPrimary)
+}
+
+func (self *GuiDriver) RightClick(x, y int) {
+	self.clickButton(x, y, tcell.ButtonSecondary)
+}
+
+func (self *GuiDriver) MiddleClick(x, y int) {
+	self.clickButton(x, y, tcell.ButtonMiddle)
+}
+
+func (self *GuiDriver) clickButton(x, y int, button tcell.ButtonMask) {
 	self.CheckAllToastsAcknowledged()
 
 	self.gui.g.ReplayedEvents.MouseEvents <- gocui.NewTcellMouseEventWrapper(
-		tcell.NewEventMouse(x, y, tcell.ButtonPrimary, 0),
+		tcell.NewEventMouse(x, y, button, 0),
+		0,
+	)
+	self.waitTillIdle()
+	self.gui.g.ReplayedEvents.MouseEvents <- gocui.NewTcellMouseEventWrapper(
+		tcell.NewEventMouse(x, y, tcell.ButtonNone, 0),
 		0,
 	)
 	self.waitTillIdle()
+}
+
+// Moves the mouse to the position without pressing any buttons
+func (self *GuiDriver) MoveMouse(x, y int) {
+	self.CheckAllToastsAcknowledged()
+
 	self.gui.g.ReplayedEvents.MouseEvents <- gocui.NewTcellMouseEventWrapper(
 		tcell.NewEventMouse(x, y, tcell.ButtonNone, 0),
 		0,
@@ -64,6 +87,31 @@ func (self *GuiDriver) Click(x, y int) {
 	self.waitTillIdle()
 }
 
+// Presses the primary button at the first position, moves the mouse one cell at
+// a time to the second position and releases the button there.
+func (self *GuiDriver) Drag(fromX, fromY, toX, toY int) {
+	self.CheckAllToastsAcknowledged()
+
+	sendMouseEvent := func(x, y int, buttons tcell.ButtonMask) {
+		self.gui.g.ReplayedEvents.MouseEvents <- gocui.NewTcellMouseEventWrapper(
+			tcell.NewEventMouse(x, y, buttons, 0),
+			0,
+		)
+		self.waitTillIdle()
+	}
+
+	sendMouseEvent(fromX, fromY, tcell.ButtonPrimary)
+	steps := max(toX-fromX, fromX-toX, toY-fromY, fromY-toY)
+	for i := 1; i < steps; i++ {
+		sendMouseEvent(fromX+(toX-fromX)*i/steps, fromY+(toY-fromY)*i/steps, tcell.ButtonPrimary)
+	}
+	// gocui only recognises a drag once the mouse has moved away from where
+	// the button was pressed, so the first move doesn't count as one
+	sendMouseEvent(toX, toY, tcell.ButtonPrimary)
+	sendMouseEvent(toX, toY, tcell.ButtonPrimary)
+	sendMouseEvent(toX, toY, tcell.ButtonNone)
+}
+
 // wait until lazygit is idle (i.e. all processing is done) before continuing
 func (self *GuiDriver) waitTillIdle() {
 	<-self.isIdleChan
@@ -2,6 +2,7 @@ package gui
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/constants"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
@@ -9,6 +10,29 @@ import (
 )
 
 func (gui *Gui) informationStr() string {
+	// Things that only last until the next few keypresses go first
+	transientInfo := []string{}
+	if count := gui.helpers.CountPrefix.Count(); count > 0 {
+		transientInfo = append(transientInfo, style.FgYellow.Sprintf(gui.c.Tr.PendingCountPrefix, count))
+	}
+	if gui.helpers.Macro.IsRecording() {
+		transientInfo = append(transientInfo, style.FgRed.SetBold().Sprint(gui.c.Tr.RecordingKeypresses))
+	}
+
+	return strings.TrimSpace(strings.Join(append(transientInfo, gui.persistentInformationStr()), " "))
+}
+
+func (gui *Gui) persistentInformationStr() string {
+	if gui.helpers.StatusBar.Enabled() {
+		segments := gui.helpers.StatusBar.InformationStr()
+		// the mode's label stays at the right end so that its reset link can
+		// still be clicked
+		if activeMode, ok := gui.helpers.Mode.GetActiveMode(); ok {
+			return strings.TrimLeft(segments+" "+activeMode.InfoLabel(), " ")
+		}
+		return segments
+	}
+
 	if activeMode, ok := gui.helpers.Mode.GetActiveMode(); ok {
 		return activeMode.InfoLabel()
 	}
@@ -0,0 +1,116 @@
+package gui
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+)
+
+// Returns bindings for the first keys of all key sequences (e.g. "g" for
+// "g p"), which open a menu showing how the sequence can continue. They take
+// precedence over the other bindings of their view, so that a sequence can
+// start with a key that's already taken. Since gocui tries a view's bindings
+// before the global ones, the first key of a global sequence is also bound in
+// each view that has a binding of its own for that key.
+func (gui *Gui) keySequencePrefixBindings(bindings []*types.Binding) []*types.Binding {
+	type prefix struct {
+		viewName string
+		key      types.Key
+	}
+
+	prefixes := []prefix{}
+	for _, binding := range bindings {
+		if sequence, ok := binding.Key.(types.KeySequence); ok && binding.Handler != nil {
+			prefixes = append(prefixes, prefix{binding.ViewName, keybindings.KeysOfSequence(sequence)[0]})
+		}
+	}
+
+	for _, globalPrefix := range lo.Filter(prefixes, func(prefix prefix, _ int) bool { return prefix.viewName == "" }) {
+		for _, binding := range bindings {
+			if binding.ViewName != "" && binding.Key == globalPrefix.key && binding.Modifier == gocui.ModNone {
+				prefixes = append(prefixes, prefix{binding.ViewName, globalPrefix.key})
+			}
+		}
+	}
+
+	return lo.Map(lo.Uniq(prefixes), func(prefix prefix, _ int) *types.Binding {
+		return &types.Binding{
+			ViewName: prefix.viewName,
+			Key:      prefix.key,
+			Modifier: gocui.ModNone,
+			Handler: func() error {
+				return gui.showKeySequenceMenu([]types.Key{prefix.key}, lo.Filter(bindings, func(binding *types.Binding, _ int) bool {
+					return binding.ViewName == prefix.viewName || binding.ViewName == ""
+				}))
+			},
+		}
+	})
+}
+
+// Shows the bindings whose key sequence starts with the pressed keys, keyed by
+// the key that comes next. Longer sequences get a submenu.
+func (gui *Gui) showKeySequenceMenu(pressedKeys []types.Key, bindings []*types.Binding) error {
+	nextKeys := []types.Key{}
+	bindingsByNextKey := map[types.Key][]*types.Binding{}
+	for _, binding := range bindings {
+		sequence, ok := binding.Key.(types.KeySequence)
+		if !ok || binding.Handler == nil {
+			continue
+		}
+
+		keys := keybindings.KeysOfSequence(sequence)
+		if len(keys) <= len(pressedKeys) || !slices.Equal(keys[:len(pressedKeys)], pressedKeys) {
+			continue
+		}
+
+		nextKey := keys[len(pressedKeys)]
+		if _, ok := bindingsByNextKey[nextKey]; !ok {
+			nextKeys = append(nextKeys, nextKey)
+		}
+		bindingsByNextKey[nextKey] = append(bindingsByNextKey[nextKey], binding)
+	}
+
+	menuItems := lo.Map(nextKeys, func(nextKey types.Key, _ int) *types.MenuItem {
+		candidates := bindingsByNextKey[nextKey]
+		keys := append(append([]types.Key{}, pressedKeys...), nextKey)
+
+		// The first binding takes precedence, like for single keys
+		complete, isComplete := lo.Find(candidates, func(binding *types.Binding) bool {
+			return len(keybindings.KeysOfSequence(binding.Key.(types.KeySequence))) == len(keys)
+		})
+		if !isComplete {
+			return &types.MenuItem{
+				Label:     gui.c.Tr.MoreKeySequences,
+				Key:       nextKey,
+				OpensMenu: true,
+				OnPress: func() error {
+					return gui.showKeySequenceMenu(keys, candidates)
+				},
+			}
+		}
+
+		var disabledReason *types.DisabledReason
+		if complete.GetDisabledReason != nil {
+			disabledReason = complete.GetDisabledReason()
+		}
+		return &types.MenuItem{
+			Label:          complete.GetDescription(),
+			Key:            nextKey,
+			OpensMenu:      complete.OpensMenu,
+			Tooltip:        complete.Tooltip,
+			DisabledReason: disabledReason,
+			OnPress:        complete.Handler,
+		}
+	})
+
+	return gui.c.Menu(types.CreateMenuOptions{
+		Title: strings.Join(lo.Map(pressedKeys, func(key types.Key, _ int) string {
+			return keybindings.LabelFromKey(key)
+		}), " "),
+		Items: menuItems,
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 func (gui *Gui) noPopupPanel(f func() error) func() error {
@@ -77,6 +78,7 @@ func (gui *Gui) keybindingOpts() types.KeybindingsOpts {
 
 func (gui *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBinding) {
 	opts := gui.c.KeybindingsOpts()
+	hasBranchCopyTemplates := len(gui.c.UserConfig().CopyTemplates.Branches) > 0
 
 	bindings := []*types.Binding{
 		{
@@ -133,9 +135,11 @@ func (gui *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBin
 		{
 			ViewName:          "localBranches",
 			Key:               opts.GetKey(opts.Config.Universal.CopyToClipboard),
-			Handler:           gui.handleCopySelectedSideContextItemToClipboard,
+			Handler:           gui.handleCopySelectedBranchToClipboard,
 			GetDisabledReason: gui.getCopySelectedSideContextItemToClipboardDisabledReason,
-			Description:       gui.c.Tr.CopyBranchNameToClipboard,
+			Description:       lo.Ternary(hasBranchCopyTemplates, gui.c.Tr.CopyToClipboardMenu, gui.c.Tr.CopyBranchNameToClipboard),
+			Tooltip:           gui.c.Tr.CopyBranchNameToClipboardTooltip,
+			OpensMenu:         hasBranchCopyTemplates,
 		},
 		{
 			ViewName:          "remoteBranches",
@@ -302,90 +306,98 @@ func (gui *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBin
 			GetDisabledReason: gui.getCopySelectedSideContextItemToClipboardDisabledReason,
 			Description:       gui.c.Tr.CopySubmoduleNameToClipboard,
 		},
-		{
-			ViewName: "extras",
-			Key:      gocui.MouseWheelUp,
-			Handler:  gui.scrollUpExtra,
-		},
-		{
-			ViewName: "extras",
-			Key:      gocui.MouseWheelDown,
-			Handler:  gui.scrollDownExtra,
-		},
-		{
-			ViewName: "extras",
-			Tag:      "navigation",
-			Key:      opts.GetKey(opts.Config.Universal.PrevItemAlt),
-			Modifier: gocui.ModNone,
-			Handler:  gui.scrollUpExtra,
-		},
-		{
-			ViewName: "extras",
-			Tag:      "navigation",
-			Key:      opts.GetKey(opts.Config.Universal.PrevItem),
-			Modifier: gocui.ModNone,
-			Handler:  gui.scrollUpExtra,
-		},
-		{
-			ViewName: "extras",
-			Tag:      "navigation",
-			Key:      opts.GetKey(opts.Config.Universal.NextItem),
-			Modifier: gocui.ModNone,
-			Handler:  gui.scrollDownExtra,
-		},
-		{
-			ViewName: "extras",
-			Tag:      "navigation",
-			Key:      opts.GetKey(opts.Config.Universal.NextItemAlt),
-			Modifier: gocui.ModNone,
-			Handler:  gui.scrollDownExtra,
-		},
-		{
-			ViewName: "extras",
-			Key:      opts.GetKey(opts.Config.Universal.NextPage),
-			Modifier: gocui.ModNone,
-			Handler:  gui.pageDownExtrasPanel,
-		},
-		{
-			ViewName: "extras",
-			Key:      opts.GetKey(opts.Config.Universal.PrevPage),
-			Modifier: gocui.ModNone,
-			Handler:  gui.pageUpExtrasPanel,
-		},
-		{
-			ViewName: "extras",
-			Key:      opts.GetKey(opts.Config.Universal.GotoTop),
-			Modifier: gocui.ModNone,
-			Handler:  gui.goToExtrasPanelTop,
-		},
-		{
-			ViewName: "extras",
-			Key:      opts.GetKey(opts.Config.Universal.GotoTopAlt),
-			Modifier: gocui.ModNone,
-			Handler:  gui.goToExtrasPanelTop,
-		},
-		{
-			ViewName: "extras",
-			Key:      opts.GetKey(opts.Config.Universal.GotoBottom),
-			Modifier: gocui.ModNone,
-			Handler:  gui.goToExtrasPanelBottom,
-		},
-		{
-			ViewName: "extras",
-			Key:      opts.GetKey(opts.Config.Universal.GotoBottomAlt),
-			Modifier: gocui.ModNone,
-			Handler:  gui.goToExtrasPanelBottom,
-		},
-		{
-			ViewName: "extras",
-			Tag:      "navigation",
-			Key:      gocui.MouseLeft,
-			Modifier: gocui.ModNone,
-			Handler:  gui.handleFocusCommandLog,
-		},
 	}
 
 	mouseKeybindings := []*gocui.ViewMouseBinding{}
+
+	// the extras window has a tab for each of these views
+	for _, viewName := range []string{"extras", "commandOutput", "appLog"} {
+		bindings = append(bindings, []*types.Binding{
+			{
+				ViewName: viewName,
+				Key:      gocui.MouseWheelUp,
+				Handler:  gui.scrollUpExtra,
+			},
+			{
+				ViewName: viewName,
+				Key:      gocui.MouseWheelDown,
+				Handler:  gui.scrollDownExtra,
+			},
+			{
+				ViewName: viewName,
+				Tag:      "navigation",
+				Key:      opts.GetKey(opts.Config.Universal.PrevItemAlt),
+				Modifier: gocui.ModNone,
+				Handler:  gui.scrollUpExtra,
+			},
+			{
+				ViewName: viewName,
+				Tag:      "navigation",
+				Key:      opts.GetKey(opts.Config.Universal.PrevItem),
+				Modifier: gocui.ModNone,
+				Handler:  gui.scrollUpExtra,
+			},
+			{
+				ViewName: viewName,
+				Tag:      "navigation",
+				Key:      opts.GetKey(opts.Config.Universal.NextItem),
+				Modifier: gocui.ModNone,
+				Handler:  gui.scrollDownExtra,
+			},
+			{
+				ViewName: viewName,
+				Tag:      "navigation",
+				Key:      opts.GetKey(opts.Config.Universal.NextItemAlt),
+				Modifier: gocui.ModNone,
+				Handler:  gui.scrollDownExtra,
+			},
+			{
+				ViewName: viewName,
+				Key:      opts.GetKey(opts.Config.Universal.NextPage),
+				Modifier: gocui.ModNone,
+				Handler:  gui.pageDownExtrasPanel,
+			},
+			{
+				ViewName: viewName,
+				Key:      opts.GetKey(opts.Config.Universal.PrevPage),
+				Modifier: gocui.ModNone,
+				Handler:  gui.pageUpExtrasPanel,
+			},
+			{
+				ViewName: viewName,
+				Key:      opts.GetKey(opts.Config.Universal.GotoTop),
+				Modifier: gocui.ModNone,
+				Handler:  gui.goToExtrasPanelTop,
+			},
+			{
+				ViewName: viewName,
+				Key:      opts.GetKey(opts.Config.Universal.GotoTopAlt),
+				Modifier: gocui.ModNone,
+				Handler:  gui.goToExtrasPanelTop,
+			},
+			{
+				ViewName: viewName,
+				Key:      opts.GetKey(opts.Config.Universal.GotoBottom),
+				Modifier: gocui.ModNone,
+				Handler:  gui.goToExtrasPanelBottom,
+			},
+			{
+				ViewName: viewName,
+				Key:      opts.GetKey(opts.Config.Universal.GotoBottomAlt),
+				Modifier: gocui.ModNone,
+				Handler:  gui.goToExtrasPanelBottom,
+			},
+		}...)
+
+		mouseKeybindings = append(mouseKeybindings, &gocui.ViewMouseBinding{
+			ViewName: viewName,
+			Key:      gocui.MouseLeft,
+			Handler: func(gocui.ViewMouseBindingOpts) error {
+				return gui.handleFocusExtrasView(viewName)
+			},
+		})
+	}
+
 	for _, c := range gui.State.Contexts.Flatten() {
 		viewName := c.GetViewName()
 		for _, binding := range c.GetKeybindings(opts) {
@@ -396,6 +408,7 @@ func (gui *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBin
 
 		mouseKeybindings = append(mouseKeybindings, c.GetMouseKeybindings(opts)...)
 	}
+	mouseKeybindings = gui.withMouseInterceptors(mouseKeybindings)
 
 	bindings = append(bindings, []*types.Binding{
 		{
@@ -417,6 +430,74 @@ func (gui *Gui) GetInitialKeybindings() ([]*types.Binding, []*gocui.ViewMouseBin
 	return bindings, mouseKeybindings
 }
 
+// Dragging the borders between the side panels, the main view and the command
+// log resizes them, and clicking or dragging in a view's scrollbar scrolls it.
+// gocui only runs the first matching binding for a mouse event, so we give
+// every binding of these views the chance to handle the event first, and add
+// bindings for the views that don't handle these mouse events themselves.
+func (gui *Gui) withMouseInterceptors(bindings []*gocui.ViewMouseBinding) []*gocui.ViewMouseBinding {
+	viewNames := []string{}
+	for _, c := range gui.State.Contexts.Flatten() {
+		_, isList := c.(types.IListContext)
+		isPanel := lo.Contains([]types.ContextKind{types.SIDE_CONTEXT, types.MAIN_CONTEXT, types.EXTRAS_CONTEXT}, c.GetKind())
+		if isList || isPanel {
+			viewNames = append(viewNames, c.GetViewName())
+		}
+	}
+
+	type mouseKey struct {
+		viewName string
+		key      gocui.Key
+		modifier gocui.Modifier
+	}
+	mouseKeys := []mouseKey{}
+	for _, viewName := range lo.Uniq(viewNames) {
+		mouseKeys = append(mouseKeys,
+			mouseKey{viewName, gocui.MouseLeft, gocui.ModNone},
+			mouseKey{viewName, gocui.MouseLeft, gocui.ModMotion},
+			mouseKey{viewName, gocui.MouseRelease, gocui.ModNone},
+		)
+	}
+
+	handledMouseKeys := []mouseKey{}
+	for _, binding := range bindings {
+		key := mouseKey{binding.ViewName, binding.Key, binding.Modifier}
+		if !lo.Contains(mouseKeys, key) {
+			continue
+		}
+		if binding.FocusedView == "" {
+			handledMouseKeys = append(handledMouseKeys, key)
+		}
+
+		baseHandler := binding.Handler
+		binding.Handler = func(opts gocui.ViewMouseBindingOpts) error {
+			if gui.interceptMouseEvent(key.viewName, key.modifier, opts) {
+				return nil
+			}
+			return baseHandler(opts)
+		}
+	}
+
+	for _, key := range lo.Without(mouseKeys, handledMouseKeys...) {
+		bindings = append(bindings, &gocui.ViewMouseBinding{
+			ViewName: key.viewName,
+			Key:      key.key,
+			Modifier: key.modifier,
+			Handler: func(opts gocui.ViewMouseBindingOpts) error {
+				gui.interceptMouseEvent(key.viewName, key.modifier, opts)
+				return nil
+			},
+		})
+	}
+
+	return bindings
+}
+
+func (gui *Gui) interceptMouseEvent(viewName string, modifier gocui.Modifier, opts gocui.ViewMouseBindingOpts) bool {
+	return gui.helpers.Scrollbar.HandleMouseEvent(viewName, modifier, opts) ||
+		gui.helpers.PanelResize.HandleMouseEvent(viewName, modifier, opts)
+}
+
 func (gui *Gui) GetInitialKeybindingsWithCustomCommands() ([]*types.Binding, []*gocui.ViewMouseBinding) {
 	// if the search or filter prompt is open, we only want the keybindings for
 	// that context. It shouldn't be possible, for example, to open a menu while
@@ -437,6 +518,7 @@ func (gui *Gui) GetInitialKeybindingsWithCustomCommands() ([]*types.Binding, []*
 	}
 	// prepending because we want to give our custom keybindings precedence over default keybindings
 	bindings = append(customBindings, bindings...)
+	bindings = append(gui.keySequencePrefixBindings(bindings), bindings...)
 	return bindings, mouseBindings
 }
 
@@ -480,8 +562,22 @@ func (gui *Gui) wrappedHandler(f func() error) func(g *gocui.Gui, v *gocui.View)
 }
 
 func (gui *Gui) SetKeybinding(binding *types.Binding) error {
+	// These are reached through the bindings of their first keys
+	if _, ok := binding.Key.(types.KeySequence); ok {
+		return nil
+	}
+
 	handler := func() error {
-		return gui.callKeybindingHandler(binding)
+		// Only keys that were already being recorded and didn't stop the
+		// recording are recorded
+		wasRecording := gui.helpers.Macro.IsRecording()
+		err := gui.helpers.CountPrefix.Call(binding, func() error {
+			return gui.callKeybindingHandler(binding)
+		})
+		if wasRecording && err == nil && gui.helpers.Macro.IsRecording() {
+			gui.helpers.Macro.RecordKey(binding)
+		}
+		return err
 	}
 
 	// TODO: move all mouse-ey stuff into new mouse approach
@@ -10,6 +10,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/constants"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 func Label(name string) string {
@@ -24,6 +25,8 @@ func LabelFromKey(key types.Key) string {
 	keyInt := 0
 
 	switch key := key.(type) {
+	case types.KeySequence:
+		return string(key)
 	case rune:
 		keyInt = int(key)
 	case gocui.Key:
@@ -41,6 +44,13 @@ func GetKey(key string) types.Key {
 	runeCount := utf8.RuneCountInString(key)
 	if key == "<disabled>" {
 		return nil
+	} else if runeCount > 1 && strings.Contains(key, " ") {
+		keys := strings.Fields(key)
+		for _, key := range keys {
+			// Only for the error message if the key is unrecognized
+			GetKey(key)
+		}
+		return types.KeySequence(strings.Join(keys, " "))
 	} else if runeCount > 1 {
 		binding, ok := config.KeyByLabel[strings.ToLower(key)]
 		if !ok {
@@ -52,3 +62,10 @@ func GetKey(key string) types.Key {
 	}
 	return nil
 }
+
+// The keys of a sequence, e.g. [g, p] for "g p"
+func KeysOfSequence(sequence types.KeySequence) []types.Key {
+	return lo.Map(strings.Fields(string(sequence)), func(key string, _ int) types.Key {
+		return GetKey(key)
+	})
+}
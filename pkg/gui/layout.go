@@ -223,6 +223,8 @@ func (gui *Gui) onInitialViewsCreationForRepo() error {
 	initialContext := gui.c.Context().Current()
 	gui.c.Context().Activate(initialContext, types.OnFocusOpts{})
 
+	gui.askToTrustRepoConfigFile()
+
 	return gui.loadNewRepo()
 }
 
@@ -267,6 +269,10 @@ func (gui *Gui) onInitialViewsCreation() error {
 		gui.showRecentRepos = false
 	}
 
+	for _, warning := range gui.Config.GetEnvOverrideWarnings() {
+		gui.c.ErrorToast(warning)
+	}
+
 	gui.helpers.Update.CheckForUpdateInBackground()
 
 	gui.waitForIntro.Done()
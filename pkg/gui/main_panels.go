@@ -17,10 +17,10 @@ func (gui *Gui) runTaskForView(view *gocui.View, task types.UpdateTask) error {
 		return gui.newStringTaskWithScroll(view, v.Str, v.OriginX, v.OriginY)
 
 	case *types.RunCommandTask:
-		return gui.newCmdTask(view, v.Cmd, v.Prefix)
+		return gui.newCmdTask(view, v.Cmd, v.GetPrefix)
 
 	case *types.RunPtyTask:
-		return gui.newPtyTask(view, v.Cmd, v.Prefix)
+		return gui.newPtyTask(view, v.Cmd, v.GetPrefix)
 	}
 
 	return nil
@@ -44,7 +44,9 @@ func (gui *Gui) createMenu(opts types.CreateMenuOptions) error {
 	gui.State.Contexts.Menu.SetMenuItems(opts.Items, opts.ColumnAlignment)
 	gui.State.Contexts.Menu.SetPrompt(opts.Prompt)
 	gui.State.Contexts.Menu.SetAllowFilteringKeybindings(opts.AllowFilteringKeybindings)
-	gui.State.Contexts.Menu.SetSelection(0)
+	gui.State.Contexts.Menu.SetOnSelectionChange(opts.OnSelectionChange)
+	gui.State.Contexts.Menu.SetOnClose(opts.OnClose)
+	gui.State.Contexts.Menu.SetSelection(opts.SelectedIndex)
 
 	gui.Views.Menu.Title = opts.Title
 	gui.Views.Menu.FgColor = theme.GocuiDefaultTextColor
@@ -3,6 +3,10 @@ package filtering
 type Filtering struct {
 	path               string // the filename that gets passed to git log
 	author             string // the author that gets passed to git log
+	since              string // the date passed to git log's --since
+	until              string // the date passed to git log's --until
+	grep               string // the pattern passed to git log's --grep
+	noMerges           bool   // whether to pass --no-merges to git log
 	selectedCommitHash string // the commit that was selected before we entered filtering mode
 }
 
@@ -11,12 +15,26 @@ func New(path string, author string) Filtering {
 }
 
 func (m *Filtering) Active() bool {
-	return m.path != "" || m.author != ""
+	return m.path != "" || m.HasCommitFilters()
+}
+
+// HasCommitFilters is true if we're filtering by anything other than a path
+func (m *Filtering) HasCommitFilters() bool {
+	return m.author != "" || m.since != "" || m.until != "" || m.grep != "" || m.noMerges
 }
 
 func (m *Filtering) Reset() {
 	m.path = ""
+	m.ResetCommitFilters()
+}
+
+// ResetCommitFilters resets all filters except the path filter
+func (m *Filtering) ResetCommitFilters() {
 	m.author = ""
+	m.since = ""
+	m.until = ""
+	m.grep = ""
+	m.noMerges = false
 }
 
 func (m *Filtering) SetPath(path string) {
@@ -35,6 +53,38 @@ func (m *Filtering) GetAuthor() string {
 	return m.author
 }
 
+func (m *Filtering) SetSince(since string) {
+	m.since = since
+}
+
+func (m *Filtering) GetSince() string {
+	return m.since
+}
+
+func (m *Filtering) SetUntil(until string) {
+	m.until = until
+}
+
+func (m *Filtering) GetUntil() string {
+	return m.until
+}
+
+func (m *Filtering) SetGrep(grep string) {
+	m.grep = grep
+}
+
+func (m *Filtering) GetGrep() string {
+	return m.grep
+}
+
+func (m *Filtering) SetNoMerges(noMerges bool) {
+	m.noMerges = noMerges
+}
+
+func (m *Filtering) GetNoMerges() bool {
+	return m.noMerges
+}
+
 func (m *Filtering) SetSelectedCommitHash(hash string) {
 	m.selectedCommitHash = hash
 }
@@ -0,0 +1,83 @@
+package marked_commits
+
+import (
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
+)
+
+// Commits that have been marked individually, so that operations like
+// cherry-picking or dropping can act on a non-contiguous set of commits.
+type MarkedCommits struct {
+	// marks only make sense in the context they were made in, so marking a
+	// commit in another context clears the existing marks
+	ContextKey string
+
+	hashes *set.Set[string]
+}
+
+func New() *MarkedCommits {
+	return &MarkedCommits{
+		hashes: set.New[string](),
+	}
+}
+
+func (self *MarkedCommits) Active() bool {
+	return self.hashes.Len() > 0
+}
+
+func (self *MarkedCommits) Count() int {
+	return self.hashes.Len()
+}
+
+func (self *MarkedCommits) Reset() {
+	self.ContextKey = ""
+	self.hashes = set.New[string]()
+}
+
+// Returns true if there are marks in the given context
+func (self *MarkedCommits) ActiveIn(contextKey string) bool {
+	return self.Active() && self.ContextKey == contextKey
+}
+
+// Returns the marked hashes if they belong to the given context, and an empty
+// set otherwise.
+func (self *MarkedCommits) HashSetFor(contextKey string) *set.Set[string] {
+	if self.ContextKey != contextKey {
+		return set.New[string]()
+	}
+	return self.hashes
+}
+
+// Marks the given commits, or unmarks them if they are all marked already.
+func (self *MarkedCommits) Toggle(contextKey string, commits []*models.Commit) {
+	if self.ContextKey != contextKey {
+		self.Reset()
+		self.ContextKey = contextKey
+	}
+
+	allMarked := lo.EveryBy(commits, func(commit *models.Commit) bool {
+		return self.hashes.Includes(commit.Hash())
+	})
+	for _, commit := range commits {
+		if allMarked {
+			self.hashes.Remove(commit.Hash())
+		} else {
+			self.hashes.Add(commit.Hash())
+		}
+	}
+}
+
+// Returns the marked commits in the order in which they appear in the given
+// list, together with their indices in the list.
+func (self *MarkedCommits) Find(commits []*models.Commit) ([]*models.Commit, []int) {
+	var markedCommits []*models.Commit
+	var indices []int
+	for i, commit := range commits {
+		if self.hashes.Includes(commit.Hash()) {
+			markedCommits = append(markedCommits, commit)
+			indices = append(indices, i)
+		}
+	}
+	return markedCommits, indices
+}
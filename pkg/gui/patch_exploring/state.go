@@ -34,6 +34,9 @@ type State struct {
 	// on by default.
 	// this makes a difference for whether we want to escape out of hunk mode
 	userEnabledHunkMode bool
+
+	// whether each line is rendered with its line numbers in front of it
+	showLineNumbers bool
 }
 
 // these represent what select mode we're in
@@ -45,8 +48,8 @@ const (
 	HUNK
 )
 
-func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *State, useHunkModeByDefault bool) *State {
-	if oldState != nil && diff == oldState.diff && selectedLineIdx == -1 {
+func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *State, useHunkModeByDefault bool, showLineNumbers bool) *State {
+	if oldState != nil && diff == oldState.diff && selectedLineIdx == -1 && showLineNumbers == oldState.showLineNumbers {
 		// if we're here then we can return the old state. If selectedLineIdx was not -1
 		// then that would mean we were trying to click and potentially drag a range, which
 		// is why in that case we continue below
@@ -59,7 +62,7 @@ func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *Stat
 		return nil
 	}
 
-	viewLineIndices, patchLineIndices := wrapPatchLines(diff, view)
+	viewLineIndices, patchLineIndices := wrapPatchLines(diff, patch, showLineNumbers, view)
 
 	rangeStartLineIdx := 0
 	if oldState != nil {
@@ -104,6 +107,7 @@ func NewState(diff string, selectedLineIdx int, view *gocui.View, oldState *Stat
 		viewLineIndices:     viewLineIndices,
 		patchLineIndices:    patchLineIndices,
 		userEnabledHunkMode: userEnabledHunkMode,
+		showLineNumbers:     showLineNumbers,
 	}
 }
 
@@ -117,7 +121,7 @@ func (s *State) OnViewWidthChanged(view *gocui.View) {
 	if s.selectMode == RANGE {
 		rangeStartPatchLineIdx = s.patchLineIndices[s.rangeStartLineIdx]
 	}
-	s.viewLineIndices, s.patchLineIndices = wrapPatchLines(s.diff, view)
+	s.viewLineIndices, s.patchLineIndices = wrapPatchLines(s.diff, s.patch, s.showLineNumbers, view)
 	s.selectedLineIdx = s.viewLineIndices[selectedPatchLineIdx]
 	if s.selectMode == RANGE {
 		s.rangeStartLineIdx = s.viewLineIndices[rangeStartPatchLineIdx]
@@ -373,16 +377,30 @@ func (s *State) CurrentLineNumber() int {
 	return s.patch.LineNumberOfLine(s.patchLineIndices[s.selectedLineIdx])
 }
 
+// Selects the line that shows the given line of the new file, or the closest
+// line to it if the patch doesn't contain it
+// The line numbers in the new version of the file of the first and last
+// selected lines
+func (s *State) SelectedLineNumberRange() (int, int) {
+	firstLineIdx, lastLineIdx := s.SelectedPatchRange()
+	return s.patch.LineNumberOfLine(firstLineIdx), s.patch.LineNumberOfLine(lastLineIdx)
+}
+
+func (s *State) SelectNewLineNumber(lineNumber int) {
+	if patchLineIdx, ok := s.patch.LineIdxOfNewLineNumber(lineNumber); ok {
+		s.SelectLine(s.viewLineIndices[patchLineIdx])
+	}
+}
+
 func (s *State) AdjustSelectedLineIdx(change int) {
 	s.DismissHunkSelectMode()
 	s.SelectLine(s.selectedLineIdx + change)
 }
 
-func (s *State) RenderForLineIndices(includedLineIndices []int) string {
-	includedLineIndicesSet := set.NewFromSlice(includedLineIndices)
-	return s.patch.FormatView(patch.FormatViewOpts{
-		IncLineIndices: includedLineIndicesSet,
-	})
+func (s *State) RenderForLineIndices(includedLineIndices []int, opts patch.FormatViewOpts) string {
+	opts.IncLineIndices = set.NewFromSlice(includedLineIndices)
+	opts.ShowLineNumbers = s.showLineNumbers
+	return s.patch.FormatView(opts)
 }
 
 func (s *State) PlainRenderSelected() string {
@@ -406,9 +424,21 @@ func (s *State) CalculateOrigin(currentOrigin int, bufferHeight int, numLines in
 	return calculateOrigin(currentOrigin, bufferHeight, numLines, firstLineIdx, lastLineIdx, s.GetSelectedViewLineIdx(), s.selectMode)
 }
 
-func wrapPatchLines(diff string, view *gocui.View) ([]int, []int) {
+func wrapPatchLines(diff string, patch *patch.Patch, showLineNumbers bool, view *gocui.View) ([]int, []int) {
+	text := strings.TrimSuffix(diff, "\n")
+	if showLineNumbers {
+		// the line numbers take up space too, so they affect where lines wrap
+		lines := strings.Split(text, "\n")
+		gutters := patch.LineNumberGutters()
+		if len(lines) == len(gutters) {
+			text = strings.Join(lo.Map(lines, func(line string, i int) string {
+				return gutters[i] + line
+			}), "\n")
+		}
+	}
+
 	_, viewLineIndices, patchLineIndices := utils.WrapViewLinesToWidth(
-		view.Wrap, view.Editable, strings.TrimSuffix(diff, "\n"), view.InnerWidth(), view.TabWidth)
+		view.Wrap, view.Editable, text, view.InnerWidth(), view.TabWidth)
 	return viewLineIndices, patchLineIndices
 }
 
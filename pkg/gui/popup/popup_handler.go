@@ -81,6 +81,11 @@ func (self *PopupHandler) WithWaitingStatusSync(message string, f func() error)
 }
 
 func (self *PopupHandler) ErrorHandler(err error) error {
+	if errors.Is(err, gocui.ErrTaskCancelled) {
+		// The user cancelled the task themselves, so there's nothing to report
+		return nil
+	}
+
 	var notHandledError *types.ErrKeybindingNotHandled
 	if errors.As(err, &notHandledError) {
 		if !notHandledError.DisabledReason.ShowErrorInPanel {
@@ -1,6 +1,7 @@
 package authors
 
 import (
+	"cmp"
 	"crypto/md5"
 	"strings"
 
@@ -12,14 +13,17 @@ import (
 )
 
 type authorNameCacheKey struct {
-	authorName string
-	truncateTo int
+	authorName  string
+	authorEmail string
+	format      string
+	truncateTo  int
 }
 
 // if these being global variables causes trouble we can wrap them in a struct
 // attached to the gui state.
 var (
 	authorInitialCache = make(map[string]string)
+	authorAvatarCache  = make(map[string]string)
 	authorNameCache    = make(map[authorNameCacheKey]string)
 	authorStyleCache   = make(map[string]*style.TextStyle)
 )
@@ -42,15 +46,49 @@ func ShortAuthor(authorName string) string {
 	return value
 }
 
-func LongAuthor(authorName string, length int) string {
-	cacheKey := authorNameCacheKey{authorName: authorName, truncateTo: length}
+// The initials in reverse video, so that the author's color shows as a
+// block like an avatar
+func AvatarAuthor(authorName string) string {
+	if value, ok := authorAvatarCache[authorName]; ok {
+		return value
+	}
+
+	initials := getInitials(authorName)
+	if initials == "" {
+		return ""
+	}
+
+	value := AuthorStyle(authorName).SetReverse().Sprint(utils.WithPadding(initials, 2, utils.AlignLeft))
+	authorAvatarCache[authorName] = value
+
+	return value
+}
+
+// LongAuthor returns the author in the given format of the
+// gui.commitAuthorFormat config, padded to the length so that the authors
+// line up. A full name that is too long is shortened to the first name and
+// the initial of the last name before it is cut off.
+func LongAuthor(authorName string, authorEmail string, format string, length int) string {
+	cacheKey := authorNameCacheKey{authorName: authorName, authorEmail: authorEmail, format: format, truncateTo: length}
 	if value, ok := authorNameCache[cacheKey]; ok {
 		return value
 	}
 
-	paddedAuthorName := utils.WithPadding(authorName, length, utils.AlignLeft)
-	truncatedName := utils.TruncateWithEllipsis(paddedAuthorName, length)
-	value := AuthorStyle(authorName).Sprint(truncatedName)
+	text := authorName
+	switch format {
+	case "shortened":
+		text = shortenedName(authorName)
+	case "email":
+		text = cmp.Or(authorEmail, authorName)
+	default:
+		if utils.StringWidth(text) > length {
+			text = shortenedName(authorName)
+		}
+	}
+
+	paddedText := utils.WithPadding(text, length, utils.AlignLeft)
+	truncatedText := utils.TruncateWithEllipsis(paddedText, length)
+	value := AuthorStyle(authorName).Sprint(truncatedText)
 	authorNameCache[cacheKey] = value
 
 	return value
@@ -62,15 +100,29 @@ func LongAuthor(authorName string, length int) string {
 // - if the length is 2, it returns the initials
 // - otherwise, it returns the author name truncated to the maximum length
 func AuthorWithLength(authorName string, length int) string {
+	return AuthorWithFormat(authorName, "", "full", length)
+}
+
+// AuthorWithFormat is like AuthorWithLength, but shows the author in the given
+// format of the gui.commitAuthorFormat config. The 'initials' and 'avatar'
+// formats ignore the length, unless it's less than 2.
+func AuthorWithFormat(authorName string, authorEmail string, format string, length int) string {
 	if length < 2 {
 		return ""
 	}
 
+	switch format {
+	case "initials":
+		return ShortAuthor(authorName)
+	case "avatar":
+		return AvatarAuthor(authorName)
+	}
+
 	if length == 2 {
 		return ShortAuthor(authorName)
 	}
 
-	return LongAuthor(authorName, length)
+	return LongAuthor(authorName, authorEmail, format, length)
 }
 
 func AuthorStyle(authorName string) *style.TextStyle {
@@ -127,6 +179,16 @@ func getInitials(authorName string) string {
 	return utils.LimitStr(split[0], 1) + utils.LimitStr(split[1], 1)
 }
 
+// e.g. 'Jesse D.' for 'Jesse Duffield'
+func shortenedName(authorName string) string {
+	split := strings.Fields(authorName)
+	if len(split) < 2 {
+		return authorName
+	}
+
+	return split[0] + " " + utils.LimitStr(split[len(split)-1], 1) + "."
+}
+
 func getFirstRune(str string) rune {
 	// just using the loop for the sake of getting the first rune
 	for _, r := range str {
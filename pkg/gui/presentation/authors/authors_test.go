@@ -34,10 +34,35 @@ func TestAuthorWithLength(t *testing.T) {
 		{"Jesse Duffield", 1, ""},
 		{"Jesse Duffield", 2, "JD"},
 		{"Jesse Duffield", 3, "Je…"},
-		{"Jesse Duffield", 10, "Jesse Duf…"},
+		{"Jesse Duffield", 6, "Jesse…"},
+		{"Jesse Duffield", 10, "Jesse D.  "},
 		{"Jesse Duffield", 14, "Jesse Duffield"},
+		{"Jesse Duffield", 16, "Jesse Duffield  "},
 	}
 	for _, s := range scenarios {
 		assert.Equal(t, s.expectedOutput, utils.Decolorise(AuthorWithLength(s.authorName, s.length)))
 	}
 }
+
+func TestAuthorWithFormat(t *testing.T) {
+	scenarios := []struct {
+		format         string
+		length         int
+		expectedOutput string
+	}{
+		{"full", 16, "Jesse Duffield  "},
+		{"shortened", 16, "Jesse D.        "},
+		{"shortened", 6, "Jesse…"},
+		{"initials", 16, "JD"},
+		{"initials", 0, ""},
+		{"email", 24, "jesse@example.com       "},
+		{"email", 10, "jesse@exa…"},
+		{"email", 2, "JD"},
+		{"avatar", 16, "JD"},
+	}
+	for _, s := range scenarios {
+		t.Run(s.format, func(t *testing.T) {
+			assert.Equal(t, s.expectedOutput, utils.Decolorise(AuthorWithFormat("Jesse Duffield", "jesse@example.com", s.format, s.length)))
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package presentation
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"net/http"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// One side of the diff of a binary file
+type BinaryFileVersion struct {
+	Content []byte
+	// False if the file doesn't exist in this version, i.e. it was added or
+	// deleted
+	Exists bool
+}
+
+// Renders the information about a binary file that is shown in the main view
+// instead of git's "Binary files differ" message
+func GetBinaryFileInfo(path string, oldVersion BinaryFileVersion, newVersion BinaryFileVersion, tr *i18n.TranslationSet) string {
+	cell := func(version BinaryFileVersion, f func(content []byte) string) string {
+		if !version.Exists {
+			return "-"
+		}
+		return f(version.Content)
+	}
+
+	newSize := cell(newVersion, func(content []byte) string { return formatSize(len(content)) })
+	if oldVersion.Exists && newVersion.Exists && len(oldVersion.Content) != len(newVersion.Content) {
+		newSize += " " + formatSizeChange(len(newVersion.Content)-len(oldVersion.Content))
+	}
+
+	rows := [][]string{
+		{"", style.AttrBold.Sprint(tr.BinaryFileOldVersion), style.AttrBold.Sprint(tr.BinaryFileNewVersion)},
+		{tr.BinaryFileSize, cell(oldVersion, func(content []byte) string { return formatSize(len(content)) }), newSize},
+		{tr.BinaryFileType, cell(oldVersion, contentType), cell(newVersion, contentType)},
+	}
+	if imageDimensions(oldVersion.Content) != "" || imageDimensions(newVersion.Content) != "" {
+		rows = append(rows, []string{tr.BinaryFileDimensions, cell(oldVersion, imageDimensions), cell(newVersion, imageDimensions)})
+	}
+
+	lines, _ := utils.RenderDisplayStrings(rows, nil)
+
+	title := utils.ResolvePlaceholderString(tr.BinaryFileTitle, map[string]string{"path": path})
+	return style.FgYellow.Sprint(title) + "\n\n" + strings.Join(lines, "\n")
+}
+
+func formatSize(size int) string {
+	return utils.FormatByteSize(int64(size))
+}
+
+func formatSizeChange(change int) string {
+	if change < 0 {
+		return style.FgRed.Sprintf("(-%s)", formatSize(-change))
+	}
+	return style.FgGreen.Sprintf("(+%s)", formatSize(change))
+}
+
+func contentType(content []byte) string {
+	contentType, _, _ := strings.Cut(http.DetectContentType(content), ";")
+	return contentType
+}
+
+// Returns the width and height of the image, or "" if the content isn't an
+// image in one of the formats we know about
+func imageDimensions(content []byte) string {
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%d×%d", config.Width, config.Height)
+}
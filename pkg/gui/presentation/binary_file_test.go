@@ -0,0 +1,70 @@
+package presentation
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func pngImage(t *testing.T, width int, height int) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func TestGetBinaryFileInfo(t *testing.T) {
+	smallImage := pngImage(t, 4, 2)
+	largeImage := pngImage(t, 300, 200)
+
+	scenarios := []struct {
+		testName   string
+		oldVersion BinaryFileVersion
+		newVersion BinaryFileVersion
+		expected   string
+	}{
+		{
+			testName:   "modified image",
+			oldVersion: BinaryFileVersion{Content: smallImage, Exists: true},
+			newVersion: BinaryFileVersion{Content: largeImage, Exists: true},
+			expected: "Binary file file.bin\n" +
+				"\n" +
+				"           Old       New\n" +
+				"Size       " + fmt.Sprintf("%-9s", formatSize(len(smallImage))) + " " + formatSize(len(largeImage)) + " (+" + formatSize(len(largeImage)-len(smallImage)) + ")\n" +
+				"Type       image/png image/png\n" +
+				"Dimensions 4×2       300×200",
+		},
+		{
+			testName:   "added file",
+			oldVersion: BinaryFileVersion{Exists: false},
+			newVersion: BinaryFileVersion{Content: []byte("\x00\x01\x02"), Exists: true},
+			expected: "Binary file file.bin\n" +
+				"\n" +
+				"     Old New\n" +
+				"Size -   3 B\n" +
+				"Type -   application/octet-stream",
+		},
+		{
+			testName:   "deleted file",
+			oldVersion: BinaryFileVersion{Content: make([]byte, 2048), Exists: true},
+			newVersion: BinaryFileVersion{Exists: false},
+			expected: "Binary file file.bin\n" +
+				"\n" +
+				"     Old                      New\n" +
+				"Size 2.0 KiB                  -\n" +
+				"Type application/octet-stream -",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			actual := GetBinaryFileInfo("file.bin", s.oldVersion, s.newVersion, i18n.EnglishTranslationSet())
+			assert.Equal(t, s.expected, utils.Decolorise(actual))
+		})
+	}
+}
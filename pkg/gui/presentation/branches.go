@@ -36,12 +36,63 @@ func GetBranchListDisplayStrings(
 	userConfig *config.UserConfig,
 	worktrees []*models.Worktree,
 ) [][]string {
+	lineTemplate, err := parseLineTemplate(userConfig.Gui.BranchLineTemplate)
 	return lo.Map(branches, func(branch *models.Branch, _ int) []string {
+		if err != nil {
+			return lineTemplateError(err)
+		}
 		diffed := branch.Name == diffName
+		if lineTemplate != nil {
+			return renderLineTemplate(lineTemplate, getBranchLineFields(branch, getItemOperation(branch), diffed, tr, userConfig, worktrees, time.Now()))
+		}
 		return getBranchDisplayStrings(branch, getItemOperation(branch), fullDescription, diffed, viewWidth, tr, userConfig, worktrees, time.Now())
 	})
 }
 
+func getBranchLineFields(
+	b *models.Branch,
+	itemOperation types.ItemOperation,
+	diffed bool,
+	tr *i18n.TranslationSet,
+	userConfig *config.UserConfig,
+	worktrees []*models.Worktree,
+	now time.Time,
+) BranchLineFields {
+	nameTextStyle := GetBranchTextStyle(b.Name)
+	if diffed {
+		nameTextStyle = theme.DiffTerminalColor
+	}
+
+	displayName := b.Name
+	if b.DisplayName != "" {
+		displayName = b.DisplayName
+	}
+
+	recencyColor := style.FgCyan
+	if b.Recency == "  *" {
+		recencyColor = style.FgGreen
+	}
+
+	fields := BranchLineFields{
+		Name:        nameTextStyle.Sprint(displayName),
+		Recency:     recencyColor.Sprint(branchRecency(b, userConfig, now)),
+		AheadBehind: BranchStatus(b, itemOperation, tr, now, userConfig),
+		Divergence:  style.FgCyan.Sprint(divergenceStr(b, itemOperation, tr, userConfig)),
+		Hash:        utils.ShortHash(b.CommitHash),
+		Subject:     b.Subject,
+	}
+	if b.IsTrackingRemote() {
+		fields.Upstream = style.FgYellow.Sprintf("%s/%s", b.UpstreamRemote, b.UpstreamBranch)
+	}
+	if icons.IsIconEnabled() {
+		fields.Icon = nameTextStyle.Sprint(icons.IconForBranch(b))
+	}
+	if git_commands.CheckedOutByOtherWorktree(b, worktrees) {
+		fields.Worktree = lo.Ternary(icons.IsIconEnabled(), icons.LINKED_WORKTREE_ICON, fmt.Sprintf("(%s)", tr.LcWorktree))
+	}
+	return fields
+}
+
 // getBranchDisplayStrings returns the display string of branch
 func getBranchDisplayStrings(
 	b *models.Branch,
@@ -59,9 +110,11 @@ func getBranchDisplayStrings(
 	branchStatus := BranchStatus(b, itemOperation, tr, now, userConfig)
 	divergence := divergenceStr(b, itemOperation, tr, userConfig)
 	worktreeIcon := lo.Ternary(icons.IsIconEnabled(), icons.LINKED_WORKTREE_ICON, fmt.Sprintf("(%s)", tr.LcWorktree))
+	recency := branchRecency(b, userConfig, now)
 
-	// Recency is always three characters, plus one for the space
-	availableWidth := viewWidth - 4
+	// Recency is three characters unless it's shown as a date, plus one for
+	// the space
+	availableWidth := viewWidth - max(utils.StringWidth(recency), 3) - 1
 	if len(divergence) > 0 {
 		availableWidth -= utils.StringWidth(divergence) + 1
 	}
@@ -111,7 +164,7 @@ func getBranchDisplayStrings(
 	}
 
 	res := make([]string, 0, 6)
-	res = append(res, recencyColor.Sprint(b.Recency))
+	res = append(res, recencyColor.Sprint(recency))
 
 	if icons.IsIconEnabled() {
 		res = append(res, nameTextStyle.Sprint(icons.IconForBranch(b)))
@@ -143,6 +196,16 @@ func getBranchDisplayStrings(
 	return res
 }
 
+// The recency of the branch in the way the gui.dateDisplay config asks for.
+// The checked-out branch keeps its marker.
+func branchRecency(b *models.Branch, userConfig *config.UserConfig, now time.Time) string {
+	if b.RecencyTimestamp == 0 || b.Head {
+		return b.Recency
+	}
+
+	return formatDate(&userConfig.Gui, "relative", now, b.RecencyTimestamp)
+}
+
 // GetBranchTextStyle branch color
 func GetBranchTextStyle(name string) style.TextStyle {
 	if style, ok := colorPatterns.match(name); ok {
@@ -187,15 +250,15 @@ func BranchStatus(
 		if branch.UpstreamGone {
 			result = style.FgRed.Sprint(tr.UpstreamGone)
 		} else if branch.MatchesUpstream() {
-			result = style.FgGreen.Sprint("✓")
+			result = style.FgGreen.Sprint(icons.UP_TO_DATE_ICON)
 		} else if branch.RemoteBranchNotStoredLocally() {
-			result = style.FgMagenta.Sprint("?")
+			result = style.FgMagenta.Sprint(icons.UPSTREAM_NOT_FETCHED_ICON)
 		} else if branch.IsBehindForPull() && branch.IsAheadForPull() {
-			result = style.FgYellow.Sprintf("↓%s↑%s", branch.BehindForPull, branch.AheadForPull)
+			result = style.FgYellow.Sprintf("%s%s%s%s", icons.BEHIND_ICON, branch.BehindForPull, icons.AHEAD_ICON, branch.AheadForPull)
 		} else if branch.IsBehindForPull() {
-			result = style.FgYellow.Sprintf("↓%s", branch.BehindForPull)
+			result = style.FgYellow.Sprint(icons.BEHIND_ICON + branch.BehindForPull)
 		} else if branch.IsAheadForPull() {
-			result = style.FgYellow.Sprintf("↑%s", branch.AheadForPull)
+			result = style.FgYellow.Sprint(icons.AHEAD_ICON + branch.AheadForPull)
 		}
 	}
 
@@ -212,10 +275,15 @@ func divergenceStr(
 	if ItemOperationToString(itemOperation, tr) == "" && userConfig.Gui.ShowDivergenceFromBaseBranch != "none" {
 		behind := branch.BehindBaseBranch.Load()
 		if behind != 0 {
-			if userConfig.Gui.ShowDivergenceFromBaseBranch == "arrowAndNumber" {
-				result += fmt.Sprintf("↓%d", behind)
+			if userConfig.Gui.ShowDivergenceFromBaseBranch == "onlyArrow" {
+				result += icons.BEHIND_ICON
 			} else {
-				result += "↓"
+				result += fmt.Sprintf("%s%d", icons.BEHIND_ICON, behind)
+			}
+		}
+		if userConfig.Gui.ShowDivergenceFromBaseBranch == "aheadAndBehind" {
+			if ahead := branch.AheadOfBaseBranch.Load(); ahead != 0 {
+				result += fmt.Sprintf("%s%d", icons.AHEAD_ICON, ahead)
 			}
 		}
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/gookit/color"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/common"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
@@ -153,6 +154,38 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 			showDivergenceCfg:    "arrowAndNumber",
 			expected:             []string{"1m", "branch_name ↓5↑3    ↓2"},
 		},
+		{
+			branch: &models.Branch{
+				Name:              "branch_name",
+				Recency:           "1m",
+				UpstreamRemote:    "origin",
+				AheadForPull:      "0",
+				BehindForPull:     "0",
+				BehindBaseBranch:  makeAtomic(2),
+				AheadOfBaseBranch: makeAtomic(4),
+			},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            24,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "aheadAndBehind",
+			expected:             []string{"1m", "branch_name ✓   ↓2↑4"},
+		},
+		{
+			branch: &models.Branch{
+				Name:              "branch_name",
+				Recency:           "1m",
+				AheadOfBaseBranch: makeAtomic(4),
+			},
+			itemOperation:        types.ItemOperationNone,
+			fullDescription:      false,
+			viewWidth:            20,
+			useIcons:             false,
+			checkedOutByWorktree: false,
+			showDivergenceCfg:    "arrowAndNumber",
+			expected:             []string{"1m", "branch_name"},
+		},
 		{
 			branch:               &models.Branch{Name: "branch_name", Recency: "1m"},
 			itemOperation:        types.ItemOperationPushing,
@@ -341,7 +374,7 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 	SetCustomBranches(c.UserConfig().Gui.BranchColorPatterns, true)
 
 	for i, s := range scenarios {
-		icons.SetNerdFontsVersion(lo.Ternary(s.useIcons, "3", ""))
+		icons.SetIconSet(lo.Ternary(s.useIcons, "nerdFontsV3", "none"), config.CustomIconsConfig{})
 		c.UserConfig().Gui.ShowDivergenceFromBaseBranch = s.showDivergenceCfg
 
 		worktrees := []*models.Worktree{}
@@ -355,3 +388,57 @@ func Test_getBranchDisplayStrings(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBranchListDisplayStringsWithLineTemplate(t *testing.T) {
+	scenarios := []struct {
+		testName     string
+		lineTemplate string
+		expected     [][]string
+	}{
+		{
+			testName:     "columns are separated by tabs",
+			lineTemplate: "{{.Recency}}\t{{.Name}} {{.AheadBehind}}\t{{.Upstream}}",
+			expected: [][]string{
+				{"1m", "main ✓", "origin/main"},
+				{"2d", "feature ", ""},
+			},
+		},
+		{
+			testName:     "unknown field",
+			lineTemplate: "{{.Nope}}",
+			expected: [][]string{
+				{`template: line:1:2: executing "line" at <.Nope>: can't evaluate field Nope in type presentation.BranchLineFields`},
+				{`template: line:1:2: executing "line" at <.Nope>: can't evaluate field Nope in type presentation.BranchLineFields`},
+			},
+		},
+		{
+			testName:     "syntax error",
+			lineTemplate: "{{.Name",
+			expected: [][]string{
+				{`template: line:1: unclosed action`},
+				{`template: line:1: unclosed action`},
+			},
+		},
+	}
+
+	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelNone)
+	defer color.ForceSetColorLevel(oldColorLevel)
+
+	c := common.NewDummyCommon()
+	SetCustomBranches(c.UserConfig().Gui.BranchColorPatterns, true)
+	icons.SetIconSet("none", config.CustomIconsConfig{})
+
+	branches := []*models.Branch{
+		{Name: "main", Recency: "1m", UpstreamRemote: "origin", UpstreamBranch: "main", AheadForPull: "0", BehindForPull: "0"},
+		{Name: "feature", Recency: "2d"},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			c.UserConfig().Gui.BranchLineTemplate = s.lineTemplate
+			result := GetBranchListDisplayStrings(branches, func(types.HasUrn) types.ItemOperation { return types.ItemOperationNone },
+				false, "", 100, c.Tr, c.UserConfig(), []*models.Worktree{})
+			assert.Equal(t, s.expected, result)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Clicking a hyperlink with this prefix selects the commit whose hash follows
+// it in the commits view
+const CommitHyperlinkPrefix = "lazygit-commit://"
+
+// Renders the header that is shown above the diff of a commit in the main view
+func GetCommitDetailsHeader(details *models.CommitDetails, tr *i18n.TranslationSet, timeFormat string) string {
+	person := func(name string, email string, timestamp int64) string {
+		return fmt.Sprintf("%s %s  %s",
+			name,
+			style.FgCyan.Sprintf("<%s>", email),
+			style.FgBlue.Sprint(time.Unix(timestamp, 0).Format(timeFormat)),
+		)
+	}
+
+	rows := [][]string{
+		{tr.CommitDetailsHash, style.FgYellow.Sprint(details.Hash)},
+		{tr.CommitDetailsAuthor, person(details.AuthorName, details.AuthorEmail, details.AuthorTimestamp)},
+		{tr.CommitDetailsCommitter, person(details.CommitterName, details.CommitterEmail, details.CommitterTimestamp)},
+	}
+	if len(details.Parents) > 0 {
+		parents := lo.Map(details.Parents, func(hash string, _ int) string {
+			return style.FgYellow.Sprint(style.PrintHyperlink(utils.ShortHash(hash), CommitHyperlinkPrefix+hash))
+		})
+		rows = append(rows, []string{tr.CommitDetailsParents, strings.Join(parents, " ")})
+	}
+	if len(details.Branches) > 0 {
+		rows = append(rows, []string{tr.CommitDetailsBranches, style.FgGreen.Sprint(strings.Join(details.Branches, ", "))})
+	}
+	if len(details.Tags) > 0 {
+		rows = append(rows, []string{tr.CommitDetailsTags, style.FgMagenta.Sprint(strings.Join(details.Tags, ", "))})
+	}
+
+	labelWidth := lo.Max(lo.Map(rows, func(row []string, _ int) int { return utils.StringWidth(row[0]) })) + 1
+
+	var builder strings.Builder
+	for _, row := range rows {
+		builder.WriteString(utils.WithPadding(row[0]+":", labelWidth, utils.AlignLeft) + " " + row[1] + "\n")
+	}
+
+	builder.WriteString("\n")
+	for _, line := range strings.Split(details.Message, "\n") {
+		builder.WriteString(strings.TrimRight("    "+line, " ") + "\n")
+	}
+
+	if len(details.Trailers) > 0 {
+		builder.WriteString("\n")
+		for _, trailer := range details.Trailers {
+			builder.WriteString("    " + style.FgCyan.Sprint(trailer.Key+":") + " " + trailer.Value + "\n")
+		}
+	}
+
+	builder.WriteString("\n")
+	return builder.String()
+}
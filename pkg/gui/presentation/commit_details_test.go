@@ -0,0 +1,80 @@
+package presentation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCommitDetailsHeader(t *testing.T) {
+	timeFormat := "2006-01-02"
+	authorDate := time.Unix(1700000000, 0).Format(timeFormat)
+	committerDate := time.Unix(1700000100, 0).Format(timeFormat)
+
+	scenarios := []struct {
+		testName string
+		details  *models.CommitDetails
+		expected string
+	}{
+		{
+			testName: "root commit",
+			details: &models.CommitDetails{
+				Hash:               "1234567890abcdef1234567890abcdef12345678",
+				AuthorName:         "Jane Doe",
+				AuthorEmail:        "jane@example.com",
+				AuthorTimestamp:    1700000000,
+				CommitterName:      "Jane Doe",
+				CommitterEmail:     "jane@example.com",
+				CommitterTimestamp: 1700000100,
+				Message:            "subject",
+			},
+			expected: "Commit:    1234567890abcdef1234567890abcdef12345678\n" +
+				"Author:    Jane Doe <jane@example.com>  " + authorDate + "\n" +
+				"Committer: Jane Doe <jane@example.com>  " + committerDate + "\n" +
+				"\n" +
+				"    subject\n" +
+				"\n",
+		},
+		{
+			testName: "commit with parents, refs and trailers",
+			details: &models.CommitDetails{
+				Hash:               "1234567890abcdef1234567890abcdef12345678",
+				AuthorName:         "Jane Doe",
+				AuthorEmail:        "jane@example.com",
+				AuthorTimestamp:    1700000000,
+				CommitterName:      "John Doe",
+				CommitterEmail:     "john@example.com",
+				CommitterTimestamp: 1700000100,
+				Parents:            []string{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"},
+				Message:            "subject\n\nbody",
+				Trailers:           []models.CommitTrailer{{Key: "Signed-off-by", Value: "Jane Doe <jane@example.com>"}},
+				Branches:           []string{"master", "feature"},
+				Tags:               []string{"v1.0"},
+			},
+			expected: "Commit:    1234567890abcdef1234567890abcdef12345678\n" +
+				"Author:    Jane Doe <jane@example.com>  " + authorDate + "\n" +
+				"Committer: John Doe <john@example.com>  " + committerDate + "\n" +
+				"Parents:   aaaaaaaa bbbbbbbb\n" +
+				"Branches:  master, feature\n" +
+				"Tags:      v1.0\n" +
+				"\n" +
+				"    subject\n" +
+				"\n" +
+				"    body\n" +
+				"\n" +
+				"    Signed-off-by: Jane Doe <jane@example.com>\n" +
+				"\n",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			result := GetCommitDetailsHeader(s.details, i18n.EnglishTranslationSet(), timeFormat)
+			assert.Equal(t, s.expected, utils.Decolorise(result))
+		})
+	}
+}
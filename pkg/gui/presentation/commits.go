@@ -2,7 +2,9 @@ package presentation
 
 import (
 	"fmt"
+	"hash/fnv"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/jesseduffield/generics/set"
@@ -13,6 +15,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/graph"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/kyokomi/emoji/v2"
@@ -32,6 +35,14 @@ var (
 	mutex        deadlock.Mutex
 )
 
+// Needed when the colors of the graph change
+func ClearPipeSetCache() {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	pipeSetCache = make(map[pipeSetCacheKey][][]graph.Pipe)
+}
+
 type bisectBounds struct {
 	newIndex int
 	oldIndex int
@@ -45,15 +56,16 @@ func GetCommitListDisplayStrings(
 	hasRebaseUpdateRefsConfig bool,
 	fullDescription bool,
 	cherryPickedCommitHashSet *set.Set[string],
+	markedCommitHashSet *set.Set[string],
 	diffName string,
 	markedBaseCommit string,
-	timeFormat string,
-	shortTimeFormat string,
+	commitStats map[string]*models.CommitStats,
 	now time.Time,
 	parseEmoji bool,
 	selectedCommitHashPtr *string,
 	startIdx int,
 	endIdx int,
+	viewWidth int,
 	showGraph bool,
 	bisectInfo *git_commands.BisectInfo,
 ) [][]string {
@@ -177,6 +189,21 @@ func GetCommitListDisplayStrings(
 					(hasRebaseUpdateRefsConfig || b.CommitHash != commits[0].Hash())
 		}))
 
+	lineTemplate, err := parseLineTemplate(common.UserConfig().Gui.CommitLineTemplate)
+	if err != nil {
+		return lo.Map(filteredCommits, func(*models.Commit, int) []string { return lineTemplateError(err) })
+	}
+
+	authorLength := common.UserConfig().Gui.CommitAuthorShortLength
+	if fullDescription {
+		authorLength = common.UserConfig().Gui.CommitAuthorLongLength
+	}
+	// Leave most of a narrow view to the subjects. A viewWidth of 0 means we
+	// don't know it.
+	if viewWidth > 0 {
+		authorLength = min(authorLength, max(viewWidth/4, 2))
+	}
+
 	lines := make([][]string, 0, len(filteredCommits))
 	var bisectStatus BisectStatus
 	willBeRebased := markedBaseCommit == ""
@@ -193,17 +220,19 @@ func GetCommitListDisplayStrings(
 			branchHeadsToVisualize,
 			hasRebaseUpdateRefsConfig,
 			cherryPickedCommitHashSet,
+			markedCommitHashSet.Includes(commit.Hash()),
 			isMarkedBaseCommit,
 			willBeRebased,
+			commitStats[commit.Hash()],
 			diffName,
-			timeFormat,
-			shortTimeFormat,
 			now,
 			parseEmoji,
 			getGraphLine(unfilteredIdx),
 			fullDescription,
+			authorLength,
 			bisectStatus,
 			bisectInfo,
+			lineTemplate,
 		))
 	}
 	return lines
@@ -258,6 +287,9 @@ func loadPipesets(commits []*models.Commit) [][]graph.Pipe {
 		// pipe sets are unique to a commit head. and a commit count. Sometimes we haven't loaded everything for that.
 		// so let's just cache it based on that.
 		getStyle := func(commit *models.Commit) *style.TextStyle {
+			if len(theme.GraphColors) > 0 {
+				return graphLaneStyle(commit.AuthorName)
+			}
 			return authors.AuthorStyle(commit.AuthorName)
 		}
 		pipeSets = graph.GetPipeSets(commits, getStyle)
@@ -267,6 +299,14 @@ func loadPipesets(commits []*models.Commit) [][]graph.Pipe {
 	return pipeSets
 }
 
+// Picks one of the theme's graph colors for the author, so that an author's
+// lanes always have the same color
+func graphLaneStyle(authorName string) *style.TextStyle {
+	hash := fnv.New32a()
+	hash.Write([]byte(authorName))
+	return &theme.GraphColors[hash.Sum32()%uint32(len(theme.GraphColors))]
+}
+
 // similar to the git_commands.BisectStatus but more gui-focused
 type BisectStatus int
 
@@ -344,17 +384,19 @@ func displayCommit(
 	branchHeadsToVisualize *set.Set[string],
 	hasRebaseUpdateRefsConfig bool,
 	cherryPickedCommitHashSet *set.Set[string],
+	isMarked bool,
 	isMarkedBaseCommit bool,
 	willBeRebased bool,
+	stats *models.CommitStats,
 	diffName string,
-	timeFormat string,
-	shortTimeFormat string,
 	now time.Time,
 	parseEmoji bool,
 	graphLine string,
 	fullDescription bool,
+	authorLength int,
 	bisectStatus BisectStatus,
 	bisectInfo *git_commands.BisectInfo,
+	lineTemplate *template.Template,
 ) []string {
 	bisectString := getBisectStatusText(bisectStatus, bisectInfo)
 
@@ -371,7 +413,7 @@ func displayCommit(
 
 	divergenceString := ""
 	if commit.Divergence != models.DivergenceNone {
-		divergenceString = hashColor.Sprint(lo.Ternary(commit.Divergence == models.DivergenceLeft, "↑", "↓"))
+		divergenceString = hashColor.Sprint(lo.Ternary(commit.Divergence == models.DivergenceLeft, icons.AHEAD_ICON, icons.BEHIND_ICON))
 	} else if icons.IsIconEnabled() {
 		divergenceString = hashColor.Sprint(icons.IconForCommit(commit))
 	}
@@ -379,7 +421,7 @@ func displayCommit(
 	descriptionString := ""
 	if fullDescription {
 		descriptionString = style.FgBlue.Sprint(
-			utils.UnixToDateSmart(now, commit.UnixTimestamp, timeFormat, shortTimeFormat),
+			formatDate(&common.UserConfig().Gui, "absolute", now, commit.UnixTimestamp),
 		)
 	}
 
@@ -404,7 +446,7 @@ func displayCommit(
 			// Don't show branch head on a "pick" todo if the rebase.updateRefs config is on
 			!(commit.IsTODO() && hasRebaseUpdateRefsConfig) {
 			tagString = style.FgCyan.SetBold().Sprint(
-				lo.Ternary(icons.IsIconEnabled(), icons.BRANCH_ICON, "*") + " " + tagString)
+				icons.BRANCH_HEAD_ICON + " " + tagString)
 		}
 	}
 
@@ -427,28 +469,81 @@ func displayCommit(
 		willBeRebased := style.FgYellow.Sprint("✓")
 		mark = fmt.Sprintf("%s ", willBeRebased)
 	}
+	if isMarked {
+		mark = style.FgMagenta.SetBold().Sprint("◆") + " " + mark
+	}
 
-	authorLength := common.UserConfig().Gui.CommitAuthorShortLength
-	if fullDescription {
-		authorLength = common.UserConfig().Gui.CommitAuthorLongLength
+	authorFormat := common.UserConfig().Gui.CommitAuthorFormat
+	author := authors.AuthorWithFormat(commit.AuthorName, commit.AuthorEmail, authorFormat, authorLength)
+
+	statsString := ""
+	if stats != nil && stats.FilesChanged > 0 {
+		statsString = fmt.Sprintf("%s %s %s",
+			theme.DefaultTextColor.Sprintf("%df", stats.FilesChanged),
+			style.FgGreen.Sprintf("+%d", stats.Insertions),
+			style.FgRed.Sprintf("-%d", stats.Deletions),
+		)
 	}
-	author := authors.AuthorWithLength(commit.AuthorName, authorLength)
 
-	cols := make([]string, 0, 7)
+	statusString := commitStatusText(common.Tr, commit)
+
+	if lineTemplate != nil {
+		fields := CommitLineFields{
+			Name:           theme.DefaultTextColor.Sprint(name),
+			Hash:           hashString,
+			Author:         authors.AuthorWithFormat(commit.AuthorName, commit.AuthorEmail, authorFormat, common.UserConfig().Gui.CommitAuthorLongLength),
+			AuthorInitials: authors.ShortAuthor(commit.AuthorName),
+			Tags:           tagString,
+			Graph:          graphLine,
+			Action:         actionString,
+			Mark:           mark,
+			Divergence:     divergenceString,
+			Bisect:         bisectString,
+			Stats:          statsString,
+			Status:         statusString,
+		}
+		// todo commits of an interactive rebase don't have a date
+		if commit.UnixTimestamp != 0 {
+			fields.Age = style.FgBlue.Sprint(utils.UnixToTimeAgo(commit.UnixTimestamp))
+			fields.Date = style.FgBlue.Sprint(formatDate(&common.UserConfig().Gui, "absolute", now, commit.UnixTimestamp))
+		}
+		return renderLineTemplate(lineTemplate, fields)
+	}
+
+	cols := make([]string, 0, 9)
+	cols = append(cols, divergenceString, hashString)
+	if common.UserConfig().Accessibility.Enabled {
+		// Otherwise, only the color of the hash tells
+		cols = append(cols, statusString)
+	}
 	cols = append(
 		cols,
-		divergenceString,
-		hashString,
 		bisectString,
 		descriptionString,
 		actionString,
 		author,
+		statsString,
 		graphLine+mark+tagString+theme.DefaultTextColor.Sprint(name),
 	)
 
 	return cols
 }
 
+// Whether the commit has been pushed or merged, as text rather than as the
+// color of its hash
+func commitStatusText(tr *i18n.TranslationSet, commit *models.Commit) string {
+	switch commit.Status {
+	case models.StatusUnpushed:
+		return tr.CommitStatusUnpushed
+	case models.StatusPushed:
+		return tr.CommitStatusPushed
+	case models.StatusMerged:
+		return tr.CommitStatusMerged
+	default:
+		return ""
+	}
+}
+
 func getBisectStatusColor(status BisectStatus) style.TextStyle {
 	switch status {
 	case BisectStatusNone:
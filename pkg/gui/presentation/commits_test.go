@@ -31,7 +31,9 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		hasUpdateRefConfig        bool
 		fullDescription           bool
 		cherryPickedCommitHashSet *set.Set[string]
+		markedCommitHashSet       *set.Set[string]
 		markedBaseCommit          string
+		commitStats               map[string]*models.CommitStats
 		diffName                  string
 		timeFormat                string
 		shortTimeFormat           string
@@ -40,8 +42,12 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		selectedCommitHashPtr     *string
 		startIdx                  int
 		endIdx                    int
+		viewWidth                 int
+		authorFormat              string
 		showGraph                 bool
 		bisectInfo                *git_commands.BisectInfo
+		lineTemplate              string
+		accessibility             bool
 		expected                  string
 		focus                     bool
 	}{
@@ -90,6 +96,49 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		hash2 commit2
 						`),
 		},
+		{
+			testName: "marked commits",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1"},
+				{Name: "commit2", Hash: "hash2"},
+				{Name: "commit3", Hash: "hash3"},
+			},
+			startIdx:                  0,
+			endIdx:                    3,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			markedCommitHashSet:       set.NewFromSlice([]string{"hash1", "hash3"}),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		hash1 ◆ commit1
+		hash2 commit2
+		hash3 ◆ commit3
+						`),
+		},
+		{
+			testName: "commit stats",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1"},
+				{Name: "commit2", Hash: "hash2"},
+				{Name: "commit3", Hash: "hash3"},
+			},
+			commitStats: map[string]*models.CommitStats{
+				"hash1": {FilesChanged: 3, Insertions: 10, Deletions: 2},
+				"hash2": {},
+			},
+			startIdx:                  0,
+			endIdx:                    3,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		hash1 3f +10 -2 commit1
+		hash2           commit2
+		hash3           commit3
+						`),
+		},
 		{
 			testName: "show local branch head, except the current branch, main branches, or merged branches",
 			commitOpts: []models.NewCommitOpts{
@@ -526,6 +575,122 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		hash2 2019-12-20 Jesse Duffield    commit2
 						`),
 		},
+		{
+			testName: "email as author",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1", UnixTimestamp: 1577844184, AuthorName: "Jesse Duffield", AuthorEmail: "jesse@example.com"},
+				{Name: "commit2", Hash: "hash2", UnixTimestamp: 1576844184, AuthorName: "Stefan Haller", AuthorEmail: "stefan.haller@example.com"},
+			},
+			fullDescription:           true,
+			authorFormat:              "email",
+			timeFormat:                "2006-01-02",
+			shortTimeFormat:           "3:04PM",
+			startIdx:                  0,
+			endIdx:                    2,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 5, 3, 4, 0, time.UTC),
+			expected: formatExpected(`
+		hash1 2:03AM     jesse@example.com commit1
+		hash2 2019-12-20 stefan.haller@ex… commit2
+						`),
+		},
+		{
+			testName: "authors shortened in a narrow view",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1", UnixTimestamp: 1577844184, AuthorName: "Jesse Duffield"},
+				{Name: "commit2", Hash: "hash2", UnixTimestamp: 1576844184, AuthorName: "Stefan Haller"},
+			},
+			fullDescription:           true,
+			timeFormat:                "2006-01-02",
+			shortTimeFormat:           "3:04PM",
+			startIdx:                  0,
+			endIdx:                    2,
+			viewWidth:                 40,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 5, 3, 4, 0, time.UTC),
+			expected: formatExpected(`
+		hash1 2:03AM     Jesse D.   commit1
+		hash2 2019-12-20 Stefan H.  commit2
+						`),
+		},
+		{
+			testName: "custom line template",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1", AuthorName: "Jesse Duffield", UnixTimestamp: 1577836800, Tags: []string{"tag1"}},
+				{Name: "commit2", Hash: "hash2", AuthorName: "Stefan Haller", UnixTimestamp: 1576800000},
+			},
+			startIdx:                  0,
+			endIdx:                    2,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			timeFormat:                "2006-01-02",
+			shortTimeFormat:           "3:04PM",
+			now:                       time.Date(2020, 1, 1, 5, 3, 4, 0, time.UTC),
+			lineTemplate:              "{{.AuthorInitials}}\t{{.Date}}\t{{.Tags}}{{.Name}} ({{.Hash}})",
+			expected: formatExpected(`
+		JD 12:00AM    tag1 commit1 (hash1)
+		SH 2019-12-20 commit2 (hash2)
+						`),
+		},
+		{
+			testName: "status as text in accessibility mode",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1", Status: models.StatusUnpushed},
+				{Name: "commit2", Hash: "hash2", Status: models.StatusPushed},
+				{Name: "commit3", Hash: "hash3", Status: models.StatusMerged},
+			},
+			startIdx:                  0,
+			endIdx:                    3,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			accessibility:             true,
+			expected: formatExpected(`
+		hash1 unpushed commit1
+		hash2 pushed   commit2
+		hash3 merged   commit3
+						`),
+		},
+		{
+			testName: "status in line template",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1", Status: models.StatusUnpushed},
+				{Name: "commit2", Hash: "hash2", Status: models.StatusMerged},
+			},
+			startIdx:                  0,
+			endIdx:                    2,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			lineTemplate:              "{{.Hash}} [{{.Status}}]\t{{.Name}}",
+			expected: formatExpected(`
+		hash1 [unpushed] commit1
+		hash2 [merged]   commit2
+						`),
+		},
+		{
+			testName: "line template with unknown field",
+			commitOpts: []models.NewCommitOpts{
+				{Name: "commit1", Hash: "hash1"},
+			},
+			startIdx:                  0,
+			endIdx:                    1,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			lineTemplate:              "{{.Subject}}",
+			expected: formatExpected(`
+		template: line:1:2: executing "line" at <.Subject>: can't evaluate field Subject in type presentation.CommitLineFields
+						`),
+		},
 	}
 
 	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelNone)
@@ -546,6 +711,11 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		if !focusing || s.focus {
 			t.Run(s.testName, func(t *testing.T) {
 				hashPool := &utils.StringPool{}
+				common.UserConfig().Gui.CommitLineTemplate = s.lineTemplate
+				common.UserConfig().Accessibility.Enabled = s.accessibility
+				common.UserConfig().Gui.TimeFormat = s.timeFormat
+				common.UserConfig().Gui.ShortTimeFormat = s.shortTimeFormat
+				common.UserConfig().Gui.CommitAuthorFormat = lo.Ternary(s.authorFormat != "", s.authorFormat, "full")
 
 				commits := lo.Map(s.commitOpts,
 					func(opts models.NewCommitOpts, _ int) *models.Commit { return models.NewCommit(hashPool, opts) })
@@ -558,15 +728,16 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 					s.hasUpdateRefConfig,
 					s.fullDescription,
 					s.cherryPickedCommitHashSet,
+					lo.Ternary(s.markedCommitHashSet != nil, s.markedCommitHashSet, set.New[string]()),
 					s.diffName,
 					s.markedBaseCommit,
-					s.timeFormat,
-					s.shortTimeFormat,
+					s.commitStats,
 					s.now,
 					s.parseEmoji,
 					s.selectedCommitHashPtr,
 					s.startIdx,
 					s.endIdx,
+					s.viewWidth,
 					s.showGraph,
 					s.bisectInfo,
 				)
@@ -0,0 +1,27 @@
+package presentation
+
+import (
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Formats a date in the way the gui.dateDisplay config asks for.
+// defaultDisplay is what the panel shows when the config is 'default', i.e.
+// 'relative' or 'absolute'.
+func formatDate(guiConfig *config.GuiConfig, defaultDisplay string, now time.Time, timestamp int64) string {
+	display := guiConfig.DateDisplay
+	if display == "default" {
+		display = defaultDisplay
+	}
+
+	switch display {
+	case "relative":
+		return utils.UnixToTimeAgoSince(now, timestamp)
+	case "custom":
+		return time.Unix(timestamp, 0).Format(guiConfig.CustomTimeFormat)
+	default:
+		return utils.UnixToDateSmart(now, timestamp, guiConfig.TimeFormat, guiConfig.ShortTimeFormat)
+	}
+}
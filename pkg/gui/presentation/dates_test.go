@@ -0,0 +1,81 @@
+package presentation
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDate(t *testing.T) {
+	os.Setenv("TZ", "UTC")
+
+	now := time.Date(2020, 1, 1, 5, 3, 4, 0, time.UTC)
+	today := time.Date(2020, 1, 1, 2, 3, 4, 0, time.UTC).Unix()
+	lastMonth := time.Date(2019, 12, 20, 12, 0, 0, 0, time.UTC).Unix()
+
+	scenarios := []struct {
+		testName       string
+		dateDisplay    string
+		defaultDisplay string
+		timestamp      int64
+		expected       string
+	}{
+		{
+			testName:       "default display of a panel showing absolute dates",
+			dateDisplay:    "default",
+			defaultDisplay: "absolute",
+			timestamp:      lastMonth,
+			expected:       "2019-12-20",
+		},
+		{
+			testName:       "default display of a panel showing relative dates",
+			dateDisplay:    "default",
+			defaultDisplay: "relative",
+			timestamp:      lastMonth,
+			expected:       "1w",
+		},
+		{
+			testName:       "relative",
+			dateDisplay:    "relative",
+			defaultDisplay: "absolute",
+			timestamp:      today,
+			expected:       "3h",
+		},
+		{
+			testName:       "absolute date of today",
+			dateDisplay:    "absolute",
+			defaultDisplay: "relative",
+			timestamp:      today,
+			expected:       "2:03AM",
+		},
+		{
+			testName:       "absolute",
+			dateDisplay:    "absolute",
+			defaultDisplay: "relative",
+			timestamp:      lastMonth,
+			expected:       "2019-12-20",
+		},
+		{
+			testName:       "custom",
+			dateDisplay:    "custom",
+			defaultDisplay: "relative",
+			timestamp:      today,
+			expected:       "2020/01/01 02:03",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			guiConfig := &config.GuiConfig{
+				DateDisplay:      s.dateDisplay,
+				TimeFormat:       "2006-01-02",
+				ShortTimeFormat:  "3:04PM",
+				CustomTimeFormat: "2006/01/02 15:04",
+			}
+			assert.Equal(t, s.expected, formatDate(guiConfig, s.defaultDisplay, now, s.timestamp))
+		})
+	}
+}
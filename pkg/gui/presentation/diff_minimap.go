@@ -0,0 +1,46 @@
+package presentation
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+)
+
+// DiffMinimap condenses the lines of a diff into the given number of rows for
+// the minimap next to the main view, coloring each row by the kind of changes
+// in the lines it covers
+func DiffMinimap(lines []string, rowCount int) []gocui.Attribute {
+	if rowCount < 1 || len(lines) == 0 {
+		return nil
+	}
+
+	added := make([]bool, rowCount)
+	removed := make([]bool, rowCount)
+	for i, line := range lines {
+		row := i * rowCount / len(lines)
+		switch {
+		case strings.HasPrefix(line, "+++ ") || strings.HasPrefix(line, "--- "):
+			// file header
+		case strings.HasPrefix(line, "+"):
+			added[row] = true
+		case strings.HasPrefix(line, "-"):
+			removed[row] = true
+		}
+	}
+
+	rows := make([]gocui.Attribute, rowCount)
+	for i := range rows {
+		switch {
+		case added[i] && removed[i]:
+			rows[i] = gocui.ColorYellow
+		case added[i]:
+			rows[i] = gocui.ColorGreen
+		case removed[i]:
+			rows[i] = gocui.ColorRed
+		default:
+			rows[i] = gocui.ColorDefault
+		}
+	}
+
+	return rows
+}
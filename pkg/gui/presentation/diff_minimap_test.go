@@ -0,0 +1,86 @@
+package presentation
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffMinimap(t *testing.T) {
+	diff := []string{
+		"diff --git a/file b/file",
+		"--- a/file",
+		"+++ b/file",
+		"@@ -1,4 +1,4 @@",
+		" one",
+		"-two",
+		"+2",
+		" three",
+	}
+
+	scenarios := []struct {
+		testName string
+		lines    []string
+		rowCount int
+		expected []gocui.Attribute
+	}{
+		{
+			testName: "no lines",
+			lines:    nil,
+			rowCount: 4,
+			expected: nil,
+		},
+		{
+			testName: "one line per row",
+			lines:    diff,
+			rowCount: 8,
+			expected: []gocui.Attribute{
+				gocui.ColorDefault,
+				gocui.ColorDefault,
+				gocui.ColorDefault,
+				gocui.ColorDefault,
+				gocui.ColorDefault,
+				gocui.ColorRed,
+				gocui.ColorGreen,
+				gocui.ColorDefault,
+			},
+		},
+		{
+			testName: "several lines per row",
+			lines:    diff,
+			rowCount: 4,
+			expected: []gocui.Attribute{
+				gocui.ColorDefault,
+				gocui.ColorDefault,
+				gocui.ColorRed,
+				gocui.ColorGreen,
+			},
+		},
+		{
+			testName: "added and removed lines in the same row",
+			lines:    diff,
+			rowCount: 2,
+			expected: []gocui.Attribute{
+				gocui.ColorDefault,
+				gocui.ColorYellow,
+			},
+		},
+		{
+			testName: "fewer lines than rows",
+			lines:    []string{"+added"},
+			rowCount: 3,
+			expected: []gocui.Attribute{
+				gocui.ColorGreen,
+				gocui.ColorDefault,
+				gocui.ColorDefault,
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, DiffMinimap(s.lines, s.rowCount))
+		})
+	}
+}
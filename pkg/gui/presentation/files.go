@@ -39,13 +39,14 @@ func RenderCommitFileTree(
 	tree *filetree.CommitFileTreeViewModel,
 	patchBuilder *patch.PatchBuilder,
 	showFileIcons bool,
+	showNumstat bool,
 	customIconsConfig *config.CustomIconsConfig,
 ) []string {
 	collapsedPaths := tree.CollapsedPaths()
 	return renderAux(tree.GetRoot().Raw(), collapsedPaths, -1, -1, func(node *filetree.Node[models.CommitFile], treeDepth int, visualDepth int, isCollapsed bool) string {
 		status := commitFilePatchStatus(node, tree, patchBuilder)
 
-		return getCommitFileLine(isCollapsed, treeDepth, visualDepth, node, status, showFileIcons, customIconsConfig)
+		return getCommitFileLine(isCollapsed, treeDepth, visualDepth, node, status, showFileIcons, showNumstat, customIconsConfig)
 	})
 }
 
@@ -172,6 +173,10 @@ func getFileLine(
 		output += theme.DefaultTextColor.Sprint(" (submodule)")
 	}
 
+	if file != nil && (file.SkipWorktree || file.AssumeUnchanged) {
+		output += style.FgYellow.Sprintf(" (%s)", strings.Join(file.IndexFlagNames(), ", "))
+	}
+
 	if file != nil && showNumstat {
 		if lineChanges := formatLineChanges(file.LinesAdded, file.LinesDeleted); lineChanges != "" {
 			output += " " + lineChanges
@@ -204,14 +209,14 @@ func formatLineChanges(linesAdded, linesDeleted int) string {
 	output := ""
 
 	if linesAdded != 0 {
-		output += style.FgGreen.Sprintf("+%d", linesAdded)
+		output += theme.AddedLinesColor.Sprintf("+%d", linesAdded)
 	}
 
 	if linesDeleted != 0 {
 		if output != "" {
 			output += " "
 		}
-		output += style.FgRed.Sprintf("-%d", linesDeleted)
+		output += theme.RemovedLinesColor.Sprintf("-%d", linesDeleted)
 	}
 
 	return output
@@ -224,6 +229,7 @@ func getCommitFileLine(
 	node *filetree.Node[models.CommitFile],
 	status patch.PatchStatus,
 	showFileIcons bool,
+	showNumstat bool,
 	customIconsConfig *config.CustomIconsConfig,
 ) string {
 	indentation := strings.Repeat("  ", visualDepth)
@@ -279,6 +285,13 @@ func getCommitFileLine(
 	}
 
 	output += nameColor.Sprint(name)
+
+	if commitFile != nil && showNumstat {
+		if lineChanges := formatLineChanges(commitFile.LinesAdded, commitFile.LinesDeleted); lineChanges != "" {
+			output += " " + lineChanges
+		}
+	}
+
 	return output
 }
 
@@ -131,12 +131,13 @@ M  file1
 
 func TestRenderCommitFileTree(t *testing.T) {
 	scenarios := []struct {
-		name           string
-		root           *filetree.FileNode
-		files          []*models.CommitFile
-		collapsedPaths []string
-		showRootItem   bool
-		expected       []string
+		name            string
+		root            *filetree.FileNode
+		files           []*models.CommitFile
+		collapsedPaths  []string
+		showRootItem    bool
+		showLineChanges bool
+		expected        []string
 	}{
 		{
 			name:     "nil node",
@@ -151,6 +152,24 @@ func TestRenderCommitFileTree(t *testing.T) {
 			showRootItem: true,
 			expected:     []string{"A test"},
 		},
+		{
+			name: "numstat",
+			files: []*models.CommitFile{
+				{Path: "test", ChangeStatus: "M", LinesAdded: 1, LinesDeleted: 2},
+				{Path: "test2", ChangeStatus: "A", LinesAdded: 3},
+				{Path: "test3", ChangeStatus: "M"},
+			},
+			showRootItem:    true,
+			showLineChanges: true,
+			expected: toStringSlice(
+				`
+▼ /
+  M test +1 -2
+  A test2 +3
+  M test3
+`,
+			),
+		},
 		{
 			name: "big example",
 			files: []*models.CommitFile{
@@ -224,7 +243,7 @@ M file1
 				},
 			)
 			patchBuilder.Start("from", "to", false, false)
-			result := RenderCommitFileTree(viewModel, patchBuilder, false, &config.CustomIconsConfig{})
+			result := RenderCommitFileTree(viewModel, patchBuilder, false, s.showLineChanges, &config.CustomIconsConfig{})
 			assert.EqualValues(t, s.expected, result)
 		})
 	}
@@ -756,14 +756,14 @@ var extIconMap = map[string]IconProperties{
 	".zst":            {Icon: "\uf410", Color: "#ECA517"},     // 
 }
 
-func patchFileIconsForNerdFontsV2() {
-	extIconMap[".cs"] = IconProperties{Icon: "\uf81a", Color: "#FEDECA"}      // 
-	extIconMap[".csproj"] = IconProperties{Icon: "\uf81a", Color: "#AB48BC"}  // 
-	extIconMap[".csx"] = IconProperties{Icon: "\uf81a", Color: "#0188D1"}     // 
-	extIconMap[".license"] = IconProperties{Icon: "\uf718", Color: "#626262"} // 
-	extIconMap[".node"] = IconProperties{Icon: "\uf898", Color: "#E8274B"}    // 
-	extIconMap[".rtf"] = IconProperties{Icon: "\uf718", Color: "#626262"}     // 
-	extIconMap[".vue"] = IconProperties{Icon: "\ufd42", Color: "#89e051"}     // ﵂
+var extIconMapForNerdFontsV2 = map[string]IconProperties{
+	".cs":      {Icon: "\uf81a", Color: "#FEDECA"}, // 
+	".csproj":  {Icon: "\uf81a", Color: "#AB48BC"}, // 
+	".csx":     {Icon: "\uf81a", Color: "#0188D1"}, // 
+	".license": {Icon: "\uf718", Color: "#626262"}, // 
+	".node":    {Icon: "\uf898", Color: "#E8274B"}, // 
+	".rtf":     {Icon: "\uf718", Color: "#626262"}, // 
+	".vue":     {Icon: "\ufd42", Color: "#89e051"}, // ﵂
 }
 
 func IconForFile(name string, isSubmodule bool, isLinkedWorktree bool, isDirectory bool, customIconsConfig *config.CustomIconsConfig) IconProperties {
@@ -771,7 +771,7 @@ func IconForFile(name string, isSubmodule bool, isLinkedWorktree bool, isDirecto
 	if icon, ok := customIconsConfig.Filenames[base]; ok {
 		return IconProperties{Color: icon.Color, Icon: icon.Icon}
 	}
-	if icon, ok := nameIconMap[base]; ok {
+	if icon, ok := nameIconMap[base]; ok && currentIconSet.typeIcons {
 		return icon
 	}
 
@@ -779,8 +779,13 @@ func IconForFile(name string, isSubmodule bool, isLinkedWorktree bool, isDirecto
 	if icon, ok := customIconsConfig.Extensions[ext]; ok {
 		return IconProperties{Color: icon.Color, Icon: icon.Icon}
 	}
-	if icon, ok := extIconMap[ext]; ok {
-		return icon
+	if currentIconSet.typeIcons {
+		if icon, ok := extIconMapForNerdFontsV2[ext]; ok && currentIconSet.name == "nerdFontsV2" {
+			return icon
+		}
+		if icon, ok := extIconMap[ext]; ok {
+			return icon
+		}
 	}
 
 	if isSubmodule {
@@ -6,16 +6,24 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 )
 
+// These are set by SetIconSet
 var (
-	BRANCH_ICON                  = "\U000f062c" // 󰘬
-	DETACHED_HEAD_ICON           = "\ue729"     // 
-	TAG_ICON                     = "\uf02b"     // 
-	COMMIT_ICON                  = "\U000f0718" // 󰜘
-	MERGE_COMMIT_ICON            = "\U000f062d" // 󰘭
-	DEFAULT_REMOTE_ICON          = "\U000f02a2" // 󰊢
-	STASH_ICON                   = "\uf01c"     // 
-	LINKED_WORKTREE_ICON         = "\U000f0339" // 󰌹
-	MISSING_LINKED_WORKTREE_ICON = "\U000f033a" // 󰌺
+	BRANCH_ICON                  string
+	DETACHED_HEAD_ICON           string
+	TAG_ICON                     string
+	COMMIT_ICON                  string
+	MERGE_COMMIT_ICON            string
+	DEFAULT_REMOTE_ICON          string
+	STASH_ICON                   string
+	LINKED_WORKTREE_ICON         string
+	MISSING_LINKED_WORKTREE_ICON string
+
+	// These are shown even if icons are disabled
+	UP_TO_DATE_ICON           string
+	AHEAD_ICON                string
+	BEHIND_ICON               string
+	UPSTREAM_NOT_FETCHED_ICON string
+	BRANCH_HEAD_ICON          string
 )
 
 var remoteIcons = map[string]string{
@@ -35,15 +43,8 @@ var remoteIcons = map[string]string{
 	"sr.ht":                  "\uf1db",     // 
 }
 
-func patchGitIconsForNerdFontsV2() {
-	BRANCH_ICON = "\ufb2b"                  // שׂ
-	COMMIT_ICON = "\ufc16"                  // ﰖ
-	MERGE_COMMIT_ICON = "\ufb2c"            // שּׁ
-	DEFAULT_REMOTE_ICON = "\uf7a1"          // 
-	LINKED_WORKTREE_ICON = "\uf838"         // 
-	MISSING_LINKED_WORKTREE_ICON = "\uf839" // 
-
-	remoteIcons["dev.azure.com"] = "\ufd03" // ﴃ
+var remoteIconsForNerdFontsV2 = map[string]string{
+	"dev.azure.com": "\ufd03", // ﴃ
 }
 
 func IconForBranch(branch *models.Branch) string {
@@ -69,7 +70,16 @@ func IconForCommit(commit *models.Commit) string {
 }
 
 func IconForRemote(remote *models.Remote) string {
+	if !currentIconSet.typeIcons {
+		return DEFAULT_REMOTE_ICON
+	}
+
 	for domain, icon := range remoteIcons {
+		if currentIconSet.name == "nerdFontsV2" {
+			if v2Icon, ok := remoteIconsForNerdFontsV2[domain]; ok {
+				icon = v2Icon
+			}
+		}
 		for _, url := range remote.Urls {
 			if strings.Contains(url, domain) {
 				return icon
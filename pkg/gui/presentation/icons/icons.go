@@ -1,9 +1,10 @@
 package icons
 
 import (
-	"log"
+	"cmp"
+	"strings"
 
-	"github.com/samber/lo"
+	"github.com/jesseduffield/lazygit/pkg/config"
 )
 
 type IconProperties struct {
@@ -11,25 +12,215 @@ type IconProperties struct {
 	Color string
 }
 
-var isIconEnabled = false
+type iconSet struct {
+	name string
+	// False for the set that only has the icons that are shown without an
+	// icon set, like the arrows of the branch status
+	enabled bool
+	// Whether files and remotes get icons for their type, e.g. a Go logo for
+	// .go files
+	typeIcons bool
+
+	branch                string
+	detachedHead          string
+	tag                   string
+	commit                string
+	mergeCommit           string
+	defaultRemote         string
+	stash                 string
+	linkedWorktree        string
+	missingLinkedWorktree string
+
+	file      IconProperties
+	submodule IconProperties
+	directory IconProperties
+
+	upToDate           string
+	ahead              string
+	behind             string
+	upstreamNotFetched string
+	branchHead         string
+}
+
+var noIcons = iconSet{
+	name:               "none",
+	upToDate:           "✓",
+	ahead:              "↑",
+	behind:             "↓",
+	upstreamNotFetched: "?",
+	branchHead:         "*",
+}
+
+var nerdFontsV3Icons = iconSet{
+	name:                  "nerdFontsV3",
+	enabled:               true,
+	typeIcons:             true,
+	branch:                "\U000f062c", // 󰘬
+	detachedHead:          "\ue729",     // 
+	tag:                   "\uf02b",     // 
+	commit:                "\U000f0718", // 󰜘
+	mergeCommit:           "\U000f062d", // 󰘭
+	defaultRemote:         "\U000f02a2", // 󰊢
+	stash:                 "\uf01c",     // 
+	linkedWorktree:        "\U000f0339", // 󰌹
+	missingLinkedWorktree: "\U000f033a", // 󰌺
+
+	file:      IconProperties{Icon: "\uf15b", Color: "#878787"},     // 
+	submodule: IconProperties{Icon: "\U000f02a2", Color: "#FF4F00"}, // 󰊢
+	directory: IconProperties{Icon: "\uf07b", Color: "#878787"},     // 
+
+	upToDate:           "✓",
+	ahead:              "↑",
+	behind:             "↓",
+	upstreamNotFetched: "?",
+	branchHead:         "\U000f062c", // 󰘬
+}
+
+var iconSets = map[string]iconSet{
+	"none":        noIcons,
+	"nerdFontsV3": nerdFontsV3Icons,
+	"nerdFontsV2": withNerdFontsV2Icons(nerdFontsV3Icons),
+	"emoji": {
+		name:                  "emoji",
+		enabled:               true,
+		branch:                "🌿",
+		detachedHead:          "📌",
+		tag:                   "🔖",
+		commit:                "🔹",
+		mergeCommit:           "🔀",
+		defaultRemote:         "🌐",
+		stash:                 "📦",
+		linkedWorktree:        "🌳",
+		missingLinkedWorktree: "🍂",
+		file:                  IconProperties{Icon: "📄"},
+		submodule:             IconProperties{Icon: "📦"},
+		directory:             IconProperties{Icon: "📁"},
+		upToDate:              "✓",
+		ahead:                 "↑",
+		behind:                "↓",
+		upstreamNotFetched:    "?",
+		branchHead:            "🌿",
+	},
+	// For terminals and fonts that can't even show arrows. File types follow
+	// the letters of `ls -l`.
+	"ascii": {
+		name:                  "ascii",
+		enabled:               true,
+		branch:                "*",
+		detachedHead:          "@",
+		tag:                   "#",
+		commit:                "o",
+		mergeCommit:           "M",
+		defaultRemote:         "R",
+		stash:                 "S",
+		linkedWorktree:        "W",
+		missingLinkedWorktree: "!",
+		file:                  IconProperties{Icon: "-", Color: "#878787"},
+		submodule:             IconProperties{Icon: "m", Color: "#878787"},
+		directory:             IconProperties{Icon: "d", Color: "#878787"},
+		upToDate:              "=",
+		ahead:                 "^",
+		behind:                "v",
+		upstreamNotFetched:    "?",
+		branchHead:            "*",
+	},
+}
+
+func withNerdFontsV2Icons(set iconSet) iconSet {
+	set.name = "nerdFontsV2"
+	set.branch = "\ufb2b"                // שׂ
+	set.commit = "\ufc16"                // ﰖ
+	set.mergeCommit = "\ufb2c"           // שּׁ
+	set.defaultRemote = "\uf7a1"         // 
+	set.linkedWorktree = "\uf838"        // 
+	set.missingLinkedWorktree = "\uf839" // 
+	set.branchHead = "\ufb2b"            // שׂ
+	return set
+}
+
+var currentIconSet iconSet
+
+func init() {
+	SetIconSet("none", config.CustomIconsConfig{})
+}
 
 func IsIconEnabled() bool {
-	return isIconEnabled
+	return currentIconSet.enabled
 }
 
-func SetNerdFontsVersion(version string) {
-	if version == "" {
-		isIconEnabled = false
-	} else {
-		if !lo.Contains([]string{"2", "3"}, version) {
-			log.Fatalf("Unsupported nerdFontVersion %s", version)
-		}
+// Makes the icon set with the given name the current one, with the custom
+// icons of the config on top. Unknown names mean no icons.
+func SetIconSet(name string, customIcons config.CustomIconsConfig) {
+	set, ok := iconSets[name]
+	if !ok {
+		set = noIcons
+	}
+	currentIconSet = set
+
+	BRANCH_ICON = set.branch
+	DETACHED_HEAD_ICON = set.detachedHead
+	TAG_ICON = set.tag
+	COMMIT_ICON = cmp.Or(customIcons.Commits.Commit, set.commit)
+	MERGE_COMMIT_ICON = cmp.Or(customIcons.Commits.MergeCommit, set.mergeCommit)
+	DEFAULT_REMOTE_ICON = set.defaultRemote
+	STASH_ICON = set.stash
+	LINKED_WORKTREE_ICON = set.linkedWorktree
+	MISSING_LINKED_WORKTREE_ICON = set.missingLinkedWorktree
 
-		if version == "2" {
-			patchGitIconsForNerdFontsV2()
-			patchFileIconsForNerdFontsV2()
+	DEFAULT_FILE_ICON = set.file
+	DEFAULT_SUBMODULE_ICON = set.submodule
+	DEFAULT_DIRECTORY_ICON = set.directory
+
+	UP_TO_DATE_ICON = cmp.Or(customIcons.BranchStatus.UpToDate, set.upToDate)
+	AHEAD_ICON = cmp.Or(customIcons.BranchStatus.Ahead, set.ahead)
+	BEHIND_ICON = cmp.Or(customIcons.BranchStatus.Behind, set.behind)
+	UPSTREAM_NOT_FETCHED_ICON = cmp.Or(customIcons.BranchStatus.UpstreamNotFetched, set.upstreamNotFetched)
+	BRANCH_HEAD_ICON = cmp.Or(customIcons.Commits.BranchHead, set.branchHead)
+}
+
+// Returns the name of the icon set that the config asks for, detecting it if
+// the config says 'auto'
+func IconSetName(guiConfig *config.GuiConfig, getenv func(string) string) string {
+	switch guiConfig.IconSet {
+	case "auto":
+		return DetectIconSet(getenv)
+	case "":
+		// The options from before there were icon sets
+		if guiConfig.NerdFontsVersion != "" {
+			return "nerdFontsV" + guiConfig.NerdFontsVersion
+		}
+		if guiConfig.ShowIcons {
+			return "nerdFontsV2"
 		}
+		return "none"
+	default:
+		return guiConfig.IconSet
+	}
+}
 
-		isIconEnabled = true
+// There's no way to ask a terminal which fonts it has, so we can only go by
+// the terminals that come with the Nerd Fonts symbols built in. Everywhere else
+// we play it safe.
+func DetectIconSet(getenv func(string) string) string {
+	switch getenv("TERM_PROGRAM") {
+	case "WezTerm", "ghostty":
+		return "nerdFontsV3"
 	}
+	switch getenv("TERM") {
+	case "xterm-kitty", "xterm-ghostty":
+		return "nerdFontsV3"
+	}
+
+	// Without UTF-8 not even the arrows of the branch status would show
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale := getenv(name); locale != "" {
+			locale = strings.ToLower(locale)
+			if !strings.Contains(locale, "utf-8") && !strings.Contains(locale, "utf8") {
+				return "ascii"
+			}
+			break
+		}
+	}
+
+	return "none"
 }
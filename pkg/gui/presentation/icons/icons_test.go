@@ -0,0 +1,119 @@
+package icons
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIconSetName(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		setup    func(guiConfig *config.GuiConfig)
+		env      map[string]string
+		expected string
+	}{
+		{
+			name:     "nothing configured",
+			setup:    func(guiConfig *config.GuiConfig) {},
+			expected: "none",
+		},
+		{
+			name: "nerd fonts version",
+			setup: func(guiConfig *config.GuiConfig) {
+				guiConfig.NerdFontsVersion = "3"
+			},
+			expected: "nerdFontsV3",
+		},
+		{
+			name: "deprecated showIcons",
+			setup: func(guiConfig *config.GuiConfig) {
+				guiConfig.ShowIcons = true
+			},
+			expected: "nerdFontsV2",
+		},
+		{
+			name: "icon set wins over nerd fonts version",
+			setup: func(guiConfig *config.GuiConfig) {
+				guiConfig.NerdFontsVersion = "3"
+				guiConfig.IconSet = "emoji"
+			},
+			expected: "emoji",
+		},
+		{
+			name: "auto in a terminal with nerd fonts",
+			setup: func(guiConfig *config.GuiConfig) {
+				guiConfig.IconSet = "auto"
+			},
+			env:      map[string]string{"TERM_PROGRAM": "WezTerm", "LANG": "C"},
+			expected: "nerdFontsV3",
+		},
+		{
+			name: "auto in kitty",
+			setup: func(guiConfig *config.GuiConfig) {
+				guiConfig.IconSet = "auto"
+			},
+			env:      map[string]string{"TERM": "xterm-kitty"},
+			expected: "nerdFontsV3",
+		},
+		{
+			name: "auto in an unknown terminal",
+			setup: func(guiConfig *config.GuiConfig) {
+				guiConfig.IconSet = "auto"
+			},
+			env:      map[string]string{"TERM": "xterm-256color", "LANG": "en_US.UTF-8"},
+			expected: "none",
+		},
+		{
+			name: "auto without UTF-8",
+			setup: func(guiConfig *config.GuiConfig) {
+				guiConfig.IconSet = "auto"
+			},
+			env:      map[string]string{"TERM": "linux", "LC_ALL": "POSIX", "LANG": "en_US.UTF-8"},
+			expected: "ascii",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			guiConfig := config.GetDefaultConfig().Gui
+			s.setup(&guiConfig)
+			getenv := func(name string) string { return s.env[name] }
+			assert.Equal(t, s.expected, IconSetName(&guiConfig, getenv))
+		})
+	}
+}
+
+func TestSetIconSet(t *testing.T) {
+	defer SetIconSet("none", config.CustomIconsConfig{})
+
+	SetIconSet("ascii", config.CustomIconsConfig{
+		BranchStatus: config.BranchStatusIconsConfig{UpToDate: "ok"},
+		Commits:      config.CommitIconsConfig{BranchHead: ">"},
+	})
+	assert.True(t, IsIconEnabled())
+	assert.Equal(t, "ok", UP_TO_DATE_ICON)
+	assert.Equal(t, "^", AHEAD_ICON)
+	assert.Equal(t, ">", BRANCH_HEAD_ICON)
+	assert.Equal(t, "o", COMMIT_ICON)
+	// The ascii set has no icons for file types, but custom ones still apply
+	customIcons := &config.CustomIconsConfig{
+		Extensions: map[string]config.IconProperties{".md": {Icon: "M"}},
+	}
+	assert.Equal(t, "-", IconForFile("main.go", false, false, false, customIcons).Icon)
+	assert.Equal(t, "M", IconForFile("README.md", false, false, false, customIcons).Icon)
+	assert.Equal(t, "d", IconForFile("pkg", false, false, true, customIcons).Icon)
+
+	// Switching back undoes the changes of the previous set
+	SetIconSet("nerdFontsV2", config.CustomIconsConfig{})
+	assert.Equal(t, "\uf81a", IconForFile("main.cs", false, false, false, &config.CustomIconsConfig{}).Icon)
+	assert.Equal(t, "✓", UP_TO_DATE_ICON)
+
+	SetIconSet("nerdFontsV3", config.CustomIconsConfig{})
+	assert.Equal(t, "\U000f031b", IconForFile("main.cs", false, false, false, &config.CustomIconsConfig{}).Icon)
+
+	SetIconSet("none", config.CustomIconsConfig{})
+	assert.False(t, IsIconEnabled())
+	assert.Equal(t, "*", BRANCH_HEAD_ICON)
+}
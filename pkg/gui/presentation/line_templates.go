@@ -0,0 +1,67 @@
+package presentation
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+)
+
+// The fields available in the gui.branchLineTemplate config. All of them are
+// already colored the same way as in the built-in layout.
+type BranchLineFields struct {
+	Name        string
+	Recency     string
+	AheadBehind string
+	Divergence  string
+	Hash        string
+	Upstream    string
+	Subject     string
+	Icon        string
+	Worktree    string
+}
+
+// The fields available in the gui.commitLineTemplate config. All of them are
+// already colored the same way as in the built-in layout.
+type CommitLineFields struct {
+	Name           string
+	Hash           string
+	Author         string
+	AuthorInitials string
+	Age            string
+	Date           string
+	Tags           string
+	Graph          string
+	Action         string
+	Mark           string
+	Divergence     string
+	Bisect         string
+	Stats          string
+	Status         string
+}
+
+// Returns nil if the template string is empty, meaning the built-in layout
+// should be used.
+func parseLineTemplate(templateStr string) (*template.Template, error) {
+	if templateStr == "" {
+		return nil, nil
+	}
+
+	return template.New("line").Option("missingkey=error").Parse(templateStr)
+}
+
+// Tabs in the template's output separate the columns of the list view, so that
+// they are aligned across lines.
+func renderLineTemplate(tmpl *template.Template, fields any) []string {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, fields); err != nil {
+		return lineTemplateError(err)
+	}
+
+	return strings.Split(buf.String(), "\t")
+}
+
+func lineTemplateError(err error) []string {
+	return []string{style.FgRed.Sprint(err.Error())}
+}
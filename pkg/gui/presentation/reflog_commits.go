@@ -5,14 +5,14 @@ import (
 
 	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/theme"
-	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/kyokomi/emoji/v2"
 	"github.com/samber/lo"
 )
 
-func GetReflogCommitListDisplayStrings(commits []*models.Commit, fullDescription bool, cherryPickedCommitHashSet *set.Set[string], diffName string, now time.Time, timeFormat string, shortTimeFormat string, parseEmoji bool) [][]string {
+func GetReflogCommitListDisplayStrings(commits []*models.Commit, fullDescription bool, cherryPickedCommitHashSet *set.Set[string], markedCommitHashSet *set.Set[string], diffName string, now time.Time, guiConfig *config.GuiConfig, parseEmoji bool) [][]string {
 	var displayFunc func(*models.Commit, reflogCommitDisplayAttributes) []string
 	if fullDescription {
 		displayFunc = getFullDescriptionDisplayStringsForReflogCommit
@@ -25,12 +25,12 @@ func GetReflogCommitListDisplayStrings(commits []*models.Commit, fullDescription
 		cherryPicked := cherryPickedCommitHashSet.Includes(commit.Hash())
 		return displayFunc(commit,
 			reflogCommitDisplayAttributes{
-				cherryPicked:    cherryPicked,
-				diffed:          diffed,
-				parseEmoji:      parseEmoji,
-				timeFormat:      timeFormat,
-				shortTimeFormat: shortTimeFormat,
-				now:             now,
+				cherryPicked: cherryPicked,
+				marked:       markedCommitHashSet.Includes(commit.Hash()),
+				diffed:       diffed,
+				parseEmoji:   parseEmoji,
+				guiConfig:    guiConfig,
+				now:          now,
 			})
 	})
 }
@@ -49,12 +49,12 @@ func reflogHashColor(cherryPicked, diffed bool) style.TextStyle {
 }
 
 type reflogCommitDisplayAttributes struct {
-	cherryPicked    bool
-	diffed          bool
-	parseEmoji      bool
-	timeFormat      string
-	shortTimeFormat string
-	now             time.Time
+	cherryPicked bool
+	marked       bool
+	diffed       bool
+	parseEmoji   bool
+	guiConfig    *config.GuiConfig
+	now          time.Time
 }
 
 func getFullDescriptionDisplayStringsForReflogCommit(c *models.Commit, attrs reflogCommitDisplayAttributes) []string {
@@ -62,10 +62,13 @@ func getFullDescriptionDisplayStringsForReflogCommit(c *models.Commit, attrs ref
 	if attrs.parseEmoji {
 		name = emoji.Sprint(name)
 	}
+	if attrs.marked {
+		name = style.FgMagenta.SetBold().Sprint("◆") + " " + name
+	}
 
 	return []string{
 		reflogHashColor(attrs.cherryPicked, attrs.diffed).Sprint(c.ShortHash()),
-		style.FgMagenta.Sprint(utils.UnixToDateSmart(attrs.now, c.UnixTimestamp, attrs.timeFormat, attrs.shortTimeFormat)),
+		style.FgMagenta.Sprint(formatDate(attrs.guiConfig, "absolute", attrs.now, c.UnixTimestamp)),
 		theme.DefaultTextColor.Sprint(name),
 	}
 }
@@ -75,6 +78,9 @@ func getDisplayStringsForReflogCommit(c *models.Commit, attrs reflogCommitDispla
 	if attrs.parseEmoji {
 		name = emoji.Sprint(name)
 	}
+	if attrs.marked {
+		name = style.FgMagenta.SetBold().Sprint("◆") + " " + name
+	}
 
 	return []string{
 		reflogHashColor(attrs.cherryPicked, attrs.diffed).Sprint(c.ShortHash()),
@@ -1,29 +1,36 @@
 package presentation
 
 import (
+	"time"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/samber/lo"
 )
 
-func GetStashEntryListDisplayStrings(stashEntries []*models.StashEntry, diffName string) [][]string {
+func GetStashEntryListDisplayStrings(stashEntries []*models.StashEntry, diffName string, guiConfig *config.GuiConfig, now time.Time) [][]string {
 	return lo.Map(stashEntries, func(stashEntry *models.StashEntry, _ int) []string {
 		diffed := stashEntry.RefName() == diffName
-		return getStashEntryDisplayStrings(stashEntry, diffed)
+		return getStashEntryDisplayStrings(stashEntry, diffed, guiConfig, now)
 	})
 }
 
 // getStashEntryDisplayStrings returns the display string of branch
-func getStashEntryDisplayStrings(s *models.StashEntry, diffed bool) []string {
+func getStashEntryDisplayStrings(s *models.StashEntry, diffed bool, guiConfig *config.GuiConfig, now time.Time) []string {
 	textStyle := theme.DefaultTextColor
 	if diffed {
 		textStyle = theme.DiffTerminalColor
 	}
 
 	res := make([]string, 0, 3)
-	res = append(res, style.FgCyan.Sprint(s.Recency))
+	recency := s.Recency
+	if s.UnixTimestamp != 0 {
+		recency = formatDate(guiConfig, "relative", now, s.UnixTimestamp)
+	}
+	res = append(res, style.FgCyan.Sprint(recency))
 
 	if icons.IsIconEnabled() {
 		res = append(res, textStyle.Sprint(icons.IconForStash(s)))
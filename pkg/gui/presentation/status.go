@@ -2,6 +2,8 @@ package presentation
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
@@ -10,6 +12,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/samber/lo"
 )
 
 func FormatStatus(
@@ -47,3 +50,17 @@ func FormatStatus(
 
 	return status
 }
+
+// Prefixes the repo name with the names of the repos whose submodules we've
+// entered to get here, outermost first, e.g. "repo › outer › inner"
+func FormatRepoBreadcrumb(parentRepoPaths []string, repoName string) string {
+	if len(parentRepoPaths) == 0 {
+		return repoName
+	}
+
+	parentNames := lo.Map(parentRepoPaths, func(path string, _ int) string {
+		return filepath.Base(path)
+	})
+
+	return strings.Join(parentNames, " › ") + " › " + repoName
+}
@@ -3,6 +3,7 @@ package presentation
 import (
 	"time"
 
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
@@ -17,12 +18,13 @@ func GetTagListDisplayStrings(
 	tags []*models.Tag,
 	getItemOperation func(item types.HasUrn) types.ItemOperation,
 	diffName string,
+	remoteTags *set.Set[string],
 	tr *i18n.TranslationSet,
 	userConfig *config.UserConfig,
 ) [][]string {
 	return lo.Map(tags, func(tag *models.Tag, _ int) []string {
 		diffed := tag.Name == diffName
-		return getTagDisplayStrings(tag, getItemOperation(tag), diffed, tr, userConfig)
+		return getTagDisplayStrings(tag, getItemOperation(tag), diffed, remoteTags, tr, userConfig)
 	})
 }
 
@@ -31,6 +33,7 @@ func getTagDisplayStrings(
 	t *models.Tag,
 	itemOperation types.ItemOperation,
 	diffed bool,
+	remoteTags *set.Set[string],
 	tr *i18n.TranslationSet,
 	userConfig *config.UserConfig,
 ) []string {
@@ -48,6 +51,15 @@ func getTagDisplayStrings(
 	if itemOperationStr != "" {
 		descriptionStr = style.FgCyan.Sprint(itemOperationStr+" "+Loader(time.Now(), userConfig.Gui.Spinner)) + " " + descriptionStr
 	}
-	res = append(res, textStyle.Sprint(t.Name), descriptionStr)
+	nameStr := textStyle.Sprint(t.Name)
+	// remoteTags is nil until we've listed the remote's tags
+	if remoteTags != nil {
+		if remoteTags.Includes(t.Name) {
+			nameStr += " " + style.FgGreen.Sprint("✓")
+		} else {
+			nameStr += " " + style.FgYellow.Sprint("↑")
+		}
+	}
+	res = append(res, nameStr, descriptionStr)
 	return res
 }
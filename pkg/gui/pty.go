@@ -3,10 +3,15 @@
 package gui
 
 import (
+	"errors"
+	"fmt"
 	"io"
+	"maps"
 	"os"
 	"os/exec"
+	"slices"
 	"strings"
+	"syscall"
 
 	"github.com/creack/pty"
 	"github.com/jesseduffield/gocui"
@@ -21,13 +26,31 @@ func (gui *Gui) desiredPtySize(view *gocui.View) *pty.Winsize {
 }
 
 func (gui *Gui) onResize() error {
+	if err := gui.resizePtys(); err != nil {
+		return err
+	}
+
+	// Telling the pty about the new size doesn't help with the output that a
+	// side-by-side pager has already laid out for the old width, so we run the
+	// command again
+	for viewName, task := range gui.ptyTasksToRerunOnResize() {
+		view, err := gui.g.View(viewName)
+		if err != nil || gui.getManager(view).GetTaskKey() != task.key {
+			continue
+		}
+		if err := gui.newPtyTask(view, copyCmd(task.cmd), task.getPrefix); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (gui *Gui) resizePtys() error {
 	gui.Mutexes.PtyMutex.Lock()
 	defer gui.Mutexes.PtyMutex.Unlock()
 
 	for viewName, ptmx := range gui.viewPtmxMap {
-		// TODO: handle resizing properly: we need to actually clear the main view
-		// and re-read the output from our pty. Or we could just re-run the original
-		// command from scratch
 		view, _ := gui.g.View(viewName)
 		if err := pty.Setsize(ptmx, gui.desiredPtySize(view)); err != nil {
 			return utils.WrapError(err)
@@ -37,20 +60,43 @@ func (gui *Gui) onResize() error {
 	return nil
 }
 
+func (gui *Gui) ptyTasksToRerunOnResize() map[string]ptyTask {
+	gui.Mutexes.PtyMutex.Lock()
+	defer gui.Mutexes.PtyMutex.Unlock()
+
+	return maps.Clone(gui.viewPtyTasksToRerunOnResize)
+}
+
 // Some commands need to output for a terminal to active certain behaviour.
 // For example,  git won't invoke the GIT_PAGER env var unless it thinks it's
 // talking to a terminal. We typically write cmd outputs straight to a view,
 // which is just an io.Reader. the pty package lets us wrap a command in a
 // pseudo-terminal meaning we'll get the behaviour we want from the underlying
 // command.
-func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error {
+func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, getPrefix func() string) error {
+	panel := string(gui.c.Context().CurrentSide().GetKey())
 	width := view.InnerWidth()
-	pager := gui.git.Config.GetPager(width)
-	externalDiffCommand := gui.Config.GetUserConfig().Git.Paging.ExternalDiffCommand
+	pager := gui.usablePager(width, panel)
+	// The external diff command may be configured per file type, so rather than
+	// looking at the config we check whether the command uses it
+	usesExternalDiff := slices.Contains(cmd.Args, "--ext-diff")
+
+	originalCmd := copyCmd(cmd)
+	gui.Mutexes.PtyMutex.Lock()
+	if gui.git.Config.PagerRendersSideBySide(panel) {
+		gui.viewPtyTasksToRerunOnResize[view.Name()] = ptyTask{
+			cmd:       originalCmd,
+			getPrefix: getPrefix,
+			key:       strings.Join(cmd.Args, " "),
+		}
+	} else {
+		delete(gui.viewPtyTasksToRerunOnResize, view.Name())
+	}
+	gui.Mutexes.PtyMutex.Unlock()
 
-	if pager == "" && externalDiffCommand == "" {
+	if pager == "" && !usesExternalDiff {
 		// if we're not using a custom pager we don't need to use a pty
-		return gui.newCmdTask(view, cmd, prefix)
+		return gui.newCmdTask(view, cmd, getPrefix)
 	}
 
 	// Run the pty after layout so that it gets the correct size
@@ -58,7 +104,7 @@ func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 		// Need to get the width and the pager again because the layout might have
 		// changed the size of the view
 		width = view.InnerWidth()
-		pager = gui.git.Config.GetPager(width)
+		pager = gui.usablePager(width, panel)
 
 		cmdStr := strings.Join(cmd.Args, " ")
 
@@ -69,6 +115,10 @@ func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 		cmd.Env = removeExistingTermEnvVars(cmd.Env)
 		cmd.Env = append(cmd.Env, "TERM=dumb")
 
+		// Some pagers and external diff tools look at this rather than at the
+		// size of the terminal
+		cmd.Env = append(cmd.Env, fmt.Sprintf("COLUMNS=%d", width))
+
 		cmd.Env = append(cmd.Env, "GIT_PAGER="+pager)
 
 		manager := gui.getManager(view)
@@ -95,13 +145,99 @@ func (gui *Gui) newPtyTask(view *gocui.View, cmd *exec.Cmd, prefix string) error
 			gui.Mutexes.PtyMutex.Unlock()
 		}
 
+		onCmdError := func(err error) {
+			if pager == "" || !isBrokenPipe(err) {
+				return
+			}
+
+			// The pager exited before git was done writing to it, so all we got
+			// is whatever the pager printed (probably an error message). Show
+			// the diff without the pager instead, and don't use it again.
+			gui.onPagerFailed(pager)
+			gui.c.OnUIThread(func() error {
+				if manager.GetTaskKey() != cmdStr {
+					return nil
+				}
+				return gui.newCmdTask(view, copyCmd(originalCmd), getPrefix)
+			})
+		}
+
 		linesToRead := gui.linesToReadFromCmdTask(view)
-		return manager.NewTask(manager.NewCmdTask(start, prefix, linesToRead, onClose), cmdStr)
+		return manager.NewTask(manager.NewCmdTask(start, getPrefix, linesToRead, onClose, onCmdError), cmdStr)
 	})
 
 	return nil
 }
 
+// Returns the pager to use, or an empty string if we can't use it because its
+// executable can't be found or it failed before. In that case we'd rather show
+// the diff without the pager than show an error.
+func (gui *Gui) usablePager(width int, panel string) string {
+	pager := gui.git.Config.GetPager(width, panel)
+	executable := pagerExecutable(pager)
+	if executable == "" {
+		return pager
+	}
+
+	gui.Mutexes.PtyMutex.Lock()
+	failed := gui.failedPagers.Includes(executable)
+	gui.Mutexes.PtyMutex.Unlock()
+	if failed {
+		return ""
+	}
+
+	// We can only check for executables that aren't wrapped in shell syntax
+	if !strings.ContainsAny(executable, "=$~'\"`\\") {
+		if _, err := exec.LookPath(executable); err != nil {
+			gui.onPagerFailed(pager)
+			return ""
+		}
+	}
+
+	return pager
+}
+
+func (gui *Gui) onPagerFailed(pager string) {
+	executable := pagerExecutable(pager)
+
+	gui.Mutexes.PtyMutex.Lock()
+	alreadyFailed := gui.failedPagers.Includes(executable)
+	gui.failedPagers.Add(executable)
+	gui.Mutexes.PtyMutex.Unlock()
+
+	if !alreadyFailed {
+		gui.c.Log.Warnf("Pager failed, showing diffs without it: %s", pager)
+		gui.c.ErrorToast(utils.ResolvePlaceholderString(gui.c.Tr.PagerFailed, map[string]string{"pager": executable}))
+	}
+}
+
+func pagerExecutable(pager string) string {
+	fields := strings.Fields(pager)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
+
+// Returns true if the command was killed because it wrote to a pipe that was
+// closed on the other end
+func isBrokenPipe(err error) bool {
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return false
+	}
+	status, ok := exitErr.Sys().(syscall.WaitStatus)
+	return ok && status.Signaled() && status.Signal() == syscall.SIGPIPE
+}
+
+func copyCmd(cmd *exec.Cmd) *exec.Cmd {
+	result := exec.Command(cmd.Path, cmd.Args[1:]...)
+	result.Args = slices.Clone(cmd.Args)
+	result.Env = slices.Clone(cmd.Env)
+	result.Dir = cmd.Dir
+	return result
+}
+
 func removeExistingTermEnvVars(env []string) []string {
 	return lo.Filter(env, func(envVar string, _ int) bool {
 		return !isTermEnvVar(envVar)
@@ -0,0 +1,85 @@
+package gui
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// A repo can have a `.lazygit.yml` checked in, e.g. to add custom commands for
+// its build tools, or to protect its release branches. Since custom commands
+// run shell commands, we only load it after asking the user whether they trust
+// it, and again whenever it changes.
+func (gui *Gui) repoConfigFilePath() string {
+	return filepath.Join(gui.git.RepoPaths.RepoPath(), ".lazygit.yml")
+}
+
+func (gui *Gui) isRepoConfigFileTrusted(content []byte) bool {
+	trust, ok := gui.Config.GetAppState().RepoConfigFileTrust[gui.repoConfigFilePath()]
+	return ok && trust.Trusted && trust.Hash == hashRepoConfigFile(content)
+}
+
+func (gui *Gui) askToTrustRepoConfigFile() {
+	path := gui.repoConfigFilePath()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		// Most repos don't have one
+		return
+	}
+
+	hash := hashRepoConfigFile(content)
+	if trust, ok := gui.Config.GetAppState().RepoConfigFileTrust[path]; ok && trust.Hash == hash {
+		return
+	}
+
+	gui.c.Confirm(types.ConfirmOpts{
+		Title:  gui.c.Tr.TrustRepoConfigFileTitle,
+		Prompt: fmt.Sprintf(gui.c.Tr.TrustRepoConfigFilePrompt, path),
+		HandleConfirm: func() error {
+			return gui.setRepoConfigFileTrust(path, hash, true)
+		},
+		HandleClose: func() error {
+			return gui.setRepoConfigFileTrust(path, hash, false)
+		},
+	})
+}
+
+func (gui *Gui) setRepoConfigFileTrust(path string, hash string, trusted bool) error {
+	appState := gui.c.GetAppState()
+	if appState.RepoConfigFileTrust == nil {
+		appState.RepoConfigFileTrust = map[string]config.RepoConfigFileTrust{}
+	}
+	appState.RepoConfigFileTrust[path] = config.RepoConfigFileTrust{Hash: hash, Trusted: trusted}
+	gui.c.SaveAppStateAndLogError()
+
+	if !trusted {
+		return nil
+	}
+
+	oldConfig := gui.Config.GetUserConfig()
+	if err := gui.Config.ReloadUserConfigForRepo(gui.getPerRepoConfigFiles()); err != nil {
+		return err
+	}
+	if err := gui.onUserConfigLoaded(); err != nil {
+		return err
+	}
+	if err := gui.resetKeybindings(); err != nil {
+		return err
+	}
+	if err := gui.checkForChangedConfigsThatDontAutoReload(oldConfig, gui.Config.GetUserConfig()); err != nil {
+		return err
+	}
+
+	gui.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+	return nil
+}
+
+func hashRepoConfigFile(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
@@ -15,6 +15,7 @@ import (
 type Client struct {
 	c                 *helpers.HelperCommon
 	handlerCreator    *HandlerCreator
+	macroHelper       *helpers.MacroHelper
 	keybindingCreator *KeybindingCreator
 }
 
@@ -35,6 +36,7 @@ func NewClient(
 		c:                 c,
 		keybindingCreator: keybindingCreator,
 		handlerCreator:    handlerCreator,
+		macroHelper:       helpers.Macro,
 	}
 }
 
@@ -54,7 +56,7 @@ func (self *Client) GetCustomCommandKeybindings() ([]*types.Binding, error) {
 				OpensMenu:   true,
 			})
 		} else {
-			handler := self.handlerCreator.call(customCommand)
+			handler := self.getHandler(customCommand)
 			compoundBindings, err := self.keybindingCreator.call(customCommand, handler)
 			if err != nil {
 				return nil, err
@@ -96,7 +98,7 @@ func (self *Client) showCustomCommandsMenu(customCommand config.CustomCommand) e
 			menuItems = append(menuItems, &types.MenuItem{
 				Label:   subCommand.GetDescription(),
 				Key:     keybindings.GetKey(subCommand.Key),
-				OnPress: self.handlerCreator.call(subCommand),
+				OnPress: self.getHandler(subCommand),
 			})
 		}
 	}
@@ -112,6 +114,16 @@ func (self *Client) showCustomCommandsMenu(customCommand config.CustomCommand) e
 	return self.c.Menu(types.CreateMenuOptions{Title: title, Items: menuItems, HideCancel: true})
 }
 
+func (self *Client) getHandler(customCommand config.CustomCommand) func() error {
+	if len(customCommand.Macro) > 0 {
+		return func() error {
+			return self.macroHelper.Run(customCommand.Macro)
+		}
+	}
+
+	return self.handlerCreator.call(customCommand)
+}
+
 func getCustomCommandsMenuDescription(customCommand config.CustomCommand, tr *i18n.TranslationSet) string {
 	if customCommand.Description != "" {
 		return customCommand.Description
@@ -3,6 +3,7 @@ package custom_commands
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"text/template"
 
@@ -106,6 +107,21 @@ func (self *HandlerCreator) call(customCommand config.CustomCommand) func() erro
 			default:
 				return errors.New("custom command prompt must have a type of 'input', 'menu', 'menuFromCommand', or 'confirm'")
 			}
+
+			if prompt.Condition != nil {
+				showPrompt := f
+				f = func() error {
+					matched, err := regexp.MatchString(prompt.Condition.Matches, form[prompt.Condition.Key])
+					if err != nil {
+						return err
+					}
+					if !matched {
+						// A prompt that isn't shown counts as answered with an empty string
+						return wrappedF("")
+					}
+					return showPrompt()
+				}
+			}
 		}
 
 		return f()
@@ -118,16 +134,46 @@ func (self *HandlerCreator) inputPrompt(prompt *config.CustomCommandPrompt, wrap
 		return err
 	}
 
+	initialValue := prompt.InitialValue
+	if prompt.InitialValueCommand != "" {
+		output, err := self.c.OS().Cmd.NewShell(prompt.InitialValueCommand, self.c.UserConfig().OS.ShellFunctionsFile).RunWithOutput()
+		if err != nil {
+			return err
+		}
+		initialValue = strings.TrimSpace(output)
+	}
+
+	self.showInputPrompt(prompt, initialValue, findSuggestionsFn, wrappedF)
+	return nil
+}
+
+func (self *HandlerCreator) showInputPrompt(
+	prompt *config.CustomCommandPrompt,
+	initialValue string,
+	findSuggestionsFn func(string) []*types.Suggestion,
+	wrappedF func(string) error,
+) {
 	self.c.Prompt(types.PromptOpts{
 		Title:               prompt.Title,
-		InitialContent:      prompt.InitialValue,
+		InitialContent:      initialValue,
 		FindSuggestionsFunc: findSuggestionsFn,
 		HandleConfirm: func(str string) error {
+			// The regex was checked when loading the config
+			if matched, _ := regexp.MatchString(prompt.ValidationRegex, str); !matched {
+				message := prompt.ValidationMessage
+				if message == "" {
+					message = fmt.Sprintf(self.c.Tr.CustomCommandInputDoesNotMatch, prompt.ValidationRegex)
+				}
+				self.c.ErrorToast(message)
+
+				// Let the user correct what they typed
+				self.showInputPrompt(prompt, str, findSuggestionsFn, wrappedF)
+				return nil
+			}
+
 			return wrappedF(str)
 		},
 	})
-
-	return nil
 }
 
 func (self *HandlerCreator) generateFindSuggestionsFunc(prompt *config.CustomCommandPrompt) (func(string) []*types.Suggestion, error) {
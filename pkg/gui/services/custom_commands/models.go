@@ -98,3 +98,17 @@ type Worktree struct {
 	Branch        string
 	Name          string
 }
+
+// The lines that are selected in the staging view
+type DiffLines struct {
+	// The file that the diff is of
+	Path string
+	// Line numbers in the working tree version of the file (or the staged
+	// version, if Staged is true) of the first and last selected line
+	FirstLineNumber int
+	LastLineNumber  int
+	// The selected lines of the diff, each starting with '+', '-' or ' '
+	Content string
+	// True if the lines are in the staged changes
+	Staged bool
+}
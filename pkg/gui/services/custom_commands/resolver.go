@@ -20,8 +20,9 @@ func (self *Resolver) resolvePrompt(
 ) (*config.CustomCommandPrompt, error) {
 	var err error
 	result := &config.CustomCommandPrompt{
-		ValueFormat: prompt.ValueFormat,
-		LabelFormat: prompt.LabelFormat,
+		ValueFormat:     prompt.ValueFormat,
+		LabelFormat:     prompt.LabelFormat,
+		ValidationRegex: prompt.ValidationRegex,
 	}
 
 	result.Title, err = resolveTemplate(prompt.Title)
@@ -34,6 +35,16 @@ func (self *Resolver) resolvePrompt(
 		return nil, err
 	}
 
+	result.InitialValueCommand, err = resolveTemplate(prompt.InitialValueCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	result.ValidationMessage, err = resolveTemplate(prompt.ValidationMessage)
+	if err != nil {
+		return nil, err
+	}
+
 	result.Suggestions.Preset, err = resolveTemplate(prompt.Suggestions.Preset)
 	if err != nil {
 		return nil, err
@@ -3,6 +3,7 @@ package custom_commands
 import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/samber/lo"
 )
 
@@ -178,6 +179,48 @@ func makeCommitRange(commits []*models.Commit, _ int, _ int) *CommitRange {
 	}
 }
 
+func (self *SessionStateLoader) selectedDiffLines() *DiffLines {
+	var context types.IPatchExplorerContext
+	if self.c.Context().IsCurrent(self.c.Contexts().Staging) {
+		context = self.c.Contexts().Staging
+	} else if self.c.Context().IsCurrent(self.c.Contexts().StagingSecondary) {
+		context = self.c.Contexts().StagingSecondary
+	} else {
+		return nil
+	}
+
+	state := context.GetState()
+	if state == nil {
+		return nil
+	}
+
+	firstLineNumber, lastLineNumber := state.SelectedLineNumberRange()
+	return &DiffLines{
+		Path:            self.c.Contexts().Files.GetSelectedPath(),
+		FirstLineNumber: firstLineNumber,
+		LastLineNumber:  lastLineNumber,
+		Content:         state.PlainRenderSelected(),
+		Staged:          context == self.c.Contexts().StagingSecondary,
+	}
+}
+
+// The files in the selected nodes of the files panel, including those in
+// selected directories
+func (self *SessionStateLoader) selectedFiles() []*File {
+	nodes, _, _ := self.c.Contexts().Files.GetSelectedItems()
+	var files []*models.File
+	for _, node := range nodes {
+		_ = node.ForEachFile(func(file *models.File) error {
+			files = append(files, file)
+			return nil
+		})
+	}
+
+	return lo.Map(lo.Uniq(files), func(file *models.File, _ int) *File {
+		return fileShimFromModelFile(file)
+	})
+}
+
 // SessionState captures the current state of the application for use in custom commands
 type SessionState struct {
 	SelectedLocalCommit    *Commit // deprecated, use SelectedCommit
@@ -185,9 +228,14 @@ type SessionState struct {
 	SelectedSubCommit      *Commit // deprecated, use SelectedCommit
 	SelectedCommit         *Commit
 	SelectedCommitRange    *CommitRange
+	SelectedCommits        []*Commit
 	SelectedFile           *File
+	SelectedFiles          []*File
 	SelectedPath           string
+	SelectedPaths          []string
+	SelectedDiffLines      *DiffLines
 	SelectedLocalBranch    *Branch
+	SelectedLocalBranches  []*Branch
 	SelectedRemoteBranch   *RemoteBranch
 	SelectedRemote         *Remote
 	SelectedTag            *Tag
@@ -208,21 +256,28 @@ func (self *SessionStateLoader) call() *SessionState {
 
 	selectedCommit := selectedLocalCommit
 	selectedCommitRange := selectedLocalCommitRange
+	selectedCommits, _, _ := self.c.Contexts().LocalCommits.GetSelectedItems()
 	if self.c.Context().IsCurrentOrParent(self.c.Contexts().ReflogCommits) {
 		selectedCommit = selectedReflogCommit
 		selectedCommitRange = selectedReflogCommitRange
+		selectedCommits, _, _ = self.c.Contexts().ReflogCommits.GetSelectedItems()
 	} else if self.c.Context().IsCurrentOrParent(self.c.Contexts().SubCommits) {
 		selectedCommit = selectedSubCommit
 		selectedCommitRange = selectedSubCommitRange
+		selectedCommits, _, _ = self.c.Contexts().SubCommits.GetSelectedItems()
 	}
 
 	selectedPath := self.c.Contexts().Files.GetSelectedPath()
+	selectedPaths, _, _ := self.c.Contexts().Files.GetSelectedItemIds()
 	selectedCommitFilePath := self.c.Contexts().CommitFiles.GetSelectedPath()
 
 	if self.c.Context().IsCurrent(self.c.Contexts().CommitFiles) {
 		selectedPath = selectedCommitFilePath
+		selectedPaths, _, _ = self.c.Contexts().CommitFiles.GetSelectedItemIds()
 	}
 
+	selectedLocalBranches, _, _ := self.c.Contexts().Branches.GetSelectedItems()
+
 	return &SessionState{
 		SelectedFile:           fileShimFromModelFile(self.c.Contexts().Files.GetSelectedFile()),
 		SelectedPath:           selectedPath,
@@ -231,7 +286,12 @@ func (self *SessionStateLoader) call() *SessionState {
 		SelectedSubCommit:      selectedSubCommit,
 		SelectedCommit:         selectedCommit,
 		SelectedCommitRange:    selectedCommitRange,
+		SelectedCommits:        lo.Map(selectedCommits, func(commit *models.Commit, _ int) *Commit { return commitShimFromModelCommit(commit) }),
+		SelectedFiles:          self.selectedFiles(),
+		SelectedPaths:          selectedPaths,
+		SelectedDiffLines:      self.selectedDiffLines(),
 		SelectedLocalBranch:    branchShimFromModelBranch(self.c.Contexts().Branches.GetSelected()),
+		SelectedLocalBranches:  lo.Map(selectedLocalBranches, func(branch *models.Branch, _ int) *Branch { return branchShimFromModelBranch(branch) }),
 		SelectedRemoteBranch:   remoteBranchShimFromModelRemoteBranch(self.c.Contexts().RemoteBranches.GetSelected()),
 		SelectedRemote:         remoteShimFromModelRemote(self.c.Contexts().Remotes.GetSelected()),
 		SelectedTag:            tagShimFromModelRemote(self.c.Contexts().Tags.GetSelected()),
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
@@ -74,6 +75,9 @@ type IGuiCommon interface {
 	// Runs a function in a goroutine. Use this whenever you want to run a goroutine and keep track of the fact
 	// that lazygit is still busy. See docs/dev/Busy.md
 	OnWorker(f func(gocui.Task) error)
+	// Like OnWorker, but names the task so that it can be told apart from
+	// others in the list of background tasks
+	OnWorkerWithName(name string, f func(gocui.Task) error)
 	// Function to call at the end of our 'layout' function which renders views
 	// For example, you may want a view's line to be focused only after that view is
 	// resized, if in accordion mode.
@@ -106,6 +110,13 @@ type IGuiCommon interface {
 
 	ResetKeybindings() error
 
+	// To call after changing the user config with GetConfig(), so that the
+	// gui uses the new config. oldConfig is the config before the change.
+	ApplyChangedUserConfig(oldConfig *config.UserConfig) error
+	// Makes all views use the colors of the given theme right away, without
+	// changing the user config
+	RenderTheme(themeConfig config.ThemeConfig)
+
 	// hopefully we can remove this once we've moved all our keybinding stuff out of the gui god struct.
 	GetInitialKeybindingsWithCustomCommands() ([]*Binding, []*gocui.ViewMouseBinding)
 
@@ -114,6 +125,12 @@ type IGuiCommon interface {
 
 	// Returns true if we're in a demo recording/playback
 	InDemo() bool
+
+	// Passes the text on to the user's screen reader if accessibility mode is
+	// enabled
+	Announce(text string)
+	// Announces the selected line of a list if it has changed
+	AnnounceSelection(context Context, line string)
 }
 
 type IModeMgr interface {
@@ -156,6 +173,14 @@ type CreateMenuOptions struct {
 	HideCancel                bool
 	ColumnAlignment           []utils.Alignment
 	AllowFilteringKeybindings bool
+	// The index of the item that is selected when the menu opens
+	SelectedIndex int
+	// Called whenever another item gets selected, e.g. to preview what pressing
+	// it would do
+	OnSelectionChange func(item *MenuItem)
+	// Called when the menu goes away, before the OnPress of the pressed item
+	// (if any)
+	OnClose func()
 }
 
 type CreatePopupPanelOpts struct {
@@ -305,6 +330,12 @@ type Model struct {
 	WorkingTreeStateAtLastCommitRefresh models.WorkingTreeState
 	RemoteBranches                      []*models.RemoteBranch
 	Tags                                []*models.Tag
+	// Names of the tags on each remote, keyed by remote name, as of the last
+	// time we listed them. Remotes we haven't listed yet are missing.
+	RemoteTags map[string]*set.Set[string]
+	// Whether the interactive rebase stopped at a break todo, as of the last
+	// commit refresh
+	RebasePausedAtBreak bool
 
 	// Name of the currently checked out branch. This will be set even when
 	// we're on a detached head because we're rebasing or bisecting.
@@ -330,6 +361,14 @@ type Mutexes struct {
 	SubprocessMutex         deadlock.Mutex
 	PopupMutex              deadlock.Mutex
 	PtyMutex                deadlock.Mutex
+	LoggedCommandsMutex     deadlock.Mutex
+}
+
+// A command from the command log that the user can copy or run again
+type LoggedCommand struct {
+	// The command as shown in the command log
+	Command string
+	Args    []string
 }
 
 // A long-running operation associated with an item. For example, we'll show
@@ -362,6 +401,7 @@ type IStateAccessor interface {
 	GetIsRefreshingFiles() bool
 	GetShowExtrasWindow() bool
 	SetShowExtrasWindow(bool)
+	GetLoggedCommands() []LoggedCommand
 	GetRetainOriginalDir() bool
 	SetRetainOriginalDir(bool)
 	GetItemOperation(item HasUrn) ItemOperation
@@ -378,6 +418,8 @@ type IRepoStateAccessor interface {
 	SetCurrentPopupOpts(*CreatePopupPanelOpts)
 	GetScreenMode() ScreenMode
 	SetScreenMode(ScreenMode)
+	GetScreenModeBeforeZenMode() ScreenMode
+	SetScreenModeBeforeZenMode(ScreenMode)
 	InSearchPrompt() bool
 	GetSearchState() *SearchState
 	SetSplitMainPanel(bool)
@@ -402,4 +444,8 @@ const (
 	SCREEN_NORMAL ScreenMode = iota
 	SCREEN_HALF
 	SCREEN_FULL
+	// Only the focused side panel and its main view are shown, without the
+	// command log and the bottom line. Not part of the cycle of the other
+	// screen modes; it is toggled on and off with its own key.
+	SCREEN_ZEN
 )
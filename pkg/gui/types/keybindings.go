@@ -7,6 +7,11 @@ import (
 
 type Key interface{} // FIXME: find out how to get `gocui.Key | rune`
 
+// A Key made of several keys that have to be pressed one after the other,
+// separated by spaces like in the config, e.g. "g p". Gocui doesn't know about
+// these; we bind the first key to a menu showing the possible continuations.
+type KeySequence string
+
 // Binding - a keybinding mapping a key and modifier to a handler. The keypress
 // is only handled if the given view has focus, or handled globally if the view
 // is ""
@@ -49,6 +54,10 @@ type Binding struct {
 	// invoke it. When left nil, the command is always enabled. Note that this
 	// function must not do expensive calls.
 	GetDisabledReason func() *DisabledReason
+
+	// If true, a count typed before the key (e.g. 5j) runs the handler that
+	// many times. Only applies when the countPrefixes config is enabled.
+	Repeatable bool
 }
 
 func (b *Binding) IsDisabled() bool {
@@ -72,6 +72,10 @@ func NewRenderStringWithScrollTask(str string, originX int, originY int) *Render
 type RunCommandTask struct {
 	Cmd    *exec.Cmd
 	Prefix string
+	// Used instead of Prefix if set. It is called while the command is running
+	// rather than on the UI thread, so it can be used for prefixes that need
+	// to run other commands
+	PrefixFn func() string
 }
 
 func (t *RunCommandTask) IsUpdateTask() {}
@@ -84,9 +88,24 @@ func NewRunCommandTaskWithPrefix(cmd *exec.Cmd, prefix string) *RunCommandTask {
 	return &RunCommandTask{Cmd: cmd, Prefix: prefix}
 }
 
+func NewRunCommandTaskWithPrefixFn(cmd *exec.Cmd, prefixFn func() string) *RunCommandTask {
+	return &RunCommandTask{Cmd: cmd, PrefixFn: prefixFn}
+}
+
+func (t *RunCommandTask) GetPrefix() string {
+	if t.PrefixFn != nil {
+		return t.PrefixFn()
+	}
+	return t.Prefix
+}
+
 type RunPtyTask struct {
 	Cmd    *exec.Cmd
 	Prefix string
+	// Used instead of Prefix if set. It is called while the command is running
+	// rather than on the UI thread, so it can be used for prefixes that need
+	// to run other commands
+	PrefixFn func() string
 }
 
 func (t *RunPtyTask) IsUpdateTask() {}
@@ -98,3 +117,14 @@ func NewRunPtyTask(cmd *exec.Cmd) *RunPtyTask {
 func NewRunPtyTaskWithPrefix(cmd *exec.Cmd, prefix string) *RunPtyTask {
 	return &RunPtyTask{Cmd: cmd, Prefix: prefix}
 }
+
+func NewRunPtyTaskWithPrefixFn(cmd *exec.Cmd, prefixFn func() string) *RunPtyTask {
+	return &RunPtyTask{Cmd: cmd, PrefixFn: prefixFn}
+}
+
+func (t *RunPtyTask) GetPrefix() string {
+	if t.PrefixFn != nil {
+		return t.PrefixFn()
+	}
+	return t.Prefix
+}
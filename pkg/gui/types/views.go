@@ -40,6 +40,8 @@ type Views struct {
 	Suggestions       *gocui.View
 	Tooltip           *gocui.View
 	Extras            *gocui.View
+	CommandOutput     *gocui.View
+	AppLog            *gocui.View
 
 	// for playing the easter egg snake game
 	Snake *gocui.View
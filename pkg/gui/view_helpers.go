@@ -37,6 +37,11 @@ func (gui *Gui) linesToReadFromCmdTask(v *gocui.View) tasks.LinesToRead {
 		linesToReadForAccurateScrollbar = 5000
 	}
 
+	// The minimap shows an overview of the whole content, so we read all of it
+	if v.Minimap != nil {
+		linesToReadForAccurateScrollbar = -1
+	}
+
 	return tasks.LinesToRead{
 		Total:               linesToReadForAccurateScrollbar,
 		InitialRefreshAfter: linesForFirstRefresh,
@@ -73,6 +78,33 @@ func (gui *Gui) onViewTabClick(windowName string, tabIndex int) error {
 		return nil
 	}
 
+	if context.GetKind() == types.EXTRAS_CONTEXT {
+		// Escaping from any of the extras tabs should return to the side panel
+		// that the extras window was focused from
+		parentContext := gui.c.Context().CurrentSide()
+		if current := gui.c.Context().Current(); current.GetKind() == types.EXTRAS_CONTEXT {
+			parentContext = current.GetParentContext()
+		}
+		context.SetParentContext(parentContext)
+		gui.c.State().SetShowExtrasWindow(true)
+	}
+
+	gui.c.Context().Push(context, types.OnFocusOpts{})
+	return nil
+}
+
+// Focuses the side panel that the mouse moved into, for focus-follows-mouse
+func (gui *Gui) onViewHovered(viewName string) error {
+	if !gui.c.UserConfig().Gui.FocusFollowsMouse || gui.helpers.Confirmation.IsPopupPanelFocused() {
+		return nil
+	}
+
+	context, ok := gui.helpers.View.ContextForView(viewName)
+	if !ok || context.GetKind() != types.SIDE_CONTEXT || !context.IsFocusable() ||
+		context.GetKey() == gui.c.Context().Current().GetKey() {
+		return nil
+	}
+
 	gui.c.Context().Push(context, types.OnFocusOpts{})
 	return nil
 }
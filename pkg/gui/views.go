@@ -6,6 +6,7 @@ import (
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/samber/lo"
 	"golang.org/x/exp/slices"
@@ -50,6 +51,8 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.Main, name: "main"},
 
 		{viewPtr: &gui.Views.Extras, name: "extras"},
+		{viewPtr: &gui.Views.CommandOutput, name: "commandOutput"},
+		{viewPtr: &gui.Views.AppLog, name: "appLog"},
 
 		// bottom line
 		{viewPtr: &gui.Views.Options, name: "options"},
@@ -141,9 +144,11 @@ func (gui *Gui) createAllViews() error {
 	gui.Views.Information.FgColor = gocui.ColorGreen
 	gui.Views.Information.Frame = false
 
-	gui.Views.Extras.Autoscroll = true
-	gui.Views.Extras.Wrap = true
-	gui.Views.Extras.AutoRenderHyperLinks = true
+	for _, view := range gui.extrasViews() {
+		view.Autoscroll = true
+		view.Wrap = true
+		view.AutoRenderHyperLinks = true
+	}
 
 	gui.Views.Snake.FgColor = gocui.ColorGreen
 
@@ -170,6 +175,7 @@ func (gui *Gui) configureViewProperties() {
 		(*mapping.viewPtr).SelBgColor = theme.GocuiSelectedLineBgColor
 		(*mapping.viewPtr).SelFgColor = gui.g.SelFgColor
 		(*mapping.viewPtr).InactiveViewSelBgColor = theme.GocuiInactiveViewSelectedLineBgColor
+		(*mapping.viewPtr).HideScrollbar = !gui.c.UserConfig().Gui.ShowScrollbars
 	}
 
 	gui.c.SetViewContent(gui.Views.SearchPrefix, gui.c.Tr.SearchPrefix)
@@ -192,6 +198,8 @@ func (gui *Gui) configureViewProperties() {
 	gui.Views.CommitMessage.Title = gui.c.Tr.CommitSummary
 	gui.Views.CommitDescription.Title = gui.c.Tr.CommitDescriptionTitle
 	gui.Views.Extras.Title = gui.c.Tr.CommandLog
+	gui.Views.CommandOutput.Title = gui.c.Tr.CommandOutputTitle
+	gui.Views.AppLog.Title = gui.c.Tr.AppLogTitle
 	gui.Views.Snake.Title = gui.c.Tr.SnakeTitle
 
 	for _, view := range []*gocui.View{gui.Views.Main, gui.Views.Secondary, gui.Views.Staging, gui.Views.StagingSecondary, gui.Views.PatchBuilding, gui.Views.PatchBuildingSecondary, gui.Views.MergeConflicts} {
@@ -200,6 +208,13 @@ func (gui *Gui) configureViewProperties() {
 		view.TabWidth = gui.c.UserConfig().Gui.TabWidth
 	}
 
+	for _, view := range []*gocui.View{gui.Views.Main, gui.Views.Secondary} {
+		view.Minimap = nil
+		if gui.c.UserConfig().Gui.ShowDiffMinimap {
+			view.Minimap = presentation.DiffMinimap
+		}
+	}
+
 	gui.Views.CommitDescription.FgColor = theme.GocuiDefaultTextColor
 	gui.Views.CommitDescription.TextArea.AutoWrap = gui.c.UserConfig().Git.Commit.AutoWrapCommitMessage
 	gui.Views.CommitDescription.TextArea.AutoWrapWidth = gui.c.UserConfig().Git.Commit.AutoWrapWidth
@@ -27,6 +27,29 @@ type TranslationSet struct {
 	SquashMergeCommittedTitle             string
 	SquashMergeUncommitted                string
 	SquashMergeCommitted                  string
+	PreviewMerge                          string
+	MergeOptionsSection                   string
+	MergeNoFastForward                    string
+	MergeNoFastForwardTooltip             string
+	MergeFastForwardOnly                  string
+	MergeFastForwardOnlyTooltip           string
+	MergeStrategyOption                   string
+	MergeStrategyOptionDefault            string
+	MergeStrategyOptionTitle              string
+	MergeStrategyOptionTooltip            string
+	MergeEditMessage                      string
+	MergeEditMessageTooltip               string
+	MergeCommitMessageTitle               string
+	RebaseOptionsSection                  string
+	RebaseResetAuthorDate                 string
+	RebaseResetAuthorDateTooltip          string
+	RebaseKeepDates                       string
+	RebaseKeepDatesTooltip                string
+	PreviewMergeTooltip                   string
+	MergePreviewTitle                     string
+	MergePreviewClean                     string
+	MergePreviewConflicts                 string
+	MergePreviewRequiresNewerGit          string
 	RegularMergeTooltip                   string
 	NormalTitle                           string
 	LogTitle                              string
@@ -36,6 +59,8 @@ type TranslationSet struct {
 	CredentialsPassphrase                 string
 	CredentialsPIN                        string
 	CredentialsToken                      string
+	CredentialsOneTimePassword            string
+	CredentialsConfirmation               string
 	PassUnameWrong                        string
 	Commit                                string
 	CommitTooltip                         string
@@ -64,6 +89,9 @@ type TranslationSet struct {
 	OpenDiffTool                          string
 	OpenMergeTool                         string
 	OpenMergeToolTooltip                  string
+	OpenMergeToolForFile                  string
+	OpenMergeToolForFileTooltip           string
+	FileHasNoConflicts                    string
 	Refresh                               string
 	RefreshTooltip                        string
 	Push                                  string
@@ -105,6 +133,27 @@ type TranslationSet struct {
 	MergeConflictCurrentDiff              string
 	MergeConflictPressEnterToResolve      string
 	MergeConflictKeepFile                 string
+	ResolveAllConflicts                   string
+	ResolveAllConflictsTooltip            string
+	ViewRerereOptions                     string
+	ViewRerereOptionsTooltip              string
+	RerereTitle                           string
+	EnableRerere                          string
+	EnableRerereTooltip                   string
+	ResolvedByRerereSection               string
+	RecordedResolutionsSection            string
+	NoRecordedResolutions                 string
+	ForgetRerereResolution                string
+	ForgetRerereResolutionTooltip         string
+	ForgetRerereResolutionPrompt          string
+	DeleteRecordedResolutionTooltip       string
+	DeleteRecordedResolutionPrompt        string
+	ResolvedByRerereNote                  string
+	TakeOursForAllConflicts               string
+	TakeTheirsForAllConflicts             string
+	TakeOursForAllConflictsPrompt         string
+	TakeTheirsForAllConflictsPrompt       string
+	NoConflictedFiles                     string
 	MergeConflictDeleteFile               string
 	Checkout                              string
 	CheckoutTooltip                       string
@@ -120,6 +169,7 @@ type TranslationSet struct {
 	BranchName                            string
 	NewBranchNameBranchOff                string
 	CantDeleteCheckOutBranch              string
+	CantDeleteProtectedBranch             string
 	DeleteBranchTitle                     string
 	DeleteBranchesTitle                   string
 	DeleteLocalBranch                     string
@@ -135,6 +185,9 @@ type TranslationSet struct {
 	RebaseBranchTooltip                   string
 	CantRebaseOntoSelf                    string
 	CantMergeBranchIntoItself             string
+	OctopusMerge                          string
+	OctopusMergePrompt                    string
+	OctopusMergeFailed                    string
 	ForceCheckout                         string
 	ForceCheckoutTooltip                  string
 	CheckoutByName                        string
@@ -184,6 +237,7 @@ type TranslationSet struct {
 	MoveDownCommit                        string
 	MoveUpCommit                          string
 	CannotMoveAnyFurther                  string
+	CannotDragCommitsOutsideBranch        string
 	CannotMoveMergeCommit                 string
 	EditCommit                            string
 	EditCommitTooltip                     string
@@ -194,15 +248,33 @@ type TranslationSet struct {
 	SetAuthor                             string
 	SetAuthorTooltip                      string
 	AddCoAuthor                           string
+	ResetAuthorDate                       string
+	ResetAuthorDateTooltip                string
+	SelectAuthor                          string
+	SelectAuthorTooltip                   string
+	EnterNewAuthor                        string
+	BulkSetAuthor                         string
+	BulkSetAuthorTooltip                  string
+	BulkSetAuthorTitle                    string
+	BulkSetAuthorPrompt                   string
+	BulkSetAuthorPushedWarning            string
 	AmendCommitAttribute                  string
 	AmendCommitAttributeTooltip           string
 	SetAuthorPromptTitle                  string
 	AddCoAuthorPromptTitle                string
 	AddCoAuthorTooltip                    string
+	InsertIssueReference                  string
+	InsertIssueReferencePromptTitle       string
+	FetchingIssuesStatus                  string
+	NoOpenIssues                          string
 	RewordCommitEditor                    string
 	NoCommitsThisBranch                   string
 	UpdateRefHere                         string
 	ExecCommandHere                       string
+	BreakHere                             string
+	ToggleBreak                           string
+	ToggleBreakTooltip                    string
+	BreakIsOnlyAllowedDuringRebase        string
 	Error                                 string
 	Undo                                  string
 	UndoReflog                            string
@@ -231,6 +303,12 @@ type TranslationSet struct {
 	StashChanges                          string
 	RenameStash                           string
 	RenameStashPrompt                     string
+	ApplyStashToBranch                    string
+	ApplyStashToBranchTooltip             string
+	ApplyStashToBranchPrompt              string
+	StashBranchNotFound                   string
+	CannotApplyStashToBranchWithChanges   string
+	StashAppliedInWorktree                string
 	OpenConfig                            string
 	EditConfig                            string
 	ForcePush                             string
@@ -238,8 +316,70 @@ type TranslationSet struct {
 	ForcePushDisabled                     string
 	UpdatesRejected                       string
 	UpdatesRejectedAndForcePushDisabled   string
+	CantForcePushProtectedBranch          string
 	CheckForUpdate                        string
 	CheckingForUpdates                    string
+	HealthChecks                          string
+	HealthChecksTooltip                   string
+	HealthChecksTitle                     string
+	RunningHealthChecks                   string
+	ActivitySummary                       string
+	ActivitySummaryTooltip                string
+	ActivitySummaryTitle                  string
+	ShowActivitySummary                   string
+	CopyActivitySummary                   string
+	LoadingActivitySummaryStatus          string
+	NoRecentActivity                      string
+	ActivitySummaryCopiedToClipboard      string
+	Settings                              string
+	SettingsTooltip                       string
+	SettingsTitle                         string
+	SettingDefaultValue                   string
+	SettingOnlyInConfigFile               string
+	SettingSaved                          string
+	SwitchProfile                         string
+	SwitchProfileTooltip                  string
+	SwitchProfileTitle                    string
+	NoProfile                             string
+	NoProfilesDefined                     string
+	SwitchedToProfile                     string
+	SwitchedToNoProfile                   string
+	SwitchTheme                           string
+	SwitchThemeTooltip                    string
+	SwitchThemeTitle                      string
+	SwitchedToTheme                       string
+	ThemePresetPinned                     string
+	HealthCheckOK                         string
+	HealthCheckWarning                    string
+	HealthCheckError                      string
+	HealthCheckDanglingObjects            string
+	HealthCheckBrokenRefs                 string
+	HealthCheckIndexSize                  string
+	HealthCheckLockFiles                  string
+	HealthCheckFsck                       string
+	HealthCheckDetachedSubmodules         string
+	HealthCheckGc                         string
+	HealthCheckNone                       string
+	HealthCheckPassedTooltip              string
+	DanglingObjectsCount                  string
+	FsckProblemsCount                     string
+	FsckNoProblems                        string
+	GcNeededDetail                        string
+	PruneDanglingObjects                  string
+	PruneDanglingObjectsTooltip           string
+	PruneDanglingObjectsPrompt            string
+	DeleteBrokenRefs                      string
+	DeleteBrokenRefsTooltip               string
+	DeleteBrokenRefsPrompt                string
+	UpgradeIndexVersionTooltip            string
+	RemoveStaleLockFiles                  string
+	RemoveStaleLockFilesTooltip           string
+	RemoveStaleLockFilesPrompt            string
+	FsckProblems                          string
+	ShowFsckProblemsTooltip               string
+	DetachedSubmodulesTooltip             string
+	RunGcTooltip                          string
+	RunningGc                             string
 	UpdateAvailableTitle                  string
 	UpdateAvailable                       string
 	UpdateInProgressWaitingStatus         string
@@ -263,8 +403,22 @@ type TranslationSet struct {
 	OpenFile                              string
 	OpenFileTooltip                       string
 	OpenInEditor                          string
+	OpenInTmux                            string
+	OpenInTmuxTooltip                     string
+	OpenRecentRepoInTmux                  string
+	NotInTmux                             string
 	IgnoreFile                            string
 	ExcludeFile                           string
+	ToggleSkipWorktree                    string
+	ToggleSkipWorktreeTooltip             string
+	ToggleAssumeUnchanged                 string
+	ToggleAssumeUnchangedTooltip          string
+	IndexFlagsOnlyForTrackedFiles         string
+	IndexFlagsNeedHiddenChangesEnabled    string
+	SkipWorktreeInSparseCheckout          string
+	HideFileChangesTitle                  string
+	HideFileChangesPrompt                 string
+	FileHiddenFromStatusWarning           string
 	RefreshFiles                          string
 	FocusMainView                         string
 	Merge                                 string
@@ -274,6 +428,11 @@ type TranslationSet struct {
 	SwitchRepo                            string
 	AllBranchesLogGraph                   string
 	UnsupportedGitService                 string
+	UnknownGitServiceProvider             string
+	ReleasesNotSupported                  string
+	PullRequestAPINotSupported            string
+	PullRequestsFromForksNotSupported     string
+	IssueAPINotSupported                  string
 	CopyPullRequestURL                    string
 	NoBranchOnRemote                      string
 	Fetch                                 string
@@ -282,6 +441,23 @@ type TranslationSet struct {
 	CollapseAllTooltip                    string
 	ExpandAll                             string
 	ExpandAllTooltip                      string
+	IntentToAdd                           string
+	IntentToAddTooltip                    string
+	OnlyUntrackedFilesCanBeIntentToAdd    string
+	ToggleExecutable                      string
+	ToggleExecutableTooltip               string
+	ToggleExecutableOnlyForFiles          string
+	CannotToggleExecutableForMode         string
+	SortFilesByName                       string
+	SortFilesByStatus                     string
+	SortFilesByStatusHint                 string
+	SortFilesByMtime                      string
+	SortFilesByMtimeHint                  string
+	SortFilesByDiffSize                   string
+	SortFilesByDiffSizeHint               string
+	SortOrderPromptFiles                  string
+	FileIsNowExecutable                   string
+	FileIsNoLongerExecutable              string
 	DisabledInFlatView                    string
 	FileEnter                             string
 	FileEnterTooltip                      string
@@ -337,9 +513,20 @@ type TranslationSet struct {
 	InformationTitle                      string
 	SecondaryTitle                        string
 	ReflogCommitsTitle                    string
+	ViewReflogOfRef                       string
+	ViewReflogOfRefTooltip                string
+	ReflogDiffTarget                      string
+	ReflogDiffTargetTooltip               string
+	ReflogDiffAgainstParent               string
+	ReflogDiffAgainstHead                 string
+	ReflogDiffAgainstWorkingTree          string
+	ShowingReflogDiffAgainstHead          string
+	ShowingReflogDiffAgainstWorkingTree   string
 	ConflictsResolved                     string
 	Continue                              string
 	UnstagedFilesAfterConflictsResolved   string
+	AutoContinueAfterConflicts            string
+	AutoContinueAfterConflictsTooltip     string
 	RebasingTitle                         string
 	RebasingFromBaseCommitTitle           string
 	SimpleRebase                          string
@@ -358,6 +545,7 @@ type TranslationSet struct {
 	PendingCherryPicksSectionHeader       string
 	PendingRevertsSectionHeader           string
 	CommitsSectionHeader                  string
+	RebasePausedAtBreakSectionHeader      string
 	YouDied                               string
 	RewordNotSupported                    string
 	ChangingThisActionIsNotAllowed        string
@@ -370,6 +558,10 @@ type TranslationSet struct {
 	SureCherryPick                        string
 	CherryPick                            string
 	CannotCherryPickNonCommit             string
+	CannotMarkNonCommit                   string
+	ToggleCommitMarked                    string
+	ToggleCommitMarkedTooltip             string
+	MarkedCommitHashes                    string
 	Donate                                string
 	AskQuestion                           string
 	PrevHunk                              string
@@ -391,6 +583,7 @@ type TranslationSet struct {
 	AmendCommitWithConflictsAmend         string
 	DropCommitTitle                       string
 	DropCommitPrompt                      string
+	DropMarkedCommitsPrompt               string
 	DropUpdateRefPrompt                   string
 	DropMergeCommitPrompt                 string
 	PullingStatus                         string
@@ -402,7 +595,10 @@ type TranslationSet struct {
 	DroppingStatus                        string
 	MovingStatus                          string
 	RebasingStatus                        string
+	OperationFinishedNotification         string
+	OperationFailedNotification           string
 	MergingStatus                         string
+	PreviewingMergeStatus                 string
 	LowercaseRebasingStatus               string
 	LowercaseMergingStatus                string
 	LowercaseCherryPickingStatus          string
@@ -425,6 +621,9 @@ type TranslationSet struct {
 	CommitFilesTitle                      string
 	CheckoutCommitFileTooltip             string
 	CanOnlyDiscardFromLocalCommits        string
+	ApplyFilesFromStash                   string
+	ApplyFilesFromStashTooltip            string
+	CanOnlyApplyFilesFromStash            string
 	Remove                                string
 	DiscardOldFileChangeTooltip           string
 	DiscardFileChangesTitle               string
@@ -435,6 +634,9 @@ type TranslationSet struct {
 	InitialBranch                         string
 	NoRecentRepositories                  string
 	IncorrectNotARepository               string
+	TrustRepoConfigFileTitle              string
+	ConfigReloaded                        string
+	TrustRepoConfigFilePrompt             string
 	AutoStashTitle                        string
 	AutoStashPrompt                       string
 	AutoStashForUndo                      string
@@ -486,6 +688,13 @@ type TranslationSet struct {
 	ResetMixedTooltip                     string
 	ResetHardTooltip                      string
 	ResetHardConfirmation                 string
+	RestoreSnapshot                       string
+	RestoreSnapshotTooltip                string
+	RestoreSnapshotPrompt                 string
+	RestoreSnapshotNoCommitsYetPrompt     string
+	RestoreSnapshotOnOtherBranchWarning   string
+	SnapshotFailed                        string
+	UndoDiscardPrompt                     string
 	PressEnterToReturn                    string
 	ViewStashOptions                      string
 	ViewStashOptionsTooltip               string
@@ -496,6 +705,14 @@ type TranslationSet struct {
 	StashAllChangesKeepIndex              string
 	StashUnstagedChanges                  string
 	StashIncludeUntrackedChanges          string
+	StashAllChangesTooltip                string
+	StashStagedChangesTooltip             string
+	StashAllChangesKeepIndexTooltip       string
+	StashUnstagedChangesTooltip           string
+	StashSelection                        string
+	StashSelectionTooltip                 string
+	CanOnlyStashUnstagedLines             string
+	StashIncludeUntrackedChangesTooltip   string
 	StashOptions                          string
 	NotARepository                        string
 	WorkingDirectoryDoesNotExist          string
@@ -556,6 +773,12 @@ type TranslationSet struct {
 	TagMessageTitle                       string
 	LightweightTag                        string
 	AnnotatedTag                          string
+	TagSignature                          string
+	EditTagMessage                        string
+	EditTagMessageTooltip                 string
+	CannotEditLightweightTag              string
+	EditTagMessageTitle                   string
+	EditTagMessagePrompt                  string
 	DeleteTagTitle                        string
 	DeleteLocalTag                        string
 	DeleteRemoteTag                       string
@@ -567,11 +790,32 @@ type TranslationSet struct {
 	PushTagTitle                          string
 	PushTag                               string
 	PushTagTooltip                        string
+	PushUnpushedTags                      string
+	PushUnpushedTagsTooltip               string
+	PushUnpushedTagsTitle                 string
+	PushUnpushedTagsPrompt                string
+	NoUnpushedTags                        string
+	TagsPushedMessage                     string
+	ListingRemoteTagsStatus               string
+	RemoteUnpushedTagsCount               string
+	RemoteHasTag                          string
+	RemoteLacksTag                        string
+	NoRemotes                             string
+	CreateRelease                         string
+	CreateReleaseTooltip                  string
+	ReleaseTitleTitle                     string
+	CreatingReleaseStatus                 string
+	ReleaseCreated                        string
 	NewTag                                string
 	NewTagTooltip                         string
 	CreatingTag                           string
 	ForceTag                              string
 	ForceTagPrompt                        string
+	CreateTagMenuTitle                    string
+	BumpPatchVersion                      string
+	BumpMinorVersion                      string
+	BumpMajorVersion                      string
+	CustomTagName                         string
 	FetchRemoteTooltip                    string
 	CheckoutCommitTooltip                 string
 	NoBranchesFoundAtCommitTooltip        string
@@ -592,6 +836,7 @@ type TranslationSet struct {
 	KeybindingsMenuSectionLocal           string
 	KeybindingsMenuSectionGlobal          string
 	KeybindingsMenuSectionNavigation      string
+	ContextMenuTitle                      string
 	RenameBranch                          string
 	Upstream                              string
 	BranchUpstreamOptionsTitle            string
@@ -604,6 +849,7 @@ type TranslationSet struct {
 	OpenKeybindingsMenu                   string
 	ResetCherryPick                       string
 	ResetCherryPickShort                  string
+	ResetMarkedCommits                    string
 	NextTab                               string
 	PrevTab                               string
 	CantUndoWhileRebasing                 string
@@ -622,6 +868,66 @@ type TranslationSet struct {
 	FilterBy                              string
 	ExitFilterMode                        string
 	FilterPathOption                      string
+	SaveFilterPathForRepo                 string
+	SaveFilterPathForRepoTooltip          string
+	WidenSavedFilterScope                 string
+	WidenSavedFilterScopeTooltip          string
+	ApplySavedFilterPath                  string
+	ForgetSavedFilterPath                 string
+	SavedForThisRepo                      string
+	FilteringCommits                      string
+	CommitFilters                         string
+	CommitFiltersTooltip                  string
+	SearchHistoryForCode                  string
+	GoToCommit                            string
+	GoToCommitTooltip                     string
+	EnterCommitHashOrRef                  string
+	CommitNotFound                        string
+	FindContainingRefs                    string
+	FindContainingRefsTooltip             string
+	GenerateReleaseNotes                  string
+	GenerateReleaseNotesForCommitsTooltip string
+	GenerateReleaseNotesForTagsTooltip    string
+	CannotGenerateReleaseNotesForTodos    string
+	GeneratingReleaseNotesStatus          string
+	NoCommitsForReleaseNotes              string
+	ReleaseNotesTitle                     string
+	ShowReleaseNotesByType                string
+	ShowReleaseNotesByAuthor              string
+	CopyReleaseNotesByType                string
+	CopyReleaseNotesByAuthor              string
+	ReleaseNotesCopiedToClipboard         string
+	ContainingRefsTitle                   string
+	NoRefsContainCommit                   string
+	FindingContainingRefsStatus           string
+	ContainingRefLocalBranch              string
+	ContainingRefRemoteBranch             string
+	ContainingRefTag                      string
+	CommitNotInCurrentHistory             string
+	SearchHistoryForCodeTooltip           string
+	PickaxeString                         string
+	PickaxeRegex                          string
+	EnterPickaxeString                    string
+	EnterPickaxeRegex                     string
+	CommitFilterAuthor                    string
+	CommitFilterSince                     string
+	CommitFilterUntil                     string
+	CommitFilterMessage                   string
+	CommitFilterNoMerges                  string
+	CommitFilterPromptTooltip             string
+	CommitFilterOn                        string
+	CommitFilterOff                       string
+	ClearCommitFilters                    string
+	ClearCommitFiltersTooltip             string
+	NoCommitFilters                       string
+	EnterSinceDate                        string
+	EnterUntilDate                        string
+	EnterCommitMessagePattern             string
+	CommitFilterAuthorLabel               string
+	CommitFilterSinceLabel                string
+	CommitFilterUntilLabel                string
+	CommitFilterMessageLabel              string
+	CommitFilterNoMergesLabel             string
 	FilterAuthorOption                    string
 	EnterFileName                         string
 	EnterAuthor                           string
@@ -653,9 +959,16 @@ type TranslationSet struct {
 	CommitSubject                         string
 	CommitAuthor                          string
 	CommitTags                            string
+	CommitDetailsHash                     string
+	CommitDetailsAuthor                   string
+	CommitDetailsCommitter                string
+	CommitDetailsParents                  string
+	CommitDetailsBranches                 string
+	CommitDetailsTags                     string
 	CopyCommitAttributeToClipboard        string
 	CopyCommitAttributeToClipboardTooltip string
 	CopyBranchNameToClipboard             string
+	CopyBranchNameToClipboardTooltip      string
 	CopyTagToClipboard                    string
 	CopyPathToClipboard                   string
 	CommitPrefixPatternError              string
@@ -672,6 +985,10 @@ type TranslationSet struct {
 	ViewCommits                           string
 	MinGitVersionError                    string
 	RunningCustomCommandStatus            string
+	CustomCommandInputDoesNotMatch        string
+	MacroCannotRunMacro                   string
+	MacroStepDisabled                     string
+	MacroStepNotFound                     string
 	SubmoduleStashAndReset                string
 	AndResetSubmodules                    string
 	EnterSubmoduleTooltip                 string
@@ -685,6 +1002,7 @@ type TranslationSet struct {
 	NewSubmoduleName                      string
 	NewSubmoduleUrl                       string
 	NewSubmodulePath                      string
+	NewSubmoduleBranch                    string
 	NewSubmodule                          string
 	AddingSubmoduleStatus                 string
 	UpdateSubmoduleUrl                    string
@@ -696,10 +1014,23 @@ type TranslationSet struct {
 	Initialize                            string
 	SubmoduleUpdateTooltip                string
 	UpdatingSubmoduleStatus               string
+	UpdateSubmoduleToRemote               string
+	UpdateSubmoduleToRemoteTooltip        string
+	UpdatingSubmoduleToRemoteStatus       string
+	SetSubmoduleBranch                    string
+	SetSubmoduleBranchTooltip             string
+	SetSubmoduleBranchPrompt              string
+	SettingSubmoduleBranchStatus          string
 	BulkInitSubmodules                    string
 	BulkUpdateSubmodules                  string
 	BulkDeinitSubmodules                  string
 	BulkUpdateRecursiveSubmodules         string
+	BulkUpdateRemoteSubmodules            string
+	RunCommandInAllSubmodules             string
+	SubmoduleCommandPrompt                string
+	CustomSubmoduleCommand                string
+	SubmoduleCommandSucceeded             string
+	SubmoduleCommandFailed                string
 	ViewBulkSubmoduleOptions              string
 	BulkSubmoduleOptions                  string
 	RunningCommand                        string
@@ -720,6 +1051,7 @@ type TranslationSet struct {
 	CommitSubjectCopiedToClipboard           string
 	CommitAuthorCopiedToClipboard            string
 	CommitTagsCopiedToClipboard              string
+	MarkedCommitHashesCopiedToClipboard      string
 	CommitHasNoTags                          string
 	CommitHasNoMessageBody                   string
 	PatchCopiedToClipboard                   string
@@ -731,12 +1063,106 @@ type TranslationSet struct {
 	ErrRepositoryMovedOrDeleted              string
 	ErrWorktreeMovedOrRemoved                string
 	CommandLog                               string
+	CommandOutputTitle                       string
+	AppLogTitle                              string
+	NoCommandOutput                          string
+	CommandExitCode                          string
 	ToggleShowCommandLog                     string
 	FocusCommandLog                          string
+	ShowBackgroundTasks                      string
+	BackgroundTasks                          string
+	UnnamedTask                              string
+	TaskRunning                              string
+	TaskPaused                               string
+	TaskAlreadyFinished                      string
+	TaskCannotBeCancelled                    string
+	CancelledTask                            string
+	CancelTaskTooltip                        string
+	RefreshTask                              string
+	AutoFetchTask                            string
+	AutoRefreshTask                          string
+	StatusBarRefreshTask                     string
+	LoadCommitStatsTask                      string
+	LoggedCommands                           string
+	OpenLoggedCommandsMenu                   string
+	OpenLoggedCommandsMenuTooltip            string
+	NoLoggedCommands                         string
+	RerunCommand                             string
+	RerunCommandPrompt                       string
+	CommandCopiedToClipboard                 string
 	CommandLogHeader                         string
 	RandomTip                                string
 	ToggleWhitespaceInDiffView               string
 	ToggleWhitespaceInDiffViewTooltip        string
+	ToggleMovedLinesInDiffView               string
+	ToggleMovedLinesInDiffViewTooltip        string
+	DiffOptions                              string
+	DiffOptionsTooltip                       string
+	DiffOptionsTitle                         string
+	IgnoreWhitespace                         string
+	IgnoreBlankLines                         string
+	IgnoreBlankLinesTooltip                  string
+	ColorMovedLines                          string
+	DiffAlgorithm                            string
+	DefaultDiffAlgorithm                     string
+	DefaultDiffAlgorithmTooltip              string
+	CantChangeDiffAlgorithmError             string
+	PagerFailed                              string
+	GoToLine                                 string
+	GoToLineTooltip                          string
+	GoToLinePromptTitle                      string
+	InvalidLineNumber                        string
+	BinaryFileTitle                          string
+	BinaryFileOldVersion                     string
+	BinaryFileNewVersion                     string
+	BinaryFileSize                           string
+	BinaryFileType                           string
+	BinaryFileDimensions                     string
+	EditFileAtLine                           string
+	EditFileAtLineTooltip                    string
+	NoDiffLineToEdit                         string
+	OpenLayoutMenu                           string
+	OpenLayoutMenuTooltip                    string
+	ToggleZenMode                            string
+	ToggleZenModeTooltip                     string
+	ToggleKeypressRecording                  string
+	ToggleKeypressRecordingTooltip           string
+	ReplayKeypressRecording                  string
+	ReplayKeypressRecordingTooltip           string
+	ReplayKeypressRecordingTitle             string
+	CycleDateDisplay                         string
+	CycleDateDisplayTooltip                  string
+	ShowingRelativeDates                     string
+	ShowingAbsoluteDates                     string
+	ShowingCustomDates                       string
+	KeypressRecordingStarted                 string
+	KeypressRecordingEmpty                   string
+	KeypressRecordingSaved                   string
+	RecordingKeypresses                      string
+	PendingCountPrefix                       string
+	MoreKeySequences                         string
+	CannotReplayWhileRecording               string
+	NoKeypressRecording                      string
+	InvalidReplayCount                       string
+	LayoutMenuTitle                          string
+	SidePanelsOnLeft                         string
+	SidePanelsOnRight                        string
+	SidePanelsOnRightTooltip                 string
+	SidePanelsSection                        string
+	SidePanelHidden                          string
+	SidePanelSharesSlotWith                  string
+	ShowSidePanel                            string
+	HideSidePanel                            string
+	MoveSidePanelUp                          string
+	MoveSidePanelDown                        string
+	ShareSlotWithPanelAbove                  string
+	ShareSlotWithPanelAboveTooltip           string
+	GiveSidePanelOwnSlot                     string
+	CannotHideLastSidePanel                  string
+	SidePanelIsHidden                        string
+	ResetLayout                              string
+	ResetLayoutTooltip                       string
+	LayoutIsNotCustomized                    string
 	IgnoreWhitespaceDiffViewSubTitle         string
 	IgnoreWhitespaceNotSupportedHere         string
 	IncreaseContextInDiffView                string
@@ -754,6 +1180,31 @@ type TranslationSet struct {
 	SelectBranch                             string
 	SelectTargetRemote                       string
 	NoValidRemoteName                        string
+	HostingServiceSection                    string
+	SelectHostingServiceRemote               string
+	HostingServiceRemoteTitle                string
+	OnlyOneRemote                            string
+	PullRequestAPINoToken                    string
+	CreatePullRequestViaAPI                  string
+	ListOpenPullRequests                     string
+	ShowPipelineStatus                       string
+	PullRequestTitleTitle                    string
+	PullRequestDescriptionTitle              string
+	CreatingPullRequestStatus                string
+	PullRequestCreated                       string
+	FetchingPullRequestsStatus               string
+	LookingUpAPITokenStatus                  string
+	NoOpenPullRequests                       string
+	PullRequestDraft                         string
+	FetchingPipelineStatusStatus             string
+	NoPipelinesForBranch                     string
+	PipelineStatusPrompt                     string
+	ListAssignedIssues                       string
+	NoAssignedIssues                         string
+	StartWorkOnIssue                         string
+	StartWorkOnIssueTooltip                  string
+	OpenIssueInBrowser                       string
+	UpdatingIssueStatus                      string
 	CreatePullRequest                        string
 	SelectConfigFile                         string
 	NoConfigFileFoundErr                     string
@@ -799,6 +1250,8 @@ type TranslationSet struct {
 	CustomPatch                              string
 	CommitsCopied                            string
 	CommitCopied                             string
+	CommitsMarked                            string
+	CommitMarked                             string
 	ResetPatch                               string
 	ResetPatchTooltip                        string
 	ApplyPatch                               string
@@ -857,9 +1310,22 @@ type TranslationSet struct {
 	BranchNameCannotBeBlank                  string
 	NewBranchName                            string
 	NewBranchNameLeaveBlank                  string
+	NewBranchNameTrackingRemoteBranch        string
+	CreateWorktreeMenuTitle                  string
+	CreateWorktreeAndSwitch                  string
+	CreateWorktreeAndOpenInNewWindow         string
+	CreateWorktreeAndOpenInNewWindowTooltip  string
+	CreateLockedWorktreeFrom                 string
+	CreateLockedWorktreeTooltip              string
+	WorktreeLockReason                       string
 	ViewWorktreeOptions                      string
 	CreateWorktreeFrom                       string
 	CreateWorktreeFromDetached               string
+	ReviewInWorktree                         string
+	ReviewInWorktreeAndOpenInEditor          string
+	ReviewInWorktreeTooltip                  string
+	RemoveReviewWorktree                     string
+	NoReviewWorktree                         string
 	LcWorktree                               string
 	ChangingDirectoryTo                      string
 	Name                                     string
@@ -870,6 +1336,9 @@ type TranslationSet struct {
 	MarkAsBaseCommitTooltip                  string
 	CancelMarkedBaseCommit                   string
 	MarkedCommitMarker                       string
+	CommitStatusUnpushed                     string
+	CommitStatusPushed                       string
+	CommitStatusMerged                       string
 	FailedToOpenURL                          string
 	InvalidLazygitEditURL                    string
 	NoCopiedCommits                          string
@@ -939,6 +1408,7 @@ type Actions struct {
 	DeleteLocalBranch                string
 	Merge                            string
 	SquashMerge                      string
+	OctopusMerge                     string
 	RebaseBranch                     string
 	RenameBranch                     string
 	CreateBranch                     string
@@ -946,6 +1416,7 @@ type Actions struct {
 	AutoForwardBranches              string
 	CherryPick                       string
 	CheckoutFile                     string
+	ApplyStashFiles                  string
 	SquashCommitDown                 string
 	FixupCommit                      string
 	RewordCommit                     string
@@ -953,39 +1424,58 @@ type Actions struct {
 	EditCommit                       string
 	AmendCommit                      string
 	ResetCommitAuthor                string
+	ResetCommitAuthorDate            string
 	SetCommitAuthor                  string
+	BulkSetCommitAuthor              string
 	AddCommitCoAuthor                string
 	RevertCommit                     string
 	CreateFixupCommit                string
 	SquashAllAboveFixupCommits       string
 	MoveCommitUp                     string
 	MoveCommitDown                   string
+	StartInteractiveRebase           string
 	CopyCommitMessageToClipboard     string
 	CopyCommitMessageBodyToClipboard string
 	CopyCommitSubjectToClipboard     string
 	CopyCommitDiffToClipboard        string
 	CopyCommitHashToClipboard        string
+	CopyMarkedCommitHashes           string
 	CopyCommitURLToClipboard         string
 	CopyCommitAuthorToClipboard      string
+	CopyReleaseNotesToClipboard      string
+	CopyActivitySummaryToClipboard   string
+	CreateRelease                    string
+	OpenNewReleasePage               string
 	CopyCommitAttributeToClipboard   string
 	CopyCommitTagsToClipboard        string
 	CopyPatchToClipboard             string
 	CustomCommand                    string
+	RerunCommand                     string
 	DiscardAllChangesInFile          string
 	DiscardAllUnstagedChangesInFile  string
 	StageFile                        string
+	IntentToAdd                      string
+	ToggleExecutable                 string
 	StageResolvedFiles               string
 	UnstageFile                      string
 	UnstageAllFiles                  string
 	StageAllFiles                    string
 	ResolveConflictByKeepingFile     string
+	ResolveAllConflictsWithOurs      string
+	ResolveAllConflictsWithTheirs    string
+	ToggleBreak                      string
+	ToggleRerere                     string
+	ForgetRerereResolution           string
 	ResolveConflictByDeletingFile    string
 	NotEnoughContextToStage          string
 	NotEnoughContextToDiscard        string
+	NotEnoughContextToStash          string
 	NotEnoughContextForCustomPatch   string
 	IgnoreExcludeFile                string
 	IgnoreFileErr                    string
 	ExcludeFile                      string
+	ToggleSkipWorktree               string
+	ToggleAssumeUnchanged            string
 	ExcludeGitIgnoreErr              string
 	Commit                           string
 	Push                             string
@@ -994,6 +1484,7 @@ type Actions struct {
 	StashAllChanges                  string
 	StashAllChangesKeepIndex         string
 	StashStagedChanges               string
+	StashSelectedLines               string
 	StashUnstagedChanges             string
 	StashIncludeUntrackedChanges     string
 	GitFlowFinish                    string
@@ -1012,6 +1503,7 @@ type Actions struct {
 	ApplyPatch                       string
 	Stash                            string
 	RenameStash                      string
+	ApplyStashToBranch               string
 	RemoveSubmodule                  string
 	ResetSubmodule                   string
 	AddSubmodule                     string
@@ -1021,13 +1513,21 @@ type Actions struct {
 	BulkUpdateSubmodules             string
 	BulkDeinitialiseSubmodules       string
 	BulkUpdateRecursiveSubmodules    string
+	BulkUpdateRemoteSubmodules       string
+	RunCommandInAllSubmodules        string
 	UpdateSubmodule                  string
+	UpdateSubmoduleToRemote          string
+	SetSubmoduleBranch               string
 	CreateLightweightTag             string
 	CreateAnnotatedTag               string
+	EditTagMessage                   string
 	DeleteLocalTag                   string
 	DeleteRemoteTag                  string
 	PushTag                          string
+	PushTags                         string
 	NukeWorkingTree                  string
+	RestoreSnapshot                  string
+	UndoDiscard                      string
 	DiscardUnstagedFileChanges       string
 	RemoveUntrackedFiles             string
 	RemoveStagedFiles                string
@@ -1040,11 +1540,25 @@ type Actions struct {
 	OpenMergeTool                    string
 	OpenCommitInBrowser              string
 	OpenPullRequest                  string
+	CreatePullRequestViaAPI          string
+	OpenIssue                        string
+	StartWorkOnIssue                 string
 	StartBisect                      string
 	ResetBisect                      string
 	BisectSkip                       string
 	BisectMark                       string
 	AddWorktree                      string
+	OpenWorktreeInNewWindow          string
+	OpenInTmux                       string
+	AddReviewWorktree                string
+	PruneDanglingObjects             string
+	DeleteBrokenRefs                 string
+	UpgradeIndexVersion              string
+	RemoveStaleLockFiles             string
+	RunGc                            string
+	ChangeSetting                    string
+	SwitchProfile                    string
+	SwitchTheme                      string
 }
 
 const englishIntroPopupMessage = `
@@ -1106,6 +1620,8 @@ func EnglishTranslationSet() *TranslationSet {
 		CredentialsPassphrase:                "Enter passphrase for SSH key",
 		CredentialsPIN:                       "Enter PIN for SSH key",
 		CredentialsToken:                     "Enter Token for SSH key",
+		CredentialsOneTimePassword:           "One-time password",
+		CredentialsConfirmation:              "SSH confirmation",
 		PassUnameWrong:                       "Password, passphrase and/or username wrong",
 		Commit:                               "Commit",
 		CommitTooltip:                        "Commit staged changes.",
@@ -1133,6 +1649,9 @@ func EnglishTranslationSet() *TranslationSet {
 		OpenDiffTool:                         "Open external diff tool (git difftool)",
 		OpenMergeTool:                        "Open external merge tool",
 		OpenMergeToolTooltip:                 "Run `git mergetool`.",
+		OpenMergeToolForFile:                 "Open merge tool for file",
+		OpenMergeToolForFileTooltip:          "Open the selected conflicted file in the merge tool (git.mergeTool, or git's merge.tool setting if not set). The file is staged if the tool exits successfully.",
+		FileHasNoConflicts:                   "The selected file has no merge conflicts",
 		Refresh:                              "Refresh",
 		RefreshTooltip:                       "Refresh the git state (i.e. run `git status`, `git branch`, etc in background to update the contents of panels). This does not run `git fetch`.",
 		Push:                                 "Push",
@@ -1149,6 +1668,27 @@ func EnglishTranslationSet() *TranslationSet {
 		MergeConflictCurrentDiff:             "Current changes:",
 		MergeConflictPressEnterToResolve:     "Press %s to resolve.",
 		MergeConflictKeepFile:                "Keep file",
+		ResolveAllConflicts:                  "Resolve all conflicts",
+		ResolveAllConflictsTooltip:           "Resolve every conflicted file at once by taking either our or their version of it, and stage the result. Useful when you know that one side should win for all files.",
+		ViewRerereOptions:                    "View rerere options",
+		ViewRerereOptionsTooltip:             "View options for rerere (reuse recorded resolution), which makes git remember how you resolved a conflict and resolve it the same way automatically the next time it comes up. Lets you enable rerere and forget resolutions that turned out to be wrong.",
+		RerereTitle:                          "Rerere (reuse recorded resolution)",
+		EnableRerere:                         "Enable rerere",
+		EnableRerereTooltip:                  "Toggle the rerere.enabled setting of this repository. When enabled, git records how you resolve conflicts and replays those resolutions when the same conflicts come up again.",
+		ResolvedByRerereSection:              "Resolved by rerere in this merge",
+		RecordedResolutionsSection:           "Recorded resolutions",
+		NoRecordedResolutions:                "No recorded resolutions",
+		ForgetRerereResolution:               "Forget resolution",
+		ForgetRerereResolutionTooltip:        "Forget the recorded resolution for this file and restore the conflict so that you can resolve it again.",
+		ForgetRerereResolutionPrompt:         "Are you sure you want to forget the recorded resolution for {{.path}}? This restores the conflict markers in the file, discarding the automatic resolution.",
+		DeleteRecordedResolutionTooltip:      "Delete this resolution from the rr-cache so that it is no longer applied.",
+		DeleteRecordedResolutionPrompt:       "Are you sure you want to delete recorded resolution {{.id}}?",
+		ResolvedByRerereNote:                 "The conflicts in this file were resolved automatically by rerere, using a resolution you recorded earlier. Review the result before continuing.",
+		TakeOursForAllConflicts:              "Take ours for all conflicted files",
+		TakeTheirsForAllConflicts:            "Take theirs for all conflicted files",
+		TakeOursForAllConflictsPrompt:        "The following files will be resolved by taking our version, discarding their changes:\n\n{{.files}}\n\nAre you sure?",
+		TakeTheirsForAllConflictsPrompt:      "The following files will be resolved by taking their version, discarding our changes:\n\n{{.files}}\n\nAre you sure?",
+		NoConflictedFiles:                    "There are no conflicted files",
 		MergeConflictDeleteFile:              "Delete file",
 		Checkout:                             "Checkout",
 		CheckoutTooltip:                      "Checkout selected item.",
@@ -1189,6 +1729,7 @@ func EnglishTranslationSet() *TranslationSet {
 		BranchName:                           "Branch name",
 		NewBranchNameBranchOff:               "New branch name (branch is off of '{{.branchName}}')",
 		CantDeleteCheckOutBranch:             "You cannot delete the checked out branch!",
+		CantDeleteProtectedBranch:            "'%s' is a protected branch and can't be deleted. See the git.protectedBranches config.",
 		DeleteBranchTitle:                    "Delete branch '{{.selectedBranchName}}'?",
 		DeleteBranchesTitle:                  "Delete selected branches?",
 		DeleteLocalBranch:                    "Delete local branch",
@@ -1204,6 +1745,9 @@ func EnglishTranslationSet() *TranslationSet {
 		RebaseBranchTooltip:                  "Rebase the checked-out branch onto the selected branch.",
 		CantRebaseOntoSelf:                   "You cannot rebase a branch onto itself",
 		CantMergeBranchIntoItself:            "You cannot merge a branch into itself",
+		OctopusMerge:                         "Octopus merge",
+		OctopusMergePrompt:                   "Merge the following branches into '{{.checkedOutBranch}}' in a single merge commit?\n\n{{.branches}}",
+		OctopusMergeFailed:                   "Git refused the octopus merge because the selected branches conflict with each other. Nothing was changed; merge the branches one at a time to resolve the conflicts.",
 		ForceCheckout:                        "Force checkout",
 		ForceCheckoutTooltip:                 "Force checkout selected branch. This will discard all local changes in your working directory before checking out the selected branch.",
 		CheckoutByName:                       "Checkout by name",
@@ -1237,6 +1781,10 @@ func EnglishTranslationSet() *TranslationSet {
 		NoCommitsThisBranch:                  "No commits for this branch",
 		UpdateRefHere:                        "Update branch '{{.ref}}' here",
 		ExecCommandHere:                      "Execute the following command here:",
+		BreakHere:                            "The rebase will pause here, after the commits below it have been applied, so that you can test them or make changes. Continue the rebase when you are done.",
+		ToggleBreak:                          "Insert/remove break",
+		ToggleBreakTooltip:                   "Insert a break after the selected todo, so that the interactive rebase pauses there and you can test the commits up to that point. If there already is a break after it, remove it. Select a break and press this key to remove it.",
+		BreakIsOnlyAllowedDuringRebase:       "Breaks can only be inserted during an interactive rebase",
 		CannotSquashOrFixupFirstCommit:       "There's no commit below to squash into",
 		CannotSquashOrFixupMergeCommit:       "Cannot squash or fixup a merge commit",
 		Fixup:                                "Fixup",
@@ -1255,6 +1803,7 @@ func EnglishTranslationSet() *TranslationSet {
 		MoveDownCommit:                       "Move commit down one",
 		MoveUpCommit:                         "Move commit up one",
 		CannotMoveAnyFurther:                 "Cannot move any further",
+		CannotDragCommitsOutsideBranch:       "Only commits of the current branch can be dragged outside of a rebase. Start an interactive rebase from an earlier commit to move the others.",
 		CannotMoveMergeCommit:                "Cannot move a merge commit",
 		EditCommit:                           "Edit (start interactive rebase)",
 		EditCommitTooltip:                    "Edit the selected commit. Use this to start an interactive rebase from the selected commit. When already mid-rebase, this will mark the selected commit for editing, which means that upon continuing the rebase, the rebase will pause at the selected commit to allow you to make changes.",
@@ -1265,11 +1814,25 @@ func EnglishTranslationSet() *TranslationSet {
 		SetAuthor:                            "Set author",
 		SetAuthorTooltip:                     "Set the author based on a prompt",
 		AddCoAuthor:                          "Add co-author",
+		ResetAuthorDate:                      "Renew author date",
+		ResetAuthorDateTooltip:               "Set the commit's author date to the current time, keeping the author itself.",
+		SelectAuthor:                         "Select author",
+		SelectAuthorTooltip:                  "Set the author to one of the authors of the loaded commits, or enter a new one.",
+		EnterNewAuthor:                       "Enter new author...",
+		BulkSetAuthor:                        "Bulk rewrite author",
+		BulkSetAuthorTooltip:                 "Set the author of all selected commits in a single rebase that runs `git commit --amend --no-edit --author` after each of them, without stopping in between. This rewrites the history of the branch.",
+		BulkSetAuthorTitle:                   "Rewrite history",
+		BulkSetAuthorPrompt:                  "WARNING: this will rewrite the author of {{count}} commit(s) to '{{author}}'. The selected commits and all commits above them will get new hashes, and the original authorship is lost unless you undo the rebase.\n\nAre you sure you want to continue?",
+		BulkSetAuthorPushedWarning:           "Some of these commits have already been pushed. Rewriting them means you'll have to force-push, which is disruptive to anyone who has based work on them.",
 		AmendCommitAttribute:                 "Amend commit attribute",
 		AmendCommitAttributeTooltip:          "Set/Reset commit author or set co-author.",
 		SetAuthorPromptTitle:                 "Set author (must look like 'Name <Email>')",
 		AddCoAuthorPromptTitle:               "Add co-author (must look like 'Name <Email>')",
 		AddCoAuthorTooltip:                   "Add co-author using the Github/Gitlab metadata Co-authored-by.",
+		InsertIssueReference:                 "Insert issue reference",
+		InsertIssueReferencePromptTitle:      "Issue",
+		FetchingIssuesStatus:                 "Fetching issues",
+		NoOpenIssues:                         "There are no open issues",
 		RewordCommitEditor:                   "Reword with editor",
 		Error:                                "Error",
 		PickHunk:                             "Pick hunk",
@@ -1301,6 +1864,12 @@ func EnglishTranslationSet() *TranslationSet {
 		StashChanges:                         "Stash changes",
 		RenameStash:                          "Rename stash",
 		RenameStashPrompt:                    "Rename stash: {{.stashName}}",
+		ApplyStashToBranch:                   "Apply to branch",
+		ApplyStashToBranchTooltip:            "Check out another branch and apply the stash entry there. If the branch is checked out in another worktree, the stash entry is applied in that worktree instead.",
+		ApplyStashToBranchPrompt:             "Apply {{.stashName}} to branch:",
+		StashBranchNotFound:                  "Could not find a local branch named '{{.branch}}'",
+		CannotApplyStashToBranchWithChanges:  "You have uncommitted changes. Commit or stash them before applying a stash entry to another branch.",
+		StashAppliedInWorktree:               "Applied stash entry in worktree '{{.worktree}}'",
 		OpenConfig:                           "Open config file",
 		EditConfig:                           "Edit config file",
 		ForcePush:                            "Force push",
@@ -1308,8 +1877,70 @@ func EnglishTranslationSet() *TranslationSet {
 		ForcePushDisabled:                    "Your branch has diverged from the remote branch and you've disabled force pushing",
 		UpdatesRejected:                      "Updates were rejected. Please fetch and examine the remote changes before pushing again.",
 		UpdatesRejectedAndForcePushDisabled:  "Updates were rejected and you have disabled force pushing",
+		CantForcePushProtectedBranch:         "'%s' is a protected branch and can't be force pushed. See the git.protectedBranches config.",
 		CheckForUpdate:                       "Check for update",
 		CheckingForUpdates:                   "Checking for updates...",
+		HealthChecks:                         "Run repository health checks",
+		HealthChecksTooltip:                  "Run a set of diagnostics on the repository in the background: dangling objects, broken refs, index size, stale lock files, git fsck, detached submodule HEADs and whether garbage collection is due. Select a check to fix what it found.",
+		HealthChecksTitle:                    "Repository health",
+		RunningHealthChecks:                  "Running health checks",
+		ActivitySummary:                      "Summarize my recent activity",
+		ActivitySummaryTooltip:               "List the commits you authored on any local branch, and the other things you did such as checkouts, rebases and resets, over the last hours (24 by default, see git.activitySummaryHours). Handy for standups.",
+		ActivitySummaryTitle:                 "My activity in the last %d hours",
+		ShowActivitySummary:                  "Show in main view",
+		CopyActivitySummary:                  "Copy to clipboard",
+		LoadingActivitySummaryStatus:         "Loading activity",
+		NoRecentActivity:                     "No commits or reflog entries in the last %d hours",
+		ActivitySummaryCopiedToClipboard:     "Activity summary copied to clipboard",
+		Settings:                             "Edit settings",
+		SettingsTooltip:                      "Browse the config options by section, with their current and default values. Booleans are toggled on <enter>, other simple values can be picked or typed in, and are checked against the config schema. Changes are written to your global config file.",
+		SettingsTitle:                        "Settings",
+		SettingDefaultValue:                  "(default: %s)",
+		SettingOnlyInConfigFile:              "Lists and maps can only be changed in the config file.",
+		SettingSaved:                         "Saved '%s'",
+		SwitchProfile:                        "Switch config profile",
+		SwitchProfileTooltip:                 "Switch to one of the profiles defined under 'profiles' in your config, e.g. for pairing or demos. The options of the active profile override the rest of the config. The profile stays active until you switch again, also across restarts.",
+		SwitchProfileTitle:                   "Config profile",
+		NoProfile:                            "(none)",
+		NoProfilesDefined:                    "No profiles are defined in your config. Add them under 'profiles'.",
+		SwitchedToProfile:                    "Switched to profile '%s'",
+		SwitchedToNoProfile:                  "Switched to no profile",
+		SwitchTheme:                          "Switch theme",
+		SwitchThemeTooltip:                   "Switch to one of the built-in theme presets, or to a theme file from the 'themes' directory next to your config file. The selected theme is previewed right away. The theme stays active until you switch again, also across restarts.",
+		SwitchThemeTitle:                     "Theme",
+		SwitchedToTheme:                      "Switched to theme '%s'",
+		ThemePresetPinned:                    "Your config sets `gui.theme.preset` to '%s', which takes precedence over the presets in this menu. Remove it from your config to switch presets here.",
+		HealthCheckOK:                        "OK",
+		HealthCheckWarning:                   "Warning",
+		HealthCheckError:                     "Error",
+		HealthCheckDanglingObjects:           "Dangling objects",
+		HealthCheckBrokenRefs:                "Broken refs",
+		HealthCheckIndexSize:                 "Index size",
+		HealthCheckLockFiles:                 "Stale lock files",
+		HealthCheckFsck:                      "Integrity (git fsck)",
+		HealthCheckDetachedSubmodules:        "Detached submodule HEADs",
+		HealthCheckGc:                        "Garbage collection",
+		HealthCheckNone:                      "None",
+		HealthCheckPassedTooltip:             "Nothing to do.",
+		DanglingObjectsCount:                 "{{.count}} dangling objects",
+		FsckProblemsCount:                    "{{.count}} problems found",
+		FsckNoProblems:                       "No problems found",
+		GcNeededDetail:                       "{{.looseObjects}} loose objects, {{.packs}} packs",
+		PruneDanglingObjects:                 "Prune dangling objects",
+		PruneDanglingObjectsTooltip:          "Run 'git gc --prune=now' to delete objects that nothing refers to any more. These are typically left behind by rebases, amends and dropped stashes.",
+		PruneDanglingObjectsPrompt:           "Are you sure you want to permanently delete {{.count}} dangling objects? Commits among them can't be recovered afterwards.",
+		DeleteBrokenRefs:                     "Delete broken refs",
+		DeleteBrokenRefsTooltip:              "Delete the broken refs with 'git update-ref -d'. A ref is broken if it points to an object that doesn't exist, e.g. after a crash; git prints a warning about it on many commands.",
+		DeleteBrokenRefsPrompt:               "Are you sure you want to delete the following refs?\n\n{{.refs}}",
+		UpgradeIndexVersionTooltip:           "Switch the index to version 4 with 'git update-index --index-version 4'. Version 4 compresses path names, which makes the index considerably smaller in repos with many files.",
+		RemoveStaleLockFiles:                 "Remove stale lock files",
+		RemoveStaleLockFilesTooltip:          "Remove lock files left behind by git processes that crashed or were killed. As long as they exist, git refuses to touch the files they lock.",
+		RemoveStaleLockFilesPrompt:           "Make sure that no other git process is running in this repo. Are you sure you want to remove the following lock files?\n\n{{.files}}",
+		FsckProblems:                         "Problems reported by git fsck",
+		ShowFsckProblemsTooltip:              "Show the problems that 'git fsck' reported. These can't be fixed automatically; fetching the missing objects from another clone of the repo is usually the way out.",
+		DetachedSubmodulesTooltip:            "Go to the submodules view. These submodules have a detached HEAD at a commit other than the one recorded in the repo, so commits made there are easily lost unless you check out a branch first.",
+		RunGcTooltip:                         "Run 'git gc' to pack loose objects and consolidate packs, which makes the repo smaller and faster.",
+		RunningGc:                            "Running git gc",
 		UpdateAvailableTitle:                 "Update available!",
 		UpdateAvailable:                      "Download and install version {{.newVersion}}?",
 		UpdateInProgressWaitingStatus:        "Updating",
@@ -1333,17 +1964,36 @@ func EnglishTranslationSet() *TranslationSet {
 		OpenFile:                             `Open file`,
 		OpenFileTooltip:                      "Open file in default application.",
 		OpenInEditor:                         "Open in editor",
+		OpenInTmux:                           "Open in tmux",
+		OpenInTmuxTooltip:                    "Open the directory in a new tmux window running lazygit, or using the command configured in `os.openInTmux`.",
+		OpenRecentRepoInTmux:                 "Open a recent repo in tmux",
+		NotInTmux:                            "Not running inside tmux. Set `os.openInTmux` in your config to open directories in tmux anyway.",
 		IgnoreFile:                           `Add to .gitignore`,
 		ExcludeFile:                          `Add to .git/info/exclude`,
+		ToggleSkipWorktree:                   "Toggle skip-worktree",
+		ToggleSkipWorktreeTooltip:            "Set or clear the skip-worktree bit of the file. While it is set, git ignores changes to the file in the working tree, e.g. to keep local tweaks to a tracked config file.",
+		ToggleAssumeUnchanged:                "Toggle assume-unchanged",
+		ToggleAssumeUnchangedTooltip:         "Set or clear the assume-unchanged bit of the file. While it is set, git doesn't check the file for changes, which is meant to speed up git status in big repos.",
+		IndexFlagsOnlyForTrackedFiles:        "Only tracked files can have the skip-worktree or assume-unchanged bit set.",
+		IndexFlagsNeedHiddenChangesEnabled:   "Enable `gui.showFilesWithHiddenChanges` in your config to toggle the skip-worktree and assume-unchanged bits.",
+		SkipWorktreeInSparseCheckout:         "In a sparse checkout the skip-worktree bit is managed by `git sparse-checkout`.",
+		HideFileChangesTitle:                 "Hide changes to file",
+		HideFileChangesPrompt:                "With the {{.flag}} bit set, changes to '{{.path}}' are hidden from the normal status output, so they won't be staged or committed. Are you sure?",
+		FileHiddenFromStatusWarning:          "This file has the {{.flags}} bit set, so its changes are hidden from the normal status output and won't be staged or committed. Use '{{.key}}' to clear the bit again.",
 		RefreshFiles:                         `Refresh files`,
 		FocusMainView:                        "Focus main view",
 		Merge:                                `Merge`,
 		RegularMerge:                         "Regular merge",
-		MergeBranchTooltip:                   "View options for merging the selected item into the current branch (regular merge, squash merge)",
+		MergeBranchTooltip:                   "View options for merging the selected item into the current branch (regular merge, squash merge). If several branches are selected, they are all merged in a single octopus merge.",
 		ConfirmQuit:                          `Are you sure you want to quit?`,
 		SwitchRepo:                           `Switch to a recent repo`,
 		AllBranchesLogGraph:                  `Show/cycle all branch logs`,
 		UnsupportedGitService:                `Unsupported git service`,
+		UnknownGitServiceProvider:            "Unknown git service provider '%s' in remoteServices config. Expected one of %s",
+		ReleasesNotSupported:                 "Creating releases is not supported for this git service",
+		PullRequestAPINotSupported:           "Managing pull requests through the API is not supported for this git service",
+		PullRequestsFromForksNotSupported:    "Creating pull requests from a fork is not supported for this git service",
+		IssueAPINotSupported:                 "Listing issues through the API is not supported for this git service",
 		CreatePullRequest:                    `Create pull request`,
 		CopyPullRequestURL:                   `Copy pull request URL to clipboard`,
 		NoBranchOnRemote:                     `This branch doesn't exist on remote. You need to push it to remote first.`,
@@ -1353,6 +2003,23 @@ func EnglishTranslationSet() *TranslationSet {
 		CollapseAllTooltip:                   "Collapse all directories in the files tree",
 		ExpandAll:                            "Expand all files",
 		ExpandAllTooltip:                     "Expand all directories in the file tree",
+		IntentToAdd:                          "Mark as intent-to-add",
+		IntentToAddTooltip:                   "Record untracked files in the index without their content (git add -N), so that their content shows up in the unstaged diff and can be staged line by line.",
+		OnlyUntrackedFilesCanBeIntentToAdd:   "Only untracked files can be marked as intent-to-add.",
+		ToggleExecutable:                     "Toggle executable bit",
+		ToggleExecutableTooltip:              "Make the selected file executable (chmod +x), or non-executable if it already is, and stage the mode change.",
+		ToggleExecutableOnlyForFiles:         "The executable bit can only be toggled for files, not directories.",
+		CannotToggleExecutableForMode:        "The executable bit can only be toggled for regular files, but the file has mode {{.mode}}.",
+		SortFilesByName:                      "Name",
+		SortFilesByStatus:                    "Status",
+		SortFilesByStatusHint:                "Conflicts, then unstaged, staged and untracked",
+		SortFilesByMtime:                     "Modification time",
+		SortFilesByMtimeHint:                 "Most recently modified first",
+		SortFilesByDiffSize:                  "Diff size",
+		SortFilesByDiffSizeHint:              "Largest diff first",
+		SortOrderPromptFiles:                 "The chosen sort order is remembered across sessions.",
+		FileIsNowExecutable:                  "'{{.path}}' is now executable",
+		FileIsNoLongerExecutable:             "'{{.path}}' is no longer executable",
 		DisabledInFlatView:                   "Not available in flat view",
 		FileEnter:                            `Stage lines / Collapse directory`,
 		FileEnterTooltip:                     "If the selected item is a file, focus the staging view so you can stage individual hunks/lines. If the selected item is a directory, collapse/expand it.",
@@ -1408,14 +2075,26 @@ func EnglishTranslationSet() *TranslationSet {
 		InformationTitle:                     "Information",
 		SecondaryTitle:                       "Secondary",
 		ReflogCommitsTitle:                   "Reflog",
+		ViewReflogOfRef:                      "View reflog of ref",
+		ViewReflogOfRefTooltip:               "Pick whose reflog to show in this view: HEAD, a local or remote branch, or the stash. You can reset to or check out entries of any reflog.",
+		ReflogDiffTarget:                     "Compare reflog entries with",
+		ReflogDiffTargetTooltip:              "Choose what the main view shows for the selected reflog entry: its own changes, or what would change if you went back to it, compared to HEAD or to the working tree. The choice stays in effect as you move through the reflog.",
+		ReflogDiffAgainstParent:              "Parent commit (show the entry's own changes)",
+		ReflogDiffAgainstHead:                "HEAD",
+		ReflogDiffAgainstWorkingTree:         "Working tree",
+		ShowingReflogDiffAgainstHead:         "Changes from HEAD to {{ref}}",
+		ShowingReflogDiffAgainstWorkingTree:  "Changes from the working tree to {{ref}}",
 		GlobalTitle:                          "Global keybindings",
 		ConflictsResolved:                    "All merge conflicts resolved. Continue the %s?",
 		Continue:                             "Continue",
 		UnstagedFilesAfterConflictsResolved:  "Files have been modified since conflicts were resolved. Auto-stage them and continue?",
+		AutoContinueAfterConflicts:           "Continue automatically when all conflicts are resolved",
+		AutoContinueAfterConflictsTooltip:    "Continue the merge or rebase right away when the last conflicted file has been resolved, instead of asking first. This only applies to the current session; set git.autoContinueAfterResolvingConflicts in your config to make it permanent.",
 		Keybindings:                          "Keybindings",
 		KeybindingsMenuSectionLocal:          "Local",
 		KeybindingsMenuSectionGlobal:         "Global",
 		KeybindingsMenuSectionNavigation:     "Navigation",
+		ContextMenuTitle:                     "Actions",
 		RebasingTitle:                        "Rebase '{{.checkedOutBranch}}'",
 		RebasingFromBaseCommitTitle:          "Rebase '{{.checkedOutBranch}}' from marked base",
 		SimpleRebase:                         "Simple rebase onto '{{.ref}}'",
@@ -1426,6 +2105,29 @@ func EnglishTranslationSet() *TranslationSet {
 		MustSelectTodoCommits:                "When rebasing, this action only works on a selection of TODO commits.",
 		SquashMergeUncommitted:               "Squash merge '{{.selectedBranch}}' into the working tree.",
 		SquashMergeCommitted:                 "Squash merge '{{.selectedBranch}}' into '{{.checkedOutBranch}}' as a single commit.",
+		PreviewMerge:                         "Preview merge (dry run)",
+		MergeOptionsSection:                  "Options",
+		MergeNoFastForward:                   "Always create a merge commit (--no-ff)",
+		MergeNoFastForwardTooltip:            "Create a merge commit even if the merge could be resolved as a fast-forward.",
+		MergeFastForwardOnly:                 "Only fast-forward (--ff-only)",
+		MergeFastForwardOnlyTooltip:          "Refuse to merge unless the checked out branch can simply be fast-forwarded.",
+		MergeStrategyOption:                  "Strategy option: {{.option}}",
+		MergeStrategyOptionDefault:           "default",
+		MergeStrategyOptionTitle:             "Strategy option",
+		MergeStrategyOptionTooltip:           "Pass an option to the merge strategy (git merge -X). For example, 'ours' or 'theirs' resolve conflicting hunks in favour of the checked out or the merged branch, respectively.",
+		MergeEditMessage:                     "Edit merge commit message",
+		MergeEditMessageTooltip:              "Ask for the message of the merge commit before merging. Only has an effect if a merge commit is created.",
+		MergeCommitMessageTitle:              "Merge commit message",
+		RebaseOptionsSection:                 "Options",
+		RebaseResetAuthorDate:                "Reset author dates (--reset-author-date)",
+		RebaseResetAuthorDateTooltip:         "Set the author date of the rebased commits to the current time, instead of keeping their original author dates.",
+		RebaseKeepDates:                      "Keep original dates (--committer-date-is-author-date)",
+		RebaseKeepDatesTooltip:               "Use the author date of each rebased commit as its committer date, instead of setting the committer date to the current time.",
+		PreviewMergeTooltip:                  "Compute what merging '{{.selectedBranch}}' into '{{.checkedOutBranch}}' would do, without touching your working tree, and show the files that would conflict and the combined diff in the main view.",
+		MergePreviewTitle:                    "Merge preview",
+		MergePreviewClean:                    "Merging '{{.selectedBranch}}' into '{{.checkedOutBranch}}' would succeed without conflicts.",
+		MergePreviewConflicts:                "Merging '{{.selectedBranch}}' into '{{.checkedOutBranch}}' would result in conflicts in these files:",
+		MergePreviewRequiresNewerGit:         "Previewing a merge requires git 2.38 or later",
 		RegularMergeTooltip:                  "Merge '{{.selectedBranch}}' into '{{.checkedOutBranch}}'.",
 		FwdNoUpstream:                        "Cannot fast-forward a branch with no upstream",
 		FwdNoLocalUpstream:                   "Cannot fast-forward a branch whose remote is not registered locally",
@@ -1437,6 +2139,7 @@ func EnglishTranslationSet() *TranslationSet {
 		PendingCherryPicksSectionHeader:      "Pending cherry-picks",
 		PendingRevertsSectionHeader:          "Pending reverts",
 		CommitsSectionHeader:                 "Commits",
+		RebasePausedAtBreakSectionHeader:     "Rebase paused here at a break (press {{.key}} to continue)",
 		YouDied:                              "YOU DIED!",
 		RewordNotSupported:                   "Rewording commits while interactively rebasing is not currently supported",
 		ChangingThisActionIsNotAllowed:       "Changing this kind of rebase todo entry is not allowed",
@@ -1449,6 +2152,10 @@ func EnglishTranslationSet() *TranslationSet {
 		SureCherryPick:                       "Are you sure you want to cherry-pick the {{.numCommits}} copied commit(s) onto this branch?",
 		CherryPick:                           "Cherry-pick",
 		CannotCherryPickNonCommit:            "Cannot cherry-pick this kind of todo item",
+		CannotMarkNonCommit:                  "Cannot mark this kind of todo item",
+		ToggleCommitMarked:                   "Toggle commit marked",
+		ToggleCommitMarkedTooltip:            "Mark or unmark the selected commit(s), to build up a set of commits that need not be adjacent. While there are marked commits, cherry-pick copy and drop act on the marked commits instead of the selection, in the order in which they appear in the list.",
+		MarkedCommitHashes:                   "Hashes of marked commits",
 		Donate:                               "Donate",
 		AskQuestion:                          "Ask Question",
 		PrevHunk:                             "Go to previous hunk",
@@ -1470,6 +2177,7 @@ func EnglishTranslationSet() *TranslationSet {
 		AmendCommitWithConflictsAmend:        "Yes, amend previous commit",
 		DropCommitTitle:                      "Drop commit",
 		DropCommitPrompt:                     "Are you sure you want to drop the selected commit(s)?",
+		DropMarkedCommitsPrompt:              "Are you sure you want to drop the {{.count}} marked commit(s)?",
 		DropMergeCommitPrompt:                "Are you sure you want to drop the selected merge commit? Note that it will also drop all the commits that were merged in by it.",
 		DropUpdateRefPrompt:                  "Are you sure you want to delete the selected update-ref todo(s)? This is irreversible except by aborting the rebase.",
 		PullingStatus:                        "Pulling",
@@ -1481,7 +2189,10 @@ func EnglishTranslationSet() *TranslationSet {
 		DroppingStatus:                       "Dropping",
 		MovingStatus:                         "Moving",
 		RebasingStatus:                       "Rebasing",
+		OperationFinishedNotification:        "{{.operation}} finished in {{.repo}}",
+		OperationFailedNotification:          "{{.operation}} failed in {{.repo}}",
 		MergingStatus:                        "Merging",
+		PreviewingMergeStatus:                "Previewing merge",
 		LowercaseRebasingStatus:              "rebasing",       // lowercase because it shows up in parentheses
 		LowercaseMergingStatus:               "merging",        // lowercase because it shows up in parentheses
 		LowercaseCherryPickingStatus:         "cherry-picking", // lowercase because it shows up in parentheses
@@ -1504,6 +2215,9 @@ func EnglishTranslationSet() *TranslationSet {
 		CommitFilesTitle:                     "Commit files",
 		CheckoutCommitFileTooltip:            "Checkout file. This replaces the file in your working tree with the version from the selected commit.",
 		CanOnlyDiscardFromLocalCommits:       "Changes can only be discarded from local commits",
+		ApplyFilesFromStash:                  "Apply to working tree",
+		ApplyFilesFromStashTooltip:           "Apply the changes that the stash entry made to the selected files to your working tree, leaving the stash entry itself untouched. This also applies untracked files that were stashed along with it.",
+		CanOnlyApplyFilesFromStash:           "Only files of a stash entry can be applied",
 		Remove:                               "Remove",
 		DiscardOldFileChangeTooltip:          "Discard this commit's changes to this file. This runs an interactive rebase in the background, so you may get a merge conflict if a later commit also changes this file.",
 		DiscardFileChangesTitle:              "Discard file changes",
@@ -1514,6 +2228,9 @@ func EnglishTranslationSet() *TranslationSet {
 		InitialBranch:                        "Branch name? (leave empty for git's default): ",
 		NoRecentRepositories:                 "Must open lazygit in a git repository. No valid recent repositories. Exiting.",
 		IncorrectNotARepository:              "The value of 'notARepository' is incorrect. It should be one of 'prompt', 'create', 'skip', or 'quit'.",
+		TrustRepoConfigFileTitle:             "Load repository config",
+		ConfigReloaded:                       "Config reloaded",
+		TrustRepoConfigFilePrompt:            "This repository has a lazygit config file at %s. It can override your settings and add custom commands, which run shell commands on your machine, so only load it if you trust the repository.\n\nDo you want to load it? You'll be asked again when it changes.",
 		AutoStashTitle:                       "Autostash?",
 		AutoStashPrompt:                      "You must stash and pop your changes to bring them across. Do this automatically? (enter/esc)",
 		AutoStashForUndo:                     "Auto-stashing changes for undoing to %s",
@@ -1542,6 +2259,13 @@ func EnglishTranslationSet() *TranslationSet {
 		ResetMixedTooltip:                    "Reset HEAD to the chosen commit, and keep the changes between the current and chosen commit as unstaged changes.",
 		ResetHardTooltip:                     "Reset HEAD to the chosen commit, and discard all changes between the current and chosen commit, as well as all current modifications in the working tree.",
 		ResetHardConfirmation:                "Are you sure you want to do a hard reset? This will discard all uncommitted changes (both staged and unstaged), which is not undoable.",
+		RestoreSnapshot:                      "Restore snapshot",
+		RestoreSnapshotTooltip:               "Restore one of the snapshots that lazygit saves before hard resets, discarding changes, and deleting unmerged branches if git.snapshots.enabled is true. Snapshots are stored under refs/lazygit/snapshots.",
+		RestoreSnapshotPrompt:                "This will reset '{{.branch}}' to {{.commit}}, the commit that was checked out when the snapshot was saved, and put back the working tree as it was then. Staged changes come back as unstaged. A snapshot of the current state is saved first. Continue?",
+		RestoreSnapshotNoCommitsYetPrompt:    "This will put back the working tree as it was when the snapshot was saved, before the first commit. Staged changes come back as unstaged. A snapshot of the current state is saved first. Continue?",
+		RestoreSnapshotOnOtherBranchWarning:  "Warning: the snapshot was saved while '{{.snapshotBranch}}' was checked out, but now '{{.branch}}' is.",
+		SnapshotFailed:                       "Could not save a snapshot, so the operation was not carried out. Snapshots can be turned off with the git.snapshots.enabled config.\n\n%v",
+		UndoDiscardPrompt:                    "Are you sure you want to bring back the changes you discarded in %s? Any changes you've made to these files since will be overwritten.",
 		ViewResetOptions:                     `Reset`,
 		FileResetOptionsTooltip:              "View reset options for working tree (e.g. nuking the working tree).",
 		FixupTooltip:                         "Meld the selected commit into the commit below it. Similar to squash, but the selected commit's message will be discarded.",
@@ -1576,6 +2300,14 @@ func EnglishTranslationSet() *TranslationSet {
 		StashAllChangesKeepIndex:             "Stash all changes and keep index",
 		StashUnstagedChanges:                 "Stash unstaged changes",
 		StashIncludeUntrackedChanges:         "Stash all changes including untracked files",
+		StashAllChangesTooltip:               "Stash all tracked changes, both staged and unstaged.",
+		StashStagedChangesTooltip:            "Stash only the changes that are staged, leaving unstaged changes in your working tree.",
+		StashAllChangesKeepIndexTooltip:      "Stash all tracked changes, but keep the staged changes in the index and working tree as well. Useful for testing exactly what you are about to commit.",
+		StashUnstagedChangesTooltip:          "Stash only the changes that are not staged, leaving staged changes in place.",
+		StashSelection:                       "Stash selection",
+		StashSelectionTooltip:                "Stash the selected lines and remove them from your working tree, leaving the rest of the file untouched. Only available for unstaged changes.",
+		CanOnlyStashUnstagedLines:            "Only unstaged lines can be stashed",
+		StashIncludeUntrackedChangesTooltip:  "Stash all changes, including untracked files.",
 		StashOptions:                         "Stash options",
 		NotARepository:                       "Error: must be run inside a git repository",
 		WorkingDirectoryDoesNotExist:         "Error: the current working directory does not exist",
@@ -1635,6 +2367,12 @@ func EnglishTranslationSet() *TranslationSet {
 		TagNameTitle:                         "Tag name",
 		TagMessageTitle:                      "Tag description",
 		AnnotatedTag:                         "Annotated tag",
+		TagSignature:                         "Signature",
+		EditTagMessage:                       "Edit tag message",
+		EditTagMessageTooltip:                "Edit the message of the selected annotated tag. The tag is deleted and recreated with the new message, pointing at the same commit.",
+		CannotEditLightweightTag:             "Only annotated tags have a message that can be edited",
+		EditTagMessageTitle:                  "Recreate tag",
+		EditTagMessagePrompt:                 "This will delete tag '{{.tagName}}' and recreate it with the new message, pointing at the same commit. If the tag has already been pushed, you will need to push it again with --force. Continue?",
 		LightweightTag:                       "Lightweight tag",
 		DeleteTagTitle:                       "Delete tag '{{.tagName}}'?",
 		DeleteLocalTag:                       "Delete local tag",
@@ -1647,12 +2385,33 @@ func EnglishTranslationSet() *TranslationSet {
 		PushTagTitle:                         "Remote to push tag '{{.tagName}}' to:",
 		// Using 'push tag' rather than just 'push' to disambiguate from a global push
 		PushTag:                        "Push tag",
-		PushTagTooltip:                 "Push the selected tag to a remote. You'll be prompted to select a remote.",
+		PushTagTooltip:                 "Push the selected tag to a remote. You'll be prompted to select a remote.\n\nOnce the remote tags have been listed (after fetching), tags that exist on the remote are marked with ✓, and tags that only exist locally with ↑.",
+		PushUnpushedTags:               "Push all unpushed tags",
+		PushUnpushedTagsTooltip:        "Push all local tags that a remote does not have yet. You'll be prompted to select a remote.\n\nThis lists the remote's tags, so the tags panel's markers are up to date afterwards.",
+		PushUnpushedTagsTitle:          "Remote to push unpushed tags to:",
+		PushUnpushedTagsPrompt:         "Are you sure you want to push the following tags to '{{.remote}}'?\n\n{{.tagNames}}",
+		NoUnpushedTags:                 "'{{.remote}}' already has all local tags",
+		TagsPushedMessage:              "Pushed {{.count}} tag(s) to '{{.remote}}'",
+		ListingRemoteTagsStatus:        "Listing remote tags",
+		RemoteUnpushedTagsCount:        "{{.count}} unpushed",
+		RemoteHasTag:                   "has tag",
+		RemoteLacksTag:                 "missing tag",
+		NoRemotes:                      "This repository has no remotes",
+		CreateRelease:                  "Create release",
+		CreateReleaseTooltip:           "Create a release for the selected tag on the repo's hosting service (GitHub, GitLab, Gitea or Forgejo). The release notes are pre-filled from the commits since the previous tag. If an API token is set in GITHUB_TOKEN/GH_TOKEN, GITLAB_TOKEN or GITEA_TOKEN/FORGEJO_TOKEN, the release is created directly; otherwise the service's new release page is opened in the browser.",
+		ReleaseTitleTitle:              "Release title",
+		CreatingReleaseStatus:          "Creating release",
+		ReleaseCreated:                 "Created release {{.url}}",
 		NewTag:                         "New tag",
 		NewTagTooltip:                  "Create new tag from current commit. You'll be prompted to enter a tag name and optional description.",
 		CreatingTag:                    "Creating tag",
 		ForceTag:                       "Force Tag",
 		ForceTagPrompt:                 "The tag '{{.tagName}}' exists already. Press {{.cancelKey}} to cancel, or {{.confirmKey}} to overwrite.",
+		CreateTagMenuTitle:             "Create tag (latest: {{.latestTag}})",
+		BumpPatchVersion:               "Bump patch version",
+		BumpMinorVersion:               "Bump minor version",
+		BumpMajorVersion:               "Bump major version",
+		CustomTagName:                  "Custom tag name",
 		FetchRemoteTooltip:             "Fetch updates from the remote repository. This retrieves new commits and branches without merging them into your local branches.",
 		CheckoutCommitTooltip:          "Checkout the selected commit as a detached HEAD.",
 		NoBranchesFoundAtCommitTooltip: "No branches found at selected commit.",
@@ -1660,62 +2419,123 @@ func EnglishTranslationSet() *TranslationSet {
 		NotAGitFlowBranch:              "This does not seem to be a git flow branch",
 		NewGitFlowBranchPrompt:         "New {{.branchType}} name:",
 
-		IgnoreTracked:                    "Ignore tracked file",
-		IgnoreTrackedPrompt:              "Are you sure you want to ignore a tracked file?",
-		ExcludeTracked:                   "Exclude tracked file",
-		ExcludeTrackedPrompt:             "Are you sure you want to exclude a tracked file?",
-		ViewResetToUpstreamOptions:       "View upstream reset options",
-		NextScreenMode:                   "Next screen mode (normal/half/fullscreen)",
-		PrevScreenMode:                   "Prev screen mode",
-		StartSearch:                      "Search the current view by text",
-		StartFilter:                      "Filter the current view by text",
-		KeybindingsLegend:                "Legend: `<c-b>` means ctrl+b, `<a-b>` means alt+b, `B` means shift+b",
-		RenameBranch:                     "Rename branch",
-		BranchUpstreamOptionsTitle:       "Upstream options",
-		ViewBranchUpstreamOptions:        "View upstream options",
-		ViewBranchUpstreamOptionsTooltip: "View options relating to the branch's upstream e.g. setting/unsetting the upstream and resetting to the upstream.",
-		UpstreamNotSetError:              "The selected branch has no upstream (or the upstream is not stored locally)",
-		UpstreamsNotSetError:             "Some of the selected branches have no upstream (or the upstream is not stored locally)",
-		Upstream:                         "Upstream",
-		NewBranchNamePrompt:              "Enter new branch name for branch",
-		RenameBranchWarning:              "This branch is tracking a remote. This action will only rename the local branch name, not the name of the remote branch. Continue?",
-		OpenKeybindingsMenu:              "Open keybindings menu",
-		ResetCherryPick:                  "Reset copied (cherry-picked) commits selection",
-		ResetCherryPickShort:             "Reset copied commits",
-		NextTab:                          "Next tab",
-		PrevTab:                          "Previous tab",
-		CantUndoWhileRebasing:            "Can't undo while rebasing",
-		CantRedoWhileRebasing:            "Can't redo while rebasing",
-		MustStashWarning:                 "Pulling a patch out into the index requires stashing and unstashing your changes. If something goes wrong, you'll be able to access your files from the stash. Continue?",
-		MustStashTitle:                   "Must stash",
-		ConfirmationTitle:                "Confirmation panel",
-		PrevPage:                         "Previous page",
-		NextPage:                         "Next page",
-		GotoTop:                          "Scroll to top",
-		GotoBottom:                       "Scroll to bottom",
-		FilteringBy:                      "Filtering by",
-		ResetInParentheses:               "(Reset)",
-		OpenFilteringMenu:                "View filter options",
-		OpenFilteringMenuTooltip:         "View options for filtering the commit log, so that only commits matching the filter are shown.",
-		FilterBy:                         "Filter by",
-		ExitFilterMode:                   "Stop filtering",
-		FilterPathOption:                 "Enter path to filter by",
-		FilterAuthorOption:               "Enter author to filter by",
-		EnterFileName:                    "Enter path:",
-		EnterAuthor:                      "Enter author:",
-		FilteringMenuTitle:               "Filtering",
-		WillCancelExistingFilterTooltip:  "Note: this will cancel the existing filter",
-		MustExitFilterModeTitle:          "Command not available",
-		MustExitFilterModePrompt:         "Command not available in filter-by-path mode. Exit filter-by-path mode?",
-		Diff:                             "Diff",
-		EnterRefToDiff:                   "Enter ref to diff",
-		EnterRefName:                     "Enter ref:",
-		ExitDiffMode:                     "Exit diff mode",
-		DiffingMenuTitle:                 "Diffing",
-		SwapDiff:                         "Reverse diff direction",
-		ViewDiffingOptions:               "View diffing options",
-		ViewDiffingOptionsTooltip:        "View options relating to diffing two refs e.g. diffing against selected ref, entering ref to diff against, and reversing the diff direction.",
-		CancelDiffingMode:                "Cancel diffing mode",
+		IgnoreTracked:                         "Ignore tracked file",
+		IgnoreTrackedPrompt:                   "Are you sure you want to ignore a tracked file?",
+		ExcludeTracked:                        "Exclude tracked file",
+		ExcludeTrackedPrompt:                  "Are you sure you want to exclude a tracked file?",
+		ViewResetToUpstreamOptions:            "View upstream reset options",
+		NextScreenMode:                        "Next screen mode (normal/half/fullscreen)",
+		PrevScreenMode:                        "Prev screen mode",
+		StartSearch:                           "Search the current view by text",
+		StartFilter:                           "Filter the current view by text",
+		KeybindingsLegend:                     "Legend: `<c-b>` means ctrl+b, `<a-b>` means alt+b, `B` means shift+b",
+		RenameBranch:                          "Rename branch",
+		BranchUpstreamOptionsTitle:            "Upstream options",
+		ViewBranchUpstreamOptions:             "View upstream options",
+		ViewBranchUpstreamOptionsTooltip:      "View options relating to the branch's upstream e.g. setting/unsetting the upstream and resetting to the upstream.",
+		UpstreamNotSetError:                   "The selected branch has no upstream (or the upstream is not stored locally)",
+		UpstreamsNotSetError:                  "Some of the selected branches have no upstream (or the upstream is not stored locally)",
+		Upstream:                              "Upstream",
+		NewBranchNamePrompt:                   "Enter new branch name for branch",
+		RenameBranchWarning:                   "This branch is tracking a remote. This action will only rename the local branch name, not the name of the remote branch. Continue?",
+		OpenKeybindingsMenu:                   "Open keybindings menu",
+		ResetCherryPick:                       "Reset copied (cherry-picked) commits selection",
+		ResetCherryPickShort:                  "Reset copied commits",
+		ResetMarkedCommits:                    "Reset marked commits",
+		NextTab:                               "Next tab",
+		PrevTab:                               "Previous tab",
+		CantUndoWhileRebasing:                 "Can't undo while rebasing",
+		CantRedoWhileRebasing:                 "Can't redo while rebasing",
+		MustStashWarning:                      "Pulling a patch out into the index requires stashing and unstashing your changes. If something goes wrong, you'll be able to access your files from the stash. Continue?",
+		MustStashTitle:                        "Must stash",
+		ConfirmationTitle:                     "Confirmation panel",
+		PrevPage:                              "Previous page",
+		NextPage:                              "Next page",
+		GotoTop:                               "Scroll to top",
+		GotoBottom:                            "Scroll to bottom",
+		FilteringBy:                           "Filtering by",
+		ResetInParentheses:                    "(Reset)",
+		OpenFilteringMenu:                     "View filter options",
+		OpenFilteringMenuTooltip:              "View options for filtering the commit log, so that only commits matching the filter are shown.",
+		FilterBy:                              "Filter by",
+		ExitFilterMode:                        "Stop filtering",
+		FilterPathOption:                      "Enter path to filter by",
+		SaveFilterPathForRepo:                 "Always filter this repo by",
+		SaveFilterPathForRepoTooltip:          "Save the path filter so that it is applied automatically whenever this repo is opened. Files, commits, reflog and stash entries are all scoped to the path.",
+		WidenSavedFilterScope:                 "Widen scope to the whole repo",
+		WidenSavedFilterScopeTooltip:          "Temporarily stop filtering by the saved path. The saved path filter will still be applied the next time the repo is opened.",
+		ApplySavedFilterPath:                  "Scope to saved path",
+		ForgetSavedFilterPath:                 "Forget saved path filter",
+		SavedForThisRepo:                      "(saved for this repo)",
+		FilteringCommits:                      "Filtering commits",
+		CommitFilters:                         "Filter commits",
+		CommitFiltersTooltip:                  "Filter the commits by author, date range or commit message, or hide merge commits. These filters can be combined with each other and with filtering by path.",
+		SearchHistoryForCode:                  "Search history for code",
+		GoToCommit:                            "Go to commit",
+		GoToCommitTooltip:                     "Select a commit by entering its hash, or the name of a branch or tag pointing to it. If the commit hasn't been loaded yet, more of the history is loaded to find it.",
+		EnterCommitHashOrRef:                  "Go to commit (hash, branch or tag):",
+		CommitNotFound:                        "Could not find a commit for '{{.ref}}'",
+		FindContainingRefs:                    "Find branches and tags containing commit",
+		FindContainingRefsTooltip:             "List the local branches, remote branches and tags whose history contains the selected commit, e.g. to find out which releases include a fix. From the list you can check out any of them or diff against it.",
+		GenerateReleaseNotes:                  "Generate release notes",
+		GenerateReleaseNotesForCommitsTooltip: "Generate release notes for the selected range of commits, grouped by conventional-commit type or by author. The notes can be shown in the main view or copied to the clipboard.",
+		GenerateReleaseNotesForTagsTooltip:    "Generate release notes for the commits between the oldest and newest selected tags, grouped by conventional-commit type or by author. If a single tag is selected, the commits since the preceding tag are used.",
+		CannotGenerateReleaseNotesForTodos:    "Release notes can't be generated for rebase todo items",
+		GeneratingReleaseNotesStatus:          "Generating release notes",
+		NoCommitsForReleaseNotes:              "There are no commits in this range",
+		ReleaseNotesTitle:                     "Release notes",
+		ShowReleaseNotesByType:                "Show grouped by type",
+		ShowReleaseNotesByAuthor:              "Show grouped by author",
+		CopyReleaseNotesByType:                "Copy grouped by type to clipboard",
+		CopyReleaseNotesByAuthor:              "Copy grouped by author to clipboard",
+		ReleaseNotesCopiedToClipboard:         "Release notes copied to clipboard",
+		ContainingRefsTitle:                   "Branches and tags containing {{.hash}}",
+		NoRefsContainCommit:                   "No branches or tags contain this commit",
+		FindingContainingRefsStatus:           "Finding branches and tags",
+		ContainingRefLocalBranch:              "branch",
+		ContainingRefRemoteBranch:             "remote branch",
+		ContainingRefTag:                      "tag",
+		CommitNotInCurrentHistory:             "Commit {{.hash}} is not in the history of the current branch, or is hidden by a filter",
+		SearchHistoryForCodeTooltip:           "Find the commits that added or removed a piece of code, using git's pickaxe (git log -S or -G). Each found commit's diff only shows the files that match, with the matched text highlighted.",
+		PickaxeString:                         "Changes in the number of occurrences of a string (-S)",
+		PickaxeRegex:                          "Added or removed lines matching a regex (-G)",
+		EnterPickaxeString:                    "Search history for string:",
+		EnterPickaxeRegex:                     "Search history for regex:",
+		CommitFilterAuthor:                    "Author",
+		CommitFilterSince:                     "Since",
+		CommitFilterUntil:                     "Until",
+		CommitFilterMessage:                   "Message",
+		CommitFilterNoMerges:                  "Hide merge commits",
+		CommitFilterPromptTooltip:             "Leave the prompt empty to remove this filter.",
+		CommitFilterOn:                        "on",
+		CommitFilterOff:                       "off",
+		ClearCommitFilters:                    "Clear commit filters",
+		ClearCommitFiltersTooltip:             "Remove all of the above filters, but keep filtering by path. Press <esc> in the commits view to stop filtering altogether.",
+		NoCommitFilters:                       "No commit filters are active",
+		EnterSinceDate:                        "Show commits more recent than (e.g. '2 weeks ago' or '2024-01-31'):",
+		EnterUntilDate:                        "Show commits older than (e.g. 'yesterday' or '2024-01-31'):",
+		EnterCommitMessagePattern:             "Show commits whose message matches (regular expression):",
+		CommitFilterAuthorLabel:               "author: {{.value}}",
+		CommitFilterSinceLabel:                "since: {{.value}}",
+		CommitFilterUntilLabel:                "until: {{.value}}",
+		CommitFilterMessageLabel:              "message: {{.value}}",
+		CommitFilterNoMergesLabel:             "no merges",
+		FilterAuthorOption:                    "Enter author to filter by",
+		EnterFileName:                         "Enter path:",
+		EnterAuthor:                           "Enter author:",
+		FilteringMenuTitle:                    "Filtering",
+		WillCancelExistingFilterTooltip:       "Note: this will cancel the existing filter",
+		MustExitFilterModeTitle:               "Command not available",
+		MustExitFilterModePrompt:              "Command not available in filter-by-path mode. Exit filter-by-path mode?",
+		Diff:                                  "Diff",
+		EnterRefToDiff:                        "Enter ref to diff",
+		EnterRefName:                          "Enter ref:",
+		ExitDiffMode:                          "Exit diff mode",
+		DiffingMenuTitle:                      "Diffing",
+		SwapDiff:                              "Reverse diff direction",
+		ViewDiffingOptions:                    "View diffing options",
+		ViewDiffingOptionsTooltip:             "View options relating to diffing two refs e.g. diffing against selected ref, entering ref to diff against, and reversing the diff direction.",
+		CancelDiffingMode:                     "Cancel diffing mode",
 		// the actual view is the extras view which I intend to give more tabs in future but for now we'll only mention the command log part
 		OpenCommandLogMenu:                       "View command log options",
 		OpenCommandLogMenuTooltip:                "View options for the command log e.g. show/hide the command log and focus the command log.",
@@ -1732,9 +2552,16 @@ func EnglishTranslationSet() *TranslationSet {
 		CommitSubject:                            "Commit subject",
 		CommitAuthor:                             "Commit author",
 		CommitTags:                               "Commit tags",
+		CommitDetailsHash:                        "Commit",
+		CommitDetailsAuthor:                      "Author",
+		CommitDetailsCommitter:                   "Committer",
+		CommitDetailsParents:                     "Parents",
+		CommitDetailsBranches:                    "Branches",
+		CommitDetailsTags:                        "Tags",
 		CopyCommitAttributeToClipboard:           "Copy commit attribute to clipboard",
 		CopyCommitAttributeToClipboardTooltip:    "Copy commit attribute to clipboard (e.g. hash, URL, diff, message, author).",
 		CopyBranchNameToClipboard:                "Copy branch name to clipboard",
+		CopyBranchNameToClipboardTooltip:         "Copy the name of the selected branch. If you have configured `copyTemplates.branches`, open a menu with the branch name and your copy templates instead.",
 		CopyTagToClipboard:                       "Copy tag to clipboard",
 		CopyPathToClipboard:                      "Copy path to clipboard",
 		CopySelectedTextToClipboard:              "Copy selected text to clipboard",
@@ -1751,6 +2578,10 @@ func EnglishTranslationSet() *TranslationSet {
 		ViewCommits:                              "View commits",
 		MinGitVersionError:                       "Git version must be at least %s. Please upgrade your git version.",
 		RunningCustomCommandStatus:               "Running custom command",
+		CustomCommandInputDoesNotMatch:           "The value must match '%s'",
+		MacroCannotRunMacro:                      "A macro can't run another macro",
+		MacroStepDisabled:                        "Macro stopped at '%s': %s",
+		MacroStepNotFound:                        "Macro stopped at '%s': there is no action for this key in the %s view",
 		SubmoduleStashAndReset:                   "Stash uncommitted submodule changes and update",
 		AndResetSubmodules:                       "And reset submodules",
 		Enter:                                    "Enter",
@@ -1764,6 +2595,7 @@ func EnglishTranslationSet() *TranslationSet {
 		NewSubmoduleName:                         "New submodule name:",
 		NewSubmoduleUrl:                          "New submodule URL:",
 		NewSubmodulePath:                         "New submodule path:",
+		NewSubmoduleBranch:                       "New submodule branch (leave empty for the remote's default branch):",
 		NewSubmodule:                             "New submodule",
 		AddingSubmoduleStatus:                    "Adding submodule",
 		UpdateSubmoduleUrl:                       "Update URL for submodule '%s'",
@@ -1775,10 +2607,23 @@ func EnglishTranslationSet() *TranslationSet {
 		Initialize:                               "Initialize",
 		SubmoduleUpdateTooltip:                   "Update selected submodule.",
 		UpdatingSubmoduleStatus:                  "Updating submodule",
+		UpdateSubmoduleToRemote:                  "Update to remote branch",
+		UpdateSubmoduleToRemoteTooltip:           "Update the selected submodule to the latest commit of the branch it tracks on its remote (`git submodule update --remote`). The progress is shown in the command log.",
+		UpdatingSubmoduleToRemoteStatus:          "Updating submodule to remote branch",
+		SetSubmoduleBranch:                       "Set tracked branch",
+		SetSubmoduleBranchTooltip:                "Set the branch that the selected submodule is updated to when updating it to its remote branch.",
+		SetSubmoduleBranchPrompt:                 "Branch for submodule '{{.name}}' to track (leave empty for the remote's default branch):",
+		SettingSubmoduleBranchStatus:             "Setting tracked branch",
 		BulkInitSubmodules:                       "Bulk init submodules",
 		BulkUpdateSubmodules:                     "Bulk update submodules",
 		BulkDeinitSubmodules:                     "Bulk deinit submodules",
 		BulkUpdateRecursiveSubmodules:            "Bulk init and update submodules recursively",
+		BulkUpdateRemoteSubmodules:               "Bulk update submodules to their remote branches",
+		RunCommandInAllSubmodules:                "Run command in all submodules",
+		SubmoduleCommandPrompt:                   "Command to run in each submodule:",
+		CustomSubmoduleCommand:                   "Custom command",
+		SubmoduleCommandSucceeded:                "Command succeeded in all submodules",
+		SubmoduleCommandFailed:                   "Command failed in {{.count}} submodule(s):",
 		ViewBulkSubmoduleOptions:                 "View bulk submodule options",
 		BulkSubmoduleOptions:                     "Bulk submodule options",
 		RunningCommand:                           "Running command",
@@ -1798,6 +2643,7 @@ func EnglishTranslationSet() *TranslationSet {
 		CommitSubjectCopiedToClipboard:           "Commit subject copied to clipboard",
 		CommitAuthorCopiedToClipboard:            "Commit author copied to clipboard",
 		CommitTagsCopiedToClipboard:              "Commit tags copied to clipboard",
+		MarkedCommitHashesCopiedToClipboard:      "Marked commit hashes copied to clipboard",
 		CommitHasNoTags:                          "Commit has no tags",
 		CommitHasNoMessageBody:                   "Commit has no message body",
 		PatchCopiedToClipboard:                   "Patch copied to clipboard",
@@ -1808,13 +2654,107 @@ func EnglishTranslationSet() *TranslationSet {
 		ErrStageDirWithInlineMergeConflicts:      "Cannot stage/unstage directory containing files with inline merge conflicts. Please fix up the merge conflicts first",
 		ErrRepositoryMovedOrDeleted:              "Cannot find repo. It might have been moved or deleted ¯\\_(ツ)_/¯",
 		CommandLog:                               "Command log",
+		CommandOutputTitle:                       "Command output",
+		AppLogTitle:                              "App log",
+		NoCommandOutput:                          "(no output)",
+		CommandExitCode:                          "Exit code: %d",
 		ErrWorktreeMovedOrRemoved:                "Cannot find worktree. It might have been moved or removed ¯\\_(ツ)_/¯",
 		ToggleShowCommandLog:                     "Toggle show/hide command log",
 		FocusCommandLog:                          "Focus command log",
+		ShowBackgroundTasks:                      "Show background tasks",
+		BackgroundTasks:                          "Background tasks",
+		UnnamedTask:                              "Unnamed task",
+		TaskRunning:                              "running",
+		TaskPaused:                               "waiting for input",
+		TaskAlreadyFinished:                      "This task has already finished",
+		TaskCannotBeCancelled:                    "Only tasks that run network operations such as fetching, pulling and pushing can be cancelled",
+		CancelledTask:                            "Cancelled '%s'",
+		CancelTaskTooltip:                        "Cancel this task, stopping the network operation that it is running.",
+		RefreshTask:                              "Refresh %s",
+		AutoFetchTask:                            "Auto-fetch",
+		AutoRefreshTask:                          "Auto-refresh files",
+		StatusBarRefreshTask:                     "Refresh status bar",
+		LoadCommitStatsTask:                      "Load commit stats",
+		LoggedCommands:                           "Logged commands",
+		OpenLoggedCommandsMenu:                   "Re-run or copy a logged command",
+		OpenLoggedCommandsMenuTooltip:            "Pick one of the commands in the command log to copy it to the clipboard or to run it again.",
+		NoLoggedCommands:                         "No commands have been logged yet",
+		RerunCommand:                             "Re-run command",
+		RerunCommandPrompt:                       "Are you sure you want to run this command again?\n\n{{.command}}",
+		CommandCopiedToClipboard:                 "Command copied to clipboard",
 		CommandLogHeader:                         "You can hide/focus this panel by pressing '%s'\n",
 		RandomTip:                                "Random tip",
 		ToggleWhitespaceInDiffView:               "Toggle whitespace",
 		ToggleWhitespaceInDiffViewTooltip:        "Toggle whether or not whitespace changes are shown in the diff view.\n\nThe default can be changed in the config file with the key 'git.ignoreWhitespaceInDiffView'.",
+		ToggleMovedLinesInDiffView:               "Toggle moved lines",
+		ToggleMovedLinesInDiffViewTooltip:        "Toggle whether or not moved lines are shown in different colors in the diff view.\n\nThe default can be changed in the config file with the key 'git.colorMoved'.",
+		DiffOptions:                              "Diff options",
+		DiffOptionsTooltip:                       "Change how diffs are rendered for the rest of the session, e.g. whether whitespace changes are ignored or which diff algorithm is used.",
+		DiffOptionsTitle:                         "Diff options",
+		IgnoreWhitespace:                         "Ignore whitespace",
+		IgnoreBlankLines:                         "Ignore blank lines",
+		IgnoreBlankLinesTooltip:                  "Toggle whether or not changes whose lines are all blank are shown in the diff view.\n\nThe default can be changed in the config file with the key 'git.ignoreBlankLinesInDiffView'.",
+		ColorMovedLines:                          "Color moved lines",
+		DiffAlgorithm:                            "Diff algorithm",
+		DefaultDiffAlgorithm:                     "Default",
+		DefaultDiffAlgorithmTooltip:              "Use the algorithm configured in git's diff.algorithm setting.\n\nThe default can be changed in the config file with the key 'git.diffAlgorithm'.",
+		CantChangeDiffAlgorithmError:             "Cannot change the diff algorithm while building a custom patch",
+		PagerFailed:                              "Couldn't run the pager '{{pager}}', showing diffs without it",
+		GoToLine:                                 "Go to line",
+		GoToLineTooltip:                          "Select the line with the given line number in the new version of the file, or the closest line to it that is part of the diff.",
+		GoToLinePromptTitle:                      "Go to line",
+		InvalidLineNumber:                        "Please enter a valid line number",
+		BinaryFileTitle:                          "Binary file {{.path}}",
+		BinaryFileOldVersion:                     "Old",
+		BinaryFileNewVersion:                     "New",
+		BinaryFileSize:                           "Size",
+		BinaryFileType:                           "Type",
+		BinaryFileDimensions:                     "Dimensions",
+		EditFileAtLine:                           "Edit file at line",
+		EditFileAtLineTooltip:                    "Open the file in your external editor, at the line of the diff that is shown at the top of the view.",
+		NoDiffLineToEdit:                         "There is no line of a diff to edit here",
+		OpenLayoutMenu:                           "View layout options",
+		OpenLayoutMenuTooltip:                    "Rearrange the side panels: change their order, hide them, let several of them share a slot, or show them on the other side of the main view. Your choices are remembered; the defaults can be set with the 'gui.sidePanels' and 'gui.sidePanelPosition' configs.",
+		ToggleZenMode:                            "Toggle zen mode",
+		ToggleZenModeTooltip:                     "Show only the focused panel and its main view, hiding the other panels, the command log and the bottom line. Press again to go back to the previous screen mode.",
+		ToggleKeypressRecording:                  "Start/stop recording keypresses",
+		ToggleKeypressRecordingTooltip:           "Record the actions you perform by their keys, so that you can replay them later, e.g. to repeat the same steps of a rebase. Text that you type into a prompt isn't recorded. The recording is kept after restarting lazygit.",
+		ReplayKeypressRecording:                  "Replay keypress recording",
+		ReplayKeypressRecordingTooltip:           "Replay the last keypress recording, as many times as you like. Replaying stops at the first action that fails.",
+		ReplayKeypressRecordingTitle:             "Replay '%s' how many times?",
+		CycleDateDisplay:                         "Cycle date display",
+		CycleDateDisplayTooltip:                  "Switch the dates in the commits, reflog, stash and branches panels between how long ago they were, the time format, and the custom time format. The default for this can be changed in the config file with 'gui.dateDisplay'.",
+		ShowingRelativeDates:                     "Showing how long ago",
+		ShowingAbsoluteDates:                     "Showing dates in the time format",
+		ShowingCustomDates:                       "Showing dates in the custom time format",
+		KeypressRecordingStarted:                 "Recording keypresses",
+		KeypressRecordingEmpty:                   "Nothing was recorded",
+		KeypressRecordingSaved:                   "Recorded '%s'",
+		RecordingKeypresses:                      "Recording keypresses",
+		PendingCountPrefix:                       "Count: %d",
+		MoreKeySequences:                         "More...",
+		CannotReplayWhileRecording:               "Can't replay while recording keypresses",
+		NoKeypressRecording:                      "There is no keypress recording yet",
+		InvalidReplayCount:                       "The number of times must be a whole number of at least 1",
+		LayoutMenuTitle:                          "Layout",
+		SidePanelsOnLeft:                         "Side panels on the left",
+		SidePanelsOnRight:                        "Side panels on the right",
+		SidePanelsOnRightTooltip:                 "In portrait mode, the side panels are shown below the main view instead.",
+		SidePanelsSection:                        "Side panels",
+		SidePanelHidden:                          "hidden",
+		SidePanelSharesSlotWith:                  "shares slot with {{panels}}",
+		ShowSidePanel:                            "Show",
+		HideSidePanel:                            "Hide",
+		MoveSidePanelUp:                          "Move up",
+		MoveSidePanelDown:                        "Move down",
+		ShareSlotWithPanelAbove:                  "Share slot with the panel above",
+		ShareSlotWithPanelAboveTooltip:           "Only one of the panels sharing a slot is shown at a time: the one that has focus, or else the first one.",
+		GiveSidePanelOwnSlot:                     "Move to its own slot",
+		CannotHideLastSidePanel:                  "At least one side panel must be shown",
+		SidePanelIsHidden:                        "The panel is hidden",
+		ResetLayout:                              "Reset to configured layout",
+		ResetLayoutTooltip:                       "Forget the changes made in this menu and go back to the layout set by the 'gui.sidePanels' and 'gui.sidePanelPosition' configs.",
+		LayoutIsNotCustomized:                    "The layout hasn't been changed in this menu",
 		IgnoreWhitespaceDiffViewSubTitle:         "(ignoring whitespace)",
 		IgnoreWhitespaceNotSupportedHere:         "Ignoring whitespace is not supported in this view",
 		IncreaseContextInDiffView:                "Increase diff context size",
@@ -1832,6 +2772,31 @@ func EnglishTranslationSet() *TranslationSet {
 		SelectBranch:                             "Select branch",
 		SelectTargetRemote:                       "Select target remote",
 		NoValidRemoteName:                        "A remote named '%s' does not exist",
+		HostingServiceSection:                    "Hosting service",
+		SelectHostingServiceRemote:               "Hosting service remote: {{.remote}}",
+		HostingServiceRemoteTitle:                "Hosting service remote",
+		OnlyOneRemote:                            "The repo has only one remote",
+		PullRequestAPINoToken:                    "Set an API token in GITHUB_TOKEN/GH_TOKEN (GitHub), GITLAB_TOKEN (GitLab) or GITEA_TOKEN/FORGEJO_TOKEN (Gitea/Forgejo), or log in with the gh or glab CLI, to use the hosting service's API",
+		CreatePullRequestViaAPI:                  "Create pull request via API",
+		ListOpenPullRequests:                     "List open pull requests",
+		ShowPipelineStatus:                       "Show CI pipeline status",
+		PullRequestTitleTitle:                    "Pull request title",
+		PullRequestDescriptionTitle:              "Pull request description",
+		CreatingPullRequestStatus:                "Creating pull request",
+		PullRequestCreated:                       "Created pull request {{.url}}",
+		FetchingPullRequestsStatus:               "Fetching pull requests",
+		LookingUpAPITokenStatus:                  "Looking up API token",
+		NoOpenPullRequests:                       "There are no open pull requests",
+		PullRequestDraft:                         "[draft]",
+		FetchingPipelineStatusStatus:             "Fetching pipeline status",
+		NoPipelinesForBranch:                     "There are no CI pipelines for this branch",
+		PipelineStatusPrompt:                     "The latest pipeline of {{.branch}} has status '{{.status}}'. Open it in the browser?",
+		ListAssignedIssues:                       "List issues assigned to me",
+		NoAssignedIssues:                         "There are no open issues assigned to you",
+		StartWorkOnIssue:                         "Start work on issue",
+		StartWorkOnIssueTooltip:                  "Create a branch for the issue off the selected branch, named according to the 'issues.branchNameTemplate' config. Then assign and label the issue as configured.",
+		OpenIssueInBrowser:                       "Open in browser",
+		UpdatingIssueStatus:                      "Updating issue",
 		SelectConfigFile:                         "Select config file",
 		NoConfigFileFoundErr:                     "No config file found",
 		LoadingFileSuggestions:                   "Loading file suggestions",
@@ -1876,6 +2841,8 @@ func EnglishTranslationSet() *TranslationSet {
 		CommitsCopied:                            "commits copied", // lowercase because it's used in a sentence
 		CommitCopied:                             "commit copied",  // lowercase because it's used in a sentence
 		ResetPatch:                               "Reset patch",
+		CommitsMarked:                            "commits marked",
+		CommitMarked:                             "commit marked",
 		ResetPatchTooltip:                        "Clear the current patch.",
 		ApplyPatch:                               "Apply patch",
 		ApplyPatchTooltip:                        "Apply the current patch to the working tree.",
@@ -1933,9 +2900,22 @@ func EnglishTranslationSet() *TranslationSet {
 		BranchNameCannotBeBlank:                  "Branch name cannot be blank",
 		NewBranchName:                            "New branch name",
 		NewBranchNameLeaveBlank:                  "New branch name (leave blank to checkout {{.default}})",
+		NewBranchNameTrackingRemoteBranch:        "New branch name tracking {{.remoteBranch}} (leave blank for {{.default}})",
+		CreateWorktreeMenuTitle:                  "Create worktree",
+		CreateWorktreeAndSwitch:                  "Create and switch to it",
+		CreateWorktreeAndOpenInNewWindow:         "Create and open in new window",
+		CreateWorktreeAndOpenInNewWindowTooltip:  "Create the worktree and open it in a new terminal tab or window, using the command configured in `os.openDirInNewWindow`.",
+		CreateLockedWorktreeFrom:                 "Create locked worktree from {{.ref}}",
+		CreateLockedWorktreeTooltip:              "Lock the new worktree so that it can't be pruned, moved or removed, e.g. because it lives on a removable drive.",
+		WorktreeLockReason:                       "Lock reason (optional)",
 		ViewWorktreeOptions:                      "View worktree options",
 		CreateWorktreeFrom:                       "Create worktree from {{.ref}}",
 		CreateWorktreeFromDetached:               "Create worktree from {{.ref}} (detached)",
+		ReviewInWorktree:                         "Review {{.ref}} in worktree",
+		ReviewInWorktreeAndOpenInEditor:          "Review {{.ref}} in worktree (open in editor)",
+		ReviewInWorktreeTooltip:                  "Create a dedicated worktree for reviewing {{.ref}} (or reuse the existing one) and switch to it, leaving the current worktree untouched. The location of the worktree is configured with `git.reviewWorktreePath`.",
+		RemoveReviewWorktree:                     "Remove review worktree for {{.ref}}",
+		NoReviewWorktree:                         "There is no review worktree for this ref",
 		LcWorktree:                               "worktree",
 		ChangingDirectoryTo:                      "Changing directory to {{.path}}",
 		Name:                                     "Name",
@@ -1946,6 +2926,9 @@ func EnglishTranslationSet() *TranslationSet {
 		MarkAsBaseCommitTooltip:                  "Select a base commit for the next rebase. When you rebase onto a branch, only commits above the base commit will be brought across. This uses the `git rebase --onto` command.",
 		CancelMarkedBaseCommit:                   "Cancel marked base commit",
 		MarkedCommitMarker:                       "↑↑↑ Will rebase from here ↑↑↑",
+		CommitStatusUnpushed:                     "unpushed",
+		CommitStatusPushed:                       "pushed",
+		CommitStatusMerged:                       "merged",
 		FailedToOpenURL:                          "Failed to open URL %s\n\nError: %v",
 		InvalidLazygitEditURL:                    "Invalid lazygit-edit URL format: %s",
 		DisabledMenuItemPrefix:                   "Disabled: ",
@@ -1978,11 +2961,13 @@ func EnglishTranslationSet() *TranslationSet {
 			DeleteLocalBranch:                "Delete local branch",
 			Merge:                            "Merge",
 			SquashMerge:                      "Squash merge",
+			OctopusMerge:                     "Octopus merge",
 			RebaseBranch:                     "Rebase branch",
 			RenameBranch:                     "Rename branch",
 			CreateBranch:                     "Create branch",
 			CherryPick:                       "(Cherry-pick) paste commits",
 			CheckoutFile:                     "Checkout file",
+			ApplyStashFiles:                  "Apply files from stash",
 			SquashCommitDown:                 "Squash commit down",
 			FixupCommit:                      "Fixup commit",
 			RewordCommit:                     "Reword commit",
@@ -1990,41 +2975,61 @@ func EnglishTranslationSet() *TranslationSet {
 			EditCommit:                       "Edit commit",
 			AmendCommit:                      "Amend commit",
 			ResetCommitAuthor:                "Reset commit author",
+			ResetCommitAuthorDate:            "Reset commit author date",
 			SetCommitAuthor:                  "Set commit author",
+			BulkSetCommitAuthor:              "Bulk set commit author",
 			AddCommitCoAuthor:                "Add commit co-author",
 			RevertCommit:                     "Revert commit",
 			CreateFixupCommit:                "Create fixup commit",
 			SquashAllAboveFixupCommits:       "Squash all above fixup commits",
 			CreateLightweightTag:             "Create lightweight tag",
 			CreateAnnotatedTag:               "Create annotated tag",
+			EditTagMessage:                   "Edit tag message",
 			CopyCommitMessageToClipboard:     "Copy commit message to clipboard",
 			CopyCommitMessageBodyToClipboard: "Copy commit message body to clipboard",
 			CopyCommitSubjectToClipboard:     "Copy commit subject to clipboard",
 			CopyCommitTagsToClipboard:        "Copy commit tags to clipboard",
 			CopyCommitDiffToClipboard:        "Copy commit diff to clipboard",
 			CopyCommitHashToClipboard:        "Copy full commit hash to clipboard",
+			CopyMarkedCommitHashes:           "Copy marked commit hashes to clipboard",
 			CopyCommitURLToClipboard:         "Copy commit URL to clipboard",
 			CopyCommitAuthorToClipboard:      "Copy commit author to clipboard",
+			CopyReleaseNotesToClipboard:      "Copy release notes to clipboard",
+			CopyActivitySummaryToClipboard:   "Copy activity summary to clipboard",
+			CreateRelease:                    "Create release",
+			OpenNewReleasePage:               "Open new release page",
 			CopyCommitAttributeToClipboard:   "Copy to clipboard",
 			CopyPatchToClipboard:             "Copy patch to clipboard",
 			MoveCommitUp:                     "Move commit up",
 			MoveCommitDown:                   "Move commit down",
+			StartInteractiveRebase:           "Start interactive rebase",
 			CustomCommand:                    "Custom command",
+			RerunCommand:                     "Re-run command",
 			DiscardAllChangesInFile:          "Discard all changes in selected file(s)",
 			DiscardAllUnstagedChangesInFile:  "Discard all unstaged changes selected file(s)",
 			StageFile:                        "Stage file",
+			IntentToAdd:                      "Mark as intent-to-add",
+			ToggleExecutable:                 "Toggle executable bit",
 			StageResolvedFiles:               "Stage files whose merge conflicts were resolved",
 			UnstageFile:                      "Unstage file",
 			UnstageAllFiles:                  "Unstage all files",
 			StageAllFiles:                    "Stage all files",
 			ResolveConflictByKeepingFile:     "Resolve by keeping file",
+			ResolveAllConflictsWithOurs:      "Resolve all conflicts with our version",
+			ResolveAllConflictsWithTheirs:    "Resolve all conflicts with their version",
+			ToggleBreak:                      "Toggle break",
+			ToggleRerere:                     "Toggle rerere",
+			ForgetRerereResolution:           "Forget rerere resolution",
 			ResolveConflictByDeletingFile:    "Resolve by deleting file",
 			NotEnoughContextToStage:          "Staging or unstaging changes is not possible with a diff context size of 0. Increase the context using '%s'.",
 			NotEnoughContextToDiscard:        "Discarding changes is not possible with a diff context size of 0. Increase the context using '%s'.",
+			NotEnoughContextToStash:          "Stashing lines is not possible with a diff context size of 0. Increase the context using '%s'.",
 			NotEnoughContextForCustomPatch:   "Creating custom patches is not possible with a diff context size of 0. Increase the context using '%s'.",
 			IgnoreExcludeFile:                "Ignore or exclude file",
 			IgnoreFileErr:                    "Cannot ignore .gitignore",
 			ExcludeFile:                      "Exclude file",
+			ToggleSkipWorktree:               "Toggle skip-worktree",
+			ToggleAssumeUnchanged:            "Toggle assume-unchanged",
 			ExcludeGitIgnoreErr:              "Cannot exclude .gitignore",
 			Commit:                           "Commit",
 			Push:                             "Push",
@@ -2033,6 +3038,7 @@ func EnglishTranslationSet() *TranslationSet {
 			StashAllChanges:                  "Stash all changes",
 			StashAllChangesKeepIndex:         "Stash all changes and keep index",
 			StashStagedChanges:               "Stash staged changes",
+			StashSelectedLines:               "Stash selected lines",
 			StashUnstagedChanges:             "Stash unstaged changes",
 			StashIncludeUntrackedChanges:     "Stash all changes including untracked files",
 			GitFlowFinish:                    "git flow finish",
@@ -2051,6 +3057,7 @@ func EnglishTranslationSet() *TranslationSet {
 			ApplyPatch:                       "Apply patch",
 			Stash:                            "Stash",
 			RenameStash:                      "Rename stash",
+			ApplyStashToBranch:               "Apply stash to branch",
 			RemoveSubmodule:                  "Remove submodule",
 			ResetSubmodule:                   "Reset submodule",
 			AddSubmodule:                     "Add submodule",
@@ -2060,11 +3067,18 @@ func EnglishTranslationSet() *TranslationSet {
 			BulkUpdateSubmodules:             "Bulk update submodules",
 			BulkDeinitialiseSubmodules:       "Bulk deinitialise submodules",
 			BulkUpdateRecursiveSubmodules:    "Bulk initialise and update submodules recursively",
+			BulkUpdateRemoteSubmodules:       "Bulk update submodules to remote branches",
+			RunCommandInAllSubmodules:        "Run command in all submodules",
 			UpdateSubmodule:                  "Update submodule",
+			UpdateSubmoduleToRemote:          "Update submodule to remote branch",
+			SetSubmoduleBranch:               "Set submodule branch",
 			DeleteLocalTag:                   "Delete local tag",
 			DeleteRemoteTag:                  "Delete remote tag",
 			PushTag:                          "Push tag",
+			PushTags:                         "Push tags",
 			NukeWorkingTree:                  "Nuke working tree",
+			RestoreSnapshot:                  "Restore snapshot",
+			UndoDiscard:                      "Undo discard",
 			DiscardUnstagedFileChanges:       "Discard unstaged file changes",
 			RemoveUntrackedFiles:             "Remove untracked files",
 			RemoveStagedFiles:                "Remove staged files",
@@ -2079,11 +3093,25 @@ func EnglishTranslationSet() *TranslationSet {
 			OpenMergeTool:                    "Open merge tool",
 			OpenCommitInBrowser:              "Open commit in browser",
 			OpenPullRequest:                  "Open pull request in browser",
+			CreatePullRequestViaAPI:          "Create pull request via API",
+			OpenIssue:                        "Open issue in browser",
+			StartWorkOnIssue:                 "Start work on issue",
 			StartBisect:                      "Start bisect",
 			ResetBisect:                      "Reset bisect",
 			BisectSkip:                       "Bisect skip",
 			BisectMark:                       "Bisect mark",
 			AddWorktree:                      "Add worktree",
+			OpenWorktreeInNewWindow:          "Open worktree in new window",
+			OpenInTmux:                       "Open in tmux",
+			AddReviewWorktree:                "Add review worktree",
+			PruneDanglingObjects:             "Prune dangling objects",
+			DeleteBrokenRefs:                 "Delete broken refs",
+			UpgradeIndexVersion:              "Upgrade index version",
+			RemoveStaleLockFiles:             "Remove stale lock files",
+			RunGc:                            "Run git gc",
+			ChangeSetting:                    "Change setting",
+			SwitchProfile:                    "Switch config profile",
+			SwitchTheme:                      "Switch theme",
 		},
 		Bisect: Bisect{
 			Mark:                        "Mark current commit (%s) as %s",
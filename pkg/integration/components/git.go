@@ -27,6 +27,14 @@ func (self *Git) RemoteTagDeleted(ref string, tagName string) *Git {
 	})
 }
 
+// Checks the author and committer dates of the given commit, formatted as
+// "<author date> <committer date>" in YYYY-MM-DD format
+func (self *Git) CommitDates(ref string, matcher *TextMatcher) *Git {
+	return self.expect([]string{"git", "log", "-1", "--format=%ad %cd", "--date=short", ref}, func(output string) (bool, string) {
+		return matcher.context(fmt.Sprintf("Unexpected dates of commit %s", ref)).test(output)
+	})
+}
+
 func (self *Git) assert(cmdArgs []string, expected string) *Git {
 	self.expect(cmdArgs, func(output string) (bool, string) {
 		return output == expected, fmt.Sprintf("Expected current branch name to be '%s', but got '%s'", expected, output)
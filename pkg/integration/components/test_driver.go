@@ -49,6 +49,30 @@ func (self *TestDriver) click(x, y int) {
 	self.Wait(self.inputDelay)
 }
 
+func (self *TestDriver) rightClick(x, y int) {
+	self.SetCaption(fmt.Sprintf("Right-clicking %d, %d", x, y))
+	self.gui.RightClick(x, y)
+	self.Wait(self.inputDelay)
+}
+
+func (self *TestDriver) middleClick(x, y int) {
+	self.SetCaption(fmt.Sprintf("Middle-clicking %d, %d", x, y))
+	self.gui.MiddleClick(x, y)
+	self.Wait(self.inputDelay)
+}
+
+func (self *TestDriver) moveMouse(x, y int) {
+	self.SetCaption(fmt.Sprintf("Moving the mouse to %d, %d", x, y))
+	self.gui.MoveMouse(x, y)
+	self.Wait(self.inputDelay)
+}
+
+func (self *TestDriver) drag(fromX, fromY, toX, toY int) {
+	self.SetCaption(fmt.Sprintf("Dragging from %d, %d to %d, %d", fromX, fromY, toX, toY))
+	self.gui.Drag(fromX, fromY, toX, toY)
+	self.Wait(self.inputDelay)
+}
+
 // Should only be used in specific cases where you're doing something weird!
 // E.g. invoking a global keybinding from within a popup.
 // You probably shouldn't use this function, and should instead go through a view like t.Views().Commit().Focus().Press(...)
@@ -34,6 +34,18 @@ func (self *fakeGuiDriver) Click(x, y int) {
 	self.clickedCoordinates = append(self.clickedCoordinates, coordinate{x: x, y: y})
 }
 
+func (self *fakeGuiDriver) RightClick(x, y int) {
+}
+
+func (self *fakeGuiDriver) MiddleClick(x, y int) {
+}
+
+func (self *fakeGuiDriver) MoveMouse(x, y int) {
+}
+
+func (self *fakeGuiDriver) Drag(fromX, fromY, toX, toY int) {
+}
+
 func (self *fakeGuiDriver) Keys() config.KeybindingConfig {
 	return config.KeybindingConfig{}
 }
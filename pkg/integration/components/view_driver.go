@@ -439,6 +439,44 @@ func (self *ViewDriver) Click(x, y int) *ViewDriver {
 	return self
 }
 
+// Coordinates are relative to the view's content, as for Click
+func (self *ViewDriver) RightClick(x, y int) *ViewDriver {
+	offsetX, offsetY, _, _ := self.getView().Dimensions()
+
+	self.t.rightClick(offsetX+1+x, offsetY+1+y)
+
+	return self
+}
+
+// Coordinates are relative to the view's content, as for Click
+func (self *ViewDriver) MiddleClick(x, y int) *ViewDriver {
+	offsetX, offsetY, _, _ := self.getView().Dimensions()
+
+	self.t.middleClick(offsetX+1+x, offsetY+1+y)
+
+	return self
+}
+
+// Moves the mouse over the view without clicking; coordinates are relative to
+// the view's content, as for Click
+func (self *ViewDriver) Hover(x, y int) *ViewDriver {
+	offsetX, offsetY, _, _ := self.getView().Dimensions()
+
+	self.t.moveMouse(offsetX+1+x, offsetY+1+y)
+
+	return self
+}
+
+// Drags the mouse from one position to another; coordinates are relative to
+// the view's content, as for Click, so -1 is the view's left or top border.
+func (self *ViewDriver) Drag(fromX, fromY, toX, toY int) *ViewDriver {
+	offsetX, offsetY, _, _ := self.getView().Dimensions()
+
+	self.t.drag(offsetX+1+fromX, offsetY+1+fromY, offsetX+1+toX, offsetY+1+toY)
+
+	return self
+}
+
 // i.e. pressing down arrow
 func (self *ViewDriver) SelectNextItem() *ViewDriver {
 	return self.PressFast(self.t.keys.Universal.NextItem)
@@ -580,6 +618,26 @@ func (self *ViewDriver) getLineCount() int {
 	return len(view.BufferLines())
 }
 
+// asserts on the width of the view, including its frame
+func (self *ViewDriver) HasWidth(expected int) *ViewDriver {
+	self.t.assertWithRetries(func() (bool, string) {
+		actual := self.getView().Width()
+		return actual == expected, fmt.Sprintf("%s: Expected view to be %d wide, but it was %d", self.context, expected, actual)
+	})
+
+	return self
+}
+
+// asserts on the height of the view, including its frame
+func (self *ViewDriver) HasHeight(expected int) *ViewDriver {
+	self.t.assertWithRetries(func() (bool, string) {
+		actual := self.getView().Height()
+		return actual == expected, fmt.Sprintf("%s: Expected view to be %d high, but it was %d", self.context, expected, actual)
+	})
+
+	return self
+}
+
 func (self *ViewDriver) IsVisible() *ViewDriver {
 	self.t.assertWithRetries(func() (bool, string) {
 		return self.getView().Visible, fmt.Sprintf("%s: Expected view to be visible, but it was not", self.context)
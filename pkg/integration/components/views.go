@@ -70,6 +70,18 @@ func (self *Views) AppStatus() *ViewDriver {
 	return self.regularView("appStatus")
 }
 
+func (self *Views) Extras() *ViewDriver {
+	return self.regularView("extras")
+}
+
+func (self *Views) CommandOutput() *ViewDriver {
+	return self.regularView("commandOutput")
+}
+
+func (self *Views) AppLog() *ViewDriver {
+	return self.regularView("appLog")
+}
+
 func (self *Views) Branches() *ViewDriver {
 	return self.regularView("localBranches")
 }
@@ -0,0 +1,62 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var MergePreview = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Preview the conflicts and diff of a merge without touching the working tree",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	GitVersion:   AtLeast("2.38.0"),
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.MergeConflictsSetup(shell)
+		shell.Checkout("original-branch").
+			NewBranch("unrelated-branch").
+			CreateFileAndAdd("unrelated-file", "content\n").
+			Commit("unrelated change").
+			Checkout("first-change-branch")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("second-change-branch")).
+			Press(keys.Branches.MergeIntoCurrentBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Merge")).
+			Select(Contains("Preview merge (dry run)")).
+			Confirm()
+
+		t.Views().Main().
+			Title(Equals("Merge preview")).
+			Content(
+				Contains("Merging 'second-change-branch' into 'first-change-branch' would result in conflicts in these files:").
+					Contains("  file").
+					Contains("+<<<<<<< HEAD").
+					Contains("+>>>>>>> second-change-branch"),
+			)
+
+		t.Views().Branches().
+			NavigateToLine(Contains("unrelated-branch")).
+			Press(keys.Branches.MergeIntoCurrentBranch)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Merge")).
+			Select(Contains("Preview merge (dry run)")).
+			Confirm()
+
+		t.Views().Main().
+			Title(Equals("Merge preview")).
+			Content(
+				Contains("Merging 'unrelated-branch' into 'first-change-branch' would succeed without conflicts.").
+					Contains("+content"),
+			)
+
+		// nothing was changed in the working tree
+		t.Views().Files().IsEmpty()
+	},
+})
@@ -0,0 +1,97 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var MergeWithOptions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Merge with a strategy option, --no-ff and a custom commit message, and check that the options are remembered",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.MergeConflictsSetup(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		openMergeMenu := func() {
+			t.Views().Branches().
+				Focus().
+				NavigateToLine(Contains("second-change-branch")).
+				Press(keys.Branches.MergeIntoCurrentBranch)
+		}
+
+		openMergeMenu()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Merge")).
+			ContainsLines(
+				Contains("[ ] Always create a merge commit (--no-ff)"),
+				Contains("[ ] Only fast-forward (--ff-only)"),
+				Contains("Strategy option: default"),
+				Contains("[ ] Edit merge commit message"),
+			).
+			Select(Contains("Always create a merge commit (--no-ff)")).
+			Confirm()
+
+		// the menu is shown again so that more options can be changed
+		t.ExpectPopup().Menu().
+			Title(Equals("Merge")).
+			ContainsLines(
+				Contains("[✓] Always create a merge commit (--no-ff)"),
+			).
+			Select(Contains("Strategy option: default")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Strategy option")).
+			Select(Contains("-X theirs")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Merge")).
+			Select(Contains("Edit merge commit message")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Merge")).
+			ContainsLines(
+				Contains("[✓] Always create a merge commit (--no-ff)"),
+				Contains("[ ] Only fast-forward (--ff-only)"),
+				Contains("Strategy option: theirs"),
+				Contains("[✓] Edit merge commit message"),
+			).
+			Select(Contains("Regular merge")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Merge commit message")).
+			InitialText(Equals("Merge branch 'second-change-branch' into first-change-branch")).
+			Clear().
+			Type("Take their changes").
+			Confirm()
+
+		// the conflict in 'file' was resolved in favour of the merged branch
+		t.Views().Files().IsEmpty()
+		t.FileSystem().FileContent("file", Equals(shared.SecondChangeFileContent))
+
+		t.Views().Commits().
+			TopLines(
+				Contains("Take their changes"),
+			)
+
+		// the options are remembered
+		openMergeMenu()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Merge")).
+			ContainsLines(
+				Contains("[✓] Always create a merge commit (--no-ff)"),
+				Contains("[ ] Only fast-forward (--ff-only)"),
+				Contains("Strategy option: theirs"),
+				Contains("[✓] Edit merge commit message"),
+			).
+			Cancel()
+	},
+})
@@ -0,0 +1,92 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var OctopusMerge = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Merge several selected branches into the current branch in a single octopus merge",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.LocalBranchSortOrder = "alphabetical"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("original-branch").
+			CreateFileAndAdd("file", "original\n").
+			Commit("one").
+			NewBranch("branch-a").
+			CreateFileAndAdd("file-a", "a\n").
+			Commit("commit a").
+			Checkout("original-branch").
+			NewBranch("branch-b").
+			CreateFileAndAdd("file-b", "b\n").
+			Commit("commit b").
+			Checkout("original-branch").
+			NewBranch("conflicting-branch-1").
+			UpdateFileAndAdd("file", "conflict 1\n").
+			Commit("conflict 1").
+			Checkout("original-branch").
+			NewBranch("conflicting-branch-2").
+			UpdateFileAndAdd("file", "conflict 2\n").
+			Commit("conflict 2").
+			Checkout("original-branch").
+			NewBranch("other-branch").
+			CreateFileAndAdd("file-other", "other\n").
+			Commit("commit other").
+			Checkout("original-branch")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("original-branch").IsSelected(),
+				Contains("branch-a"),
+				Contains("branch-b"),
+				Contains("conflicting-branch-1"),
+				Contains("conflicting-branch-2"),
+				Contains("other-branch"),
+			).
+			NavigateToLine(Contains("branch-a")).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Branches.MergeIntoCurrentBranch)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Octopus merge")).
+			Content(Equals("Merge the following branches into 'original-branch' in a single merge commit?\n\nbranch-a\nbranch-b")).
+			Confirm()
+
+		t.Views().Commits().
+			TopLines(
+				Contains("Merge branches 'branch-a' and 'branch-b' into original-branch"),
+			)
+
+		t.FileSystem().PathPresent("file-a")
+		t.FileSystem().PathPresent("file-b")
+
+		// git refuses an octopus merge of branches that conflict with each other
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("conflicting-branch-1")).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Branches.MergeIntoCurrentBranch)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Octopus merge")).
+			Content(Contains("conflicting-branch-1\nconflicting-branch-2\nother-branch")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("Git refused the octopus merge because the selected branches conflict with each other.")).
+			Confirm()
+
+		t.Views().Files().IsEmpty()
+		t.Views().Commits().
+			TopLines(
+				Contains("Merge branches 'branch-a' and 'branch-b' into original-branch"),
+			)
+	},
+})
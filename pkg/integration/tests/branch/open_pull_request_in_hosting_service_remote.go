@@ -0,0 +1,73 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var OpenPullRequestInHostingServiceRemote = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Choose the remote that pull requests are created in, and open a pull request from a fork into it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().OS.OpenLink = "echo {{link}} > /tmp/openlink"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content1")
+		shell.Commit("one")
+
+		shell.CloneIntoRemote("upstream")
+		shell.NewBranch("feature")
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("feature", "origin/feature")
+
+		shell.RunCommand([]string{"git", "remote", "set-url", "origin", "https://github.com/my-personal-fork/lazygit"})
+		shell.RunCommand([]string{"git", "remote", "set-url", "upstream", "https://github.com/jesseduffield/lazygit"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().
+			Branches().
+			Focus().
+			Lines(
+				Contains("feature").IsSelected(),
+				Contains("master"),
+			).
+			Press(keys.Branches.ViewPullRequestOptions)
+
+		t.ExpectPopup().
+			Menu().
+			Title(Equals("View create pull request options")).
+			Select(Contains("Hosting service remote: origin")).
+			Confirm()
+
+		t.ExpectPopup().
+			Menu().
+			Title(Equals("Hosting service remote")).
+			Select(Contains("upstream")).
+			Confirm()
+
+		// The options menu is reopened with the new remote
+		t.ExpectPopup().
+			Menu().
+			Title(Equals("View create pull request options")).
+			Tap(func() {
+				t.Views().Menu().ContainsLines(Contains("Hosting service remote: upstream"))
+			}).
+			Select(Contains("feature → Default branch")).
+			Confirm()
+
+		t.FileSystem().FileContent(
+			"/tmp/openlink",
+			Equals("https://github.com/jesseduffield/lazygit/compare/my-personal-fork:feature?expand=1\n"))
+
+		// The choice is remembered, so it also applies to the create pull request command
+		t.Shell().RunShellCommand("rm /tmp/openlink")
+		t.Views().Branches().
+			IsFocused().
+			Press(keys.Branches.CreatePullRequest)
+
+		t.FileSystem().FileContent(
+			"/tmp/openlink",
+			Equals("https://github.com/jesseduffield/lazygit/compare/my-personal-fork:feature?expand=1\n"))
+	},
+})
@@ -63,12 +63,10 @@ var OpenPullRequestSelectRemoteAndTargetBranch = NewIntegrationTest(NewIntegrati
 				Equals("master")).
 			ConfirmSuggestion(Equals("master"))
 
-		// Verify that the expected URL is used (by checking the openlink file)
-		//
-		// Please note that when targeting a different remote - like it's done here in this test -
-		// the link is not yet correct. Thus, this test is expected to fail once this is fixed.
+		// Verify that the pull request is created in the selected remote's repo, from the
+		// branch in the fork (by checking the openlink file)
 		t.FileSystem().FileContent(
 			"/tmp/openlink",
-			Equals("https://github.com/my-personal-fork/lazygit/compare/master...branch-2?expand=1\n"))
+			Equals("https://github.com/jesseduffield/lazygit/compare/master...my-personal-fork:branch-2?expand=1\n"))
 	},
 })
@@ -0,0 +1,63 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PullRequestWithoutApiToken = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "The hosting service API items of the pull request options menu fail when no API token is found, and are disabled for services without an API",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Services = map[string]string{"git.example.com": "github:git.example.com"}
+		// Don't look for tokens outside of the (empty) environment
+		config.GetUserConfig().HostingServiceTokenSources = []string{}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content1")
+		shell.Commit("one")
+
+		shell.NewBranch("branch-1")
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("branch-1", "origin/branch-1")
+
+		shell.RunCommand([]string{"git", "remote", "set-url", "origin", "https://git.example.com/peter/calculator"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().
+			Branches().
+			Focus().
+			Press(keys.Branches.ViewPullRequestOptions)
+
+		t.ExpectPopup().
+			Menu().
+			Title(Equals("View create pull request options")).
+			Select(Contains("List open pull requests")).
+			Confirm()
+
+		t.ExpectPopup().Alert().Title(Equals("Error")).Content(Contains("Set an API token")).Confirm()
+
+		// A domain that isn't associated with any hosting service
+		t.Shell().RunCommand([]string{"git", "remote", "set-url", "origin", "https://git.other-example.com/peter/calculator"})
+
+		t.Views().
+			Branches().
+			Press(keys.Branches.ViewPullRequestOptions)
+
+		t.ExpectPopup().
+			Menu().
+			Title(Equals("View create pull request options")).
+			Select(Contains("List open pull requests")).
+			Tooltip(Contains("Disabled: Managing pull requests through the API is not supported")).
+			Confirm().
+			Tap(func() {
+				t.ExpectToast(Contains("Disabled: Managing pull requests through the API is not supported"))
+			}).
+			Select(Contains("Create pull request via API")).
+			Tooltip(Contains("Disabled: Managing pull requests through the API is not supported")).
+			Select(Contains("List issues assigned to me")).
+			Tooltip(Contains("Disabled: Listing issues through the API is not supported")).
+			Cancel()
+	},
+})
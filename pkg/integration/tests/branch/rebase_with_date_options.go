@@ -0,0 +1,71 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RebaseWithDateOptions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Rebase with --committer-date-is-author-date, and check that the option is remembered",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("master 1").
+			NewBranch("feature").
+			EmptyCommitWithDate("feature 1", "2001-01-01T12:00:00").
+			Checkout("master").
+			EmptyCommit("master 2").
+			Checkout("feature")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		openRebaseMenu := func() {
+			t.Views().Branches().
+				Focus().
+				NavigateToLine(Contains("master")).
+				Press(keys.Branches.RebaseBranch)
+		}
+
+		openRebaseMenu()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Rebase 'feature'")).
+			ContainsLines(
+				Contains("[ ] Reset author dates (--reset-author-date)"),
+				Contains("[ ] Keep original dates (--committer-date-is-author-date)"),
+			).
+			Select(Contains("Keep original dates")).
+			Confirm()
+
+		// the menu is shown again so that more options can be changed
+		t.ExpectPopup().Menu().
+			Title(Equals("Rebase 'feature'")).
+			ContainsLines(
+				Contains("[ ] Reset author dates (--reset-author-date)"),
+				Contains("[✓] Keep original dates (--committer-date-is-author-date)"),
+			).
+			Select(Contains("Simple rebase")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("feature 1"),
+				Contains("master 2"),
+				Contains("master 1"),
+			)
+
+		t.Git().CommitDates("HEAD", Equals("2001-01-01 2001-01-01"))
+
+		// the options are remembered
+		openRebaseMenu()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Rebase 'feature'")).
+			ContainsLines(
+				Contains("[ ] Reset author dates (--reset-author-date)"),
+				Contains("[✓] Keep original dates (--committer-date-is-author-date)"),
+			).
+			Cancel()
+	},
+})
@@ -0,0 +1,80 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RestoreDeletedBranchSnapshot = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Force delete an unmerged branch, then recreate it by restoring the snapshot saved beforehand",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.Snapshots.Enabled = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("blah").
+			NewBranch("unmerged").
+			EmptyCommit("on unmerged").
+			Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("unmerged"),
+			).
+			NavigateToLine(Contains("unmerged")).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().
+					Menu().
+					Title(Equals("Delete branch 'unmerged'?")).
+					Select(Contains("Delete local branch")).
+					Confirm()
+				t.ExpectPopup().
+					Confirmation().
+					Title(Equals("Force delete branch")).
+					Content(Equals("'unmerged' is not fully merged. Are you sure you want to delete it?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("master").IsSelected(),
+			)
+
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.ViewResetOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("")).
+			Select(Contains("Restore snapshot")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Restore snapshot")).
+			Lines(
+				Contains("Delete local branch").Contains("unmerged").IsSelected(),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master"),
+				Contains("unmerged"),
+			).
+			NavigateToLine(Contains("unmerged")).
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("on unmerged").IsSelected(),
+				Contains("blah"),
+			)
+	},
+})
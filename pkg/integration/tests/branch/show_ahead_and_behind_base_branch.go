@@ -0,0 +1,48 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowAheadAndBehindBaseBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show how far branches are ahead of and behind their base branch, and update it when a branch moves",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowDivergenceFromBaseBranch = "aheadAndBehind"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("master 1").
+			EmptyCommit("master 2").
+			EmptyCommit("master 3").
+			NewBranchFrom("behind-only", "master^").
+			NewBranchFrom("feature", "master^").
+			EmptyCommit("feature 1").
+			EmptyCommit("feature 2")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				MatchesRegexp(`feature\s+↓1↑2`).IsSelected(),
+				MatchesRegexp(`behind-only\s+↓1$`),
+				DoesNotContainAnyOf("↓", "↑").Contains("master"),
+			)
+
+		t.Shell().EmptyCommit("feature 3")
+		// In the branches view the refresh key renames the branch
+		t.Views().Files().
+			Focus().
+			Press(keys.Universal.Refresh)
+
+		t.Views().Branches().
+			Focus().
+			Lines(
+				MatchesRegexp(`feature\s+↓1↑3`).IsSelected(),
+				MatchesRegexp(`behind-only\s+↓1$`),
+				DoesNotContainAnyOf("↓", "↑").Contains("master"),
+			)
+	},
+})
@@ -0,0 +1,87 @@
+package cherry_pick
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CherryPickMarkedCommits = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Cherry pick commits that are not adjacent by marking them in the subcommits view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.LocalBranchSortOrder = "recency"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("base").
+			NewBranch("first-branch").
+			NewBranch("second-branch").
+			Checkout("first-branch").
+			EmptyCommit("one").
+			Checkout("second-branch").
+			EmptyCommit("two").
+			EmptyCommit("three").
+			EmptyCommit("four").
+			Checkout("first-branch")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("first-branch"),
+				Contains("second-branch"),
+				Contains("master"),
+			).
+			SelectNextItem().
+			PressEnter()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("four").IsSelected(),
+				Contains("three"),
+				Contains("two"),
+				Contains("base"),
+			).
+			// mark commits 'four' and 'two'
+			Press(keys.Commits.ToggleCommitMarked).
+			NavigateToLine(Contains("two")).
+			Press(keys.Commits.ToggleCommitMarked).
+			Lines(
+				Contains("◆ four"),
+				Contains("three").DoesNotContain("◆"),
+				Contains("◆ two").IsSelected(),
+				Contains("base"),
+			).
+			Press(keys.Commits.CherryPickCopy).
+			Lines(
+				Contains("four").DoesNotContain("◆"),
+				Contains("three"),
+				Contains("two").DoesNotContain("◆").IsSelected(),
+				Contains("base"),
+			)
+
+		t.Views().Information().Content(Contains("2 commits copied"))
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("one").IsSelected(),
+				Contains("base"),
+			).
+			Press(keys.Commits.PasteCommits).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Cherry-pick")).
+					Content(Contains("Are you sure you want to cherry-pick the 2 copied commit(s) onto this branch?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("four"),
+				Contains("two"),
+				Contains("one").IsSelected(),
+				Contains("base"),
+			)
+	},
+})
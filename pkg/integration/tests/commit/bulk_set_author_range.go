@@ -0,0 +1,66 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var BulkSetAuthorRange = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Rewrite the author of a range of commits in a single rebase",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("user.email", "Bill@example.com")
+		shell.SetConfig("user.name", "Bill Smith")
+
+		shell.EmptyCommit("fourth")
+		shell.EmptyCommit("third")
+		shell.EmptyCommit("second")
+		shell.EmptyCommit("first")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("BS").Contains("first").IsSelected(),
+				Contains("BS").Contains("second"),
+				Contains("BS").Contains("third"),
+				Contains("BS").Contains("fourth"),
+			).
+			SelectNextItem().
+			Press(keys.Universal.ToggleRangeSelect).
+			SelectNextItem().
+			Press(keys.Commits.ResetCommitAuthor).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Amend commit attribute")).
+					Select(Contains("Bulk rewrite author")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Select author")).
+					Select(Contains("Enter new author...")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Contains("Set author")).
+					Type("John Smith <John@example.com>").
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Rewrite history")).
+					Content(Contains("this will rewrite the author of 2 commit(s) to 'John Smith <John@example.com>'")).
+					Confirm()
+			}).
+			PressEscape().
+			Lines(
+				Contains("BS").Contains("first"),
+				Contains("JS").Contains("second"),
+				Contains("JS").Contains("third").IsSelected(),
+				Contains("BS").Contains("fourth"),
+			)
+
+		t.Git().CurrentBranchName("master")
+	},
+})
@@ -0,0 +1,73 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitDetailsHeader = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a header with the commit's metadata in the main view, and click a parent to select it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowCommitDetailsHeader = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CreateLightweightTag("v1.0", "HEAD")
+		shell.EmptyCommit("two")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("three\n\nSome body\n\nSigned-off-by: John Doe <john@example.com>")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("three").IsSelected(),
+				Contains("two"),
+				Contains("one"),
+			)
+
+		t.Views().Main().
+			ContainsLines(
+				Contains("Commit:"),
+				Contains("Author:"),
+				Contains("Committer:"),
+				Contains("Parents:"),
+				Equals("Branches:  feature"),
+				Equals(""),
+				Equals("    three"),
+				Equals(""),
+				Equals("    Some body"),
+				Equals(""),
+				Equals("    Signed-off-by: John Doe <john@example.com>"),
+			).
+			Content(DoesNotContain("Tags:"))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("one"))
+
+		t.Views().Main().
+			ContainsLines(
+				Equals("Branches:  feature, master"),
+				Equals("Tags:      v1.0"),
+			).
+			Content(DoesNotContain("Parents:"))
+
+		t.Views().Commits().
+			NavigateToLine(Contains("three"))
+
+		// Click the parent hash
+		t.Views().Main().
+			Content(Contains("Parents:")).
+			Click(11, 3)
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("three"),
+				Contains("two").IsSelected(),
+				Contains("one"),
+			)
+	},
+})
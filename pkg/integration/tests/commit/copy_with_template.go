@@ -0,0 +1,68 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+// We're emulating the clipboard by writing to a file called clipboard
+
+var CopyWithTemplate = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Copy a commit and a branch to the clipboard using custom copy templates",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().OS.CopyToClipboardCmd = "printf '%s' {{text}} > clipboard"
+		cfg.GetUserConfig().CopyTemplates.Commits = []config.CopyTemplate{
+			{Description: "Reference", Template: "{{.Subject}} by {{.Author}} <{{.AuthorEmail}}>", Key: "r"},
+		}
+		cfg.GetUserConfig().CopyTemplates.Branches = []config.CopyTemplate{
+			{Description: "Markdown", Template: "`{{.Name}}`: {{.Subject}}"},
+		}
+	},
+
+	SetupRepo: func(shell *Shell) {
+		shell.SetAuthor("John Doe", "john@doe.com")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("add the feature")
+	},
+
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("add the feature").IsSelected(),
+			).
+			Press(keys.Commits.CopyCommitAttributeToClipboard)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Copy to clipboard")).
+			Select(Contains("Reference")).
+			Confirm()
+
+		t.ExpectToast(Equals("'add the feature by John Doe <john@doe.com>' copied to clipboard"))
+
+		t.FileSystem().FileContent("clipboard", Equals("add the feature by John Doe <john@doe.com>"))
+
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("feature").IsSelected(),
+			).
+			Press(keys.Universal.CopyToClipboard)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Copy to clipboard")).
+			Lines(
+				Contains("Branch name"),
+				Contains("Markdown"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Markdown")).
+			Confirm()
+
+		t.ExpectToast(Equals("'`feature`: add the feature' copied to clipboard"))
+
+		t.FileSystem().FileContent("clipboard", Equals("`feature`: add the feature"))
+	},
+})
@@ -0,0 +1,71 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FindContainingRefs = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "List the branches and tags containing a commit, then check one out and diff against another",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+		shell.CreateLightweightTag("v1.0", "HEAD")
+		shell.CloneIntoRemote("origin")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("three")
+		shell.Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("two").IsSelected(),
+				Contains("one"),
+			).
+			NavigateToLine(Contains("one")).
+			Press(keys.Commits.FindContainingRefs)
+
+		t.ExpectPopup().Menu().
+			Title(Contains("Branches and tags containing")).
+			Lines(
+				Contains("branch        feature").IsSelected(),
+				Contains("branch        master"),
+				Contains("remote branch origin/master"),
+				Contains("tag           v1.0"),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("feature")).
+			Select(Contains("Checkout")).
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("feature").IsSelected(),
+				Contains("master"),
+			)
+
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("one")).
+			Press(keys.Commits.FindContainingRefs)
+
+		t.ExpectPopup().Menu().
+			Title(Contains("Branches and tags containing")).
+			Select(Contains("v1.0")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("v1.0")).
+			Select(Contains("Diff v1.0")).
+			Confirm()
+
+		t.Views().Information().Content(Contains("Showing output for: git diff --stat -p v1.0"))
+	},
+})
@@ -0,0 +1,57 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var GoToCommit = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Jump to a commit by ref, including one that is outside of the initially loaded commits",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.CreateLightweightTag("old-tag", "HEAD")
+		shell.RunShellCommand(`for i in $(seq 1 305); do git commit --allow-empty -q -m "commit $i"; done`)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("commit 305")).
+			Press(keys.Commits.GoToCommit)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Go to commit (hash, branch or tag):")).
+			Type("HEAD~2").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			SelectedLine(Contains("commit 303")).
+			Press(keys.Commits.GoToCommit)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Go to commit (hash, branch or tag):")).
+			Type("nope").
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Could not find a commit for 'nope'")).
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Press(keys.Commits.GoToCommit)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Go to commit (hash, branch or tag):")).
+			Type("old-tag").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			SelectedLine(Contains("first commit"))
+	},
+})
@@ -0,0 +1,44 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ResetAuthorDate = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Reset the author date of a commit that isn't the head commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("user.email", "Bill@example.com")
+		shell.SetConfig("user.name", "Bill Smith")
+
+		shell.EmptyCommitWithDate("two", "2001-01-01T12:00:00")
+		shell.EmptyCommitWithDate("one", "2001-01-02T12:00:00")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("one").IsSelected(),
+				Contains("two"),
+			).
+			SelectNextItem().
+			Press(keys.Commits.ResetCommitAuthor).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Amend commit attribute")).
+					Select(Contains("Renew author date")).
+					Confirm()
+			}).
+			Lines(
+				Contains("BS").Contains("one"),
+				Contains("BS").Contains("two").IsSelected(),
+			)
+
+		t.Git().CommitDates("HEAD^", DoesNotContain("2001-01-01"))
+		// the author date of the other commit is unchanged
+		t.Git().CommitDates("HEAD", MatchesRegexp(`^2001-01-02 `))
+	},
+})
@@ -0,0 +1,58 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SelectAuthor = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Set the author of a commit by picking one of the known authors",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("user.email", "John@example.com")
+		shell.SetConfig("user.name", "John Smith")
+
+		shell.EmptyCommit("two")
+
+		shell.SetConfig("user.email", "Bill@example.com")
+		shell.SetConfig("user.name", "Bill Smith")
+
+		shell.EmptyCommit("one")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("BS").Contains("one").IsSelected(),
+				Contains("JS").Contains("two"),
+			).
+			Press(keys.Commits.ResetCommitAuthor).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Amend commit attribute")).
+					Select(Contains("Select author")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Select author")).
+					Lines(
+						Contains("Enter new author..."),
+						Contains("Bill Smith <Bill@example.com>"),
+						Contains("John Smith <John@example.com>"),
+						Contains("Cancel"),
+					).
+					Select(Contains("John Smith")).
+					Confirm()
+			}).
+			Lines(
+				Contains("JS").Contains("one").IsSelected(),
+				Contains("JS").Contains("two"),
+			)
+
+		t.Views().Main().ContainsLines(
+			Equals("Author: John Smith <John@example.com>"),
+		)
+	},
+})
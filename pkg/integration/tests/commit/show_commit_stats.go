@@ -0,0 +1,31 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ShowCommitStats = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the number of files changed, insertions and deletions of each commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowCommitStats = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\ntwo\nthree\n")
+		shell.CreateFileAndAdd("file2", "four\n")
+		shell.Commit("add files")
+		shell.UpdateFileAndAdd("file1", "one\n")
+		shell.Commit("shorten file1")
+		shell.EmptyCommit("empty")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Lines(
+				Contains("empty").DoesNotContain("+"),
+				Contains("1f +0 -2").Contains("shorten file1"),
+				Contains("2f +4 -0").Contains("add files"),
+			)
+	},
+})
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Profiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Switch between config profiles at runtime",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Profiles = map[string]map[string]any{
+			"demo": {
+				"gui": map[string]any{"showCommandLog": false},
+				"keybinding": map[string]any{
+					"status": map[string]any{"switchProfile": "C"},
+				},
+			},
+			"work": {
+				"gui": map[string]any{"showFileTree": false},
+			},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.SwitchProfile)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Config profile")).
+			Lines(
+				Equals("(•) (none)"),
+				Equals("( ) demo"),
+				Equals("( ) work"),
+				Contains("Cancel"),
+			).
+			Select(Contains("demo")).
+			Tooltip(Contains("showCommandLog: false")).
+			Confirm()
+
+		t.ExpectToast(Equals("Switched to profile 'demo'"))
+
+		t.Views().Status().
+			IsFocused().
+			// The profile's keybindings are active
+			Press("C")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Config profile")).
+			Lines(
+				Equals("( ) (none)"),
+				Equals("(•) demo"),
+				Equals("( ) work"),
+				Contains("Cancel"),
+			).
+			Select(Contains("(none)")).
+			Confirm()
+
+		t.ExpectToast(Equals("Switched to no profile"))
+
+		t.Views().Status().
+			Press(keys.Status.SwitchProfile)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Config profile")).
+			TopLines(
+				Equals("(•) (none)"),
+			).
+			Cancel()
+	},
+})
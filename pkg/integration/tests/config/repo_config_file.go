@@ -0,0 +1,56 @@
+package config
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RepoConfigFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "A .lazygit.yml checked into the repo is loaded once the user trusts it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd(".lazygit.yml", `
+git:
+  protectedBranches:
+    - release/*
+customCommands:
+  - key: Z
+    context: global
+    command: printf 'repo Z' > file.txt`)
+		shell.Commit("add repo config")
+		shell.NewBranch("release/1.0")
+		shell.NewBranch("feature")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Load repository config")).
+			Content(Contains(".lazygit.yml")).
+			Confirm()
+
+		t.GlobalPress("Z")
+		t.FileSystem().FileContent("file.txt", Equals("repo Z"))
+
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("feature").IsSelected(),
+				Contains("master"),
+				Contains("release/1.0"),
+			).
+			NavigateToLine(Contains("release/1.0")).
+			Press(keys.Universal.Remove)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Delete branch 'release/1.0'?")).
+			Select(Contains("Delete local branch")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("'release/1.0' is a protected branch and can't be deleted. See the git.protectedBranches config.")).
+			Confirm()
+	},
+})
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Themes = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Switch between built-in themes and theme files at runtime",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		themesDir := filepath.Join(cfg.GetUserConfigDir(), "themes")
+		_ = os.MkdirAll(themesDir, 0o755)
+		_ = os.WriteFile(filepath.Join(themesDir, "solarized.yml"), []byte("activeBorderColor:\n  - '#268bd2'\n"), 0o644)
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.SwitchTheme)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Theme")).
+			Lines(
+				Equals("(•) default").IsSelected(),
+				Equals("( ) colorblind"),
+				Equals("( ) highContrast"),
+				Equals("( ) solarized"),
+				Contains("Cancel"),
+			).
+			// Previewing a theme doesn't switch to it
+			Select(Contains("highContrast")).
+			Cancel()
+
+		t.Views().Status().
+			IsFocused().
+			Press(keys.Status.SwitchTheme)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Theme")).
+			TopLines(
+				Equals("(•) default").IsSelected(),
+			).
+			Select(Contains("solarized")).
+			Confirm()
+
+		t.ExpectToast(Equals("Switched to theme 'solarized'"))
+
+		t.Views().Status().
+			IsFocused().
+			Press(keys.Status.SwitchTheme)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Theme")).
+			Lines(
+				Equals("( ) default"),
+				Equals("( ) colorblind"),
+				Equals("( ) highContrast"),
+				Equals("(•) solarized").IsSelected(),
+				Contains("Cancel"),
+			).
+			Select(Contains("colorblind")).
+			Confirm()
+
+		t.ExpectToast(Equals("Switched to theme 'colorblind'"))
+	},
+})
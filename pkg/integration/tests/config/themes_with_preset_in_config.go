@@ -0,0 +1,52 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ThemesWithPresetInConfig = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "The themes menu doesn't offer presets other than the one that the config sets",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Gui.Theme.Preset = "highContrast"
+		_ = os.WriteFile(filepath.Join(cfg.GetUserConfigDir(), config.ConfigFilename), []byte("gui:\n  theme:\n    preset: highContrast\n"), 0o644)
+
+		themesDir := filepath.Join(cfg.GetUserConfigDir(), "themes")
+		_ = os.MkdirAll(themesDir, 0o755)
+		_ = os.WriteFile(filepath.Join(themesDir, "solarized.yml"), []byte("activeBorderColor:\n  - '#268bd2'\n"), 0o644)
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.SwitchTheme)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Theme")).
+			Lines(
+				Equals("( ) default"),
+				Equals("( ) colorblind"),
+				Equals("(•) highContrast").IsSelected(),
+				Equals("( ) solarized"),
+				Contains("Cancel"),
+			).
+			Select(Contains("colorblind")).
+			Confirm()
+
+		t.ExpectToast(Equals("Disabled: Your config sets `gui.theme.preset` to 'highContrast', which takes precedence over the presets in this menu. Remove it from your config to switch presets here."))
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Theme")).
+			Select(Contains("solarized")).
+			Confirm()
+
+		t.ExpectToast(Equals("Switched to theme 'solarized'"))
+	},
+})
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UntrustedRepoConfigFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "A .lazygit.yml checked into the repo is not loaded if the user doesn't trust it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd(".lazygit.yml", `
+customCommands:
+  - key: Z
+    context: global
+    command: printf 'repo Z' > file.txt`)
+		shell.Commit("add repo config")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Load repository config")).
+			Content(Contains(".lazygit.yml")).
+			Cancel()
+
+		t.GlobalPress("Z")
+		t.FileSystem().PathNotPresent("file.txt")
+	},
+})
@@ -0,0 +1,62 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var AutoContinueAfterResolving = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Turn on auto-continue for the session and check that the merge continues without prompting once the last conflict is resolved",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFiles(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  UU file1"),
+				Equals("  UU file2"),
+			).
+			Press(keys.Universal.CreateRebaseOptionsMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Merge options")).
+			Select(Contains("Continue automatically when all conflicts are resolved")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			NavigateToLine(Contains("file1")).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			PressPrimaryAction()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("UU file2").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().MergeConflicts().
+			IsFocused().
+			PressPrimaryAction()
+
+		// no prompt to continue: the merge is concluded right away
+		t.Views().Files().
+			IsFocused().
+			IsEmpty()
+
+		t.Views().Commits().
+			TopLines(
+				Contains("Merge branch 'second-change-branch' into first-change-branch"),
+			)
+	},
+})
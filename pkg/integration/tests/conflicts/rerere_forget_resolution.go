@@ -0,0 +1,86 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var RerereForgetResolution = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show that rerere resolved a conflict automatically, and forget that resolution",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("rerere.enabled", "true")
+
+		// Resolve the conflict once so that rerere records the resolution,
+		// then undo the merge and do it again
+		shared.CreateMergeConflictFile(shell)
+		shell.UpdateFileAndAdd("file", "resolved\n")
+		shell.ContinueMerge()
+		shell.HardReset("HEAD~1")
+		shell.RunCommandExpectError([]string{"git", "merge", "--no-edit", "second-change-branch"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.FileSystem().FileContent("file", Equals("resolved\n"))
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("resolved automatically by rerere"))
+
+		t.Views().Files().
+			Press(keys.Files.ViewRerereOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Rerere (reuse recorded resolution)")).
+			TopLines(
+				Contains("[✓]").Contains("Enable rerere"),
+				Contains("--- Resolved by rerere in this merge ---"),
+				Contains("file"),
+				Equals("  "),
+				Contains("--- Recorded resolutions ---"),
+				Contains("First Change"),
+			).
+			Select(Contains("file")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Forget resolution")).
+			Content(Contains("forget the recorded resolution for file")).
+			Confirm()
+
+		t.FileSystem().FileContent("file", Contains("<<<<<<<"))
+
+		t.Views().Files().
+			Lines(
+				Contains("UU file"),
+			)
+
+		t.Views().Main().
+			Content(DoesNotContain("rerere"))
+
+		t.Views().Files().
+			Press(keys.Files.ViewRerereOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Rerere (reuse recorded resolution)")).
+			TopLines(
+				Contains("[✓]").Contains("Enable rerere").IsSelected(),
+				Contains("--- Recorded resolutions ---"),
+				Contains("No recorded resolutions"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Rerere (reuse recorded resolution)")).
+			TopLines(
+				Contains("[ ]").Contains("Enable rerere"),
+			)
+	},
+})
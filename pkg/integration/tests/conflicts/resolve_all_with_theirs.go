@@ -0,0 +1,44 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var ResolveAllWithTheirs = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Resolve all conflicted files at once by taking their version",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFiles(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  UU file1"),
+				Equals("  UU file2"),
+			).
+			Press(keys.Files.ResolveAllConflicts)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Resolve all conflicts")).
+			Select(Contains("Take theirs for all conflicted files")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Resolve all conflicts")).
+			Content(Contains("taking their version").Contains("UU file1\nUU file2")).
+			Confirm()
+
+		t.Common().ContinueOnConflictsResolved("merge")
+
+		t.Views().Files().IsEmpty()
+
+		t.FileSystem().FileContent("file1", Equals(shared.SecondChangeFileContent))
+		t.FileSystem().FileContent("file2", Equals(shared.SecondChangeFileContent))
+	},
+})
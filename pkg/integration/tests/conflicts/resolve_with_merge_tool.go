@@ -0,0 +1,60 @@
+package conflicts
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/shared"
+)
+
+var ResolveWithMergeTool = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Open a single conflicted file in the configured merge tool and have it staged when the tool succeeds",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.MergeTool = "take-theirs"
+	},
+	SetupRepo: func(shell *Shell) {
+		shared.CreateMergeConflictFiles(shell)
+
+		// a "merge tool" that simply takes their version of the file
+		shell.SetConfig("mergetool.take-theirs.cmd", `cp "$REMOTE" "$MERGED"`)
+		shell.SetConfig("mergetool.take-theirs.trustExitCode", "true")
+		shell.SetConfig("mergetool.keepBackup", "false")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  UU file1"),
+				Equals("  UU file2"),
+			).
+			Press(keys.Files.OpenMergeToolForFile).
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: The selected file has no merge conflicts"))
+			}).
+			NavigateToLine(Contains("file2")).
+			Press(keys.Files.OpenMergeToolForFile).
+			// file2 is no longer conflicted, so it's hidden by the conflicts filter
+			Lines(
+				Equals("UU file1").IsSelected(),
+			)
+
+		t.Views().Files().
+			Press(keys.Files.OpenStatusFilter).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Filtering")).
+					Select(Contains("No filter")).
+					Confirm()
+			}).
+			Lines(
+				Equals("▼ /"),
+				Equals("  UU file1"),
+				Equals("  M  file2"),
+				Equals("  A  file3"),
+			)
+
+		t.FileSystem().FileContent("file2", Equals(shared.SecondChangeFileContent))
+	},
+})
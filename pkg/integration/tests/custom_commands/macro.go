@@ -0,0 +1,66 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Macro = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Using a custom command to run a sequence of built-in actions, stopping at the first one that is disabled",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFile("file1", "content")
+		shell.CreateFile("file2", "content")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().CustomCommands = []config.CustomCommand{
+			{
+				Key:         "X",
+				Context:     "files",
+				Description: "Stage all and commit",
+				Macro:       []string{"a", "c"},
+			},
+			{
+				Key:         "Y",
+				Context:     "files",
+				Description: "Stage selected and commit",
+				Macro:       []string{"<space>", "c"},
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /"),
+				Equals("  ?? file1"),
+				Equals("  ?? file2"),
+			).
+			Press("X")
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("my commit").
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("my commit"),
+				Contains("initial commit"),
+			)
+
+		t.Views().Files().
+			IsFocused().
+			IsEmpty().
+			Press("Y")
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Macro stopped at '<space>': No item selected")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused()
+	},
+})
@@ -0,0 +1,91 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PromptValidationAndConditions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Using a custom command with validated input, an initial value from a command, and a conditional prompt",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("blah")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().CustomCommands = []config.CustomCommand{
+			{
+				Key:     "a",
+				Context: "files",
+				Command: `echo "{{.Form.Type}} {{.Form.Ticket}} {{.Form.Name}}" > result`,
+				Prompts: []config.CustomCommandPrompt{
+					{
+						Key:   "Type",
+						Type:  "menu",
+						Title: "Type",
+						Options: []config.CustomCommandMenuOption{
+							{Value: "feature"},
+							{Value: "chore"},
+						},
+					},
+					{
+						Key:               "Ticket",
+						Type:              "input",
+						Title:             "Ticket",
+						ValidationRegex:   "^[A-Z]+-[0-9]+$",
+						ValidationMessage: "Enter a ticket number like ABC-123",
+						Condition: &config.CustomCommandPromptCondition{
+							Key:     "Type",
+							Matches: "^feature$",
+						},
+					},
+					{
+						Key:                 "Name",
+						Type:                "input",
+						Title:               "Name",
+						InitialValueCommand: "echo '  {{.Form.Type}}-name  '",
+					},
+				},
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsEmpty().
+			IsFocused().
+			Press("a")
+
+		t.ExpectPopup().Menu().Title(Equals("Type")).Select(Contains("feature")).Confirm()
+
+		t.ExpectPopup().Prompt().Title(Equals("Ticket")).Type("abc-123").Confirm()
+
+		t.ExpectToast(Equals("Enter a ticket number like ABC-123"))
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Ticket")).
+			InitialText(Equals("abc-123")).
+			Clear().
+			Type("ABC-123").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Name")).
+			InitialText(Equals("feature-name")).
+			Confirm()
+
+		t.FileSystem().FileContent("result", Equals("feature ABC-123 feature-name\n"))
+
+		t.Views().Files().
+			Press("a")
+
+		// The ticket prompt is skipped for chores
+		t.ExpectPopup().Menu().Title(Equals("Type")).Select(Contains("chore")).Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Name")).
+			InitialText(Equals("chore-name")).
+			Confirm()
+
+		t.FileSystem().FileContent("result", Equals("chore  chore-name\n"))
+	},
+})
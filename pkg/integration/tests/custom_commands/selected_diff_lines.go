@@ -0,0 +1,61 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SelectedDiffLines = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Use the {{ .SelectedDiffLines }} template variable in the staging view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Gui.UseHunkModeInStagingView = false
+		cfg.GetUserConfig().CustomCommands = []config.CustomCommand{
+			{
+				Key:     "X",
+				Context: "staging, stagingSecondary",
+				Command: "printf '%s %s-%s %s\\n%s' {{.SelectedDiffLines.Path | quote}} {{.SelectedDiffLines.FirstLineNumber}} {{.SelectedDiffLines.LastLineNumber}} {{.SelectedDiffLines.Staged}} {{.SelectedDiffLines.Content | quote}} > ../output.txt",
+			},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\ntwo\nthree\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "one\nzwei\ndrei\nvier\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(
+				Contains("-two"),
+			).
+			NavigateToLine(Contains("+zwei")).
+			Press(keys.Universal.ToggleRangeSelect).
+			NavigateToLine(Contains("+vier")).
+			Press("X")
+
+		t.FileSystem().FileContent("../output.txt", Equals("file1 2-4 false\n+zwei\n+drei\n+vier\n"))
+
+		t.Views().Staging().
+			PressPrimaryAction().
+			Press(keys.Universal.TogglePanel)
+
+		t.Views().StagingSecondary().
+			IsFocused().
+			NavigateToLine(Contains("+drei")).
+			Press("X")
+
+		// Only the additions were staged, so the staged version of the file
+		// still has the deleted lines before them
+		t.FileSystem().FileContent("../output.txt", Equals("file1 5-5 true\n+drei\n"))
+	},
+})
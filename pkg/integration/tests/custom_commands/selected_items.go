@@ -0,0 +1,82 @@
+package custom_commands
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SelectedItems = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Use the template variables for the items of a range selection in different contexts",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(3)
+		shell.NewBranch("branch-a")
+		shell.NewBranch("branch-b")
+		shell.CreateDir("dir")
+		shell.CreateFile("dir/file1", "")
+		shell.CreateFile("dir/file2", "")
+		shell.CreateFile("file3", "")
+	},
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().CustomCommands = []config.CustomCommand{
+			{
+				Key:     "X",
+				Context: "files",
+				Command: "printf '%s\\n' {{range .SelectedPaths}}{{. | quote}} {{end}}-- {{range .SelectedFiles}}{{.Name | quote}} {{end}} > ../output.txt",
+			},
+			{
+				Key:     "X",
+				Context: "localBranches",
+				Command: "printf '%s\\n' {{range .SelectedLocalBranches}}{{.Name | quote}} {{end}} > ../output.txt",
+			},
+			{
+				Key:     "X",
+				Context: "commits",
+				Command: "printf '%s\\n' {{range .SelectedCommits}}{{.Name | quote}} {{end}} > ../output.txt",
+			},
+		}
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  ▼ dir"),
+				Equals("    ?? file1"),
+				Equals("    ?? file2"),
+				Equals("  ?? file3"),
+			).
+			NavigateToLine(Contains("dir")).
+			Press(keys.Universal.RangeSelectDown).
+			Press("X")
+
+		// The files of a selected directory are included in SelectedFiles
+		t.FileSystem().FileContent("../output.txt", Equals("dir\ndir/file1\n--\ndir/file1\ndir/file2\n"))
+
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("branch-b").IsSelected(),
+				Contains("branch-a"),
+				Contains("master"),
+			).
+			Press(keys.Universal.RangeSelectDown).
+			Press("X")
+
+		t.FileSystem().FileContent("../output.txt", Equals("branch-b\nbranch-a\n"))
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Universal.RangeSelectDown).
+			Press("X")
+
+		t.FileSystem().FileContent("../output.txt", Equals("commit 03\ncommit 02\ncommit 01\n"))
+	},
+})
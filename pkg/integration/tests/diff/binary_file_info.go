@@ -0,0 +1,67 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var BinaryFileInfo = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the size and type of the old and new version of a binary file instead of its diff",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("data.bin", "\x00\x01\x02")
+		shell.Commit("first commit")
+		shell.UpdateFile("data.bin", "\x00\x01\x02\x03\x04")
+		shell.CreateFile("new.bin", "\x00\x01")
+		shell.CreateFile("text.txt", "hello\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("   M data.bin"),
+				Equals("  ?? new.bin"),
+				Equals("  ?? text.txt"),
+			).
+			NavigateToLine(Contains("data.bin"))
+
+		t.Views().Main().
+			Content(Contains("Binary file data.bin")).
+			Content(Contains("Size 3 B                      5 B (+2 B)")).
+			Content(Contains("Type application/octet-stream application/octet-stream"))
+
+		t.Views().Files().
+			NavigateToLine(Contains("new.bin"))
+
+		t.Views().Main().
+			Content(Contains("Binary file new.bin")).
+			Content(Contains("Size -   2 B"))
+
+		t.Views().Files().
+			NavigateToLine(Contains("text.txt"))
+
+		t.Views().Main().
+			Content(DoesNotContain("Binary file")).
+			Content(Contains("+hello"))
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("first commit").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Contains("data.bin").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(Contains("Binary file data.bin")).
+			Content(Contains("Size -   3 B"))
+	},
+})
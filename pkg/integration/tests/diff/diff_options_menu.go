@@ -0,0 +1,79 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DiffOptionsMenu = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Change the diff options from the diff options menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "line1\nline2\nline3\nline4\nline5\nline6\nline7\nline8\nline9\nline10\n")
+		shell.Commit("initial commit")
+		// Add a blank line near the top and change a line at the bottom, far
+		// enough apart to end up in separate hunks
+		shell.UpdateFile("myfile", "line1\n\nline2\nline3\nline4\nline5\nline6\nline7\nline8\nline9\nchanged10\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Main().
+			Content(Contains("@@ -1,4 +1,5 @@")).
+			Content(Contains("+changed10"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.OpenDiffOptionsMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diff options")).
+			ContainsLines(
+				Contains("[ ] Ignore whitespace"),
+				Contains("[ ] Ignore blank lines"),
+			).
+			Select(Contains("Ignore blank lines")).
+			Confirm()
+
+		t.Views().Main().
+			Content(DoesNotContain("@@ -1,4 +1,5 @@")).
+			Content(Contains("+changed10"))
+
+		t.Views().Files().
+			Press(keys.Universal.OpenDiffOptionsMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diff options")).
+			ContainsLines(
+				Contains("[✓] Ignore blank lines"),
+			).
+			ContainsLines(
+				Contains("(•) Default"),
+				Contains("( ) myers"),
+				Contains("( ) minimal"),
+				Contains("( ) patience"),
+				Contains("( ) histogram"),
+			).
+			Select(Contains("histogram")).
+			Confirm()
+
+		t.Views().Files().
+			Press(keys.Universal.OpenDiffOptionsMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Diff options")).
+			ContainsLines(
+				Contains("( ) Default"),
+				Contains("( ) myers"),
+				Contains("( ) minimal"),
+				Contains("( ) patience"),
+				Contains("(•) histogram"),
+			).
+			Select(Contains("Ignore blank lines")).
+			Confirm()
+
+		t.Views().Main().
+			Content(Contains("@@ -1,4 +1,5 @@")).
+			Content(Contains("+changed10"))
+	},
+})
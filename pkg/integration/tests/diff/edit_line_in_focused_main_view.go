@@ -0,0 +1,76 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+func hundredLines(change func(lineNumber int) string) string {
+	lines := make([]string, 100)
+	for i := range lines {
+		lines[i] = change(i + 1)
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+var EditLineInFocusedMainView = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Edit the file at the diff line shown at the top of the focused main view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().OS.EditAtLine = "echo {{filename}}:{{line}} > edit-command"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file.txt", hundredLines(func(lineNumber int) string {
+			return fmt.Sprintf("line %d", lineNumber)
+		}))
+		shell.Commit("01")
+		// Change every tenth line so that the diff consists of many hunks
+		shell.UpdateFileAndAdd("file.txt", hundredLines(func(lineNumber int) string {
+			if lineNumber%10 == 0 {
+				return fmt.Sprintf("changed %d", lineNumber)
+			}
+			return fmt.Sprintf("line %d", lineNumber)
+		}))
+		shell.Commit("02")
+		// Insert two lines at the top of the working copy, so that the line
+		// numbers of the commit's diff need to be adjusted
+		shell.UpdateFile("file.txt", "new 1\nnew 2\n"+hundredLines(func(lineNumber int) string {
+			if lineNumber%10 == 0 {
+				return fmt.Sprintf("changed %d", lineNumber)
+			}
+			return fmt.Sprintf("line %d", lineNumber)
+		}))
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("02").IsSelected(),
+				Contains("01"),
+			).
+			Press(keys.Universal.FocusMainView)
+
+		// The top of the view shows the commit message, so the first hunk is
+		// used
+		t.Views().Main().
+			IsFocused().
+			Press(keys.Universal.Edit)
+
+		t.FileSystem().FileContent("edit-command", Contains("file.txt:9\n"))
+
+		// Scroll down so that the line 'line 12' of the first hunk is at the
+		// top of the view
+		for range 20 {
+			t.Views().Main().Press(keys.Universal.NextItem)
+		}
+
+		t.Views().Main().
+			Press(keys.Universal.Edit)
+
+		t.FileSystem().FileContent("edit-command", Contains("file.txt:14\n"))
+	},
+})
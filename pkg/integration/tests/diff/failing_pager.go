@@ -0,0 +1,33 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/samber/lo"
+)
+
+var FailingPager = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the diff without the pager if the pager fails",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.Paging.Pager = "sh -c 'echo the pager failed; exit 1'"
+	},
+	SetupRepo: func(shell *Shell) {
+		// The diff needs to be big enough that git is still writing to the
+		// pager when it exits
+		lines := lo.Times(5000, func(i int) string { return fmt.Sprintf("line %d", i+1) })
+		shell.CreateFileAndAdd("myfile", strings.Join(lines, "\n")+"\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused()
+
+		t.Views().Main().
+			Content(Contains("+line 1")).
+			Content(DoesNotContain("the pager failed"))
+	},
+})
@@ -0,0 +1,65 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FileTypeDiffCommand = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the diff of a file using the external diff command configured for its file type",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.Paging.FileTypeDiffCommands = []config.FileTypeDiffCommand{
+			{Extensions: []string{"png"}, Command: "echo image diff of"},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("image.png", "old image\n")
+		shell.CreateFileAndAdd("text.txt", "old text\n")
+		shell.Commit("first commit")
+		shell.UpdateFile("image.png", "new image\n")
+		shell.UpdateFile("text.txt", "new text\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("   M image.png"),
+				Equals("   M text.txt"),
+			).
+			NavigateToLine(Contains("image.png"))
+
+		t.Views().Main().
+			Content(Contains("image diff of image.png")).
+			Content(DoesNotContain("+new image"))
+
+		t.Views().Files().
+			NavigateToLine(Contains("text.txt"))
+
+		t.Views().Main().
+			Content(DoesNotContain("diff of")).
+			Content(Contains("+new text"))
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("first commit").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			NavigateToLine(Contains("image.png"))
+
+		t.Views().Main().
+			Content(Contains("image diff of image.png"))
+
+		t.Views().CommitFiles().
+			NavigateToLine(Contains("text.txt"))
+
+		t.Views().Main().
+			Content(Contains("+old text"))
+	},
+})
@@ -0,0 +1,28 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MissingPager = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the diff without the pager if the pager's executable can't be found",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.Paging.Pager = "lazygit-nonexistent-pager --paging=never"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "first line\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("myfile", "first line\nsecond line\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused()
+
+		t.Views().Main().
+			Content(Contains("+second line")).
+			Content(DoesNotContain("lazygit-nonexistent-pager"))
+	},
+})
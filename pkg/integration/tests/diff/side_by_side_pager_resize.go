@@ -0,0 +1,36 @@
+package diff
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SideBySidePagerResize = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Render the diff again when the main view is resized if the pager renders side by side",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		// A pager that tells us which width it was given
+		cfg.GetUserConfig().Git.Paging.Pager = "sh -c 'echo pager width: $COLUMNS; cat'"
+		cfg.GetUserConfig().Git.Paging.SideBySide = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("myfile", "first line\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("myfile", "first line\nsecond line\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Main().
+			Content(Contains("pager width: 98")).
+			Content(Contains("+second line"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.NextScreenMode)
+
+		t.Views().Main().
+			Content(DoesNotContain("pager width: 98")).
+			Content(Contains("pager width: ")).
+			Content(Contains("+second line"))
+	},
+})
@@ -0,0 +1,60 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CollapsedDirsSurviveRefresh = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Collapsed directories in the file tree stay collapsed when files change",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowRootItemInFileTree = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateDir("dir")
+		shell.CreateFile("dir/file-one", "original content\n")
+		shell.CreateDir("dir2")
+		shell.CreateFile("dir2/file-two", "original content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ dir").IsSelected(),
+				Equals("  ?? file-one"),
+				Equals("▼ dir2"),
+				Equals("  ?? file-two"),
+			).
+			PressEnter().
+			Lines(
+				Equals("▶ dir").IsSelected(),
+				Equals("▼ dir2"),
+				Equals("  ?? file-two"),
+			)
+
+		t.Shell().CreateFile("dir/file-three", "new content\n")
+
+		t.Views().Files().
+			Press(keys.Files.RefreshFiles).
+			Lines(
+				Equals("▶ dir").IsSelected(),
+				Equals("▼ dir2"),
+				Equals("  ?? file-two"),
+			).
+			Press(keys.Files.CollapseAll).
+			Lines(
+				Equals("▶ dir").IsSelected(),
+				Equals("▶ dir2"),
+			).
+			Press(keys.Files.ExpandAll).
+			Lines(
+				Equals("▼ dir").IsSelected(),
+				Equals("  ?? file-one"),
+				Equals("  ?? file-three"),
+				Equals("▼ dir2"),
+				Equals("  ?? file-two"),
+			)
+	},
+})
@@ -0,0 +1,58 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var IntentToAdd = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Mark a new file as intent-to-add and stage only some of its lines",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowRootItemInFileTree = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("existing.txt", "existing")
+		shell.Commit("initial commit")
+		shell.UpdateFile("existing.txt", "changed")
+		shell.CreateFile("new.txt", "one\ntwo\nthree\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals(" M existing.txt").IsSelected(),
+				Equals("?? new.txt"),
+			).
+			Press(keys.Files.IntentToAdd).
+			Tap(func() {
+				t.ExpectToast(Equals("Disabled: Only untracked files can be marked as intent-to-add."))
+			}).
+			NavigateToLine(Contains("new.txt")).
+			Press(keys.Files.IntentToAdd).
+			Lines(
+				Equals(" M existing.txt"),
+				Equals(" A new.txt").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			SelectedLines(Contains("+one")).
+			PressPrimaryAction().
+			Content(DoesNotContain("+one").Contains("+two").Contains("+three")).
+			Tap(func() {
+				t.Views().StagingSecondary().
+					Content(Contains("+one").DoesNotContain("+two"))
+			}).
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals(" M existing.txt"),
+				Equals("AM new.txt").IsSelected(),
+			)
+	},
+})
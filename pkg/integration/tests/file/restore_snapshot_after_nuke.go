@@ -0,0 +1,78 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RestoreSnapshotAfterNuke = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Nuke the working tree, then get the changes back by restoring the snapshot saved beforehand",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.AnimateExplosion = false
+		config.GetUserConfig().Git.Snapshots.Enabled = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("tracked", "original\n")
+		shell.Commit("first commit")
+		shell.UpdateFile("tracked", "modified\n")
+		shell.CreateFileAndAdd("staged", "staged content\n")
+		shell.CreateFile("untracked", "untracked content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  A  staged"),
+				Equals("   M tracked"),
+				Equals("  ?? untracked"),
+			).
+			Press(keys.Files.ViewResetOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("")).
+			Select(Contains("Nuke working tree")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Nuke working tree")).
+			Content(Contains("Are you sure you want to nuke the working tree?")).
+			Confirm()
+
+		t.Views().Files().
+			IsEmpty().
+			Press(keys.Files.ViewResetOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("")).
+			Select(Contains("Restore snapshot")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Restore snapshot")).
+			Lines(
+				Contains("Nuke working tree").IsSelected(),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Restore snapshot")).
+			Content(Contains("This will reset 'master' to")).
+			Content(Contains("A snapshot of the current state is saved first")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  ?? staged"),
+				Equals("   M tracked"),
+				Equals("  ?? untracked"),
+			)
+
+		t.FileSystem().FileContent("tracked", Equals("modified\n"))
+		t.FileSystem().FileContent("untracked", Equals("untracked content\n"))
+	},
+})
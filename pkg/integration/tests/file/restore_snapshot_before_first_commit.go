@@ -0,0 +1,67 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RestoreSnapshotBeforeFirstCommit = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Remove untracked files in a repo without commits, then get them back by restoring the snapshot saved beforehand",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.Snapshots.Enabled = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("staged", "staged content\n")
+		shell.CreateFile("untracked", "untracked content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  A  staged"),
+				Equals("  ?? untracked"),
+			).
+			Press(keys.Files.ViewResetOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("")).
+			Select(Contains("Discard untracked files")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Equals("A  staged"),
+			).
+			Press(keys.Files.ViewResetOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("")).
+			Select(Contains("Restore snapshot")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Restore snapshot")).
+			Lines(
+				Contains("Remove untracked files").IsSelected(),
+				Contains("Cancel"),
+			).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Restore snapshot")).
+			Content(Contains("before the first commit")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Equals("▼ /"),
+				Equals("  A  staged"),
+				Equals("  ?? untracked"),
+			)
+
+		t.FileSystem().FileContent("untracked", Equals("untracked content\n"))
+	},
+})
@@ -0,0 +1,105 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SkipWorktreeAndAssumeUnchanged = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Toggle the skip-worktree and assume-unchanged bits of a tracked file, which keeps it listed with an indicator while its changes are hidden",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowRootItemInFileTree = false
+		config.GetUserConfig().Gui.ShowFilesWithHiddenChanges = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("config.json", "original")
+		shell.Commit("initial commit")
+		shell.UpdateFile("config.json", "local tweak")
+		shell.CreateFile("untracked.txt", "new")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		toggle := func(item string) {
+			t.Views().Files().Press(keys.Files.IgnoreFile)
+
+			t.ExpectPopup().Menu().
+				Title(Equals("Ignore or exclude file")).
+				Select(Contains(item)).
+				Confirm()
+		}
+
+		t.Views().Files().
+			Focus().
+			Lines(
+				Equals(" M config.json").IsSelected(),
+				Equals("?? untracked.txt"),
+			).
+			NavigateToLine(Contains("untracked.txt")).
+			Press(keys.Files.IgnoreFile).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Ignore or exclude file")).
+					Select(Contains("Toggle skip-worktree")).
+					Tooltip(Contains("Disabled: Only tracked files can have the skip-worktree or assume-unchanged bit set.")).
+					Confirm().
+					Tap(func() {
+						t.ExpectToast(Equals("Disabled: Only tracked files can have the skip-worktree or assume-unchanged bit set."))
+					}).
+					Cancel()
+			}).
+			NavigateToLine(Contains("config.json"))
+
+		toggle("Toggle skip-worktree")
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Hide changes to file")).
+			Content(Equals("With the skip-worktree bit set, changes to 'config.json' are hidden from the normal status output, so they won't be staged or committed. Are you sure?")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Equals("   config.json (skip-worktree)").IsSelected(),
+				Equals("?? untracked.txt"),
+			)
+
+		t.Views().Main().
+			Content(Contains("This file has the skip-worktree bit set, so its changes are hidden from the normal status output"))
+
+		toggle("Toggle assume-unchanged")
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Hide changes to file")).
+			Content(Contains("With the assume-unchanged bit set")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Equals("   config.json (skip-worktree, assume-unchanged)").IsSelected(),
+				Equals("?? untracked.txt"),
+			)
+
+		t.Views().Main().
+			Content(Contains("This file has the skip-worktree and assume-unchanged bit set"))
+
+		// clearing a bit doesn't need confirmation
+		toggle("Toggle skip-worktree")
+
+		t.Views().Files().
+			Lines(
+				Equals("   config.json (assume-unchanged)").IsSelected(),
+				Equals("?? untracked.txt"),
+			)
+
+		toggle("Toggle assume-unchanged")
+
+		t.Views().Files().
+			Lines(
+				Equals(" M config.json").IsSelected(),
+				Equals("?? untracked.txt"),
+			)
+
+		t.Views().Main().
+			Content(Contains("+local tweak"))
+	},
+})
@@ -0,0 +1,69 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SortOrder = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Sort the files by status and by diff size",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowFileTree = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("a.txt", "x\n")
+		shell.CreateFileAndAdd("b.txt", "x\n")
+		shell.CreateFileAndAdd("c.txt", "x\n")
+		shell.Commit("initial commit")
+		shell.UpdateFileAndAdd("a.txt", "x\n1\n")
+		shell.UpdateFile("b.txt", "x\n1\n2\n3\n4\n5\n")
+		shell.UpdateFile("c.txt", "x\n1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("M  a.txt").IsSelected(),
+				Equals(" M b.txt"),
+				Equals(" M c.txt"),
+			).
+			Press(keys.Files.SortOrder).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Sort order")).
+					Select(Contains("Status")).
+					Confirm()
+			}).
+			Lines(
+				Equals(" M b.txt"),
+				Equals(" M c.txt"),
+				Equals("M  a.txt").IsSelected(),
+			).
+			Press(keys.Files.SortOrder).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Sort order")).
+					Select(Contains("Diff size")).
+					Confirm()
+			}).
+			Lines(
+				Equals(" M c.txt"),
+				Equals(" M b.txt"),
+				Equals("M  a.txt").IsSelected(),
+			).
+			Press(keys.Files.SortOrder).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Sort order")).
+					Select(Contains("Name")).
+					Confirm()
+			}).
+			Lines(
+				Equals("M  a.txt").IsSelected(),
+				Equals(" M b.txt"),
+				Equals(" M c.txt"),
+			)
+	},
+})
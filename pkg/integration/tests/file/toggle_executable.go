@@ -0,0 +1,61 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ToggleExecutable = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Toggle the executable bit of a tracked and an untracked file, which stages the mode change",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowRootItemInFileTree = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("script.sh", "echo hello")
+		shell.Commit("initial commit")
+		shell.UpdateFile("script.sh", "echo changed")
+		shell.CreateFile("new.sh", "echo new")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("?? new.sh").IsSelected(),
+				Equals(" M script.sh"),
+			).
+			Press(keys.Files.ToggleExecutable).
+			Tap(func() {
+				t.ExpectToast(Equals("'new.sh' is now executable"))
+			}).
+			Lines(
+				Equals("A  new.sh").IsSelected(),
+				Equals(" M script.sh"),
+			).
+			Tap(func() {
+				t.Views().Main().Content(Contains("new file mode 100755"))
+			}).
+			NavigateToLine(Contains("script.sh")).
+			Press(keys.Files.ToggleExecutable).
+			Tap(func() {
+				t.ExpectToast(Equals("'script.sh' is now executable"))
+			}).
+			Lines(
+				Equals("A  new.sh"),
+				Equals("MM script.sh").IsSelected(),
+			).
+			Tap(func() {
+				t.Views().Secondary().Content(Contains("old mode 100644").Contains("new mode 100755").DoesNotContain("+echo changed"))
+				t.Views().Main().Content(Contains("+echo changed").DoesNotContain("new mode"))
+			}).
+			Press(keys.Files.ToggleExecutable).
+			Tap(func() {
+				t.ExpectToast(Equals("'script.sh' is no longer executable"))
+			}).
+			Lines(
+				Equals("A  new.sh"),
+				Equals(" M script.sh").IsSelected(),
+			)
+	},
+})
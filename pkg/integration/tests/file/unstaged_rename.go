@@ -0,0 +1,58 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UnstagedRename = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a file that was moved without telling git as a single rename, and stage, unstage and discard it as one unit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.DetectUnstagedRenames = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("before", "some content\nthat is long enough\nto be detected as a rename\n")
+		shell.CreateFileAndAdd("other", "other content\n")
+		shell.Commit("initial commit")
+		shell.RunCommand([]string{"mv", "before", "after"})
+		shell.CreateFile("untracked", "untracked content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("   R before → after"),
+				Equals("  ?? untracked"),
+			).
+			NavigateToLine(Contains("after")).
+			PressPrimaryAction().
+			Lines(
+				Equals("▼ /"),
+				Equals("  R  before → after").IsSelected(),
+				Equals("  ?? untracked"),
+			).
+			PressPrimaryAction().
+			Lines(
+				Equals("▼ /"),
+				Equals("   R before → after").IsSelected(),
+				Equals("  ?? untracked"),
+			).
+			Press(keys.Universal.Remove)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Discard changes")).
+			Select(Contains("Discard all changes")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Equals("?? untracked").IsSelected(),
+			)
+
+		t.FileSystem().PathPresent("before")
+		t.FileSystem().PathNotPresent("after")
+	},
+})
@@ -8,7 +8,7 @@ import (
 var FilterFiles = NewIntegrationTest(NewIntegrationTestArgs{
 	Description:  "Basic file filtering by text",
 	ExtraCmdArgs: []string{},
-	Skip:         true, // Skipping until we have implemented file view filtering
+	Skip:         false,
 	SetupConfig:  func(config *config.AppConfig) {},
 	SetupRepo: func(shell *Shell) {
 		shell.CreateDir("folder1")
@@ -0,0 +1,88 @@
+package filter_and_search
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FilterFilesAndStageVisible = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter files with a glob and stage or discard only the files that match",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowRootItemInFileTree = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("dir/one.go", "one")
+		shell.CreateFileAndAdd("dir/notes.md", "notes")
+		shell.CreateFileAndAdd("two.go", "two")
+		shell.CreateFileAndAdd("README.md", "readme")
+		shell.Commit("initial commit")
+		shell.UpdateFile("dir/one.go", "one changed")
+		shell.UpdateFile("dir/notes.md", "notes changed")
+		shell.UpdateFile("two.go", "two changed")
+		shell.UpdateFile("README.md", "readme changed")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ dir").IsSelected(),
+				Equals("   M notes.md"),
+				Equals("   M one.go"),
+				Equals(" M README.md"),
+				Equals(" M two.go"),
+			).
+			FilterOrSearch("*.go").
+			Lines(
+				Equals("▼ dir").IsSelected(),
+				Equals("   M one.go"),
+				Equals(" M two.go"),
+			).
+			// staging the directory only stages the files in it that match
+			PressPrimaryAction().
+			Lines(
+				Equals("▼ dir").IsSelected(),
+				Equals("  M  one.go"),
+				Equals(" M two.go"),
+			).
+			Press(keys.Files.ToggleStagedAll).
+			Lines(
+				Equals("▼ dir").IsSelected(),
+				Equals("  M  one.go"),
+				Equals("M  two.go"),
+			).
+			PressEscape().
+			Lines(
+				Equals("▼ dir").IsSelected(),
+				Equals("   M notes.md"),
+				Equals("  M  one.go"),
+				Equals(" M README.md"),
+				Equals("M  two.go"),
+			).
+			FilterOrSearch("*.md").
+			Lines(
+				Equals("▼ dir").IsSelected(),
+				Equals("   M notes.md"),
+				Equals(" M README.md"),
+			).
+			// discarding the directory only discards the files in it that match
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Discard changes")).
+					Select(Contains("Discard all changes")).
+					Confirm()
+			}).
+			Lines(
+				Equals(" M README.md"),
+			).
+			PressEscape().
+			Lines(
+				Equals("▼ dir"),
+				Equals("  M  one.go"),
+				Equals(" M README.md"),
+				Equals("M  two.go"),
+			)
+	},
+})
@@ -0,0 +1,78 @@
+package filter_and_search
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SearchHistoryForCode = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Search the history for commits that added or removed some code, using a string and a regex",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("a.go", "func loadCommits() {}\n")
+		shell.Commit("add loadCommits")
+		shell.CreateFileAndAdd("b.go", "func loadBranches() {}\n")
+		shell.Commit("add loadBranches")
+		shell.UpdateFileAndAdd("a.go", "func loadCommits() {}\n// unrelated\n")
+		shell.CreateFileAndAdd("c.go", "// other\n")
+		shell.Commit("touch a.go")
+		shell.UpdateFileAndAdd("a.go", "// unrelated\n")
+		shell.UpdateFileAndAdd("c.go", "// other file\n")
+		shell.Commit("remove loadCommits")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Press(keys.Commits.SearchHistoryForCode)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Search history for code")).
+			Select(Contains("(-S)")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Search history for string:")).
+			Type("loadCommits").
+			Confirm()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Title(Contains("-SloadCommits")).
+			Lines(
+				Contains("remove loadCommits").IsSelected(),
+				Contains("add loadCommits"),
+			)
+
+		// Only the files that match the search are shown in the diff
+		t.Views().Main().
+			Content(Contains("-func loadCommits() {}")).
+			Content(DoesNotContain("c.go"))
+
+		t.Views().SubCommits().
+			PressEscape()
+
+		t.Views().Commits().
+			IsFocused().
+			Press(keys.Commits.SearchHistoryForCode)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Search history for code")).
+			Select(Contains("(-G)")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Search history for regex:")).
+			Type("func load.*\\(").
+			Confirm()
+
+		t.Views().SubCommits().
+			IsFocused().
+			Lines(
+				Contains("remove loadCommits").IsSelected(),
+				Contains("add loadBranches"),
+				Contains("add loadCommits"),
+			)
+	},
+})
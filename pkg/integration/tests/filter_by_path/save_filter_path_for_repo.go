@@ -0,0 +1,121 @@
+package filter_by_path
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var SaveFilterPathForRepo = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter by a directory, save the filter for the repo, and toggle between the saved scope and the whole repo",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowFileTree = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("backend/server.go", "server")
+		shell.Commit("add backend")
+		shell.CreateFileAndAdd("frontend/app.js", "app")
+		shell.Commit("add frontend")
+
+		shell.UpdateFile("backend/server.go", "server changed")
+		shell.UpdateFile("frontend/app.js", "app changed")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("backend/server.go"),
+				Contains("frontend/app.js"),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Enter path to filter by")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter path:")).
+			Type("frontend").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("add frontend").IsSelected(),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Always filter this repo by 'frontend'")).
+			Confirm()
+
+		t.Views().Information().Content(Contains("Filtering by 'frontend' (saved for this repo)"))
+
+		t.Views().Files().
+			Lines(
+				Contains("frontend/app.js"),
+			)
+
+		t.Views().Commits().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Lines(
+				Contains("Filter by 'CI <CI@example.com>'"),
+				Contains("Enter path to filter by"),
+				Contains("Enter author to filter by"),
+				Contains("Widen scope to the whole repo"),
+				Contains("Forget saved path filter 'frontend'"),
+				Contains("Stop filtering"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Widen scope to the whole repo")).
+			Confirm()
+
+		t.Views().Information().Content(DoesNotContain("Filtering by"))
+
+		t.Views().Files().
+			Lines(
+				Contains("backend/server.go"),
+				Contains("frontend/app.js"),
+			)
+
+		t.Views().Commits().
+			Lines(
+				Contains("add frontend"),
+				Contains("add backend"),
+			).
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Scope to saved path 'frontend'")).
+			Confirm()
+
+		t.Views().Information().Content(Contains("Filtering by 'frontend' (saved for this repo)"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("add frontend"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Contains("frontend/app.js"),
+			)
+
+		t.Views().Commits().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Forget saved path filter 'frontend'")).
+			Confirm()
+
+		t.Views().Information().Content(Contains("Filtering by 'frontend'").DoesNotContain("saved"))
+	},
+})
@@ -0,0 +1,128 @@
+package filter_commits
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CombineCommitFilters = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Filter commits by message, date and merge status on top of a path filter, then clear the commit filters again",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("main")
+		shell.CreateFileAndAdd("src/a", "a")
+		shell.EmptyCommitWithDate("fix: ancient bug", "2020-01-01T12:00:00")
+		shell.CreateFileAndAdd("docs/readme", "readme")
+		shell.EmptyCommitWithDate("feat: add docs", "2023-01-01T12:00:00")
+		shell.NewBranch("feature")
+		shell.CreateFileAndAdd("src/b", "b")
+		shell.EmptyCommitWithDate("fix: feature bug", "2023-02-01T12:00:00")
+		shell.Checkout("main")
+		shell.Merge("feature")
+		shell.CreateFileAndAdd("src/c", "c")
+		shell.EmptyCommitWithDate("fix: recent bug", "2023-03-01T12:00:00")
+		shell.UpdateFileAndAdd("docs/readme", "fixed readme")
+		shell.EmptyCommitWithDate("fix: docs typo", "2023-04-01T12:00:00")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Enter path to filter by")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter path:")).
+			Type("src").
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Press(keys.Commits.OpenCommitFilters)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filter commits")).
+			Select(Contains("Message")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Show commits whose message matches (regular expression):")).
+			Type("fix").
+			Confirm()
+
+		t.Views().Commits().
+			Press(keys.Commits.OpenCommitFilters)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filter commits")).
+			Select(Contains("Hide merge commits")).
+			Confirm()
+
+		t.Views().Information().Content(Contains("Filtering by 'src' (message: fix, no merges)"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("fix: recent bug"),
+				Contains("fix: feature bug"),
+				Contains("fix: ancient bug"),
+			).
+			Press(keys.Commits.OpenCommitFilters)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filter commits")).
+			Select(Contains("Since")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Contains("Show commits more recent than")).
+			Type("2022-06-01").
+			Confirm()
+
+		t.Views().Information().Content(Contains("Filtering by 'src' (since: 2022-06-01, message: fix, no merges)"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("fix: recent bug"),
+				Contains("fix: feature bug"),
+			).
+			Press(keys.Commits.OpenCommitFilters)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filter commits")).
+			Lines(
+				Contains("Author"),
+				Contains("Since").Contains("2022-06-01"),
+				Contains("Until"),
+				Contains("Message").Contains("fix"),
+				Contains("Hide merge commits").Contains("on"),
+				Contains("Clear commit filters"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Clear commit filters")).
+			Confirm()
+
+		t.Views().Information().Content(Contains("Filtering by 'src'").DoesNotContain("message"))
+
+		t.Views().Commits().
+			Content(Contains("fix: recent bug")).
+			Content(DoesNotContain("docs")).
+			Press(keys.Universal.Return)
+
+		t.Views().Information().Content(DoesNotContain("Filtering"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("fix: docs typo"),
+				Contains("fix: recent bug"),
+				Contains("Merge branch 'feature'"),
+				Contains("fix: feature bug"),
+				Contains("feat: add docs"),
+				Contains("fix: ancient bug"),
+			)
+	},
+})
@@ -0,0 +1,91 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DragCommits = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Reorder commits by dragging them with the mouse, which starts an interactive rebase if there isn't one yet",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("main")
+		shell.EmptyCommit("initial commit")
+		shell.EmptyCommit("last main commit")
+
+		shell.NewBranch("feature-branch")
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+		shell.EmptyCommit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("three").IsSelected(),
+				Contains("two"),
+				Contains("one"),
+				Contains("last main commit"),
+				Contains("initial commit"),
+			).
+			// Commits of the main branch can't be dragged without starting a
+			// rebase from an earlier commit first
+			Drag(0, 3, 0, 0).
+			Tap(func() {
+				t.ExpectToast(Contains("Only commits of the current branch can be dragged outside of a rebase"))
+			}).
+			Lines(
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+				Contains("last main commit").IsSelected(),
+				Contains("initial commit"),
+			).
+			Drag(0, 2, 0, 0).
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("one").IsSelected(),
+				Contains("three"),
+				Contains("two"),
+				Contains("--- Commits ---"),
+				Contains("last main commit"),
+				Contains("initial commit"),
+			).
+			// Dragging past the last todo moves the commit as far as it can go
+			Drag(0, 1, 0, 5).
+			Tap(func() {
+				t.ExpectToast(Contains("Cannot move any further"))
+			}).
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("three"),
+				Contains("two"),
+				Contains("one").IsSelected(),
+				Contains("--- Commits ---"),
+				Contains("last main commit"),
+				Contains("initial commit"),
+			).
+			Drag(0, 2, 0, 1).
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("two").IsSelected(),
+				Contains("three"),
+				Contains("one"),
+				Contains("--- Commits ---"),
+				Contains("last main commit"),
+				Contains("initial commit"),
+			).
+			Tap(func() {
+				t.Common().ContinueRebase()
+			}).
+			Lines(
+				Contains("two").IsSelected(),
+				Contains("three"),
+				Contains("one"),
+				Contains("last main commit"),
+				Contains("initial commit"),
+			)
+	},
+})
@@ -0,0 +1,56 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DropMarkedCommits = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Marks two commits that are not adjacent and drops them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(5)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().Focus().
+			Lines(
+				Contains("commit 05").IsSelected(),
+				Contains("commit 04"),
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			SelectNextItem().
+			Press(keys.Commits.ToggleCommitMarked).
+			NavigateToLine(Contains("commit 02")).
+			Press(keys.Commits.ToggleCommitMarked).
+			Lines(
+				Contains("commit 05").DoesNotContain("◆"),
+				Contains("◆ commit 04"),
+				Contains("commit 03").DoesNotContain("◆"),
+				Contains("◆ commit 02").IsSelected(),
+				Contains("commit 01").DoesNotContain("◆"),
+			).
+			Tap(func() {
+				t.Views().Information().Content(Contains("2 commits marked"))
+			}).
+			NavigateToLine(Contains("commit 01")).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Drop commit")).
+					Content(Equals("Are you sure you want to drop the 2 marked commit(s)?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("commit 05"),
+				Contains("commit 03"),
+				Contains("commit 01").IsSelected(),
+			).
+			Tap(func() {
+				t.Views().Information().Content(DoesNotContain("marked"))
+			})
+	},
+})
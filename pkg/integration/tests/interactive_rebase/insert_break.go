@@ -0,0 +1,112 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var InsertBreak = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Insert a break after a todo commit so that the rebase pauses there, then continue",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(4)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Press(keys.Commits.ToggleBreak).
+			Tap(func() {
+				t.ExpectToast(Contains("Disabled: Breaks can only be inserted during an interactive rebase"))
+			}).
+			NavigateToLine(Contains("commit 01")).
+			Press(keys.Universal.Edit).
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("commit 04"),
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("--- Commits ---"),
+				Contains("commit 01").IsSelected(),
+			).
+			NavigateToLine(Contains("commit 02")).
+			Press(keys.Commits.ToggleBreak).
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("commit 04"),
+				Contains("commit 03"),
+				Contains("break"),
+				Contains("commit 02").IsSelected(),
+				Contains("--- Commits ---"),
+				Contains("commit 01"),
+			).
+			// Toggling again removes the break
+			Press(keys.Commits.ToggleBreak).
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("commit 04"),
+				Contains("commit 03"),
+				Contains("commit 02").IsSelected(),
+				Contains("--- Commits ---"),
+				Contains("commit 01"),
+			).
+			NavigateToLine(Contains("commit 03")).
+			Press(keys.Commits.ToggleBreak).
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("commit 04"),
+				Contains("break"),
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("--- Commits ---"),
+				Contains("commit 01"),
+			).
+			SelectPreviousItem().
+			// Toggling on the break itself removes it
+			Press(keys.Commits.ToggleBreak).
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("commit 04"),
+				Contains("commit 03").IsSelected(),
+				Contains("commit 02"),
+				Contains("--- Commits ---"),
+				Contains("commit 01"),
+			).
+			Press(keys.Commits.ToggleBreak).
+			SelectPreviousItem()
+
+		t.Views().Main().Content(Contains("The rebase will pause here"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("commit 04"),
+				Contains("break").IsSelected(),
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("--- Commits ---"),
+				Contains("commit 01"),
+			).
+			Tap(func() {
+				t.Common().ContinueRebase()
+			}).
+			Lines(
+				Contains("--- Pending rebase todos ---"),
+				Contains("commit 04"),
+				Contains("--- Rebase paused here at a break (press m to continue) ---"),
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			Tap(func() {
+				t.Common().ContinueRebase()
+			}).
+			Lines(
+				Contains("commit 04"),
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			)
+	},
+})
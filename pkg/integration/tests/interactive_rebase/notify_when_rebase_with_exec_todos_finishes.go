@@ -0,0 +1,44 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var NotifyWhenRebaseWithExecTodosFinishes = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a desktop notification when continuing a rebase with exec todos finishes",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Notifications.Enabled = true
+		cfg.GetUserConfig().OS.Notify = "printf '%s: %s' {{title}} {{message}} > notification"
+		cfg.GetUserConfig().CustomCommands = []config.CustomCommand{
+			{
+				Key:     "X",
+				Context: "commits",
+				Command: "git -c core.editor=: rebase -i -x false HEAD^^",
+			},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			NewBranch("branch1").
+			CreateNCommits(3)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Press("X").
+			Tap(func() {
+				t.ExpectPopup().Alert().Title(Equals("Error")).Content(Contains("Executing: false")).Confirm()
+
+				// Rebasing in a custom command doesn't notify
+				t.FileSystem().PathNotPresent("notification")
+
+				t.Common().ContinueRebase()
+				t.ExpectPopup().Alert().Title(Equals("Error")).Content(Contains("exit status 1")).Confirm()
+
+				t.FileSystem().FileContent("notification", Equals("lazygit: Rebase failed in repo"))
+			})
+	},
+})
@@ -0,0 +1,57 @@
+package misc
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var KeypressRecording = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Record some keypresses and replay them several times",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(7)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Press(keys.Universal.ReplayKeypressRecording)
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("There is no keypress recording yet")).
+			Confirm()
+
+		t.Views().Commits().
+			Press(keys.Universal.ToggleKeypressRecording)
+
+		t.ExpectToast(Equals("Recording keypresses"))
+
+		t.Views().Information().Content(Contains("Recording keypresses"))
+
+		t.Views().Commits().
+			SelectedLine(Contains("commit 07")).
+			Press(keys.Universal.NextItem).
+			Press(keys.Universal.NextItem).
+			SelectedLine(Contains("commit 05")).
+			Press(keys.Universal.ToggleKeypressRecording)
+
+		t.ExpectToast(Equals("Recorded '<down> <down>'"))
+
+		t.Views().Information().Content(DoesNotContain("Recording keypresses"))
+
+		t.Views().Commits().
+			Press(keys.Universal.ReplayKeypressRecording)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Replay '<down> <down>' how many times?")).
+			InitialText(Equals("1")).
+			Clear().
+			Type("2").
+			Confirm()
+
+		t.Views().Commits().
+			SelectedLine(Contains("commit 01"))
+	},
+})
@@ -0,0 +1,68 @@
+package reflog
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DiffAgainstCurrentState = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Compare a reflog entry with HEAD and with the working tree",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "first version\n")
+		shell.Commit("one")
+		shell.UpdateFileAndAdd("file", "second version\n")
+		shell.Commit("two")
+		shell.UpdateFile("file", "uncommitted version\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().ReflogCommits().
+			Focus().
+			Lines(
+				Contains("commit: two").IsSelected(),
+				Contains("commit (initial): one"),
+			).
+			SelectNextItem()
+
+		t.Views().Main().
+			Content(Contains("+first version"))
+
+		t.Views().ReflogCommits().
+			Press(keys.Reflog.ViewDiffTarget).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Compare reflog entries with")).
+					Select(Contains("HEAD")).
+					Confirm()
+			})
+
+		t.Views().Main().
+			Content(Contains("Changes from HEAD to")).
+			Content(Contains("-second version")).
+			Content(Contains("+first version"))
+
+		t.Views().ReflogCommits().
+			Press(keys.Reflog.ViewDiffTarget).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Compare reflog entries with")).
+					Select(Contains("Working tree")).
+					Confirm()
+			})
+
+		t.Views().Main().
+			Content(Contains("Changes from the working tree to")).
+			Content(Contains("-uncommitted version")).
+			Content(Contains("+first version"))
+
+		// The choice sticks when selecting another entry
+		t.Views().ReflogCommits().
+			SelectPreviousItem()
+
+		t.Views().Main().
+			Content(Contains("-uncommitted version")).
+			Content(Contains("+second version"))
+	},
+})
@@ -0,0 +1,77 @@
+package reflog
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ViewReflogOfBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the reflog of a branch other than the checked out one, and check out a commit that was reset away on that branch",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("two")
+		shell.EmptyCommit("three")
+		shell.HardReset("HEAD^")
+		shell.Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().ReflogCommits().
+			Focus().
+			TopLines(
+				Contains("checkout: moving from feature to master").IsSelected(),
+			).
+			Press(keys.Reflog.SelectRef).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("View reflog of ref")).
+					Select(Contains("feature")).
+					Confirm()
+			}).
+			Lines(
+				Contains("reset: moving to HEAD^").IsSelected(),
+				Contains("commit: three"),
+				Contains("commit: two"),
+				Contains("branch: Created from"),
+			).
+			SelectNextItem().
+			PressPrimaryAction().
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Contains("Checkout branch or commit")).
+					Select(MatchesRegexp("Checkout commit [a-f0-9]+ as detached head")).
+					Confirm()
+			}).
+			// The reflog of the branch is unaffected by the checkout
+			Lines(
+				Contains("reset: moving to HEAD^").IsSelected(),
+				Contains("commit: three"),
+				Contains("commit: two"),
+				Contains("branch: Created from"),
+			)
+
+		t.Views().Commits().
+			Lines(
+				Contains("three"),
+				Contains("two"),
+				Contains("one"),
+			)
+
+		t.Views().ReflogCommits().
+			Focus().
+			Press(keys.Reflog.SelectRef).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("View reflog of ref")).
+					Select(Equals("HEAD")).
+					Confirm()
+			}).
+			TopLines(
+				Contains("checkout: moving from master to").IsSelected(),
+				Contains("checkout: moving from feature to master"),
+			)
+	},
+})
@@ -0,0 +1,78 @@
+package staging
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/samber/lo"
+)
+
+var GoToLine = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show line numbers in the staging view and go to a line by its number",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Gui.UseHunkModeInStagingView = false
+		cfg.GetUserConfig().Gui.ShowLineNumbersInStagingView = true
+	},
+	SetupRepo: func(shell *Shell) {
+		lines := lo.Times(30, func(i int) string { return fmt.Sprintf("line %d", i+1) })
+		shell.CreateFileAndAdd("file1", strings.Join(lines, "\n")+"\n")
+		shell.Commit("one")
+
+		lines[4] = "changed 5"
+		lines[24] = "changed 25"
+		shell.UpdateFile("file1", strings.Join(lines, "\n")+"\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Contains("file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			ContainsLines(
+				Equals(" 4  4 │ line 4"),
+				Equals(" 5    │-line 5"),
+				Equals("    5 │+changed 5"),
+				Equals(" 6  6 │ line 6"),
+			).
+			SelectedLines(Equals(" 5    │-line 5")).
+			Press(keys.Main.GoToLine).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Go to line")).
+					Type("25").
+					Confirm()
+			}).
+			SelectedLines(Equals("   25 │+changed 25")).
+			Press(keys.Main.GoToLine).
+			Tap(func() {
+				// line 15 isn't part of the diff, so we get the closest line
+				// that is
+				t.ExpectPopup().Prompt().
+					Title(Equals("Go to line")).
+					Type("15").
+					Confirm()
+			}).
+			SelectedLines(Equals(" 8  8 │ line 8")).
+			Press(keys.Main.GoToLine).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Go to line")).
+					Type("abc").
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("Please enter a valid line number")).
+					Confirm()
+			}).
+			SelectedLines(Equals(" 8  8 │ line 8"))
+	},
+})
@@ -0,0 +1,64 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplyFiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Apply only some of the files of a stash entry, including an untracked one",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "one\n")
+		shell.CreateFileAndAdd("file2", "two\n")
+		shell.Commit("initial commit")
+		shell.UpdateFile("file1", "one changed\n")
+		shell.UpdateFile("file2", "two changed\n")
+		shell.CreateFile("untracked", "new\n")
+		shell.RunCommand([]string{"git", "stash", "push", "--include-untracked", "-m", "stash one"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().IsEmpty()
+
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash one").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().CommitFiles().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("  M file1"),
+				Equals("  M file2"),
+				Equals("  A untracked"),
+			).
+			NavigateToLine(Contains("untracked"))
+
+		t.Views().Main().Content(Contains("+new"))
+
+		t.Views().CommitFiles().
+			Press(keys.CommitFiles.ApplyFilesFromStash).
+			NavigateToLine(Contains("file1")).
+			Press(keys.CommitFiles.ApplyFilesFromStash)
+
+		t.Views().Files().
+			Lines(
+				Equals("▼ /"),
+				Equals("   M file1"),
+				Equals("  ?? untracked"),
+			)
+
+		t.FileSystem().FileContent("file1", Equals("one changed\n"))
+		t.FileSystem().FileContent("untracked", Equals("new\n"))
+
+		t.Views().Stash().
+			Lines(
+				Contains("stash one"),
+			)
+	},
+})
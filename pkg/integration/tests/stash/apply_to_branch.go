@@ -0,0 +1,69 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ApplyToBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Apply a stash entry to a different branch, refusing to do so while there are uncommitted changes",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content\n")
+		shell.Commit("initial commit")
+		shell.NewBranch("other")
+		shell.Checkout("master")
+		shell.UpdateFile("file", "changed\n")
+		shell.Stash("stash one")
+		shell.CreateFileAndAdd("dirty", "dirty\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("stash one").IsSelected(),
+			).
+			Press(keys.Stash.ApplyToBranch)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Apply stash@{0} to branch:")).
+			Type("other").
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("You have uncommitted changes")).
+			Confirm()
+
+		t.Shell().Commit("add dirty")
+		t.GlobalPress(keys.Universal.Refresh)
+
+		t.Views().Stash().
+			Press(keys.Stash.ApplyToBranch)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Apply stash@{0} to branch:")).
+			Type("other").
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("other").IsSelected(),
+				Contains("master"),
+			)
+
+		t.Views().Files().
+			Lines(
+				Contains("file"),
+			)
+
+		t.FileSystem().FileContent("file", Equals("changed\n"))
+
+		t.Views().Stash().
+			Lines(
+				Contains("stash one"),
+			)
+	},
+})
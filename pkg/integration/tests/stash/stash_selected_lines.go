@@ -0,0 +1,61 @@
+package stash
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StashSelectedLines = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Stash a single line of a file from the staging view, commit the rest, then apply the stashed line again",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file1", "a\nb\nc\n")
+		shell.Commit("one")
+
+		shell.UpdateFile("file1", "a\nX\nb\nc\nY\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals(" M file1").IsSelected(),
+			).
+			PressEnter()
+
+		t.Views().Staging().
+			IsFocused().
+			Press(keys.Main.ToggleSelectHunk).
+			SelectedLines(Contains("+X")).
+			Press(keys.Files.ViewStashOptions)
+
+		t.ExpectPopup().Prompt().Title(Equals("Stash changes")).Type("just X").Confirm()
+
+		t.Views().Staging().
+			IsFocused().
+			Content(DoesNotContain("+X")).
+			SelectedLines(Contains("+Y"))
+
+		t.FileSystem().FileContent("file1", Equals("a\nb\nc\nY\n"))
+
+		t.Shell().GitAddAll().Commit("two")
+		t.GlobalPress(keys.Universal.Refresh)
+
+		t.Views().Files().IsEmpty()
+
+		t.Views().Stash().
+			Focus().
+			Lines(
+				Contains("just X").IsSelected(),
+			).
+			PressPrimaryAction()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Stash apply")).
+			Content(Contains("Are you sure you want to apply this stash entry?")).
+			Confirm()
+
+		t.FileSystem().FileContent("file1", Equals("a\nX\nb\nc\nY\n"))
+	},
+})
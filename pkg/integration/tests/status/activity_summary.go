@@ -0,0 +1,64 @@
+package status
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ActivitySummary = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Summarize the user's commits on all branches and their other reflog activity over the last hours",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().OS.CopyToClipboardCmd = "printf '%s' {{text}} > clipboard"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommitWithDate("ancient master work", "2020-01-01T00:00:00Z")
+		shell.SetAuthor("Someone Else", "someone@example.com")
+		shell.EmptyCommit("commit by someone else")
+		shell.SetAuthor("CI", "CI@example.com")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("feature work")
+		shell.Checkout("master")
+		shell.EmptyCommit("master work")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.ActivitySummary).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("My activity in the last 24 hours")).
+					Select(Contains("Show in main view")).
+					Confirm()
+
+				t.Views().Main().
+					Title(Equals("My activity in the last 24 hours")).
+					Content(
+						Contains("## Commits").
+							Contains("### feature").
+							Contains(" feature work (").
+							Contains("### master").
+							Contains(" master work (").
+							Contains("## Other activity").
+							Contains("checkout: moving from feature to master").
+							DoesNotContain("ancient master work").
+							DoesNotContain("someone else"),
+					)
+			}).
+			Press(keys.Status.ActivitySummary).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("My activity in the last 24 hours")).
+					Select(Contains("Copy to clipboard")).
+					Confirm()
+
+				t.ExpectToast(Equals("Activity summary copied to clipboard"))
+
+				t.FileSystem().FileContent("clipboard",
+					Contains("### feature").
+						Contains("checkout: moving from feature to master").
+						DoesNotContain("ancient master work"))
+			})
+	},
+})
@@ -0,0 +1,44 @@
+package status
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var HealthCheckDetachedSubmodules = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Only submodules that are detached at a commit other than the recorded one are flagged by the health checks",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.CloneIntoSubmodule("at_recorded_commit", "at_recorded_commit")
+		shell.CloneIntoSubmodule("moved", "moved")
+		shell.GitAddAll()
+		shell.Commit("add submodules")
+
+		// What 'git submodule update' leaves behind
+		shell.RunCommand([]string{"git", "-C", "at_recorded_commit", "checkout", "--detach"})
+
+		shell.RunCommand([]string{"git", "-C", "moved", "checkout", "--detach"})
+		shell.RunCommand([]string{"git", "-C", "moved", "commit", "--allow-empty", "-m", "commit on detached head"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.HealthChecks)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Repository health")).
+			Select(Contains("Detached submodule HEADs")).
+			Tap(func() {
+				t.Views().Menu().SelectedLine(
+					Contains("Warning").Contains("moved").DoesNotContain("at_recorded_commit"),
+				)
+			}).
+			Confirm()
+
+		t.Views().Submodules().
+			IsFocused()
+	},
+})
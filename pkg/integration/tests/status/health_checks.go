@@ -0,0 +1,89 @@
+package status
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var HealthChecks = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Run the repository health checks and fix what they find",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file", "content")
+		shell.Commit("initial commit")
+
+		shell.RunShellCommand("echo 'nobody refers to me' | git hash-object -w --stdin")
+		shell.CreateFile(".git/refs/heads/broken", "garbage\n")
+		shell.CreateFile(".git/packed-refs.lock", "")
+		shell.RunCommand([]string{"touch", "-d", "2 hours ago", ".git/packed-refs.lock"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.HealthChecks)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Repository health")).
+			Lines(
+				Contains("OK").Contains("Integrity (git fsck)").Contains("No problems found"),
+				Contains("Warning").Contains("Dangling objects").Contains("1 dangling objects"),
+				Contains("Error").Contains("Broken refs").Contains("refs/heads/broken"),
+				Contains("OK").Contains("Index size"),
+				Contains("Error").Contains("Stale lock files").Contains("packed-refs.lock"),
+				Contains("OK").Contains("Detached submodule HEADs").Contains("None"),
+				Contains("OK").Contains("Garbage collection"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Stale lock files")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Remove stale lock files")).
+			Content(Contains("packed-refs.lock")).
+			Confirm()
+
+		t.Views().Status().
+			Press(keys.Status.HealthChecks)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Repository health")).
+			Select(Contains("Broken refs")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Delete broken refs")).
+			Content(Contains("refs/heads/broken")).
+			Confirm()
+
+		t.Views().Status().
+			Press(keys.Status.HealthChecks)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Repository health")).
+			Select(Contains("Dangling objects")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Prune dangling objects")).
+			Content(Contains("Are you sure you want to permanently delete 1 dangling objects?")).
+			Confirm()
+
+		t.Views().Status().
+			Press(keys.Status.HealthChecks)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Repository health")).
+			Lines(
+				Contains("OK").Contains("Integrity (git fsck)").Contains("No problems found"),
+				Contains("OK").Contains("Dangling objects").Contains("None"),
+				Contains("OK").Contains("Broken refs").Contains("None"),
+				Contains("OK").Contains("Index size"),
+				Contains("OK").Contains("Stale lock files").Contains("None"),
+				Contains("OK").Contains("Detached submodule HEADs"),
+				Contains("OK").Contains("Garbage collection"),
+				Contains("Cancel"),
+			)
+	},
+})
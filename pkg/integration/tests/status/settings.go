@@ -0,0 +1,115 @@
+package status
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Settings = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Change config options in the settings menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.OpenSettings)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Settings")).
+			Select(Contains("gui/")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("gui")).
+			Select(Contains("showCommandLog")).
+			Tap(func() {
+				t.Views().Menu().SelectedLine(Contains("true"))
+			}).
+			Confirm()
+
+		t.ExpectToast(Equals("Saved 'gui.showCommandLog'"))
+
+		t.ExpectPopup().Menu().
+			Title(Equals("gui")).
+			Select(Contains("showCommandLog")).
+			Tap(func() {
+				t.Views().Menu().SelectedLine(Contains("false").Contains("(default: true)"))
+			}).
+			Select(Contains("scrollHeight")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("gui.scrollHeight")).
+			InitialText(Equals("2")).
+			Clear().
+			Type("0").
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("'gui.scrollHeight' must be at least 1")).
+			Confirm()
+
+		t.Views().Status().
+			Press(keys.Status.OpenSettings)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Settings")).
+			Select(Contains("gui/")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("gui")).
+			Select(Contains("scrollHeight")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("gui.scrollHeight")).
+			InitialText(Equals("2")).
+			Clear().
+			Type("5").
+			Confirm()
+
+		t.ExpectToast(Equals("Saved 'gui.scrollHeight'"))
+
+		t.ExpectPopup().Menu().
+			Title(Equals("gui")).
+			Select(Contains("scrollHeight")).
+			Tap(func() {
+				t.Views().Menu().SelectedLine(Contains("5").Contains("(default: 2)"))
+			}).
+			Select(Contains("filterMode")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("gui.filterMode")).
+			Lines(
+				Equals("(•) substring"),
+				Equals("( ) fuzzy"),
+				Contains("Cancel"),
+			).
+			Select(Contains("fuzzy")).
+			Confirm()
+
+		t.ExpectToast(Equals("Saved 'gui.filterMode'"))
+
+		t.ExpectPopup().Menu().
+			Title(Equals("gui")).
+			Select(Contains("filterMode")).
+			Tap(func() {
+				t.Views().Menu().SelectedLine(Contains("fuzzy").Contains("(default: substring)"))
+			}).
+			Select(Contains("branchColorPatterns")).
+			Tooltip(Contains("Lists and maps can only be changed in the config file.")).
+			Cancel()
+
+		t.FileSystem().FileContent("../../used_config/config.yml",
+			Contains("showCommandLog: false").
+				Contains("scrollHeight: 5").
+				Contains("filterMode: fuzzy"))
+	},
+})
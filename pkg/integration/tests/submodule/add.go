@@ -31,6 +31,11 @@ var Add = NewIntegrationTest(NewIntegrationTestArgs{
 					Title(Equals("New submodule path:")).
 					InitialText(Equals("my_submodule")).
 					Clear().Type("my_submodule_path").Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("New submodule branch (leave empty for the remote's default branch):")).
+					InitialText(Equals("")).
+					Confirm()
 			}).
 			Lines(
 				Contains("my_submodule").IsSelected(),
@@ -37,12 +37,16 @@ var EnterNested = NewIntegrationTest(NewIntegrationTestArgs{
 			// enter the nested submodule
 			PressEnter()
 
-		t.Views().Status().Content(Contains("innerSubPath(innerSubName)"))
+		t.Views().Status().Content(Contains("repo › outerSubPath › innerSubPath(innerSubName)"))
 		t.Views().Commits().ContainsLines(
 			Contains("initial inner commit"),
 		)
 
+		// going back up takes us through the outer submodule
 		t.Views().Files().PressEscape()
-		t.Views().Status().Content(Contains("repo"))
+		t.Views().Status().Content(Contains("repo › outerSubPath(outerSubName)"))
+
+		t.Views().Files().PressEscape()
+		t.Views().Status().Content(Contains("repo").DoesNotContain("›"))
 	},
 })
@@ -0,0 +1,62 @@
+package submodule
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EnterNestedStepByStep = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Enter a submodule, then enter its own submodule from there, and go back up one level at a time",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(cfg *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		setupNestedSubmodules(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Submodules().Focus().
+			Lines(
+				Equals("outerSubName").IsSelected(),
+				Equals("  - innerSubName"),
+			).
+			PressEnter()
+
+		t.Views().Status().Content(Contains("repo › outerSubPath(outerSubName) →"))
+
+		t.Views().Submodules().Focus().
+			Lines(
+				Equals("innerSubName").IsSelected(),
+			).
+			Tap(func() {
+				t.Views().Main().ContainsLines(
+					Contains("Name: innerSubName"),
+					Contains("Path: modules/innerSubPath"),
+					Contains("Url:  ../innerSubmodule"),
+				)
+			}).
+			PressEnter()
+
+		t.Views().Status().Content(Contains("repo › outerSubPath › innerSubPath(innerSubName) →"))
+		t.Views().Commits().ContainsLines(
+			Contains("initial inner commit"),
+		)
+		t.Views().Submodules().IsEmpty()
+
+		t.Views().Files().PressEscape()
+		t.Views().Status().Content(Contains("repo › outerSubPath(outerSubName) →"))
+		t.Views().Submodules().
+			IsFocused().
+			Lines(
+				Equals("innerSubName").IsSelected(),
+			).
+			PressEscape()
+
+		t.Views().Status().Content(Contains("repo → master").DoesNotContain("›"))
+		t.Views().Submodules().
+			IsFocused().
+			Lines(
+				Equals("outerSubName"),
+				Equals("  - innerSubName"),
+			)
+	},
+})
@@ -0,0 +1,81 @@
+package submodule
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RunCommandInAll = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Run a preset and a custom command in all submodules, showing the output in the command log and the submodules where the command failed",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.SubmoduleCommandPresets = []string{
+			`test "$name" != sub_b && echo "hello from $name"`,
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.CloneIntoSubmodule("sub_a", "sub_a")
+		shell.CloneIntoSubmodule("sub_b", "sub_b")
+		shell.GitAddAll()
+		shell.Commit("add submodules")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Submodules().Focus().
+			Lines(
+				Contains("sub_a"),
+				Contains("sub_b"),
+			).
+			Press(keys.Submodules.BulkMenu).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Bulk submodule options")).
+					Select(Contains("Run command in all submodules")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Run command in all submodules")).
+					Select(Contains("hello from")).
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("Command failed in 1 submodule(s):\n\nsub_b")).
+					Confirm()
+
+				t.Views().Extras().Content(
+					Contains("git submodule foreach --recursive test").
+						Contains("Entering 'sub_a'").
+						Contains("hello from sub_a").
+						Contains("Entering 'sub_b'").
+						DoesNotContain("hello from sub_b").
+						DoesNotContain("__lazygit"),
+				)
+			}).
+			Press(keys.Submodules.BulkMenu).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Bulk submodule options")).
+					Select(Contains("Run command in all submodules")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Run command in all submodules")).
+					Select(Contains("Custom command")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Command to run in each submodule:")).
+					Type(`echo "bye from $name"`).
+					Confirm()
+
+				t.ExpectToast(Equals("Command succeeded in all submodules"))
+
+				t.Views().Extras().Content(
+					Contains("bye from sub_a").
+						Contains("bye from sub_b"),
+				)
+			})
+	},
+})
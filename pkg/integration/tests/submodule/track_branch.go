@@ -0,0 +1,68 @@
+package submodule
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var TrackBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Set the branch that a submodule tracks and update the submodule to the latest commit of that branch",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.CloneIntoSubmodule("my_submodule_name", "my_submodule_path")
+		shell.GitAddAll()
+		shell.Commit("add submodule")
+
+		// Add a branch with a new commit to the submodule's remote
+		shell.NewBranch("stable")
+		shell.EmptyCommit("stable commit")
+		shell.RunCommand([]string{"git", "push", "../my_submodule_name", "stable"})
+		shell.Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Submodules().Focus().
+			Lines(
+				Contains("my_submodule_name").IsSelected(),
+			).
+			Press(keys.Submodules.SetBranch).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Branch for submodule 'my_submodule_name' to track (leave empty for the remote's default branch):")).
+					InitialText(Equals("")).
+					Type("stable").
+					Confirm()
+
+				t.Views().Main().TopLines(
+					Contains("Name: my_submodule_name"),
+					Contains("Path: my_submodule_path"),
+					Contains("Url:  ../my_submodule_name"),
+					Contains("Branch: stable"),
+				)
+			}).
+			Press(keys.Submodules.UpdateRemote)
+
+		t.Views().Files().Focus().
+			Lines(
+				Equals("▼ /"),
+				Equals("   M .gitmodules"),
+				Equals("   M my_submodule_path (submodule)"),
+			).
+			NavigateToLine(Contains(".gitmodules")).
+			Tap(func() {
+				t.Views().Main().Content(
+					Contains("branch = stable"),
+				)
+			}).
+			NavigateToLine(Contains("my_submodule_path")).
+			Tap(func() {
+				t.Views().Main().Content(
+					Contains("> stable commit"),
+				)
+			})
+
+		t.Views().Extras().Content(Contains("git submodule update --init --remote --progress -- my_submodule_path"))
+	},
+})
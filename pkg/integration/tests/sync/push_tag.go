@@ -27,12 +27,9 @@ var PushTag = NewIntegrationTest(NewIntegrationTestArgs{
 			).
 			Press(keys.Branches.PushTag)
 
-		t.ExpectPopup().Prompt().
+		t.ExpectPopup().Menu().
 			Title(Equals("Remote to push tag 'mytag' to:")).
-			InitialText(Equals("origin")).
-			SuggestionLines(
-				Contains("origin"),
-			).
+			Select(Contains("origin")).
 			Confirm()
 
 		t.Views().Remotes().
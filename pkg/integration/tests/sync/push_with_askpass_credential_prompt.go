@@ -0,0 +1,80 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushWithAskpassCredentialPrompt = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push a commit to a pre-configured upstream, where git asks for credentials through GIT_ASKPASS",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.EmptyCommit("two")
+
+		// The hook asks for credentials like git does when pushing over https
+		shell.CopyHelpFile("pre-push-askpass", ".git/hooks/pre-push")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Equals("↑1 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Push)
+
+		// correct credentials are: username=username, password=password, code=123456
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Username")).
+			Type("username").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Password")).
+			Type("password").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("One-time password")).
+			Type("654321").
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("incorrect credentials")).
+			Confirm()
+
+		t.Views().Status().Content(Equals("↑1 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Push)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Username")).
+			Type("username").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Password")).
+			Type("password").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("One-time password")).
+			Type("123456").
+			Confirm()
+
+		t.Views().Status().Content(Equals("✓ repo → master"))
+
+		assertSuccessfullyPushed(t)
+	},
+})
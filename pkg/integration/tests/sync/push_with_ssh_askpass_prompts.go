@@ -0,0 +1,63 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushWithSshAskpassPrompts = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push a commit to a pre-configured upstream, where ssh asks to trust the host key and for the key's passphrase through SSH_ASKPASS",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.EmptyCommit("two")
+
+		// The hook asks what ssh asks when connecting to an unknown host
+		shell.CopyHelpFile("pre-push-ssh-askpass", ".git/hooks/pre-push")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Equals("↑1 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Push)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("SSH confirmation")).
+			Content(Contains("SHA256:+DiY3wvvV6TuJJhbpZisF/zLDA0zPMSvHdkr4UvCOqU")).
+			Cancel()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("Host key verification failed.")).
+			Confirm()
+
+		t.Views().Status().Content(Equals("↑1 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Push)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("SSH confirmation")).
+			Content(Contains("Are you sure you want to continue connecting")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Enter passphrase for SSH key")).
+			Type("passphrase").
+			Confirm()
+
+		t.Views().Status().Content(Equals("✓ repo → master"))
+
+		assertSuccessfullyPushed(t)
+	},
+})
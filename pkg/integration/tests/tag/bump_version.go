@@ -0,0 +1,71 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var BumpVersion = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Create a tag by bumping the minor version of the latest semver tag, with a message generated from a template",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.SuggestTagVersionBumps = true
+		config.GetUserConfig().Git.TagMessageTemplate = "Release {{.Tag}}\n\nChanges since {{.PreviousTag}}:\n{{.Changelog}}"
+	},
+	SetupRepo: func(shell *Shell) {
+		// Older than the tag created in the test, which is sorted first by
+		// date; tags with the same date would be sorted by name instead
+		shell.EmptyCommitWithDate("first commit", "2023-01-01T12:00:00")
+		shell.CreateLightweightTag("v1.9.0", "HEAD")
+		shell.CreateLightweightTag("v1.10.2", "HEAD")
+		shell.CreateLightweightTag("nightly", "HEAD")
+		shell.EmptyCommit("add feature")
+		shell.EmptyCommit("fix bug")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			Press(keys.Universal.New).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Create tag (latest: v1.10.2)")).
+					Lines(
+						Contains("Bump patch version").Contains("v1.10.3"),
+						Contains("Bump minor version").Contains("v1.11.0"),
+						Contains("Bump major version").Contains("v2.0.0"),
+						Contains("Custom tag name"),
+						Contains("Cancel"),
+					).
+					Select(Contains("Bump minor version")).
+					Confirm()
+
+				t.ExpectPopup().CommitMessagePanel().
+					Title(Equals("Tag name")).
+					Content(Equals("v1.11.0")).
+					SwitchToDescription().
+					Content(Equals("Release v1.11.0\n\nChanges since v1.10.2:\n- fix bug\n- add feature")).
+					SwitchToSummary().
+					Confirm()
+			}).
+			Lines(
+				Contains("v1.11.0").IsSelected(),
+				Contains("nightly"),
+				Contains("v1.10.2"),
+				Contains("v1.9.0"),
+			)
+
+		t.Views().Tags().
+			Press(keys.Universal.New).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Create tag (latest: v1.11.0)")).
+					Select(Contains("Custom tag name")).
+					Confirm()
+
+				t.ExpectPopup().CommitMessagePanel().
+					Title(Equals("Tag name")).
+					InitialText(Equals(""))
+			})
+	},
+})
@@ -0,0 +1,44 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CreateRelease = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Create a release for a tag without an API token, which opens the hosting service's new release page",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().OS.OpenLink = "printf '%s' {{link}} > openlink"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateLightweightTag("v1.0.0", "HEAD")
+		shell.EmptyCommit("feat: add thing")
+		shell.CreateLightweightTag("v1.1.0", "HEAD")
+		shell.RunCommand([]string{"git", "remote", "add", "origin", "https://github.com/peter/calculator"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			NavigateToLine(Contains("v1.1.0")).
+			Press(keys.Branches.CreateRelease)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Title(Equals("Release title")).
+			Content(Equals("v1.1.0")).
+			Clear().
+			Type("Calculator 1.1").
+			SwitchToDescription().
+			Title(Equals("Release notes")).
+			Content(Contains("## Features").Contains("- add thing (").DoesNotContain("initial commit")).
+			Clear().
+			Type("Adds a thing").
+			SwitchToSummary().
+			Confirm()
+
+		t.FileSystem().FileContent("openlink",
+			Equals("https://github.com/peter/calculator/releases/new?tag=v1.1.0&title=Calculator+1.1&body=Adds+a+thing"))
+	},
+})
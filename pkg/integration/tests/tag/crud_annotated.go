@@ -46,12 +46,9 @@ var CrudAnnotated = NewIntegrationTest(NewIntegrationTestArgs{
 			}).
 			Press(keys.Universal.Push).
 			Tap(func() {
-				t.ExpectPopup().Prompt().
+				t.ExpectPopup().Menu().
 					Title(Equals("Remote to push tag 'new-tag' to:")).
-					InitialText(Equals("origin")).
-					SuggestionLines(
-						Contains("origin"),
-					).
+					Select(Contains("origin")).
 					Confirm()
 			}).
 			Press(keys.Universal.Remove).
@@ -63,12 +60,9 @@ var CrudAnnotated = NewIntegrationTest(NewIntegrationTestArgs{
 					Confirm()
 			}).
 			Tap(func() {
-				t.ExpectPopup().Prompt().
+				t.ExpectPopup().Menu().
 					Title(Equals("Remote from which to remove tag 'new-tag':")).
-					InitialText(Equals("origin")).
-					SuggestionLines(
-						Contains("origin"),
-					).
+					Select(Contains("origin")).
 					Confirm()
 			}).
 			Tap(func() {
@@ -0,0 +1,67 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var EditAnnotatedMessage = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Edit the message of an annotated tag, and try to edit a lightweight one",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.CreateLightweightTag("lightweight-tag", "HEAD")
+		shell.EmptyCommit("second commit")
+		shell.CreateAnnotatedTag("annotated-tag", "old message", "HEAD~1")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("annotated-tag").IsSelected(),
+				Contains("lightweight-tag"),
+			).
+			Press(keys.Universal.Edit).
+			Tap(func() {
+				t.ExpectPopup().CommitMessagePanel().
+					Title(Equals("Tag name")).
+					Content(Equals("annotated-tag")).
+					SwitchToDescription().
+					Content(Equals("old message")).
+					Clear().
+					Type("new message").
+					SwitchToSummary().
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Recreate tag")).
+					Content(Contains("This will delete tag 'annotated-tag' and recreate it")).
+					Confirm()
+			}).
+			Lines(
+				Contains("annotated-tag").Contains("new message").IsSelected(),
+				Contains("lightweight-tag"),
+			).
+			Tap(func() {
+				t.Views().Main().ContainsLines(
+					Equals("Annotated tag: annotated-tag"),
+					Equals(""),
+					Contains("Tagger:"),
+					Contains("TaggerDate:"),
+					Equals(""),
+					Equals("new message"),
+				)
+				t.Views().Main().Content(Contains("first commit").DoesNotContain("second commit"))
+			}).
+			NavigateToLine(Contains("lightweight-tag")).
+			Press(keys.Universal.Edit).
+			Tap(func() {
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("Only annotated tags have a message that can be edited")).
+					Confirm()
+			})
+	},
+})
@@ -0,0 +1,70 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var GenerateReleaseNotes = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Generate release notes for the commits since the previous tag, show them in the main view and copy them to the clipboard",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().OS.CopyToClipboardCmd = "printf '%s' {{text}} > clipboard"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateLightweightTag("v1.0.0", "HEAD")
+		shell.EmptyCommit("feat: add thing")
+		shell.EmptyCommit("fix(ui): crash on startup")
+		shell.EmptyCommit("Update docs")
+		shell.CreateLightweightTag("v1.1.0", "HEAD")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			NavigateToLine(Contains("v1.1.0")).
+			Press(keys.Commits.GenerateReleaseNotes).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Release notes: v1.0.0..v1.1.0")).
+					Select(Contains("Show grouped by type")).
+					Confirm()
+
+				t.Views().Main().
+					Title(Equals("Release notes: v1.0.0..v1.1.0")).
+					Content(
+						Contains("## Features\n\n- add thing (").
+							Contains("## Bug fixes\n\n- **ui:** crash on startup (").
+							Contains("## Other changes\n\n- Update docs (").
+							DoesNotContain("initial commit"),
+					)
+			}).
+			Press(keys.Commits.GenerateReleaseNotes).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Release notes: v1.0.0..v1.1.0")).
+					Select(Contains("Copy grouped by author to clipboard")).
+					Confirm()
+
+				t.ExpectToast(Equals("Release notes copied to clipboard"))
+
+				t.FileSystem().FileContent("clipboard",
+					Contains("- Update docs (").
+						Contains("- fix(ui): crash on startup (").
+						Contains("- feat: add thing (").
+						DoesNotContain("initial commit"))
+			}).
+			NavigateToLine(Contains("v1.0.0")).
+			Press(keys.Commits.GenerateReleaseNotes).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Release notes: v1.0.0")).
+					Select(Contains("Show grouped by type")).
+					Confirm()
+
+				t.Views().Main().
+					Content(Equals("## Other changes\n\n- initial commit (" + t.Git().GetCommitHash("v1.0.0")[:7] + ")"))
+			})
+	},
+})
@@ -0,0 +1,45 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushToChosenRemote = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push a tag to a remote picked from a menu that shows which remotes have the tag",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateLightweightTag("mytag", "HEAD")
+		shell.CloneIntoRemote("origin")
+		shell.CloneIntoRemote("other")
+		shell.RunCommand([]string{"git", "-C", "../other", "tag", "-d", "mytag"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.Fetch)
+
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("mytag ✓").IsSelected(),
+			).
+			Press(keys.Branches.PushTag)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Remote to push tag 'mytag' to:")).
+			Lines(
+				Contains("origin").Contains("has tag"),
+				Contains("other").Contains("missing tag"),
+				Contains("Cancel"),
+			).
+			Select(Contains("other")).
+			Confirm()
+
+		t.Shell().
+			RunCommand([]string{"git", "ls-remote", "--exit-code", "other", "refs/tags/mytag"})
+	},
+})
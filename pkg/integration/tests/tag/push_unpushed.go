@@ -0,0 +1,80 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushUnpushed = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Mark tags with their status on the remote after fetching, and push all tags that only exist locally to the remote at once",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateLightweightTag("pushed", "HEAD")
+		shell.CloneIntoRemote("origin")
+		shell.CreateLightweightTag("local-one", "HEAD")
+		shell.CreateLightweightTag("local-two", "HEAD")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			Lines(
+				DoesNotContainAnyOf("✓", "↑").Contains("local-one"),
+				DoesNotContainAnyOf("✓", "↑").Contains("local-two"),
+				DoesNotContainAnyOf("✓", "↑").Contains("pushed"),
+			)
+
+		// Fetching lists the remote's tags, so the tags get marked
+		t.Views().Files().
+			Focus().
+			Press(keys.Files.Fetch)
+
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("local-one ↑"),
+				Contains("local-two ↑"),
+				Contains("pushed ✓"),
+			).
+			Press(keys.Branches.PushUnpushedTags).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Remote to push unpushed tags to:")).
+					Select(Contains("origin")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Push all unpushed tags")).
+					Content(Contains("Are you sure you want to push the following tags to 'origin'?").
+						Contains("local-one").
+						Contains("local-two").
+						DoesNotContain("pushed")).
+					Confirm()
+
+				t.ExpectToast(Equals("Pushed 2 tag(s) to 'origin'"))
+			}).
+			Lines(
+				Contains("local-one ✓"),
+				Contains("local-two ✓"),
+				Contains("pushed ✓"),
+			).
+			Press(keys.Branches.PushUnpushedTags).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Remote to push unpushed tags to:")).
+					Select(Contains("origin").Contains("0 unpushed")).
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("'origin' already has all local tags")).
+					Confirm()
+			})
+
+		t.Shell().
+			RunCommand([]string{"git", "ls-remote", "--exit-code", "origin", "refs/tags/local-one"}).
+			RunCommand([]string{"git", "ls-remote", "--exit-code", "origin", "refs/tags/local-two"})
+	},
+})
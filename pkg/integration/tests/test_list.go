@@ -17,6 +17,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_and_search"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_by_author"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_by_path"
+	"github.com/jesseduffield/lazygit/pkg/integration/tests/filter_commits"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/interactive_rebase"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/misc"
 	"github.com/jesseduffield/lazygit/pkg/integration/tests/patch_building"
@@ -48,6 +49,8 @@ var tests = []*components.IntegrationTest{
 	branch.DeleteRemoteBranchWithDifferentName,
 	branch.DeleteWhileFiltering,
 	branch.DetachedHead,
+	branch.MergePreview,
+	branch.MergeWithOptions,
 	branch.MoveCommitsToNewBranchFromBaseBranch,
 	branch.MoveCommitsToNewBranchFromMainBranch,
 	branch.MoveCommitsToNewBranchKeepStacked,
@@ -56,10 +59,13 @@ var tests = []*components.IntegrationTest{
 	branch.NewBranchFromRemoteTrackingSameName,
 	branch.NewBranchWithPrefix,
 	branch.NewBranchWithPrefixUsingRunCommand,
+	branch.OctopusMerge,
+	branch.OpenPullRequestInHostingServiceRemote,
 	branch.OpenPullRequestInvalidTargetRemoteName,
 	branch.OpenPullRequestNoUpstream,
 	branch.OpenPullRequestSelectRemoteAndTargetBranch,
 	branch.OpenWithCliArg,
+	branch.PullRequestWithoutApiToken,
 	branch.Rebase,
 	branch.RebaseAbortOnConflict,
 	branch.RebaseAndDrop,
@@ -70,13 +76,16 @@ var tests = []*components.IntegrationTest{
 	branch.RebaseFromMarkedBase,
 	branch.RebaseOntoBaseBranch,
 	branch.RebaseToUpstream,
+	branch.RebaseWithDateOptions,
 	branch.Rename,
 	branch.Reset,
 	branch.ResetToDuplicateNamedTag,
 	branch.ResetToDuplicateNamedUpstream,
 	branch.ResetToUpstream,
+	branch.RestoreDeletedBranchSnapshot,
 	branch.SelectCommitsOfCurrentBranch,
 	branch.SetUpstream,
+	branch.ShowAheadAndBehindBaseBranch,
 	branch.ShowDivergenceFromBaseBranch,
 	branch.ShowDivergenceFromUpstream,
 	branch.ShowDivergenceFromUpstreamNoDivergence,
@@ -88,6 +97,7 @@ var tests = []*components.IntegrationTest{
 	cherry_pick.CherryPick,
 	cherry_pick.CherryPickConflicts,
 	cherry_pick.CherryPickDuringRebase,
+	cherry_pick.CherryPickMarkedCommits,
 	cherry_pick.CherryPickMerge,
 	cherry_pick.CherryPickRange,
 	commit.AddCoAuthor,
@@ -98,10 +108,12 @@ var tests = []*components.IntegrationTest{
 	commit.AmendWhenThereAreConflictsAndCancel,
 	commit.AmendWhenThereAreConflictsAndContinue,
 	commit.AutoWrapMessage,
+	commit.BulkSetAuthorRange,
 	commit.Checkout,
 	commit.CheckoutFileFromCommit,
 	commit.CheckoutFileFromRangeSelectionOfCommits,
 	commit.Commit,
+	commit.CommitDetailsHeader,
 	commit.CommitMultiline,
 	commit.CommitSkipHooks,
 	commit.CommitSwitchToEditor,
@@ -114,6 +126,7 @@ var tests = []*components.IntegrationTest{
 	commit.CopyAuthorToClipboard,
 	commit.CopyMessageBodyToClipboard,
 	commit.CopyTagToClipboard,
+	commit.CopyWithTemplate,
 	commit.CreateAmendCommit,
 	commit.CreateFixupCommitInBranchStack,
 	commit.CreateTag,
@@ -125,6 +138,8 @@ var tests = []*components.IntegrationTest{
 	commit.FindBaseCommitForFixupDisregardMainBranch,
 	commit.FindBaseCommitForFixupOnlyAddedLines,
 	commit.FindBaseCommitForFixupWarningForAddedLines,
+	commit.FindContainingRefs,
+	commit.GoToCommit,
 	commit.Highlight,
 	commit.History,
 	commit.HistoryComplex,
@@ -133,6 +148,7 @@ var tests = []*components.IntegrationTest{
 	commit.PasteCommitMessageOverExisting,
 	commit.PreserveCommitMessage,
 	commit.ResetAuthor,
+	commit.ResetAuthorDate,
 	commit.ResetAuthorRange,
 	commit.Revert,
 	commit.RevertMerge,
@@ -140,20 +156,31 @@ var tests = []*components.IntegrationTest{
 	commit.RevertWithConflictSingleCommit,
 	commit.Reword,
 	commit.Search,
+	commit.SelectAuthor,
 	commit.SetAuthor,
 	commit.SetAuthorRange,
+	commit.ShowCommitStats,
 	commit.StageRangeOfLines,
 	commit.Staged,
 	commit.StagedWithoutHooks,
 	commit.Unstaged,
 	config.CustomCommandsInPerRepoConfig,
 	config.NegativeRefspec,
+	config.Profiles,
 	config.RemoteNamedStar,
+	config.RepoConfigFile,
+	config.Themes,
+	config.ThemesWithPresetInConfig,
+	config.UntrustedRepoConfigFile,
+	conflicts.AutoContinueAfterResolving,
 	conflicts.Filter,
+	conflicts.RerereForgetResolution,
+	conflicts.ResolveAllWithTheirs,
 	conflicts.ResolveExternally,
 	conflicts.ResolveMultipleFiles,
 	conflicts.ResolveNoAutoStage,
 	conflicts.ResolveNonTextualConflicts,
+	conflicts.ResolveWithMergeTool,
 	conflicts.ResolveWithoutTrailingLf,
 	conflicts.UndoChooseHunk,
 	custom_commands.AccessCommitProperties,
@@ -162,13 +189,17 @@ var tests = []*components.IntegrationTest{
 	custom_commands.CustomCommandsSubmenu,
 	custom_commands.FormPrompts,
 	custom_commands.GlobalContext,
+	custom_commands.Macro,
 	custom_commands.MenuFromCommand,
 	custom_commands.MenuFromCommandsOutput,
 	custom_commands.MultipleContexts,
 	custom_commands.MultiplePrompts,
+	custom_commands.PromptValidationAndConditions,
 	custom_commands.RunCommand,
 	custom_commands.SelectedCommit,
 	custom_commands.SelectedCommitRange,
+	custom_commands.SelectedDiffLines,
+	custom_commands.SelectedItems,
 	custom_commands.SelectedPath,
 	custom_commands.ShowOutputInPanel,
 	custom_commands.SuggestionsCommand,
@@ -188,14 +219,22 @@ var tests = []*components.IntegrationTest{
 	demo.StageLines,
 	demo.Undo,
 	demo.WorktreeCreateFromBranches,
+	diff.BinaryFileInfo,
 	diff.CopyToClipboard,
 	diff.Diff,
 	diff.DiffAndApplyPatch,
 	diff.DiffCommits,
 	diff.DiffNonStickyRange,
+	diff.DiffOptionsMenu,
+	diff.EditLineInFocusedMainView,
+	diff.FailingPager,
+	diff.FileTypeDiffCommand,
 	diff.IgnoreWhitespace,
+	diff.MissingPager,
 	diff.RenameSimilarityThresholdChange,
+	diff.SideBySidePagerResize,
 	file.CollapseExpand,
+	file.CollapsedDirsSurviveRefresh,
 	file.CopyMenu,
 	file.DirWithUntrackedFile,
 	file.DiscardAllDirChanges,
@@ -208,16 +247,24 @@ var tests = []*components.IntegrationTest{
 	file.DiscardVariousChangesRangeSelect,
 	file.Gitignore,
 	file.GitignoreSpecialCharacters,
+	file.IntentToAdd,
 	file.RememberCommitMessageAfterFail,
 	file.RenameSimilarityThresholdChange,
 	file.RenamedFiles,
 	file.RenamedFilesNoRootItem,
+	file.RestoreSnapshotAfterNuke,
+	file.RestoreSnapshotBeforeFirstCommit,
+	file.SkipWorktreeAndAssumeUnchanged,
+	file.SortOrder,
 	file.StageChildrenRangeSelect,
 	file.StageDeletedRangeSelect,
 	file.StageRangeSelect,
+	file.ToggleExecutable,
+	file.UnstagedRename,
 	filter_and_search.FilterByFileStatus,
 	filter_and_search.FilterCommitFiles,
 	filter_and_search.FilterFiles,
+	filter_and_search.FilterFilesAndStageVisible,
 	filter_and_search.FilterFuzzy,
 	filter_and_search.FilterMenu,
 	filter_and_search.FilterMenuByKeybinding,
@@ -230,6 +277,7 @@ var tests = []*components.IntegrationTest{
 	filter_and_search.NestedFilter,
 	filter_and_search.NestedFilterTransient,
 	filter_and_search.NewSearch,
+	filter_and_search.SearchHistoryForCode,
 	filter_and_search.StageAllStagesOnlyTrackedFilesInTrackedOnlyFilter,
 	filter_and_search.StagingFolderStagesOnlyTrackedFilesInTrackedOnlyFilter,
 	filter_by_author.SelectAuthor,
@@ -238,9 +286,11 @@ var tests = []*components.IntegrationTest{
 	filter_by_path.DropCommitInFilteringMode,
 	filter_by_path.KeepSameCommitSelectedOnExit,
 	filter_by_path.RewordCommitInFilteringMode,
+	filter_by_path.SaveFilterPathForRepo,
 	filter_by_path.SelectFile,
 	filter_by_path.ShowDiffsForRenamedFile,
 	filter_by_path.TypeFile,
+	filter_commits.CombineCommitFilters,
 	interactive_rebase.AdvancedInteractiveRebase,
 	interactive_rebase.AmendCommitWithConflict,
 	interactive_rebase.AmendFirstCommit,
@@ -250,7 +300,9 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.AmendNonHeadCommitDuringRebase,
 	interactive_rebase.DeleteUpdateRefTodo,
 	interactive_rebase.DontShowBranchHeadsForTodoItems,
+	interactive_rebase.DragCommits,
 	interactive_rebase.DropCommitInCopiedBranchWithUpdateRef,
+	interactive_rebase.DropMarkedCommits,
 	interactive_rebase.DropMergeCommit,
 	interactive_rebase.DropTodoCommitWithUpdateRef,
 	interactive_rebase.DropWithCustomCommentChar,
@@ -263,6 +315,7 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.EditTheConflCommit,
 	interactive_rebase.FixupFirstCommit,
 	interactive_rebase.FixupSecondCommit,
+	interactive_rebase.InsertBreak,
 	interactive_rebase.InteractiveRebaseOfCopiedBranch,
 	interactive_rebase.InteractiveRebaseWithConflictForEditCommand,
 	interactive_rebase.MidRebaseRangeSelect,
@@ -271,6 +324,7 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.MoveInRebase,
 	interactive_rebase.MoveUpdateRefTodo,
 	interactive_rebase.MoveWithCustomCommentChar,
+	interactive_rebase.NotifyWhenRebaseWithExecTodosFinishes,
 	interactive_rebase.OutsideRebaseRangeSelect,
 	interactive_rebase.PickRescheduled,
 	interactive_rebase.QuickStart,
@@ -303,6 +357,7 @@ var tests = []*components.IntegrationTest{
 	misc.CopyToClipboard,
 	misc.DisabledKeybindings,
 	misc.InitialOpen,
+	misc.KeypressRecording,
 	misc.RecentReposOnLaunch,
 	patch_building.Apply,
 	patch_building.ApplyInReverse,
@@ -337,9 +392,11 @@ var tests = []*components.IntegrationTest{
 	patch_building.ToggleRange,
 	reflog.Checkout,
 	reflog.CherryPick,
+	reflog.DiffAgainstCurrentState,
 	reflog.DoNotShowBranchMarkersInReflogSubcommits,
 	reflog.Patch,
 	reflog.Reset,
+	reflog.ViewReflogOfBranch,
 	shell_commands.BasicShellCommand,
 	shell_commands.ComplexShellCommand,
 	shell_commands.DeleteFromHistory,
@@ -349,12 +406,15 @@ var tests = []*components.IntegrationTest{
 	staging.DiffChangeScreenMode,
 	staging.DiffContextChange,
 	staging.DiscardAllChanges,
+	staging.GoToLine,
 	staging.Search,
 	staging.StageHunks,
 	staging.StageLines,
 	staging.StageRanges,
 	stash.Apply,
+	stash.ApplyFiles,
 	stash.ApplyPatch,
+	stash.ApplyToBranch,
 	stash.CreateBranch,
 	stash.Drop,
 	stash.DropMultiple,
@@ -367,21 +427,29 @@ var tests = []*components.IntegrationTest{
 	stash.StashAll,
 	stash.StashAndKeepIndex,
 	stash.StashIncludingUntrackedFiles,
+	stash.StashSelectedLines,
 	stash.StashStaged,
 	stash.StashStagedPartialFile,
 	stash.StashUnstaged,
+	status.ActivitySummary,
 	status.ClickRepoNameToOpenReposMenu,
 	status.ClickToFocus,
 	status.ClickWorkingTreeStateToOpenRebaseOptionsMenu,
+	status.HealthCheckDetachedSubmodules,
+	status.HealthChecks,
 	status.LogCmd,
 	status.LogCmdStatusPanelAllBranchesLog,
+	status.Settings,
 	submodule.Add,
 	submodule.Enter,
 	submodule.EnterNested,
+	submodule.EnterNestedStepByStep,
 	submodule.Remove,
 	submodule.RemoveNested,
 	submodule.Reset,
 	submodule.ResetFolder,
+	submodule.RunCommandInAll,
+	submodule.TrackBranch,
 	sync.FetchAndAutoForwardBranchesAllBranches,
 	sync.FetchAndAutoForwardBranchesNone,
 	sync.FetchAndAutoForwardBranchesOnlyMainBranches,
@@ -406,30 +474,56 @@ var tests = []*components.IntegrationTest{
 	sync.PushFollowTags,
 	sync.PushNoFollowTags,
 	sync.PushTag,
+	sync.PushWithAskpassCredentialPrompt,
 	sync.PushWithCredentialPrompt,
+	sync.PushWithSshAskpassPrompts,
 	sync.RenameBranchAndPull,
+	tag.BumpVersion,
 	tag.Checkout,
 	tag.CheckoutWhenBranchWithSameNameExists,
 	tag.CopyToClipboard,
+	tag.CreateRelease,
 	tag.CreateWhileCommitting,
 	tag.CrudAnnotated,
 	tag.CrudLightweight,
 	tag.DeleteLocalAndRemote,
+	tag.EditAnnotatedMessage,
 	tag.ForceTagAnnotated,
 	tag.ForceTagLightweight,
+	tag.GenerateReleaseNotes,
+	tag.PushToChosenRemote,
+	tag.PushUnpushed,
 	tag.Reset,
 	tag.ResetToDuplicateNamedBranch,
+	ui.AccessibilityMode,
 	ui.Accordion,
+	ui.ClickThroughDisabled,
+	ui.CommandLogFile,
+	ui.ContextMenus,
+	ui.CountPrefixes,
+	ui.CustomizeLayout,
+	ui.CycleDateDisplay,
+	ui.DiffMinimap,
 	ui.DisableSwitchTabWithPanelJumpKeys,
 	ui.EmptyMenu,
+	ui.ExtrasTabs,
+	ui.FocusFollowsMouse,
+	ui.KeySequences,
 	ui.KeybindingSuggestionsWhenSwitchingRepos,
 	ui.ModeSpecificKeybindingSuggestions,
+	ui.MouseGestures,
 	ui.OpenLinkFailure,
 	ui.RangeSelect,
+	ui.RerunLoggedCommand,
+	ui.ResizePanelsWithMouse,
+	ui.Scrollbar,
+	ui.StatusBarSegments,
 	ui.SwitchTabFromMenu,
 	ui.SwitchTabWithPanelJumpKeys,
+	ui.ZenMode,
 	undo.UndoCheckoutAndDrop,
 	undo.UndoCommit,
+	undo.UndoDiscard,
 	undo.UndoDrop,
 	worktree.AddFromBranch,
 	worktree.AddFromBranchDetached,
@@ -438,6 +532,7 @@ var tests = []*components.IntegrationTest{
 	worktree.AssociateBranchRebase,
 	worktree.BareRepo,
 	worktree.BareRepoWorktreeConfig,
+	worktree.CreateWithOptions,
 	worktree.Crud,
 	worktree.CustomCommand,
 	worktree.DetachWorktreeFromBranch,
@@ -447,8 +542,11 @@ var tests = []*components.IntegrationTest{
 	worktree.FastForwardWorktreeBranch,
 	worktree.FastForwardWorktreeBranchShouldNotPolluteCurrentWorktree,
 	worktree.ForceRemoveWorktree,
+	worktree.OpenInTmux,
+	worktree.OpenInTmuxOutsideTmux,
 	worktree.RemoveWorktreeFromBranch,
 	worktree.ResetWindowTabs,
+	worktree.ReviewInWorktree,
 	worktree.SymlinkIntoRepoSubdir,
 	worktree.WorktreeInRepo,
 }
@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AccessibilityMode = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Announce selections and popups, and show the status of commits as text",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Accessibility = config.AccessibilityConfig{
+			Enabled:   true,
+			Output:    "lines",
+			LinesFile: ".git/announcements",
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(2)
+		shell.NewBranch("feature")
+		shell.EmptyCommit("feature commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains(" pushed ").Contains("feature commit").IsSelected(),
+				Contains("merged").Contains("commit 02"),
+				Contains("merged").Contains("commit 01"),
+			).
+			SelectNextItem().
+			Press(keys.Universal.Remove)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Drop commit")).
+			Content(Contains("Are you sure")).
+			Cancel()
+
+		t.FileSystem().FileContent(".git/announcements",
+			Contains("Commits: ").
+				Contains(" pushed CI ◯ feature commit, 1 of 3\n").
+				Contains(" merged CI ◯ commit 02, 2 of 3\n"+
+					"Drop commit: Are you sure you want to drop the selected commit(s)?\n"))
+	},
+})
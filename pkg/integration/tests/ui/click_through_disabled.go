@@ -0,0 +1,29 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ClickThroughDisabled = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Without click-through, the first click on an unfocused panel only focuses it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ClickThrough = false
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(3)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused()
+
+		t.Views().Commits().
+			Click(1, 2).
+			IsFocused().
+			SelectedLine(Contains("commit 03")).
+			Click(1, 2).
+			SelectedLine(Contains("commit 01"))
+	},
+})
@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommandLogFile = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Commands from the command log are appended to a file in the .git directory",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Gui.CommandLogFile.Enabled = true
+		cfg.GetUserConfig().CustomCommands = []config.CustomCommand{
+			{
+				Key:     "X",
+				Context: "global",
+				Command: "exit 3",
+			},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("other")
+		shell.Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("other")).
+			PressPrimaryAction().
+			Lines(
+				Contains("other").IsSelected(),
+				Contains("master"),
+			)
+
+		t.FileSystem().FileContent(".git/lazygit-command.log",
+			MatchesRegexp(`^\S+ exit=0 duration=\S+ git checkout other\n$`))
+
+		t.GlobalPress("X")
+		t.ExpectPopup().Alert().Title(Equals("Error")).Content(Contains("exit status 3")).Confirm()
+
+		t.FileSystem().FileContent(".git/lazygit-command.log",
+			MatchesRegexp(`git checkout other\n\S+ exit=3 duration=\S+ .*exit 3.*\n$`))
+	},
+})
@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ContextMenus = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Right-click items to show a menu of the actions of their panel",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(3)
+		shell.CreateFile("file-a", "a")
+		shell.CreateFile("file-b", "b")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus()
+
+		t.Views().Files().
+			RightClick(1, 2)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Actions")).
+			Select(Contains("<space> Stage")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /"),
+				Equals("  ?? file-a"),
+				Equals("  A  file-b").IsSelected(),
+			)
+
+		t.Views().Commits().
+			RightClick(1, 1)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Actions")).
+			ContainsLines(Contains("Reword")).
+			Tap(func() {
+				// Only the actions of the panel are shown, not the global ones
+				t.Views().Menu().Content(DoesNotContain("Quit"))
+			}).
+			Cancel()
+
+		t.Views().Commits().
+			IsFocused().
+			SelectedLine(Contains("commit 02"))
+	},
+})
@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CountPrefixes = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Type a count before movement keys and before moving a commit",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.CountPrefixes = true
+		// The digits start a count in the side panels
+		config.GetUserConfig().Keybinding.Universal.JumpToBlock = []string{"<f1>", "<f2>", "<f3>", "<f4>", "<f5>"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(12)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			SelectedLine(Contains("commit 12")).
+			Press("5")
+
+		t.Views().Information().Content(Contains("Count: 5"))
+
+		t.Views().Commits().
+			Press(keys.Universal.NextItem).
+			SelectedLine(Contains("commit 07")).
+			Tap(func() {
+				t.Views().Information().Content(DoesNotContain("Count:"))
+			}).
+			// 0 continues the count rather than focusing the main view
+			Press("1").
+			Press("0").
+			Press(keys.Universal.PrevItem).
+			IsFocused().
+			SelectedLine(Contains("commit 12")).
+			Press("3").
+			Press(keys.Commits.MoveDownCommit).
+			TopLines(
+				Contains("commit 11"),
+				Contains("commit 10"),
+				Contains("commit 09"),
+				Contains("commit 12").IsSelected(),
+				Contains("commit 08"),
+			).
+			// Without a count, 0 focuses the main view as usual
+			Press("0")
+
+		t.Views().Main().IsFocused()
+	},
+})
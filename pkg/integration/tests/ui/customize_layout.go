@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CustomizeLayout = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Hide side panels, let them share a slot, and rearrange them with the layout menu",
+	ExtraCmdArgs: []string{},
+	Width:        150,
+	Height:       50,
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.SidePanels = []string{"status", "files", "branches", "commits, stash"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CreateFileAndAdd("file", "content")
+		shell.Stash("stash one")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().IsVisible()
+		t.Views().Stash().IsInvisible()
+
+		// the stash panel takes the place of the commits panel while it has focus
+		t.Views().Files().Press(keys.Universal.JumpToBlock[4])
+		t.Views().Stash().
+			IsFocused().
+			IsVisible().
+			Lines(Contains("stash one"))
+		t.Views().Commits().IsInvisible()
+
+		t.Views().Stash().Press(keys.Universal.JumpToBlock[1])
+		t.Views().Files().IsFocused()
+		t.Views().Commits().IsVisible()
+		t.Views().Stash().IsInvisible()
+
+		t.GlobalPress(keys.Universal.OpenLayoutMenu)
+		t.ExpectPopup().Menu().
+			Title(Equals("Layout")).
+			Select(Contains("Stash").Contains("shares slot with Commits")).
+			Confirm()
+		t.ExpectPopup().Menu().
+			Title(Equals("Stash")).
+			Select(Contains("Move to its own slot")).
+			Confirm()
+		t.ExpectPopup().Menu().
+			Title(Equals("Layout")).
+			Select(Contains("Status")).
+			Confirm()
+		t.ExpectPopup().Menu().
+			Title(Equals("Status")).
+			Select(Contains("Hide")).
+			Confirm()
+		t.ExpectPopup().Menu().
+			Title(Equals("Layout")).
+			Select(Contains("Side panels on the right")).
+			Confirm()
+		t.ExpectPopup().Menu().
+			Title(Equals("Layout")).
+			Cancel()
+
+		t.Views().Status().IsInvisible()
+		t.Views().Commits().IsVisible()
+		t.Views().Stash().IsVisible()
+		t.Views().Main().HasWidth(100)
+
+		// with the side panels on the right, their border is the main view's
+		// right border; drag it to the left
+		t.Views().Main().Drag(98, 5, 78, 5)
+		t.Views().Main().HasWidth(78)
+		t.Views().Files().HasWidth(72)
+
+		t.GlobalPress(keys.Universal.OpenLayoutMenu)
+		t.ExpectPopup().Menu().
+			Title(Equals("Layout")).
+			Select(Contains("Reset to configured layout")).
+			Confirm()
+		t.ExpectPopup().Menu().
+			Title(Equals("Layout")).
+			Cancel()
+
+		t.Views().Status().IsVisible()
+		t.Views().Stash().IsInvisible()
+	},
+})
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CycleDateDisplay = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Cycle the dates of the branches and stash panels between relative, absolute and custom",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		// Without any verbs, so that the test doesn't depend on the time it runs
+		cfg.GetUserConfig().Gui.CustomTimeFormat = "<custom>"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("feature")
+		shell.Checkout("master")
+		shell.CreateFileAndAdd("file", "content")
+		shell.Stash("my stash")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Lines(
+				MatchesRegexp(`\* +master`),
+				MatchesRegexp(`\d+[sm] +feature`),
+			)
+		t.Views().Stash().
+			Lines(
+				MatchesRegexp(`\d+[sm] +On master: my stash`),
+			)
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.CycleDateDisplay)
+		t.ExpectToast(Equals("Showing how long ago"))
+		t.Views().Branches().
+			Lines(
+				MatchesRegexp(`\* +master`),
+				MatchesRegexp(`\d+[sm] +feature`),
+			)
+
+		t.Views().Files().
+			Press(keys.Universal.CycleDateDisplay)
+		t.ExpectToast(Equals("Showing dates in the time format"))
+		t.Views().Branches().
+			Lines(
+				MatchesRegexp(`\* +master`),
+				MatchesRegexp(`\d+:\d\d[AP]M +feature`),
+			)
+		t.Views().Stash().
+			Lines(
+				MatchesRegexp(`\d+:\d\d[AP]M +On master: my stash`),
+			)
+
+		t.Views().Files().
+			Press(keys.Universal.CycleDateDisplay)
+		t.ExpectToast(Equals("Showing dates in the custom time format"))
+		t.Views().Branches().
+			Lines(
+				MatchesRegexp(`\* +master`),
+				Contains("<custom> feature"),
+			)
+		t.Views().Stash().
+			Lines(
+				Contains("<custom> On master: my stash"),
+			)
+
+		// and round again
+		t.Views().Files().
+			Press(keys.Universal.CycleDateDisplay)
+		t.ExpectToast(Equals("Showing how long ago"))
+		t.Views().Stash().
+			Lines(
+				MatchesRegexp(`\d+[sm] +On master: my stash`),
+			)
+	},
+})
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+	"github.com/samber/lo"
+)
+
+var DiffMinimap = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Jump to a region of a long diff by clicking in the minimap",
+	ExtraCmdArgs: []string{},
+	Width:        150,
+	Height:       50,
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Gui.ShowDiffMinimap = true
+		cfg.GetUserConfig().Gui.ShowScrollbars = false
+	},
+	SetupRepo: func(shell *Shell) {
+		lines := []string{}
+		for i := 1; i <= 100; i++ {
+			lines = append(lines, fmt.Sprintf("line %03d", i))
+		}
+		shell.CreateFileAndAdd("file.txt", strings.Join(lines, "\n")+"\n")
+		shell.Commit("add file")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus()
+
+		// the diff has 14 lines of headers followed by the 100 added lines, and
+		// the view shows 37 of them
+		visibleLinesFrom := func(first int) []*TextMatcher {
+			return lo.Times(37, func(i int) *TextMatcher {
+				return Contains(fmt.Sprintf("+line %03d", first+i))
+			})
+		}
+
+		t.Views().Main().
+			HasWidth(100).
+			HasHeight(39).
+			// the middle row of the minimap stands for lines 41 to 44, which
+			// end up in the middle of the view
+			Click(98, 18).
+			VisibleLines(visibleLinesFrom(23)...).
+			// the bottom row stands for the end of the diff
+			Click(98, 36).
+			VisibleLines(visibleLinesFrom(64)...)
+	},
+})
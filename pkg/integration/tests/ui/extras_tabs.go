@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ExtrasTabs = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Cycle through the command log, command output and app log tabs of the extras window",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Gui.ShowCommandLog = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("other")
+		shell.Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("other")).
+			PressPrimaryAction().
+			Lines(
+				Contains("other").IsSelected(),
+				Contains("master"),
+			)
+
+		t.GlobalPress(keys.Universal.ExtrasMenu)
+		t.ExpectPopup().Menu().
+			Title(Equals("Command log")).
+			Select(Contains("Focus command log")).
+			Confirm()
+
+		t.Views().Extras().
+			IsFocused().
+			Content(Contains("git checkout other")).
+			Press(keys.Universal.NextTab)
+
+		t.Views().CommandOutput().
+			IsFocused().
+			Content(
+				Contains("git checkout other").
+					Contains("Switched to branch 'other'").
+					Contains("Exit code: 0"),
+			).
+			Press(keys.Universal.NextTab)
+
+		t.Views().AppLog().
+			IsFocused().
+			Press(keys.Universal.NextTab)
+
+		t.Views().Extras().
+			IsFocused().
+			Press(keys.Universal.PrevTab)
+
+		t.Views().AppLog().
+			IsFocused().
+			Press(keys.Universal.Return)
+
+		t.Views().Branches().
+			IsFocused()
+	},
+})
@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FocusFollowsMouse = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Focus side panels by moving the mouse over them",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.FocusFollowsMouse = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(3)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused()
+
+		t.Views().Commits().
+			Hover(1, 1).
+			IsFocused().
+			// Hovering doesn't change the selection
+			SelectedLine(Contains("commit 03"))
+
+		t.Views().Branches().
+			Hover(1, 0).
+			IsFocused()
+
+		t.Views().Branches().
+			Press(keys.Universal.OptionMenu)
+
+		// Popups keep the focus
+		t.Views().Commits().
+			Hover(1, 1)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Keybindings")).
+			Cancel()
+
+		t.Views().Branches().
+			IsFocused()
+	},
+})
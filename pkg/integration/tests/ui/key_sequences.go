@@ -0,0 +1,97 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var KeySequences = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Use keybindings made of several keys, picking the continuation from a menu",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Keybinding.Files.CommitChanges = "g c"
+		cfg.GetUserConfig().Keybinding.Files.StashAllChanges = "g s"
+		cfg.GetUserConfig().CustomCommands = []config.CustomCommand{
+			{
+				Key:         "g n f",
+				Context:     "global",
+				Command:     "touch newfile",
+				Description: "Create new file",
+			},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFileAndAdd("file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press("g")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("g")).
+			Lines(
+				Contains("n More...").IsSelected(),
+				Contains("c Commit"),
+				Contains("s Stash"),
+				Contains("Cancel"),
+			)
+
+		t.Views().Menu().Press("n")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("g n")).
+			Lines(
+				Contains("f Create new file").IsSelected(),
+				Contains("Cancel"),
+			)
+
+		t.Views().Menu().Press("f")
+
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /"),
+				Equals("  A  file"),
+				Equals("  ?? newfile"),
+			).
+			Press("g")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("g"))
+
+		t.Views().Menu().Press("c")
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("my commit").
+			Confirm()
+
+		// The first key of a global sequence takes precedence over a view's
+		// own binding for it (resetting to a commit in the commits view)
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("my commit").IsSelected(),
+				Contains("initial commit"),
+			).
+			Press("g")
+
+		t.ExpectPopup().Menu().
+			Title(Equals("g")).
+			Lines(
+				Contains("n More...").IsSelected(),
+				Contains("Cancel"),
+			).
+			Cancel()
+
+		t.Views().Files().
+			Focus().
+			Press(keys.Universal.OptionMenuAlt1)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Keybindings")).
+			ContainsLines(Contains("g c Commit"))
+	},
+})
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MouseGestures = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Run the actions configured for mouse gestures in side panels",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Keybinding.Mouse = map[string]config.MouseGesturesConfig{
+			"files": {RightClick: "<space>"},
+			"commits": {
+				DoubleClick: "<disabled>",
+				MiddleClick: "r",
+			},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(3)
+		shell.CreateFile("file-a", "a")
+		shell.CreateFile("file-b", "b")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus()
+
+		// Right-clicking a file focuses the panel and stages the clicked file
+		t.Views().Files().
+			RightClick(1, 2).
+			IsFocused().
+			Lines(
+				Equals("▼ /"),
+				Equals("  ?? file-a"),
+				Equals("  A  file-b").IsSelected(),
+			)
+
+		// Double-clicking a commit selects it without opening its files
+		t.Views().Commits().
+			Click(1, 1).
+			Click(1, 1).
+			IsFocused().
+			SelectedLine(Contains("commit 02"))
+
+		t.Views().Commits().
+			MiddleClick(1, 2)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Title(Equals("Reword commit")).
+			InitialText(Equals("commit 01")).
+			Cancel()
+
+		t.Views().Commits().
+			IsFocused().
+			SelectedLine(Contains("commit 01"))
+	},
+})
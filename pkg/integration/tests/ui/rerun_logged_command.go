@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RerunLoggedCommand = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Copy and re-run a command from the command log",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Gui.ShowCommandLog = true
+		cfg.GetUserConfig().OS.CopyToClipboardCmd = "printf '%s' {{text}} > clipboard"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("other")
+		shell.Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("other"),
+			).
+			NavigateToLine(Contains("other")).
+			PressPrimaryAction().
+			Lines(
+				Contains("other").IsSelected(),
+				Contains("master"),
+			).
+			NavigateToLine(Contains("master")).
+			PressPrimaryAction().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("other"),
+			)
+
+		t.GlobalPress(keys.Universal.ExtrasMenu)
+		t.ExpectPopup().Menu().
+			Title(Equals("Command log")).
+			Select(Contains("Focus command log")).
+			Confirm()
+
+		t.Views().Extras().
+			IsFocused().
+			Press(keys.Universal.GoInto)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Logged commands")).
+			TopLines(
+				Equals("git checkout master"),
+				Equals("git checkout other"),
+			).
+			Select(Equals("git checkout other")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("git checkout other")).
+			Select(Contains("Copy to clipboard")).
+			Confirm()
+
+		t.ExpectToast(Equals("Command copied to clipboard"))
+		t.FileSystem().FileContent("clipboard", Equals("git checkout other"))
+
+		t.Views().Extras().
+			IsFocused().
+			Press(keys.Universal.GoInto)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Logged commands")).
+			Select(Equals("git checkout other")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("git checkout other")).
+			Select(Contains("Re-run command")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Re-run command")).
+			Content(Contains("git checkout other")).
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("other"),
+				Contains("master"),
+			)
+	},
+})
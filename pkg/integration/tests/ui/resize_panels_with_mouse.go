@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ResizePanelsWithMouse = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Resize the side panels and the command log by dragging their borders with the mouse",
+	ExtraCmdArgs: []string{},
+	Width:        150,
+	Height:       50,
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowCommandLog = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Main().HasWidth(100)
+		t.Views().Commits().HasWidth(50)
+		t.Views().Extras().HasHeight(10)
+
+		// grab the left border of the main view and drag it to the right
+		t.Views().Main().Drag(-1, 5, 19, 5)
+		t.Views().Main().HasWidth(78)
+		t.Views().Commits().HasWidth(72)
+		// grab the top border of the command log to the right of its tabs and
+		// drag it upwards
+		t.Views().Extras().Drag(60, -1, 60, -6)
+		t.Views().Extras().HasHeight(15)
+		// double-clicking a border goes back to the configured size
+		t.Views().Main().Click(-1, 5).Click(-1, 5)
+		t.Views().Main().HasWidth(100)
+		t.Views().Commits().HasWidth(50)
+		t.Views().Extras().HasHeight(15)
+	},
+})
@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Scrollbar = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Scroll a list view by clicking and dragging in its scrollbar",
+	ExtraCmdArgs: []string{},
+	Width:        150,
+	Height:       50,
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(40)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			HasWidth(50).
+			HasHeight(14).
+			SelectedLine(Contains("commit 40")).
+			// clicking the bottom of the scrollbar scrolls to the end without
+			// changing the selection
+			Click(48, 11).
+			VisibleLines(
+				Contains("commit 12"),
+				Contains("commit 11"),
+				Contains("commit 10"),
+				Contains("commit 09"),
+				Contains("commit 08"),
+				Contains("commit 07"),
+				Contains("commit 06"),
+				Contains("commit 05"),
+				Contains("commit 04"),
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			).
+			SelectedLineIdx(0).
+			// dragging the scrollbar halfway up scrolls to the middle
+			Drag(48, 11, 48, 6).
+			VisibleLines(
+				Contains("commit 25"),
+				Contains("commit 24"),
+				Contains("commit 23"),
+				Contains("commit 22"),
+				Contains("commit 21"),
+				Contains("commit 20"),
+				Contains("commit 19"),
+				Contains("commit 18"),
+				Contains("commit 17"),
+				Contains("commit 16"),
+				Contains("commit 15"),
+				Contains("commit 14"),
+			).
+			SelectedLineIdx(0).
+			// clicking inside the view still selects the clicked line
+			Click(5, 0).
+			SelectedLine(Contains("commit 25"))
+	},
+})
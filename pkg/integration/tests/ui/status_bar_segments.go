@@ -0,0 +1,34 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StatusBarSegments = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show configured segments at the right end of the bottom line",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Gui.StatusBarSegments = []config.StatusBarSegment{
+			{Template: "{{.Branch}}"},
+			{Command: "cat segment.txt"},
+			{Template: "{{.Operation}}"},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+		shell.CreateFile("segment.txt", "output of command")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Information().Content(Equals("master | output of command"))
+
+		t.Views().Commits().
+			Focus().
+			NavigateToLine(Contains("one")).
+			Press(keys.Universal.Edit)
+
+		t.Views().Information().Content(Contains("| output of command | Rebasing"))
+	},
+})
@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ZenMode = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Toggle zen mode, which shows only the focused panel and its main view",
+	ExtraCmdArgs: []string{},
+	Width:        150,
+	Height:       40,
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowCommandLog = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			HasWidth(50).
+			Press(keys.Universal.ToggleZenMode).
+			HasWidth(75).
+			HasHeight(40)
+		t.Views().Main().
+			HasWidth(75).
+			HasHeight(40)
+		t.Views().Extras().IsInvisible()
+
+		// the main view takes up the whole screen while it has focus
+		t.Views().Files().Press(keys.Universal.FocusMainView)
+		t.Views().Main().
+			IsFocused().
+			HasWidth(150).
+			PressEscape()
+
+		t.Views().Files().
+			IsFocused().
+			HasWidth(75).
+			Press(keys.Universal.ToggleZenMode).
+			HasWidth(50)
+		t.Views().Extras().IsVisible()
+
+		// leaving zen mode goes back to the screen mode we came from
+		t.Views().Files().
+			Press(keys.Universal.NextScreenMode).
+			Press(keys.Universal.NextScreenMode).
+			HasWidth(150).
+			Press(keys.Universal.ToggleZenMode).
+			HasWidth(75).
+			Press(keys.Universal.ToggleZenMode).
+			HasWidth(150)
+	},
+})
@@ -0,0 +1,63 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UndoDiscard = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Undo discarding changes in the files panel, then undo the commit before that",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.Snapshots.Enabled = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file-one", "original content\n")
+		shell.Commit("one")
+		shell.UpdateFile("file-one", "new content\n")
+		shell.CreateFile("file-two", "untracked content\n")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("▼ /").IsSelected(),
+				Equals("   M file-one"),
+				Equals("  ?? file-two"),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Discard changes")).
+					Select(Contains("Discard all changes")).
+					Confirm()
+			}).
+			IsEmpty().
+			Press(keys.Universal.Undo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(Equals("Are you sure you want to bring back the changes you discarded in file-one, file-two? Any changes you've made to these files since will be overwritten.")).
+					Confirm()
+			}).
+			Lines(
+				Equals("▼ /"),
+				Equals("   M file-one"),
+				Equals("  ?? file-two"),
+			)
+
+		t.FileSystem().FileContent("file-one", Equals("new content\n"))
+		t.FileSystem().FileContent("file-two", Equals("untracked content\n"))
+
+		// The discard backup is used up, so undoing again goes back to the reflog
+		t.Views().Files().
+			Press(keys.Universal.Undo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(MatchesRegexp(`Are you sure you want to soft reset to '.*'\?`)).
+					Cancel()
+			})
+	},
+})
@@ -0,0 +1,110 @@
+package worktree
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CreateWithOptions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Create a worktree tracking a remote branch at a suggested path and open it in a new window, then create a locked worktree",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().OS.OpenDirInNewWindow = "printf '%s' {{dir}} > new-window"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.NewBranch("feature")
+		shell.EmptyCommit("feature commit")
+		shell.CloneIntoRemote("origin")
+		shell.Checkout("master")
+		shell.RunCommand([]string{"git", "branch", "-D", "feature"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin"),
+			).
+			PressEnter()
+
+		t.Views().RemoteBranches().
+			IsFocused().
+			NavigateToLine(Contains("feature")).
+			Press(keys.Worktrees.ViewWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Contains("Create worktree from origin/feature").DoesNotContain("detached")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("New worktree path")).
+					SuggestionLines(Equals("../repo-feature")).
+					ConfirmFirstSuggestion()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("New branch name tracking origin/feature (leave blank for feature)")).
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Create worktree")).
+					Lines(
+						Contains("Create and switch to it"),
+						Contains("Create and open in new window"),
+						Contains("Cancel"),
+					).
+					Select(Contains("Create and open in new window")).
+					Confirm()
+			})
+
+		t.FileSystem().FileContent("new-window", Contains("/repo-feature"))
+
+		t.Views().Worktrees().
+			Focus().
+			Lines(
+				Contains("repo (main)"),
+				Contains("repo-feature"),
+			)
+
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master"),
+				Contains("feature (worktree)").Contains("✓"),
+			).
+			NavigateToLine(Contains("feature")).
+			Press(keys.Worktrees.ViewWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Contains("Create locked worktree from feature")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("New worktree path")).
+					Type("../locked").
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Contains("New branch name")).
+					Type("locked-branch").
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Lock reason (optional)")).
+					Type("on usb drive").
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Create worktree")).
+					Select(Contains("Create and switch to it")).
+					Confirm()
+			})
+
+		t.Views().Status().
+			Content(Contains("repo(locked)"))
+
+		t.FileSystem().FileContent("../repo/.git/worktrees/locked/locked", Contains("on usb drive"))
+	},
+})
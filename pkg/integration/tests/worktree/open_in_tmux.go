@@ -0,0 +1,69 @@
+package worktree
+
+import (
+	"path/filepath"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var OpenInTmux = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Open a worktree, a submodule and a recent repo in tmux using a custom command",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().OS.OpenInTmux = "printf '%s %s' {{name}} {{dir}} > tmux-opened"
+		otherRepo, _ := filepath.Abs("../other")
+		config.GetAppState().RecentRepos = []string{otherRepo}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.AddWorktree("HEAD", "../linked-worktree", "newbranch")
+		shell.CloneIntoSubmodule("my_submodule", "my_submodule")
+		shell.CloneNonBare("other")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Worktrees().
+			Focus().
+			NavigateToLine(Contains("linked-worktree")).
+			Press(keys.Universal.OpenInTmux)
+
+		t.FileSystem().FileContent("tmux-opened", Equals("linked-worktree "+absPath("../linked-worktree")))
+
+		t.Views().Submodules().
+			Focus().
+			Lines(
+				Contains("my_submodule"),
+			).
+			Press(keys.Universal.OpenInTmux)
+
+		t.FileSystem().FileContent("tmux-opened", Equals("my_submodule "+absPath("my_submodule")))
+
+		t.GlobalPress(keys.Universal.OpenRecentRepos)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Recent repositories")).
+			Lines(
+				Contains("other").IsSelected(),
+				Contains("Open a recent repo in tmux"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Open a recent repo in tmux")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Open a recent repo in tmux")).
+			Select(Contains("other")).
+			Confirm()
+
+		t.FileSystem().FileContent("tmux-opened", Equals("other "+absPath("../other")))
+
+		// we stay in the current repo
+		t.Views().Status().Content(Contains("repo → master"))
+	},
+})
+
+func absPath(path string) string {
+	abs, _ := filepath.Abs(path)
+	return abs
+}
@@ -0,0 +1,25 @@
+package worktree
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var OpenInTmuxOutsideTmux = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Opening a worktree in tmux is disabled when lazygit doesn't run inside tmux and no command is configured",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.AddWorktree("HEAD", "../linked-worktree", "newbranch")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Worktrees().
+			Focus().
+			NavigateToLine(Contains("linked-worktree")).
+			Press(keys.Universal.OpenInTmux)
+
+		t.ExpectToast(Equals("Disabled: Not running inside tmux. Set `os.openInTmux` in your config to open directories in tmux anyway."))
+	},
+})
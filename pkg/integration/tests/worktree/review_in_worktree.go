@@ -0,0 +1,117 @@
+package worktree
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ReviewInWorktree = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Review a branch in a dedicated worktree, switch back, and remove the review worktree again",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch")
+		shell.CreateFileAndAdd("README.md", "hello world")
+		shell.Commit("initial commit")
+		shell.NewBranch("feature/review-me")
+		shell.Checkout("mybranch")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("mybranch"),
+				Contains("feature/review-me"),
+			).
+			NavigateToLine(Contains("feature/review-me")).
+			Press(keys.Worktrees.ViewWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Contains("Remove review worktree for feature/review-me")).
+					Confirm()
+
+				t.ExpectToast(Equals("Disabled: There is no review worktree for this ref"))
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Equals("Review feature/review-me in worktree")).
+					Confirm()
+			}).
+			IsFocused().
+			Lines(
+				Contains("feature/review-me").IsSelected(),
+				Contains("mybranch (worktree)"),
+			)
+
+		t.Views().Status().
+			Lines(
+				Contains("repo(repo-review-feature-review-me) → feature/review-me"),
+			)
+
+		t.Views().Worktrees().
+			Focus().
+			Lines(
+				Contains("repo-review-feature-review-me").IsSelected(),
+				Contains("repo (main)"),
+			).
+			NavigateToLine(Contains("repo (main)")).
+			Press(keys.Universal.Select)
+
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("mybranch"),
+				Contains("feature/review-me (worktree)"),
+			).
+			NavigateToLine(Contains("feature/review-me")).
+			// Reviewing again reuses the existing worktree
+			Press(keys.Worktrees.ViewWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Equals("Review feature/review-me in worktree")).
+					Confirm()
+			})
+
+		t.Views().Status().
+			Lines(
+				Contains("repo(repo-review-feature-review-me) → feature/review-me"),
+			)
+
+		t.Views().Worktrees().
+			Focus().
+			Lines(
+				Contains("repo-review-feature-review-me"),
+				Contains("repo (main)"),
+			).
+			NavigateToLine(Contains("repo (main)")).
+			Press(keys.Universal.Select)
+
+		t.Views().Branches().
+			Focus().
+			NavigateToLine(Contains("feature/review-me")).
+			Press(keys.Worktrees.ViewWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Contains("Remove review worktree for feature/review-me")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Remove worktree")).
+					Content(Contains("Are you sure you want to remove worktree 'repo-review-feature-review-me'?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("mybranch"),
+				Contains("feature/review-me").DoesNotContain("worktree").IsSelected(),
+			)
+
+		t.Views().Worktrees().
+			Lines(
+				Contains("repo (main)"),
+			)
+	},
+})
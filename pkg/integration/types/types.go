@@ -24,6 +24,10 @@ type IntegrationTest interface {
 type GuiDriver interface {
 	PressKey(string)
 	Click(int, int)
+	RightClick(int, int)
+	MiddleClick(int, int)
+	MoveMouse(int, int)
+	Drag(fromX, fromY, toX, toY int)
 	Keys() config.KeybindingConfig
 	CurrentContext() types.Context
 	ContextForView(viewName string) types.Context
@@ -106,6 +106,10 @@ func setDefaultVals(rootSchema, schema *jsonschema.Schema, defaults any) {
 		schema.Default = v.Int()
 	case reflect.String:
 		schema.Default = v.String()
+	case reflect.Slice:
+		if v.Len() > 0 {
+			schema.Default = defaults
+		}
 	default:
 		// Do nothing
 	}
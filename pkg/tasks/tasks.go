@@ -117,7 +117,12 @@ func (self *ViewBufferManager) ReadToEnd(then func()) {
 	}
 }
 
-func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), prefix string, linesToRead LinesToRead, onDoneFn func()) func(TaskOpts) error {
+// getPrefix, if not nil, returns text to show before the command's output. It
+// is called on the task's goroutine while the command is running, so it may
+// take a while.
+// onCmdError, if not nil, is called when the command finishes on its own with an
+// error (but not when it is stopped because another task wants to run)
+func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), getPrefix func() string, linesToRead LinesToRead, onDoneFn func(), onCmdError func(error)) func(TaskOpts) error {
 	return func(opts TaskOpts) error {
 		var onDoneOnce sync.Once
 		var onFirstPageShownOnce sync.Once
@@ -151,6 +156,11 @@ func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), p
 		cmd, r := start()
 		timeToStart := time.Since(startTime)
 
+		prefix := ""
+		if getPrefix != nil {
+			prefix = getPrefix()
+		}
+
 		done := make(chan struct{})
 
 		go utils.Safe(func() {
@@ -313,6 +323,9 @@ func (self *ViewBufferManager) NewCmdTask(start func() (*exec.Cmd, io.Reader), p
 			default:
 				if err := cmd.Wait(); err != nil {
 					self.Log.Errorf("Unexpected error when running cmd task: %v; Failed command: %v %v", err, cmd.Path, cmd.Args)
+					if onCmdError != nil {
+						onCmdError(err)
+					}
 				}
 			}
 
@@ -377,19 +390,22 @@ func (self *ViewBufferManager) NewTask(f func(TaskOpts) error, key string) error
 		})
 	}
 
-	go utils.Safe(func() {
-		defer completeGocuiTask()
+	// Assign the task ID before spawning the goroutine, so that when two tasks
+	// are created in quick succession the one created last always wins, no
+	// matter in which order their goroutines get scheduled.
+	self.taskIDMutex.Lock()
+	self.newTaskID++
+	taskID := self.newTaskID
 
-		self.taskIDMutex.Lock()
-		self.newTaskID++
-		taskID := self.newTaskID
+	if self.GetTaskKey() != key && self.onNewKey != nil {
+		self.onNewKey()
+	}
+	self.taskKey = key
 
-		if self.GetTaskKey() != key && self.onNewKey != nil {
-			self.onNewKey()
-		}
-		self.taskKey = key
+	self.taskIDMutex.Unlock()
 
-		self.taskIDMutex.Unlock()
+	go utils.Safe(func() {
+		defer completeGocuiTask()
 
 		self.waitingMutex.Lock()
 
@@ -52,7 +52,7 @@ func TestNewCmdTaskInstantStop(t *testing.T) {
 		return cmd, reader
 	}
 
-	fn := manager.NewCmdTask(start, "prefix\n", LinesToRead{20, -1, nil}, onDone)
+	fn := manager.NewCmdTask(start, func() string { return "prefix\n" }, LinesToRead{20, -1, nil}, onDone, nil)
 
 	_ = fn(TaskOpts{Stop: stop, InitialContentLoaded: func() { task.Done() }})
 
@@ -115,7 +115,7 @@ func TestNewCmdTask(t *testing.T) {
 		return cmd, reader
 	}
 
-	fn := manager.NewCmdTask(start, "prefix\n", LinesToRead{20, -1, nil}, onDone)
+	fn := manager.NewCmdTask(start, func() string { return "prefix\n" }, LinesToRead{20, -1, nil}, onDone, nil)
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
@@ -250,7 +250,7 @@ func TestNewCmdTaskRefresh(t *testing.T) {
 			return cmd, &reader
 		}
 
-		fn := manager.NewCmdTask(start, "", s.linesToRead, func() {})
+		fn := manager.NewCmdTask(start, nil, s.linesToRead, func() {}, nil)
 		wg := sync.WaitGroup{}
 		wg.Add(1)
 		go func() {
@@ -268,3 +268,61 @@ func TestNewCmdTaskRefresh(t *testing.T) {
 		}
 	}
 }
+
+type waitGroupTask struct {
+	*gocui.FakeTask
+	wg *sync.WaitGroup
+}
+
+func (self waitGroupTask) Done() {
+	self.wg.Done()
+}
+
+func TestNewTaskLastCreatedWins(t *testing.T) {
+	// The goroutines of tasks created in quick succession can be scheduled in
+	// any order, so try a few times
+	for range 50 {
+		wg := sync.WaitGroup{}
+		newTask := func() gocui.Task {
+			wg.Add(1)
+			return waitGroupTask{FakeTask: gocui.NewFakeTask(), wg: &wg}
+		}
+
+		manager := NewViewBufferManager(
+			utils.NewDummyLog(),
+			bytes.NewBuffer(nil),
+			func() {},
+			func() {},
+			func() {},
+			func() {},
+			newTask,
+		)
+
+		var ranTasksMutex sync.Mutex
+		ranTasks := []string{}
+		taskFunc := func(key string) func(TaskOpts) error {
+			return func(TaskOpts) error {
+				ranTasksMutex.Lock()
+				defer ranTasksMutex.Unlock()
+				ranTasks = append(ranTasks, key)
+				return nil
+			}
+		}
+
+		// Keep the goroutines from getting anywhere until both tasks exist
+		manager.waitingMutex.Lock()
+		_ = manager.NewTask(taskFunc("first"), "first")
+		_ = manager.NewTask(taskFunc("second"), "second")
+
+		if key := manager.GetTaskKey(); key != "second" {
+			t.Fatalf("expected the task key to be 'second' right away, got '%s'", key)
+		}
+
+		manager.waitingMutex.Unlock()
+		wg.Wait()
+
+		if !reflect.DeepEqual(ranTasks, []string{"second"}) {
+			t.Fatalf("expected only the task created last to run, but these ran: %v", ranTasks)
+		}
+	}
+}
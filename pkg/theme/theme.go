@@ -4,6 +4,7 @@ import (
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/samber/lo"
 )
 
 var (
@@ -45,10 +46,24 @@ var (
 	DiffTerminalColor = style.FgMagenta
 
 	UnstagedChangesColor = style.New()
+
+	// AddedLinesColor is the text style of added lines in diffs
+	AddedLinesColor = style.FgGreen
+	// RemovedLinesColor is the text style of removed lines in diffs
+	RemovedLinesColor = style.FgRed
+
+	// ConflictMarkerColor is the text style of the marker lines of merge conflicts
+	ConflictMarkerColor = style.FgRed
+
+	// GraphColors are the styles to pick from for the lanes of the commit graph.
+	// If empty, each author gets a color of their own.
+	GraphColors []style.TextStyle
 )
 
 // UpdateTheme updates all theme variables
 func UpdateTheme(themeConfig config.ThemeConfig) {
+	themeConfig = themeConfig.WithPreset()
+
 	ActiveBorderColor = GetGocuiStyle(themeConfig.ActiveBorderColor)
 	InactiveBorderColor = GetGocuiStyle(themeConfig.InactiveBorderColor)
 	SearchingActiveBorderColor = GetGocuiStyle(themeConfig.SearchingActiveBorderColor)
@@ -71,6 +86,13 @@ func UpdateTheme(themeConfig config.ThemeConfig) {
 	OptionsColor = GetGocuiStyle(themeConfig.OptionsTextColor)
 	OptionsFgColor = GetTextStyle(themeConfig.OptionsTextColor, false)
 
+	AddedLinesColor = GetTextStyle(themeConfig.AddedLinesColor, false)
+	RemovedLinesColor = GetTextStyle(themeConfig.RemovedLinesColor, false)
+	ConflictMarkerColor = GetTextStyle(themeConfig.ConflictMarkerColor, false)
+	GraphColors = lo.Map(themeConfig.GraphColors, func(color string, _ int) style.TextStyle {
+		return GetTextStyle([]string{color}, false)
+	})
+
 	DefaultTextColor = GetTextStyle(themeConfig.DefaultFgColor, false)
 	GocuiDefaultTextColor = GetGocuiStyle(themeConfig.DefaultFgColor)
 }
@@ -6,8 +6,11 @@ import (
 )
 
 func UnixToTimeAgo(timestamp int64) string {
-	now := time.Now().Unix()
-	return formatSecondsAgo(now - timestamp)
+	return UnixToTimeAgoSince(time.Now(), timestamp)
+}
+
+func UnixToTimeAgoSince(now time.Time, timestamp int64) string {
+	return formatSecondsAgo(now.Unix() - timestamp)
 }
 
 const (
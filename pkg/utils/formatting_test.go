@@ -252,6 +252,14 @@ func TestRenderDisplayStrings(t *testing.T) {
 	}
 }
 
+func TestFormatByteSize(t *testing.T) {
+	assert.Equal(t, "0 B", FormatByteSize(0))
+	assert.Equal(t, "1023 B", FormatByteSize(1023))
+	assert.Equal(t, "1.0 KiB", FormatByteSize(1024))
+	assert.Equal(t, "1.5 MiB", FormatByteSize(3*512*1024))
+	assert.Equal(t, "2.0 TiB", FormatByteSize(2048*1024*1024*1024))
+}
+
 func BenchmarkStringWidthAsciiOriginal(b *testing.B) {
 	for b.Loop() {
 		runewidth.StringWidth("some ASCII string")
@@ -286,9 +286,45 @@ func RemoveUpdateRefsForCopiedBranch(fileName string, commentChar byte) error {
 }
 
 // We render a todo in the commits view if it's a commit or if it's an
-// update-ref or exec. We don't render label, reset, or comment lines.
+// update-ref, exec, or break. We don't render label, reset, or comment lines.
 func isRenderedTodo(t todo.Todo, isInRebase bool) bool {
-	return t.Commit != "" || (isInRebase && (t.Command == todo.UpdateRef || t.Command == todo.Exec))
+	return t.Commit != "" || (isInRebase && (t.Command == todo.UpdateRef || t.Command == todo.Exec || t.Command == todo.Break))
+}
+
+// Inserts a break todo right after the given todo, so that the rebase pauses
+// once that todo has been executed, or removes the break if there already is
+// one. A nil todoToFind refers to the very beginning of the todo list.
+func ToggleBreakAfterTodo(fileName string, todoToFind *Todo, commentChar byte) error {
+	todos, err := ReadRebaseTodoFile(fileName, commentChar)
+	if err != nil {
+		return err
+	}
+	newTodos, err := toggleBreakAfterTodo(todos, todoToFind)
+	if err != nil {
+		return err
+	}
+	return WriteRebaseTodoFile(fileName, newTodos, commentChar)
+}
+
+func toggleBreakAfterTodo(todos []todo.Todo, todoToFind *Todo) ([]todo.Todo, error) {
+	breakIdx := 0
+	if todoToFind != nil {
+		idx, ok := findTodo(todos, *todoToFind)
+		if !ok {
+			// Should never happen
+			return []todo.Todo{}, fmt.Errorf("Todo %s not found in git-rebase-todo", todoToFind.Hash)
+		}
+		breakIdx = idx + 1
+	}
+
+	// Skip todos that we don't show (e.g. labels) to find the one that
+	// directly follows in the commits view
+	if _, skip, ok := lo.FindIndexOf(todos[breakIdx:], func(t todo.Todo) bool { return isRenderedTodo(t, true) }); ok &&
+		todos[breakIdx+skip].Command == todo.Break {
+		return Remove(todos, breakIdx+skip), nil
+	}
+
+	return slices.Insert(todos, breakIdx, todo.Todo{Command: todo.Break}), nil
 }
 
 func DropMergeCommit(fileName string, hash string, commentChar byte) error {
@@ -316,3 +352,25 @@ func dropMergeCommit(todos []todo.Todo, hash string) ([]todo.Todo, error) {
 	_, idx, _ := lo.FindIndexOf(todos, isMerge)
 	return slices.Delete(todos, idx, idx+1), nil
 }
+
+// Deletes exec todos that don't directly follow one of the given commits. This
+// is used together with `git rebase --exec`, which adds an exec todo after
+// every commit, to run the command only for a subset of the rebased commits.
+func RemoveExecTodosNotAfterCommits(fileName string, hashes []string, commentChar byte) error {
+	todos, err := ReadRebaseTodoFile(fileName, commentChar)
+	if err != nil {
+		return err
+	}
+
+	return WriteRebaseTodoFile(fileName, removeExecTodosNotAfterCommits(todos, hashes), commentChar)
+}
+
+func removeExecTodosNotAfterCommits(todos []todo.Todo, hashes []string) []todo.Todo {
+	keepExec := false
+	return lo.Filter(todos, func(t todo.Todo, _ int) bool {
+		if t.Commit != "" {
+			keepExec = lo.ContainsBy(hashes, func(hash string) bool { return equalHash(hash, t.Commit) })
+		}
+		return t.Command != todo.Exec || keepExec
+	})
+}
@@ -553,6 +553,168 @@ func TestRebaseCommands_deleteTodos(t *testing.T) {
 	}
 }
 
+func TestRebaseCommands_toggleBreakAfterTodo(t *testing.T) {
+	scenarios := []struct {
+		name          string
+		todos         []todo.Todo
+		todoToFind    *Todo
+		expectedTodos []todo.Todo
+		expectedErr   error
+	}{
+		{
+			name: "insert break after a commit",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Pick, Commit: "5678"},
+			},
+			todoToFind: &Todo{Hash: "1234"},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Break},
+				{Command: todo.Pick, Commit: "5678"},
+			},
+		},
+		{
+			name: "insert break after the last commit",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Pick, Commit: "5678"},
+			},
+			todoToFind: &Todo{Hash: "5678"},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Break},
+			},
+		},
+		{
+			name: "remove existing break",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Break},
+				{Command: todo.Pick, Commit: "5678"},
+			},
+			todoToFind: &Todo{Hash: "1234"},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Pick, Commit: "5678"},
+			},
+		},
+		{
+			name: "remove break at the beginning",
+			todos: []todo.Todo{
+				{Command: todo.Break},
+				{Command: todo.Pick, Commit: "1234"},
+			},
+			todoToFind: nil,
+			expectedTodos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+			},
+		},
+		{
+			name: "remove break after a label",
+			todos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Break},
+				{Command: todo.Pick, Commit: "1234"},
+			},
+			todoToFind: nil,
+			expectedTodos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Pick, Commit: "1234"},
+			},
+		},
+		{
+			name: "todo not found",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+			},
+			todoToFind:    &Todo{Hash: "abcd"},
+			expectedTodos: []todo.Todo{},
+			expectedErr:   errors.New("Todo abcd not found in git-rebase-todo"),
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			actualTodos, actualErr := toggleBreakAfterTodo(scenario.todos, scenario.todoToFind)
+
+			if scenario.expectedErr == nil {
+				assert.NoError(t, actualErr)
+			} else {
+				assert.EqualError(t, actualErr, scenario.expectedErr.Error())
+			}
+
+			assert.EqualValues(t, scenario.expectedTodos, actualTodos)
+		})
+	}
+}
+
+func TestRebaseCommands_removeExecTodosNotAfterCommits(t *testing.T) {
+	scenarios := []struct {
+		name          string
+		todos         []todo.Todo
+		hashes        []string
+		expectedTodos []todo.Todo
+	}{
+		{
+			name: "keep exec todos after the given commits only",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "true"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Exec, ExecCommand: "true"},
+				{Command: todo.Pick, Commit: "abcd"},
+				{Command: todo.Exec, ExecCommand: "true"},
+			},
+			hashes: []string{"1234", "abcd"},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "true"},
+				{Command: todo.Pick, Commit: "5678"},
+				{Command: todo.Pick, Commit: "abcd"},
+				{Command: todo.Exec, ExecCommand: "true"},
+			},
+		},
+		{
+			name: "match abbreviated hashes",
+			todos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "true"},
+			},
+			hashes: []string{"123456789"},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "true"},
+			},
+		},
+		{
+			name: "keep exec todos after merge commits",
+			todos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Exec, ExecCommand: "true"},
+				{Command: todo.Merge, Commit: "5678", Flag: "-C", Label: "branch"},
+				{Command: todo.Exec, ExecCommand: "true"},
+			},
+			hashes: []string{"5678"},
+			expectedTodos: []todo.Todo{
+				{Command: todo.Label, Label: "onto"},
+				{Command: todo.Pick, Commit: "1234"},
+				{Command: todo.Merge, Commit: "5678", Flag: "-C", Label: "branch"},
+				{Command: todo.Exec, ExecCommand: "true"},
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			actualTodos := removeExecTodosNotAfterCommits(scenario.todos, scenario.hashes)
+			assert.EqualValues(t, scenario.expectedTodos, actualTodos)
+		})
+	}
+}
+
 func Test_equalHash(t *testing.T) {
 	scenarios := []struct {
 		a        string
@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// SemVer is a semantic version as used in tag names, e.g. v1.2.3. Pre-release
+// and build suffixes are not supported; tags with such suffixes are ignored
+// when looking for the latest version.
+type SemVer struct {
+	// Either "v" or ""; kept so that bumped versions use the same style
+	Prefix string
+	Major  int
+	Minor  int
+	Patch  int
+}
+
+var semVerRegex = regexp.MustCompile(`^(v?)(\d+)\.(\d+)\.(\d+)$`)
+
+// ParseSemVer returns nil if the given string is not a plain semantic version
+func ParseSemVer(str string) *SemVer {
+	match := semVerRegex.FindStringSubmatch(str)
+	if match == nil {
+		return nil
+	}
+
+	major, err1 := strconv.Atoi(match[2])
+	minor, err2 := strconv.Atoi(match[3])
+	patch, err3 := strconv.Atoi(match[4])
+	if err1 != nil || err2 != nil || err3 != nil {
+		return nil
+	}
+
+	return &SemVer{Prefix: match[1], Major: major, Minor: minor, Patch: patch}
+}
+
+func (self SemVer) String() string {
+	return fmt.Sprintf("%s%d.%d.%d", self.Prefix, self.Major, self.Minor, self.Patch)
+}
+
+func (self SemVer) BumpMajor() SemVer {
+	return SemVer{Prefix: self.Prefix, Major: self.Major + 1}
+}
+
+func (self SemVer) BumpMinor() SemVer {
+	return SemVer{Prefix: self.Prefix, Major: self.Major, Minor: self.Minor + 1}
+}
+
+func (self SemVer) BumpPatch() SemVer {
+	return SemVer{Prefix: self.Prefix, Major: self.Major, Minor: self.Minor, Patch: self.Patch + 1}
+}
+
+func (self SemVer) Less(other SemVer) bool {
+	if self.Major != other.Major {
+		return self.Major < other.Major
+	}
+	if self.Minor != other.Minor {
+		return self.Minor < other.Minor
+	}
+	return self.Patch < other.Patch
+}
+
+// LatestSemVer returns the highest version among the given names along with
+// the name it was parsed from, or nil if none of them is a semantic version.
+func LatestSemVer(names []string) (*SemVer, string) {
+	var latest *SemVer
+	latestName := ""
+	for _, name := range names {
+		version := ParseSemVer(name)
+		if version == nil {
+			continue
+		}
+		if latest == nil || latest.Less(*version) {
+			latest = version
+			latestName = name
+		}
+	}
+	return latest, latestName
+}
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSemVer(t *testing.T) {
+	type scenario struct {
+		str      string
+		expected *SemVer
+	}
+
+	scenarios := []scenario{
+		{
+			str:      "v1.2.3",
+			expected: &SemVer{Prefix: "v", Major: 1, Minor: 2, Patch: 3},
+		},
+		{
+			str:      "10.0.12",
+			expected: &SemVer{Prefix: "", Major: 10, Minor: 0, Patch: 12},
+		},
+		{
+			str:      "v1.2",
+			expected: nil,
+		},
+		{
+			str:      "v1.2.3-rc1",
+			expected: nil,
+		},
+		{
+			str:      "release",
+			expected: nil,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.str, func(t *testing.T) {
+			assert.Equal(t, s.expected, ParseSemVer(s.str))
+		})
+	}
+}
+
+func TestSemVerBump(t *testing.T) {
+	version := SemVer{Prefix: "v", Major: 1, Minor: 2, Patch: 3}
+
+	assert.Equal(t, "v1.2.4", version.BumpPatch().String())
+	assert.Equal(t, "v1.3.0", version.BumpMinor().String())
+	assert.Equal(t, "v2.0.0", version.BumpMajor().String())
+}
+
+func TestLatestSemVer(t *testing.T) {
+	type scenario struct {
+		testName     string
+		names        []string
+		expectedName string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "no names",
+			names:        []string{},
+			expectedName: "",
+		},
+		{
+			testName:     "no semver names",
+			names:        []string{"release", "v1.0"},
+			expectedName: "",
+		},
+		{
+			testName:     "compares numerically",
+			names:        []string{"v1.9.0", "v1.10.0", "v1.2.5", "nightly"},
+			expectedName: "v1.10.0",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			latest, name := LatestSemVer(s.names)
+			assert.Equal(t, s.expectedName, name)
+			assert.Equal(t, s.expectedName == "", latest == nil)
+		})
+	}
+}
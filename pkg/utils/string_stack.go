@@ -1,5 +1,7 @@
 package utils
 
+import "slices"
+
 type StringStack struct {
 	stack []string
 }
@@ -25,3 +27,8 @@ func (self *StringStack) IsEmpty() bool {
 func (self *StringStack) Clear() {
 	self.stack = []string{}
 }
+
+// Returns the items from the bottom of the stack to the top
+func (self *StringStack) Items() []string {
+	return slices.Clone(self.stack)
+}
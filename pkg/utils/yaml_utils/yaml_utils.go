@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -63,6 +64,38 @@ func transformNode(node *yaml.Node, path []string, transform func(node *yaml.Nod
 	return transformNode(valueNode, path[1:], transform)
 }
 
+// Sets the value at the given path of a yaml document, adding the key and the
+// mappings leading to it if they don't exist yet. Comments are kept.
+func SetValue(rootNode *yaml.Node, path []string, value any) error {
+	if len(rootNode.Content) == 0 {
+		*rootNode = yaml.Node{
+			Kind:    yaml.DocumentNode,
+			Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}},
+		}
+	}
+
+	node := rootNode.Content[0]
+	for i, key := range path {
+		if node.Kind != yaml.MappingNode {
+			return fmt.Errorf("Expected a mapping at '%s'", strings.Join(path[:i], "."))
+		}
+
+		_, valueNode := LookupKey(node, key)
+		if valueNode == nil {
+			valueNode = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, valueNode)
+		}
+		node = valueNode
+	}
+
+	lineComment := node.LineComment
+	if err := node.Encode(value); err != nil {
+		return err
+	}
+	node.LineComment = lineComment
+	return nil
+}
+
 // Takes the root node of a yaml document, a path to a key, and a new name for the key.
 // Will rename the key to the new name if it exists, and do nothing otherwise.
 func RenameYamlKey(rootNode *yaml.Node, path []string, newKey string) (error, bool) {
@@ -290,6 +290,77 @@ foo:
 	}
 }
 
+func TestSetValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          string
+		path        []string
+		value       any
+		expectedOut string
+		expectedErr string
+	}{
+		{
+			name:        "Empty document",
+			in:          "",
+			path:        []string{"gui", "showIcons"},
+			value:       true,
+			expectedOut: "gui:\n  showIcons: true\n",
+		},
+		{
+			name: "Existing value, keeping comments",
+			in: `# The gui config
+gui:
+  # Side panel width
+  sidePanelWidth: 0.3 # default
+  showIcons: false
+`,
+			path:  []string{"gui", "sidePanelWidth"},
+			value: 0.5,
+			expectedOut: `# The gui config
+gui:
+  # Side panel width
+  sidePanelWidth: 0.5 # default
+  showIcons: false
+`,
+		},
+		{
+			name: "New key in existing mapping",
+			in: `gui:
+  showIcons: false
+`,
+			path:  []string{"gui", "theme", "activeBorderColor"},
+			value: []string{"green", "bold"},
+			expectedOut: `gui:
+  showIcons: false
+  theme:
+    activeBorderColor:
+      - green
+      - bold
+`,
+		},
+		{
+			name:        "Path through a scalar",
+			in:          "gui: 1\n",
+			path:        []string{"gui", "showIcons"},
+			value:       true,
+			expectedErr: "Expected a mapping at 'gui'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			node := unmarshalForTest(t, test.in)
+			err := SetValue(&node, test.path, test.value)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedOut, marshalForTest(t, &node))
+		})
+	}
+}
+
 func TestRemoveKey(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -0,0 +1,9 @@
+// Package schema embeds the JSON schema of the user config, which
+// pkg/jsonschema generates from the config structs, so that lazygit can use
+// the descriptions and constraints in it at runtime.
+package schema
+
+import _ "embed"
+
+//go:embed config.json
+var ConfigJSON []byte
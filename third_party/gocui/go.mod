@@ -0,0 +1,11 @@
+module github.com/jesseduffield/gocui
+
+go 1.12
+
+require (
+	github.com/gdamore/tcell/v2 v2.8.0
+	github.com/go-errors/errors v1.0.2
+	github.com/mattn/go-runewidth v0.0.16
+	github.com/rivo/uniseg v0.4.7 // indirect
+	github.com/stretchr/testify v1.7.0
+)
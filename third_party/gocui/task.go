@@ -0,0 +1,150 @@
+package gocui
+
+import (
+	"sync"
+	"time"
+)
+
+// A task represents the fact that the program is busy doing something, which
+// is useful for integration tests which only want to proceed when the program
+// is idle.
+
+type Task interface {
+	Done()
+	Pause()
+	Continue()
+	// Closed when the task has been cancelled. Tasks that can be interrupted
+	// should stop what they're doing once this happens. Calling this marks the
+	// task as cancellable.
+	Cancelled() <-chan struct{}
+	// not exporting because we don't need to
+	isBusy() bool
+}
+
+type TaskImpl struct {
+	id        int
+	kind      TaskKind
+	name      string
+	startTime time.Time
+	busy      bool
+	onDone    func()
+	withMutex func(func())
+
+	cancelled   chan struct{}
+	cancelOnce  sync.Once
+	cancellable bool
+}
+
+func (self *TaskImpl) Done() {
+	self.onDone()
+}
+
+func (self *TaskImpl) Pause() {
+	self.withMutex(func() {
+		self.busy = false
+	})
+}
+
+func (self *TaskImpl) Continue() {
+	self.withMutex(func() {
+		self.busy = true
+	})
+}
+
+func (self *TaskImpl) Cancel() {
+	self.cancelOnce.Do(func() {
+		close(self.cancelled)
+	})
+}
+
+func (self *TaskImpl) Cancelled() <-chan struct{} {
+	self.withMutex(func() {
+		self.cancellable = true
+	})
+	return self.cancelled
+}
+
+func (self *TaskImpl) isBusy() bool {
+	return self.busy
+}
+
+type TaskKind int
+
+const (
+	// A function running in a goroutine, started with OnWorker
+	TaskKindWorker TaskKind = iota
+	// Work on the main goroutine: either a function queued with Update, or
+	// the handling of an input event
+	TaskKindUpdate
+)
+
+// A snapshot of a task, for showing the user what the program is busy with
+type TaskInfo struct {
+	Id        int
+	Kind      TaskKind
+	Name      string
+	StartTime time.Time
+	// false if the task is paused e.g. because it's waiting for user input
+	Busy bool
+	// true if the task watches for being cancelled, i.e. cancelling it has any
+	// effect
+	Cancellable bool
+}
+
+type TaskStatus int
+
+const (
+	TaskStatusBusy TaskStatus = iota
+	TaskStatusPaused
+	TaskStatusDone
+)
+
+type FakeTask struct {
+	status TaskStatus
+}
+
+func NewFakeTask() *FakeTask {
+	return &FakeTask{
+		status: TaskStatusBusy,
+	}
+}
+
+func (self *FakeTask) Done() {
+	self.status = TaskStatusDone
+}
+
+func (self *FakeTask) Pause() {
+	self.status = TaskStatusPaused
+}
+
+func (self *FakeTask) Continue() {
+	self.status = TaskStatusBusy
+}
+
+func (self *FakeTask) Cancelled() <-chan struct{} {
+	return nil
+}
+
+func (self *FakeTask) isBusy() bool {
+	return self.status == TaskStatusBusy
+}
+
+func (self *FakeTask) Status() TaskStatus {
+	return self.status
+}
+
+func (self *FakeTask) FormatStatus() string {
+	return formatTaskStatus(self.status)
+}
+
+func formatTaskStatus(status TaskStatus) string {
+	switch status {
+	case TaskStatusBusy:
+		return "busy"
+	case TaskStatusPaused:
+		return "paused"
+	case TaskStatusDone:
+		return "done"
+	}
+	return "unknown"
+}
@@ -0,0 +1,129 @@
+package gocui
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Tracks whether the program is busy (i.e. either something is happening on
+// the main goroutine or a worker goroutine). Used by integration tests
+// to wait until the program is idle before progressing.
+type TaskManager struct {
+	// each of these listeners will be notified when the program goes from busy to idle
+	idleListeners []chan struct{}
+	tasks         map[int]Task
+	// auto-incrementing id for new tasks
+	nextId int
+
+	mutex sync.Mutex
+}
+
+func newTaskManager() *TaskManager {
+	return &TaskManager{
+		tasks:         make(map[int]Task),
+		idleListeners: []chan struct{}{},
+	}
+}
+
+func (self *TaskManager) NewTask() *TaskImpl {
+	return self.newTask(TaskKindWorker, "")
+}
+
+func (self *TaskManager) newTask(kind TaskKind, name string) *TaskImpl {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.nextId++
+	taskId := self.nextId
+
+	onDone := func() { self.delete(taskId) }
+	task := &TaskImpl{
+		id:        taskId,
+		kind:      kind,
+		name:      name,
+		startTime: time.Now(),
+		busy:      true,
+		onDone:    onDone,
+		withMutex: self.withMutex,
+		cancelled: make(chan struct{}),
+	}
+	self.tasks[taskId] = task
+
+	return task
+}
+
+// Returns the tasks that haven't finished yet, oldest first
+func (self *TaskManager) Tasks() []TaskInfo {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	result := make([]TaskInfo, 0, len(self.tasks))
+	for _, task := range self.tasks {
+		impl, ok := task.(*TaskImpl)
+		if !ok {
+			continue
+		}
+		result = append(result, TaskInfo{
+			Id:          impl.id,
+			Kind:        impl.kind,
+			Name:        impl.name,
+			StartTime:   impl.startTime,
+			Busy:        impl.busy,
+			Cancellable: impl.cancellable,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Id < result[j].Id })
+
+	return result
+}
+
+// Cancels the task with the given id. Returns false if the task has already
+// finished.
+func (self *TaskManager) CancelTask(taskId int) bool {
+	self.mutex.Lock()
+	task, ok := self.tasks[taskId]
+	self.mutex.Unlock()
+	if !ok {
+		return false
+	}
+
+	impl, ok := task.(*TaskImpl)
+	if !ok {
+		return false
+	}
+
+	impl.Cancel()
+	return true
+}
+
+func (self *TaskManager) addIdleListener(c chan struct{}) {
+	self.idleListeners = append(self.idleListeners, c)
+}
+
+func (self *TaskManager) withMutex(f func()) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	f()
+
+	// Check if all tasks are done
+	for _, task := range self.tasks {
+		if task.isBusy() {
+			return
+		}
+	}
+
+	// If we get here, all tasks are done, so
+	// notify listeners that the program is idle
+	for _, listener := range self.idleListeners {
+		listener <- struct{}{}
+	}
+}
+
+func (self *TaskManager) delete(taskId int) {
+	self.withMutex(func() {
+		delete(self.tasks, taskId)
+	})
+}
@@ -0,0 +1,31 @@
+package gocui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskManagerCancelTask(t *testing.T) {
+	taskManager := newTaskManager()
+	plain := taskManager.newTask(TaskKindWorker, "plain")
+	cancellable := taskManager.newTask(TaskKindWorker, "cancellable")
+	cancelled := cancellable.Cancelled()
+
+	tasks := taskManager.Tasks()
+	assert.Len(t, tasks, 2)
+	assert.Equal(t, "plain", tasks[0].Name)
+	assert.False(t, tasks[0].Cancellable)
+	assert.Equal(t, "cancellable", tasks[1].Name)
+	assert.True(t, tasks[1].Cancellable)
+
+	assert.True(t, taskManager.CancelTask(cancellable.id))
+	select {
+	case <-cancelled:
+	default:
+		t.Fatal("expected the task to be cancelled")
+	}
+
+	plain.Done()
+	assert.False(t, taskManager.CancelTask(plain.id))
+}